@@ -0,0 +1,232 @@
+package main
+
+import (
+	"fmt"
+	"go/types"
+	"sort"
+	"strings"
+
+	. "github.com/dave/jennifer/jen"
+)
+
+// builderRoots are the resource kinds the chart renders. Fluent builders are
+// emitted for these and for every assertion struct reachable from them.
+var builderRoots = []string{
+	"k8s.io/api/apps/v1.Deployment",
+	"k8s.io/api/autoscaling/v2.HorizontalPodAutoscaler",
+	"k8s.io/api/batch/v1.Job",
+	"k8s.io/api/core/v1.ConfigMap",
+	"k8s.io/api/core/v1.Secret",
+	"k8s.io/api/core/v1.Service",
+	"k8s.io/api/core/v1.ServiceAccount",
+	"k8s.io/api/networking/v1.Ingress",
+	"k8s.io/api/policy/v1.PodDisruptionBudget",
+	"k8s.io/api/rbac/v1.Role",
+	"k8s.io/api/rbac/v1.RoleBinding",
+	"sigs.k8s.io/gateway-api/apis/v1.GRPCRoute",
+	"sigs.k8s.io/gateway-api/apis/v1.HTTPRoute",
+	"github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1.ServiceMonitor",
+}
+
+// builderName returns the builder type name for an assertion struct, e.g.
+// "DeploymentAssertion" -> "DeploymentBuilder".
+func builderName(assertName string) string {
+	return strings.TrimSuffix(assertName, "Assertion") + "Builder"
+}
+
+// emitBuilders emits a generic fluent builder for every assertion struct
+// reachable from builderRoots, plus a constructor for each root. keyed holds
+// the assertion structs that have a natural key for ByKey.
+func emitBuilders(f *File, knownStructs map[string]*structInfo, keyed map[string]bool) {
+	structKey := func(t types.Type) (string, bool) {
+		named, ok := deref(t).(*types.Named)
+		if !ok || named.Obj().Pkg() == nil {
+			return "", false
+		}
+		key := named.Obj().Pkg().Path() + "." + named.Obj().Name()
+		_, ok = knownStructs[key]
+		return key, ok
+	}
+	sliceElemKey := func(t types.Type) (string, bool) {
+		sl, ok := t.(*types.Slice)
+		if !ok {
+			return "", false
+		}
+		return structKey(sl.Elem())
+	}
+
+	// Walk the field graph from the roots.
+	reachable := map[string]bool{}
+	var visit func(key string)
+	visit = func(key string) {
+		if reachable[key] {
+			return
+		}
+		reachable[key] = true
+		struc := knownStructs[key].struc
+		for i := 0; i < struc.NumFields(); i++ {
+			field := struc.Field(i)
+			if !field.Exported() {
+				continue
+			}
+			if k, ok := structKey(field.Type()); ok {
+				visit(k)
+			} else if k, ok := sliceElemKey(field.Type()); ok {
+				visit(k)
+			}
+		}
+	}
+	for _, root := range builderRoots {
+		if _, ok := knownStructs[root]; !ok {
+			panic(fmt.Sprintf("builder root %s is not a scanned struct", root))
+		}
+		visit(root)
+	}
+	keys := make([]string, 0, len(reachable))
+	for key := range reachable {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	// Root constructors
+	for _, root := range builderRoots {
+		info := knownStructs[root]
+		builder := builderName(info.assertNam)
+		ctor := strings.TrimSuffix(info.assertNam, "Assertion")
+		f.Comment(fmt.Sprintf("%s starts a fluent builder for a %s.", ctor, info.assertNam))
+		f.Comment("Call Build to obtain the assertion struct.")
+		f.Func().Id(ctor).Params().Id(builder).Types(Id(info.assertNam)).Block(
+			Id("root").Op(":=").Op("&").Id(info.assertNam).Values(),
+			Return(Id(builder).Types(Id(info.assertNam)).Values(Dict{
+				Id("root"): Id("root"),
+				Id("get"): Func().Params().Op("*").Id(info.assertNam).Block(
+					Return(Id("root")),
+				),
+			})),
+		)
+		f.Line()
+	}
+
+	for _, key := range keys {
+		info := knownStructs[key]
+		assertName := info.assertNam
+		builder := builderName(assertName)
+		recv := func() *Statement {
+			return Params(Id("b").Id(builder).Types(Id("R")))
+		}
+		self := func() *Statement { return Id(builder).Types(Id("R")) }
+
+		f.Comment(fmt.Sprintf("%s fluently fills in a %s that is part of the", builder, assertName))
+		f.Comment("assertion struct R returned by Build.")
+		f.Type().Id(builder).Types(Id("R").Any()).Struct(
+			Id("root").Op("*").Id("R"),
+			Id("get").Func().Params().Op("*").Id(assertName),
+		)
+		f.Line()
+
+		f.Comment("Build returns the root assertion struct.")
+		f.Func().Add(recv()).Id("Build").Params().Id("R").Block(
+			Return(Op("*").Id("b").Dot("root")),
+		)
+		f.Line()
+
+		f.Comment(fmt.Sprintf("With applies fn to the underlying %s, for fields without", assertName))
+		f.Comment("a dedicated builder method or to set a gomega matcher.")
+		f.Func().Add(recv()).Id("With").Params(
+			Id("fn").Func().Params(Op("*").Id(assertName)),
+		).Add(self()).Block(
+			Id("fn").Call(Id("b").Dot("get").Call()),
+			Return(Id("b")),
+		)
+		f.Line()
+
+		used := map[string]bool{"Build": true, "With": true}
+		struc := info.struc
+		for i := 0; i < struc.NumFields(); i++ {
+			used[struc.Field(i).Name()] = true
+		}
+
+		for i := 0; i < struc.NumFields(); i++ {
+			field := struc.Field(i)
+			if !field.Exported() {
+				continue
+			}
+			name := field.Name()
+			fieldType := field.Type()
+
+			// Nested struct: descend into its builder.
+			if k, ok := structKey(fieldType); ok {
+				child := knownStructs[k].assertNam
+				f.Comment(fmt.Sprintf("%s descends into the %s field.", name, name))
+				f.Func().Add(recv()).Id(name).Params().Id(builderName(child)).Types(Id("R")).Block(
+					Id("get").Op(":=").Id("b").Dot("get"),
+					Return(Id(builderName(child)).Types(Id("R")).Values(Dict{
+						Id("root"): Id("b").Dot("root"),
+						Id("get"): Func().Params().Op("*").Id(child).Block(
+							Return(Op("&").Id("get").Call().Dot(name)),
+						),
+					})),
+				)
+				f.Line()
+				continue
+			}
+
+			// Slice of assertion structs: a positional setter, plus a keyed
+			// accessor when the element type has a natural key.
+			if k, ok := sliceElemKey(fieldType); ok {
+				elem := knownStructs[k].assertNam
+				f.Comment(fmt.Sprintf("%s sets %s to exactly the given elements, compared by position.", name, name))
+				f.Func().Add(recv()).Id(name).Params(Id("v").Index().Id(elem)).Add(self()).Block(
+					Id("b").Dot("get").Call().Dot(name).Op("=").Id("Some").Call(Id("v")),
+					Return(Id("b")),
+				)
+				f.Line()
+
+				accessor := elementAccessorName(name, knownStructs[k].named.Obj().Name())
+				if !keyed[elem] || used[accessor] {
+					continue
+				}
+				used[accessor] = true
+				f.Comment(fmt.Sprintf("%s descends into the element of %s with the given Name,", accessor, name))
+				f.Comment(fmt.Sprintf("adding it if needed. %s is compared with ByKey semantics.", name))
+				f.Func().Add(recv()).Id(accessor).Params(Id("name").String()).Id(builderName(elem)).Types(Id("R")).Block(
+					Id("get").Op(":=").Id("b").Dot("get"),
+					Id("keyedElement").Call(Op("&").Id("get").Call().Dot(name), Id("name")),
+					Return(Id(builderName(elem)).Types(Id("R")).Values(Dict{
+						Id("root"): Id("b").Dot("root"),
+						Id("get"): Func().Params().Op("*").Id(elem).Block(
+							Return(Id("keyedElement").Call(Op("&").Id("get").Call().Dot(name), Id("name"))),
+						),
+					})),
+				)
+				f.Line()
+				continue
+			}
+
+			// Plain Opt[T] field: a value setter. Pointer fields take the
+			// pointee so that callers do not need Ptr.
+			valueType := fieldType
+			setValue := Id("Some").Call(Id("v"))
+			if ptr, ok := fieldType.(*types.Pointer); ok {
+				valueType = ptr.Elem()
+				setValue = Id("SomePtr").Call(Id("v"))
+			}
+			f.Comment(fmt.Sprintf("%s sets the expected %s.", name, name))
+			f.Func().Add(recv()).Id(name).Params(Id("v").Add(jenType(valueType))).Add(self()).Block(
+				Id("b").Dot("get").Call().Dot(name).Op("=").Add(setValue),
+				Return(Id("b")),
+			)
+			f.Line()
+		}
+	}
+}
+
+// elementAccessorName derives the keyed accessor for a slice field, e.g.
+// "Containers" -> "Container". Fields that are not plural, such as "Env",
+// use the element's type name instead ("EnvVar").
+func elementAccessorName(field, elemType string) string {
+	if len(field) > 1 && strings.HasSuffix(field, "s") && !strings.HasSuffix(field, "ss") {
+		return strings.TrimSuffix(field, "s")
+	}
+	return elemType
+}
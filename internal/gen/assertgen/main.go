@@ -15,6 +15,15 @@ import (
 	. "github.com/dave/jennifer/jen"
 )
 
+// structInfo describes a struct type from a scanned package and the
+// assertion struct generated for it.
+type structInfo struct {
+	named     *types.Named
+	struc     *types.Struct
+	pkg       *packages.Package
+	assertNam string // assertion struct name
+}
+
 var scannedPkgs = []string{
 	"k8s.io/api/apps/v1",
 	"k8s.io/api/autoscaling/v2",
@@ -34,6 +43,7 @@ var scannedPkgs = []string{
 func main() {
 	outFlag := flag.String("out", "", "output file path")
 	pkgFlag := flag.String("package", "support", "package name for the generated file")
+	buildersOutFlag := flag.String("builders-out", "", "output file path for the fluent builders (skipped if empty)")
 	flag.Parse()
 
 	if *outFlag == "" {
@@ -42,7 +52,7 @@ func main() {
 
 	// Load all scanned packages
 	cfg := &packages.Config{
-		Mode: packages.NeedTypes | packages.NeedName | packages.NeedSyntax | packages.NeedTypesInfo,
+		Mode: packages.NeedTypes | packages.NeedName | packages.NeedSyntax | packages.NeedTypesInfo | packages.NeedImports | packages.NeedDeps,
 	}
 	pkgs, err := packages.Load(cfg, scannedPkgs...)
 	if err != nil {
@@ -50,13 +60,6 @@ func main() {
 	}
 
 	// Build a set of all struct types from scanned packages, keyed by *types.Named
-	type structInfo struct {
-		named     *types.Named
-		struc     *types.Struct
-		pkg       *packages.Package
-		assertNam string // assertion struct name
-	}
-
 	knownStructs := map[string]*structInfo{} // key: pkgPath + "." + name
 	var orderedKeys []string
 
@@ -158,9 +161,14 @@ func main() {
 	f.Comment("Opt wraps an optional value for assertion comparison.")
 	f.Comment("Val holds a concrete expected value; Matcher holds a gomega matcher.")
 	f.Comment("If Matcher is set it takes precedence over Val.")
+	f.Comment("The constructor used decides how Val is compared: for slices of assertion")
+	f.Comment("structs Some, ContainsElements, ConsistsOf and ByKey pair elements")
+	f.Comment("differently, while Absent, NotContains and NotContainsKeys assert that")
+	f.Comment("something is missing.")
 	f.Type().Id("Opt").Types(Id("T").Any()).Struct(
 		Id("Val").Op("*").Id("T"),
 		Id("Matcher").Qual("github.com/onsi/gomega/types", "GomegaMatcher"),
+		Line().Id("match").Id("matchMode"),
 	)
 	f.Line()
 
@@ -210,14 +218,46 @@ func main() {
 			}
 		}
 
+		// absent is set by Unset to assert that the nested field is nil or zero
+		fields = append(fields, Line().Id("absent").Bool())
+
 		f.Comment(fmt.Sprintf("%s is the assertion struct for %s.", info.assertNam, info.named.Obj().Name()))
 		f.Type().Id(info.assertNam).Struct(fields...)
 
-		// Emit isAssertable method
+		// Emit isAssertable and markAbsent methods
 		f.Func().Params(Id("_").Id(info.assertNam)).Id("isAssertable").Params().Block()
+		f.Func().Params(Id("a").Op("*").Id(info.assertNam)).Id("markAbsent").Params().Block(
+			Id("a").Dot("absent").Op("=").True(),
+		)
 		f.Line()
 	}
 
+	// Emit the natural keys used by ByKey: every assertion struct that appears
+	// as a slice element and whose source type has a string Name field.
+	sliceElems := map[string]bool{}
+	for _, key := range orderedKeys {
+		struc := knownStructs[key].struc
+		for i := 0; i < struc.NumFields(); i++ {
+			if assertName, ok := isSliceOfKnownStruct(struc.Field(i).Type()); ok {
+				sliceElems[assertName] = true
+			}
+		}
+	}
+	var naturalKeys []Code
+	keyed := map[string]bool{}
+	for _, key := range orderedKeys {
+		info := knownStructs[key]
+		if !sliceElems[info.assertNam] || !hasStringField(info.struc, "Name") {
+			continue
+		}
+		keyed[info.assertNam] = true
+		naturalKeys = append(naturalKeys, Line().Id("reflect").Dot("TypeOf").Call(Id(info.assertNam).Values()).Op(":").Lit("Name"))
+	}
+	f.Comment("naturalKeys maps slice element assertion types to the field ByKey pairs")
+	f.Comment("elements by.")
+	f.Var().Id("naturalKeys").Op("=").Map(Id("reflect").Dot("Type")).String().Values(append(naturalKeys, Line())...)
+	f.Line()
+
 	// Render the jennifer portion
 	jenBuf := &strings.Builder{}
 	if err := f.Render(jenBuf); err != nil {
@@ -239,20 +279,363 @@ func SomePtr[T any](v T) Opt[*T] { return Some(&v) }
 
 var assertableType = reflect.TypeOf((*Assertable)(nil)).Elem()
 
+// matchMode selects how an Opt is compared against the actual field. For an
+// Opt[[]E] of assertion structs the first four modes select how its elements
+// are paired with the elements of the actual slice.
+type matchMode int
+
+const (
+	// matchExact requires equal lengths and compares elements by position.
+	matchExact matchMode = iota
+	// matchContains requires every expected element to match a distinct
+	// actual element, in any order. Extra actual elements are ignored.
+	matchContains
+	// matchAnyOrder is matchContains with equal lengths.
+	matchAnyOrder
+	// matchByKey pairs elements by their natural key. Extra actual elements
+	// are ignored.
+	matchByKey
+	// matchAbsent requires the actual field to be unset.
+	matchAbsent
+	// matchNotContains requires that no actual element matches any expected one.
+	matchNotContains
+	// matchNotContainsKeys requires that the actual map has none of the
+	// expected map's keys.
+	matchNotContainsKeys
+)
+
+// Absent creates an Opt that passes if the actual field is unset: a nil
+// pointer, an empty slice or map, or the zero value.
+func Absent[T any]() Opt[T] {
+	return Opt[T]{match: matchAbsent}
+}
+
+// Unset returns an assertion struct that passes if the actual nested field
+// is unset: a nil pointer or the zero value. Use it for optional blocks such
+// as a container's StartupProbe, e.g. Unset[ProbeAssertion]().
+func Unset[T any, PT interface {
+	*T
+	markAbsent()
+}]() T {
+	var v T
+	PT(&v).markAbsent()
+	return v
+}
+
+// NotContains creates an Opt that passes if no element of the actual slice
+// matches any of the given elements. Assertion structs are matched partially,
+// so NotContains(ContainerAssertion{Name: Some("x")}) rejects any container
+// named x; other element types are compared for equality.
+func NotContains[E any](elems ...E) Opt[[]E] {
+	return Opt[[]E]{Val: &elems, match: matchNotContains}
+}
+
+// NotContainsKeys creates an Opt that passes if the actual map has none of
+// the given keys, e.g. NotContainsKeys[string]("prometheus.io/scrape").
+func NotContainsKeys[V any, K comparable](keys ...K) Opt[map[K]V] {
+	m := make(map[K]V, len(keys))
+	for _, k := range keys {
+		var zero V
+		m[k] = zero
+	}
+	return Opt[map[K]V]{Val: &m, match: matchNotContainsKeys}
+}
+
+// ContainsElements creates an Opt that passes if every given element partially
+// matches a distinct element of the actual slice, in any order. Actual elements
+// not matched by any of them are ignored.
+func ContainsElements[E Assertable](elems ...E) Opt[[]E] {
+	return Opt[[]E]{Val: &elems, match: matchContains}
+}
+
+// ConsistsOf creates an Opt that passes if the actual slice has exactly as many
+// elements as given and each given element partially matches a distinct one
+// of them, in any order.
+func ConsistsOf[E Assertable](elems ...E) Opt[[]E] {
+	return Opt[[]E]{Val: &elems, match: matchAnyOrder}
+}
+
+// ByKey creates an Opt that pairs each given element with the actual element
+// sharing its natural key (the Name of a container, env var, volume, port, …)
+// and compares the pair partially. Actual elements with other keys are
+// ignored. It panics if E has no natural key or an element leaves it unset.
+func ByKey[E Assertable](elems ...E) Opt[[]E] {
+	elemType := reflect.TypeOf((*E)(nil)).Elem()
+	keyField, ok := naturalKeys[elemType]
+	if !ok {
+		panic(fmt.Sprintf("ByKey: %v has no natural key", elemType))
+	}
+	for i, elem := range elems {
+		if _, ok := assertionKey(reflect.ValueOf(elem), keyField); !ok {
+			panic(fmt.Sprintf("ByKey: element %d of %v does not set %s", i, elemType, keyField))
+		}
+	}
+	return Opt[[]E]{Val: &elems, match: matchByKey}
+}
+
+// keyedElement returns the element of opt whose natural key is name,
+// appending a new element with that key if there is none. It backs the keyed
+// accessors of the fluent builders, which always use ByKey semantics.
+func keyedElement[E Assertable](opt *Opt[[]E], name string) *E {
+	if opt.Val == nil {
+		*opt = Opt[[]E]{Val: &[]E{}, match: matchByKey}
+	}
+	if opt.match != matchByKey {
+		panic(fmt.Sprintf("keyed builder access to a slice already set with another matching mode (%T)", *new(E)))
+	}
+	keyField := naturalKeys[reflect.TypeOf((*E)(nil)).Elem()]
+	elems := *opt.Val
+	for i := range elems {
+		if key, ok := assertionKey(reflect.ValueOf(elems[i]), keyField); ok && key.String() == name {
+			return &elems[i]
+		}
+	}
+	var elem E
+	keyOpt := reflect.ValueOf(&elem).Elem().FieldByName(keyField).FieldByName("Val")
+	key := reflect.New(keyOpt.Type().Elem())
+	key.Elem().SetString(name)
+	keyOpt.Set(key)
+	*opt.Val = append(elems, elem)
+	return &(*opt.Val)[len(*opt.Val)-1]
+}
+
+// assertionKey returns the expected value of keyField in an assertion struct.
+func assertionKey(assertion reflect.Value, keyField string) (reflect.Value, bool) {
+	val := assertion.FieldByName(keyField).FieldByName("Val")
+	if !val.IsValid() || val.IsNil() {
+		return reflect.Value{}, false
+	}
+	return val.Elem(), true
+}
+
+// Mismatch describes a single field of an assertion that actual did not satisfy.
+type Mismatch struct {
+	// Path is the field path from the asserted object, e.g.
+	// Spec.Template.Spec.Containers[0].SecurityContext.RunAsUser.
+	Path string
+	// Message explains the mismatch.
+	Message string
+	// Expected and Actual hold the compared values for plain value
+	// comparisons. They are nil for matcher and structural mismatches.
+	Expected any
+	Actual   any
+
+	compared bool
+}
+
+// String renders the mismatch as its path and message followed, for value
+// comparisons, by a unified diff of the expected and actual subtree as YAML.
+func (m Mismatch) String() string {
+	if !m.compared {
+		return fmt.Sprintf("%s: %s", m.Path, m.Message)
+	}
+	diff := yamlDiff(m.Expected, m.Actual)
+	return fmt.Sprintf("%s: %s\n  %s", m.Path, m.Message, strings.ReplaceAll(diff, "\n", "\n  "))
+}
+
+// yamlDiff renders a unified diff between expected and actual marshalled as
+// YAML. Lines are coloured only if useColor reports so.
+func yamlDiff(expected, actual any) string {
+	diff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(strings.TrimSuffix(toYAML(expected), "\n")),
+		B:        difflib.SplitLines(strings.TrimSuffix(toYAML(actual), "\n")),
+		FromFile: "expected",
+		ToFile:   "actual",
+		Context:  3,
+	})
+	if err != nil {
+		return fmt.Sprintf("expected: %s\nactual:   %s", toYAML(expected), toYAML(actual))
+	}
+	return colorizeDiff(strings.TrimRight(diff, "\n"))
+}
+
+// toYAML marshals v the way it would appear in a manifest. Pointers are
+// followed so that Opt[*T] values show the pointee rather than an address.
+func toYAML(v any) string {
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Ptr && !rv.IsNil() {
+		rv = rv.Elem()
+	}
+	if !rv.IsValid() || (rv.Kind() == reflect.Ptr && rv.IsNil()) {
+		return "null\n"
+	}
+	out, err := yaml.Marshal(rv.Interface())
+	if err != nil {
+		return fmt.Sprintf("%#v\n", rv.Interface())
+	}
+	return string(out)
+}
+
+const (
+	ansiReset = "\x1b[0m"
+	ansiRed   = "\x1b[31m"
+	ansiGreen = "\x1b[32m"
+	ansiCyan  = "\x1b[36m"
+)
+
+// ColorEnvVar enables coloured diffs when stdout is not a terminal, as is
+// the case under go test, for logs that render ANSI escapes.
+const ColorEnvVar = "ZITADEL_TEST_COLOR"
+
+// isTerminal reports whether stdout is a terminal. Tests replace it, since
+// the answer depends on how go test was run.
+var isTerminal = func() bool {
+	info, err := os.Stdout.Stat()
+	return err == nil && info.Mode()&os.ModeCharDevice != 0
+}
+
+// useColor reports whether diffs are coloured: never if NO_COLOR is set
+// (https://no-color.org), otherwise if ColorEnvVar is set or isTerminal
+// reports so.
+func useColor() bool {
+	if os.Getenv("NO_COLOR") != "" {
+		return false
+	}
+	if os.Getenv(ColorEnvVar) != "" {
+		return true
+	}
+	return isTerminal()
+}
+
+// colorizeDiff adds ANSI colours to a unified diff when useColor reports so:
+// removed (expected) lines in red, added (actual) lines in green and hunk
+// headers in cyan.
+func colorizeDiff(diff string) string {
+	if !useColor() {
+		return diff
+	}
+	lines := strings.Split(diff, "\n")
+	for i, line := range lines {
+		switch {
+		case strings.HasPrefix(line, "---"), strings.HasPrefix(line, "+++"):
+		case strings.HasPrefix(line, "-"):
+			lines[i] = ansiRed + line + ansiReset
+		case strings.HasPrefix(line, "+"):
+			lines[i] = ansiGreen + line + ansiReset
+		case strings.HasPrefix(line, "@@"):
+			lines[i] = ansiCyan + line + ansiReset
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// objectRef returns "Kind name" for a Kubernetes object, or "" if actual is
+// not one. The kind is taken from the Go type because objects read through
+// typed clients have an empty TypeMeta.
+func objectRef(actual any) string {
+	obj, ok := actual.(interface{ GetName() string })
+	if !ok {
+		return ""
+	}
+	rv := reflect.ValueOf(actual)
+	if rv.Kind() == reflect.Ptr && rv.IsNil() {
+		return ""
+	}
+	kind := reflect.Indirect(rv).Type().Name()
+	if obj.GetName() == "" {
+		return kind
+	}
+	return kind + " " + obj.GetName()
+}
+
+// failureHeader prefixes summary with the object the assertion ran against
+// and appends the caller's message, if any.
+func failureHeader(actual any, summary string, msgAndArgs []any) string {
+	if ref := objectRef(actual); ref != "" {
+		summary = ref + ": " + summary
+	}
+	if extra := formatMsgAndArgs(msgAndArgs); extra != "" {
+		summary += " (" + extra + ")"
+	}
+	return summary
+}
+
+// reporter receives the mismatches found while walking an assertion tree.
+// testingT returns the test to mark helpers on, or nil if the reporter does
+// not fail a test directly.
+type reporter interface {
+	testingT() *testing.T
+	report(m Mismatch)
+}
+
+// failFastReporter fails the test on the first mismatch.
+type failFastReporter struct {
+	t          *testing.T
+	actual     any
+	msgAndArgs []any
+}
+
+func (r failFastReporter) testingT() *testing.T { return r.t }
+
+func (r failFastReporter) report(m Mismatch) {
+	r.t.Helper()
+	require.Fail(r.t, m.String(), failureHeader(r.actual, fmt.Sprintf("field %q did not match", m.Path), r.msgAndArgs))
+}
+
+// formatMsgAndArgs renders testify-style msgAndArgs, where a leading string
+// is used as the format for the remaining arguments.
+func formatMsgAndArgs(msgAndArgs []any) string {
+	if len(msgAndArgs) == 0 {
+		return ""
+	}
+	if format, ok := msgAndArgs[0].(string); ok && len(msgAndArgs) > 1 {
+		return fmt.Sprintf(format, msgAndArgs[1:]...)
+	}
+	return fmt.Sprint(msgAndArgs...)
+}
+
+// collectingReporter records every mismatch and lets the walk continue.
+type collectingReporter struct {
+	mismatches []Mismatch
+}
+
+func (r *collectingReporter) testingT() *testing.T { return nil }
+
+func (r *collectingReporter) report(m Mismatch) {
+	r.mismatches = append(r.mismatches, m)
+}
+
 // AssertPartial walks the assertion struct via reflection, comparing set fields against actual.
+// It fails the test on the first mismatching field.
 func AssertPartial[T Assertable](t *testing.T, actual any, assertion T, msgAndArgs ...any) {
 	t.Helper()
-	doAssertPartial(t, reflect.ValueOf(actual), reflect.ValueOf(assertion), msgAndArgs)
+	doAssertPartial(failFastReporter{t: t, actual: actual, msgAndArgs: msgAndArgs}, "", reflect.ValueOf(actual), reflect.ValueOf(assertion))
 }
 
-func doAssertPartial(t *testing.T, actualVal reflect.Value, assertionVal reflect.Value, msgAndArgs []any) {
+// AssertPartialAll is like AssertPartial but walks the whole assertion tree
+// first and then fails once, listing every mismatching field with its path.
+func AssertPartialAll[T Assertable](t *testing.T, actual any, assertion T, msgAndArgs ...any) {
 	t.Helper()
+	mismatches := Mismatches(actual, assertion)
+	if len(mismatches) == 0 {
+		return
+	}
+	var report strings.Builder
+	fmt.Fprintf(&report, "%d field(s) did not match:", len(mismatches))
+	for _, m := range mismatches {
+		report.WriteString("\n  ")
+		report.WriteString(strings.ReplaceAll(m.String(), "\n", "\n  "))
+	}
+	require.Fail(t, report.String(), failureHeader(actual, "assertion failed", msgAndArgs))
+}
+
+// Mismatches walks the whole assertion tree and returns every field of
+// actual that does not satisfy assertion, in field order.
+func Mismatches[T Assertable](actual any, assertion T) []Mismatch {
+	r := &collectingReporter{}
+	doAssertPartial(r, "", reflect.ValueOf(actual), reflect.ValueOf(assertion))
+	return r.mismatches
+}
+
+func doAssertPartial(r reporter, path string, actualVal reflect.Value, assertionVal reflect.Value) {
+	if t := r.testingT(); t != nil {
+		t.Helper()
+	}
 
 	// Dereference pointers on actual
 	for actualVal.Kind() == reflect.Ptr {
 		if actualVal.IsNil() {
-			require.Fail(t, "actual is nil but assertion has fields to check",
-				append([]any{"got nil pointer when asserting against %v"}, msgAndArgs...)...)
+			r.report(Mismatch{Path: rootPath(path), Message: "actual is nil but assertion has fields to check"})
 			return
 		}
 		actualVal = actualVal.Elem()
@@ -261,8 +644,7 @@ func doAssertPartial(t *testing.T, actualVal reflect.Value, assertionVal reflect
 	// Dereference pointers on assertion
 	for assertionVal.Kind() == reflect.Ptr {
 		if assertionVal.IsNil() {
-			require.Fail(t, "assertion is nil but has fields to check",
-				append([]any{"got nil pointer for assertion"}, msgAndArgs...)...)
+			r.report(Mismatch{Path: rootPath(path), Message: "assertion is nil but has fields to check"})
 			return
 		}
 		assertionVal = assertionVal.Elem()
@@ -276,7 +658,11 @@ func doAssertPartial(t *testing.T, actualVal reflect.Value, assertionVal reflect
 
 	for i := 0; i < assertionType.NumField(); i++ {
 		fieldInfo := assertionType.Field(i)
+		if !fieldInfo.IsExported() {
+			continue
+		}
 		fieldVal := assertionVal.Field(i)
+		fieldPath := joinPath(path, fieldInfo.Name)
 
 		// Get the corresponding field in actual
 		actualField := actualVal.FieldByName(fieldInfo.Name)
@@ -290,20 +676,34 @@ func doAssertPartial(t *testing.T, actualVal reflect.Value, assertionVal reflect
 			if fieldVal.IsZero() {
 				continue
 			}
-			doAssertPartial(t, actualField, fieldVal, msgAndArgs)
+			// Built by Unset: the actual field must be nil or zero
+			if fieldVal.FieldByName("absent").Bool() {
+				if !isUnset(actualField) {
+					reportPresent(r, fieldPath, actualField)
+				}
+				continue
+			}
+			doAssertPartial(r, fieldPath, actualField, fieldVal)
+			continue
+		}
+
+		// Must be Opt[T] — check Absent first, then Matcher, then Val
+		mode := matchMode(fieldVal.FieldByName("match").Int())
+		if mode == matchAbsent {
+			if !isUnset(actualField) {
+				reportPresent(r, fieldPath, actualField)
+			}
 			continue
 		}
 
-		// Must be Opt[T] — check Matcher first, then Val
 		matcherField := fieldVal.FieldByName("Matcher")
 		if matcherField.IsValid() && !matcherField.IsNil() {
 			matcher := matcherField.Interface().(types.GomegaMatcher)
 			success, err := matcher.Match(actualField.Interface())
-			require.NoError(t, err,
-				append([]any{fmt.Sprintf("field %q: matcher error", fieldInfo.Name)}, msgAndArgs...)...)
-			if !success {
-				require.Fail(t, matcher.FailureMessage(actualField.Interface()),
-					append([]any{fmt.Sprintf("field %q", fieldInfo.Name)}, msgAndArgs...)...)
+			if err != nil {
+				r.report(Mismatch{Path: fieldPath, Message: fmt.Sprintf("matcher error: %v", err)})
+			} else if !success {
+				r.report(Mismatch{Path: fieldPath, Message: matcher.FailureMessage(actualField.Interface())})
 			}
 			continue
 		}
@@ -319,17 +719,26 @@ func doAssertPartial(t *testing.T, actualVal reflect.Value, assertionVal reflect
 		// val is set — compare
 		expectedVal := valField.Elem()
 
-		// Slice of Assertable: compare element-by-element with partial matching
-		if expectedVal.Kind() == reflect.Slice && expectedVal.Type().Elem().Implements(assertableType) {
-			require.Equal(t, expectedVal.Len(), actualField.Len(),
-				append([]any{fmt.Sprintf("field %q: slice length mismatch", fieldInfo.Name)}, msgAndArgs...)...)
-			for j := 0; j < expectedVal.Len(); j++ {
-				elemMsg := append([]any{fmt.Sprintf("field %q[%d]", fieldInfo.Name, j)}, msgAndArgs...)
-				doAssertPartial(t, actualField.Index(j), expectedVal.Index(j), elemMsg)
+		if mode == matchNotContains {
+			compareNotContains(r, fieldPath, expectedVal, actualField)
+			continue
+		}
+		if mode == matchNotContainsKeys {
+			for _, k := range sortedMapKeys(expectedVal) {
+				if actualField.MapIndex(k).IsValid() {
+					r.report(Mismatch{Path: fmt.Sprintf("%s[%v]", fieldPath, k.Interface()), Message: "key should be absent"})
+				}
 			}
 			continue
 		}
 
+		// Slice of Assertable: compare elements with partial matching, paired
+		// according to the constructor that built the Opt.
+		if expectedVal.Kind() == reflect.Slice && expectedVal.Type().Elem().Implements(assertableType) {
+			compareSlice(r, fieldPath, mode, expectedVal, actualField)
+			continue
+		}
+
 		// Map subset matching: verify all expected entries exist in actual.
 		// This allows actual to have extra keys (e.g. Helm metadata annotations).
 		// An empty expected map means "assert no entries" — treat nil actual as empty.
@@ -337,29 +746,257 @@ func doAssertPartial(t *testing.T, actualVal reflect.Value, assertionVal reflect
 			if expectedVal.Len() == 0 {
 				// Expected empty map — actual must also be empty (or nil)
 				if actualField.Kind() == reflect.Map && actualField.Len() > 0 {
-					require.Fail(t, fmt.Sprintf("field %q: expected empty map but actual has %d entries", fieldInfo.Name, actualField.Len()),
-						msgAndArgs...)
+					r.report(Mismatch{Path: fieldPath, Message: fmt.Sprintf("expected empty map but actual has %d entries", actualField.Len())})
 				}
 				continue
 			}
-			for _, k := range expectedVal.MapKeys() {
+			for _, k := range sortedMapKeys(expectedVal) {
+				entryPath := fmt.Sprintf("%s[%v]", fieldPath, k.Interface())
 				actualEntry := actualField.MapIndex(k)
-				require.True(t, actualEntry.IsValid(),
-					append([]any{fmt.Sprintf("field %q: expected key %v not found in actual map", fieldInfo.Name, k.Interface())}, msgAndArgs...)...)
-				require.Equal(t, expectedVal.MapIndex(k).Interface(), actualEntry.Interface(),
-					append([]any{fmt.Sprintf("field %q[%v] mismatch", fieldInfo.Name, k.Interface())}, msgAndArgs...)...)
+				if !actualEntry.IsValid() {
+					r.report(Mismatch{Path: entryPath, Message: "expected key not found in actual map"})
+					continue
+				}
+				compareValues(r, entryPath, expectedVal.MapIndex(k), actualEntry)
 			}
 			continue
 		}
 
-		if !expectedVal.CanInterface() || !actualField.CanInterface() {
-			require.True(t, reflect.DeepEqual(expectedVal, actualField),
-				append([]any{fmt.Sprintf("field %q mismatch\nexpected: %v\nactual:   %v", fieldInfo.Name, expectedVal, actualField)}, msgAndArgs...)...)
+		compareValues(r, fieldPath, expectedVal, actualField)
+	}
+}
+
+// isUnset reports whether v is a nil pointer, an empty slice or map, or the
+// zero value of its type.
+func isUnset(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Slice, reflect.Map:
+		return v.Len() == 0
+	default:
+		return v.IsZero()
+	}
+}
+
+// reportPresent reports that the field at path should be unset but is not.
+func reportPresent(r reporter, path string, actual reflect.Value) {
+	if t := r.testingT(); t != nil {
+		t.Helper()
+	}
+	if !actual.CanInterface() {
+		r.report(Mismatch{Path: path, Message: fmt.Sprintf("expected to be unset, got %v", actual)})
+		return
+	}
+	r.report(Mismatch{Path: path, Message: "expected to be unset", Expected: nil, Actual: actual.Interface(), compared: true})
+}
+
+// compareNotContains reports every actual element that matches one of the
+// excluded elements.
+func compareNotContains(r reporter, path string, excluded, actual reflect.Value) {
+	if t := r.testingT(); t != nil {
+		t.Helper()
+	}
+	partial := excluded.Type().Elem().Implements(assertableType)
+	for j := 0; j < actual.Len(); j++ {
+		for i := 0; i < excluded.Len(); i++ {
+			var matched bool
+			if partial {
+				matched = matchesPartially(actual.Index(j), excluded.Index(i))
+			} else {
+				matched = tassert.ObjectsAreEqual(excluded.Index(i).Interface(), actual.Index(j).Interface())
+			}
+			if matched {
+				r.report(Mismatch{Path: fmt.Sprintf("%s[%d]", path, j), Message: fmt.Sprintf("matches excluded element %d", i)})
+				break
+			}
+		}
+	}
+}
+
+// compareSlice compares a slice of assertion structs against actual using
+// the given pairing mode.
+func compareSlice(r reporter, path string, mode matchMode, expected, actual reflect.Value) {
+	if t := r.testingT(); t != nil {
+		t.Helper()
+	}
+
+	if (mode == matchExact || mode == matchAnyOrder) && expected.Len() != actual.Len() {
+		r.report(Mismatch{
+			Path:     path,
+			Message:  "slice length mismatch",
+			Expected: expected.Len(),
+			Actual:   actual.Len(),
+			compared: true,
+		})
+	}
+
+	switch mode {
+	case matchExact:
+		// On a length mismatch the common prefix is still compared so that
+		// element-level problems are reported alongside it.
+		for j := 0; j < min(expected.Len(), actual.Len()); j++ {
+			doAssertPartial(r, fmt.Sprintf("%s[%d]", path, j), actual.Index(j), expected.Index(j))
+		}
+
+	case matchContains, matchAnyOrder:
+		pairing := pairElements(expected, actual)
+		for i, j := range pairing {
+			if j >= 0 {
+				continue
+			}
+			r.report(Mismatch{Path: path, Message: unmatchedMessage(expected.Index(i), actual, i, pairing)})
+		}
+
+	case matchByKey:
+		keyField := naturalKeys[expected.Type().Elem()]
+		for i := 0; i < expected.Len(); i++ {
+			key, _ := assertionKey(expected.Index(i), keyField)
+			elemPath := fmt.Sprintf("%s[%s=%v]", path, keyField, key.Interface())
+			found := false
+			for j := 0; j < actual.Len(); j++ {
+				elem := reflect.Indirect(actual.Index(j))
+				if reflect.DeepEqual(elem.FieldByName(keyField).Interface(), key.Interface()) {
+					doAssertPartial(r, elemPath, actual.Index(j), expected.Index(i))
+					found = true
+					break
+				}
+			}
+			if !found {
+				r.report(Mismatch{Path: elemPath, Message: "no element with this key in actual"})
+			}
+		}
+	}
+}
+
+// matchesPartially reports whether actual satisfies every field of assertion.
+func matchesPartially(actual, assertion reflect.Value) bool {
+	r := &collectingReporter{}
+	doAssertPartial(r, "", actual, assertion)
+	return len(r.mismatches) == 0
+}
+
+// pairElements assigns each expected element a distinct matching actual
+// element, maximising the number of pairs. The result holds the actual index
+// for each expected index, or -1 if it could not be paired.
+func pairElements(expected, actual reflect.Value) []int {
+	candidates := make([][]int, expected.Len())
+	for i := range candidates {
+		for j := 0; j < actual.Len(); j++ {
+			if matchesPartially(actual.Index(j), expected.Index(i)) {
+				candidates[i] = append(candidates[i], j)
+			}
+		}
+	}
+
+	// Augmenting paths (Kuhn's algorithm); slices in manifests are small.
+	owner := make([]int, actual.Len())
+	for j := range owner {
+		owner[j] = -1
+	}
+	var augment func(i int, seen []bool) bool
+	augment = func(i int, seen []bool) bool {
+		for _, j := range candidates[i] {
+			if seen[j] {
+				continue
+			}
+			seen[j] = true
+			if owner[j] < 0 || augment(owner[j], seen) {
+				owner[j] = i
+				return true
+			}
+		}
+		return false
+	}
+	for i := range candidates {
+		augment(i, make([]bool, actual.Len()))
+	}
+
+	pairing := make([]int, expected.Len())
+	for i := range pairing {
+		pairing[i] = -1
+	}
+	for j, i := range owner {
+		if i >= 0 {
+			pairing[i] = j
+		}
+	}
+	return pairing
+}
+
+// unmatchedMessage explains why expected element i found no partner, using
+// the unpaired actual element with the fewest mismatches as the closest one.
+func unmatchedMessage(expected, actual reflect.Value, i int, pairing []int) string {
+	paired := map[int]bool{}
+	for _, j := range pairing {
+		if j >= 0 {
+			paired[j] = true
+		}
+	}
+	closest, closestMismatches := -1, []Mismatch(nil)
+	for j := 0; j < actual.Len(); j++ {
+		if paired[j] {
 			continue
 		}
-		require.Equal(t, expectedVal.Interface(), actualField.Interface(),
-			append([]any{fmt.Sprintf("field %q mismatch", fieldInfo.Name)}, msgAndArgs...)...)
+		r := &collectingReporter{}
+		doAssertPartial(r, "", actual.Index(j), expected)
+		if closest < 0 || len(r.mismatches) < len(closestMismatches) {
+			closest, closestMismatches = j, r.mismatches
+		}
+	}
+	if closest < 0 {
+		return fmt.Sprintf("no element matches expected element %d (all %d actual elements are taken)", i, actual.Len())
 	}
+	paths := make([]string, 0, len(closestMismatches))
+	for _, m := range closestMismatches {
+		paths = append(paths, m.Path)
+	}
+	return fmt.Sprintf("no element matches expected element %d; closest is [%d], which differs in %s",
+		i, closest, strings.Join(paths, ", "))
+}
+
+// compareValues reports a mismatch at path unless expected and actual are equal.
+func compareValues(r reporter, path string, expected, actual reflect.Value) {
+	if t := r.testingT(); t != nil {
+		t.Helper()
+	}
+	if !expected.CanInterface() || !actual.CanInterface() {
+		if !reflect.DeepEqual(expected, actual) {
+			r.report(Mismatch{Path: path, Message: fmt.Sprintf("mismatch\nexpected: %v\nactual:   %v", expected, actual)})
+		}
+		return
+	}
+	if !tassert.ObjectsAreEqual(expected.Interface(), actual.Interface()) {
+		r.report(Mismatch{
+			Path:     path,
+			Message:  "mismatch",
+			Expected: expected.Interface(),
+			Actual:   actual.Interface(),
+			compared: true,
+		})
+	}
+}
+
+// sortedMapKeys returns the keys of m ordered by their formatted value so
+// that reports list map entries deterministically.
+func sortedMapKeys(m reflect.Value) []reflect.Value {
+	keys := m.MapKeys()
+	sort.Slice(keys, func(i, j int) bool {
+		return fmt.Sprint(keys[i].Interface()) < fmt.Sprint(keys[j].Interface())
+	})
+	return keys
+}
+
+func joinPath(path, field string) string {
+	if path == "" {
+		return field
+	}
+	return path + "." + field
+}
+
+func rootPath(path string) string {
+	if path == "" {
+		return "."
+	}
+	return path
 }
 `
 
@@ -371,10 +1008,16 @@ func doAssertPartial(t *testing.T, actualVal reflect.Value, assertionVal reflect
 		`import (`,
 		`import (
 	"fmt"
+	"os"
 	"reflect"
+	"sort"
+	"strings"
 	"testing"
 
-	"github.com/stretchr/testify/require"`,
+	"github.com/pmezard/go-difflib/difflib"
+	tassert "github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"sigs.k8s.io/yaml"`,
 		1,
 	)
 
@@ -391,6 +1034,17 @@ func doAssertPartial(t *testing.T, actualVal reflect.Value, assertionVal reflect
 	}
 
 	fmt.Printf("Generated %s (%d bytes)\n", *outFlag, len(formatted))
+
+	if *buildersOutFlag == "" {
+		return
+	}
+	bf := NewFile(*pkgFlag)
+	bf.HeaderComment("Code generated by assertgen. DO NOT EDIT.")
+	emitBuilders(bf, knownStructs, keyed)
+	if err := bf.Save(*buildersOutFlag); err != nil {
+		log.Fatalf("writing builders: %v", err)
+	}
+	fmt.Printf("Generated %s\n", *buildersOutFlag)
 }
 
 // pkgPrefix generates a disambiguation prefix from a package path.
@@ -414,6 +1068,20 @@ func pkgPrefix(pkgPath string) string {
 	return ""
 }
 
+// hasStringField reports whether struc has an exported field with the given
+// name whose type is a string or a named string type.
+func hasStringField(struc *types.Struct, name string) bool {
+	for i := 0; i < struc.NumFields(); i++ {
+		field := struc.Field(i)
+		if field.Name() != name {
+			continue
+		}
+		basic, ok := field.Type().Underlying().(*types.Basic)
+		return ok && basic.Kind() == types.String
+	}
+	return false
+}
+
 // deref strips pointer indirection from a type.
 func deref(t types.Type) types.Type {
 	for {
@@ -0,0 +1,284 @@
+package main
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"go/types"
+	"reflect"
+	"strings"
+
+	"golang.org/x/tools/go/packages"
+)
+
+// definitionPackages maps the prefixes of kubernetes-json-schema definition
+// names to the Go packages that define their types. Prometheus Operator
+// types are named after their API group, the way CRD catalogs name them.
+var definitionPackages = []struct{ prefix, pkgPath string }{
+	{"io.k8s.api.", "k8s.io/api/"},
+	{"io.k8s.apimachinery.pkg.apis.", "k8s.io/apimachinery/pkg/apis/"},
+	{"com.coreos.monitoring.", "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/"},
+}
+
+// k8sPackage maps a definition name such as "io.k8s.api.core.v1.Affinity" to
+// the Go package and type that define it ("k8s.io/api/core/v1", "Affinity").
+func k8sPackage(definition string) (pkgPath, typeName string, err error) {
+	for _, p := range definitionPackages {
+		rest, ok := strings.CutPrefix(definition, p.prefix)
+		if !ok {
+			continue
+		}
+		parts := strings.Split(rest, ".")
+		if len(parts) < 2 {
+			break
+		}
+		return p.pkgPath + strings.Join(parts[:len(parts)-1], "/"), parts[len(parts)-1], nil
+	}
+	return "", "", fmt.Errorf("unsupported Kubernetes definition %q", definition)
+}
+
+// definitionName is the inverse of k8sPackage.
+func definitionName(pkgPath, typeName string) (string, error) {
+	for _, p := range definitionPackages {
+		if rest, ok := strings.CutPrefix(pkgPath, p.pkgPath); ok {
+			return p.prefix + strings.ReplaceAll(rest, "/", ".") + "." + typeName, nil
+		}
+	}
+	return "", fmt.Errorf("no definition name for %s.%s", pkgPath, typeName)
+}
+
+// specialTypes are the types whose JSON form differs from their Go
+// structure, as declared by their OpenAPISchemaType methods.
+var specialTypes = map[string]func() *Schema{
+	"k8s.io/apimachinery/pkg/api/resource.Quantity":   func() *Schema { return &Schema{Type: []string{"string", "number"}} },
+	"k8s.io/apimachinery/pkg/util/intstr.IntOrString": func() *Schema { return &Schema{Type: []string{"string", "integer"}} },
+	"k8s.io/apimachinery/pkg/apis/meta/v1.Time":       func() *Schema { return &Schema{Type: "string", Format: "date-time"} },
+	"k8s.io/apimachinery/pkg/apis/meta/v1.MicroTime":  func() *Schema { return &Schema{Type: "string", Format: "date-time"} },
+	"k8s.io/apimachinery/pkg/apis/meta/v1.Duration":   func() *Schema { return &Schema{Type: "string"} },
+	"k8s.io/apimachinery/pkg/apis/meta/v1.FieldsV1":   func() *Schema { return &Schema{Type: "object"} },
+	"k8s.io/apimachinery/pkg/runtime.RawExtension":    func() *Schema { return &Schema{Type: "object"} },
+}
+
+// k8sDefinitions generates the schema of every referenced Kubernetes
+// definition, and of every definition those refer to in turn, from the
+// vendored Go types. refs maps the definitions to the values paths using
+// them, for error messages.
+//
+// The schemas follow the conventions of the Kubernetes OpenAPI generator:
+// properties are named by their JSON tags, inlined structs contribute their
+// properties, and a property is required unless its JSON tag has omitempty
+// or its comment is marked +optional.
+func k8sDefinitions(refs map[string]string) (map[string]*Schema, error) {
+	if len(refs) == 0 {
+		return nil, nil
+	}
+
+	want := map[string]bool{}
+	for _, definition := range sortedKeys(refs) {
+		pkgPath, _, err := k8sPackage(definition)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", refs[definition], err)
+		}
+		want[pkgPath] = true
+	}
+	paths := make([]string, 0, len(want))
+	for path := range want {
+		paths = append(paths, path)
+	}
+
+	cfg := &packages.Config{
+		Mode: packages.NeedTypes | packages.NeedName | packages.NeedImports | packages.NeedDeps | packages.NeedSyntax,
+	}
+	pkgs, err := packages.Load(cfg, paths...)
+	if err != nil {
+		return nil, fmt.Errorf("loading packages: %w", err)
+	}
+	b := &definitionBuilder{
+		docs: map[token.Pos]*ast.CommentGroup{},
+		defs: map[string]*Schema{},
+	}
+	loaded := map[string]*types.Package{}
+	packages.Visit(pkgs, nil, func(pkg *packages.Package) {
+		if len(pkg.Errors) > 0 || pkg.Types == nil {
+			return
+		}
+		loaded[pkg.PkgPath] = pkg.Types
+		for _, file := range pkg.Syntax {
+			ast.Inspect(file, func(n ast.Node) bool {
+				if field, ok := n.(*ast.Field); ok {
+					for _, name := range field.Names {
+						b.docs[name.Pos()] = field.Doc
+					}
+				}
+				return true
+			})
+		}
+	})
+
+	var missing []string
+	for _, definition := range sortedKeys(refs) {
+		pkgPath, typeName, _ := k8sPackage(definition)
+		var named *types.Named
+		if pkg, ok := loaded[pkgPath]; ok {
+			if obj, ok := pkg.Scope().Lookup(typeName).(*types.TypeName); ok {
+				named, _ = obj.Type().(*types.Named)
+			}
+		}
+		if named == nil {
+			missing = append(missing, fmt.Sprintf("%s (referenced by %s)", definition, refs[definition]))
+			continue
+		}
+		if _, err := b.define(named); err != nil {
+			return nil, fmt.Errorf("%s: %w", definition, err)
+		}
+	}
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("unresolved Kubernetes definitions:\n  %s", strings.Join(missing, "\n  "))
+	}
+	return b.defs, nil
+}
+
+// definitionBuilder converts Go types to definitions.
+type definitionBuilder struct {
+	// docs maps the position of each struct field's name to its comment.
+	docs map[token.Pos]*ast.CommentGroup
+	// defs holds the definitions generated so far, by name.
+	defs map[string]*Schema
+}
+
+// define generates the definition of a struct type, unless it exists, and
+// returns its name.
+func (b *definitionBuilder) define(named *types.Named) (string, error) {
+	obj := named.Obj()
+	name, err := definitionName(obj.Pkg().Path(), obj.Name())
+	if err != nil {
+		return "", err
+	}
+	if _, ok := b.defs[name]; ok {
+		return name, nil
+	}
+	st, ok := named.Underlying().(*types.Struct)
+	if !ok {
+		return "", fmt.Errorf("%s is not a struct", name)
+	}
+	s := &Schema{Type: "object"}
+	// Register the definition before filling it in, so that recursive types
+	// refer to it instead of being expanded forever.
+	b.defs[name] = s
+	if err := b.properties(s, st); err != nil {
+		return "", fmt.Errorf("%s: %w", name, err)
+	}
+	return name, nil
+}
+
+// properties adds the fields of st to s.
+func (b *definitionBuilder) properties(s *Schema, st *types.Struct) error {
+	for i := range st.NumFields() {
+		field := st.Field(i)
+		name, opts, _ := strings.Cut(reflect.StructTag(st.Tag(i)).Get("json"), ",")
+		if name == "-" || !field.Exported() {
+			continue
+		}
+		if field.Embedded() && name == "" {
+			embedded, ok := deref(field.Type()).Underlying().(*types.Struct)
+			if !ok {
+				return fmt.Errorf("embedded field %s is not a struct", field.Name())
+			}
+			if err := b.properties(s, embedded); err != nil {
+				return err
+			}
+			continue
+		}
+		if name == "" {
+			name = field.Name()
+		}
+
+		prop, err := b.schema(field.Type())
+		if err != nil {
+			return fmt.Errorf("field %s: %w", field.Name(), err)
+		}
+		if s.Properties == nil {
+			s.Properties = map[string]*Schema{}
+		}
+		s.Properties[name] = prop
+		if !optional(opts, b.docs[field.Pos()]) {
+			s.Required = append(s.Required, name)
+		}
+	}
+	return nil
+}
+
+// schema returns the schema of a field of type t.
+func (b *definitionBuilder) schema(t types.Type) (*Schema, error) {
+	switch t := t.(type) {
+	case *types.Pointer:
+		return b.schema(t.Elem())
+	case *types.Named:
+		if special, ok := specialTypes[t.Obj().Pkg().Path()+"."+t.Obj().Name()]; ok {
+			return special(), nil
+		}
+		if _, ok := t.Underlying().(*types.Struct); ok {
+			name, err := b.define(t)
+			if err != nil {
+				return nil, err
+			}
+			return &Schema{Ref: "#/$defs/" + name}, nil
+		}
+		return b.schema(t.Underlying())
+	case *types.Basic:
+		switch {
+		case t.Info()&types.IsString != 0:
+			return &Schema{Type: "string"}, nil
+		case t.Info()&types.IsBoolean != 0:
+			return &Schema{Type: "boolean"}, nil
+		case t.Info()&types.IsInteger != 0:
+			return &Schema{Type: "integer"}, nil
+		case t.Info()&types.IsFloat != 0:
+			return &Schema{Type: "number"}, nil
+		}
+	case *types.Slice:
+		if elem, ok := t.Elem().(*types.Basic); ok && elem.Kind() == types.Byte {
+			// encoding/json writes a []byte as a base64 string.
+			return &Schema{Type: "string"}, nil
+		}
+		items, err := b.schema(t.Elem())
+		if err != nil {
+			return nil, err
+		}
+		return &Schema{Type: "array", Items: items}, nil
+	case *types.Map:
+		values, err := b.schema(t.Elem())
+		if err != nil {
+			return nil, err
+		}
+		return &Schema{Type: "object", AdditionalProperties: values}, nil
+	}
+	return nil, fmt.Errorf("unsupported type %s", t)
+}
+
+// optional reports whether a field with the given JSON tag options and
+// comment may be left out.
+func optional(tagOpts string, doc *ast.CommentGroup) bool {
+	for _, opt := range strings.Split(tagOpts, ",") {
+		if opt == "omitempty" || opt == "omitzero" {
+			return true
+		}
+	}
+	if doc == nil {
+		return false
+	}
+	for _, c := range doc.List {
+		switch strings.TrimSpace(strings.TrimPrefix(c.Text, "//")) {
+		case "+optional", "+k8s:optional", "+kubebuilder:validation:Optional":
+			return true
+		}
+	}
+	return false
+}
+
+func deref(t types.Type) types.Type {
+	if p, ok := t.(*types.Pointer); ok {
+		return p.Elem()
+	}
+	return t
+}
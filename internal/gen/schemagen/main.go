@@ -0,0 +1,355 @@
+// Command schemagen generates the chart's values.schema.json from values.yaml.
+//
+// Types are inferred from the default values. helm-docs descriptions
+// ("# -- ...") become schema descriptions, and "# @schema" annotations refine
+// the inferred schema, either on the line of the value or in the comment block
+// above its key:
+//
+//	# @schema $ref: $k8s/_definitions.json#/definitions/io.k8s.api.core.v1.Affinity
+//	# -- (Affinity) Affinity rules for pod scheduling.
+//	affinity: {}
+//	hostnames: []  # @schema item: string
+//
+// Multiple annotations are separated by ";". A $k8s reference names a
+// definition the way kubernetes-json-schema does. It is generated from the
+// type in the vendored k8s.io/api, k8s.io/apimachinery or Prometheus Operator
+// packages into the schema's $defs, together with the definitions it refers
+// to, so that validating values needs no network access. A definition without
+// a type fails generation instead of producing a schema that only breaks at
+// install time.
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Schema is the subset of JSON Schema draft 2020-12 the generator emits.
+// Field order matches the order keys are written in values.schema.json.
+type Schema struct {
+	Schema               string             `json:"$schema,omitempty"`
+	Description          string             `json:"description,omitempty"`
+	Ref                  string             `json:"$ref,omitempty"`
+	Type                 any                `json:"type,omitempty"`
+	Format               string             `json:"format,omitempty"`
+	Enum                 []any              `json:"enum,omitempty"`
+	Items                *Schema            `json:"items,omitempty"`
+	Properties           map[string]*Schema `json:"properties,omitempty"`
+	Required             []string           `json:"required,omitempty"`
+	AdditionalProperties *Schema            `json:"additionalProperties,omitempty"`
+	Defs                 map[string]*Schema `json:"$defs,omitempty"`
+}
+
+const draft2020 = "https://json-schema.org/draft/2020-12/schema"
+
+func main() {
+	valuesFlag := flag.String("values", "", "path to the chart's values.yaml")
+	outFlag := flag.String("out", "", "output file path")
+	flag.Parse()
+
+	if *valuesFlag == "" || *outFlag == "" {
+		log.Fatal("-values and -out flags are required")
+	}
+
+	data, err := os.ReadFile(*valuesFlag)
+	if err != nil {
+		log.Fatalf("reading values: %v", err)
+	}
+
+	g := &generator{}
+	schema, err := g.generate(data)
+	if err != nil {
+		log.Fatalf("generating schema: %v", err)
+	}
+	if schema.Defs, err = k8sDefinitions(g.k8sRefs); err != nil {
+		log.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetIndent("", "    ")
+	if err := enc.Encode(schema); err != nil {
+		log.Fatalf("encoding schema: %v", err)
+	}
+	if err := os.WriteFile(*outFlag, buf.Bytes(), 0644); err != nil {
+		log.Fatalf("writing output: %v", err)
+	}
+	fmt.Printf("Generated %s (%d Kubernetes definitions referenced, %d bundled)\n", *outFlag, len(g.k8sRefs), len(schema.Defs))
+}
+
+type generator struct {
+	// k8sRefs maps each referenced Kubernetes definition name, e.g.
+	// "io.k8s.api.core.v1.Affinity", to the first values path using it.
+	k8sRefs map[string]string
+}
+
+// generate builds the root schema from the values.yaml document.
+func (g *generator) generate(data []byte) (*Schema, error) {
+	var doc yaml.Node
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return nil, err
+	}
+	if doc.Kind != yaml.DocumentNode || len(doc.Content) != 1 || doc.Content[0].Kind != yaml.MappingNode {
+		return nil, fmt.Errorf("values must be a single YAML mapping")
+	}
+	g.k8sRefs = map[string]string{}
+	root, err := g.mapping(doc.Content[0], "")
+	if err != nil {
+		return nil, err
+	}
+	root.Schema = draft2020
+	return root, nil
+}
+
+// mapping builds an object schema with one property per key.
+func (g *generator) mapping(node *yaml.Node, path string) (*Schema, error) {
+	s := &Schema{Type: "object"}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		key, value := node.Content[i], node.Content[i+1]
+		prop, err := g.property(key, value, path+"/"+key.Value)
+		if err != nil {
+			return nil, err
+		}
+		if s.Properties == nil {
+			s.Properties = map[string]*Schema{}
+		}
+		s.Properties[key.Value] = prop
+	}
+	return s, nil
+}
+
+// property builds the schema of a single key, applying its description and
+// annotations to the schema inferred from its value.
+func (g *generator) property(key, value *yaml.Node, path string) (*Schema, error) {
+	s, err := g.infer(value, path)
+	if err != nil {
+		return nil, err
+	}
+	s.Description = description(key.HeadComment)
+
+	ann, err := parseAnnotations(key.HeadComment, key.LineComment, value.LineComment)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	if err := g.apply(s, ann, path); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return s, nil
+}
+
+// infer derives a schema from a default value. Arrays take their item
+// schema from the first element.
+func (g *generator) infer(node *yaml.Node, path string) (*Schema, error) {
+	switch node.Kind {
+	case yaml.MappingNode:
+		return g.mapping(node, path)
+	case yaml.SequenceNode:
+		s := &Schema{Type: "array"}
+		if len(node.Content) > 0 {
+			items, err := g.infer(node.Content[0], path+"[]")
+			if err != nil {
+				return nil, err
+			}
+			s.Items = items
+		}
+		return s, nil
+	case yaml.ScalarNode:
+		switch node.ShortTag() {
+		case "!!str":
+			return &Schema{Type: "string"}, nil
+		case "!!int":
+			return &Schema{Type: "integer"}, nil
+		case "!!float":
+			return &Schema{Type: "number"}, nil
+		case "!!bool":
+			return &Schema{Type: "boolean"}, nil
+		case "!!null":
+			return &Schema{Type: "null"}, nil
+		}
+		return nil, fmt.Errorf("%s: unsupported scalar tag %s", path, node.ShortTag())
+	case yaml.AliasNode:
+		return g.infer(node.Alias, path)
+	}
+	return nil, fmt.Errorf("%s: unsupported YAML node kind %d", path, node.Kind)
+}
+
+// annotation is a single "key: value" pair from a "# @schema" comment.
+type annotation struct {
+	key   string
+	value *yaml.Node
+}
+
+// parseAnnotations collects the "# @schema" annotations from the given
+// comments, in order.
+func parseAnnotations(comments ...string) ([]annotation, error) {
+	var anns []annotation
+	for _, comment := range comments {
+		for _, line := range strings.Split(comment, "\n") {
+			line = strings.TrimSpace(strings.TrimLeft(strings.TrimSpace(line), "#"))
+			rest, ok := strings.CutPrefix(line, "@schema")
+			if !ok {
+				continue
+			}
+			for _, part := range strings.Split(rest, ";") {
+				part = strings.TrimSpace(part)
+				if part == "" {
+					continue
+				}
+				key, raw, ok := strings.Cut(part, ":")
+				if !ok {
+					return nil, fmt.Errorf("malformed @schema annotation %q", part)
+				}
+				var value yaml.Node
+				if err := yaml.Unmarshal([]byte(strings.TrimSpace(raw)), &value); err != nil {
+					return nil, fmt.Errorf("parsing @schema %s: %w", key, err)
+				}
+				if len(value.Content) != 1 {
+					return nil, fmt.Errorf("@schema %s has no value", key)
+				}
+				anns = append(anns, annotation{key: strings.TrimSpace(key), value: value.Content[0]})
+			}
+		}
+	}
+	return anns, nil
+}
+
+// apply refines an inferred schema with annotations.
+func (g *generator) apply(s *Schema, anns []annotation, path string) error {
+	items := func() *Schema {
+		if s.Items == nil {
+			s.Items = &Schema{}
+		}
+		return s.Items
+	}
+	for _, ann := range anns {
+		switch ann.key {
+		case "type":
+			if ann.value.Kind == yaml.SequenceNode {
+				// Use the raw scalars: decoding would turn "null" into "".
+				types := make([]string, 0, len(ann.value.Content))
+				for _, t := range ann.value.Content {
+					types = append(types, t.Value)
+				}
+				s.Type = types
+			} else {
+				s.Type = ann.value.Value
+			}
+		case "enum":
+			if err := ann.value.Decode(&s.Enum); err != nil {
+				return err
+			}
+		case "item":
+			items().Type = ann.value.Value
+		case "itemProperties":
+			props := map[string]*Schema{}
+			if err := decodeJSON(ann.value, &props); err != nil {
+				return err
+			}
+			// Merge into the properties inferred from a default element,
+			// so keys absent from the defaults can be declared.
+			item := items()
+			if item.Properties == nil {
+				item.Properties = map[string]*Schema{}
+			}
+			for name, prop := range props {
+				item.Properties[name] = prop
+			}
+		case "itemRef":
+			items().Ref = g.ref(ann.value.Value, path)
+		case "$ref":
+			s.Ref = g.ref(ann.value.Value, path)
+		case "additionalProperties":
+			additional := &Schema{}
+			if err := decodeJSON(ann.value, additional); err != nil {
+				return err
+			}
+			s.AdditionalProperties = additional
+		default:
+			return fmt.Errorf("unknown @schema annotation %q", ann.key)
+		}
+	}
+	return nil
+}
+
+// decodeJSON decodes an annotation value written as JSON (which is also a
+// YAML flow mapping) into out.
+func decodeJSON(node *yaml.Node, out any) error {
+	var raw any
+	if err := node.Decode(&raw); err != nil {
+		return err
+	}
+	data, err := json.Marshal(raw)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, out)
+}
+
+// k8sDefinitionRef matches a $k8s reference and captures the definition name.
+var k8sDefinitionRef = regexp.MustCompile(`^\$k8s/_definitions\.json#/definitions/([\w.]+)$`)
+
+// ref expands a $k8s reference to the bundled definition and records the
+// definition it names.
+func (g *generator) ref(ref, path string) string {
+	m := k8sDefinitionRef.FindStringSubmatch(ref)
+	if m == nil {
+		return ref
+	}
+	if _, ok := g.k8sRefs[m[1]]; !ok {
+		g.k8sRefs[m[1]] = path
+	}
+	return "#/$defs/" + m[1]
+}
+
+// helmDocsType matches a leading helm-docs type hint such as "(Affinity)".
+// Hints that are not plain identifiers, such as "([]EnvVar)" or
+// "(map[string]string)", are kept as part of the description.
+var helmDocsType = regexp.MustCompile(`^\([\w.]+\)\s*`)
+
+// description returns the helm-docs description from a key's head comment:
+// the last comment block starting with "# --", joined into a single line.
+// Annotation lines are skipped.
+func description(comment string) string {
+	lines := strings.Split(comment, "\n")
+	start := -1
+	for i, line := range lines {
+		if strings.HasPrefix(strings.TrimSpace(line), "# --") {
+			start = i
+		}
+	}
+	if start < 0 {
+		return ""
+	}
+
+	var words []string
+	for i, line := range lines[start:] {
+		text := strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(line), "#"))
+		if i == 0 {
+			text = helmDocsType.ReplaceAllString(strings.TrimSpace(strings.TrimPrefix(text, "--")), "")
+		}
+		if text == "" || strings.HasPrefix(text, "@schema") {
+			continue
+		}
+		words = append(words, strings.Fields(text)...)
+	}
+	return strings.Join(words, " ")
+}
+
+// sortedKeys returns the keys of m in sorted order.
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
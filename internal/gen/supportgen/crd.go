@@ -0,0 +1,223 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"go/types"
+	"os"
+	"strings"
+
+	"golang.org/x/tools/go/packages"
+
+	. "github.com/dave/jennifer/jen"
+)
+
+// crdResource is a CRD-backed resource read through the dynamic client. The
+// chart renders these, but they are not part of kubernetes.Interface.
+type crdResource struct {
+	Group, Version, Resource string // e.g. "gateway.networking.k8s.io", "v1", "httproutes"
+	TypeName                 string // e.g. "HTTPRoute"
+	TypePkgPath              string // e.g. "sigs.k8s.io/gateway-api/apis/v1"
+	Namespaced               bool
+	HasAssertion             bool
+}
+
+// ListName is the plural used in List method names, e.g. "HTTPRoutes" or
+// "BackendTLSPolicies": the Kind's spelling of the resource name.
+func (r crdResource) ListName() string {
+	kind := strings.ToLower(r.TypeName)
+	n := 0
+	for n < len(kind) && n < len(r.Resource) && kind[n] == r.Resource[n] {
+		n++
+	}
+	return r.TypeName[:n] + r.Resource[n:]
+}
+
+// gvrVar is the name of the package-level GroupVersionResource variable.
+func (r crdResource) gvrVar() string {
+	return "gvr" + r.ListName()
+}
+
+// loadCRDs reads the GVR-to-Go-type mappings from path. Each non-empty line
+// that is not a "#" comment has the form
+//
+//	<group>/<version>/<resource> <import path>.<Type> [cluster]
+//
+// where "cluster" marks a cluster-scoped resource. Every type must exist.
+func loadCRDs(path string) ([]crdResource, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var crds []crdResource
+	scanner := bufio.NewScanner(f)
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+		fields := strings.Fields(text)
+		if len(fields) < 2 || len(fields) > 3 || (len(fields) == 3 && fields[2] != "cluster") {
+			return nil, fmt.Errorf("%s:%d: want \"<group>/<version>/<resource> <import path>.<Type> [cluster]\"", path, line)
+		}
+		gvr := strings.Split(fields[0], "/")
+		dot := strings.LastIndex(fields[1], ".")
+		if len(gvr) != 3 || dot < 0 {
+			return nil, fmt.Errorf("%s:%d: malformed mapping %q", path, line, text)
+		}
+		pkgPath := fields[1][:dot]
+		crds = append(crds, crdResource{
+			Group:        gvr[0],
+			Version:      gvr[1],
+			Resource:     gvr[2],
+			TypeName:     fields[1][dot+1:],
+			TypePkgPath:  pkgPath,
+			Namespaced:   len(fields) == 2,
+			HasAssertion: assertgenPkgs[pkgPath],
+		})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return crds, checkCRDTypes(crds)
+}
+
+// checkCRDTypes verifies that every mapped Go type exists.
+func checkCRDTypes(crds []crdResource) error {
+	seen := map[string]bool{}
+	var paths []string
+	for _, c := range crds {
+		if !seen[c.TypePkgPath] {
+			seen[c.TypePkgPath] = true
+			paths = append(paths, c.TypePkgPath)
+		}
+	}
+	pkgs, err := packages.Load(&packages.Config{
+		Mode: packages.NeedTypes | packages.NeedName | packages.NeedImports | packages.NeedDeps,
+	}, paths...)
+	if err != nil {
+		return fmt.Errorf("loading CRD packages: %w", err)
+	}
+	loaded := map[string]*types.Package{}
+	for _, pkg := range pkgs {
+		if len(pkg.Errors) == 0 && pkg.Types != nil {
+			loaded[pkg.PkgPath] = pkg.Types
+		}
+	}
+	for _, c := range crds {
+		pkg, ok := loaded[c.TypePkgPath]
+		if !ok || pkg.Scope().Lookup(c.TypeName) == nil {
+			return fmt.Errorf("%s/%s/%s: type %s.%s not found", c.Group, c.Version, c.Resource, c.TypePkgPath, c.TypeName)
+		}
+	}
+	return nil
+}
+
+// emitCRD emits the GVR variable and the Get, List and WaitFor helpers for a
+// CRD-backed resource. They mirror the methods generated for kubernetes.Interface
+// resources, reading through the dynamic client instead.
+func emitCRD(f *File, r crdResource) {
+	typ := Qual(r.TypePkgPath, r.TypeName)
+	list := "List" + r.ListName()
+
+	f.Var().Id(r.gvrVar()).Op("=").Qual("k8s.io/apimachinery/pkg/runtime/schema", "GroupVersionResource").Values(Dict{
+		Id("Group"):    Lit(r.Group),
+		Id("Version"):  Lit(r.Version),
+		Id("Resource"): Lit(r.Resource),
+	})
+	f.Line()
+
+	// Get<Kind>(t, name) *pkg.Type
+	f.Comment(fmt.Sprintf("Get%s fetches a %s by name, failing the test on error.", r.TypeName, r.TypeName))
+	f.Func().Params(Id("env").Op("*").Id("Env")).Id("Get"+r.TypeName).Params(
+		Id("t").Op("*").Qual("testing", "T"),
+		Id("name").String(),
+	).Op("*").Add(typ.Clone()).Block(
+		Id("t").Dot("Helper").Call(),
+		List(Id("obj"), Id("err")).Op(":=").Id("env").Dot("Get"+r.TypeName+"E").Call(Id("t"), Id("name")),
+		Qual("github.com/stretchr/testify/require", "NoError").Call(
+			Id("t"), Id("err"), Lit(fmt.Sprintf("failed to get %s %%s", r.TypeName)), Id("name"),
+		),
+		Return(Id("obj")),
+	)
+	f.Line()
+
+	// Get<Kind>E(t, name) (*pkg.Type, error)
+	f.Comment(fmt.Sprintf("Get%sE fetches a %s by name, returning the error for non-existence checks.", r.TypeName, r.TypeName))
+	f.Func().Params(Id("env").Op("*").Id("Env")).Id("Get"+r.TypeName+"E").Params(
+		Id("t").Op("*").Qual("testing", "T"),
+		Id("name").String(),
+	).Parens(List(Op("*").Add(typ.Clone()), Error())).Block(
+		Id("t").Dot("Helper").Call(),
+		Return(Id("getDynamic").Types(typ.Clone()).Call(Id("env").Dot("Ctx"), dynamicClient(r), Id("name"))),
+	)
+	f.Line()
+
+	// List<Plural>(t, opts...) []pkg.Type
+	f.Comment(fmt.Sprintf("%s lists the %s matching opts, failing the test on error.", list, r.ListName()))
+	f.Func().Params(Id("env").Op("*").Id("Env")).Id(list).Params(
+		Id("t").Op("*").Qual("testing", "T"),
+		Id("opts").Op("...").Id("ListOption"),
+	).Index().Add(typ.Clone()).Block(
+		Id("t").Dot("Helper").Call(),
+		List(Id("items"), Id("err")).Op(":=").Id("env").Dot(list+"E").Call(Id("t"), Id("opts").Op("...")),
+		Qual("github.com/stretchr/testify/require", "NoError").Call(
+			Id("t"), Id("err"), Lit(fmt.Sprintf("failed to list %s", r.ListName())),
+		),
+		Return(Id("items")),
+	)
+	f.Line()
+
+	// List<Plural>E(t, opts...) ([]pkg.Type, error)
+	f.Comment(fmt.Sprintf("%sE lists the %s matching opts, returning the error.", list, r.ListName()))
+	f.Func().Params(Id("env").Op("*").Id("Env")).Id(list+"E").Params(
+		Id("t").Op("*").Qual("testing", "T"),
+		Id("opts").Op("...").Id("ListOption"),
+	).Parens(List(Index().Add(typ.Clone()), Error())).Block(
+		Id("t").Dot("Helper").Call(),
+		Return(Id("listDynamic").Types(typ.Clone()).Call(Id("env").Dot("Ctx"), dynamicClient(r), Id("listOptions").Call(Id("opts")))),
+	)
+	f.Line()
+
+	// WaitFor<Kind>(t, name, ready, timeout) *pkg.Type
+	f.Comment(fmt.Sprintf("WaitFor%s watches the %s called name until ready reports true", r.TypeName, r.TypeName))
+	f.Comment("for it and returns it. A nil ready waits for it to exist. The test fails")
+	f.Comment("after timeout.")
+	f.Func().Params(Id("env").Op("*").Id("Env")).Id("WaitFor"+r.TypeName).Params(
+		Id("t").Op("*").Qual("testing", "T"),
+		Id("name").String(),
+		Id("ready").Func().Params(Op("*").Add(typ.Clone())).Bool(),
+		Id("timeout").Qual("time", "Duration"),
+	).Op("*").Add(typ.Clone()).Block(
+		Id("t").Dot("Helper").Call(),
+		Return(Id("waitForDynamic").Call(
+			Id("t"), Id("env"), Lit(r.TypeName), Id("name"), dynamicClient(r), Id("ready"), Id("timeout"),
+		)),
+	)
+	f.Line()
+}
+
+// emitCRDListKinds emits crdListKinds, which maps every CRD-backed resource to
+// its list kind. The fake dynamic client cannot list a resource whose list
+// kind it would have to guess from an irregular plural, such as "gateways".
+func emitCRDListKinds(f *File, crds []crdResource) {
+	entries := Dict{}
+	for _, c := range crds {
+		entries[Id(c.gvrVar())] = Lit(c.TypeName + "List")
+	}
+	f.Comment("crdListKinds maps the CRD-backed resources to their list kinds, for the")
+	f.Comment("fake dynamic client of the rendered backend.")
+	f.Var().Id("crdListKinds").Op("=").Map(Qual("k8s.io/apimachinery/pkg/runtime/schema", "GroupVersionResource")).String().Values(entries)
+	f.Line()
+}
+
+// dynamicClient generates: env.DynamicClient.Resource(<gvr>)[.Namespace(namespace)]
+func dynamicClient(r crdResource) *Statement {
+	chain := Id("env").Dot("DynamicClient").Dot("Resource").Call(Id(r.gvrVar()))
+	if r.Namespaced {
+		chain = chain.Dot("Namespace").Call(Id("env").Dot("Kube").Dot("Namespace"))
+	}
+	return chain
+}
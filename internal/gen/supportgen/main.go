@@ -15,10 +15,12 @@ import (
 	. "github.com/dave/jennifer/jen"
 )
 
-// assertgenPkgs is the set of K8s API packages scanned by assertgen.
+// assertgenPkgs is the set of API packages scanned by assertgen.
 // Resources whose types come from these packages have assertion structs
 // and are included in the AssertPartial type switch.
 var assertgenPkgs = map[string]bool{
+	"github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1": true,
+	"sigs.k8s.io/gateway-api/apis/v1": true,
 	"k8s.io/api/apps/v1":        true,
 	"k8s.io/api/autoscaling/v2": true,
 	"k8s.io/api/batch/v1":       true,
@@ -37,24 +39,43 @@ type resource struct {
 	Plural       string // e.g. "Deployments" (the method name on the group interface)
 	Namespaced   bool
 	HasAssertion bool   // whether an assertion struct exists for this type
+	ListTypeName string // e.g. "DeploymentList", empty if the resource cannot be listed and watched
 }
 
 func (r resource) AssertName() string {
 	return r.Name + "Assertion"
 }
 
+// ListName is the plural used in List method names, e.g. "Deployments". It
+// carries the same GroupMethod prefix as Name when Name was disambiguated.
+func (r resource) ListName() string {
+	if r.Name != r.TypeName {
+		return r.GroupMethod + r.Plural
+	}
+	return r.Plural
+}
+
 func main() {
 	outFlag := flag.String("out", "", "output file path")
 	pkgFlag := flag.String("package", "support", "package name for the generated file")
+	crdsFlag := flag.String("crds", "", "file mapping CRD-backed GVRs to Go types (see loadCRDs)")
 	flag.Parse()
 
 	if *outFlag == "" {
 		log.Fatal("-out flag is required")
 	}
 
+	var crds []crdResource
+	if *crdsFlag != "" {
+		var err error
+		if crds, err = loadCRDs(*crdsFlag); err != nil {
+			log.Fatalf("loading CRD mappings: %v", err)
+		}
+	}
+
 	// Load the clientset package to introspect kubernetes.Interface
 	cfg := &packages.Config{
-		Mode: packages.NeedTypes | packages.NeedName,
+		Mode: packages.NeedTypes | packages.NeedName | packages.NeedSyntax | packages.NeedTypesInfo | packages.NeedImports | packages.NeedDeps,
 	}
 	pkgs, err := packages.Load(cfg, "k8s.io/client-go/kubernetes")
 	if err != nil {
@@ -133,14 +154,18 @@ func main() {
 			// Get the resource interface return type
 			resRetType := fnSig.Results().At(0).Type()
 
-			// Find the Get() method on the resource interface
+			// Find the Get(), List() and Watch() methods on the resource interface
 			resMset := types.NewMethodSet(resRetType)
-			var getMethod *types.Func
+			var getMethod, listMethod, watchMethod *types.Func
 			for k := 0; k < resMset.Len(); k++ {
 				m := resMset.At(k)
-				if m.Obj().Name() == "Get" {
+				switch m.Obj().Name() {
+				case "Get":
 					getMethod = m.Obj().(*types.Func)
-					break
+				case "List":
+					listMethod = m.Obj().(*types.Func)
+				case "Watch":
+					watchMethod = m.Obj().(*types.Func)
 				}
 			}
 			if getMethod == nil {
@@ -177,6 +202,11 @@ func main() {
 				!strings.HasSuffix(typeName, "List") &&
 				!strings.HasSuffix(typeName, "Status")
 
+			var listTypeName string
+			if watchMethod != nil {
+				listTypeName = listItemsOf(listMethod, named)
+			}
+
 			resources = append(resources, resource{
 				Name:         typeName,
 				TypeName:     typeName,
@@ -185,6 +215,7 @@ func main() {
 				Plural:       fn.Name(),
 				Namespaced:   namespaced,
 				HasAssertion: hasAssertion,
+				ListTypeName: listTypeName,
 			})
 		}
 	}
@@ -239,6 +270,33 @@ func main() {
 		return resources[i].Name < resources[j].Name
 	})
 
+	// List method names are derived from the plural rather than Name, so
+	// check that they are unique too.
+	listNames := make(map[string]string)
+	for _, r := range resources {
+		if r.ListTypeName == "" {
+			continue
+		}
+		if other, ok := listNames[r.ListName()]; ok {
+			log.Fatalf("List%s is generated for both %s and %s", r.ListName(), other, r.Name)
+		}
+		listNames[r.ListName()] = r.Name
+	}
+	names := make(map[string]bool)
+	for _, r := range resources {
+		names[r.Name] = true
+	}
+	for _, c := range crds {
+		if names[c.TypeName] {
+			log.Fatalf("CRD %s/%s collides with a kubernetes.Interface resource", c.Group, c.TypeName)
+		}
+		if other, ok := listNames[c.ListName()]; ok {
+			log.Fatalf("List%s is generated for both %s and %s", c.ListName(), other, c.TypeName)
+		}
+		names[c.TypeName] = true
+		listNames[c.ListName()] = c.TypeName
+	}
+
 	// Build the jennifer file
 	f := NewFile(*pkgFlag)
 	f.HeaderComment("Code generated by supportgen. DO NOT EDIT.")
@@ -273,58 +331,126 @@ func main() {
 			Return(getClientExpr(r)),
 		)
 		f.Line()
+
+		if r.ListTypeName != "" {
+			emitListAndWait(f, r)
+		}
+	}
+
+	// Emit dynamic-client helpers for each CRD-backed resource
+	for _, c := range crds {
+		emitCRD(f, c)
+	}
+	if len(crds) > 0 {
+		emitCRDListKinds(f, crds)
 	}
 
-	// Collect resources with assertion types for the AssertPartial switch
+	// Collect resources with assertion types for the fetch and AssertNone switches
 	var assertResources []resource
 	for _, r := range resources {
 		if r.HasAssertion {
 			assertResources = append(assertResources, r)
 		}
 	}
+	for _, c := range crds {
+		if c.HasAssertion {
+			assertResources = append(assertResources, resource{Name: c.TypeName, TypeName: c.TypeName, TypePkgPath: c.TypePkgPath})
+		}
+	}
 
-	// Emit AssertPartial method
-	var switchCases []Code
+	// Emit fetch method — maps an assertion type to the resource it describes
+	var fetchCases []Code
 	for _, r := range assertResources {
-		switchCases = append(switchCases,
+		fetchCases = append(fetchCases,
 			Case(
 				Qual("github.com/zitadel/zitadel-charts/test/assert", r.AssertName()),
+				Op("*").Qual("github.com/zitadel/zitadel-charts/test/assert", r.AssertName()),
 			).Block(
-				Qual("github.com/zitadel/zitadel-charts/test/assert", "AssertPartial").Call(
-					Id("t"),
-					Id("env").Dot("Get"+r.Name).Call(Id("t"), Id("name")),
-					Id("a"),
-					Id("name"),
-				),
+				Return(Id("env").Dot("Get"+r.Name).Call(Id("t"), Id("name"))),
 			),
+		)
+	}
+	fetchCases = append(fetchCases,
+		Default().Block(
+			Id("t").Dot("Fatalf").Call(Lit("env.AssertPartial: unsupported assertion type %T"), Id("assertion")),
+			Return(Nil()),
+		),
+	)
+
+	f.Comment("fetch fetches the K8s resource implied by the assertion type. The")
+	f.Comment("resource type is inferred from the concrete assertion struct via a type")
+	f.Comment("switch.")
+	f.Func().Params(Id("env").Op("*").Id("Env")).Id("fetch").Params(
+		Id("t").Op("*").Qual("testing", "T"),
+		Id("name").String(),
+		Id("assertion").Qual("github.com/zitadel/zitadel-charts/test/assert", "Assertable"),
+	).Any().Block(
+		Id("t").Dot("Helper").Call(),
+		Switch(Id("assertion").Assert(Type())).Block(fetchCases...),
+	)
+	f.Line()
+
+	// Emit fetchE method — the non-fatal fetch used by the Eventually assertions
+	var fetchECases []Code
+	for _, r := range assertResources {
+		fetchECases = append(fetchECases,
 			Case(
+				Qual("github.com/zitadel/zitadel-charts/test/assert", r.AssertName()),
 				Op("*").Qual("github.com/zitadel/zitadel-charts/test/assert", r.AssertName()),
 			).Block(
-				Qual("github.com/zitadel/zitadel-charts/test/assert", "AssertPartial").Call(
-					Id("t"),
-					Id("env").Dot("Get"+r.Name).Call(Id("t"), Id("name")),
-					Op("*").Id("a"),
-					Id("name"),
-				),
+				Return(Id("env").Dot("Get"+r.Name+"E").Call(Id("t"), Id("name"))),
 			),
 		)
 	}
-	switchCases = append(switchCases,
+	fetchECases = append(fetchECases,
 		Default().Block(
-			Id("env").Dot("assertPartialFallback").Call(Id("t"), Id("name"), Id("assertion")),
+			Id("t").Dot("Fatalf").Call(Lit("env.AssertPartialEventually: unsupported assertion type %T"), Id("assertion")),
+			Return(Nil(), Nil()),
 		),
 	)
 
+	f.Comment("fetchE is like fetch but returns the error instead of failing the test,")
+	f.Comment("so that callers can retry.")
+	f.Func().Params(Id("env").Op("*").Id("Env")).Id("fetchE").Params(
+		Id("t").Op("*").Qual("testing", "T"),
+		Id("name").String(),
+		Id("assertion").Qual("github.com/zitadel/zitadel-charts/test/assert", "Assertable"),
+	).Parens(List(Any(), Error())).Block(
+		Id("t").Dot("Helper").Call(),
+		Switch(Id("assertion").Assert(Type())).Block(fetchECases...),
+	)
+	f.Line()
+
+	// Emit AssertPartial and AssertPartialAll methods
 	f.Comment("AssertPartial fetches the K8s resource implied by the assertion type and")
-	f.Comment("performs a partial assertion. The resource type is inferred from the")
-	f.Comment("concrete assertion struct via a type switch.")
+	f.Comment("performs a partial assertion, failing on the first mismatching field.")
 	f.Func().Params(Id("env").Op("*").Id("Env")).Id("AssertPartial").Params(
 		Id("t").Op("*").Qual("testing", "T"),
 		Id("name").String(),
 		Id("assertion").Qual("github.com/zitadel/zitadel-charts/test/assert", "Assertable"),
 	).Block(
 		Id("t").Dot("Helper").Call(),
-		Switch(Id("a").Op(":=").Id("assertion").Assert(Type())).Block(switchCases...),
+		Qual("github.com/zitadel/zitadel-charts/test/assert", "AssertPartial").Call(
+			Id("t"),
+			Id("env").Dot("fetch").Call(Id("t"), Id("name"), Id("assertion")),
+			Id("assertion"),
+		),
+	)
+	f.Line()
+
+	f.Comment("AssertPartialAll is like AssertPartial but reports every mismatching")
+	f.Comment("field, with its full path, in a single failure.")
+	f.Func().Params(Id("env").Op("*").Id("Env")).Id("AssertPartialAll").Params(
+		Id("t").Op("*").Qual("testing", "T"),
+		Id("name").String(),
+		Id("assertion").Qual("github.com/zitadel/zitadel-charts/test/assert", "Assertable"),
+	).Block(
+		Id("t").Dot("Helper").Call(),
+		Qual("github.com/zitadel/zitadel-charts/test/assert", "AssertPartialAll").Call(
+			Id("t"),
+			Id("env").Dot("fetch").Call(Id("t"), Id("name"), Id("assertion")),
+			Id("assertion"),
+		),
 	)
 	f.Line()
 
@@ -349,7 +475,7 @@ func main() {
 	}
 	noneSwitchCases = append(noneSwitchCases,
 		Default().Block(
-			Id("env").Dot("assertNoneFallback").Call(Id("t"), Id("name"), Id("assertion")),
+			Id("t").Dot("Fatalf").Call(Lit("env.AssertNone: unsupported assertion type %T"), Id("assertion")),
 		),
 	)
 
@@ -382,8 +508,8 @@ func main() {
 		log.Fatalf("writing output: %v", err)
 	}
 
-	fmt.Printf("Generated %s with %d resources (%d with assertions, %d bytes)\n",
-		*outFlag, len(resources), len(assertResources), len(formatted))
+	fmt.Printf("Generated %s with %d resources and %d CRDs (%d with assertions, %d bytes)\n",
+		*outFlag, len(resources), len(crds), len(assertResources), len(formatted))
 }
 
 // resourcePriority computes a priority score for deduplication.
@@ -428,6 +554,106 @@ func extractVersion(pkgPath string) int {
 	return v
 }
 
+// listItemsOf returns the name of the list type the List method returns,
+// e.g. "DeploymentList", if its Items are elements of the resource type.
+func listItemsOf(listMethod *types.Func, resource *types.Named) string {
+	if listMethod == nil {
+		return ""
+	}
+	sig := listMethod.Type().(*types.Signature)
+	if sig.Results().Len() != 2 {
+		return ""
+	}
+	ptr, ok := sig.Results().At(0).Type().(*types.Pointer)
+	if !ok {
+		return ""
+	}
+	named, ok := ptr.Elem().(*types.Named)
+	if !ok {
+		return ""
+	}
+	st, ok := named.Underlying().(*types.Struct)
+	if !ok {
+		return ""
+	}
+	for i := 0; i < st.NumFields(); i++ {
+		if st.Field(i).Name() != "Items" {
+			continue
+		}
+		slice, ok := st.Field(i).Type().(*types.Slice)
+		if ok && types.Identical(slice.Elem(), resource) {
+			return named.Obj().Name()
+		}
+	}
+	return ""
+}
+
+// emitListAndWait emits List<Plural>, List<Plural>E and WaitFor<Name> for a
+// resource that can be listed and watched.
+func emitListAndWait(f *File, r resource) {
+	list := "List" + r.ListName()
+
+	// List<Plural>(t, opts...) []pkg.Type
+	f.Comment(fmt.Sprintf("%s lists the %s matching opts, failing the test on error.", list, r.ListName()))
+	f.Func().Params(Id("env").Op("*").Id("Env")).Id(list).Params(
+		Id("t").Op("*").Qual("testing", "T"),
+		Id("opts").Op("...").Id("ListOption"),
+	).Index().Qual(r.TypePkgPath, r.TypeName).Block(
+		Id("t").Dot("Helper").Call(),
+		List(Id("items"), Id("err")).Op(":=").Id("env").Dot(list+"E").Call(Id("t"), Id("opts").Op("...")),
+		Qual("github.com/stretchr/testify/require", "NoError").Call(
+			Id("t"), Id("err"), Lit(fmt.Sprintf("failed to list %s", r.ListName())),
+		),
+		Return(Id("items")),
+	)
+	f.Line()
+
+	// List<Plural>E(t, opts...) ([]pkg.Type, error)
+	f.Comment(fmt.Sprintf("%sE lists the %s matching opts, returning the error.", list, r.ListName()))
+	f.Func().Params(Id("env").Op("*").Id("Env")).Id(list+"E").Params(
+		Id("t").Op("*").Qual("testing", "T"),
+		Id("opts").Op("...").Id("ListOption"),
+	).Parens(List(
+		Index().Qual(r.TypePkgPath, r.TypeName),
+		Error(),
+	)).Block(
+		Id("t").Dot("Helper").Call(),
+		List(Id("list"), Id("err")).Op(":=").Add(resourceClient(r)).Dot("List").Call(
+			Id("env").Dot("Ctx"),
+			Id("listOptions").Call(Id("opts")),
+		),
+		If(Err().Op("!=").Nil()).Block(Return(Nil(), Err())),
+		Return(Id("list").Dot("Items"), Nil()),
+	)
+	f.Line()
+
+	// WaitFor<Name>(t, name, ready, timeout) *pkg.Type
+	f.Comment(fmt.Sprintf("WaitFor%s watches the %s called name until ready reports true", r.Name, r.Name))
+	f.Comment("for it and returns it. A nil ready waits for it to exist. The test fails")
+	f.Comment("after timeout.")
+	f.Func().Params(Id("env").Op("*").Id("Env")).Id("WaitFor"+r.Name).Params(
+		Id("t").Op("*").Qual("testing", "T"),
+		Id("name").String(),
+		Id("ready").Func().Params(Op("*").Qual(r.TypePkgPath, r.TypeName)).Bool(),
+		Id("timeout").Qual("time", "Duration"),
+	).Op("*").Qual(r.TypePkgPath, r.TypeName).Block(
+		Id("t").Dot("Helper").Call(),
+		Return(Id("waitFor").Call(
+			Id("t"), Id("env"), Lit(r.TypeName), Id("name"), resourceClient(r), Id("ready"), Id("timeout"),
+		)),
+	)
+	f.Line()
+}
+
+// resourceClient generates: env.Client.<Group>().<Plural>([namespace])
+func resourceClient(r resource) *Statement {
+	chain := Id("env").Dot("Client").Dot(r.GroupMethod).Call()
+	if r.Namespaced {
+		return chain.Dot(r.Plural).Call(Id("env").Dot("Kube").Dot("Namespace"))
+	}
+	return chain.Dot(r.Plural).Call()
+}
+
 // getClientCall generates: obj, err := env.Client.<Group>().<Plural>([namespace]).Get(ctx, name, opts)
 func getClientCall(r resource, _ bool) *Statement {
 	return List(Id("obj"), Id("err")).Op(":=").Add(getClientExpr(r))
@@ -2,10 +2,7 @@ package acceptance_test
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
-	"net/url"
-	"strings"
 	"testing"
 	"time"
 
@@ -16,7 +13,8 @@ import (
 	"google.golang.org/protobuf/types/known/emptypb"
 
 	grpchelper "github.com/zitadel/zitadel-charts/test/acceptance/helpers/grpc"
-	httphelper "github.com/zitadel/zitadel-charts/test/acceptance/helpers/http"
+	"github.com/zitadel/zitadel-charts/test/internal/api"
+	httphelper "github.com/zitadel/zitadel-charts/test/internal/http"
 )
 
 // CheckAuthenticatedAPI verifies that both HTTP and gRPC authenticated API
@@ -40,25 +38,10 @@ import (
 func CheckAuthenticatedAPI(ctx context.Context, t *testing.T, k *k8s.KubectlOptions, apiBaseURL, secretName, secretKey string) {
 	t.Helper()
 
-	secret := k8s.GetSecret(t, k, secretName)
-	key := secret.Data[secretKey]
-	require.NotNil(t, key, "key %s in secret %s is nil", secretKey, secretName)
-
-	jwta, err := oidc.NewJWTProfileAssertionFromFileData(key, []string{apiBaseURL})
-	require.NoError(t, err)
-
-	jwt, err := oidc.GenerateJWTProfileToken(jwta)
-	require.NoError(t, err)
-
 	authCtx, cancel := context.WithTimeout(ctx, 5*time.Minute)
 	defer cancel()
 
-	var token string
-	require.EventuallyWithT(t, func(collect *assert.CollectT) {
-		var tokenErr error
-		token, tokenErr = getAccessToken(authCtx, jwt, apiBaseURL)
-		assert.NoError(collect, tokenErr)
-	}, 1*time.Minute, time.Second, "getting token failed for a minute")
+	token := MachineToken(authCtx, t, k, apiBaseURL, secretName, secretKey)
 
 	require.EventuallyWithT(t, func(collect *assert.CollectT) {
 		httpErr := callAuthenticatedHTTP(authCtx, token, apiBaseURL)
@@ -70,29 +53,58 @@ func CheckAuthenticatedAPI(ctx context.Context, t *testing.T, k *k8s.KubectlOpti
 	}, 1*time.Minute, time.Second, "calling authenticated endpoints failed for a minute")
 }
 
-func getAccessToken(ctx context.Context, jwt, apiBaseURL string) (string, error) {
-	form := url.Values{}
-	form.Add("grant_type", string(oidc.GrantTypeBearer))
-	form.Add("scope", fmt.Sprintf("%s %s %s urn:zitadel:iam:org:project:id:zitadel:aud", oidc.ScopeOpenID, oidc.ScopeProfile, oidc.ScopeEmail))
-	form.Add("assertion", jwt)
+// NewAPIClient returns a client of the ZITADEL APIs that authenticates with
+// the service account key stored under secretKey in the Kubernetes secret
+// secretName. extraScopes extend the access tokens it requests to other
+// resources, such as "urn:zitadel:iam:org:project:id:<id>:aud".
+func NewAPIClient(t *testing.T, k *k8s.KubectlOptions, apiBaseURL, secretName, secretKey string, extraScopes ...string) *api.Client {
+	t.Helper()
 
-	status, body, err := httphelper.Post(ctx, fmt.Sprintf("%s/oauth/v2/token", apiBaseURL),
-		map[string]string{"Content-Type": "application/x-www-form-urlencoded"},
-		strings.NewReader(form.Encode()))
-	if err != nil {
-		return "", err
-	}
-	if status != 200 {
-		return "", fmt.Errorf("expected token response 200, but got %d", status)
-	}
+	client, err := api.New(apiBaseURL, machineKey(t, k, secretName, secretKey), extraScopes...)
+	require.NoError(t, err)
+	return client
+}
 
-	var tokenResp struct {
-		AccessToken string `json:"access_token"`
-	}
-	if err = json.Unmarshal(body, &tokenResp); err != nil {
-		return "", err
-	}
-	return tokenResp.AccessToken, nil
+// MachineToken exchanges the service account key stored under secretKey in
+// the Kubernetes secret secretName for an access token with access to the
+// ZITADEL APIs and, through extraScopes, to other resources such as
+// "urn:zitadel:iam:org:project:id:<id>:aud". It retries for a minute while
+// the token endpoint starts up.
+func MachineToken(ctx context.Context, t *testing.T, k *k8s.KubectlOptions, apiBaseURL, secretName, secretKey string, extraScopes ...string) string {
+	t.Helper()
+
+	client := NewAPIClient(t, k, apiBaseURL, secretName, secretKey, extraScopes...)
+
+	var token string
+	require.EventuallyWithT(t, func(collect *assert.CollectT) {
+		var tokenErr error
+		token, tokenErr = client.Token(ctx)
+		assert.NoError(collect, tokenErr)
+	}, 1*time.Minute, time.Second, "getting token failed for a minute")
+	return token
+}
+
+// MachineAssertion returns a JWT signed with the service account key stored
+// under secretKey in the Kubernetes secret secretName, which authenticates the
+// machine user at the OAuth endpoints of apiBaseURL.
+func MachineAssertion(t *testing.T, k *k8s.KubectlOptions, apiBaseURL, secretName, secretKey string) string {
+	t.Helper()
+
+	jwta, err := oidc.NewJWTProfileAssertionFromFileData(machineKey(t, k, secretName, secretKey), []string{apiBaseURL})
+	require.NoError(t, err)
+
+	jwt, err := oidc.GenerateJWTProfileToken(jwta)
+	require.NoError(t, err)
+	return jwt
+}
+
+func machineKey(t *testing.T, k *k8s.KubectlOptions, secretName, secretKey string) []byte {
+	t.Helper()
+
+	secret := k8s.GetSecret(t, k, secretName)
+	key := secret.Data[secretKey]
+	require.NotNil(t, key, "key %s in secret %s is nil", secretKey, secretName)
+	return key
 }
 
 func callAuthenticatedHTTP(ctx context.Context, token, apiBaseURL string) error {
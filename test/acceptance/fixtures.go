@@ -0,0 +1,78 @@
+package acceptance_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gruntwork-io/terratest/modules/k8s"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel-charts/test/internal/api"
+)
+
+// CheckAPIFixtures builds the fixtures a real tenant starts with through the
+// typed API client, proving that the admin API (v1), the management API (v1)
+// and the organization and user services (v2) accept writes and serve their
+// reads from the projections.
+//
+// Using the machine user's key from the Kubernetes secret, the function
+// looks up the default organization, creates a new organization owned by the
+// machine user and, inside it, a project with an API application and a
+// human user. It then reads the project and the user back and deletes the
+// user again.
+func CheckAPIFixtures(ctx context.Context, t *testing.T, k *k8s.KubectlOptions, apiBaseURL, secretName, secretKey string) {
+	t.Helper()
+
+	fixtureCtx, cancel := context.WithTimeout(ctx, 5*time.Minute)
+	defer cancel()
+
+	client := NewAPIClient(t, k, apiBaseURL, secretName, secretKey)
+
+	defaultOrg := retryCall(t, "getting the default organization", func() (*api.Org, error) {
+		return client.GetDefaultOrg(fixtureCtx)
+	})
+	require.NotEmpty(t, defaultOrg.ID, "the instance has no default organization")
+
+	orgName := "fixtures-" + randomString(t)[:8]
+	orgID := createOnce(t, "creating organization "+orgName, func() (string, error) {
+		return client.AddOrganization(fixtureCtx, orgName, client.UserID())
+	}, func() (string, error) {
+		orgs, err := client.ListOrganizations(fixtureCtx, orgName)
+		if err != nil || len(orgs) == 0 {
+			return "", err
+		}
+		return orgs[0].ID, nil
+	})
+	require.NotEqual(t, defaultOrg.ID, orgID, "a new organization should have its own ID")
+	orgClient := client.InOrg(orgID)
+
+	projectID := SeedProject(fixtureCtx, t, orgClient, "fixtures")
+	CreateAPIApp(fixtureCtx, t, orgClient, projectID, "fixtures")
+	CheckProject(fixtureCtx, t, orgClient, projectID, "fixtures")
+
+	username := "fixtures-user"
+	userID := createOnce(t, "creating user "+username, func() (string, error) {
+		return client.AddHumanUser(fixtureCtx, &api.AddHumanUserRequest{
+			OrganizationID: orgID,
+			Username:       username,
+			GivenName:      "Acceptance",
+			FamilyName:     "Test",
+			Email:          username + "@example.com",
+			Password:       humanUserPassword,
+		})
+	}, func() (string, error) {
+		return findUser(fixtureCtx, client, username)
+	})
+
+	require.EventuallyWithT(t, func(collect *assert.CollectT) {
+		user, err := client.GetUserByID(fixtureCtx, userID)
+		if assert.NoError(collect, err) && assert.NotNil(collect, user) {
+			assert.Equal(collect, username, user.Username)
+			assert.Equal(collect, "USER_STATE_ACTIVE", user.State)
+		}
+	}, 1*time.Minute, time.Second, "user %s is not readable", userID)
+
+	require.NoError(t, client.DeleteUser(fixtureCtx, userID))
+}
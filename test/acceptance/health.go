@@ -9,14 +9,13 @@ import (
 	"time"
 
 	"github.com/gruntwork-io/terratest/modules/k8s"
-	"github.com/stretchr/testify/assert"
-	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/status"
 
 	grpchelper "github.com/zitadel/zitadel-charts/test/acceptance/helpers/grpc"
-	httphelper "github.com/zitadel/zitadel-charts/test/acceptance/helpers/http"
+	httphelper "github.com/zitadel/zitadel-charts/test/internal/http"
+	"github.com/zitadel/zitadel-charts/test/support"
 )
 
 // CheckAccessibility performs comprehensive endpoint accessibility verification
@@ -33,18 +32,20 @@ import (
 //   - environment.json: Console config contains correct API and issuer URLs
 //   - grpc healthz: gRPC management API responds to health check requests
 //
-// Each check uses eventual consistency with a 1-minute retry window and 1-second
-// intervals to account for ingress routing propagation and pod startup delays.
+// Each check is retried by support.Env.Eventually for up to a minute at
+// 1-second intervals to account for ingress routing propagation and pod
+// startup delays.
 // This is particularly important in Kind clusters where Traefik may take time
 // to recognize new ingress routes.
 //
 // The environment.json check is critical as it verifies that the ZITADEL
 // console will be able to communicate with the backend APIs. Misconfigured
 // external domains are a common source of deployment issues.
-func CheckAccessibility(ctx context.Context, t *testing.T, _ *k8s.KubectlOptions, apiBaseURL string) {
+func CheckAccessibility(ctx context.Context, t *testing.T, k *k8s.KubectlOptions, apiBaseURL string) {
 	t.Helper()
 	ctx, cancel := context.WithTimeout(ctx, 2*time.Minute)
 	defer cancel()
+	env := support.NewEnv(ctx, t, k)
 
 	checks := []struct {
 		name string
@@ -75,7 +76,7 @@ func CheckAccessibility(ctx context.Context, t *testing.T, _ *k8s.KubectlOptions
 
 	for _, check := range checks {
 		t.Run(check.name, func(t *testing.T) {
-			awaitCheck(ctx, t, 1*time.Minute, check.fn, "check %s failed", check.name)
+			env.Eventually(t, check.fn, 1*time.Minute, "check %s failed", check.name)
 		})
 	}
 }
@@ -193,12 +194,3 @@ func containsAny(body string, targets []string) bool {
 	}
 	return false
 }
-
-func awaitCheck(ctx context.Context, t *testing.T, waitFor time.Duration, cb func(ctx context.Context) error, msg string, args ...any) {
-	t.Helper()
-	require.EventuallyWithTf(t, func(collect *assert.CollectT) {
-		if !assert.NoError(collect, cb(ctx)) {
-			t.Logf("retrying in a second")
-		}
-	}, waitFor, time.Second, msg, args...)
-}
@@ -4,8 +4,11 @@ import (
 	"context"
 	"crypto/tls"
 	"fmt"
+	"net/http"
 	"net/url"
+	"strings"
 
+	"connectrpc.com/connect"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
@@ -48,3 +51,69 @@ func WithBearerToken(ctx context.Context, token string) context.Context {
 func Invoke(ctx context.Context, conn *grpc.ClientConn, method string, req, reply interface{}) error {
 	return conn.Invoke(ctx, method, req, reply)
 }
+
+// Protocol is an RPC protocol ZITADEL serves its gRPC services with.
+type Protocol string
+
+const (
+	// ProtocolGRPC is native gRPC over HTTP/2.
+	ProtocolGRPC Protocol = "grpc"
+	// ProtocolGRPCWeb is gRPC-web, which works over HTTP/1.1 and is used by
+	// browsers.
+	ProtocolGRPCWeb Protocol = "grpc-web"
+	// ProtocolConnect is the Connect protocol, which sends unary calls as
+	// plain HTTP POST requests.
+	ProtocolConnect Protocol = "connect"
+)
+
+// Protocols lists every Protocol.
+var Protocols = []Protocol{ProtocolGRPC, ProtocolGRPCWeb, ProtocolConnect}
+
+var insecureHTTPClient = &http.Client{
+	Transport: &http.Transport{
+		TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
+	},
+}
+
+// Call calls the unary method, e.g. "/zitadel.management.v1.ManagementService/Healthz",
+// over protocol and returns the reply. Metadata added to ctx, e.g. by
+// WithBearerToken, is sent as request headers with every protocol. Like Dial,
+// it skips TLS certificate verification.
+func Call[Req, Res any](ctx context.Context, protocol Protocol, apiBaseURL, method string, req *Req) (*Res, error) {
+	if protocol == ProtocolGRPC {
+		conn, err := Dial(ctx, apiBaseURL)
+		if err != nil {
+			return nil, fmt.Errorf("couldn't create gRPC connection: %w", err)
+		}
+		defer func() { _ = conn.Close() }()
+
+		reply := new(Res)
+		if err := Invoke(ctx, conn, method, req, reply); err != nil {
+			return nil, err
+		}
+		return reply, nil
+	}
+
+	var opts []connect.ClientOption
+	switch protocol {
+	case ProtocolGRPCWeb:
+		opts = append(opts, connect.WithGRPCWeb())
+	case ProtocolConnect:
+	default:
+		return nil, fmt.Errorf("unknown protocol %q", protocol)
+	}
+	client := connect.NewClient[Req, Res](insecureHTTPClient, strings.TrimSuffix(apiBaseURL, "/")+method, opts...)
+
+	request := connect.NewRequest(req)
+	md, _ := metadata.FromOutgoingContext(ctx)
+	for key, values := range md {
+		for _, value := range values {
+			request.Header().Add(key, value)
+		}
+	}
+	response, err := client.CallUnary(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	return response.Msg, nil
+}
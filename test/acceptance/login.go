@@ -37,46 +37,9 @@ func CheckLogin(t *testing.T, apiBaseURL string) {
 	require.NoError(t, err)
 
 	loginFailuresDir := filepath.Join(".login-failures", t.Name())
-	userDataDir, err := os.MkdirTemp("", "chromedp-test-*")
-	require.NoError(t, err)
-	t.Cleanup(func() {
-		if err := os.RemoveAll(userDataDir); err != nil {
-			t.Logf("Warning: failed to cleanup temp directory %s: %v", userDataDir, err)
-		}
-	})
-
-	allocatorOpts := append(
-		chromedp.DefaultExecAllocatorOptions[:],
-		chromedp.IgnoreCertErrors,
-		chromedp.NoSandbox,
-		chromedp.Flag("incognito", true),
-		chromedp.Flag("disable-gpu", true),
-		chromedp.Flag("disable-dev-shm-usage", true),
-		chromedp.UserDataDir(userDataDir),
-		chromedp.WSURLReadTimeout(60*time.Second),
-	)
-	if execPath := findChromeExecutable(); execPath != "" {
-		allocatorOpts = append(allocatorOpts, chromedp.ExecPath(execPath))
-	}
-
-	allocCtx, allocCancel := chromedp.NewExecAllocator(context.Background(), allocatorOpts...)
-	t.Cleanup(allocCancel)
-
-	browserCtx, browserCancel := chromedp.NewContext(
-		allocCtx,
-		chromedp.WithLogf(t.Logf),
-		chromedp.WithErrorf(t.Logf),
-	)
-	t.Cleanup(browserCancel)
-
-	loginCtx, loginCancel := context.WithTimeout(browserCtx, 5*time.Minute)
+	loginCtx, loginCancel := context.WithTimeout(newBrowser(t), 5*time.Minute)
 	defer loginCancel()
 
-	err = chromedp.Run(browserCtx, chromedp.ActionFunc(func(ctx context.Context) error {
-		return nil
-	}))
-	require.NoError(t, err)
-
 	loginHint := fmt.Sprintf("zitadel-admin@zitadel.%s", apiURL.Hostname())
 
 	t.Run("navigate", func(t *testing.T) {
@@ -141,6 +104,50 @@ func CheckLogin(t *testing.T, apiBaseURL string) {
 	})
 }
 
+// newBrowser starts a headless Chrome that ignores certificate errors and
+// returns its context. The browser is closed when the test ends.
+func newBrowser(t *testing.T) context.Context {
+	t.Helper()
+
+	userDataDir, err := os.MkdirTemp("", "chromedp-test-*")
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		if err := os.RemoveAll(userDataDir); err != nil {
+			t.Logf("Warning: failed to cleanup temp directory %s: %v", userDataDir, err)
+		}
+	})
+
+	allocatorOpts := append(
+		chromedp.DefaultExecAllocatorOptions[:],
+		chromedp.IgnoreCertErrors,
+		chromedp.NoSandbox,
+		chromedp.Flag("incognito", true),
+		chromedp.Flag("disable-gpu", true),
+		chromedp.Flag("disable-dev-shm-usage", true),
+		chromedp.UserDataDir(userDataDir),
+		chromedp.WSURLReadTimeout(60*time.Second),
+	)
+	if execPath := findChromeExecutable(); execPath != "" {
+		allocatorOpts = append(allocatorOpts, chromedp.ExecPath(execPath))
+	}
+
+	allocCtx, allocCancel := chromedp.NewExecAllocator(context.Background(), allocatorOpts...)
+	t.Cleanup(allocCancel)
+
+	browserCtx, browserCancel := chromedp.NewContext(
+		allocCtx,
+		chromedp.WithLogf(t.Logf),
+		chromedp.WithErrorf(t.Logf),
+	)
+	t.Cleanup(browserCancel)
+
+	err = chromedp.Run(browserCtx, chromedp.ActionFunc(func(ctx context.Context) error {
+		return nil
+	}))
+	require.NoError(t, err)
+	return browserCtx
+}
+
 func runWithScreenshotOnFailure(t *testing.T, ctx context.Context, failuresDir string, timeout time.Duration, actions ...chromedp.Action) {
 	t.Helper()
 	timeoutCtx, cancel := context.WithTimeout(ctx, timeout)
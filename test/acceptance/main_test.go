@@ -7,11 +7,17 @@
 // via a HelmChartConfig manifest to use NodePort with dynamically mapped ports.
 // The Gateway API provider is enabled and Traefik creates a GatewayClass and
 // Gateway automatically. The cluster is torn down when the suite completes.
-// No external cluster or manual setup is required.
+// No external cluster or manual setup is required. Set
+// ZITADEL_TEST_CLUSTER=persistent to keep the cluster between runs instead
+// (see testcluster.Start).
+//
+// Set ZITADEL_TEST_TOPOLOGY=zones to run on three nodes in three zones; the
+// topology tests skip on a single-node cluster.
 package acceptance_test
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"os"
 	"testing"
@@ -43,7 +49,13 @@ func run(m *testing.M) int {
 	ctx, cancel := context.WithTimeout(context.Background(), k3sStartupTimeout)
 	defer cancel()
 
-	cluster, err := testcluster.Start(ctx)
+	options, err := topologyOptions()
+	if err != nil {
+		log.Print(err)
+		return 1
+	}
+
+	cluster, err := testcluster.Start(ctx, options...)
 	if err != nil {
 		log.Printf("failed to start K3s cluster: %v", err)
 		return 1
@@ -55,3 +67,23 @@ func run(m *testing.M) int {
 
 	return m.Run()
 }
+
+// topologyOptions returns the testcluster options for the topology selected
+// by topologyEnvVar. The "zones" topology labels the server and two agents
+// with one zone each.
+func topologyOptions() ([]testcluster.Option, error) {
+	switch topology := os.Getenv(topologyEnvVar); topology {
+	case "", "single":
+		return nil, nil
+	case "zones":
+		return []testcluster.Option{
+			testcluster.WithServer(testcluster.Node{Labels: map[string]string{zoneLabel: "zone-a"}}),
+			testcluster.WithAgents(
+				testcluster.Node{Labels: map[string]string{zoneLabel: "zone-b"}},
+				testcluster.Node{Labels: map[string]string{zoneLabel: "zone-c"}},
+			),
+		}, nil
+	default:
+		return nil, fmt.Errorf("%s: unknown topology %q (want \"single\" or \"zones\")", topologyEnvVar, topology)
+	}
+}
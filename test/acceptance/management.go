@@ -0,0 +1,204 @@
+package acceptance_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel-charts/test/internal/api"
+)
+
+// SeedProject creates a project through the management API and returns its
+// ID.
+func SeedProject(ctx context.Context, t *testing.T, client *api.Client, name string) string {
+	t.Helper()
+
+	id := createOnce(t, "creating project "+name, func() (string, error) {
+		return client.AddProject(ctx, name)
+	}, func() (string, error) {
+		projects, err := client.ListProjects(ctx, name)
+		if err != nil || len(projects) == 0 {
+			return "", err
+		}
+		return projects[0].ID, nil
+	})
+	require.NotEmpty(t, id, "project %q was created without an ID", name)
+	return id
+}
+
+// CheckProject verifies that the project with the given ID still exists
+// under its name.
+func CheckProject(ctx context.Context, t *testing.T, client *api.Client, id, name string) {
+	t.Helper()
+
+	require.EventuallyWithT(t, func(collect *assert.CollectT) {
+		project, err := client.GetProjectByID(ctx, id)
+		if assert.NoError(collect, err) && assert.NotNil(collect, project) {
+			assert.Equal(collect, name, project.Name)
+		}
+	}, 1*time.Minute, time.Second, "project %s is not readable", id)
+}
+
+// CreateHumanUser imports a human user with a verified email and a password
+// that does not have to be changed on first login, and returns the user's ID.
+func CreateHumanUser(ctx context.Context, t *testing.T, client *api.Client, username, password string) string {
+	t.Helper()
+
+	id := createOnce(t, "creating user "+username, func() (string, error) {
+		return client.ImportHumanUser(ctx, &api.ImportHumanUserRequest{
+			UserName: username,
+			Profile:  api.HumanProfile{FirstName: "Acceptance", LastName: "Test"},
+			Email: api.HumanEmail{
+				Email:           username + "@example.com",
+				IsEmailVerified: true,
+			},
+			Password:               password,
+			PasswordChangeRequired: false,
+		})
+	}, func() (string, error) {
+		return findUser(ctx, client, username)
+	})
+	require.NotEmpty(t, id, "user %q was created without an ID", username)
+	return id
+}
+
+// CreateOIDCApp creates a public OIDC application in the project that uses
+// the authorization code flow with PKCE and redirects to redirectURI, and
+// returns its client ID. Development mode is enabled so that redirectURI may
+// be a plain HTTP URL.
+func CreateOIDCApp(ctx context.Context, t *testing.T, client *api.Client, projectID, name, redirectURI string) string {
+	t.Helper()
+
+	app := createOnce(t, "creating OIDC app "+name, func() (*api.AppCredentials, error) {
+		return client.AddOIDCApp(ctx, projectID, &api.AddOIDCAppRequest{
+			Name:                     name,
+			RedirectURIs:             []string{redirectURI},
+			ResponseTypes:            []string{"OIDC_RESPONSE_TYPE_CODE"},
+			GrantTypes:               []string{"OIDC_GRANT_TYPE_AUTHORIZATION_CODE"},
+			AppType:                  "OIDC_APP_TYPE_USER_AGENT",
+			AuthMethodType:           "OIDC_AUTH_METHOD_TYPE_NONE",
+			AccessTokenType:          "OIDC_TOKEN_TYPE_BEARER",
+			IDTokenUserinfoAssertion: true,
+			DevMode:                  true,
+		})
+	}, func() (*api.AppCredentials, error) {
+		return findApp(ctx, client, projectID, name)
+	})
+	require.NotEmpty(t, app.ClientID, "OIDC app %q was created without a client ID", name)
+	return app.ClientID
+}
+
+// CreateSAMLApp creates a SAML application in the project from the service
+// provider's metadata and returns its ID.
+func CreateSAMLApp(ctx context.Context, t *testing.T, client *api.Client, projectID, name string, metadata []byte) string {
+	t.Helper()
+
+	app := createOnce(t, "creating SAML app "+name, func() (*api.AppCredentials, error) {
+		return client.AddSAMLApp(ctx, projectID, name, metadata)
+	}, func() (*api.AppCredentials, error) {
+		return findApp(ctx, client, projectID, name)
+	})
+	require.NotEmpty(t, app.AppID, "SAML app %q was created without an ID", name)
+	return app.AppID
+}
+
+// CreateAPIApp creates an API application in the project that authenticates
+// with HTTP basic auth, as resource servers do at the introspection endpoint,
+// and returns its client ID and secret. The secret of an application that
+// already exists is regenerated, since it is only returned on creation.
+func CreateAPIApp(ctx context.Context, t *testing.T, client *api.Client, projectID, name string) (string, string) {
+	t.Helper()
+
+	app := createOnce(t, "creating API app "+name, func() (*api.AppCredentials, error) {
+		return client.AddAPIApp(ctx, projectID, name, "API_AUTH_METHOD_TYPE_BASIC")
+	}, func() (*api.AppCredentials, error) {
+		app, err := findApp(ctx, client, projectID, name)
+		if err != nil || app == nil {
+			return nil, err
+		}
+		app.ClientSecret, err = client.RegenerateAPIClientSecret(ctx, projectID, app.AppID)
+		return app, err
+	})
+	require.NotEmpty(t, app.ClientSecret, "API app %q was created without a client secret", name)
+	return app.ClientID, app.ClientSecret
+}
+
+// findApp returns the credentials of the project's application named name,
+// or nil if there is none. The client secret is not returned by the API.
+func findApp(ctx context.Context, client *api.Client, projectID, name string) (*api.AppCredentials, error) {
+	apps, err := client.ListApps(ctx, projectID, name)
+	if err != nil || len(apps) == 0 {
+		return nil, err
+	}
+	return &api.AppCredentials{AppID: apps[0].ID, ClientID: apps[0].ClientID()}, nil
+}
+
+// findUser returns the ID of the user named username, or "" if there is
+// none.
+func findUser(ctx context.Context, client *api.Client, username string) (string, error) {
+	users, err := client.ListUsers(ctx, username)
+	if err != nil || len(users) == 0 {
+		return "", err
+	}
+	return users[0].UserID, nil
+}
+
+// errNotFound is returned by createOnce while a resource that was reported
+// to exist cannot be found yet, since searches read from projections that
+// lag behind writes.
+var errNotFound = errors.New("the resource exists but is not found yet")
+
+// retryCall calls fn until it succeeds and returns its result. It retries
+// for a minute while the call fails with an error api.Temporary reports, so
+// that the API may start up, and fails the test on any other error.
+func retryCall[T any](t *testing.T, what string, fn func() (T, error)) T {
+	t.Helper()
+
+	deadline := time.Now().Add(time.Minute)
+	for {
+		result, err := fn()
+		if err == nil {
+			return result
+		}
+		if !api.Temporary(err) && !errors.Is(err, errNotFound) {
+			require.NoError(t, err, "%s failed", what)
+		}
+		if time.Now().After(deadline) {
+			require.NoError(t, err, "%s failed for a minute", what)
+		}
+		time.Sleep(time.Second)
+	}
+}
+
+// createOnce creates a resource with create, retrying like retryCall, and
+// returns its result. Creates are not idempotent, so find looks for the
+// resource instead, returning the zero value if there is none, when create
+// reports that it already exists and before create is repeated, since a
+// create that failed without a response may still have taken effect.
+func createOnce[T comparable](t *testing.T, what string, create, find func() (T, error)) T {
+	t.Helper()
+
+	var zero T
+	attempted := false
+	return retryCall(t, what, func() (T, error) {
+		if attempted {
+			if found, err := find(); err != nil || found != zero {
+				return found, err
+			}
+		}
+		attempted = true
+		result, err := create()
+		if api.IsAlreadyExists(err) {
+			found, err := find()
+			if err == nil && found == zero {
+				err = errNotFound
+			}
+			return found, err
+		}
+		return result, err
+	})
+}
@@ -8,7 +8,10 @@ import (
 	"time"
 
 	"github.com/gruntwork-io/terratest/modules/k8s"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/zitadel/zitadel-charts/test/support"
 )
 
 // CheckMetrics verifies that Prometheus-compatible metrics endpoints are
@@ -27,28 +30,18 @@ func CheckMetrics(ctx context.Context, t *testing.T, k *k8s.KubectlOptions, useT
 	t.Helper()
 	ctx, cancel := context.WithTimeout(ctx, 2*time.Minute)
 	defer cancel()
-
-	clientset, err := k8s.GetKubernetesClientFromOptionsE(t, k)
-	if err != nil {
-		t.Fatalf("failed to create k8s client: %v", err)
-	}
+	env := support.NewEnv(ctx, t, k)
 
 	t.Run("zitadel", func(t *testing.T) {
-		awaitCheck(ctx, t, 1*time.Minute, func(ctx context.Context) error {
-			pods := k8s.ListPods(t, k, metav1.ListOptions{
-				LabelSelector: "app.kubernetes.io/component=start",
-			})
-			if len(pods) == 0 {
-				return fmt.Errorf("no zitadel pods found")
-			}
+		pod := readyPod(t, env, "start")
+		scheme := ""
+		if useTLS {
+			scheme = "https"
+		}
 
-			scheme := ""
-			if useTLS {
-				scheme = "https"
-			}
-
-			body, err := clientset.CoreV1().Pods(k.Namespace).
-				ProxyGet(scheme, pods[0].Name, "8080", "/debug/metrics", nil).
+		env.Eventually(t, func(ctx context.Context) error {
+			body, err := env.Client.CoreV1().Pods(env.Namespace).
+				ProxyGet(scheme, pod.Name, "8080", "/debug/metrics", nil).
 				DoRaw(ctx)
 			if err != nil {
 				return fmt.Errorf("proxy request failed: %w", err)
@@ -58,20 +51,15 @@ func CheckMetrics(ctx context.Context, t *testing.T, k *k8s.KubectlOptions, useT
 				return fmt.Errorf("metrics response does not contain expected metric 'go_goroutines'")
 			}
 			return nil
-		}, "zitadel metrics check failed")
+		}, 1*time.Minute, "zitadel metrics check failed")
 	})
 
 	t.Run("login", func(t *testing.T) {
-		awaitCheck(ctx, t, 1*time.Minute, func(ctx context.Context) error {
-			pods := k8s.ListPods(t, k, metav1.ListOptions{
-				LabelSelector: "app.kubernetes.io/component=login",
-			})
-			if len(pods) == 0 {
-				return fmt.Errorf("no login pods found")
-			}
+		pod := readyPod(t, env, "login")
 
-			body, err := clientset.CoreV1().Pods(k.Namespace).
-				ProxyGet("", pods[0].Name, "9464", "/metrics", nil).
+		env.Eventually(t, func(ctx context.Context) error {
+			body, err := env.Client.CoreV1().Pods(env.Namespace).
+				ProxyGet("", pod.Name, "9464", "/metrics", nil).
 				DoRaw(ctx)
 			if err != nil {
 				return fmt.Errorf("proxy request failed: %w", err)
@@ -82,6 +70,25 @@ func CheckMetrics(ctx context.Context, t *testing.T, k *k8s.KubectlOptions, useT
 				return fmt.Errorf("login metrics response does not contain Prometheus format headers")
 			}
 			return nil
-		}, "login metrics check failed")
+		}, 1*time.Minute, "login metrics check failed")
 	})
 }
+
+// readyPod returns a pod of the chart component once it is ready.
+func readyPod(t *testing.T, env *support.Env, component string) *corev1.Pod {
+	t.Helper()
+
+	pods := env.ListPods(t, support.WithLabelSelector("app.kubernetes.io/component="+component))
+	require.NotEmpty(t, pods, "no %s pods found", component)
+	return env.WaitForPod(t, pods[0].Name, podReady, 1*time.Minute)
+}
+
+// podReady reports whether the pod's Ready condition is true.
+func podReady(pod *corev1.Pod) bool {
+	for _, c := range pod.Status.Conditions {
+		if c.Type == corev1.PodReady {
+			return c.Status == corev1.ConditionTrue
+		}
+	}
+	return false
+}
@@ -0,0 +1,164 @@
+package acceptance_test
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gruntwork-io/terratest/modules/k8s"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	httphelper "github.com/zitadel/zitadel-charts/test/internal/http"
+)
+
+// clientAssertionType marks a JWT as the client authentication of a request
+// to an OAuth endpoint (RFC 7523).
+const clientAssertionType = "urn:ietf:params:oauth:client-assertion-type:jwt-bearer"
+
+// maxAgePattern extracts the max-age directive of a Cache-Control header.
+var maxAgePattern = regexp.MustCompile(`max-age=(\d+)`)
+
+// CheckOAuthEndpoints exercises the OAuth and OpenID Connect endpoints beyond
+// the token endpoint with every method a client uses, proving that the
+// service, the ingress and the HTTPRoute forward all of their paths.
+//
+// Using the machine user's key from the Kubernetes secret, the function
+// creates a project with an API application, the way a resource server is
+// registered, and obtains an access token whose audience includes the
+// project. It then runs the following checks against the endpoints named in
+// the discovery document:
+//
+//   - introspection: the API application authenticates with basic auth and
+//     the token is reported active for the machine user
+//   - userinfo: GET and POST both return the machine user's subject
+//   - jwks: the signing keys are served with a Cache-Control max-age, which
+//     tells relying parties when to pick up rotated keys
+//   - revocation: the machine user revokes its token, after which the
+//     management API rejects it and introspection reports it inactive
+//
+// The machine user's ID, which introspection and userinfo must report as the
+// subject, is taken from its key rather than from either response.
+func CheckOAuthEndpoints(ctx context.Context, t *testing.T, k *k8s.KubectlOptions, apiBaseURL, secretName, secretKey string) {
+	t.Helper()
+
+	oauthCtx, cancel := context.WithTimeout(ctx, 5*time.Minute)
+	defer cancel()
+
+	discovery := Discover(oauthCtx, t, apiBaseURL)
+	client := NewAPIClient(t, k, apiBaseURL, secretName, secretKey)
+	projectID := SeedProject(oauthCtx, t, client, "oauth-endpoints")
+	clientID, clientSecret := CreateAPIApp(oauthCtx, t, client, projectID, "oauth-endpoints")
+
+	assertion := MachineAssertion(t, k, apiBaseURL, secretName, secretKey)
+	token := MachineToken(oauthCtx, t, k, apiBaseURL, secretName, secretKey,
+		fmt.Sprintf("urn:zitadel:iam:org:project:id:%s:aud", projectID))
+
+	subject := client.UserID()
+	require.NotEmpty(t, subject, "the machine key %s has no user ID", secretName)
+
+	t.Run("introspection", func(t *testing.T) {
+		introspection := introspect(oauthCtx, t, discovery.IntrospectionEndpoint, clientID, clientSecret, token)
+		require.True(t, introspection.Active, "the token should be active")
+		require.Equal(t, subject, introspection.Subject, "introspection should describe the machine user's token")
+	})
+
+	t.Run("userinfo", func(t *testing.T) {
+		for _, method := range []string{http.MethodGet, http.MethodPost} {
+			resp, body, err := httphelper.Do(oauthCtx, method, discovery.UserinfoEndpoint,
+				map[string]string{"Authorization": "Bearer " + token}, nil)
+			require.NoError(t, err)
+			require.Equal(t, 200, resp.StatusCode, "%s %s: %s", method, discovery.UserinfoEndpoint, body)
+
+			var userinfo struct {
+				Subject string `json:"sub"`
+			}
+			require.NoError(t, json.Unmarshal(body, &userinfo))
+			require.Equal(t, subject, userinfo.Subject, "%s userinfo should describe the machine user", method)
+		}
+	})
+
+	t.Run("jwks", func(t *testing.T) {
+		resp, body, err := httphelper.Do(oauthCtx, http.MethodGet, discovery.JwksURI, nil, nil)
+		require.NoError(t, err)
+		require.Equal(t, 200, resp.StatusCode, "GET %s: %s", discovery.JwksURI, body)
+		require.Contains(t, resp.Header.Get("Content-Type"), "application/json")
+
+		var jwks struct {
+			Keys []struct {
+				KeyID string `json:"kid"`
+			} `json:"keys"`
+		}
+		require.NoError(t, json.Unmarshal(body, &jwks))
+		require.NotEmpty(t, jwks.Keys, "the JWKS holds no keys")
+		for _, key := range jwks.Keys {
+			require.NotEmpty(t, key.KeyID, "every key needs a kid to be matched after a rotation")
+		}
+
+		cacheControl := resp.Header.Get("Cache-Control")
+		match := maxAgePattern.FindStringSubmatch(cacheControl)
+		require.NotNil(t, match, "the JWKS should be served with a Cache-Control max-age, got %q", cacheControl)
+		maxAge, err := strconv.Atoi(match[1])
+		require.NoError(t, err)
+		require.Positive(t, maxAge, "the JWKS should be cacheable, got %q", cacheControl)
+	})
+
+	t.Run("revocation", func(t *testing.T) {
+		form := url.Values{
+			"token":                 {token},
+			"client_assertion_type": {clientAssertionType},
+			"client_assertion":      {assertion},
+		}
+		status, body, err := httphelper.Post(oauthCtx, discovery.RevocationEndpoint,
+			map[string]string{"Content-Type": "application/x-www-form-urlencoded"},
+			strings.NewReader(form.Encode()))
+		require.NoError(t, err)
+		require.Equal(t, 200, status, "revoking the token failed: %s", body)
+
+		require.EventuallyWithT(t, func(collect *assert.CollectT) {
+			status, _, err := httphelper.Get(oauthCtx, apiBaseURL+"/management/v1/languages",
+				map[string]string{"Authorization": "Bearer " + token})
+			if assert.NoError(collect, err) {
+				assert.Equal(collect, 401, status, "the revoked token should be rejected")
+			}
+		}, 1*time.Minute, time.Second, "the revoked token was accepted for a minute")
+
+		introspection := introspect(oauthCtx, t, discovery.IntrospectionEndpoint, clientID, clientSecret, token)
+		require.False(t, introspection.Active, "the revoked token should be inactive")
+	})
+}
+
+// introspectionResponse holds the fields of an introspection response the
+// checks look at.
+type introspectionResponse struct {
+	Active  bool   `json:"active"`
+	Subject string `json:"sub"`
+}
+
+// introspect asks the introspection endpoint about token, authenticating as
+// the API application with basic auth.
+func introspect(ctx context.Context, t *testing.T, endpoint, clientID, clientSecret, token string) *introspectionResponse {
+	t.Helper()
+
+	// The credentials are form-encoded before they are joined (RFC 6749,
+	// section 2.3.1).
+	credentials := url.QueryEscape(clientID) + ":" + url.QueryEscape(clientSecret)
+	status, body, err := httphelper.Post(ctx, endpoint, map[string]string{
+		"Authorization": "Basic " + base64.StdEncoding.EncodeToString([]byte(credentials)),
+		"Content-Type":  "application/x-www-form-urlencoded",
+	}, strings.NewReader(url.Values{"token": {token}}.Encode()))
+	require.NoError(t, err)
+	require.Equal(t, 200, status, "introspecting the token failed: %s", body)
+
+	introspection := new(introspectionResponse)
+	require.NoError(t, json.Unmarshal(body, introspection))
+	return introspection
+}
@@ -0,0 +1,241 @@
+package acceptance_test
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/chromedp/chromedp"
+	"github.com/gruntwork-io/terratest/modules/k8s"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zitadel/oidc/pkg/client/rp"
+	"github.com/zitadel/oidc/pkg/oidc"
+
+	httphelper "github.com/zitadel/zitadel-charts/test/internal/http"
+)
+
+// humanUserPassword is the password of the human users the browser flows log
+// in with.
+const humanUserPassword = "Password1!"
+
+// CheckOIDCFlow performs an OpenID Connect authorization code flow with PKCE
+// the way a browser-based application does, proving that the external
+// domain, the ingress routes and the Login UI are wired together end to end.
+//
+// Using the machine user's key from the Kubernetes secret, the function
+// creates a project, a public OIDC application and a human user through the
+// management API. The application redirects to an HTTP listener inside the
+// test. Headless Chrome then opens the authorization endpoint from the
+// discovery document, logs in as the user through the Login UI, and follows
+// the redirect back to the listener. The code is exchanged for tokens at the
+// token endpoint with the PKCE code verifier.
+//
+// This check validates:
+//   - Issuer and endpoints in /.well-known/openid-configuration
+//   - Redirects between the API, the Login UI and the application
+//   - The ID token's signature against the JWKS, and its issuer, audience,
+//     expiry, nonce and access token hash
+//   - The subject, email and preferred_username claims of the logged in user
+//
+// Screenshots are saved to .login-failures when a browser step fails.
+func CheckOIDCFlow(ctx context.Context, t *testing.T, k *k8s.KubectlOptions, apiBaseURL, secretName, secretKey string) {
+	t.Helper()
+
+	flowCtx, cancel := context.WithTimeout(ctx, 5*time.Minute)
+	defer cancel()
+
+	apiURL, err := url.Parse(apiBaseURL)
+	require.NoError(t, err)
+
+	discovery := Discover(flowCtx, t, apiBaseURL)
+	require.Equal(t, apiBaseURL, discovery.Issuer, "the issuer should be the external URL")
+
+	redirectURI, callbacks := serveCallback(t)
+	client := NewAPIClient(t, k, apiBaseURL, secretName, secretKey)
+	projectID := SeedProject(flowCtx, t, client, "oidc-flow")
+	clientID := CreateOIDCApp(flowCtx, t, client, projectID, "oidc-flow", redirectURI)
+	username := "oidc-flow-user"
+	userID := CreateHumanUser(flowCtx, t, client, username, humanUserPassword)
+	loginName := fmt.Sprintf("%s@zitadel.%s", username, apiURL.Hostname())
+
+	codeVerifier, state, nonce := randomString(t), randomString(t), randomString(t)
+	authorize := url.Values{
+		"client_id":             {clientID},
+		"redirect_uri":          {redirectURI},
+		"response_type":         {"code"},
+		"scope":                 {strings.Join([]string{oidc.ScopeOpenID, oidc.ScopeProfile, oidc.ScopeEmail}, " ")},
+		"state":                 {state},
+		"nonce":                 {nonce},
+		"code_challenge":        {oidc.NewSHACodeChallenge(codeVerifier)},
+		"code_challenge_method": {string(oidc.CodeChallengeMethodS256)},
+		"login_hint":            {loginName},
+	}
+
+	var callback url.Values
+	t.Run("login", func(t *testing.T) {
+		callback = loginAndAwaitCallback(t, discovery.AuthorizationEndpoint+"?"+authorize.Encode(), loginName, callbacks)
+	})
+	require.NotNil(t, callback, "the browser was not redirected to %s", redirectURI)
+	require.Equal(t, state, callback.Get("state"), "the redirect should carry the state")
+	require.Empty(t, callback.Get("error"), "the authorization failed: %s", callback.Get("error_description"))
+
+	tokens := exchangeCode(flowCtx, t, discovery.TokenEndpoint, clientID, redirectURI, callback.Get("code"), codeVerifier)
+
+	t.Run("id-token", func(t *testing.T) {
+		verifier := rp.NewIDTokenVerifier(discovery.Issuer, clientID,
+			rp.NewRemoteKeySet(httphelper.Client(), discovery.JwksURI),
+			rp.WithNonce(func(context.Context) string { return nonce }),
+		)
+		claims, err := rp.VerifyTokens(flowCtx, tokens.AccessToken, tokens.IDToken, verifier)
+		require.NoError(t, err, "the ID token is not valid")
+		require.Equal(t, userID, claims.GetSubject())
+		require.Equal(t, username+"@example.com", claims.GetEmail())
+		require.Equal(t, loginName, claims.GetClaim("preferred_username"))
+	})
+}
+
+// Discover fetches the OpenID Connect discovery document of the instance.
+func Discover(ctx context.Context, t *testing.T, apiBaseURL string) *oidc.DiscoveryConfiguration {
+	t.Helper()
+
+	discovery := new(oidc.DiscoveryConfiguration)
+	require.EventuallyWithT(t, func(collect *assert.CollectT) {
+		status, body, err := httphelper.Get(ctx, apiBaseURL+oidc.DiscoveryEndpoint, nil)
+		if !assert.NoError(collect, err) || !assert.Equal(collect, 200, status) {
+			return
+		}
+		assert.NoError(collect, json.Unmarshal(body, discovery))
+	}, 1*time.Minute, time.Second, "fetching the discovery document failed for a minute")
+	return discovery
+}
+
+// serveCallback starts an HTTP listener for the redirect at the end of a
+// browser flow and returns its URL and a channel receiving the query and
+// form parameters of the first request.
+func serveCallback(t *testing.T) (string, <-chan url.Values) {
+	t.Helper()
+
+	callbacks := make(chan url.Values, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = r.ParseForm()
+		select {
+		case callbacks <- r.Form:
+		default:
+		}
+		_, _ = fmt.Fprintln(w, "You can close this window.")
+	}))
+	t.Cleanup(server.Close)
+	return server.URL + "/callback", callbacks
+}
+
+// loginAndAwaitCallback opens startURL in headless Chrome, logs in through
+// the Login UI as loginName with humanUserPassword, and returns the parameters
+// the browser delivered to the listener of serveCallback. The Login UI asks
+// for the login name unless the flow passed it as a hint, and may ask to set
+// up a second factor, which is skipped.
+func loginAndAwaitCallback(t *testing.T, startURL, loginName string, callbacks <-chan url.Values) url.Values {
+	t.Helper()
+
+	failuresDir := filepath.Join(".login-failures", t.Name())
+	browserCtx, cancel := context.WithTimeout(newBrowser(t), 3*time.Minute)
+	defer cancel()
+
+	usernameInput := testIDSelector("username-text-input")
+	passwordInput := testIDSelector("password-text-input")
+	var askedForUsername bool
+	runWithScreenshotOnFailure(t, browserCtx, failuresDir, 60*time.Second,
+		chromedp.Navigate(startURL),
+		chromedp.WaitVisible(usernameInput+", "+passwordInput, chromedp.ByQuery),
+		chromedp.Evaluate(fmt.Sprintf("document.querySelector(%q) !== null", usernameInput), &askedForUsername),
+	)
+	if askedForUsername {
+		runWithScreenshotOnFailure(t, browserCtx, failuresDir, 60*time.Second,
+			chromedp.SendKeys(usernameInput, loginName, chromedp.ByQuery),
+			chromedp.WaitReady(testIDSelector("submit-button"), chromedp.ByQuery),
+			chromedp.Click(testIDSelector("submit-button"), chromedp.ByQuery),
+		)
+	}
+
+	var callback url.Values
+	runWithScreenshotOnFailure(t, browserCtx, failuresDir, 60*time.Second,
+		chromedp.WaitVisible(passwordInput, chromedp.ByQuery),
+		chromedp.SendKeys(passwordInput, humanUserPassword, chromedp.ByQuery),
+		chromedp.WaitReady(testIDSelector("submit-button"), chromedp.ByQuery),
+		chromedp.Click(testIDSelector("submit-button"), chromedp.ByQuery),
+		awaitCallback(callbacks, &callback),
+	)
+	return callback
+}
+
+// awaitCallback waits until the browser reaches the listener of
+// serveCallback and stores the parameters it received, skipping the second
+// factor setup on the way.
+func awaitCallback(callbacks <-chan url.Values, callback *url.Values) chromedp.Action {
+	return chromedp.ActionFunc(func(ctx context.Context) error {
+		for {
+			select {
+			case *callback = <-callbacks:
+				return nil
+			case <-ctx.Done():
+				return fmt.Errorf("waiting for the redirect: %w", ctx.Err())
+			case <-time.After(500 * time.Millisecond):
+			}
+			var location string
+			if err := chromedp.Location(&location).Do(ctx); err != nil {
+				return err
+			}
+			if strings.Contains(location, "/ui/v2/login/mfa/set") {
+				if err := chromedp.Click(testIDSelector("reset-button"), chromedp.ByQuery).Do(ctx); err != nil {
+					return err
+				}
+			}
+		}
+	})
+}
+
+// exchangeCode redeems an authorization code at the token endpoint, proving
+// possession of the PKCE code verifier instead of a client secret.
+func exchangeCode(ctx context.Context, t *testing.T, tokenEndpoint, clientID, redirectURI, code, codeVerifier string) *oidc.AccessTokenResponse {
+	t.Helper()
+	require.NotEmpty(t, code, "the redirect carries no authorization code")
+
+	form := url.Values{
+		"grant_type":    {string(oidc.GrantTypeCode)},
+		"code":          {code},
+		"redirect_uri":  {redirectURI},
+		"client_id":     {clientID},
+		"code_verifier": {codeVerifier},
+	}
+	status, body, err := httphelper.Post(ctx, tokenEndpoint,
+		map[string]string{"Content-Type": "application/x-www-form-urlencoded"},
+		strings.NewReader(form.Encode()))
+	require.NoError(t, err)
+	require.Equal(t, 200, status, "exchanging the code failed: %s", body)
+
+	tokens := new(oidc.AccessTokenResponse)
+	require.NoError(t, json.Unmarshal(body, tokens))
+	require.NotEmpty(t, tokens.AccessToken, "the token response has no access token")
+	require.NotEmpty(t, tokens.IDToken, "the token response has no ID token")
+	return tokens
+}
+
+// randomString returns 32 random bytes, base64url encoded, as used for PKCE
+// code verifiers, states and nonces.
+func randomString(t *testing.T) string {
+	t.Helper()
+
+	b := make([]byte, 32)
+	_, err := rand.Read(b)
+	require.NoError(t, err)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
@@ -0,0 +1,219 @@
+package acceptance_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/gruntwork-io/terratest/modules/helm"
+	"github.com/gruntwork-io/terratest/modules/k8s"
+	"github.com/stretchr/testify/require"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/yaml"
+
+	"github.com/zitadel/zitadel-charts/test/internal/testcluster"
+)
+
+// yamlDocumentSeparator splits the output of `helm get hooks` into its
+// manifests.
+var yamlDocumentSeparator = regexp.MustCompile(`(?m)^---\s*$`)
+
+// hookLogLines is how many trailing log lines of a failed hook pod
+// HookFailure includes.
+const hookLogLines = 20
+
+// ReleaseStatus returns the revision and status, e.g. "deployed" or
+// "failed", of the ZITADEL release.
+func ReleaseStatus(t *testing.T, k *k8s.KubectlOptions) (int, string) {
+	t.Helper()
+
+	out, err := helm.RunHelmCommandAndGetStdOutE(t, &helm.Options{KubectlOptions: k}, "status", zitadelRelease, "-o", "json")
+	require.NoError(t, err, "failed to get the status of release %s", zitadelRelease)
+	var release struct {
+		Version int `json:"version"`
+		Info    struct {
+			Status string `json:"status"`
+		} `json:"info"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(out), &release), "failed to parse the status of release %s", zitadelRelease)
+	return release.Version, release.Info.Status
+}
+
+// RollbackZitadel rolls the ZITADEL release back to revision and waits until
+// all resources are ready (--wait --timeout 10m).
+func RollbackZitadel(t *testing.T, k *k8s.KubectlOptions, revision int) {
+	t.Helper()
+
+	options := &helm.Options{
+		KubectlOptions: k,
+		ExtraArgs:      map[string][]string{"rollback": {"--wait", "--timeout", "10m"}},
+	}
+	helm.Rollback(t, options, zitadelRelease, strconv.Itoa(revision))
+}
+
+// CheckFailedUpgrade verifies the state the chart leaves behind after an
+// upgrade failed in its init or setup hook: the release is marked failed,
+// the hook job reports a failure whose description contains want, and the
+// ZITADEL pods of the previous revision are untouched and keep serving. If
+// the check fails, the namespace's diagnostics are collected while the
+// failed hooks are still there to inspect in the CI artifacts.
+func CheckFailedUpgrade(ctx context.Context, t *testing.T, k *k8s.KubectlOptions, apiBaseURL, want string) {
+	t.Helper()
+	defer func() {
+		if t.Failed() {
+			t.Logf("diagnostics written to %s", testcluster.CollectDiagnostics(t, k))
+		}
+	}()
+
+	_, status := ReleaseStatus(t, k)
+	require.Equal(t, "failed", status, "release %s should be marked failed", zitadelRelease)
+
+	clientset, err := k8s.GetKubernetesClientFromOptionsE(t, k)
+	require.NoError(t, err, "failed to create k8s client")
+	failure, err := HookFailure(ctx, clientset, k.Namespace)
+	require.NoError(t, err)
+	t.Logf("failed hook:\n%s", failure)
+	require.Contains(t, failure, want, "the failed hook should explain why")
+
+	deployment := k8s.GetDeployment(t, k, zitadelRelease)
+	require.Equal(t, deployment.Status.Replicas, deployment.Status.ReadyReplicas,
+		"ZITADEL pods should keep running while the upgrade hooks fail")
+	CheckAccessibility(ctx, t, k, apiBaseURL)
+}
+
+// CheckRollback rolls back to revision, restores the configuration hooks of
+// that revision and verifies that the release is deployed and working again,
+// including after its pods restart, which only succeeds if the configuration
+// they read is the one of revision.
+func CheckRollback(ctx context.Context, t *testing.T, k *k8s.KubectlOptions, apiBaseURL string, revision int) {
+	t.Helper()
+
+	RollbackZitadel(t, k, revision)
+	_, status := ReleaseStatus(t, k)
+	require.Equal(t, "deployed", status, "release %s should be deployed after the rollback", zitadelRelease)
+	RestoreHookResources(t, k, revision)
+
+	k8s.RunKubectl(t, k, "rollout", "restart", "deployment/"+zitadelRelease)
+	k8s.RunKubectl(t, k, "rollout", "status", "deployment/"+zitadelRelease, "--timeout", "5m")
+	CheckAccessibility(ctx, t, k, apiBaseURL)
+}
+
+// RestoreHookResources applies the ConfigMaps and Secrets of revision that
+// the chart recreates as pre-upgrade hooks. Helm runs none of these hooks on
+// rollback, so after a failed upgrade they keep the content written by its
+// hooks until they are restored.
+func RestoreHookResources(t *testing.T, k *k8s.KubectlOptions, revision int) {
+	t.Helper()
+
+	out, err := helm.RunHelmCommandAndGetStdOutE(t, &helm.Options{KubectlOptions: k},
+		"get", "hooks", zitadelRelease, "--revision", strconv.Itoa(revision))
+	require.NoError(t, err, "failed to get the hooks of release %s revision %d", zitadelRelease, revision)
+
+	var manifests []string
+	for _, doc := range yamlDocumentSeparator.Split(out, -1) {
+		var obj metav1.PartialObjectMetadata
+		require.NoError(t, yaml.Unmarshal([]byte(doc), &obj), "failed to parse a hook of revision %d", revision)
+		hooks := strings.Split(obj.Annotations["helm.sh/hook"], ",")
+		if (obj.Kind == "ConfigMap" || obj.Kind == "Secret") && slices.Contains(hooks, "pre-upgrade") {
+			manifests = append(manifests, doc)
+		}
+	}
+	require.NotEmpty(t, manifests, "revision %d has no configuration hooks", revision)
+	k8s.KubectlApplyFromString(t, k, strings.Join(manifests, "\n---\n"))
+}
+
+// HookFailure describes why the init or setup job of the ZITADEL release
+// failed: the job's failure condition, the state of its pods' containers, the
+// tail of their logs and the warning events about them. It fails if no hook
+// job failed.
+func HookFailure(ctx context.Context, clientset kubernetes.Interface, namespace string) (string, error) {
+	jobs, err := clientset.BatchV1().Jobs(namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: "app.kubernetes.io/component in (init,setup)",
+	})
+	if err != nil {
+		return "", fmt.Errorf("listing hook jobs: %w", err)
+	}
+	events, err := clientset.CoreV1().Events(namespace).List(ctx, metav1.ListOptions{FieldSelector: "type=Warning"})
+	if err != nil {
+		return "", fmt.Errorf("listing events: %w", err)
+	}
+	sort.SliceStable(events.Items, func(i, j int) bool {
+		return events.Items[i].LastTimestamp.Before(&events.Items[j].LastTimestamp)
+	})
+
+	var sb strings.Builder
+	for _, job := range jobs.Items {
+		condition := failedCondition(job)
+		if condition == nil {
+			continue
+		}
+		fmt.Fprintf(&sb, "job %s failed: %s: %s\n", job.Name, condition.Reason, condition.Message)
+
+		pods, err := clientset.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{
+			LabelSelector: batchv1.JobNameLabel + "=" + job.Name,
+		})
+		if err != nil {
+			return "", fmt.Errorf("listing pods of job %s: %w", job.Name, err)
+		}
+		for _, pod := range pods.Items {
+			for _, s := range append(pod.Status.InitContainerStatuses, pod.Status.ContainerStatuses...) {
+				describeContainer(ctx, &sb, clientset, pod, s)
+			}
+		}
+		// The pods of a job that exceeded its deadline are deleted, so their
+		// events are all that is left of them.
+		for _, e := range events.Items {
+			if e.InvolvedObject.Name == job.Name || strings.HasPrefix(e.InvolvedObject.Name, job.Name+"-") {
+				fmt.Fprintf(&sb, "  event %s/%s: %s: %s\n", e.InvolvedObject.Kind, e.InvolvedObject.Name, e.Reason, e.Message)
+			}
+		}
+	}
+	if sb.Len() == 0 {
+		return "", fmt.Errorf("none of the %d hook jobs in %s failed", len(jobs.Items), namespace)
+	}
+	return sb.String(), nil
+}
+
+func failedCondition(job batchv1.Job) *batchv1.JobCondition {
+	for _, c := range job.Status.Conditions {
+		if c.Type == batchv1.JobFailed && c.Status == corev1.ConditionTrue {
+			return &c
+		}
+	}
+	return nil
+}
+
+// describeContainer writes the state of a hook container and, if it ran, the
+// tail of its log.
+func describeContainer(ctx context.Context, sb *strings.Builder, clientset kubernetes.Interface, pod corev1.Pod, s corev1.ContainerStatus) {
+	switch {
+	case s.State.Waiting != nil:
+		fmt.Fprintf(sb, "  pod %s container %s waiting: %s: %s\n", pod.Name, s.Name, s.State.Waiting.Reason, s.State.Waiting.Message)
+		return
+	case s.State.Terminated != nil:
+		fmt.Fprintf(sb, "  pod %s container %s exited with %d: %s\n", pod.Name, s.Name, s.State.Terminated.ExitCode, s.State.Terminated.Reason)
+	default:
+		fmt.Fprintf(sb, "  pod %s container %s running\n", pod.Name, s.Name)
+	}
+	logs, err := clientset.CoreV1().Pods(pod.Namespace).GetLogs(pod.Name, &corev1.PodLogOptions{
+		Container: s.Name,
+		TailLines: ptr.To[int64](hookLogLines),
+	}).DoRaw(ctx)
+	if err != nil {
+		fmt.Fprintf(sb, "    (no logs: %v)\n", err)
+		return
+	}
+	for _, line := range strings.Split(strings.TrimRight(string(logs), "\n"), "\n") {
+		fmt.Fprintf(sb, "    %s\n", line)
+	}
+}
@@ -0,0 +1,50 @@
+package acceptance_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gruntwork-io/terratest/modules/k8s"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	grpchelper "github.com/zitadel/zitadel-charts/test/acceptance/helpers/grpc"
+)
+
+// rpcMethods are unary methods with empty requests, one from the v1 APIs and
+// one from the v2 services. Their replies are decoded into emptypb.Empty,
+// which discards the fields.
+var rpcMethods = []string{
+	"/zitadel.management.v1.ManagementService/GetSupportedLanguages",
+	"/zitadel.settings.v2.SettingsService/GetGeneralSettings",
+}
+
+// CheckRPCProtocols calls the same management and v2 service methods over
+// native gRPC, gRPC-web and the Connect protocol, which ZITADEL serves on a
+// single port. Native gRPC needs end-to-end HTTP/2, which the GRPCRoute or
+// the ingress's h2c backend provides, while gRPC-web and Connect also work
+// over HTTP/1.1 and travel the same routes as the REST APIs. Each call is
+// authenticated with the machine user's token, so a route that drops
+// headers fails the check.
+func CheckRPCProtocols(ctx context.Context, t *testing.T, k *k8s.KubectlOptions, apiBaseURL, secretName, secretKey string) {
+	t.Helper()
+
+	rpcCtx, cancel := context.WithTimeout(ctx, 5*time.Minute)
+	defer cancel()
+
+	token := MachineToken(rpcCtx, t, k, apiBaseURL, secretName, secretKey)
+	authCtx := grpchelper.WithBearerToken(rpcCtx, token)
+
+	for _, protocol := range grpchelper.Protocols {
+		t.Run(string(protocol), func(t *testing.T) {
+			for _, method := range rpcMethods {
+				require.EventuallyWithT(t, func(collect *assert.CollectT) {
+					_, err := grpchelper.Call[emptypb.Empty, emptypb.Empty](authCtx, protocol, apiBaseURL, method, &emptypb.Empty{})
+					assert.NoError(collect, err, "calling %s over %s", method, protocol)
+				}, 1*time.Minute, time.Second, "calling %s over %s failed for a minute", method, protocol)
+			}
+		})
+	}
+}
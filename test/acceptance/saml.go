@@ -0,0 +1,164 @@
+package acceptance_test
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/crewjam/saml"
+	"github.com/crewjam/saml/samlsp"
+	"github.com/gruntwork-io/terratest/modules/k8s"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	httphelper "github.com/zitadel/zitadel-charts/test/internal/http"
+)
+
+// samlMetadataPath is where ZITADEL serves its SAML IdP metadata.
+const samlMetadataPath = "/saml/v2/metadata"
+
+// CheckSAML performs an SP-initiated SAML login against ZITADEL as identity
+// provider, proving that /saml/v2 is routed through the ingress or HTTPRoute
+// and that the assertions it issues can be trusted by a service provider.
+//
+// The function runs a service provider stand-in inside the test process,
+// with its own signing key and an assertion consumer service on a local
+// HTTP listener. Using the machine user's key from the Kubernetes secret, it
+// registers the service provider's metadata as a SAML application and
+// creates a human user through the management API. Headless Chrome then
+// follows a signed AuthnRequest with the redirect binding, logs in as the
+// user through the Login UI, and posts the response back to the listener.
+//
+// This check validates:
+//   - The IdP metadata at /saml/v2/metadata names the external URL
+//   - Redirects between the API, the Login UI and the service provider
+//   - The response's signature against the certificates in the metadata,
+//     and its issuer, destination, audience, validity and request ID
+//   - The UserID and Email attributes of the logged in user
+//
+// Screenshots are saved to .login-failures when a browser step fails.
+func CheckSAML(ctx context.Context, t *testing.T, k *k8s.KubectlOptions, apiBaseURL, secretName, secretKey string) {
+	t.Helper()
+
+	samlCtx, cancel := context.WithTimeout(ctx, 5*time.Minute)
+	defer cancel()
+
+	apiURL, err := url.Parse(apiBaseURL)
+	require.NoError(t, err)
+
+	idp := FetchSAMLMetadata(samlCtx, t, apiBaseURL)
+	require.Equal(t, apiBaseURL+samlMetadataPath, idp.EntityID, "the entity ID should be the external URL")
+
+	acsURL, callbacks := serveCallback(t)
+	sp := newServiceProvider(t, acsURL, idp)
+	metadata, err := xml.Marshal(sp.Metadata())
+	require.NoError(t, err)
+
+	client := NewAPIClient(t, k, apiBaseURL, secretName, secretKey)
+	projectID := SeedProject(samlCtx, t, client, "saml-flow")
+	CreateSAMLApp(samlCtx, t, client, projectID, "saml-flow", metadata)
+	username := "saml-flow-user"
+	userID := CreateHumanUser(samlCtx, t, client, username, humanUserPassword)
+	loginName := fmt.Sprintf("%s@zitadel.%s", username, apiURL.Hostname())
+
+	request, err := sp.MakeAuthenticationRequest(sp.GetSSOBindingLocation(saml.HTTPRedirectBinding),
+		saml.HTTPRedirectBinding, saml.HTTPPostBinding)
+	require.NoError(t, err)
+	redirect, err := request.Redirect("", &sp)
+	require.NoError(t, err)
+
+	var callback url.Values
+	t.Run("login", func(t *testing.T) {
+		callback = loginAndAwaitCallback(t, redirect.String(), loginName, callbacks)
+	})
+	require.NotNil(t, callback, "the browser did not post a response to %s", acsURL)
+
+	t.Run("assertion", func(t *testing.T) {
+		response, err := base64.StdEncoding.DecodeString(callback.Get("SAMLResponse"))
+		require.NoError(t, err, "the SAMLResponse is not base64")
+		assertion, err := sp.ParseXMLResponse(response, []string{request.ID})
+		if invalid := (*saml.InvalidResponseError)(nil); errors.As(err, &invalid) {
+			err = invalid.PrivateErr
+		}
+		require.NoError(t, err, "the SAML response is not valid")
+
+		attributes := samlAttributes(assertion)
+		require.Equal(t, []string{userID}, attributes["UserID"])
+		require.Equal(t, []string{username + "@example.com"}, attributes["Email"])
+	})
+}
+
+// FetchSAMLMetadata fetches and parses the SAML IdP metadata of the
+// instance.
+func FetchSAMLMetadata(ctx context.Context, t *testing.T, apiBaseURL string) *saml.EntityDescriptor {
+	t.Helper()
+
+	var metadata *saml.EntityDescriptor
+	require.EventuallyWithT(t, func(collect *assert.CollectT) {
+		status, body, err := httphelper.Get(ctx, apiBaseURL+samlMetadataPath, nil)
+		if !assert.NoError(collect, err) || !assert.Equal(collect, 200, status) {
+			return
+		}
+		metadata, err = samlsp.ParseMetadata(body)
+		assert.NoError(collect, err)
+	}, 1*time.Minute, time.Second, "fetching the SAML metadata failed for a minute")
+	return metadata
+}
+
+// newServiceProvider returns a service provider that trusts idp, receives
+// responses at acsURL, and signs its requests with a new self-signed key.
+func newServiceProvider(t *testing.T, acsURL string, idp *saml.EntityDescriptor) saml.ServiceProvider {
+	t.Helper()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "zitadel-charts-acceptance-sp"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(24 * time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	acs, err := url.Parse(acsURL)
+	require.NoError(t, err)
+	metadataURL := *acs
+	metadataURL.Path = strings.TrimSuffix(acs.Path, "/callback") + "/metadata"
+
+	return saml.ServiceProvider{
+		Key:         key,
+		Certificate: cert,
+		MetadataURL: metadataURL,
+		AcsURL:      *acs,
+		IDPMetadata: idp,
+		HTTPClient:  httphelper.Client(),
+	}
+}
+
+// samlAttributes returns the values of the assertion's attributes by name.
+func samlAttributes(assertion *saml.Assertion) map[string][]string {
+	attributes := map[string][]string{}
+	for _, statement := range assertion.AttributeStatements {
+		for _, attribute := range statement.Attributes {
+			for _, value := range attribute.Values {
+				attributes[attribute.Name] = append(attributes[attribute.Name], value.Value)
+			}
+		}
+	}
+	return attributes
+}
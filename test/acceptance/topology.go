@@ -0,0 +1,150 @@
+package acceptance_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/gruntwork-io/terratest/modules/k8s"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes"
+)
+
+// topologyEnvVar selects the cluster topology: "single" (the default) or
+// "zones".
+const topologyEnvVar = "ZITADEL_TEST_TOPOLOGY"
+
+// zoneLabel is the well-known node label the zone topology is built on.
+const zoneLabel = corev1.LabelTopologyZone
+
+// RequireZones skips the test unless the cluster's nodes span at least want
+// zones (see ZITADEL_TEST_TOPOLOGY). It returns the zone of every node.
+func RequireZones(t *testing.T, k *k8s.KubectlOptions, want int) map[string]string {
+	t.Helper()
+
+	nodeZones := map[string]string{}
+	zones := map[string]bool{}
+	for _, node := range k8s.GetNodes(t, k) {
+		if zone := node.Labels[zoneLabel]; zone != "" {
+			nodeZones[node.Name] = zone
+			zones[zone] = true
+		}
+	}
+	if len(zones) < want {
+		t.Skipf("the cluster spans %d zones, the test needs %d; set %s=zones", len(zones), want, topologyEnvVar)
+	}
+	return nodeZones
+}
+
+// CheckZoneSpread verifies that the ZITADEL and Login pods are spread evenly
+// across the zones in nodeZones: every zone runs a pod as long as there are
+// enough replicas, and no two zones differ by more than one pod.
+func CheckZoneSpread(t *testing.T, k *k8s.KubectlOptions, nodeZones map[string]string) {
+	t.Helper()
+
+	for _, component := range []string{"start", "login"} {
+		t.Run(component, func(t *testing.T) {
+			pods := k8s.ListPods(t, k, metav1.ListOptions{
+				LabelSelector: "app.kubernetes.io/component=" + component,
+			})
+			require.NotEmpty(t, pods, "no %s pods found", component)
+
+			perZone := map[string]int{}
+			for zone := range zonesOf(nodeZones) {
+				perZone[zone] = 0
+			}
+			for _, pod := range pods {
+				zone, ok := nodeZones[pod.Spec.NodeName]
+				require.True(t, ok, "pod %s runs on node %q, which has no zone", pod.Name, pod.Spec.NodeName)
+				perZone[zone]++
+			}
+
+			lowest, highest := len(pods), 0
+			for _, n := range perZone {
+				lowest, highest = min(lowest, n), max(highest, n)
+			}
+			require.LessOrEqual(t, highest-lowest, 1, "%s pods are not spread evenly across zones: %v", component, perZone)
+		})
+	}
+}
+
+func zonesOf(nodeZones map[string]string) map[string]bool {
+	zones := map[string]bool{}
+	for _, zone := range nodeZones {
+		zones[zone] = true
+	}
+	return zones
+}
+
+// CheckDrain drains the node running a ZITADEL pod the way kubectl drain
+// does, by cordoning it and evicting the pod, and verifies that the
+// PodDisruptionBudget rendered by pdb_zitadel.yaml holds: the first eviction
+// uses up the single disruption the budget allows, so evicting a pod on any
+// other node is refused while the replacement is not ready. The node is
+// uncordoned afterwards and ZITADEL must recover its full replica count.
+//
+// ZITADEL must run with minAvailable set to one less than its replicas.
+func CheckDrain(ctx context.Context, t *testing.T, k *k8s.KubectlOptions) {
+	t.Helper()
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Minute)
+	defer cancel()
+
+	clientset, err := k8s.GetKubernetesClientFromOptionsE(t, k)
+	require.NoError(t, err, "failed to create k8s client")
+
+	pods := k8s.ListPods(t, k, metav1.ListOptions{LabelSelector: "app.kubernetes.io/component=start"})
+	require.GreaterOrEqual(t, len(pods), 2, "draining needs ZITADEL pods on at least two nodes")
+	drained := pods[0].Spec.NodeName
+
+	setUnschedulable(ctx, t, clientset, drained, true)
+	defer setUnschedulable(context.Background(), t, clientset, drained, false)
+
+	// The disruption controller may not have counted the pods as healthy
+	// yet, so the first eviction is retried until the budget allows it.
+	var lastErr error
+	err = wait.PollUntilContextTimeout(ctx, 2*time.Second, time.Minute, true, func(ctx context.Context) (bool, error) {
+		lastErr = evict(ctx, clientset, pods[0])
+		return lastErr == nil, nil
+	})
+	require.NoError(t, err, "evicting pod %s from node %s was refused: %v", pods[0].Name, drained, lastErr)
+
+	var other *corev1.Pod
+	for i := range pods[1:] {
+		if pods[1+i].Spec.NodeName != drained {
+			other = &pods[1+i]
+			break
+		}
+	}
+	require.NotNil(t, other, "all ZITADEL pods run on node %s", drained)
+	err = evict(ctx, clientset, *other)
+	require.Error(t, err, "evicting pod %s was allowed, although the budget was used up", other.Name)
+	require.True(t, apierrors.IsTooManyRequests(err), "evicting pod %s: want the PodDisruptionBudget to refuse, got: %v", other.Name, err)
+
+	setUnschedulable(ctx, t, clientset, drained, false)
+	k8s.WaitUntilDeploymentAvailable(t, k, zitadelRelease, 60, 5*time.Second)
+}
+
+// evict requests the eviction of pod through the Eviction API, which enforces
+// PodDisruptionBudgets.
+func evict(ctx context.Context, clientset *kubernetes.Clientset, pod corev1.Pod) error {
+	return clientset.PolicyV1().Evictions(pod.Namespace).Evict(ctx, &policyv1.Eviction{
+		ObjectMeta: metav1.ObjectMeta{Name: pod.Name, Namespace: pod.Namespace},
+	})
+}
+
+// setUnschedulable cordons or uncordons a node.
+func setUnschedulable(ctx context.Context, t *testing.T, clientset *kubernetes.Clientset, node string, unschedulable bool) {
+	t.Helper()
+
+	patch, err := json.Marshal(map[string]any{"spec": map[string]any{"unschedulable": unschedulable}})
+	require.NoError(t, err)
+	_, err = clientset.CoreV1().Nodes().Patch(ctx, node, types.MergePatchType, patch, metav1.PatchOptions{})
+	require.NoError(t, err, "failed to set unschedulable=%t on node %s", unschedulable, node)
+}
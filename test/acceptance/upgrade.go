@@ -0,0 +1,40 @@
+package acceptance_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/gruntwork-io/terratest/modules/k8s"
+	"github.com/stretchr/testify/require"
+)
+
+// previousChartEnvVar points at the archive of the chart version the upgrade
+// tests start from, e.g. one fetched with
+// `helm pull zitadel --repo https://charts.zitadel.com`.
+const previousChartEnvVar = "ZITADEL_TEST_PREVIOUS_CHART"
+
+// RequirePreviousChart returns the path from previousChartEnvVar, skipping
+// the test when it is unset.
+func RequirePreviousChart(t *testing.T) string {
+	t.Helper()
+
+	path := os.Getenv(previousChartEnvVar)
+	if path == "" {
+		t.Skipf("%s is not set", previousChartEnvVar)
+	}
+	_, err := os.Stat(path)
+	require.NoError(t, err, "%s", previousChartEnvVar)
+	return path
+}
+
+// secretData returns the data of the named secrets, to compare across an
+// upgrade.
+func secretData(t *testing.T, k *k8s.KubectlOptions, names ...string) map[string]map[string][]byte {
+	t.Helper()
+
+	data := map[string]map[string][]byte{}
+	for _, name := range names {
+		data[name] = k8s.GetSecret(t, k, name).Data
+	}
+	return data
+}
@@ -0,0 +1,120 @@
+package acceptance_test
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/gruntwork-io/terratest/modules/k8s"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel-charts/test/internal/testcluster"
+)
+
+// TestUpgradeFromPreviousChart installs the chart version given by
+// ZITADEL_TEST_PREVIOUS_CHART, seeds a project through the management API,
+// and upgrades to the working-tree chart. The pre-upgrade init and setup
+// hooks must run against the existing database without losing data, and the
+// masterkey and the keep-policy machine user secret must survive, so that
+// the credentials issued before the upgrade still authenticate.
+func TestUpgradeFromPreviousChart(t *testing.T) {
+	previous := RequirePreviousChart(t)
+	domain := "upgrade.127.0.0.1.sslip.io"
+	apiBaseURL := BuildAPIBaseURL(domain, httpsPort, true)
+	machineUsername := "zitadel-admin-sa"
+	projectName := "upgrade-path"
+
+	testcluster.WithNamespace(t, func(ctx context.Context, k *k8s.KubectlOptions) {
+		opts := []ZitadelOption{
+			WithExternalDomain(domain),
+			WithExternalPort(httpsPort),
+			WithMachineUser("Admin", machineUsername),
+		}
+
+		testcluster.InstallPostgres(t, k)
+		InstallZitadel(t, k, append(opts, WithChart(previous))...)
+		CheckAccessibility(ctx, t, k, apiBaseURL)
+
+		projectID := SeedProject(ctx, t, NewAPIClient(t, k, apiBaseURL, machineUsername, machineUsername+".json"), projectName)
+		secrets := []string{zitadelRelease + "-masterkey", machineUsername}
+		before := secretData(t, k, secrets...)
+
+		UpgradeZitadel(t, k, opts...)
+
+		t.Run("accessibility", func(t *testing.T) { CheckAccessibility(ctx, t, k, apiBaseURL) })
+		t.Run("secrets", func(t *testing.T) {
+			require.Equal(t, before, secretData(t, k, secrets...), "secrets changed during the upgrade")
+		})
+		t.Run("authenticated-api", func(t *testing.T) {
+			CheckAuthenticatedAPI(ctx, t, k, apiBaseURL, machineUsername, machineUsername+".json")
+		})
+		t.Run("data", func(t *testing.T) {
+			client := NewAPIClient(t, k, apiBaseURL, machineUsername, machineUsername+".json")
+			CheckProject(ctx, t, client, projectID, projectName)
+		})
+	})
+}
+
+// TestFailedUpgradeRecovery breaks upgrades of a working release in the ways
+// seen in production and verifies that each failure is contained: the init
+// or setup hook fails with a clear reason, the pods of the previous revision
+// keep serving, and `helm rollback` followed by re-applying the previous
+// revision's configuration hooks restores a release that survives a pod
+// restart and can be upgraded again. The hooks are limited to a single
+// attempt of two minutes so that every broken upgrade fails quickly.
+func TestFailedUpgradeRecovery(t *testing.T) {
+	domain := "rollback.127.0.0.1.sslip.io"
+	apiBaseURL := BuildAPIBaseURL(domain, httpsPort, true)
+	machineUsername := "zitadel-admin-sa"
+
+	testcluster.WithNamespace(t, func(ctx context.Context, k *k8s.KubectlOptions) {
+		opts := []ZitadelOption{
+			WithExternalDomain(domain),
+			WithExternalPort(httpsPort),
+			WithMachineUser("Admin", machineUsername),
+		}
+
+		testcluster.InstallPostgres(t, k)
+		InstallZitadel(t, k, opts...)
+		CheckAccessibility(ctx, t, k, apiBaseURL)
+
+		for _, tc := range []struct {
+			name     string
+			breakage ZitadelOption
+			want     string
+		}{
+			{
+				name:     "bad-image-tag",
+				breakage: WithImageTag("v0.0.0-does-not-exist"),
+				want:     "v0.0.0-does-not-exist",
+			},
+			{
+				name: "invalid-config",
+				breakage: WithConfigOverrides(map[string]any{
+					"Database": map[string]any{"Postgres": map[string]any{"Port": "not-a-port"}},
+				}),
+				want: "not-a-port",
+			},
+			{
+				name:     "unreachable-database",
+				breakage: WithDBHost("db-unreachable"),
+				want:     "db-unreachable",
+			},
+		} {
+			t.Run(tc.name, func(t *testing.T) {
+				revision, _ := ReleaseStatus(t, k)
+				err := UpgradeZitadelE(t, k, slices.Concat(opts, []ZitadelOption{WithJobLimits(0, 120), tc.breakage})...)
+				require.Error(t, err, "the broken upgrade succeeded")
+
+				CheckFailedUpgrade(ctx, t, k, apiBaseURL, tc.want)
+				CheckRollback(ctx, t, k, apiBaseURL, revision)
+			})
+		}
+
+		t.Run("upgrade-after-rollback", func(t *testing.T) {
+			UpgradeZitadel(t, k, opts...)
+			CheckAccessibility(ctx, t, k, apiBaseURL)
+			CheckAuthenticatedAPI(ctx, t, k, apiBaseURL, machineUsername, machineUsername+".json")
+		})
+	})
+}
@@ -4,16 +4,22 @@ import (
 	"fmt"
 	"path/filepath"
 	"runtime"
+	"strconv"
 	"testing"
 
 	"github.com/gruntwork-io/terratest/modules/helm"
 	"github.com/gruntwork-io/terratest/modules/k8s"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+
+	"github.com/zitadel/zitadel-charts/test/internal/testcluster"
+	"github.com/zitadel/zitadel-charts/test/values"
 )
 
-const (
-	zitadelRelease   = "zitadel-test"
-	defaultMasterkey = "x123456789012345678901234567891y"
-)
+const zitadelRelease = "zitadel-test"
 
 // ZitadelOption configures ZITADEL installation.
 type ZitadelOption func(*zitadelConfig)
@@ -41,7 +47,17 @@ type zitadelConfig struct {
 	dbSslUserCrtSecret  string
 	machineUserName     string
 	machineUserUsername string
-	additionalValues    map[string]string
+	additionalDNSName   string
+	gatewayName         string
+	gatewayNamespace    string
+	replicas            int
+	zoneSpread          bool
+	pdbMinAvailable     *int
+	chartPath           string
+	imageTag            string
+	jobBackoffLimit     *int
+	jobDeadlineSeconds  *int
+	configOverrides     map[string]any
 }
 
 // WithExternalDomain sets the external domain for ZITADEL.
@@ -64,9 +80,7 @@ func WithSelfSignedCert(additionalDNSName string) ZitadelOption {
 	return func(c *zitadelConfig) {
 		c.selfSignedCert = true
 		c.tlsEnabled = true
-		if additionalDNSName != "" {
-			c.additionalValues["zitadel.selfSignedCert.additionalDnsName"] = additionalDNSName
-		}
+		c.additionalDNSName = additionalDNSName
 	}
 }
 
@@ -144,20 +158,8 @@ func WithoutDBHost() ZitadelOption {
 func WithGateway(gatewayName, gatewayNamespace string) ZitadelOption {
 	return func(c *zitadelConfig) {
 		c.useGateway = true
-		c.additionalValues["service.appProtocol"] = ""
-		c.additionalValues["login.service.appProtocol"] = ""
-		c.additionalValues["gateway.httpRoute.enabled"] = "true"
-		c.additionalValues["gateway.httpRoute.parentRefs[0].name"] = gatewayName
-		c.additionalValues["gateway.httpRoute.parentRefs[0].namespace"] = gatewayNamespace
-		c.additionalValues["gateway.httpRoute.parentRefs[0].sectionName"] = "web"
-		c.additionalValues["gateway.grpcRoute.enabled"] = "true"
-		c.additionalValues["gateway.grpcRoute.parentRefs[0].name"] = gatewayName
-		c.additionalValues["gateway.grpcRoute.parentRefs[0].namespace"] = gatewayNamespace
-		c.additionalValues["gateway.grpcRoute.parentRefs[0].sectionName"] = "web"
-		c.additionalValues["login.gateway.httpRoute.enabled"] = "true"
-		c.additionalValues["login.gateway.httpRoute.parentRefs[0].name"] = gatewayName
-		c.additionalValues["login.gateway.httpRoute.parentRefs[0].namespace"] = gatewayNamespace
-		c.additionalValues["login.gateway.httpRoute.parentRefs[0].sectionName"] = "web"
+		c.gatewayName = gatewayName
+		c.gatewayNamespace = gatewayNamespace
 	}
 }
 
@@ -168,140 +170,315 @@ func WithExternalSecure(secure bool) ZitadelOption {
 	}
 }
 
+// WithReplicas sets the replica count of both the ZITADEL and the Login
+// deployments.
+func WithReplicas(replicas int) ZitadelOption {
+	return func(c *zitadelConfig) {
+		c.replicas = replicas
+	}
+}
+
+// WithZoneSpread adds topologySpreadConstraints that require the ZITADEL and
+// Login pods to spread evenly across the topology.kubernetes.io/zone labels
+// of the nodes.
+func WithZoneSpread() ZitadelOption {
+	return func(c *zitadelConfig) {
+		c.zoneSpread = true
+	}
+}
+
+// WithPDBMinAvailable sets minAvailable on the ZITADEL PodDisruptionBudget.
+func WithPDBMinAvailable(minAvailable int) ZitadelOption {
+	return func(c *zitadelConfig) {
+		c.pdbMinAvailable = &minAvailable
+	}
+}
+
+// WithChart installs the chart from path, e.g. the archive of a released
+// version, instead of the working tree.
+func WithChart(path string) ZitadelOption {
+	return func(c *zitadelConfig) {
+		c.chartPath = path
+	}
+}
+
+// WithImageTag overrides the ZITADEL image tag, which defaults to the chart's
+// appVersion.
+func WithImageTag(tag string) ZitadelOption {
+	return func(c *zitadelConfig) {
+		c.imageTag = tag
+	}
+}
+
+// WithDBHost sets the database host, which defaults to the service created
+// by testcluster.InstallPostgres.
+func WithDBHost(host string) ZitadelOption {
+	return func(c *zitadelConfig) {
+		c.dbHost = host
+	}
+}
+
+// WithJobLimits sets backoffLimit and activeDeadlineSeconds on the init and
+// setup jobs, so that a hook that cannot succeed fails once its attempts are
+// used up or its deadline passes, instead of retrying for up to the chart's
+// five minutes.
+func WithJobLimits(backoffLimit, activeDeadlineSeconds int) ZitadelOption {
+	return func(c *zitadelConfig) {
+		c.jobBackoffLimit = &backoffLimit
+		c.jobDeadlineSeconds = &activeDeadlineSeconds
+	}
+}
+
+// WithConfigOverrides merges overrides into the configmapConfig built from
+// the other options, replacing values at the same path.
+func WithConfigOverrides(overrides map[string]any) ZitadelOption {
+	return func(c *zitadelConfig) {
+		c.configOverrides = overrides
+	}
+}
+
 // InstallZitadel installs ZITADEL via Helm with the provided options. The chart
 // is installed from the local filesystem relative to this test file location.
 // The install blocks until all resources are ready (--wait --timeout 10m).
 func InstallZitadel(t *testing.T, k *k8s.KubectlOptions, opts ...ZitadelOption) {
 	t.Helper()
 
-	cfg := &zitadelConfig{
-		externalPort:     "443",
-		dbSSLMode:        "disable",
-		dbHost:           "db-postgresql",
-		dbUser:           "postgres",
-		dbAdminUser:      "postgres",
-		additionalValues: make(map[string]string),
+	cfg, vals := zitadelValues(t, opts)
+	options := &helm.Options{
+		KubectlOptions: k,
+		ValuesFiles:    []string{vals.WriteFile(t)},
+		ExtraArgs:      map[string][]string{"install": {"--wait", "--timeout", "10m"}},
 	}
-	for _, opt := range opts {
-		opt(cfg)
+	helm.Install(t, options, cfg.chartPath, zitadelRelease)
+}
+
+// UpgradeZitadel upgrades the release installed by InstallZitadel, with
+// values built from opts the same way. It blocks until the upgrade hooks have
+// completed and all resources are ready.
+func UpgradeZitadel(t *testing.T, k *k8s.KubectlOptions, opts ...ZitadelOption) {
+	t.Helper()
+	require.NoError(t, UpgradeZitadelE(t, k, opts...))
+}
+
+// UpgradeZitadelE is like UpgradeZitadel but returns the error of a failed
+// upgrade instead of failing the test.
+func UpgradeZitadelE(t *testing.T, k *k8s.KubectlOptions, opts ...ZitadelOption) error {
+	t.Helper()
+
+	cfg, vals := zitadelValues(t, opts)
+	options := &helm.Options{
+		KubectlOptions: k,
+		ValuesFiles:    []string{vals.WriteFile(t)},
+		ExtraArgs:      map[string][]string{"upgrade": {"--wait", "--timeout", "10m"}},
 	}
+	return helm.UpgradeE(t, options, cfg.chartPath, zitadelRelease)
+}
+
+// zitadelValues applies opts and builds the chart values from them.
+func zitadelValues(t *testing.T, opts []ZitadelOption) (*zitadelConfig, *values.Values) {
+	t.Helper()
 
 	_, filename, _, _ := runtime.Caller(0)
 	repoRoot := filepath.Join(filepath.Dir(filename), "..", "..")
-	chartPath := filepath.Join(repoRoot, "charts", "zitadel")
 
-	values := map[string]string{
-		"replicaCount":       "1",
-		"login.replicaCount": "1",
-		"pdb.enabled":        "true",
-		"metrics.enabled":    "true",
-		"login.metrics.enabled": "true",
+	cfg := &zitadelConfig{
+		externalPort: "443",
+		dbSSLMode:    "disable",
+		dbHost:       testcluster.PostgresHost,
+		dbUser:       "postgres",
+		dbAdminUser:  "postgres",
+		replicas:     1,
+		chartPath:    filepath.Join(repoRoot, "charts", "zitadel"),
+	}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
+	config := map[string]any{
+		"TLS": map[string]any{"Enabled": cfg.tlsEnabled},
+	}
+	vals := &values.Values{
+		ReplicaCount: ptr.To(cfg.replicas),
+		PDB:          &values.PDB{Enabled: ptr.To(true)},
+		Metrics:      &values.Metrics{Enabled: ptr.To(true)},
+		Ingress:      &values.Ingress{Enabled: ptr.To(!cfg.useGateway)},
+		Zitadel:      &values.Zitadel{ConfigmapConfig: config},
+		Login: &values.Login{
+			ReplicaCount: ptr.To(cfg.replicas),
+			Metrics:      &values.LoginMetrics{Enabled: ptr.To(true)},
+			Ingress:      &values.LoginIngress{Enabled: ptr.To(!cfg.useGateway)},
+		},
 	}
 
 	if cfg.useGateway {
-		values["ingress.enabled"] = "false"
-		values["login.ingress.enabled"] = "false"
-	} else {
-		values["ingress.enabled"] = "true"
-		values["login.ingress.enabled"] = "true"
+		// Clear appProtocol so the Gateway controller infers the backend
+		// protocol from the route type.
+		vals.Service = &values.Service{AppProtocol: ptr.To("")}
+		vals.Login.Service = &values.LoginService{AppProtocol: ptr.To("")}
+		vals.Gateway = &values.Gateway{
+			HTTPRoute: &values.GatewayHTTPRoute{
+				Enabled: ptr.To(true),
+				ParentRefs: []values.GatewayHTTPRouteParentRef{{
+					Name:        ptr.To(cfg.gatewayName),
+					Namespace:   ptr.To(cfg.gatewayNamespace),
+					SectionName: ptr.To("web"),
+				}},
+			},
+			GRPCRoute: &values.GatewayGRPCRoute{
+				Enabled: ptr.To(true),
+				ParentRefs: []values.GatewayGRPCRouteParentRef{{
+					Name:        ptr.To(cfg.gatewayName),
+					Namespace:   ptr.To(cfg.gatewayNamespace),
+					SectionName: ptr.To("web"),
+				}},
+			},
+		}
+		vals.Login.Gateway = &values.LoginGateway{
+			HTTPRoute: &values.LoginGatewayHTTPRoute{
+				Enabled: ptr.To(true),
+				ParentRefs: []values.LoginGatewayHTTPRouteParentRef{{
+					Name:        ptr.To(cfg.gatewayName),
+					Namespace:   ptr.To(cfg.gatewayNamespace),
+					SectionName: ptr.To("web"),
+				}},
+			},
+		}
 	}
 
-	if cfg.externalDomain != "" {
-		values["zitadel.configmapConfig.ExternalDomain"] = cfg.externalDomain
+	if cfg.zoneSpread {
+		vals.TopologySpreadConstraints = zoneSpread("zitadel", "start")
+		vals.Login.TopologySpreadConstraints = zoneSpread("zitadel-login", "login")
 	}
-	if cfg.externalPort != "" {
-		values["zitadel.configmapConfig.ExternalPort"] = cfg.externalPort
+	if cfg.pdbMinAvailable != nil {
+		vals.PDB.MinAvailable = ptr.To(intstr.FromInt32(int32(*cfg.pdbMinAvailable)))
 	}
 
-	if cfg.tlsEnabled {
-		values["zitadel.configmapConfig.TLS.Enabled"] = "true"
-	} else {
-		values["zitadel.configmapConfig.TLS.Enabled"] = "false"
+	if cfg.imageTag != "" {
+		vals.Image = &values.Image{Tag: ptr.To(cfg.imageTag)}
+	}
+	if cfg.jobBackoffLimit != nil {
+		vals.InitJob = &values.InitJob{
+			BackoffLimit:          cfg.jobBackoffLimit,
+			ActiveDeadlineSeconds: cfg.jobDeadlineSeconds,
+		}
+		vals.SetupJob = &values.SetupJob{
+			BackoffLimit:          cfg.jobBackoffLimit,
+			ActiveDeadlineSeconds: cfg.jobDeadlineSeconds,
+		}
 	}
 
+	if cfg.externalDomain != "" {
+		config["ExternalDomain"] = cfg.externalDomain
+	}
+	if cfg.externalPort != "" {
+		port, err := strconv.Atoi(cfg.externalPort)
+		require.NoError(t, err, "invalid external port %q", cfg.externalPort)
+		config["ExternalPort"] = port
+	}
 	if cfg.externalSecure != nil {
-		if *cfg.externalSecure {
-			values["zitadel.configmapConfig.ExternalSecure"] = "true"
-		} else {
-			values["zitadel.configmapConfig.ExternalSecure"] = "false"
-		}
+		config["ExternalSecure"] = *cfg.externalSecure
 	}
 
 	if cfg.selfSignedCert {
-		values["zitadel.selfSignedCert.enabled"] = "true"
-		values["service.annotations.traefik\\.ingress\\.kubernetes\\.io/service\\.serversscheme"] = "https"
+		vals.Zitadel.SelfSignedCert = &values.ZitadelSelfSignedCert{Enabled: ptr.To(true)}
+		if cfg.additionalDNSName != "" {
+			vals.Zitadel.SelfSignedCert.AdditionalDNSName = ptr.To(cfg.additionalDNSName)
+		}
+		if vals.Service == nil {
+			vals.Service = &values.Service{}
+		}
+		vals.Service.Annotations = map[string]string{
+			"traefik.ingress.kubernetes.io/service.serversscheme": "https",
+		}
 	}
 
 	if cfg.masterkeySecretName != "" {
-		values["zitadel.masterkeySecretName"] = cfg.masterkeySecretName
+		vals.Zitadel.MasterkeySecretName = ptr.To(cfg.masterkeySecretName)
 	} else {
-		values["zitadel.masterkey"] = defaultMasterkey
+		vals.Zitadel.Masterkey = ptr.To(testcluster.Masterkey)
 	}
 
 	if cfg.configSecretName != "" {
-		values["zitadel.configSecretName"] = cfg.configSecretName
+		vals.Zitadel.ConfigSecretName = ptr.To(cfg.configSecretName)
 		if cfg.configSecretKey != "" {
-			values["zitadel.configSecretKey"] = cfg.configSecretKey
+			vals.Zitadel.ConfigSecretKey = ptr.To(cfg.configSecretKey)
 		}
 	}
 
 	if cfg.dsn != "" {
 		// DSN mode: pass the connection string as an env var and skip all
 		// discrete Database.Postgres.* fields so the chart enters DSN mode.
-		values["env[0].name"] = "ZITADEL_DATABASE_POSTGRES_DSN"
-		values["env[0].value"] = cfg.dsn
+		vals.Env = []corev1.EnvVar{{Name: "ZITADEL_DATABASE_POSTGRES_DSN", Value: cfg.dsn}}
 	} else {
-		if !cfg.skipDBHost {
-			values["zitadel.configmapConfig.Database.Postgres.Host"] = cfg.dbHost
+		postgres := testcluster.PostgresConfig(cfg.dbUser, cfg.dbAdminUser, cfg.dbSSLMode)
+		if cfg.skipDBHost {
+			delete(postgres, "Host")
+		} else {
+			postgres["Host"] = cfg.dbHost
 		}
-		values["zitadel.configmapConfig.Database.Postgres.Port"] = "5432"
-		values["zitadel.configmapConfig.Database.Postgres.Database"] = "zitadel"
-		values["zitadel.configmapConfig.Database.Postgres.MaxOpenConns"] = "20"
-		values["zitadel.configmapConfig.Database.Postgres.MaxIdleConns"] = "10"
-		values["zitadel.configmapConfig.Database.Postgres.MaxConnLifetime"] = "30m"
-		values["zitadel.configmapConfig.Database.Postgres.MaxConnIdleTime"] = "5m"
-		values["zitadel.configmapConfig.Database.Postgres.User.Username"] = cfg.dbUser
-		values["zitadel.configmapConfig.Database.Postgres.User.SSL.Mode"] = cfg.dbSSLMode
-		values["zitadel.configmapConfig.Database.Postgres.Admin.Username"] = cfg.dbAdminUser
-		values["zitadel.configmapConfig.Database.Postgres.Admin.SSL.Mode"] = cfg.dbSSLMode
+		config["Database"] = map[string]any{"Postgres": postgres}
 
+		secretPostgres := map[string]any{}
 		if cfg.dbPassword != "" {
-			values["zitadel.secretConfig.Database.Postgres.User.Password"] = cfg.dbPassword
+			secretPostgres["User"] = map[string]any{"Password": cfg.dbPassword}
 		}
 		if cfg.dbAdminPassword != "" {
-			values["zitadel.secretConfig.Database.Postgres.Admin.Password"] = cfg.dbAdminPassword
+			secretPostgres["Admin"] = map[string]any{"Password": cfg.dbAdminPassword}
+		}
+		if len(secretPostgres) > 0 {
+			vals.Zitadel.SecretConfig = map[string]any{
+				"Database": map[string]any{"Postgres": secretPostgres},
+			}
 		}
 	}
 
 	if cfg.dbSslCaCrtSecret != "" {
-		values["zitadel.dbSslCaCrtSecret"] = cfg.dbSslCaCrtSecret
+		vals.Zitadel.DBSSLCaCrtSecret = ptr.To(cfg.dbSslCaCrtSecret)
 	}
 	if cfg.dbSslAdminCrtSecret != "" {
-		values["zitadel.dbSslAdminCrtSecret"] = cfg.dbSslAdminCrtSecret
+		vals.Zitadel.DBSSLAdminCrtSecret = ptr.To(cfg.dbSslAdminCrtSecret)
 	}
 	if cfg.dbSslUserCrtSecret != "" {
-		values["zitadel.dbSslUserCrtSecret"] = cfg.dbSslUserCrtSecret
+		vals.Zitadel.DBSSLUserCrtSecret = ptr.To(cfg.dbSslUserCrtSecret)
 	}
 
 	if cfg.machineUserUsername != "" {
-		values["zitadel.configmapConfig.FirstInstance.Org.Machine.Machine.Username"] = cfg.machineUserUsername
-		values["zitadel.configmapConfig.FirstInstance.Org.Machine.Machine.Name"] = cfg.machineUserName
-		values["zitadel.configmapConfig.FirstInstance.Org.Machine.MachineKey.ExpirationDate"] = "2029-01-01T00:00:00Z"
-		values["zitadel.configmapConfig.FirstInstance.Org.Machine.MachineKey.Type"] = "1"
-		values["zitadel.configmapConfig.Log.Level"] = "debug"
+		config["FirstInstance"] = testcluster.MachineUserConfig(cfg.machineUserName, cfg.machineUserUsername)
+		config["Log"] = map[string]any{"Level": "debug"}
 	}
 
-	for k, v := range cfg.additionalValues {
-		values[k] = v
-	}
+	mergeConfig(config, cfg.configOverrides)
+	return cfg, vals
+}
 
-	options := &helm.Options{
-		KubectlOptions: k,
-		SetValues:      values,
-		ExtraArgs:      map[string][]string{"install": {"--wait", "--timeout", "10m"}},
+// mergeConfig deep-merges src into dst. Nested maps are merged, any other
+// value in src replaces the one in dst.
+func mergeConfig(dst, src map[string]any) {
+	for key, value := range src {
+		srcMap, srcIsMap := value.(map[string]any)
+		dstMap, dstIsMap := dst[key].(map[string]any)
+		if srcIsMap && dstIsMap {
+			mergeConfig(dstMap, srcMap)
+			continue
+		}
+		dst[key] = value
 	}
+}
 
-	helm.Install(t, options, chartPath, zitadelRelease)
+// zoneSpread returns a constraint that spreads the pods of one chart
+// component evenly across zones, refusing to schedule a pod that would
+// unbalance them.
+func zoneSpread(name, component string) []corev1.TopologySpreadConstraint {
+	return []corev1.TopologySpreadConstraint{{
+		MaxSkew:           1,
+		TopologyKey:       zoneLabel,
+		WhenUnsatisfiable: corev1.DoNotSchedule,
+		LabelSelector: &metav1.LabelSelector{MatchLabels: map[string]string{
+			"app.kubernetes.io/name":      name,
+			"app.kubernetes.io/instance":  zitadelRelease,
+			"app.kubernetes.io/component": component,
+		}},
+	}}
 }
 
 // BuildAPIBaseURL constructs the API base URL from domain and port. It uses
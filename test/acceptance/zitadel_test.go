@@ -21,7 +21,7 @@ func TestPostgresInsecure(t *testing.T) {
 	machineUsername := "zitadel-admin-sa"
 
 	testcluster.WithNamespace(t, func(ctx context.Context, k *k8s.KubectlOptions) {
-		InstallPostgres(t, k)
+		testcluster.InstallPostgres(t, k)
 		InstallZitadel(t, k,
 			WithExternalDomain(domain),
 			WithExternalPort(httpsPort),
@@ -31,6 +31,12 @@ func TestPostgresInsecure(t *testing.T) {
 		t.Run("accessibility", func(t *testing.T) { CheckAccessibility(ctx, t, k, apiBaseURL) })
 		t.Run("metrics", func(t *testing.T) { CheckMetrics(ctx, t, k, false) })
 		t.Run("login", func(t *testing.T) { CheckLogin(t, apiBaseURL) })
+		t.Run("oidc-flow", func(t *testing.T) {
+			CheckOIDCFlow(ctx, t, k, apiBaseURL, machineUsername, machineUsername+".json")
+		})
+		t.Run("saml", func(t *testing.T) {
+			CheckSAML(ctx, t, k, apiBaseURL, machineUsername, machineUsername+".json")
+		})
 		t.Run("authenticated-api", func(t *testing.T) {
 			CheckAuthenticatedAPI(ctx, t, k, apiBaseURL, machineUsername, machineUsername+".json")
 		})
@@ -51,7 +57,7 @@ func TestPostgresDSN(t *testing.T) {
 	machineUsername := "zitadel-admin-sa"
 
 	testcluster.WithNamespace(t, func(ctx context.Context, k *k8s.KubectlOptions) {
-		InstallPostgres(t, k, WithPostgresDatabase("zitadel"))
+		testcluster.InstallPostgres(t, k, testcluster.WithPostgresDatabase("zitadel"))
 		InstallZitadel(t, k,
 			WithExternalDomain(domain),
 			WithExternalPort(httpsPort),
@@ -95,9 +101,9 @@ func TestPostgresSecure(t *testing.T) {
 		testcluster.CreateTLSSecret(t, k, "postgres-cert", ca.Cert, pgCert.Cert, pgCert.Key)
 		testcluster.CreateTLSSecret(t, k, "zitadel-cert", ca.Cert, zitadelCert.Cert, zitadelCert.Key)
 
-		InstallPostgres(t, k,
-			WithPostgresTLS("postgres-cert"),
-			WithPostgresPassword("abc"),
+		testcluster.InstallPostgres(t, k,
+			testcluster.WithPostgresTLS("postgres-cert"),
+			testcluster.WithPostgresPassword("abc"),
 		)
 		InstallZitadel(t, k,
 			WithExternalDomain(domain),
@@ -134,7 +140,7 @@ func TestReferencedSecrets(t *testing.T) {
 
 	testcluster.WithNamespace(t, func(ctx context.Context, k *k8s.KubectlOptions) {
 		testcluster.CreateOpaqueSecret(t, k, "existing-zitadel-masterkey", map[string]string{
-			"masterkey": defaultMasterkey,
+			"masterkey": testcluster.Masterkey,
 		})
 		testcluster.CreateOpaqueSecret(t, k, "existing-zitadel-secrets", map[string]string{
 			"config.yaml": `Database:
@@ -143,7 +149,7 @@ func TestReferencedSecrets(t *testing.T) {
 `,
 		})
 
-		InstallPostgres(t, k)
+		testcluster.InstallPostgres(t, k)
 		InstallZitadel(t, k,
 			WithExternalDomain(domain),
 			WithExternalPort(httpsPort),
@@ -179,7 +185,7 @@ func TestMachineUser(t *testing.T) {
 	machineUsername := "zitadel-admin-sa"
 
 	testcluster.WithNamespace(t, func(ctx context.Context, k *k8s.KubectlOptions) {
-		InstallPostgres(t, k)
+		testcluster.InstallPostgres(t, k)
 		InstallZitadel(t, k,
 			WithExternalDomain(domain),
 			WithExternalPort(httpsPort),
@@ -192,6 +198,15 @@ func TestMachineUser(t *testing.T) {
 		t.Run("authenticated-api", func(t *testing.T) {
 			CheckAuthenticatedAPI(ctx, t, k, apiBaseURL, machineUsername, machineUsername+".json")
 		})
+		t.Run("oauth-endpoints", func(t *testing.T) {
+			CheckOAuthEndpoints(ctx, t, k, apiBaseURL, machineUsername, machineUsername+".json")
+		})
+		t.Run("rpc-protocols", func(t *testing.T) {
+			CheckRPCProtocols(ctx, t, k, apiBaseURL, machineUsername, machineUsername+".json")
+		})
+		t.Run("api-fixtures", func(t *testing.T) {
+			CheckAPIFixtures(ctx, t, k, apiBaseURL, machineUsername, machineUsername+".json")
+		})
 		t.Run("uninstall", func(t *testing.T) {
 			CheckUninstall(ctx, t, k, nil)
 		})
@@ -211,7 +226,7 @@ func TestInternalTLS(t *testing.T) {
 	machineUsername := "zitadel-admin-sa"
 
 	testcluster.WithNamespace(t, func(ctx context.Context, k *k8s.KubectlOptions) {
-		InstallPostgres(t, k)
+		testcluster.InstallPostgres(t, k)
 		InstallZitadel(t, k,
 			WithExternalDomain(domain),
 			WithExternalPort(httpsPort),
@@ -222,6 +237,9 @@ func TestInternalTLS(t *testing.T) {
 		t.Run("accessibility", func(t *testing.T) { CheckAccessibility(ctx, t, k, apiBaseURL) })
 		t.Run("metrics", func(t *testing.T) { CheckMetrics(ctx, t, k, true) })
 		t.Run("login", func(t *testing.T) { CheckLogin(t, apiBaseURL) })
+		t.Run("oidc-flow", func(t *testing.T) {
+			CheckOIDCFlow(ctx, t, k, apiBaseURL, machineUsername, machineUsername+".json")
+		})
 		t.Run("authenticated-api", func(t *testing.T) {
 			CheckAuthenticatedAPI(ctx, t, k, apiBaseURL, machineUsername, machineUsername+".json")
 		})
@@ -244,7 +262,7 @@ func TestGatewayAPI(t *testing.T) {
 	machineUsername := "zitadel-admin-sa"
 
 	testcluster.WithNamespace(t, func(ctx context.Context, k *k8s.KubectlOptions) {
-		InstallPostgres(t, k)
+		testcluster.InstallPostgres(t, k)
 		InstallZitadel(t, k,
 			WithExternalDomain(domain),
 			WithExternalPort(httpPort),
@@ -255,11 +273,47 @@ func TestGatewayAPI(t *testing.T) {
 
 		t.Run("accessibility", func(t *testing.T) { CheckAccessibility(ctx, t, k, apiBaseURL) })
 		t.Run("metrics", func(t *testing.T) { CheckMetrics(ctx, t, k, false) })
+		t.Run("saml", func(t *testing.T) {
+			CheckSAML(ctx, t, k, apiBaseURL, machineUsername, machineUsername+".json")
+		})
 		t.Run("authenticated-api", func(t *testing.T) {
 			CheckAuthenticatedAPI(ctx, t, k, apiBaseURL, machineUsername, machineUsername+".json")
 		})
+		t.Run("oauth-endpoints", func(t *testing.T) {
+			CheckOAuthEndpoints(ctx, t, k, apiBaseURL, machineUsername, machineUsername+".json")
+		})
+		t.Run("rpc-protocols", func(t *testing.T) {
+			CheckRPCProtocols(ctx, t, k, apiBaseURL, machineUsername, machineUsername+".json")
+		})
 		t.Run("uninstall", func(t *testing.T) {
 			CheckUninstall(ctx, t, k, nil)
 		})
 	})
 }
+
+// TestZoneTopology validates the chart's scheduling settings on a cluster
+// whose nodes span three zones (ZITADEL_TEST_TOPOLOGY=zones). ZITADEL and
+// Login run three replicas with zone topologySpreadConstraints, which must
+// place one pod in every zone, and a PodDisruptionBudget that lets a node
+// drain evict only one ZITADEL pod at a time.
+func TestZoneTopology(t *testing.T) {
+	domain := "zones.127.0.0.1.sslip.io"
+	apiBaseURL := BuildAPIBaseURL(domain, httpsPort, true)
+
+	testcluster.WithNamespace(t, func(ctx context.Context, k *k8s.KubectlOptions) {
+		nodeZones := RequireZones(t, k, 3)
+
+		testcluster.InstallPostgres(t, k)
+		InstallZitadel(t, k,
+			WithExternalDomain(domain),
+			WithExternalPort(httpsPort),
+			WithReplicas(3),
+			WithZoneSpread(),
+			WithPDBMinAvailable(2),
+		)
+
+		t.Run("spread", func(t *testing.T) { CheckZoneSpread(t, k, nodeZones) })
+		t.Run("drain", func(t *testing.T) { CheckDrain(ctx, t, k) })
+		t.Run("accessibility", func(t *testing.T) { CheckAccessibility(ctx, t, k, apiBaseURL) })
+	})
+}
@@ -0,0 +1,316 @@
+package assert_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/zitadel/zitadel-charts/test/assert"
+)
+
+// TestMismatchesReportsEveryFieldPath verifies that the accumulating walk
+// does not stop at the first mismatch and records the full path of each one.
+func TestMismatchesReportsEveryFieldPath(t *testing.T) {
+	t.Parallel()
+
+	actual := &appsv1.Deployment{
+		Spec: appsv1.DeploymentSpec{
+			Replicas: assert.Ptr(int32(1)),
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{{
+						Name: "zitadel",
+						SecurityContext: &corev1.SecurityContext{
+							RunAsUser: assert.Ptr(int64(1000)),
+						},
+					}},
+				},
+			},
+		},
+	}
+	actual.Labels = map[string]string{"app": "zitadel"}
+
+	mismatches := assert.Mismatches(actual, assert.DeploymentAssertion{
+		ObjectMeta: assert.ObjectMetaAssertion{
+			Labels: assert.Some(map[string]string{"app": "zitadel", "team": "iam"}),
+		},
+		Spec: assert.DeploymentSpecAssertion{
+			Replicas: assert.SomePtr(int32(2)),
+			Template: assert.PodTemplateSpecAssertion{
+				Spec: assert.PodSpecAssertion{
+					Containers: assert.Some([]assert.ContainerAssertion{
+						{
+							Name: assert.Some("zitadel"),
+							SecurityContext: assert.SecurityContextAssertion{
+								RunAsUser: assert.SomePtr(int64(1001)),
+							},
+						},
+						{Name: assert.Some("sidecar")},
+					}),
+				},
+			},
+		},
+	})
+
+	paths := make([]string, 0, len(mismatches))
+	for _, m := range mismatches {
+		paths = append(paths, m.Path)
+	}
+	require.Equal(t, []string{
+		"ObjectMeta.Labels[team]",
+		"Spec.Replicas",
+		"Spec.Template.Spec.Containers",
+		"Spec.Template.Spec.Containers[0].SecurityContext.RunAsUser",
+	}, paths)
+}
+
+// TestMismatchStringRendersYAMLDiff verifies that value mismatches render as
+// an uncoloured unified YAML diff when NO_COLOR is set.
+func TestMismatchStringRendersYAMLDiff(t *testing.T) {
+	t.Setenv("NO_COLOR", "1")
+
+	mismatches := assert.Mismatches(&corev1.Container{
+		Resources: corev1.ResourceRequirements{
+			Limits: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("512Mi")},
+		},
+	}, assert.ContainerAssertion{
+		Resources: assert.ResourceRequirementsAssertion{
+			Limits: assert.Some(corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("1Gi")}),
+		},
+	})
+
+	require.Len(t, mismatches, 1)
+	require.Equal(t, "Resources.Limits[memory]: mismatch\n"+
+		"  --- expected\n"+
+		"  +++ actual\n"+
+		"  @@ -1 +1 @@\n"+
+		"  -1Gi\n"+
+		"  +512Mi", mismatches[0].String())
+}
+
+// TestMismatchStringColours verifies that diffs are coloured on a terminal
+// or if ColorEnvVar asks for colour, and that NO_COLOR wins over both.
+func TestMismatchStringColours(t *testing.T) {
+	diff := func() string {
+		mismatches := assert.Mismatches(&corev1.Container{Image: "zitadel:v2"},
+			assert.ContainerAssertion{Image: assert.Some("zitadel:v3")})
+		require.Len(t, mismatches, 1)
+		return mismatches[0].String()
+	}
+	const coloured = "\x1b[31m-zitadel:v3\x1b[0m"
+
+	t.Setenv("NO_COLOR", "")
+	t.Setenv(assert.ColorEnvVar, "")
+	assert.SetTerminal(t, false)
+	require.NotContains(t, diff(), "\x1b[")
+
+	assert.SetTerminal(t, true)
+	require.Contains(t, diff(), coloured)
+
+	assert.SetTerminal(t, false)
+	t.Setenv(assert.ColorEnvVar, "1")
+	require.Contains(t, diff(), coloured)
+
+	assert.SetTerminal(t, true)
+	t.Setenv("NO_COLOR", "1")
+	require.NotContains(t, diff(), "\x1b[")
+}
+
+// TestSliceMatchModes verifies how each slice constructor pairs expected
+// elements with actual ones.
+func TestSliceMatchModes(t *testing.T) {
+	t.Parallel()
+
+	container := &corev1.Container{
+		Env: []corev1.EnvVar{
+			{Name: "A", Value: "1"},
+			{Name: "B", Value: "2"},
+			{Name: "C", Value: "3"},
+		},
+	}
+	env := func(name, value string) assert.EnvVarAssertion {
+		return assert.EnvVarAssertion{Name: assert.Some(name), Value: assert.Some(value)}
+	}
+	paths := func(opt assert.Opt[[]assert.EnvVarAssertion]) []string {
+		var paths []string
+		for _, m := range assert.Mismatches(container, assert.ContainerAssertion{Env: opt}) {
+			paths = append(paths, m.Path)
+		}
+		return paths
+	}
+
+	testCases := []struct {
+		name  string
+		opt   assert.Opt[[]assert.EnvVarAssertion]
+		paths []string
+	}{
+		{
+			name:  "some-is-positional",
+			opt:   assert.Some([]assert.EnvVarAssertion{env("B", "2")}),
+			paths: []string{"Env", "Env[0].Name", "Env[0].Value"},
+		},
+		{
+			name: "contains-elements-ignores-order-and-extras",
+			opt:  assert.ContainsElements(env("C", "3"), env("A", "1")),
+		},
+		{
+			name:  "contains-elements-reports-unmatched",
+			opt:   assert.ContainsElements(env("C", "4")),
+			paths: []string{"Env"},
+		},
+		{
+			name:  "consists-of-requires-equal-length",
+			opt:   assert.ConsistsOf(env("C", "3"), env("A", "1")),
+			paths: []string{"Env"},
+		},
+		{
+			name: "consists-of-ignores-order",
+			opt:  assert.ConsistsOf(env("C", "3"), env("A", "1"), env("B", "2")),
+		},
+		{
+			name: "by-key-compares-named-element",
+			opt:  assert.ByKey(assert.EnvVarAssertion{Name: assert.Some("B"), Value: assert.Some("2")}),
+		},
+		{
+			name:  "by-key-reports-value-and-missing-key",
+			opt:   assert.ByKey(env("B", "9"), env("D", "4")),
+			paths: []string{"Env[Name=B].Value", "Env[Name=D]"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			require.Equal(t, tc.paths, paths(tc.opt))
+		})
+	}
+}
+
+// TestByKeyRequiresKey verifies that ByKey rejects elements without a key.
+func TestByKeyRequiresKey(t *testing.T) {
+	t.Parallel()
+
+	require.Panics(t, func() {
+		assert.ByKey(assert.EnvVarAssertion{Value: assert.Some("1")})
+	})
+	require.Panics(t, func() {
+		assert.ByKey(assert.PodSpecAssertion{})
+	})
+}
+
+// TestBuilderCompilesToAssertionStruct verifies that a fluent builder yields
+// an assertion struct the partial assertion engine accepts, including keyed
+// elements that are revisited after other elements were appended.
+func TestBuilderCompilesToAssertionStruct(t *testing.T) {
+	t.Parallel()
+
+	d := assert.Deployment()
+	d.Container("zitadel").Image("zitadel")
+	d.InitContainer("wait").Image("busybox")
+	d.Container("login").Image("login")
+	d.Container("zitadel").SecurityContext().RunAsUser(1000)
+	built := d.Build()
+
+	containers := *built.Spec.Template.Spec.Containers.Val
+	require.Len(t, containers, 2)
+	require.Equal(t, "zitadel", *containers[0].Name.Val)
+	require.Equal(t, int64(1000), **containers[0].SecurityContext.RunAsUser.Val)
+
+	actual := &appsv1.Deployment{
+		Spec: appsv1.DeploymentSpec{
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					InitContainers: []corev1.Container{{Name: "wait", Image: "busybox"}},
+					Containers: []corev1.Container{
+						{Name: "login", Image: "login"},
+						{Name: "sidecar"},
+						{Name: "zitadel", Image: "zitadel", SecurityContext: &corev1.SecurityContext{RunAsUser: assert.Ptr(int64(1000))}},
+					},
+				},
+			},
+		},
+	}
+	require.Empty(t, assert.Mismatches(actual, built))
+
+	actual.Spec.Template.Spec.Containers[2].SecurityContext.RunAsUser = assert.Ptr(int64(0))
+	mismatches := assert.Mismatches(actual, built)
+	require.Len(t, mismatches, 1)
+	require.Equal(t, "Spec.Template.Spec.Containers[Name=zitadel].SecurityContext.RunAsUser", mismatches[0].Path)
+}
+
+// TestNegativeAssertions verifies Absent, Unset, NotContains and
+// NotContainsKeys against present and missing values.
+func TestNegativeAssertions(t *testing.T) {
+	t.Parallel()
+
+	container := &corev1.Container{
+		Name:         "zitadel",
+		Args:         []string{"start"},
+		StartupProbe: &corev1.Probe{PeriodSeconds: 1},
+		Env:          []corev1.EnvVar{{Name: "A", Value: "1"}},
+	}
+	pod := &corev1.PodSpec{NodeSelector: map[string]string{"zone": "a"}}
+
+	testCases := []struct {
+		name      string
+		actual    any
+		assertion assert.Assertable
+		paths     []string
+	}{
+		{
+			name:      "unset-passes-on-nil-pointer",
+			actual:    container,
+			assertion: assert.ContainerAssertion{SecurityContext: assert.Unset[assert.SecurityContextAssertion]()},
+		},
+		{
+			name:      "unset-fails-on-set-pointer",
+			actual:    container,
+			assertion: assert.ContainerAssertion{StartupProbe: assert.Unset[assert.CoreProbeAssertion]()},
+			paths:     []string{"StartupProbe"},
+		},
+		{
+			name:      "absent-passes-on-empty-slice",
+			actual:    container,
+			assertion: assert.ContainerAssertion{Command: assert.Absent[[]string]()},
+		},
+		{
+			name:      "absent-fails-on-set-value",
+			actual:    container,
+			assertion: assert.ContainerAssertion{Name: assert.Absent[string](), Args: assert.Absent[[]string]()},
+			paths:     []string{"Name", "Args"},
+		},
+		{
+			name:   "not-contains-matches-partially",
+			actual: container,
+			assertion: assert.ContainerAssertion{
+				Env: assert.NotContains(assert.EnvVarAssertion{Name: assert.Some("A")}, assert.EnvVarAssertion{Name: assert.Some("B")}),
+			},
+			paths: []string{"Env[0]"},
+		},
+		{
+			name:      "not-contains-compares-plain-elements",
+			actual:    container,
+			assertion: assert.ContainerAssertion{Args: assert.NotContains("stop")},
+		},
+		{
+			name:      "not-contains-keys",
+			actual:    pod,
+			assertion: assert.PodSpecAssertion{NodeSelector: assert.NotContainsKeys[string]("zone", "region")},
+			paths:     []string{"NodeSelector[zone]"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			var paths []string
+			for _, m := range assert.Mismatches(tc.actual, tc.assertion) {
+				paths = append(paths, m.Path)
+			}
+			require.Equal(t, tc.paths, paths)
+		})
+	}
+}
@@ -0,0 +1,34 @@
+package assert
+
+// Shortcuts for the workload builders, which otherwise need
+// Spec().Template().Spec() to reach the pod spec.
+
+// PodSpec descends into the pod template spec.
+func (b DeploymentBuilder[R]) PodSpec() PodSpecBuilder[R] {
+	return b.Spec().Template().Spec()
+}
+
+// Container descends into the pod template container with the given name.
+func (b DeploymentBuilder[R]) Container(name string) ContainerBuilder[R] {
+	return b.PodSpec().Container(name)
+}
+
+// InitContainer descends into the pod template init container with the given name.
+func (b DeploymentBuilder[R]) InitContainer(name string) ContainerBuilder[R] {
+	return b.PodSpec().InitContainer(name)
+}
+
+// PodSpec descends into the pod template spec.
+func (b JobBuilder[R]) PodSpec() PodSpecBuilder[R] {
+	return b.Spec().Template().Spec()
+}
+
+// Container descends into the pod template container with the given name.
+func (b JobBuilder[R]) Container(name string) ContainerBuilder[R] {
+	return b.PodSpec().Container(name)
+}
+
+// InitContainer descends into the pod template init container with the given name.
+func (b JobBuilder[R]) InitContainer(name string) ContainerBuilder[R] {
+	return b.PodSpec().InitContainer(name)
+}
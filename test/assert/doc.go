@@ -2,5 +2,9 @@
 // types. Assertion structs use Opt[T] to mark which fields should be compared,
 // skipping any field left at zero value.
 //
-//go:generate go run ../../internal/gen/assertgen -out zz_generated.go -package assert
+// For the resource kinds the chart renders, fluent builders such as
+// Deployment().Container("zitadel").SecurityContext().RunAsUser(1000).Build()
+// fill in the same assertion structs without spelling out every level.
+//
+//go:generate go run ../../internal/gen/assertgen -out zz_generated.go -builders-out zz_generated_builders.go -package assert
 package assert
@@ -0,0 +1,11 @@
+package assert
+
+import "testing"
+
+// SetTerminal makes diffs behave as if stdout were a terminal or not until
+// the test ends.
+func SetTerminal(t *testing.T, terminal bool) {
+	previous := isTerminal
+	isTerminal = func() bool { return terminal }
+	t.Cleanup(func() { isTerminal = previous })
+}
@@ -4,11 +4,16 @@ package assert
 
 import (
 	"fmt"
+	"os"
 	"reflect"
+	"sort"
+	"strings"
 	"testing"
 
 	types "github.com/onsi/gomega/types"
+	"github.com/pmezard/go-difflib/difflib"
 	v1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	tassert "github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	v12 "k8s.io/api/apps/v1"
 	v2 "k8s.io/api/autoscaling/v2"
@@ -24,15 +29,22 @@ import (
 	intstr "k8s.io/apimachinery/pkg/util/intstr"
 	watch "k8s.io/apimachinery/pkg/watch"
 	v18 "sigs.k8s.io/gateway-api/apis/v1"
+	"sigs.k8s.io/yaml"
 	"time"
 )
 
 // Opt wraps an optional value for assertion comparison.
 // Val holds a concrete expected value; Matcher holds a gomega matcher.
 // If Matcher is set it takes precedence over Val.
+// The constructor used decides how Val is compared: for slices of assertion
+// structs Some, ContainsElements, ConsistsOf and ByKey pair elements
+// differently, while Absent, NotContains and NotContainsKeys assert that
+// something is missing.
 type Opt[T any] struct {
 	Val     *T
 	Matcher types.GomegaMatcher
+
+	match matchMode
 }
 
 // Some creates an Opt with a set value.
@@ -59,16 +71,26 @@ type APIServerConfigAssertion struct {
 	Authorization   AuthorizationAssertion
 	BearerToken     Opt[string]
 	ProxyConfig     ProxyConfigAssertion
+
+	absent bool
 }
 
 func (_ APIServerConfigAssertion) isAssertable() {}
+func (a *APIServerConfigAssertion) markAbsent() {
+	a.absent = true
+}
 
 // AlertingSpecAssertion is the assertion struct for AlertingSpec.
 type AlertingSpecAssertion struct {
 	Alertmanagers Opt[[]AlertmanagerEndpointsAssertion]
+
+	absent bool
 }
 
 func (_ AlertingSpecAssertion) isAssertable() {}
+func (a *AlertingSpecAssertion) markAbsent() {
+	a.absent = true
+}
 
 // AlertmanagerAssertion is the assertion struct for Alertmanager.
 type AlertmanagerAssertion struct {
@@ -76,25 +98,40 @@ type AlertmanagerAssertion struct {
 	ObjectMeta ObjectMetaAssertion
 	Spec       AlertmanagerSpecAssertion
 	Status     Opt[v1.AlertmanagerStatus]
+
+	absent bool
 }
 
 func (_ AlertmanagerAssertion) isAssertable() {}
+func (a *AlertmanagerAssertion) markAbsent() {
+	a.absent = true
+}
 
 // AlertmanagerConfigMatcherStrategyAssertion is the assertion struct for AlertmanagerConfigMatcherStrategy.
 type AlertmanagerConfigMatcherStrategyAssertion struct {
 	Type Opt[v1.AlertmanagerConfigMatcherStrategyType]
+
+	absent bool
 }
 
 func (_ AlertmanagerConfigMatcherStrategyAssertion) isAssertable() {}
+func (a *AlertmanagerConfigMatcherStrategyAssertion) markAbsent() {
+	a.absent = true
+}
 
 // AlertmanagerConfigurationAssertion is the assertion struct for AlertmanagerConfiguration.
 type AlertmanagerConfigurationAssertion struct {
 	Name      Opt[string]
 	Global    AlertmanagerGlobalConfigAssertion
 	Templates Opt[[]SecretOrConfigMapAssertion]
+
+	absent bool
 }
 
 func (_ AlertmanagerConfigurationAssertion) isAssertable() {}
+func (a *AlertmanagerConfigurationAssertion) markAbsent() {
+	a.absent = true
+}
 
 // AlertmanagerEndpointsAssertion is the assertion struct for AlertmanagerEndpoints.
 type AlertmanagerEndpointsAssertion struct {
@@ -114,9 +151,14 @@ type AlertmanagerEndpointsAssertion struct {
 	EnableHttp2         Opt[*bool]
 	RelabelConfigs      Opt[[]RelabelConfigAssertion]
 	AlertRelabelConfigs Opt[[]RelabelConfigAssertion]
+
+	absent bool
 }
 
 func (_ AlertmanagerEndpointsAssertion) isAssertable() {}
+func (a *AlertmanagerEndpointsAssertion) markAbsent() {
+	a.absent = true
+}
 
 // AlertmanagerGlobalConfigAssertion is the assertion struct for AlertmanagerGlobalConfig.
 type AlertmanagerGlobalConfigAssertion struct {
@@ -133,17 +175,27 @@ type AlertmanagerGlobalConfigAssertion struct {
 	RocketChatConfig    GlobalRocketChatConfigAssertion
 	WebexConfig         GlobalWebexConfigAssertion
 	WeChatConfig        GlobalWeChatConfigAssertion
+
+	absent bool
 }
 
 func (_ AlertmanagerGlobalConfigAssertion) isAssertable() {}
+func (a *AlertmanagerGlobalConfigAssertion) markAbsent() {
+	a.absent = true
+}
 
 // AlertmanagerLimitsSpecAssertion is the assertion struct for AlertmanagerLimitsSpec.
 type AlertmanagerLimitsSpecAssertion struct {
 	MaxSilences        Opt[*int32]
 	MaxPerSilenceBytes Opt[*v1.ByteSize]
+
+	absent bool
 }
 
 func (_ AlertmanagerLimitsSpecAssertion) isAssertable() {}
+func (a *AlertmanagerLimitsSpecAssertion) markAbsent() {
+	a.absent = true
+}
 
 // AlertmanagerSpecAssertion is the assertion struct for AlertmanagerSpec.
 type AlertmanagerSpecAssertion struct {
@@ -209,48 +261,78 @@ type AlertmanagerSpecAssertion struct {
 	AdditionalArgs                       Opt[[]ArgumentAssertion]
 	TerminationGracePeriodSeconds        Opt[*int64]
 	HostUsers                            Opt[*bool]
+
+	absent bool
 }
 
 func (_ AlertmanagerSpecAssertion) isAssertable() {}
+func (a *AlertmanagerSpecAssertion) markAbsent() {
+	a.absent = true
+}
 
 // AlertmanagerWebSpecAssertion is the assertion struct for AlertmanagerWebSpec.
 type AlertmanagerWebSpecAssertion struct {
 	WebConfigFileFields WebConfigFileFieldsAssertion
 	GetConcurrency      Opt[*uint32]
 	Timeout             Opt[*uint32]
+
+	absent bool
 }
 
 func (_ AlertmanagerWebSpecAssertion) isAssertable() {}
+func (a *AlertmanagerWebSpecAssertion) markAbsent() {
+	a.absent = true
+}
 
 // ArbitraryFSAccessThroughSMsConfigAssertion is the assertion struct for ArbitraryFSAccessThroughSMsConfig.
 type ArbitraryFSAccessThroughSMsConfigAssertion struct {
 	Deny Opt[bool]
+
+	absent bool
 }
 
 func (_ ArbitraryFSAccessThroughSMsConfigAssertion) isAssertable() {}
+func (a *ArbitraryFSAccessThroughSMsConfigAssertion) markAbsent() {
+	a.absent = true
+}
 
 // ArgumentAssertion is the assertion struct for Argument.
 type ArgumentAssertion struct {
 	Name  Opt[string]
 	Value Opt[string]
+
+	absent bool
 }
 
 func (_ ArgumentAssertion) isAssertable() {}
+func (a *ArgumentAssertion) markAbsent() {
+	a.absent = true
+}
 
 // AttachMetadataAssertion is the assertion struct for AttachMetadata.
 type AttachMetadataAssertion struct {
 	Node Opt[*bool]
+
+	absent bool
 }
 
 func (_ AttachMetadataAssertion) isAssertable() {}
+func (a *AttachMetadataAssertion) markAbsent() {
+	a.absent = true
+}
 
 // AuthorizationAssertion is the assertion struct for Authorization.
 type AuthorizationAssertion struct {
 	SafeAuthorization SafeAuthorizationAssertion
 	CredentialsFile   Opt[string]
+
+	absent bool
 }
 
 func (_ AuthorizationAssertion) isAssertable() {}
+func (a *AuthorizationAssertion) markAbsent() {
+	a.absent = true
+}
 
 // AzureADAssertion is the assertion struct for AzureAD.
 type AzureADAssertion struct {
@@ -260,49 +342,79 @@ type AzureADAssertion struct {
 	SDK              AzureSDKAssertion
 	WorkloadIdentity AzureWorkloadIdentityAssertion
 	Scope            Opt[*string]
+
+	absent bool
 }
 
 func (_ AzureADAssertion) isAssertable() {}
+func (a *AzureADAssertion) markAbsent() {
+	a.absent = true
+}
 
 // AzureOAuthAssertion is the assertion struct for AzureOAuth.
 type AzureOAuthAssertion struct {
 	ClientID     Opt[string]
 	ClientSecret SecretKeySelectorAssertion
 	TenantID     Opt[string]
+
+	absent bool
 }
 
 func (_ AzureOAuthAssertion) isAssertable() {}
+func (a *AzureOAuthAssertion) markAbsent() {
+	a.absent = true
+}
 
 // AzureSDKAssertion is the assertion struct for AzureSDK.
 type AzureSDKAssertion struct {
 	TenantID Opt[*string]
+
+	absent bool
 }
 
 func (_ AzureSDKAssertion) isAssertable() {}
+func (a *AzureSDKAssertion) markAbsent() {
+	a.absent = true
+}
 
 // AzureWorkloadIdentityAssertion is the assertion struct for AzureWorkloadIdentity.
 type AzureWorkloadIdentityAssertion struct {
 	ClientID Opt[string]
 	TenantID Opt[string]
+
+	absent bool
 }
 
 func (_ AzureWorkloadIdentityAssertion) isAssertable() {}
+func (a *AzureWorkloadIdentityAssertion) markAbsent() {
+	a.absent = true
+}
 
 // BasicAuthAssertion is the assertion struct for BasicAuth.
 type BasicAuthAssertion struct {
 	Username SecretKeySelectorAssertion
 	Password SecretKeySelectorAssertion
+
+	absent bool
 }
 
 func (_ BasicAuthAssertion) isAssertable() {}
+func (a *BasicAuthAssertion) markAbsent() {
+	a.absent = true
+}
 
 // ClusterTLSConfigAssertion is the assertion struct for ClusterTLSConfig.
 type ClusterTLSConfigAssertion struct {
 	ServerTLS WebTLSConfigAssertion
 	ClientTLS SafeTLSConfigAssertion
+
+	absent bool
 }
 
 func (_ ClusterTLSConfigAssertion) isAssertable() {}
+func (a *ClusterTLSConfigAssertion) markAbsent() {
+	a.absent = true
+}
 
 // CommonPrometheusFieldsAssertion is the assertion struct for CommonPrometheusFields.
 type CommonPrometheusFieldsAssertion struct {
@@ -407,9 +519,14 @@ type CommonPrometheusFieldsAssertion struct {
 	Runtime                              RuntimeConfigAssertion
 	TerminationGracePeriodSeconds        Opt[*int64]
 	HostUsers                            Opt[*bool]
+
+	absent bool
 }
 
 func (_ CommonPrometheusFieldsAssertion) isAssertable() {}
+func (a *CommonPrometheusFieldsAssertion) markAbsent() {
+	a.absent = true
+}
 
 // MonitoringConditionAssertion is the assertion struct for Condition.
 type MonitoringConditionAssertion struct {
@@ -419,9 +536,14 @@ type MonitoringConditionAssertion struct {
 	Reason             Opt[string]
 	Message            Opt[string]
 	ObservedGeneration Opt[int64]
+
+	absent bool
 }
 
 func (_ MonitoringConditionAssertion) isAssertable() {}
+func (a *MonitoringConditionAssertion) markAbsent() {
+	a.absent = true
+}
 
 // ConfigResourceConditionAssertion is the assertion struct for ConfigResourceCondition.
 type ConfigResourceConditionAssertion struct {
@@ -431,9 +553,14 @@ type ConfigResourceConditionAssertion struct {
 	Reason             Opt[string]
 	Message            Opt[string]
 	ObservedGeneration Opt[int64]
+
+	absent bool
 }
 
 func (_ ConfigResourceConditionAssertion) isAssertable() {}
+func (a *ConfigResourceConditionAssertion) markAbsent() {
+	a.absent = true
+}
 
 // CoreV1TopologySpreadConstraintAssertion is the assertion struct for CoreV1TopologySpreadConstraint.
 type CoreV1TopologySpreadConstraintAssertion struct {
@@ -445,18 +572,28 @@ type CoreV1TopologySpreadConstraintAssertion struct {
 	NodeAffinityPolicy Opt[*v11.NodeInclusionPolicy]
 	NodeTaintsPolicy   Opt[*v11.NodeInclusionPolicy]
 	MatchLabelKeys     Opt[[]string]
+
+	absent bool
 }
 
 func (_ CoreV1TopologySpreadConstraintAssertion) isAssertable() {}
+func (a *CoreV1TopologySpreadConstraintAssertion) markAbsent() {
+	a.absent = true
+}
 
 // EmbeddedObjectMetadataAssertion is the assertion struct for EmbeddedObjectMetadata.
 type EmbeddedObjectMetadataAssertion struct {
 	Name        Opt[string]
 	Labels      Opt[map[string]string]
 	Annotations Opt[map[string]string]
+
+	absent bool
 }
 
 func (_ EmbeddedObjectMetadataAssertion) isAssertable() {}
+func (a *EmbeddedObjectMetadataAssertion) markAbsent() {
+	a.absent = true
+}
 
 // EmbeddedPersistentVolumeClaimAssertion is the assertion struct for EmbeddedPersistentVolumeClaim.
 type EmbeddedPersistentVolumeClaimAssertion struct {
@@ -464,9 +601,14 @@ type EmbeddedPersistentVolumeClaimAssertion struct {
 	EmbeddedObjectMetadata EmbeddedObjectMetadataAssertion
 	Spec                   PersistentVolumeClaimSpecAssertion
 	Status                 Opt[v11.PersistentVolumeClaimStatus]
+
+	absent bool
 }
 
 func (_ EmbeddedPersistentVolumeClaimAssertion) isAssertable() {}
+func (a *EmbeddedPersistentVolumeClaimAssertion) markAbsent() {
+	a.absent = true
+}
 
 // EndpointAssertion is the assertion struct for Endpoint.
 type EndpointAssertion struct {
@@ -485,32 +627,52 @@ type EndpointAssertion struct {
 	FilterRunning                  Opt[*bool]
 	BearerTokenFile                Opt[string]
 	HTTPConfigWithProxyAndTLSFiles HTTPConfigWithProxyAndTLSFilesAssertion
+
+	absent bool
 }
 
 func (_ EndpointAssertion) isAssertable() {}
+func (a *EndpointAssertion) markAbsent() {
+	a.absent = true
+}
 
 // ExemplarsAssertion is the assertion struct for Exemplars.
 type ExemplarsAssertion struct {
 	MaxSize Opt[*int64]
+
+	absent bool
 }
 
 func (_ ExemplarsAssertion) isAssertable() {}
+func (a *ExemplarsAssertion) markAbsent() {
+	a.absent = true
+}
 
 // GlobalJiraConfigAssertion is the assertion struct for GlobalJiraConfig.
 type GlobalJiraConfigAssertion struct {
 	APIURL Opt[*v1.URL]
+
+	absent bool
 }
 
 func (_ GlobalJiraConfigAssertion) isAssertable() {}
+func (a *GlobalJiraConfigAssertion) markAbsent() {
+	a.absent = true
+}
 
 // GlobalRocketChatConfigAssertion is the assertion struct for GlobalRocketChatConfig.
 type GlobalRocketChatConfigAssertion struct {
 	APIURL  Opt[*v1.URL]
 	Token   SecretKeySelectorAssertion
 	TokenID SecretKeySelectorAssertion
+
+	absent bool
 }
 
 func (_ GlobalRocketChatConfigAssertion) isAssertable() {}
+func (a *GlobalRocketChatConfigAssertion) markAbsent() {
+	a.absent = true
+}
 
 // GlobalSMTPConfigAssertion is the assertion struct for GlobalSMTPConfig.
 type GlobalSMTPConfigAssertion struct {
@@ -523,72 +685,117 @@ type GlobalSMTPConfigAssertion struct {
 	AuthSecret   SecretKeySelectorAssertion
 	RequireTLS   Opt[*bool]
 	TLSConfig    SafeTLSConfigAssertion
+
+	absent bool
 }
 
 func (_ GlobalSMTPConfigAssertion) isAssertable() {}
+func (a *GlobalSMTPConfigAssertion) markAbsent() {
+	a.absent = true
+}
 
 // GlobalTelegramConfigAssertion is the assertion struct for GlobalTelegramConfig.
 type GlobalTelegramConfigAssertion struct {
 	APIURL Opt[*v1.URL]
+
+	absent bool
 }
 
 func (_ GlobalTelegramConfigAssertion) isAssertable() {}
+func (a *GlobalTelegramConfigAssertion) markAbsent() {
+	a.absent = true
+}
 
 // GlobalVictorOpsConfigAssertion is the assertion struct for GlobalVictorOpsConfig.
 type GlobalVictorOpsConfigAssertion struct {
 	APIURL Opt[*v1.URL]
 	APIKey SecretKeySelectorAssertion
+
+	absent bool
 }
 
 func (_ GlobalVictorOpsConfigAssertion) isAssertable() {}
+func (a *GlobalVictorOpsConfigAssertion) markAbsent() {
+	a.absent = true
+}
 
 // GlobalWeChatConfigAssertion is the assertion struct for GlobalWeChatConfig.
 type GlobalWeChatConfigAssertion struct {
 	APIURL    Opt[*v1.URL]
 	APISecret SecretKeySelectorAssertion
 	APICorpID Opt[*string]
+
+	absent bool
 }
 
 func (_ GlobalWeChatConfigAssertion) isAssertable() {}
+func (a *GlobalWeChatConfigAssertion) markAbsent() {
+	a.absent = true
+}
 
 // GlobalWebexConfigAssertion is the assertion struct for GlobalWebexConfig.
 type GlobalWebexConfigAssertion struct {
 	APIURL Opt[*v1.URL]
+
+	absent bool
 }
 
 func (_ GlobalWebexConfigAssertion) isAssertable() {}
+func (a *GlobalWebexConfigAssertion) markAbsent() {
+	a.absent = true
+}
 
 // HTTPConfigAssertion is the assertion struct for HTTPConfig.
 type HTTPConfigAssertion struct {
 	HTTPConfigWithoutTLS HTTPConfigWithoutTLSAssertion
 	TLSConfig            SafeTLSConfigAssertion
+
+	absent bool
 }
 
 func (_ HTTPConfigAssertion) isAssertable() {}
+func (a *HTTPConfigAssertion) markAbsent() {
+	a.absent = true
+}
 
 // HTTPConfigWithProxyAssertion is the assertion struct for HTTPConfigWithProxy.
 type HTTPConfigWithProxyAssertion struct {
 	HTTPConfig  HTTPConfigAssertion
 	ProxyConfig ProxyConfigAssertion
+
+	absent bool
 }
 
 func (_ HTTPConfigWithProxyAssertion) isAssertable() {}
+func (a *HTTPConfigWithProxyAssertion) markAbsent() {
+	a.absent = true
+}
 
 // HTTPConfigWithProxyAndTLSFilesAssertion is the assertion struct for HTTPConfigWithProxyAndTLSFiles.
 type HTTPConfigWithProxyAndTLSFilesAssertion struct {
 	HTTPConfigWithTLSFiles HTTPConfigWithTLSFilesAssertion
 	ProxyConfig            ProxyConfigAssertion
+
+	absent bool
 }
 
 func (_ HTTPConfigWithProxyAndTLSFilesAssertion) isAssertable() {}
+func (a *HTTPConfigWithProxyAndTLSFilesAssertion) markAbsent() {
+	a.absent = true
+}
 
 // HTTPConfigWithTLSFilesAssertion is the assertion struct for HTTPConfigWithTLSFiles.
 type HTTPConfigWithTLSFilesAssertion struct {
 	HTTPConfigWithoutTLS HTTPConfigWithoutTLSAssertion
 	TLSConfig            MonitoringTLSConfigAssertion
+
+	absent bool
 }
 
 func (_ HTTPConfigWithTLSFilesAssertion) isAssertable() {}
+func (a *HTTPConfigWithTLSFilesAssertion) markAbsent() {
+	a.absent = true
+}
 
 // HTTPConfigWithoutTLSAssertion is the assertion struct for HTTPConfigWithoutTLS.
 type HTTPConfigWithoutTLSAssertion struct {
@@ -598,49 +805,79 @@ type HTTPConfigWithoutTLSAssertion struct {
 	BearerTokenSecret SecretKeySelectorAssertion
 	FollowRedirects   Opt[*bool]
 	EnableHTTP2       Opt[*bool]
+
+	absent bool
 }
 
 func (_ HTTPConfigWithoutTLSAssertion) isAssertable() {}
+func (a *HTTPConfigWithoutTLSAssertion) markAbsent() {
+	a.absent = true
+}
 
 // MonitoringHostAliasAssertion is the assertion struct for HostAlias.
 type MonitoringHostAliasAssertion struct {
 	IP        Opt[string]
 	Hostnames Opt[[]string]
+
+	absent bool
 }
 
 func (_ MonitoringHostAliasAssertion) isAssertable() {}
+func (a *MonitoringHostAliasAssertion) markAbsent() {
+	a.absent = true
+}
 
 // HostPortAssertion is the assertion struct for HostPort.
 type HostPortAssertion struct {
 	Host Opt[string]
 	Port Opt[string]
+
+	absent bool
 }
 
 func (_ HostPortAssertion) isAssertable() {}
+func (a *HostPortAssertion) markAbsent() {
+	a.absent = true
+}
 
 // ManagedIdentityAssertion is the assertion struct for ManagedIdentity.
 type ManagedIdentityAssertion struct {
 	ClientID Opt[*string]
+
+	absent bool
 }
 
 func (_ ManagedIdentityAssertion) isAssertable() {}
+func (a *ManagedIdentityAssertion) markAbsent() {
+	a.absent = true
+}
 
 // MetadataConfigAssertion is the assertion struct for MetadataConfig.
 type MetadataConfigAssertion struct {
 	Send              Opt[bool]
 	SendInterval      Opt[v1.Duration]
 	MaxSamplesPerSend Opt[*int32]
+
+	absent bool
 }
 
 func (_ MetadataConfigAssertion) isAssertable() {}
+func (a *MetadataConfigAssertion) markAbsent() {
+	a.absent = true
+}
 
 // NamespaceSelectorAssertion is the assertion struct for NamespaceSelector.
 type NamespaceSelectorAssertion struct {
 	Any        Opt[bool]
 	MatchNames Opt[[]string]
+
+	absent bool
 }
 
 func (_ NamespaceSelectorAssertion) isAssertable() {}
+func (a *NamespaceSelectorAssertion) markAbsent() {
+	a.absent = true
+}
 
 // NativeHistogramConfigAssertion is the assertion struct for NativeHistogramConfig.
 type NativeHistogramConfigAssertion struct {
@@ -649,9 +886,14 @@ type NativeHistogramConfigAssertion struct {
 	NativeHistogramBucketLimit     Opt[*uint64]
 	NativeHistogramMinBucketFactor QuantityAssertion
 	ConvertClassicHistogramsToNHCB Opt[*bool]
+
+	absent bool
 }
 
 func (_ NativeHistogramConfigAssertion) isAssertable() {}
+func (a *NativeHistogramConfigAssertion) markAbsent() {
+	a.absent = true
+}
 
 // OAuth2Assertion is the assertion struct for OAuth2.
 type OAuth2Assertion struct {
@@ -662,9 +904,14 @@ type OAuth2Assertion struct {
 	EndpointParams Opt[map[string]string]
 	TLSConfig      SafeTLSConfigAssertion
 	ProxyConfig    ProxyConfigAssertion
+
+	absent bool
 }
 
 func (_ OAuth2Assertion) isAssertable() {}
+func (a *OAuth2Assertion) markAbsent() {
+	a.absent = true
+}
 
 // OTLPConfigAssertion is the assertion struct for OTLPConfig.
 type OTLPConfigAssertion struct {
@@ -675,9 +922,14 @@ type OTLPConfigAssertion struct {
 	KeepIdentifyingResourceAttributes Opt[*bool]
 	ConvertHistogramsToNHCB           Opt[*bool]
 	PromoteScopeMetadata              Opt[*bool]
+
+	absent bool
 }
 
 func (_ OTLPConfigAssertion) isAssertable() {}
+func (a *OTLPConfigAssertion) markAbsent() {
+	a.absent = true
+}
 
 // MonitoringObjectReferenceAssertion is the assertion struct for ObjectReference.
 type MonitoringObjectReferenceAssertion struct {
@@ -685,26 +937,41 @@ type MonitoringObjectReferenceAssertion struct {
 	Resource  Opt[string]
 	Namespace Opt[string]
 	Name      Opt[string]
+
+	absent bool
 }
 
 func (_ MonitoringObjectReferenceAssertion) isAssertable() {}
+func (a *MonitoringObjectReferenceAssertion) markAbsent() {
+	a.absent = true
+}
 
 // MonitoringPodDNSConfigAssertion is the assertion struct for PodDNSConfig.
 type MonitoringPodDNSConfigAssertion struct {
 	Nameservers Opt[[]string]
 	Searches    Opt[[]string]
 	Options     Opt[[]MonitoringPodDNSConfigOptionAssertion]
+
+	absent bool
 }
 
 func (_ MonitoringPodDNSConfigAssertion) isAssertable() {}
+func (a *MonitoringPodDNSConfigAssertion) markAbsent() {
+	a.absent = true
+}
 
 // MonitoringPodDNSConfigOptionAssertion is the assertion struct for PodDNSConfigOption.
 type MonitoringPodDNSConfigOptionAssertion struct {
 	Name  Opt[string]
 	Value Opt[*string]
+
+	absent bool
 }
 
 func (_ MonitoringPodDNSConfigOptionAssertion) isAssertable() {}
+func (a *MonitoringPodDNSConfigOptionAssertion) markAbsent() {
+	a.absent = true
+}
 
 // PodMetricsEndpointAssertion is the assertion struct for PodMetricsEndpoint.
 type PodMetricsEndpointAssertion struct {
@@ -723,9 +990,14 @@ type PodMetricsEndpointAssertion struct {
 	RelabelConfigs           Opt[[]RelabelConfigAssertion]
 	FilterRunning            Opt[*bool]
 	HTTPConfigWithProxy      HTTPConfigWithProxyAssertion
+
+	absent bool
 }
 
 func (_ PodMetricsEndpointAssertion) isAssertable() {}
+func (a *PodMetricsEndpointAssertion) markAbsent() {
+	a.absent = true
+}
 
 // PodMonitorAssertion is the assertion struct for PodMonitor.
 type PodMonitorAssertion struct {
@@ -733,9 +1005,14 @@ type PodMonitorAssertion struct {
 	ObjectMeta ObjectMetaAssertion
 	Spec       PodMonitorSpecAssertion
 	Status     Opt[v1.ConfigResourceStatus]
+
+	absent bool
 }
 
 func (_ PodMonitorAssertion) isAssertable() {}
+func (a *PodMonitorAssertion) markAbsent() {
+	a.absent = true
+}
 
 // PodMonitorSpecAssertion is the assertion struct for PodMonitorSpec.
 type PodMonitorSpecAssertion struct {
@@ -757,9 +1034,14 @@ type PodMonitorSpecAssertion struct {
 	AttachMetadata         AttachMetadataAssertion
 	ScrapeClassName        Opt[*string]
 	BodySizeLimit          Opt[*v1.ByteSize]
+
+	absent bool
 }
 
 func (_ PodMonitorSpecAssertion) isAssertable() {}
+func (a *PodMonitorSpecAssertion) markAbsent() {
+	a.absent = true
+}
 
 // MonitoringProbeAssertion is the assertion struct for Probe.
 type MonitoringProbeAssertion struct {
@@ -767,17 +1049,27 @@ type MonitoringProbeAssertion struct {
 	ObjectMeta ObjectMetaAssertion
 	Spec       ProbeSpecAssertion
 	Status     Opt[v1.ConfigResourceStatus]
+
+	absent bool
 }
 
 func (_ MonitoringProbeAssertion) isAssertable() {}
+func (a *MonitoringProbeAssertion) markAbsent() {
+	a.absent = true
+}
 
 // ProbeParamAssertion is the assertion struct for ProbeParam.
 type ProbeParamAssertion struct {
 	Name   Opt[string]
 	Values Opt[[]string]
+
+	absent bool
 }
 
 func (_ ProbeParamAssertion) isAssertable() {}
+func (a *ProbeParamAssertion) markAbsent() {
+	a.absent = true
+}
 
 // ProbeSpecAssertion is the assertion struct for ProbeSpec.
 type ProbeSpecAssertion struct {
@@ -801,35 +1093,55 @@ type ProbeSpecAssertion struct {
 	ScrapeClassName        Opt[*string]
 	Params                 Opt[[]ProbeParamAssertion]
 	HTTPConfig             HTTPConfigAssertion
+
+	absent bool
 }
 
 func (_ ProbeSpecAssertion) isAssertable() {}
+func (a *ProbeSpecAssertion) markAbsent() {
+	a.absent = true
+}
 
 // ProbeTargetIngressAssertion is the assertion struct for ProbeTargetIngress.
 type ProbeTargetIngressAssertion struct {
 	Selector          LabelSelectorAssertion
 	NamespaceSelector NamespaceSelectorAssertion
 	RelabelConfigs    Opt[[]RelabelConfigAssertion]
+
+	absent bool
 }
 
 func (_ ProbeTargetIngressAssertion) isAssertable() {}
+func (a *ProbeTargetIngressAssertion) markAbsent() {
+	a.absent = true
+}
 
 // ProbeTargetStaticConfigAssertion is the assertion struct for ProbeTargetStaticConfig.
 type ProbeTargetStaticConfigAssertion struct {
 	Targets        Opt[[]string]
 	Labels         Opt[map[string]string]
 	RelabelConfigs Opt[[]RelabelConfigAssertion]
+
+	absent bool
 }
 
 func (_ ProbeTargetStaticConfigAssertion) isAssertable() {}
+func (a *ProbeTargetStaticConfigAssertion) markAbsent() {
+	a.absent = true
+}
 
 // ProbeTargetsAssertion is the assertion struct for ProbeTargets.
 type ProbeTargetsAssertion struct {
 	StaticConfig ProbeTargetStaticConfigAssertion
 	Ingress      ProbeTargetIngressAssertion
+
+	absent bool
 }
 
 func (_ ProbeTargetsAssertion) isAssertable() {}
+func (a *ProbeTargetsAssertion) markAbsent() {
+	a.absent = true
+}
 
 // ProberSpecAssertion is the assertion struct for ProberSpec.
 type ProberSpecAssertion struct {
@@ -837,9 +1149,14 @@ type ProberSpecAssertion struct {
 	Scheme      Opt[*v1.Scheme]
 	Path        Opt[string]
 	ProxyConfig ProxyConfigAssertion
+
+	absent bool
 }
 
 func (_ ProberSpecAssertion) isAssertable() {}
+func (a *ProberSpecAssertion) markAbsent() {
+	a.absent = true
+}
 
 // PrometheusAssertion is the assertion struct for Prometheus.
 type PrometheusAssertion struct {
@@ -847,9 +1164,14 @@ type PrometheusAssertion struct {
 	ObjectMeta ObjectMetaAssertion
 	Spec       PrometheusSpecAssertion
 	Status     Opt[v1.PrometheusStatus]
+
+	absent bool
 }
 
 func (_ PrometheusAssertion) isAssertable() {}
+func (a *PrometheusAssertion) markAbsent() {
+	a.absent = true
+}
 
 // PrometheusRuleAssertion is the assertion struct for PrometheusRule.
 type PrometheusRuleAssertion struct {
@@ -857,24 +1179,39 @@ type PrometheusRuleAssertion struct {
 	ObjectMeta ObjectMetaAssertion
 	Spec       PrometheusRuleSpecAssertion
 	Status     Opt[v1.ConfigResourceStatus]
+
+	absent bool
 }
 
 func (_ PrometheusRuleAssertion) isAssertable() {}
+func (a *PrometheusRuleAssertion) markAbsent() {
+	a.absent = true
+}
 
 // PrometheusRuleExcludeConfigAssertion is the assertion struct for PrometheusRuleExcludeConfig.
 type PrometheusRuleExcludeConfigAssertion struct {
 	RuleNamespace Opt[string]
 	RuleName      Opt[string]
+
+	absent bool
 }
 
 func (_ PrometheusRuleExcludeConfigAssertion) isAssertable() {}
+func (a *PrometheusRuleExcludeConfigAssertion) markAbsent() {
+	a.absent = true
+}
 
 // PrometheusRuleSpecAssertion is the assertion struct for PrometheusRuleSpec.
 type PrometheusRuleSpecAssertion struct {
 	Groups Opt[[]RuleGroupAssertion]
+
+	absent bool
 }
 
 func (_ PrometheusRuleSpecAssertion) isAssertable() {}
+func (a *PrometheusRuleSpecAssertion) markAbsent() {
+	a.absent = true
+}
 
 // PrometheusSpecAssertion is the assertion struct for PrometheusSpec.
 type PrometheusSpecAssertion struct {
@@ -902,18 +1239,28 @@ type PrometheusSpecAssertion struct {
 	EvaluationInterval                 Opt[v1.Duration]
 	RuleQueryOffset                    Opt[*v1.Duration]
 	EnableAdminAPI                     Opt[bool]
+
+	absent bool
 }
 
 func (_ PrometheusSpecAssertion) isAssertable() {}
+func (a *PrometheusSpecAssertion) markAbsent() {
+	a.absent = true
+}
 
 // PrometheusWebSpecAssertion is the assertion struct for PrometheusWebSpec.
 type PrometheusWebSpecAssertion struct {
 	WebConfigFileFields WebConfigFileFieldsAssertion
 	PageTitle           Opt[*string]
 	MaxConnections      Opt[*int32]
+
+	absent bool
 }
 
 func (_ PrometheusWebSpecAssertion) isAssertable() {}
+func (a *PrometheusWebSpecAssertion) markAbsent() {
+	a.absent = true
+}
 
 // ProxyConfigAssertion is the assertion struct for ProxyConfig.
 type ProxyConfigAssertion struct {
@@ -921,9 +1268,14 @@ type ProxyConfigAssertion struct {
 	NoProxy              Opt[*string]
 	ProxyFromEnvironment Opt[*bool]
 	ProxyConnectHeader   Opt[map[string][]v11.SecretKeySelector]
+
+	absent bool
 }
 
 func (_ ProxyConfigAssertion) isAssertable() {}
+func (a *ProxyConfigAssertion) markAbsent() {
+	a.absent = true
+}
 
 // QuerySpecAssertion is the assertion struct for QuerySpec.
 type QuerySpecAssertion struct {
@@ -931,9 +1283,14 @@ type QuerySpecAssertion struct {
 	MaxConcurrency Opt[*int32]
 	MaxSamples     Opt[*int32]
 	Timeout        Opt[*v1.Duration]
+
+	absent bool
 }
 
 func (_ QuerySpecAssertion) isAssertable() {}
+func (a *QuerySpecAssertion) markAbsent() {
+	a.absent = true
+}
 
 // QueueConfigAssertion is the assertion struct for QueueConfig.
 type QueueConfigAssertion struct {
@@ -947,9 +1304,14 @@ type QueueConfigAssertion struct {
 	MaxBackoff        Opt[*v1.Duration]
 	RetryOnRateLimit  Opt[bool]
 	SampleAgeLimit    Opt[*v1.Duration]
+
+	absent bool
 }
 
 func (_ QueueConfigAssertion) isAssertable() {}
+func (a *QueueConfigAssertion) markAbsent() {
+	a.absent = true
+}
 
 // RelabelConfigAssertion is the assertion struct for RelabelConfig.
 type RelabelConfigAssertion struct {
@@ -960,9 +1322,14 @@ type RelabelConfigAssertion struct {
 	Modulus      Opt[uint64]
 	Replacement  Opt[*string]
 	Action       Opt[string]
+
+	absent bool
 }
 
 func (_ RelabelConfigAssertion) isAssertable() {}
+func (a *RelabelConfigAssertion) markAbsent() {
+	a.absent = true
+}
 
 // RemoteReadSpecAssertion is the assertion struct for RemoteReadSpec.
 type RemoteReadSpecAssertion struct {
@@ -981,9 +1348,14 @@ type RemoteReadSpecAssertion struct {
 	ProxyConfig          ProxyConfigAssertion
 	FollowRedirects      Opt[*bool]
 	FilterExternalLabels Opt[*bool]
+
+	absent bool
 }
 
 func (_ RemoteReadSpecAssertion) isAssertable() {}
+func (a *RemoteReadSpecAssertion) markAbsent() {
+	a.absent = true
+}
 
 // RemoteWriteSpecAssertion is the assertion struct for RemoteWriteSpec.
 type RemoteWriteSpecAssertion struct {
@@ -1009,23 +1381,38 @@ type RemoteWriteSpecAssertion struct {
 	MetadataConfig       MetadataConfigAssertion
 	EnableHttp2          Opt[*bool]
 	RoundRobinDNS        Opt[*bool]
+
+	absent bool
 }
 
 func (_ RemoteWriteSpecAssertion) isAssertable() {}
+func (a *RemoteWriteSpecAssertion) markAbsent() {
+	a.absent = true
+}
 
 // RetainConfigAssertion is the assertion struct for RetainConfig.
 type RetainConfigAssertion struct {
 	RetentionPeriod Opt[v1.Duration]
+
+	absent bool
 }
 
 func (_ RetainConfigAssertion) isAssertable() {}
+func (a *RetainConfigAssertion) markAbsent() {
+	a.absent = true
+}
 
 // MonitoringRollingUpdateStatefulSetStrategyAssertion is the assertion struct for RollingUpdateStatefulSetStrategy.
 type MonitoringRollingUpdateStatefulSetStrategyAssertion struct {
 	MaxUnavailable IntOrStringAssertion
+
+	absent bool
 }
 
 func (_ MonitoringRollingUpdateStatefulSetStrategyAssertion) isAssertable() {}
+func (a *MonitoringRollingUpdateStatefulSetStrategyAssertion) markAbsent() {
+	a.absent = true
+}
 
 // RuleAssertion is the assertion struct for Rule.
 type RuleAssertion struct {
@@ -1036,9 +1423,14 @@ type RuleAssertion struct {
 	KeepFiringFor Opt[*v1.NonEmptyDuration]
 	Labels        Opt[map[string]string]
 	Annotations   Opt[map[string]string]
+
+	absent bool
 }
 
 func (_ RuleAssertion) isAssertable() {}
+func (a *RuleAssertion) markAbsent() {
+	a.absent = true
+}
 
 // RuleGroupAssertion is the assertion struct for RuleGroup.
 type RuleGroupAssertion struct {
@@ -1049,40 +1441,65 @@ type RuleGroupAssertion struct {
 	Rules                   Opt[[]RuleAssertion]
 	PartialResponseStrategy Opt[string]
 	Limit                   Opt[*int]
+
+	absent bool
 }
 
 func (_ RuleGroupAssertion) isAssertable() {}
+func (a *RuleGroupAssertion) markAbsent() {
+	a.absent = true
+}
 
 // RulesAssertion is the assertion struct for Rules.
 type RulesAssertion struct {
 	Alert RulesAlertAssertion
+
+	absent bool
 }
 
 func (_ RulesAssertion) isAssertable() {}
+func (a *RulesAssertion) markAbsent() {
+	a.absent = true
+}
 
 // RulesAlertAssertion is the assertion struct for RulesAlert.
 type RulesAlertAssertion struct {
 	ForOutageTolerance Opt[string]
 	ForGracePeriod     Opt[string]
 	ResendDelay        Opt[string]
+
+	absent bool
 }
 
 func (_ RulesAlertAssertion) isAssertable() {}
+func (a *RulesAlertAssertion) markAbsent() {
+	a.absent = true
+}
 
 // RuntimeConfigAssertion is the assertion struct for RuntimeConfig.
 type RuntimeConfigAssertion struct {
 	GoGC Opt[*int32]
+
+	absent bool
 }
 
 func (_ RuntimeConfigAssertion) isAssertable() {}
+func (a *RuntimeConfigAssertion) markAbsent() {
+	a.absent = true
+}
 
 // SafeAuthorizationAssertion is the assertion struct for SafeAuthorization.
 type SafeAuthorizationAssertion struct {
 	Type        Opt[string]
 	Credentials SecretKeySelectorAssertion
+
+	absent bool
 }
 
 func (_ SafeAuthorizationAssertion) isAssertable() {}
+func (a *SafeAuthorizationAssertion) markAbsent() {
+	a.absent = true
+}
 
 // SafeTLSConfigAssertion is the assertion struct for SafeTLSConfig.
 type SafeTLSConfigAssertion struct {
@@ -1093,17 +1510,27 @@ type SafeTLSConfigAssertion struct {
 	InsecureSkipVerify Opt[*bool]
 	MinVersion         Opt[*v1.TLSVersion]
 	MaxVersion         Opt[*v1.TLSVersion]
+
+	absent bool
 }
 
 func (_ SafeTLSConfigAssertion) isAssertable() {}
+func (a *SafeTLSConfigAssertion) markAbsent() {
+	a.absent = true
+}
 
 // MonitoringSchemeGroupVersionAssertion is the assertion struct for GroupVersion.
 type MonitoringSchemeGroupVersionAssertion struct {
 	Group   Opt[string]
 	Version Opt[string]
+
+	absent bool
 }
 
 func (_ MonitoringSchemeGroupVersionAssertion) isAssertable() {}
+func (a *MonitoringSchemeGroupVersionAssertion) markAbsent() {
+	a.absent = true
+}
 
 // ScrapeClassAssertion is the assertion struct for ScrapeClass.
 type ScrapeClassAssertion struct {
@@ -1115,17 +1542,27 @@ type ScrapeClassAssertion struct {
 	Relabelings            Opt[[]RelabelConfigAssertion]
 	MetricRelabelings      Opt[[]RelabelConfigAssertion]
 	AttachMetadata         AttachMetadataAssertion
+
+	absent bool
 }
 
 func (_ ScrapeClassAssertion) isAssertable() {}
+func (a *ScrapeClassAssertion) markAbsent() {
+	a.absent = true
+}
 
 // SecretOrConfigMapAssertion is the assertion struct for SecretOrConfigMap.
 type SecretOrConfigMapAssertion struct {
 	Secret    SecretKeySelectorAssertion
 	ConfigMap ConfigMapKeySelectorAssertion
+
+	absent bool
 }
 
 func (_ SecretOrConfigMapAssertion) isAssertable() {}
+func (a *SecretOrConfigMapAssertion) markAbsent() {
+	a.absent = true
+}
 
 // ServiceMonitorAssertion is the assertion struct for ServiceMonitor.
 type ServiceMonitorAssertion struct {
@@ -1133,9 +1570,14 @@ type ServiceMonitorAssertion struct {
 	ObjectMeta ObjectMetaAssertion
 	Spec       ServiceMonitorSpecAssertion
 	Status     Opt[v1.ConfigResourceStatus]
+
+	absent bool
 }
 
 func (_ ServiceMonitorAssertion) isAssertable() {}
+func (a *ServiceMonitorAssertion) markAbsent() {
+	a.absent = true
+}
 
 // ServiceMonitorSpecAssertion is the assertion struct for ServiceMonitorSpec.
 type ServiceMonitorSpecAssertion struct {
@@ -1159,17 +1601,27 @@ type ServiceMonitorSpecAssertion struct {
 	ScrapeClassName        Opt[*string]
 	BodySizeLimit          Opt[*v1.ByteSize]
 	ServiceDiscoveryRole   Opt[*v1.ServiceDiscoveryRole]
+
+	absent bool
 }
 
 func (_ ServiceMonitorSpecAssertion) isAssertable() {}
+func (a *ServiceMonitorSpecAssertion) markAbsent() {
+	a.absent = true
+}
 
 // ShardRetentionPolicyAssertion is the assertion struct for ShardRetentionPolicy.
 type ShardRetentionPolicyAssertion struct {
 	WhenScaled Opt[*v1.WhenScaledRetentionType]
 	Retain     RetainConfigAssertion
+
+	absent bool
 }
 
 func (_ ShardRetentionPolicyAssertion) isAssertable() {}
+func (a *ShardRetentionPolicyAssertion) markAbsent() {
+	a.absent = true
+}
 
 // Sigv4Assertion is the assertion struct for Sigv4.
 type Sigv4Assertion struct {
@@ -1179,17 +1631,27 @@ type Sigv4Assertion struct {
 	Profile            Opt[string]
 	RoleArn            Opt[string]
 	UseFIPSSTSEndpoint Opt[*bool]
+
+	absent bool
 }
 
 func (_ Sigv4Assertion) isAssertable() {}
+func (a *Sigv4Assertion) markAbsent() {
+	a.absent = true
+}
 
 // MonitoringStatefulSetUpdateStrategyAssertion is the assertion struct for StatefulSetUpdateStrategy.
 type MonitoringStatefulSetUpdateStrategyAssertion struct {
 	Type          Opt[v1.StatefulSetUpdateStrategyType]
 	RollingUpdate MonitoringRollingUpdateStatefulSetStrategyAssertion
+
+	absent bool
 }
 
 func (_ MonitoringStatefulSetUpdateStrategyAssertion) isAssertable() {}
+func (a *MonitoringStatefulSetUpdateStrategyAssertion) markAbsent() {
+	a.absent = true
+}
 
 // StorageSpecAssertion is the assertion struct for StorageSpec.
 type StorageSpecAssertion struct {
@@ -1197,33 +1659,53 @@ type StorageSpecAssertion struct {
 	EmptyDir            EmptyDirVolumeSourceAssertion
 	Ephemeral           EphemeralVolumeSourceAssertion
 	VolumeClaimTemplate EmbeddedPersistentVolumeClaimAssertion
+
+	absent bool
 }
 
 func (_ StorageSpecAssertion) isAssertable() {}
+func (a *StorageSpecAssertion) markAbsent() {
+	a.absent = true
+}
 
 // MonitoringTLSConfigAssertion is the assertion struct for TLSConfig.
 type MonitoringTLSConfigAssertion struct {
 	SafeTLSConfig  SafeTLSConfigAssertion
 	TLSFilesConfig TLSFilesConfigAssertion
+
+	absent bool
 }
 
 func (_ MonitoringTLSConfigAssertion) isAssertable() {}
+func (a *MonitoringTLSConfigAssertion) markAbsent() {
+	a.absent = true
+}
 
 // TLSFilesConfigAssertion is the assertion struct for TLSFilesConfig.
 type TLSFilesConfigAssertion struct {
 	CAFile   Opt[string]
 	CertFile Opt[string]
 	KeyFile  Opt[string]
+
+	absent bool
 }
 
 func (_ TLSFilesConfigAssertion) isAssertable() {}
+func (a *TLSFilesConfigAssertion) markAbsent() {
+	a.absent = true
+}
 
 // TSDBSpecAssertion is the assertion struct for TSDBSpec.
 type TSDBSpecAssertion struct {
 	OutOfOrderTimeWindow Opt[*v1.Duration]
+
+	absent bool
 }
 
 func (_ TSDBSpecAssertion) isAssertable() {}
+func (a *TSDBSpecAssertion) markAbsent() {
+	a.absent = true
+}
 
 // ThanosRulerAssertion is the assertion struct for ThanosRuler.
 type ThanosRulerAssertion struct {
@@ -1231,9 +1713,14 @@ type ThanosRulerAssertion struct {
 	ObjectMeta ObjectMetaAssertion
 	Spec       ThanosRulerSpecAssertion
 	Status     Opt[v1.ThanosRulerStatus]
+
+	absent bool
 }
 
 func (_ ThanosRulerAssertion) isAssertable() {}
+func (a *ThanosRulerAssertion) markAbsent() {
+	a.absent = true
+}
 
 // ThanosRulerSpecAssertion is the assertion struct for ThanosRulerSpec.
 type ThanosRulerSpecAssertion struct {
@@ -1303,16 +1790,26 @@ type ThanosRulerSpecAssertion struct {
 	TerminationGracePeriodSeconds      Opt[*int64]
 	EnableFeatures                     Opt[[]v1.EnableFeature]
 	HostUsers                          Opt[*bool]
+
+	absent bool
 }
 
 func (_ ThanosRulerSpecAssertion) isAssertable() {}
+func (a *ThanosRulerSpecAssertion) markAbsent() {
+	a.absent = true
+}
 
 // ThanosRulerWebSpecAssertion is the assertion struct for ThanosRulerWebSpec.
 type ThanosRulerWebSpecAssertion struct {
 	WebConfigFileFields WebConfigFileFieldsAssertion
+
+	absent bool
 }
 
 func (_ ThanosRulerWebSpecAssertion) isAssertable() {}
+func (a *ThanosRulerWebSpecAssertion) markAbsent() {
+	a.absent = true
+}
 
 // ThanosSpecAssertion is the assertion struct for ThanosSpec.
 type ThanosSpecAssertion struct {
@@ -1339,17 +1836,27 @@ type ThanosSpecAssertion struct {
 	GetConfigTimeout        Opt[v1.Duration]
 	VolumeMounts            Opt[[]VolumeMountAssertion]
 	AdditionalArgs          Opt[[]ArgumentAssertion]
+
+	absent bool
 }
 
 func (_ ThanosSpecAssertion) isAssertable() {}
+func (a *ThanosSpecAssertion) markAbsent() {
+	a.absent = true
+}
 
 // MonitoringTopologySpreadConstraintAssertion is the assertion struct for TopologySpreadConstraint.
 type MonitoringTopologySpreadConstraintAssertion struct {
 	CoreV1TopologySpreadConstraint CoreV1TopologySpreadConstraintAssertion
 	AdditionalLabelSelectors       Opt[*v1.AdditionalLabelSelectors]
+
+	absent bool
 }
 
 func (_ MonitoringTopologySpreadConstraintAssertion) isAssertable() {}
+func (a *MonitoringTopologySpreadConstraintAssertion) markAbsent() {
+	a.absent = true
+}
 
 // TracingConfigAssertion is the assertion struct for TracingConfig.
 type TracingConfigAssertion struct {
@@ -1361,25 +1868,40 @@ type TracingConfigAssertion struct {
 	Compression      Opt[*string]
 	Timeout          Opt[*v1.Duration]
 	TLSConfig        MonitoringTLSConfigAssertion
+
+	absent bool
 }
 
 func (_ TracingConfigAssertion) isAssertable() {}
+func (a *TracingConfigAssertion) markAbsent() {
+	a.absent = true
+}
 
 // WebConfigFileFieldsAssertion is the assertion struct for WebConfigFileFields.
 type WebConfigFileFieldsAssertion struct {
 	TLSConfig  WebTLSConfigAssertion
 	HTTPConfig WebHTTPConfigAssertion
+
+	absent bool
 }
 
 func (_ WebConfigFileFieldsAssertion) isAssertable() {}
+func (a *WebConfigFileFieldsAssertion) markAbsent() {
+	a.absent = true
+}
 
 // WebHTTPConfigAssertion is the assertion struct for WebHTTPConfig.
 type WebHTTPConfigAssertion struct {
 	HTTP2   Opt[*bool]
 	Headers WebHTTPHeadersAssertion
+
+	absent bool
 }
 
 func (_ WebHTTPConfigAssertion) isAssertable() {}
+func (a *WebHTTPConfigAssertion) markAbsent() {
+	a.absent = true
+}
 
 // WebHTTPHeadersAssertion is the assertion struct for WebHTTPHeaders.
 type WebHTTPHeadersAssertion struct {
@@ -1388,9 +1910,14 @@ type WebHTTPHeadersAssertion struct {
 	XContentTypeOptions     Opt[string]
 	XXSSProtection          Opt[string]
 	StrictTransportSecurity Opt[string]
+
+	absent bool
 }
 
 func (_ WebHTTPHeadersAssertion) isAssertable() {}
+func (a *WebHTTPHeadersAssertion) markAbsent() {
+	a.absent = true
+}
 
 // WebTLSConfigAssertion is the assertion struct for WebTLSConfig.
 type WebTLSConfigAssertion struct {
@@ -1406,9 +1933,14 @@ type WebTLSConfigAssertion struct {
 	CipherSuites             Opt[[]string]
 	PreferServerCipherSuites Opt[*bool]
 	CurvePreferences         Opt[[]string]
+
+	absent bool
 }
 
 func (_ WebTLSConfigAssertion) isAssertable() {}
+func (a *WebTLSConfigAssertion) markAbsent() {
+	a.absent = true
+}
 
 // WorkloadBindingAssertion is the assertion struct for WorkloadBinding.
 type WorkloadBindingAssertion struct {
@@ -1417,9 +1949,14 @@ type WorkloadBindingAssertion struct {
 	Name       Opt[string]
 	Namespace  Opt[string]
 	Conditions Opt[[]ConfigResourceConditionAssertion]
+
+	absent bool
 }
 
 func (_ WorkloadBindingAssertion) isAssertable() {}
+func (a *WorkloadBindingAssertion) markAbsent() {
+	a.absent = true
+}
 
 // ControllerRevisionAssertion is the assertion struct for ControllerRevision.
 type ControllerRevisionAssertion struct {
@@ -1427,9 +1964,14 @@ type ControllerRevisionAssertion struct {
 	ObjectMeta ObjectMetaAssertion
 	Data       Opt[runtime.RawExtension]
 	Revision   Opt[int64]
+
+	absent bool
 }
 
 func (_ ControllerRevisionAssertion) isAssertable() {}
+func (a *ControllerRevisionAssertion) markAbsent() {
+	a.absent = true
+}
 
 // DaemonSetAssertion is the assertion struct for DaemonSet.
 type DaemonSetAssertion struct {
@@ -1437,9 +1979,14 @@ type DaemonSetAssertion struct {
 	ObjectMeta ObjectMetaAssertion
 	Spec       DaemonSetSpecAssertion
 	Status     Opt[v12.DaemonSetStatus]
+
+	absent bool
 }
 
 func (_ DaemonSetAssertion) isAssertable() {}
+func (a *DaemonSetAssertion) markAbsent() {
+	a.absent = true
+}
 
 // DaemonSetConditionAssertion is the assertion struct for DaemonSetCondition.
 type DaemonSetConditionAssertion struct {
@@ -1448,9 +1995,14 @@ type DaemonSetConditionAssertion struct {
 	LastTransitionTime TimeAssertion
 	Reason             Opt[string]
 	Message            Opt[string]
+
+	absent bool
 }
 
 func (_ DaemonSetConditionAssertion) isAssertable() {}
+func (a *DaemonSetConditionAssertion) markAbsent() {
+	a.absent = true
+}
 
 // DaemonSetSpecAssertion is the assertion struct for DaemonSetSpec.
 type DaemonSetSpecAssertion struct {
@@ -1459,17 +2011,27 @@ type DaemonSetSpecAssertion struct {
 	UpdateStrategy       DaemonSetUpdateStrategyAssertion
 	MinReadySeconds      Opt[int32]
 	RevisionHistoryLimit Opt[*int32]
+
+	absent bool
 }
 
 func (_ DaemonSetSpecAssertion) isAssertable() {}
+func (a *DaemonSetSpecAssertion) markAbsent() {
+	a.absent = true
+}
 
 // DaemonSetUpdateStrategyAssertion is the assertion struct for DaemonSetUpdateStrategy.
 type DaemonSetUpdateStrategyAssertion struct {
 	Type          Opt[v12.DaemonSetUpdateStrategyType]
 	RollingUpdate RollingUpdateDaemonSetAssertion
+
+	absent bool
 }
 
 func (_ DaemonSetUpdateStrategyAssertion) isAssertable() {}
+func (a *DaemonSetUpdateStrategyAssertion) markAbsent() {
+	a.absent = true
+}
 
 // DeploymentAssertion is the assertion struct for Deployment.
 type DeploymentAssertion struct {
@@ -1477,9 +2039,14 @@ type DeploymentAssertion struct {
 	ObjectMeta ObjectMetaAssertion
 	Spec       DeploymentSpecAssertion
 	Status     Opt[v12.DeploymentStatus]
+
+	absent bool
 }
 
 func (_ DeploymentAssertion) isAssertable() {}
+func (a *DeploymentAssertion) markAbsent() {
+	a.absent = true
+}
 
 // DeploymentConditionAssertion is the assertion struct for DeploymentCondition.
 type DeploymentConditionAssertion struct {
@@ -1489,9 +2056,14 @@ type DeploymentConditionAssertion struct {
 	LastTransitionTime TimeAssertion
 	Reason             Opt[string]
 	Message            Opt[string]
+
+	absent bool
 }
 
 func (_ DeploymentConditionAssertion) isAssertable() {}
+func (a *DeploymentConditionAssertion) markAbsent() {
+	a.absent = true
+}
 
 // DeploymentSpecAssertion is the assertion struct for DeploymentSpec.
 type DeploymentSpecAssertion struct {
@@ -1503,17 +2075,27 @@ type DeploymentSpecAssertion struct {
 	RevisionHistoryLimit    Opt[*int32]
 	Paused                  Opt[bool]
 	ProgressDeadlineSeconds Opt[*int32]
+
+	absent bool
 }
 
 func (_ DeploymentSpecAssertion) isAssertable() {}
+func (a *DeploymentSpecAssertion) markAbsent() {
+	a.absent = true
+}
 
 // DeploymentStrategyAssertion is the assertion struct for DeploymentStrategy.
 type DeploymentStrategyAssertion struct {
 	Type          Opt[v12.DeploymentStrategyType]
 	RollingUpdate RollingUpdateDeploymentAssertion
+
+	absent bool
 }
 
 func (_ DeploymentStrategyAssertion) isAssertable() {}
+func (a *DeploymentStrategyAssertion) markAbsent() {
+	a.absent = true
+}
 
 // ReplicaSetAssertion is the assertion struct for ReplicaSet.
 type ReplicaSetAssertion struct {
@@ -1521,9 +2103,14 @@ type ReplicaSetAssertion struct {
 	ObjectMeta ObjectMetaAssertion
 	Spec       ReplicaSetSpecAssertion
 	Status     Opt[v12.ReplicaSetStatus]
+
+	absent bool
 }
 
 func (_ ReplicaSetAssertion) isAssertable() {}
+func (a *ReplicaSetAssertion) markAbsent() {
+	a.absent = true
+}
 
 // ReplicaSetConditionAssertion is the assertion struct for ReplicaSetCondition.
 type ReplicaSetConditionAssertion struct {
@@ -1532,9 +2119,14 @@ type ReplicaSetConditionAssertion struct {
 	LastTransitionTime TimeAssertion
 	Reason             Opt[string]
 	Message            Opt[string]
+
+	absent bool
 }
 
 func (_ ReplicaSetConditionAssertion) isAssertable() {}
+func (a *ReplicaSetConditionAssertion) markAbsent() {
+	a.absent = true
+}
 
 // ReplicaSetSpecAssertion is the assertion struct for ReplicaSetSpec.
 type ReplicaSetSpecAssertion struct {
@@ -1542,41 +2134,66 @@ type ReplicaSetSpecAssertion struct {
 	MinReadySeconds Opt[int32]
 	Selector        LabelSelectorAssertion
 	Template        PodTemplateSpecAssertion
+
+	absent bool
 }
 
 func (_ ReplicaSetSpecAssertion) isAssertable() {}
+func (a *ReplicaSetSpecAssertion) markAbsent() {
+	a.absent = true
+}
 
 // RollingUpdateDaemonSetAssertion is the assertion struct for RollingUpdateDaemonSet.
 type RollingUpdateDaemonSetAssertion struct {
 	MaxUnavailable IntOrStringAssertion
 	MaxSurge       IntOrStringAssertion
+
+	absent bool
 }
 
 func (_ RollingUpdateDaemonSetAssertion) isAssertable() {}
+func (a *RollingUpdateDaemonSetAssertion) markAbsent() {
+	a.absent = true
+}
 
 // RollingUpdateDeploymentAssertion is the assertion struct for RollingUpdateDeployment.
 type RollingUpdateDeploymentAssertion struct {
 	MaxUnavailable IntOrStringAssertion
 	MaxSurge       IntOrStringAssertion
+
+	absent bool
 }
 
 func (_ RollingUpdateDeploymentAssertion) isAssertable() {}
+func (a *RollingUpdateDeploymentAssertion) markAbsent() {
+	a.absent = true
+}
 
 // AppsRollingUpdateStatefulSetStrategyAssertion is the assertion struct for RollingUpdateStatefulSetStrategy.
 type AppsRollingUpdateStatefulSetStrategyAssertion struct {
 	Partition      Opt[*int32]
 	MaxUnavailable IntOrStringAssertion
+
+	absent bool
 }
 
 func (_ AppsRollingUpdateStatefulSetStrategyAssertion) isAssertable() {}
+func (a *AppsRollingUpdateStatefulSetStrategyAssertion) markAbsent() {
+	a.absent = true
+}
 
 // AppsSchemeGroupVersionAssertion is the assertion struct for GroupVersion.
 type AppsSchemeGroupVersionAssertion struct {
 	Group   Opt[string]
 	Version Opt[string]
+
+	absent bool
 }
 
 func (_ AppsSchemeGroupVersionAssertion) isAssertable() {}
+func (a *AppsSchemeGroupVersionAssertion) markAbsent() {
+	a.absent = true
+}
 
 // StatefulSetAssertion is the assertion struct for StatefulSet.
 type StatefulSetAssertion struct {
@@ -1584,9 +2201,14 @@ type StatefulSetAssertion struct {
 	ObjectMeta ObjectMetaAssertion
 	Spec       StatefulSetSpecAssertion
 	Status     Opt[v12.StatefulSetStatus]
+
+	absent bool
 }
 
 func (_ StatefulSetAssertion) isAssertable() {}
+func (a *StatefulSetAssertion) markAbsent() {
+	a.absent = true
+}
 
 // StatefulSetConditionAssertion is the assertion struct for StatefulSetCondition.
 type StatefulSetConditionAssertion struct {
@@ -1595,24 +2217,39 @@ type StatefulSetConditionAssertion struct {
 	LastTransitionTime TimeAssertion
 	Reason             Opt[string]
 	Message            Opt[string]
+
+	absent bool
 }
 
 func (_ StatefulSetConditionAssertion) isAssertable() {}
+func (a *StatefulSetConditionAssertion) markAbsent() {
+	a.absent = true
+}
 
 // StatefulSetOrdinalsAssertion is the assertion struct for StatefulSetOrdinals.
 type StatefulSetOrdinalsAssertion struct {
 	Start Opt[int32]
+
+	absent bool
 }
 
 func (_ StatefulSetOrdinalsAssertion) isAssertable() {}
+func (a *StatefulSetOrdinalsAssertion) markAbsent() {
+	a.absent = true
+}
 
 // StatefulSetPersistentVolumeClaimRetentionPolicyAssertion is the assertion struct for StatefulSetPersistentVolumeClaimRetentionPolicy.
 type StatefulSetPersistentVolumeClaimRetentionPolicyAssertion struct {
 	WhenDeleted Opt[v12.PersistentVolumeClaimRetentionPolicyType]
 	WhenScaled  Opt[v12.PersistentVolumeClaimRetentionPolicyType]
+
+	absent bool
 }
 
 func (_ StatefulSetPersistentVolumeClaimRetentionPolicyAssertion) isAssertable() {}
+func (a *StatefulSetPersistentVolumeClaimRetentionPolicyAssertion) markAbsent() {
+	a.absent = true
+}
 
 // StatefulSetSpecAssertion is the assertion struct for StatefulSetSpec.
 type StatefulSetSpecAssertion struct {
@@ -1627,52 +2264,82 @@ type StatefulSetSpecAssertion struct {
 	MinReadySeconds                      Opt[int32]
 	PersistentVolumeClaimRetentionPolicy StatefulSetPersistentVolumeClaimRetentionPolicyAssertion
 	Ordinals                             StatefulSetOrdinalsAssertion
+
+	absent bool
 }
 
 func (_ StatefulSetSpecAssertion) isAssertable() {}
+func (a *StatefulSetSpecAssertion) markAbsent() {
+	a.absent = true
+}
 
 // AppsStatefulSetUpdateStrategyAssertion is the assertion struct for StatefulSetUpdateStrategy.
 type AppsStatefulSetUpdateStrategyAssertion struct {
 	Type          Opt[v12.StatefulSetUpdateStrategyType]
 	RollingUpdate AppsRollingUpdateStatefulSetStrategyAssertion
+
+	absent bool
 }
 
 func (_ AppsStatefulSetUpdateStrategyAssertion) isAssertable() {}
+func (a *AppsStatefulSetUpdateStrategyAssertion) markAbsent() {
+	a.absent = true
+}
 
 // ContainerResourceMetricSourceAssertion is the assertion struct for ContainerResourceMetricSource.
 type ContainerResourceMetricSourceAssertion struct {
 	Name      Opt[v11.ResourceName]
 	Target    MetricTargetAssertion
 	Container Opt[string]
+
+	absent bool
 }
 
 func (_ ContainerResourceMetricSourceAssertion) isAssertable() {}
+func (a *ContainerResourceMetricSourceAssertion) markAbsent() {
+	a.absent = true
+}
 
 // CrossVersionObjectReferenceAssertion is the assertion struct for CrossVersionObjectReference.
 type CrossVersionObjectReferenceAssertion struct {
 	Kind       Opt[string]
 	Name       Opt[string]
 	APIVersion Opt[string]
+
+	absent bool
 }
 
 func (_ CrossVersionObjectReferenceAssertion) isAssertable() {}
+func (a *CrossVersionObjectReferenceAssertion) markAbsent() {
+	a.absent = true
+}
 
 // ExternalMetricSourceAssertion is the assertion struct for ExternalMetricSource.
 type ExternalMetricSourceAssertion struct {
 	Metric MetricIdentifierAssertion
 	Target MetricTargetAssertion
+
+	absent bool
 }
 
 func (_ ExternalMetricSourceAssertion) isAssertable() {}
+func (a *ExternalMetricSourceAssertion) markAbsent() {
+	a.absent = true
+}
 
 // HPAScalingPolicyAssertion is the assertion struct for HPAScalingPolicy.
 type HPAScalingPolicyAssertion struct {
 	Type          Opt[v2.HPAScalingPolicyType]
 	Value         Opt[int32]
 	PeriodSeconds Opt[int32]
+
+	absent bool
 }
 
 func (_ HPAScalingPolicyAssertion) isAssertable() {}
+func (a *HPAScalingPolicyAssertion) markAbsent() {
+	a.absent = true
+}
 
 // HPAScalingRulesAssertion is the assertion struct for HPAScalingRules.
 type HPAScalingRulesAssertion struct {
@@ -1680,9 +2347,14 @@ type HPAScalingRulesAssertion struct {
 	SelectPolicy               Opt[*v2.ScalingPolicySelect]
 	Policies                   Opt[[]HPAScalingPolicyAssertion]
 	Tolerance                  QuantityAssertion
+
+	absent bool
 }
 
 func (_ HPAScalingRulesAssertion) isAssertable() {}
+func (a *HPAScalingRulesAssertion) markAbsent() {
+	a.absent = true
+}
 
 // HorizontalPodAutoscalerAssertion is the assertion struct for HorizontalPodAutoscaler.
 type HorizontalPodAutoscalerAssertion struct {
@@ -1690,17 +2362,27 @@ type HorizontalPodAutoscalerAssertion struct {
 	ObjectMeta ObjectMetaAssertion
 	Spec       HorizontalPodAutoscalerSpecAssertion
 	Status     Opt[v2.HorizontalPodAutoscalerStatus]
+
+	absent bool
 }
 
 func (_ HorizontalPodAutoscalerAssertion) isAssertable() {}
+func (a *HorizontalPodAutoscalerAssertion) markAbsent() {
+	a.absent = true
+}
 
 // HorizontalPodAutoscalerBehaviorAssertion is the assertion struct for HorizontalPodAutoscalerBehavior.
 type HorizontalPodAutoscalerBehaviorAssertion struct {
 	ScaleUp   HPAScalingRulesAssertion
 	ScaleDown HPAScalingRulesAssertion
+
+	absent bool
 }
 
 func (_ HorizontalPodAutoscalerBehaviorAssertion) isAssertable() {}
+func (a *HorizontalPodAutoscalerBehaviorAssertion) markAbsent() {
+	a.absent = true
+}
 
 // HorizontalPodAutoscalerConditionAssertion is the assertion struct for HorizontalPodAutoscalerCondition.
 type HorizontalPodAutoscalerConditionAssertion struct {
@@ -1709,9 +2391,14 @@ type HorizontalPodAutoscalerConditionAssertion struct {
 	LastTransitionTime TimeAssertion
 	Reason             Opt[string]
 	Message            Opt[string]
+
+	absent bool
 }
 
 func (_ HorizontalPodAutoscalerConditionAssertion) isAssertable() {}
+func (a *HorizontalPodAutoscalerConditionAssertion) markAbsent() {
+	a.absent = true
+}
 
 // HorizontalPodAutoscalerSpecAssertion is the assertion struct for HorizontalPodAutoscalerSpec.
 type HorizontalPodAutoscalerSpecAssertion struct {
@@ -1720,17 +2407,27 @@ type HorizontalPodAutoscalerSpecAssertion struct {
 	MaxReplicas    Opt[int32]
 	Metrics        Opt[[]MetricSpecAssertion]
 	Behavior       HorizontalPodAutoscalerBehaviorAssertion
+
+	absent bool
 }
 
 func (_ HorizontalPodAutoscalerSpecAssertion) isAssertable() {}
+func (a *HorizontalPodAutoscalerSpecAssertion) markAbsent() {
+	a.absent = true
+}
 
 // MetricIdentifierAssertion is the assertion struct for MetricIdentifier.
 type MetricIdentifierAssertion struct {
 	Name     Opt[string]
 	Selector LabelSelectorAssertion
+
+	absent bool
 }
 
 func (_ MetricIdentifierAssertion) isAssertable() {}
+func (a *MetricIdentifierAssertion) markAbsent() {
+	a.absent = true
+}
 
 // MetricSpecAssertion is the assertion struct for MetricSpec.
 type MetricSpecAssertion struct {
@@ -1740,9 +2437,14 @@ type MetricSpecAssertion struct {
 	Resource          ResourceMetricSourceAssertion
 	ContainerResource ContainerResourceMetricSourceAssertion
 	External          ExternalMetricSourceAssertion
+
+	absent bool
 }
 
 func (_ MetricSpecAssertion) isAssertable() {}
+func (a *MetricSpecAssertion) markAbsent() {
+	a.absent = true
+}
 
 // MetricTargetAssertion is the assertion struct for MetricTarget.
 type MetricTargetAssertion struct {
@@ -1750,42 +2452,67 @@ type MetricTargetAssertion struct {
 	Value              QuantityAssertion
 	AverageValue       QuantityAssertion
 	AverageUtilization Opt[*int32]
+
+	absent bool
 }
 
 func (_ MetricTargetAssertion) isAssertable() {}
+func (a *MetricTargetAssertion) markAbsent() {
+	a.absent = true
+}
 
 // ObjectMetricSourceAssertion is the assertion struct for ObjectMetricSource.
 type ObjectMetricSourceAssertion struct {
 	DescribedObject CrossVersionObjectReferenceAssertion
 	Target          MetricTargetAssertion
 	Metric          MetricIdentifierAssertion
+
+	absent bool
 }
 
 func (_ ObjectMetricSourceAssertion) isAssertable() {}
+func (a *ObjectMetricSourceAssertion) markAbsent() {
+	a.absent = true
+}
 
 // PodsMetricSourceAssertion is the assertion struct for PodsMetricSource.
 type PodsMetricSourceAssertion struct {
 	Metric MetricIdentifierAssertion
 	Target MetricTargetAssertion
+
+	absent bool
 }
 
 func (_ PodsMetricSourceAssertion) isAssertable() {}
+func (a *PodsMetricSourceAssertion) markAbsent() {
+	a.absent = true
+}
 
 // ResourceMetricSourceAssertion is the assertion struct for ResourceMetricSource.
 type ResourceMetricSourceAssertion struct {
 	Name   Opt[v11.ResourceName]
 	Target MetricTargetAssertion
+
+	absent bool
 }
 
 func (_ ResourceMetricSourceAssertion) isAssertable() {}
+func (a *ResourceMetricSourceAssertion) markAbsent() {
+	a.absent = true
+}
 
 // AutoscalingSchemeGroupVersionAssertion is the assertion struct for GroupVersion.
 type AutoscalingSchemeGroupVersionAssertion struct {
 	Group   Opt[string]
 	Version Opt[string]
+
+	absent bool
 }
 
 func (_ AutoscalingSchemeGroupVersionAssertion) isAssertable() {}
+func (a *AutoscalingSchemeGroupVersionAssertion) markAbsent() {
+	a.absent = true
+}
 
 // CronJobAssertion is the assertion struct for CronJob.
 type CronJobAssertion struct {
@@ -1793,9 +2520,14 @@ type CronJobAssertion struct {
 	ObjectMeta ObjectMetaAssertion
 	Spec       CronJobSpecAssertion
 	Status     Opt[v13.CronJobStatus]
+
+	absent bool
 }
 
 func (_ CronJobAssertion) isAssertable() {}
+func (a *CronJobAssertion) markAbsent() {
+	a.absent = true
+}
 
 // CronJobSpecAssertion is the assertion struct for CronJobSpec.
 type CronJobSpecAssertion struct {
@@ -1807,9 +2539,14 @@ type CronJobSpecAssertion struct {
 	JobTemplate                JobTemplateSpecAssertion
 	SuccessfulJobsHistoryLimit Opt[*int32]
 	FailedJobsHistoryLimit     Opt[*int32]
+
+	absent bool
 }
 
 func (_ CronJobSpecAssertion) isAssertable() {}
+func (a *CronJobSpecAssertion) markAbsent() {
+	a.absent = true
+}
 
 // JobAssertion is the assertion struct for Job.
 type JobAssertion struct {
@@ -1817,9 +2554,14 @@ type JobAssertion struct {
 	ObjectMeta ObjectMetaAssertion
 	Spec       JobSpecAssertion
 	Status     Opt[v13.JobStatus]
+
+	absent bool
 }
 
 func (_ JobAssertion) isAssertable() {}
+func (a *JobAssertion) markAbsent() {
+	a.absent = true
+}
 
 // JobConditionAssertion is the assertion struct for JobCondition.
 type JobConditionAssertion struct {
@@ -1829,9 +2571,14 @@ type JobConditionAssertion struct {
 	LastTransitionTime TimeAssertion
 	Reason             Opt[string]
 	Message            Opt[string]
+
+	absent bool
 }
 
 func (_ JobConditionAssertion) isAssertable() {}
+func (a *JobConditionAssertion) markAbsent() {
+	a.absent = true
+}
 
 // JobSpecAssertion is the assertion struct for JobSpec.
 type JobSpecAssertion struct {
@@ -1851,81 +2598,131 @@ type JobSpecAssertion struct {
 	Suspend                 Opt[*bool]
 	PodReplacementPolicy    Opt[*v13.PodReplacementPolicy]
 	ManagedBy               Opt[*string]
+
+	absent bool
 }
 
 func (_ JobSpecAssertion) isAssertable() {}
+func (a *JobSpecAssertion) markAbsent() {
+	a.absent = true
+}
 
 // JobTemplateSpecAssertion is the assertion struct for JobTemplateSpec.
 type JobTemplateSpecAssertion struct {
 	ObjectMeta ObjectMetaAssertion
 	Spec       JobSpecAssertion
+
+	absent bool
 }
 
 func (_ JobTemplateSpecAssertion) isAssertable() {}
+func (a *JobTemplateSpecAssertion) markAbsent() {
+	a.absent = true
+}
 
 // PodFailurePolicyAssertion is the assertion struct for PodFailurePolicy.
 type PodFailurePolicyAssertion struct {
 	Rules Opt[[]PodFailurePolicyRuleAssertion]
+
+	absent bool
 }
 
 func (_ PodFailurePolicyAssertion) isAssertable() {}
+func (a *PodFailurePolicyAssertion) markAbsent() {
+	a.absent = true
+}
 
 // PodFailurePolicyOnExitCodesRequirementAssertion is the assertion struct for PodFailurePolicyOnExitCodesRequirement.
 type PodFailurePolicyOnExitCodesRequirementAssertion struct {
 	ContainerName Opt[*string]
 	Operator      Opt[v13.PodFailurePolicyOnExitCodesOperator]
 	Values        Opt[[]int32]
+
+	absent bool
 }
 
 func (_ PodFailurePolicyOnExitCodesRequirementAssertion) isAssertable() {}
+func (a *PodFailurePolicyOnExitCodesRequirementAssertion) markAbsent() {
+	a.absent = true
+}
 
 // PodFailurePolicyOnPodConditionsPatternAssertion is the assertion struct for PodFailurePolicyOnPodConditionsPattern.
 type PodFailurePolicyOnPodConditionsPatternAssertion struct {
 	Type   Opt[v11.PodConditionType]
 	Status Opt[v11.ConditionStatus]
+
+	absent bool
 }
 
 func (_ PodFailurePolicyOnPodConditionsPatternAssertion) isAssertable() {}
+func (a *PodFailurePolicyOnPodConditionsPatternAssertion) markAbsent() {
+	a.absent = true
+}
 
 // PodFailurePolicyRuleAssertion is the assertion struct for PodFailurePolicyRule.
 type PodFailurePolicyRuleAssertion struct {
 	Action          Opt[v13.PodFailurePolicyAction]
 	OnExitCodes     PodFailurePolicyOnExitCodesRequirementAssertion
 	OnPodConditions Opt[[]PodFailurePolicyOnPodConditionsPatternAssertion]
+
+	absent bool
 }
 
 func (_ PodFailurePolicyRuleAssertion) isAssertable() {}
+func (a *PodFailurePolicyRuleAssertion) markAbsent() {
+	a.absent = true
+}
 
 // BatchSchemeGroupVersionAssertion is the assertion struct for GroupVersion.
 type BatchSchemeGroupVersionAssertion struct {
 	Group   Opt[string]
 	Version Opt[string]
+
+	absent bool
 }
 
 func (_ BatchSchemeGroupVersionAssertion) isAssertable() {}
+func (a *BatchSchemeGroupVersionAssertion) markAbsent() {
+	a.absent = true
+}
 
 // SuccessPolicyAssertion is the assertion struct for SuccessPolicy.
 type SuccessPolicyAssertion struct {
 	Rules Opt[[]SuccessPolicyRuleAssertion]
+
+	absent bool
 }
 
 func (_ SuccessPolicyAssertion) isAssertable() {}
+func (a *SuccessPolicyAssertion) markAbsent() {
+	a.absent = true
+}
 
 // SuccessPolicyRuleAssertion is the assertion struct for SuccessPolicyRule.
 type SuccessPolicyRuleAssertion struct {
 	SucceededIndexes Opt[*string]
 	SucceededCount   Opt[*int32]
+
+	absent bool
 }
 
 func (_ SuccessPolicyRuleAssertion) isAssertable() {}
+func (a *SuccessPolicyRuleAssertion) markAbsent() {
+	a.absent = true
+}
 
 // UncountedTerminatedPodsAssertion is the assertion struct for UncountedTerminatedPods.
 type UncountedTerminatedPodsAssertion struct {
 	Succeeded Opt[[]types1.UID]
 	Failed    Opt[[]types1.UID]
+
+	absent bool
 }
 
 func (_ UncountedTerminatedPodsAssertion) isAssertable() {}
+func (a *UncountedTerminatedPodsAssertion) markAbsent() {
+	a.absent = true
+}
 
 // AWSElasticBlockStoreVolumeSourceAssertion is the assertion struct for AWSElasticBlockStoreVolumeSource.
 type AWSElasticBlockStoreVolumeSourceAssertion struct {
@@ -1933,41 +2730,66 @@ type AWSElasticBlockStoreVolumeSourceAssertion struct {
 	FSType    Opt[string]
 	Partition Opt[int32]
 	ReadOnly  Opt[bool]
+
+	absent bool
 }
 
 func (_ AWSElasticBlockStoreVolumeSourceAssertion) isAssertable() {}
+func (a *AWSElasticBlockStoreVolumeSourceAssertion) markAbsent() {
+	a.absent = true
+}
 
 // AffinityAssertion is the assertion struct for Affinity.
 type AffinityAssertion struct {
 	NodeAffinity    NodeAffinityAssertion
 	PodAffinity     PodAffinityAssertion
 	PodAntiAffinity PodAntiAffinityAssertion
+
+	absent bool
 }
 
 func (_ AffinityAssertion) isAssertable() {}
+func (a *AffinityAssertion) markAbsent() {
+	a.absent = true
+}
 
 // AppArmorProfileAssertion is the assertion struct for AppArmorProfile.
 type AppArmorProfileAssertion struct {
 	Type             Opt[v11.AppArmorProfileType]
 	LocalhostProfile Opt[*string]
+
+	absent bool
 }
 
 func (_ AppArmorProfileAssertion) isAssertable() {}
+func (a *AppArmorProfileAssertion) markAbsent() {
+	a.absent = true
+}
 
 // AttachedVolumeAssertion is the assertion struct for AttachedVolume.
 type AttachedVolumeAssertion struct {
 	Name       Opt[v11.UniqueVolumeName]
 	DevicePath Opt[string]
+
+	absent bool
 }
 
 func (_ AttachedVolumeAssertion) isAssertable() {}
+func (a *AttachedVolumeAssertion) markAbsent() {
+	a.absent = true
+}
 
 // AvoidPodsAssertion is the assertion struct for AvoidPods.
 type AvoidPodsAssertion struct {
 	PreferAvoidPods Opt[[]PreferAvoidPodsEntryAssertion]
+
+	absent bool
 }
 
 func (_ AvoidPodsAssertion) isAssertable() {}
+func (a *AvoidPodsAssertion) markAbsent() {
+	a.absent = true
+}
 
 // AzureDiskVolumeSourceAssertion is the assertion struct for AzureDiskVolumeSource.
 type AzureDiskVolumeSourceAssertion struct {
@@ -1977,9 +2799,14 @@ type AzureDiskVolumeSourceAssertion struct {
 	FSType      Opt[*string]
 	ReadOnly    Opt[*bool]
 	Kind        Opt[*v11.AzureDataDiskKind]
+
+	absent bool
 }
 
 func (_ AzureDiskVolumeSourceAssertion) isAssertable() {}
+func (a *AzureDiskVolumeSourceAssertion) markAbsent() {
+	a.absent = true
+}
 
 // AzureFilePersistentVolumeSourceAssertion is the assertion struct for AzureFilePersistentVolumeSource.
 type AzureFilePersistentVolumeSourceAssertion struct {
@@ -1987,27 +2814,42 @@ type AzureFilePersistentVolumeSourceAssertion struct {
 	ShareName       Opt[string]
 	ReadOnly        Opt[bool]
 	SecretNamespace Opt[*string]
+
+	absent bool
 }
 
 func (_ AzureFilePersistentVolumeSourceAssertion) isAssertable() {}
+func (a *AzureFilePersistentVolumeSourceAssertion) markAbsent() {
+	a.absent = true
+}
 
 // AzureFileVolumeSourceAssertion is the assertion struct for AzureFileVolumeSource.
 type AzureFileVolumeSourceAssertion struct {
 	SecretName Opt[string]
 	ShareName  Opt[string]
 	ReadOnly   Opt[bool]
+
+	absent bool
 }
 
 func (_ AzureFileVolumeSourceAssertion) isAssertable() {}
+func (a *AzureFileVolumeSourceAssertion) markAbsent() {
+	a.absent = true
+}
 
 // BindingAssertion is the assertion struct for Binding.
 type BindingAssertion struct {
 	TypeMeta   TypeMetaAssertion
 	ObjectMeta ObjectMetaAssertion
 	Target     CoreObjectReferenceAssertion
+
+	absent bool
 }
 
 func (_ BindingAssertion) isAssertable() {}
+func (a *BindingAssertion) markAbsent() {
+	a.absent = true
+}
 
 // CSIPersistentVolumeSourceAssertion is the assertion struct for CSIPersistentVolumeSource.
 type CSIPersistentVolumeSourceAssertion struct {
@@ -2021,9 +2863,14 @@ type CSIPersistentVolumeSourceAssertion struct {
 	NodePublishSecretRef       SecretReferenceAssertion
 	ControllerExpandSecretRef  SecretReferenceAssertion
 	NodeExpandSecretRef        SecretReferenceAssertion
+
+	absent bool
 }
 
 func (_ CSIPersistentVolumeSourceAssertion) isAssertable() {}
+func (a *CSIPersistentVolumeSourceAssertion) markAbsent() {
+	a.absent = true
+}
 
 // CSIVolumeSourceAssertion is the assertion struct for CSIVolumeSource.
 type CSIVolumeSourceAssertion struct {
@@ -2032,17 +2879,27 @@ type CSIVolumeSourceAssertion struct {
 	FSType               Opt[*string]
 	VolumeAttributes     Opt[map[string]string]
 	NodePublishSecretRef CoreLocalObjectReferenceAssertion
+
+	absent bool
 }
 
 func (_ CSIVolumeSourceAssertion) isAssertable() {}
+func (a *CSIVolumeSourceAssertion) markAbsent() {
+	a.absent = true
+}
 
 // CapabilitiesAssertion is the assertion struct for Capabilities.
 type CapabilitiesAssertion struct {
 	Add  Opt[[]v11.Capability]
 	Drop Opt[[]v11.Capability]
+
+	absent bool
 }
 
 func (_ CapabilitiesAssertion) isAssertable() {}
+func (a *CapabilitiesAssertion) markAbsent() {
+	a.absent = true
+}
 
 // CephFSPersistentVolumeSourceAssertion is the assertion struct for CephFSPersistentVolumeSource.
 type CephFSPersistentVolumeSourceAssertion struct {
@@ -2052,9 +2909,14 @@ type CephFSPersistentVolumeSourceAssertion struct {
 	SecretFile Opt[string]
 	SecretRef  SecretReferenceAssertion
 	ReadOnly   Opt[bool]
+
+	absent bool
 }
 
 func (_ CephFSPersistentVolumeSourceAssertion) isAssertable() {}
+func (a *CephFSPersistentVolumeSourceAssertion) markAbsent() {
+	a.absent = true
+}
 
 // CephFSVolumeSourceAssertion is the assertion struct for CephFSVolumeSource.
 type CephFSVolumeSourceAssertion struct {
@@ -2064,9 +2926,14 @@ type CephFSVolumeSourceAssertion struct {
 	SecretFile Opt[string]
 	SecretRef  CoreLocalObjectReferenceAssertion
 	ReadOnly   Opt[bool]
+
+	absent bool
 }
 
 func (_ CephFSVolumeSourceAssertion) isAssertable() {}
+func (a *CephFSVolumeSourceAssertion) markAbsent() {
+	a.absent = true
+}
 
 // CinderPersistentVolumeSourceAssertion is the assertion struct for CinderPersistentVolumeSource.
 type CinderPersistentVolumeSourceAssertion struct {
@@ -2074,9 +2941,14 @@ type CinderPersistentVolumeSourceAssertion struct {
 	FSType    Opt[string]
 	ReadOnly  Opt[bool]
 	SecretRef SecretReferenceAssertion
+
+	absent bool
 }
 
 func (_ CinderPersistentVolumeSourceAssertion) isAssertable() {}
+func (a *CinderPersistentVolumeSourceAssertion) markAbsent() {
+	a.absent = true
+}
 
 // CinderVolumeSourceAssertion is the assertion struct for CinderVolumeSource.
 type CinderVolumeSourceAssertion struct {
@@ -2084,16 +2956,26 @@ type CinderVolumeSourceAssertion struct {
 	FSType    Opt[string]
 	ReadOnly  Opt[bool]
 	SecretRef CoreLocalObjectReferenceAssertion
+
+	absent bool
 }
 
 func (_ CinderVolumeSourceAssertion) isAssertable() {}
+func (a *CinderVolumeSourceAssertion) markAbsent() {
+	a.absent = true
+}
 
 // ClientIPConfigAssertion is the assertion struct for ClientIPConfig.
 type ClientIPConfigAssertion struct {
 	TimeoutSeconds Opt[*int32]
+
+	absent bool
 }
 
 func (_ ClientIPConfigAssertion) isAssertable() {}
+func (a *ClientIPConfigAssertion) markAbsent() {
+	a.absent = true
+}
 
 // ClusterTrustBundleProjectionAssertion is the assertion struct for ClusterTrustBundleProjection.
 type ClusterTrustBundleProjectionAssertion struct {
@@ -2102,9 +2984,14 @@ type ClusterTrustBundleProjectionAssertion struct {
 	LabelSelector LabelSelectorAssertion
 	Optional      Opt[*bool]
 	Path          Opt[string]
+
+	absent bool
 }
 
 func (_ ClusterTrustBundleProjectionAssertion) isAssertable() {}
+func (a *ClusterTrustBundleProjectionAssertion) markAbsent() {
+	a.absent = true
+}
 
 // ComponentConditionAssertion is the assertion struct for ComponentCondition.
 type ComponentConditionAssertion struct {
@@ -2112,9 +2999,14 @@ type ComponentConditionAssertion struct {
 	Status  Opt[v11.ConditionStatus]
 	Message Opt[string]
 	Error   Opt[string]
+
+	absent bool
 }
 
 func (_ ComponentConditionAssertion) isAssertable() {}
+func (a *ComponentConditionAssertion) markAbsent() {
+	a.absent = true
+}
 
 // ConfigMapAssertion is the assertion struct for ConfigMap.
 type ConfigMapAssertion struct {
@@ -2123,26 +3015,41 @@ type ConfigMapAssertion struct {
 	Immutable  Opt[*bool]
 	Data       Opt[map[string]string]
 	BinaryData Opt[map[string][]byte]
+
+	absent bool
 }
 
 func (_ ConfigMapAssertion) isAssertable() {}
+func (a *ConfigMapAssertion) markAbsent() {
+	a.absent = true
+}
 
 // ConfigMapEnvSourceAssertion is the assertion struct for ConfigMapEnvSource.
 type ConfigMapEnvSourceAssertion struct {
 	LocalObjectReference CoreLocalObjectReferenceAssertion
 	Optional             Opt[*bool]
+
+	absent bool
 }
 
 func (_ ConfigMapEnvSourceAssertion) isAssertable() {}
+func (a *ConfigMapEnvSourceAssertion) markAbsent() {
+	a.absent = true
+}
 
 // ConfigMapKeySelectorAssertion is the assertion struct for ConfigMapKeySelector.
 type ConfigMapKeySelectorAssertion struct {
 	LocalObjectReference CoreLocalObjectReferenceAssertion
 	Key                  Opt[string]
 	Optional             Opt[*bool]
+
+	absent bool
 }
 
 func (_ ConfigMapKeySelectorAssertion) isAssertable() {}
+func (a *ConfigMapKeySelectorAssertion) markAbsent() {
+	a.absent = true
+}
 
 // ConfigMapNodeConfigSourceAssertion is the assertion struct for ConfigMapNodeConfigSource.
 type ConfigMapNodeConfigSourceAssertion struct {
@@ -2151,18 +3058,28 @@ type ConfigMapNodeConfigSourceAssertion struct {
 	UID              Opt[types1.UID]
 	ResourceVersion  Opt[string]
 	KubeletConfigKey Opt[string]
+
+	absent bool
 }
 
 func (_ ConfigMapNodeConfigSourceAssertion) isAssertable() {}
+func (a *ConfigMapNodeConfigSourceAssertion) markAbsent() {
+	a.absent = true
+}
 
 // ConfigMapProjectionAssertion is the assertion struct for ConfigMapProjection.
 type ConfigMapProjectionAssertion struct {
 	LocalObjectReference CoreLocalObjectReferenceAssertion
 	Items                Opt[[]KeyToPathAssertion]
 	Optional             Opt[*bool]
+
+	absent bool
 }
 
 func (_ ConfigMapProjectionAssertion) isAssertable() {}
+func (a *ConfigMapProjectionAssertion) markAbsent() {
+	a.absent = true
+}
 
 // ConfigMapVolumeSourceAssertion is the assertion struct for ConfigMapVolumeSource.
 type ConfigMapVolumeSourceAssertion struct {
@@ -2170,9 +3087,14 @@ type ConfigMapVolumeSourceAssertion struct {
 	Items                Opt[[]KeyToPathAssertion]
 	DefaultMode          Opt[*int32]
 	Optional             Opt[*bool]
+
+	absent bool
 }
 
 func (_ ConfigMapVolumeSourceAssertion) isAssertable() {}
+func (a *ConfigMapVolumeSourceAssertion) markAbsent() {
+	a.absent = true
+}
 
 // ContainerAssertion is the assertion struct for Container.
 type ContainerAssertion struct {
@@ -2201,26 +3123,41 @@ type ContainerAssertion struct {
 	Stdin                    Opt[bool]
 	StdinOnce                Opt[bool]
 	TTY                      Opt[bool]
+
+	absent bool
 }
 
 func (_ ContainerAssertion) isAssertable() {}
+func (a *ContainerAssertion) markAbsent() {
+	a.absent = true
+}
 
 // ContainerExtendedResourceRequestAssertion is the assertion struct for ContainerExtendedResourceRequest.
 type ContainerExtendedResourceRequestAssertion struct {
 	ContainerName Opt[string]
 	ResourceName  Opt[string]
 	RequestName   Opt[string]
+
+	absent bool
 }
 
 func (_ ContainerExtendedResourceRequestAssertion) isAssertable() {}
+func (a *ContainerExtendedResourceRequestAssertion) markAbsent() {
+	a.absent = true
+}
 
 // ContainerImageAssertion is the assertion struct for ContainerImage.
 type ContainerImageAssertion struct {
 	Names     Opt[[]string]
 	SizeBytes Opt[int64]
+
+	absent bool
 }
 
 func (_ ContainerImageAssertion) isAssertable() {}
+func (a *ContainerImageAssertion) markAbsent() {
+	a.absent = true
+}
 
 // ContainerPortAssertion is the assertion struct for ContainerPort.
 type ContainerPortAssertion struct {
@@ -2229,49 +3166,79 @@ type ContainerPortAssertion struct {
 	ContainerPort Opt[int32]
 	Protocol      Opt[v11.Protocol]
 	HostIP        Opt[string]
+
+	absent bool
 }
 
 func (_ ContainerPortAssertion) isAssertable() {}
+func (a *ContainerPortAssertion) markAbsent() {
+	a.absent = true
+}
 
 // ContainerResizePolicyAssertion is the assertion struct for ContainerResizePolicy.
 type ContainerResizePolicyAssertion struct {
 	ResourceName  Opt[v11.ResourceName]
 	RestartPolicy Opt[v11.ResourceResizeRestartPolicy]
+
+	absent bool
 }
 
 func (_ ContainerResizePolicyAssertion) isAssertable() {}
+func (a *ContainerResizePolicyAssertion) markAbsent() {
+	a.absent = true
+}
 
 // ContainerRestartRuleAssertion is the assertion struct for ContainerRestartRule.
 type ContainerRestartRuleAssertion struct {
 	Action    Opt[v11.ContainerRestartRuleAction]
 	ExitCodes ContainerRestartRuleOnExitCodesAssertion
+
+	absent bool
 }
 
 func (_ ContainerRestartRuleAssertion) isAssertable() {}
+func (a *ContainerRestartRuleAssertion) markAbsent() {
+	a.absent = true
+}
 
 // ContainerRestartRuleOnExitCodesAssertion is the assertion struct for ContainerRestartRuleOnExitCodes.
 type ContainerRestartRuleOnExitCodesAssertion struct {
 	Operator Opt[v11.ContainerRestartRuleOnExitCodesOperator]
 	Values   Opt[[]int32]
+
+	absent bool
 }
 
 func (_ ContainerRestartRuleOnExitCodesAssertion) isAssertable() {}
+func (a *ContainerRestartRuleOnExitCodesAssertion) markAbsent() {
+	a.absent = true
+}
 
 // ContainerStateAssertion is the assertion struct for ContainerState.
 type ContainerStateAssertion struct {
 	Waiting    ContainerStateWaitingAssertion
 	Running    ContainerStateRunningAssertion
 	Terminated ContainerStateTerminatedAssertion
+
+	absent bool
 }
 
 func (_ ContainerStateAssertion) isAssertable() {}
+func (a *ContainerStateAssertion) markAbsent() {
+	a.absent = true
+}
 
 // ContainerStateRunningAssertion is the assertion struct for ContainerStateRunning.
 type ContainerStateRunningAssertion struct {
 	StartedAt TimeAssertion
+
+	absent bool
 }
 
 func (_ ContainerStateRunningAssertion) isAssertable() {}
+func (a *ContainerStateRunningAssertion) markAbsent() {
+	a.absent = true
+}
 
 // ContainerStateTerminatedAssertion is the assertion struct for ContainerStateTerminated.
 type ContainerStateTerminatedAssertion struct {
@@ -2282,38 +3249,63 @@ type ContainerStateTerminatedAssertion struct {
 	StartedAt   TimeAssertion
 	FinishedAt  TimeAssertion
 	ContainerID Opt[string]
+
+	absent bool
 }
 
 func (_ ContainerStateTerminatedAssertion) isAssertable() {}
+func (a *ContainerStateTerminatedAssertion) markAbsent() {
+	a.absent = true
+}
 
 // ContainerStateWaitingAssertion is the assertion struct for ContainerStateWaiting.
 type ContainerStateWaitingAssertion struct {
 	Reason  Opt[string]
 	Message Opt[string]
+
+	absent bool
 }
 
 func (_ ContainerStateWaitingAssertion) isAssertable() {}
+func (a *ContainerStateWaitingAssertion) markAbsent() {
+	a.absent = true
+}
 
 // ContainerUserAssertion is the assertion struct for ContainerUser.
 type ContainerUserAssertion struct {
 	Linux LinuxContainerUserAssertion
+
+	absent bool
 }
 
 func (_ ContainerUserAssertion) isAssertable() {}
+func (a *ContainerUserAssertion) markAbsent() {
+	a.absent = true
+}
 
 // DaemonEndpointAssertion is the assertion struct for DaemonEndpoint.
 type DaemonEndpointAssertion struct {
 	Port Opt[int32]
+
+	absent bool
 }
 
 func (_ DaemonEndpointAssertion) isAssertable() {}
+func (a *DaemonEndpointAssertion) markAbsent() {
+	a.absent = true
+}
 
 // DownwardAPIProjectionAssertion is the assertion struct for DownwardAPIProjection.
 type DownwardAPIProjectionAssertion struct {
 	Items Opt[[]DownwardAPIVolumeFileAssertion]
+
+	absent bool
 }
 
 func (_ DownwardAPIProjectionAssertion) isAssertable() {}
+func (a *DownwardAPIProjectionAssertion) markAbsent() {
+	a.absent = true
+}
 
 // DownwardAPIVolumeFileAssertion is the assertion struct for DownwardAPIVolumeFile.
 type DownwardAPIVolumeFileAssertion struct {
@@ -2321,25 +3313,40 @@ type DownwardAPIVolumeFileAssertion struct {
 	FieldRef         ObjectFieldSelectorAssertion
 	ResourceFieldRef ResourceFieldSelectorAssertion
 	Mode             Opt[*int32]
+
+	absent bool
 }
 
 func (_ DownwardAPIVolumeFileAssertion) isAssertable() {}
+func (a *DownwardAPIVolumeFileAssertion) markAbsent() {
+	a.absent = true
+}
 
 // DownwardAPIVolumeSourceAssertion is the assertion struct for DownwardAPIVolumeSource.
 type DownwardAPIVolumeSourceAssertion struct {
 	Items       Opt[[]DownwardAPIVolumeFileAssertion]
 	DefaultMode Opt[*int32]
+
+	absent bool
 }
 
 func (_ DownwardAPIVolumeSourceAssertion) isAssertable() {}
+func (a *DownwardAPIVolumeSourceAssertion) markAbsent() {
+	a.absent = true
+}
 
 // EmptyDirVolumeSourceAssertion is the assertion struct for EmptyDirVolumeSource.
 type EmptyDirVolumeSourceAssertion struct {
 	Medium    Opt[v11.StorageMedium]
 	SizeLimit QuantityAssertion
+
+	absent bool
 }
 
 func (_ EmptyDirVolumeSourceAssertion) isAssertable() {}
+func (a *EmptyDirVolumeSourceAssertion) markAbsent() {
+	a.absent = true
+}
 
 // EndpointAddressAssertion is the assertion struct for EndpointAddress.
 type EndpointAddressAssertion struct {
@@ -2347,9 +3354,14 @@ type EndpointAddressAssertion struct {
 	Hostname  Opt[string]
 	NodeName  Opt[*string]
 	TargetRef CoreObjectReferenceAssertion
+
+	absent bool
 }
 
 func (_ EndpointAddressAssertion) isAssertable() {}
+func (a *EndpointAddressAssertion) markAbsent() {
+	a.absent = true
+}
 
 // EndpointPortAssertion is the assertion struct for EndpointPort.
 type EndpointPortAssertion struct {
@@ -2357,45 +3369,70 @@ type EndpointPortAssertion struct {
 	Port        Opt[int32]
 	Protocol    Opt[v11.Protocol]
 	AppProtocol Opt[*string]
+
+	absent bool
 }
 
 func (_ EndpointPortAssertion) isAssertable() {}
+func (a *EndpointPortAssertion) markAbsent() {
+	a.absent = true
+}
 
 // EndpointSubsetAssertion is the assertion struct for EndpointSubset.
 type EndpointSubsetAssertion struct {
 	Addresses         Opt[[]EndpointAddressAssertion]
 	NotReadyAddresses Opt[[]EndpointAddressAssertion]
 	Ports             Opt[[]EndpointPortAssertion]
+
+	absent bool
 }
 
 func (_ EndpointSubsetAssertion) isAssertable() {}
+func (a *EndpointSubsetAssertion) markAbsent() {
+	a.absent = true
+}
 
 // EndpointsAssertion is the assertion struct for Endpoints.
 type EndpointsAssertion struct {
 	TypeMeta   TypeMetaAssertion
 	ObjectMeta ObjectMetaAssertion
 	Subsets    Opt[[]EndpointSubsetAssertion]
+
+	absent bool
 }
 
 func (_ EndpointsAssertion) isAssertable() {}
+func (a *EndpointsAssertion) markAbsent() {
+	a.absent = true
+}
 
 // EnvFromSourceAssertion is the assertion struct for EnvFromSource.
 type EnvFromSourceAssertion struct {
 	Prefix       Opt[string]
 	ConfigMapRef ConfigMapEnvSourceAssertion
 	SecretRef    SecretEnvSourceAssertion
+
+	absent bool
 }
 
 func (_ EnvFromSourceAssertion) isAssertable() {}
+func (a *EnvFromSourceAssertion) markAbsent() {
+	a.absent = true
+}
 
 // EnvVarAssertion is the assertion struct for EnvVar.
 type EnvVarAssertion struct {
 	Name      Opt[string]
 	Value     Opt[string]
 	ValueFrom EnvVarSourceAssertion
+
+	absent bool
 }
 
 func (_ EnvVarAssertion) isAssertable() {}
+func (a *EnvVarAssertion) markAbsent() {
+	a.absent = true
+}
 
 // EnvVarSourceAssertion is the assertion struct for EnvVarSource.
 type EnvVarSourceAssertion struct {
@@ -2404,17 +3441,27 @@ type EnvVarSourceAssertion struct {
 	ConfigMapKeyRef  ConfigMapKeySelectorAssertion
 	SecretKeyRef     SecretKeySelectorAssertion
 	FileKeyRef       FileKeySelectorAssertion
+
+	absent bool
 }
 
 func (_ EnvVarSourceAssertion) isAssertable() {}
+func (a *EnvVarSourceAssertion) markAbsent() {
+	a.absent = true
+}
 
 // EphemeralContainerAssertion is the assertion struct for EphemeralContainer.
 type EphemeralContainerAssertion struct {
 	EphemeralContainerCommon EphemeralContainerCommonAssertion
 	TargetContainerName      Opt[string]
+
+	absent bool
 }
 
 func (_ EphemeralContainerAssertion) isAssertable() {}
+func (a *EphemeralContainerAssertion) markAbsent() {
+	a.absent = true
+}
 
 // EphemeralContainerCommonAssertion is the assertion struct for EphemeralContainerCommon.
 type EphemeralContainerCommonAssertion struct {
@@ -2443,16 +3490,26 @@ type EphemeralContainerCommonAssertion struct {
 	Stdin                    Opt[bool]
 	StdinOnce                Opt[bool]
 	TTY                      Opt[bool]
+
+	absent bool
 }
 
 func (_ EphemeralContainerCommonAssertion) isAssertable() {}
+func (a *EphemeralContainerCommonAssertion) markAbsent() {
+	a.absent = true
+}
 
 // EphemeralVolumeSourceAssertion is the assertion struct for EphemeralVolumeSource.
 type EphemeralVolumeSourceAssertion struct {
 	VolumeClaimTemplate PersistentVolumeClaimTemplateAssertion
+
+	absent bool
 }
 
 func (_ EphemeralVolumeSourceAssertion) isAssertable() {}
+func (a *EphemeralVolumeSourceAssertion) markAbsent() {
+	a.absent = true
+}
 
 // EventAssertion is the assertion struct for Event.
 type EventAssertion struct {
@@ -2472,32 +3529,52 @@ type EventAssertion struct {
 	Related             CoreObjectReferenceAssertion
 	ReportingController Opt[string]
 	ReportingInstance   Opt[string]
+
+	absent bool
 }
 
 func (_ EventAssertion) isAssertable() {}
+func (a *EventAssertion) markAbsent() {
+	a.absent = true
+}
 
 // EventSeriesAssertion is the assertion struct for EventSeries.
 type EventSeriesAssertion struct {
 	Count            Opt[int32]
 	LastObservedTime MicroTimeAssertion
+
+	absent bool
 }
 
 func (_ EventSeriesAssertion) isAssertable() {}
+func (a *EventSeriesAssertion) markAbsent() {
+	a.absent = true
+}
 
 // EventSourceAssertion is the assertion struct for EventSource.
 type EventSourceAssertion struct {
 	Component Opt[string]
 	Host      Opt[string]
+
+	absent bool
 }
 
 func (_ EventSourceAssertion) isAssertable() {}
+func (a *EventSourceAssertion) markAbsent() {
+	a.absent = true
+}
 
 // ExecActionAssertion is the assertion struct for ExecAction.
 type ExecActionAssertion struct {
 	Command Opt[[]string]
+
+	absent bool
 }
 
 func (_ ExecActionAssertion) isAssertable() {}
+func (a *ExecActionAssertion) markAbsent() {
+	a.absent = true
+}
 
 // FCVolumeSourceAssertion is the assertion struct for FCVolumeSource.
 type FCVolumeSourceAssertion struct {
@@ -2506,9 +3583,14 @@ type FCVolumeSourceAssertion struct {
 	FSType     Opt[string]
 	ReadOnly   Opt[bool]
 	WWIDs      Opt[[]string]
+
+	absent bool
 }
 
 func (_ FCVolumeSourceAssertion) isAssertable() {}
+func (a *FCVolumeSourceAssertion) markAbsent() {
+	a.absent = true
+}
 
 // FileKeySelectorAssertion is the assertion struct for FileKeySelector.
 type FileKeySelectorAssertion struct {
@@ -2516,9 +3598,14 @@ type FileKeySelectorAssertion struct {
 	Path       Opt[string]
 	Key        Opt[string]
 	Optional   Opt[*bool]
+
+	absent bool
 }
 
 func (_ FileKeySelectorAssertion) isAssertable() {}
+func (a *FileKeySelectorAssertion) markAbsent() {
+	a.absent = true
+}
 
 // FlexPersistentVolumeSourceAssertion is the assertion struct for FlexPersistentVolumeSource.
 type FlexPersistentVolumeSourceAssertion struct {
@@ -2527,9 +3614,14 @@ type FlexPersistentVolumeSourceAssertion struct {
 	SecretRef SecretReferenceAssertion
 	ReadOnly  Opt[bool]
 	Options   Opt[map[string]string]
+
+	absent bool
 }
 
 func (_ FlexPersistentVolumeSourceAssertion) isAssertable() {}
+func (a *FlexPersistentVolumeSourceAssertion) markAbsent() {
+	a.absent = true
+}
 
 // FlexVolumeSourceAssertion is the assertion struct for FlexVolumeSource.
 type FlexVolumeSourceAssertion struct {
@@ -2538,17 +3630,27 @@ type FlexVolumeSourceAssertion struct {
 	SecretRef CoreLocalObjectReferenceAssertion
 	ReadOnly  Opt[bool]
 	Options   Opt[map[string]string]
+
+	absent bool
 }
 
 func (_ FlexVolumeSourceAssertion) isAssertable() {}
+func (a *FlexVolumeSourceAssertion) markAbsent() {
+	a.absent = true
+}
 
 // FlockerVolumeSourceAssertion is the assertion struct for FlockerVolumeSource.
 type FlockerVolumeSourceAssertion struct {
 	DatasetName Opt[string]
 	DatasetUUID Opt[string]
+
+	absent bool
 }
 
 func (_ FlockerVolumeSourceAssertion) isAssertable() {}
+func (a *FlockerVolumeSourceAssertion) markAbsent() {
+	a.absent = true
+}
 
 // GCEPersistentDiskVolumeSourceAssertion is the assertion struct for GCEPersistentDiskVolumeSource.
 type GCEPersistentDiskVolumeSourceAssertion struct {
@@ -2556,26 +3658,41 @@ type GCEPersistentDiskVolumeSourceAssertion struct {
 	FSType    Opt[string]
 	Partition Opt[int32]
 	ReadOnly  Opt[bool]
+
+	absent bool
 }
 
 func (_ GCEPersistentDiskVolumeSourceAssertion) isAssertable() {}
+func (a *GCEPersistentDiskVolumeSourceAssertion) markAbsent() {
+	a.absent = true
+}
 
 // GRPCActionAssertion is the assertion struct for GRPCAction.
 type GRPCActionAssertion struct {
 	Port    Opt[int32]
 	Service Opt[*string]
+
+	absent bool
 }
 
 func (_ GRPCActionAssertion) isAssertable() {}
+func (a *GRPCActionAssertion) markAbsent() {
+	a.absent = true
+}
 
 // GitRepoVolumeSourceAssertion is the assertion struct for GitRepoVolumeSource.
 type GitRepoVolumeSourceAssertion struct {
 	Repository Opt[string]
 	Revision   Opt[string]
 	Directory  Opt[string]
+
+	absent bool
 }
 
 func (_ GitRepoVolumeSourceAssertion) isAssertable() {}
+func (a *GitRepoVolumeSourceAssertion) markAbsent() {
+	a.absent = true
+}
 
 // GlusterfsPersistentVolumeSourceAssertion is the assertion struct for GlusterfsPersistentVolumeSource.
 type GlusterfsPersistentVolumeSourceAssertion struct {
@@ -2583,18 +3700,28 @@ type GlusterfsPersistentVolumeSourceAssertion struct {
 	Path               Opt[string]
 	ReadOnly           Opt[bool]
 	EndpointsNamespace Opt[*string]
+
+	absent bool
 }
 
 func (_ GlusterfsPersistentVolumeSourceAssertion) isAssertable() {}
+func (a *GlusterfsPersistentVolumeSourceAssertion) markAbsent() {
+	a.absent = true
+}
 
 // GlusterfsVolumeSourceAssertion is the assertion struct for GlusterfsVolumeSource.
 type GlusterfsVolumeSourceAssertion struct {
 	EndpointsName Opt[string]
 	Path          Opt[string]
 	ReadOnly      Opt[bool]
+
+	absent bool
 }
 
 func (_ GlusterfsVolumeSourceAssertion) isAssertable() {}
+func (a *GlusterfsVolumeSourceAssertion) markAbsent() {
+	a.absent = true
+}
 
 // HTTPGetActionAssertion is the assertion struct for HTTPGetAction.
 type HTTPGetActionAssertion struct {
@@ -2603,40 +3730,65 @@ type HTTPGetActionAssertion struct {
 	Host        Opt[string]
 	Scheme      Opt[v11.URIScheme]
 	HTTPHeaders Opt[[]CoreHTTPHeaderAssertion]
+
+	absent bool
 }
 
 func (_ HTTPGetActionAssertion) isAssertable() {}
+func (a *HTTPGetActionAssertion) markAbsent() {
+	a.absent = true
+}
 
 // CoreHTTPHeaderAssertion is the assertion struct for HTTPHeader.
 type CoreHTTPHeaderAssertion struct {
 	Name  Opt[string]
 	Value Opt[string]
+
+	absent bool
 }
 
 func (_ CoreHTTPHeaderAssertion) isAssertable() {}
+func (a *CoreHTTPHeaderAssertion) markAbsent() {
+	a.absent = true
+}
 
 // CoreHostAliasAssertion is the assertion struct for HostAlias.
 type CoreHostAliasAssertion struct {
 	IP        Opt[string]
 	Hostnames Opt[[]string]
+
+	absent bool
 }
 
 func (_ CoreHostAliasAssertion) isAssertable() {}
+func (a *CoreHostAliasAssertion) markAbsent() {
+	a.absent = true
+}
 
 // HostIPAssertion is the assertion struct for HostIP.
 type HostIPAssertion struct {
 	IP Opt[string]
+
+	absent bool
 }
 
 func (_ HostIPAssertion) isAssertable() {}
+func (a *HostIPAssertion) markAbsent() {
+	a.absent = true
+}
 
 // HostPathVolumeSourceAssertion is the assertion struct for HostPathVolumeSource.
 type HostPathVolumeSourceAssertion struct {
 	Path Opt[string]
 	Type Opt[*v11.HostPathType]
+
+	absent bool
 }
 
 func (_ HostPathVolumeSourceAssertion) isAssertable() {}
+func (a *HostPathVolumeSourceAssertion) markAbsent() {
+	a.absent = true
+}
 
 // ISCSIPersistentVolumeSourceAssertion is the assertion struct for ISCSIPersistentVolumeSource.
 type ISCSIPersistentVolumeSourceAssertion struct {
@@ -2651,9 +3803,14 @@ type ISCSIPersistentVolumeSourceAssertion struct {
 	SessionCHAPAuth   Opt[bool]
 	SecretRef         SecretReferenceAssertion
 	InitiatorName     Opt[*string]
+
+	absent bool
 }
 
 func (_ ISCSIPersistentVolumeSourceAssertion) isAssertable() {}
+func (a *ISCSIPersistentVolumeSourceAssertion) markAbsent() {
+	a.absent = true
+}
 
 // ISCSIVolumeSourceAssertion is the assertion struct for ISCSIVolumeSource.
 type ISCSIVolumeSourceAssertion struct {
@@ -2668,35 +3825,55 @@ type ISCSIVolumeSourceAssertion struct {
 	SessionCHAPAuth   Opt[bool]
 	SecretRef         CoreLocalObjectReferenceAssertion
 	InitiatorName     Opt[*string]
+
+	absent bool
 }
 
 func (_ ISCSIVolumeSourceAssertion) isAssertable() {}
+func (a *ISCSIVolumeSourceAssertion) markAbsent() {
+	a.absent = true
+}
 
 // ImageVolumeSourceAssertion is the assertion struct for ImageVolumeSource.
 type ImageVolumeSourceAssertion struct {
 	Reference  Opt[string]
 	PullPolicy Opt[v11.PullPolicy]
+
+	absent bool
 }
 
 func (_ ImageVolumeSourceAssertion) isAssertable() {}
+func (a *ImageVolumeSourceAssertion) markAbsent() {
+	a.absent = true
+}
 
 // KeyToPathAssertion is the assertion struct for KeyToPath.
 type KeyToPathAssertion struct {
 	Key  Opt[string]
 	Path Opt[string]
 	Mode Opt[*int32]
+
+	absent bool
 }
 
 func (_ KeyToPathAssertion) isAssertable() {}
+func (a *KeyToPathAssertion) markAbsent() {
+	a.absent = true
+}
 
 // LifecycleAssertion is the assertion struct for Lifecycle.
 type LifecycleAssertion struct {
 	PostStart  LifecycleHandlerAssertion
 	PreStop    LifecycleHandlerAssertion
 	StopSignal Opt[*v11.Signal]
+
+	absent bool
 }
 
 func (_ LifecycleAssertion) isAssertable() {}
+func (a *LifecycleAssertion) markAbsent() {
+	a.absent = true
+}
 
 // LifecycleHandlerAssertion is the assertion struct for LifecycleHandler.
 type LifecycleHandlerAssertion struct {
@@ -2704,18 +3881,28 @@ type LifecycleHandlerAssertion struct {
 	HTTPGet   HTTPGetActionAssertion
 	TCPSocket TCPSocketActionAssertion
 	Sleep     SleepActionAssertion
+
+	absent bool
 }
 
 func (_ LifecycleHandlerAssertion) isAssertable() {}
+func (a *LifecycleHandlerAssertion) markAbsent() {
+	a.absent = true
+}
 
 // LimitRangeAssertion is the assertion struct for LimitRange.
 type LimitRangeAssertion struct {
 	TypeMeta   TypeMetaAssertion
 	ObjectMeta ObjectMetaAssertion
 	Spec       LimitRangeSpecAssertion
+
+	absent bool
 }
 
 func (_ LimitRangeAssertion) isAssertable() {}
+func (a *LimitRangeAssertion) markAbsent() {
+	a.absent = true
+}
 
 // LimitRangeItemAssertion is the assertion struct for LimitRangeItem.
 type LimitRangeItemAssertion struct {
@@ -2725,25 +3912,40 @@ type LimitRangeItemAssertion struct {
 	Default              Opt[v11.ResourceList]
 	DefaultRequest       Opt[v11.ResourceList]
 	MaxLimitRequestRatio Opt[v11.ResourceList]
+
+	absent bool
 }
 
 func (_ LimitRangeItemAssertion) isAssertable() {}
+func (a *LimitRangeItemAssertion) markAbsent() {
+	a.absent = true
+}
 
 // LimitRangeSpecAssertion is the assertion struct for LimitRangeSpec.
 type LimitRangeSpecAssertion struct {
 	Limits Opt[[]LimitRangeItemAssertion]
+
+	absent bool
 }
 
 func (_ LimitRangeSpecAssertion) isAssertable() {}
+func (a *LimitRangeSpecAssertion) markAbsent() {
+	a.absent = true
+}
 
 // LinuxContainerUserAssertion is the assertion struct for LinuxContainerUser.
 type LinuxContainerUserAssertion struct {
 	UID                Opt[int64]
 	GID                Opt[int64]
 	SupplementalGroups Opt[[]int64]
+
+	absent bool
 }
 
 func (_ LinuxContainerUserAssertion) isAssertable() {}
+func (a *LinuxContainerUserAssertion) markAbsent() {
+	a.absent = true
+}
 
 // LoadBalancerIngressAssertion is the assertion struct for LoadBalancerIngress.
 type LoadBalancerIngressAssertion struct {
@@ -2751,33 +3953,53 @@ type LoadBalancerIngressAssertion struct {
 	Hostname Opt[string]
 	IPMode   Opt[*v11.LoadBalancerIPMode]
 	Ports    Opt[[]v11.PortStatus]
+
+	absent bool
 }
 
 func (_ LoadBalancerIngressAssertion) isAssertable() {}
+func (a *LoadBalancerIngressAssertion) markAbsent() {
+	a.absent = true
+}
 
 // CoreLocalObjectReferenceAssertion is the assertion struct for LocalObjectReference.
 type CoreLocalObjectReferenceAssertion struct {
 	Name Opt[string]
+
+	absent bool
 }
 
 func (_ CoreLocalObjectReferenceAssertion) isAssertable() {}
+func (a *CoreLocalObjectReferenceAssertion) markAbsent() {
+	a.absent = true
+}
 
 // LocalVolumeSourceAssertion is the assertion struct for LocalVolumeSource.
 type LocalVolumeSourceAssertion struct {
 	Path   Opt[string]
 	FSType Opt[*string]
+
+	absent bool
 }
 
 func (_ LocalVolumeSourceAssertion) isAssertable() {}
+func (a *LocalVolumeSourceAssertion) markAbsent() {
+	a.absent = true
+}
 
 // NFSVolumeSourceAssertion is the assertion struct for NFSVolumeSource.
 type NFSVolumeSourceAssertion struct {
 	Server   Opt[string]
 	Path     Opt[string]
 	ReadOnly Opt[bool]
+
+	absent bool
 }
 
 func (_ NFSVolumeSourceAssertion) isAssertable() {}
+func (a *NFSVolumeSourceAssertion) markAbsent() {
+	a.absent = true
+}
 
 // NamespaceAssertion is the assertion struct for Namespace.
 type NamespaceAssertion struct {
@@ -2785,9 +4007,14 @@ type NamespaceAssertion struct {
 	ObjectMeta ObjectMetaAssertion
 	Spec       NamespaceSpecAssertion
 	Status     Opt[v11.NamespaceStatus]
+
+	absent bool
 }
 
 func (_ NamespaceAssertion) isAssertable() {}
+func (a *NamespaceAssertion) markAbsent() {
+	a.absent = true
+}
 
 // NamespaceConditionAssertion is the assertion struct for NamespaceCondition.
 type NamespaceConditionAssertion struct {
@@ -2796,16 +4023,26 @@ type NamespaceConditionAssertion struct {
 	LastTransitionTime TimeAssertion
 	Reason             Opt[string]
 	Message            Opt[string]
+
+	absent bool
 }
 
 func (_ NamespaceConditionAssertion) isAssertable() {}
+func (a *NamespaceConditionAssertion) markAbsent() {
+	a.absent = true
+}
 
 // NamespaceSpecAssertion is the assertion struct for NamespaceSpec.
 type NamespaceSpecAssertion struct {
 	Finalizers Opt[[]v11.FinalizerName]
+
+	absent bool
 }
 
 func (_ NamespaceSpecAssertion) isAssertable() {}
+func (a *NamespaceSpecAssertion) markAbsent() {
+	a.absent = true
+}
 
 // NodeAssertion is the assertion struct for Node.
 type NodeAssertion struct {
@@ -2813,25 +4050,40 @@ type NodeAssertion struct {
 	ObjectMeta ObjectMetaAssertion
 	Spec       NodeSpecAssertion
 	Status     Opt[v11.NodeStatus]
+
+	absent bool
 }
 
 func (_ NodeAssertion) isAssertable() {}
+func (a *NodeAssertion) markAbsent() {
+	a.absent = true
+}
 
 // NodeAddressAssertion is the assertion struct for NodeAddress.
 type NodeAddressAssertion struct {
 	Type    Opt[v11.NodeAddressType]
 	Address Opt[string]
+
+	absent bool
 }
 
 func (_ NodeAddressAssertion) isAssertable() {}
+func (a *NodeAddressAssertion) markAbsent() {
+	a.absent = true
+}
 
 // NodeAffinityAssertion is the assertion struct for NodeAffinity.
 type NodeAffinityAssertion struct {
 	RequiredDuringSchedulingIgnoredDuringExecution  NodeSelectorAssertion
 	PreferredDuringSchedulingIgnoredDuringExecution Opt[[]PreferredSchedulingTermAssertion]
+
+	absent bool
 }
 
 func (_ NodeAffinityAssertion) isAssertable() {}
+func (a *NodeAffinityAssertion) markAbsent() {
+	a.absent = true
+}
 
 // NodeConditionAssertion is the assertion struct for NodeCondition.
 type NodeConditionAssertion struct {
@@ -2841,78 +4093,128 @@ type NodeConditionAssertion struct {
 	LastTransitionTime TimeAssertion
 	Reason             Opt[string]
 	Message            Opt[string]
+
+	absent bool
 }
 
 func (_ NodeConditionAssertion) isAssertable() {}
+func (a *NodeConditionAssertion) markAbsent() {
+	a.absent = true
+}
 
 // NodeConfigSourceAssertion is the assertion struct for NodeConfigSource.
 type NodeConfigSourceAssertion struct {
 	ConfigMap ConfigMapNodeConfigSourceAssertion
+
+	absent bool
 }
 
 func (_ NodeConfigSourceAssertion) isAssertable() {}
+func (a *NodeConfigSourceAssertion) markAbsent() {
+	a.absent = true
+}
 
 // NodeDaemonEndpointsAssertion is the assertion struct for NodeDaemonEndpoints.
 type NodeDaemonEndpointsAssertion struct {
 	KubeletEndpoint DaemonEndpointAssertion
+
+	absent bool
 }
 
 func (_ NodeDaemonEndpointsAssertion) isAssertable() {}
+func (a *NodeDaemonEndpointsAssertion) markAbsent() {
+	a.absent = true
+}
 
 // NodeFeaturesAssertion is the assertion struct for NodeFeatures.
 type NodeFeaturesAssertion struct {
 	SupplementalGroupsPolicy Opt[*bool]
+
+	absent bool
 }
 
 func (_ NodeFeaturesAssertion) isAssertable() {}
+func (a *NodeFeaturesAssertion) markAbsent() {
+	a.absent = true
+}
 
 // NodeProxyOptionsAssertion is the assertion struct for NodeProxyOptions.
 type NodeProxyOptionsAssertion struct {
 	TypeMeta TypeMetaAssertion
 	Path     Opt[string]
+
+	absent bool
 }
 
 func (_ NodeProxyOptionsAssertion) isAssertable() {}
+func (a *NodeProxyOptionsAssertion) markAbsent() {
+	a.absent = true
+}
 
 // NodeRuntimeHandlerAssertion is the assertion struct for NodeRuntimeHandler.
 type NodeRuntimeHandlerAssertion struct {
 	Name     Opt[string]
 	Features NodeRuntimeHandlerFeaturesAssertion
+
+	absent bool
 }
 
 func (_ NodeRuntimeHandlerAssertion) isAssertable() {}
+func (a *NodeRuntimeHandlerAssertion) markAbsent() {
+	a.absent = true
+}
 
 // NodeRuntimeHandlerFeaturesAssertion is the assertion struct for NodeRuntimeHandlerFeatures.
 type NodeRuntimeHandlerFeaturesAssertion struct {
 	RecursiveReadOnlyMounts Opt[*bool]
 	UserNamespaces          Opt[*bool]
+
+	absent bool
 }
 
 func (_ NodeRuntimeHandlerFeaturesAssertion) isAssertable() {}
+func (a *NodeRuntimeHandlerFeaturesAssertion) markAbsent() {
+	a.absent = true
+}
 
 // NodeSelectorAssertion is the assertion struct for NodeSelector.
 type NodeSelectorAssertion struct {
 	NodeSelectorTerms Opt[[]NodeSelectorTermAssertion]
+
+	absent bool
 }
 
 func (_ NodeSelectorAssertion) isAssertable() {}
+func (a *NodeSelectorAssertion) markAbsent() {
+	a.absent = true
+}
 
 // NodeSelectorRequirementAssertion is the assertion struct for NodeSelectorRequirement.
 type NodeSelectorRequirementAssertion struct {
 	Key      Opt[string]
 	Operator Opt[v11.NodeSelectorOperator]
 	Values   Opt[[]string]
+
+	absent bool
 }
 
 func (_ NodeSelectorRequirementAssertion) isAssertable() {}
+func (a *NodeSelectorRequirementAssertion) markAbsent() {
+	a.absent = true
+}
 
 // NodeSelectorTermAssertion is the assertion struct for NodeSelectorTerm.
 type NodeSelectorTermAssertion struct {
 	MatchExpressions Opt[[]NodeSelectorRequirementAssertion]
 	MatchFields      Opt[[]NodeSelectorRequirementAssertion]
+
+	absent bool
 }
 
 func (_ NodeSelectorTermAssertion) isAssertable() {}
+func (a *NodeSelectorTermAssertion) markAbsent() {
+	a.absent = true
+}
 
 // NodeSpecAssertion is the assertion struct for NodeSpec.
 type NodeSpecAssertion struct {
@@ -2923,9 +4225,14 @@ type NodeSpecAssertion struct {
 	Taints             Opt[[]TaintAssertion]
 	ConfigSource       NodeConfigSourceAssertion
 	DoNotUseExternalID Opt[string]
+
+	absent bool
 }
 
 func (_ NodeSpecAssertion) isAssertable() {}
+func (a *NodeSpecAssertion) markAbsent() {
+	a.absent = true
+}
 
 // NodeSystemInfoAssertion is the assertion struct for NodeSystemInfo.
 type NodeSystemInfoAssertion struct {
@@ -2940,17 +4247,27 @@ type NodeSystemInfoAssertion struct {
 	OperatingSystem         Opt[string]
 	Architecture            Opt[string]
 	Swap                    Opt[*v11.NodeSwapStatus]
+
+	absent bool
 }
 
 func (_ NodeSystemInfoAssertion) isAssertable() {}
+func (a *NodeSystemInfoAssertion) markAbsent() {
+	a.absent = true
+}
 
 // ObjectFieldSelectorAssertion is the assertion struct for ObjectFieldSelector.
 type ObjectFieldSelectorAssertion struct {
 	APIVersion Opt[string]
 	FieldPath  Opt[string]
+
+	absent bool
 }
 
 func (_ ObjectFieldSelectorAssertion) isAssertable() {}
+func (a *ObjectFieldSelectorAssertion) markAbsent() {
+	a.absent = true
+}
 
 // CoreObjectReferenceAssertion is the assertion struct for ObjectReference.
 type CoreObjectReferenceAssertion struct {
@@ -2961,9 +4278,14 @@ type CoreObjectReferenceAssertion struct {
 	APIVersion      Opt[string]
 	ResourceVersion Opt[string]
 	FieldPath       Opt[string]
+
+	absent bool
 }
 
 func (_ CoreObjectReferenceAssertion) isAssertable() {}
+func (a *CoreObjectReferenceAssertion) markAbsent() {
+	a.absent = true
+}
 
 // PersistentVolumeAssertion is the assertion struct for PersistentVolume.
 type PersistentVolumeAssertion struct {
@@ -2971,9 +4293,14 @@ type PersistentVolumeAssertion struct {
 	ObjectMeta ObjectMetaAssertion
 	Spec       PersistentVolumeSpecAssertion
 	Status     Opt[v11.PersistentVolumeStatus]
+
+	absent bool
 }
 
 func (_ PersistentVolumeAssertion) isAssertable() {}
+func (a *PersistentVolumeAssertion) markAbsent() {
+	a.absent = true
+}
 
 // PersistentVolumeClaimAssertion is the assertion struct for PersistentVolumeClaim.
 type PersistentVolumeClaimAssertion struct {
@@ -2981,9 +4308,14 @@ type PersistentVolumeClaimAssertion struct {
 	ObjectMeta ObjectMetaAssertion
 	Spec       PersistentVolumeClaimSpecAssertion
 	Status     Opt[v11.PersistentVolumeClaimStatus]
+
+	absent bool
 }
 
 func (_ PersistentVolumeClaimAssertion) isAssertable() {}
+func (a *PersistentVolumeClaimAssertion) markAbsent() {
+	a.absent = true
+}
 
 // PersistentVolumeClaimConditionAssertion is the assertion struct for PersistentVolumeClaimCondition.
 type PersistentVolumeClaimConditionAssertion struct {
@@ -2993,9 +4325,14 @@ type PersistentVolumeClaimConditionAssertion struct {
 	LastTransitionTime TimeAssertion
 	Reason             Opt[string]
 	Message            Opt[string]
+
+	absent bool
 }
 
 func (_ PersistentVolumeClaimConditionAssertion) isAssertable() {}
+func (a *PersistentVolumeClaimConditionAssertion) markAbsent() {
+	a.absent = true
+}
 
 // PersistentVolumeClaimSpecAssertion is the assertion struct for PersistentVolumeClaimSpec.
 type PersistentVolumeClaimSpecAssertion struct {
@@ -3008,25 +4345,40 @@ type PersistentVolumeClaimSpecAssertion struct {
 	DataSource                TypedLocalObjectReferenceAssertion
 	DataSourceRef             TypedObjectReferenceAssertion
 	VolumeAttributesClassName Opt[*string]
+
+	absent bool
 }
 
 func (_ PersistentVolumeClaimSpecAssertion) isAssertable() {}
+func (a *PersistentVolumeClaimSpecAssertion) markAbsent() {
+	a.absent = true
+}
 
 // PersistentVolumeClaimTemplateAssertion is the assertion struct for PersistentVolumeClaimTemplate.
 type PersistentVolumeClaimTemplateAssertion struct {
 	ObjectMeta ObjectMetaAssertion
 	Spec       PersistentVolumeClaimSpecAssertion
+
+	absent bool
 }
 
 func (_ PersistentVolumeClaimTemplateAssertion) isAssertable() {}
+func (a *PersistentVolumeClaimTemplateAssertion) markAbsent() {
+	a.absent = true
+}
 
 // PersistentVolumeClaimVolumeSourceAssertion is the assertion struct for PersistentVolumeClaimVolumeSource.
 type PersistentVolumeClaimVolumeSourceAssertion struct {
 	ClaimName Opt[string]
 	ReadOnly  Opt[bool]
+
+	absent bool
 }
 
 func (_ PersistentVolumeClaimVolumeSourceAssertion) isAssertable() {}
+func (a *PersistentVolumeClaimVolumeSourceAssertion) markAbsent() {
+	a.absent = true
+}
 
 // PersistentVolumeSourceAssertion is the assertion struct for PersistentVolumeSource.
 type PersistentVolumeSourceAssertion struct {
@@ -3052,9 +4404,14 @@ type PersistentVolumeSourceAssertion struct {
 	Local                LocalVolumeSourceAssertion
 	StorageOS            StorageOSPersistentVolumeSourceAssertion
 	CSI                  CSIPersistentVolumeSourceAssertion
+
+	absent bool
 }
 
 func (_ PersistentVolumeSourceAssertion) isAssertable() {}
+func (a *PersistentVolumeSourceAssertion) markAbsent() {
+	a.absent = true
+}
 
 // PersistentVolumeSpecAssertion is the assertion struct for PersistentVolumeSpec.
 type PersistentVolumeSpecAssertion struct {
@@ -3068,17 +4425,27 @@ type PersistentVolumeSpecAssertion struct {
 	VolumeMode                    Opt[*v11.PersistentVolumeMode]
 	NodeAffinity                  VolumeNodeAffinityAssertion
 	VolumeAttributesClassName     Opt[*string]
+
+	absent bool
 }
 
 func (_ PersistentVolumeSpecAssertion) isAssertable() {}
+func (a *PersistentVolumeSpecAssertion) markAbsent() {
+	a.absent = true
+}
 
 // PhotonPersistentDiskVolumeSourceAssertion is the assertion struct for PhotonPersistentDiskVolumeSource.
 type PhotonPersistentDiskVolumeSourceAssertion struct {
 	PdID   Opt[string]
 	FSType Opt[string]
+
+	absent bool
 }
 
 func (_ PhotonPersistentDiskVolumeSourceAssertion) isAssertable() {}
+func (a *PhotonPersistentDiskVolumeSourceAssertion) markAbsent() {
+	a.absent = true
+}
 
 // PodAssertion is the assertion struct for Pod.
 type PodAssertion struct {
@@ -3086,17 +4453,27 @@ type PodAssertion struct {
 	ObjectMeta ObjectMetaAssertion
 	Spec       PodSpecAssertion
 	Status     Opt[v11.PodStatus]
+
+	absent bool
 }
 
 func (_ PodAssertion) isAssertable() {}
+func (a *PodAssertion) markAbsent() {
+	a.absent = true
+}
 
 // PodAffinityAssertion is the assertion struct for PodAffinity.
 type PodAffinityAssertion struct {
 	RequiredDuringSchedulingIgnoredDuringExecution  Opt[[]PodAffinityTermAssertion]
 	PreferredDuringSchedulingIgnoredDuringExecution Opt[[]WeightedPodAffinityTermAssertion]
+
+	absent bool
 }
 
 func (_ PodAffinityAssertion) isAssertable() {}
+func (a *PodAffinityAssertion) markAbsent() {
+	a.absent = true
+}
 
 // PodAffinityTermAssertion is the assertion struct for PodAffinityTerm.
 type PodAffinityTermAssertion struct {
@@ -3106,17 +4483,27 @@ type PodAffinityTermAssertion struct {
 	NamespaceSelector LabelSelectorAssertion
 	MatchLabelKeys    Opt[[]string]
 	MismatchLabelKeys Opt[[]string]
+
+	absent bool
 }
 
 func (_ PodAffinityTermAssertion) isAssertable() {}
+func (a *PodAffinityTermAssertion) markAbsent() {
+	a.absent = true
+}
 
 // PodAntiAffinityAssertion is the assertion struct for PodAntiAffinity.
 type PodAntiAffinityAssertion struct {
 	RequiredDuringSchedulingIgnoredDuringExecution  Opt[[]PodAffinityTermAssertion]
 	PreferredDuringSchedulingIgnoredDuringExecution Opt[[]WeightedPodAffinityTermAssertion]
+
+	absent bool
 }
 
 func (_ PodAntiAffinityAssertion) isAssertable() {}
+func (a *PodAntiAffinityAssertion) markAbsent() {
+	a.absent = true
+}
 
 // PodAttachOptionsAssertion is the assertion struct for PodAttachOptions.
 type PodAttachOptionsAssertion struct {
@@ -3126,9 +4513,14 @@ type PodAttachOptionsAssertion struct {
 	Stderr    Opt[bool]
 	TTY       Opt[bool]
 	Container Opt[string]
+
+	absent bool
 }
 
 func (_ PodAttachOptionsAssertion) isAssertable() {}
+func (a *PodAttachOptionsAssertion) markAbsent() {
+	a.absent = true
+}
 
 // PodCertificateProjectionAssertion is the assertion struct for PodCertificateProjection.
 type PodCertificateProjectionAssertion struct {
@@ -3139,9 +4531,14 @@ type PodCertificateProjectionAssertion struct {
 	KeyPath              Opt[string]
 	CertificateChainPath Opt[string]
 	UserAnnotations      Opt[map[string]string]
+
+	absent bool
 }
 
 func (_ PodCertificateProjectionAssertion) isAssertable() {}
+func (a *PodCertificateProjectionAssertion) markAbsent() {
+	a.absent = true
+}
 
 // PodConditionAssertion is the assertion struct for PodCondition.
 type PodConditionAssertion struct {
@@ -3152,26 +4549,41 @@ type PodConditionAssertion struct {
 	LastTransitionTime TimeAssertion
 	Reason             Opt[string]
 	Message            Opt[string]
+
+	absent bool
 }
 
 func (_ PodConditionAssertion) isAssertable() {}
+func (a *PodConditionAssertion) markAbsent() {
+	a.absent = true
+}
 
 // CorePodDNSConfigAssertion is the assertion struct for PodDNSConfig.
 type CorePodDNSConfigAssertion struct {
 	Nameservers Opt[[]string]
 	Searches    Opt[[]string]
 	Options     Opt[[]CorePodDNSConfigOptionAssertion]
+
+	absent bool
 }
 
 func (_ CorePodDNSConfigAssertion) isAssertable() {}
+func (a *CorePodDNSConfigAssertion) markAbsent() {
+	a.absent = true
+}
 
 // CorePodDNSConfigOptionAssertion is the assertion struct for PodDNSConfigOption.
 type CorePodDNSConfigOptionAssertion struct {
 	Name  Opt[string]
 	Value Opt[*string]
+
+	absent bool
 }
 
 func (_ CorePodDNSConfigOptionAssertion) isAssertable() {}
+func (a *CorePodDNSConfigOptionAssertion) markAbsent() {
+	a.absent = true
+}
 
 // PodExecOptionsAssertion is the assertion struct for PodExecOptions.
 type PodExecOptionsAssertion struct {
@@ -3182,16 +4594,26 @@ type PodExecOptionsAssertion struct {
 	TTY       Opt[bool]
 	Container Opt[string]
 	Command   Opt[[]string]
+
+	absent bool
 }
 
 func (_ PodExecOptionsAssertion) isAssertable() {}
+func (a *PodExecOptionsAssertion) markAbsent() {
+	a.absent = true
+}
 
 // PodIPAssertion is the assertion struct for PodIP.
 type PodIPAssertion struct {
 	IP Opt[string]
+
+	absent bool
 }
 
 func (_ PodIPAssertion) isAssertable() {}
+func (a *PodIPAssertion) markAbsent() {
+	a.absent = true
+}
 
 // PodLogOptionsAssertion is the assertion struct for PodLogOptions.
 type PodLogOptionsAssertion struct {
@@ -3206,55 +4628,90 @@ type PodLogOptionsAssertion struct {
 	LimitBytes                   Opt[*int64]
 	InsecureSkipTLSVerifyBackend Opt[bool]
 	Stream                       Opt[*string]
+
+	absent bool
 }
 
 func (_ PodLogOptionsAssertion) isAssertable() {}
+func (a *PodLogOptionsAssertion) markAbsent() {
+	a.absent = true
+}
 
 // PodOSAssertion is the assertion struct for PodOS.
 type PodOSAssertion struct {
 	Name Opt[v11.OSName]
+
+	absent bool
 }
 
 func (_ PodOSAssertion) isAssertable() {}
+func (a *PodOSAssertion) markAbsent() {
+	a.absent = true
+}
 
 // PodPortForwardOptionsAssertion is the assertion struct for PodPortForwardOptions.
 type PodPortForwardOptionsAssertion struct {
 	TypeMeta TypeMetaAssertion
 	Ports    Opt[[]int32]
+
+	absent bool
 }
 
 func (_ PodPortForwardOptionsAssertion) isAssertable() {}
+func (a *PodPortForwardOptionsAssertion) markAbsent() {
+	a.absent = true
+}
 
 // PodProxyOptionsAssertion is the assertion struct for PodProxyOptions.
 type PodProxyOptionsAssertion struct {
 	TypeMeta TypeMetaAssertion
 	Path     Opt[string]
+
+	absent bool
 }
 
 func (_ PodProxyOptionsAssertion) isAssertable() {}
+func (a *PodProxyOptionsAssertion) markAbsent() {
+	a.absent = true
+}
 
 // PodReadinessGateAssertion is the assertion struct for PodReadinessGate.
 type PodReadinessGateAssertion struct {
 	ConditionType Opt[v11.PodConditionType]
+
+	absent bool
 }
 
 func (_ PodReadinessGateAssertion) isAssertable() {}
+func (a *PodReadinessGateAssertion) markAbsent() {
+	a.absent = true
+}
 
 // PodResourceClaimAssertion is the assertion struct for PodResourceClaim.
 type PodResourceClaimAssertion struct {
 	Name                      Opt[string]
 	ResourceClaimName         Opt[*string]
 	ResourceClaimTemplateName Opt[*string]
+
+	absent bool
 }
 
 func (_ PodResourceClaimAssertion) isAssertable() {}
+func (a *PodResourceClaimAssertion) markAbsent() {
+	a.absent = true
+}
 
 // PodSchedulingGateAssertion is the assertion struct for PodSchedulingGate.
 type PodSchedulingGateAssertion struct {
 	Name Opt[string]
+
+	absent bool
 }
 
 func (_ PodSchedulingGateAssertion) isAssertable() {}
+func (a *PodSchedulingGateAssertion) markAbsent() {
+	a.absent = true
+}
 
 // PodSecurityContextAssertion is the assertion struct for PodSecurityContext.
 type PodSecurityContextAssertion struct {
@@ -3271,16 +4728,26 @@ type PodSecurityContextAssertion struct {
 	SeccompProfile           SeccompProfileAssertion
 	AppArmorProfile          AppArmorProfileAssertion
 	SELinuxChangePolicy      Opt[*v11.PodSELinuxChangePolicy]
+
+	absent bool
 }
 
 func (_ PodSecurityContextAssertion) isAssertable() {}
+func (a *PodSecurityContextAssertion) markAbsent() {
+	a.absent = true
+}
 
 // PodSignatureAssertion is the assertion struct for PodSignature.
 type PodSignatureAssertion struct {
 	PodController OwnerReferenceAssertion
+
+	absent bool
 }
 
 func (_ PodSignatureAssertion) isAssertable() {}
+func (a *PodSignatureAssertion) markAbsent() {
+	a.absent = true
+}
 
 // PodSpecAssertion is the assertion struct for PodSpec.
 type PodSpecAssertion struct {
@@ -3326,51 +4793,81 @@ type PodSpecAssertion struct {
 	Resources                     ResourceRequirementsAssertion
 	HostnameOverride              Opt[*string]
 	WorkloadRef                   WorkloadReferenceAssertion
+
+	absent bool
 }
 
 func (_ PodSpecAssertion) isAssertable() {}
+func (a *PodSpecAssertion) markAbsent() {
+	a.absent = true
+}
 
 // PodStatusResultAssertion is the assertion struct for PodStatusResult.
 type PodStatusResultAssertion struct {
 	TypeMeta   TypeMetaAssertion
 	ObjectMeta ObjectMetaAssertion
 	Status     Opt[v11.PodStatus]
+
+	absent bool
 }
 
 func (_ PodStatusResultAssertion) isAssertable() {}
+func (a *PodStatusResultAssertion) markAbsent() {
+	a.absent = true
+}
 
 // PodTemplateAssertion is the assertion struct for PodTemplate.
 type PodTemplateAssertion struct {
 	TypeMeta   TypeMetaAssertion
 	ObjectMeta ObjectMetaAssertion
 	Template   PodTemplateSpecAssertion
+
+	absent bool
 }
 
 func (_ PodTemplateAssertion) isAssertable() {}
+func (a *PodTemplateAssertion) markAbsent() {
+	a.absent = true
+}
 
 // PodTemplateSpecAssertion is the assertion struct for PodTemplateSpec.
 type PodTemplateSpecAssertion struct {
 	ObjectMeta ObjectMetaAssertion
 	Spec       PodSpecAssertion
+
+	absent bool
 }
 
 func (_ PodTemplateSpecAssertion) isAssertable() {}
+func (a *PodTemplateSpecAssertion) markAbsent() {
+	a.absent = true
+}
 
 // PortworxVolumeSourceAssertion is the assertion struct for PortworxVolumeSource.
 type PortworxVolumeSourceAssertion struct {
 	VolumeID Opt[string]
 	FSType   Opt[string]
 	ReadOnly Opt[bool]
+
+	absent bool
 }
 
 func (_ PortworxVolumeSourceAssertion) isAssertable() {}
+func (a *PortworxVolumeSourceAssertion) markAbsent() {
+	a.absent = true
+}
 
 // CorePreconditionsAssertion is the assertion struct for Preconditions.
 type CorePreconditionsAssertion struct {
 	UID Opt[*types1.UID]
+
+	absent bool
 }
 
 func (_ CorePreconditionsAssertion) isAssertable() {}
+func (a *CorePreconditionsAssertion) markAbsent() {
+	a.absent = true
+}
 
 // PreferAvoidPodsEntryAssertion is the assertion struct for PreferAvoidPodsEntry.
 type PreferAvoidPodsEntryAssertion struct {
@@ -3378,17 +4875,27 @@ type PreferAvoidPodsEntryAssertion struct {
 	EvictionTime TimeAssertion
 	Reason       Opt[string]
 	Message      Opt[string]
+
+	absent bool
 }
 
 func (_ PreferAvoidPodsEntryAssertion) isAssertable() {}
+func (a *PreferAvoidPodsEntryAssertion) markAbsent() {
+	a.absent = true
+}
 
 // PreferredSchedulingTermAssertion is the assertion struct for PreferredSchedulingTerm.
 type PreferredSchedulingTermAssertion struct {
 	Weight     Opt[int32]
 	Preference NodeSelectorTermAssertion
+
+	absent bool
 }
 
 func (_ PreferredSchedulingTermAssertion) isAssertable() {}
+func (a *PreferredSchedulingTermAssertion) markAbsent() {
+	a.absent = true
+}
 
 // CoreProbeAssertion is the assertion struct for Probe.
 type CoreProbeAssertion struct {
@@ -3399,9 +4906,14 @@ type CoreProbeAssertion struct {
 	SuccessThreshold              Opt[int32]
 	FailureThreshold              Opt[int32]
 	TerminationGracePeriodSeconds Opt[*int64]
+
+	absent bool
 }
 
 func (_ CoreProbeAssertion) isAssertable() {}
+func (a *CoreProbeAssertion) markAbsent() {
+	a.absent = true
+}
 
 // ProbeHandlerAssertion is the assertion struct for ProbeHandler.
 type ProbeHandlerAssertion struct {
@@ -3409,17 +4921,27 @@ type ProbeHandlerAssertion struct {
 	HTTPGet   HTTPGetActionAssertion
 	TCPSocket TCPSocketActionAssertion
 	GRPC      GRPCActionAssertion
+
+	absent bool
 }
 
 func (_ ProbeHandlerAssertion) isAssertable() {}
+func (a *ProbeHandlerAssertion) markAbsent() {
+	a.absent = true
+}
 
 // ProjectedVolumeSourceAssertion is the assertion struct for ProjectedVolumeSource.
 type ProjectedVolumeSourceAssertion struct {
 	Sources     Opt[[]VolumeProjectionAssertion]
 	DefaultMode Opt[*int32]
+
+	absent bool
 }
 
 func (_ ProjectedVolumeSourceAssertion) isAssertable() {}
+func (a *ProjectedVolumeSourceAssertion) markAbsent() {
+	a.absent = true
+}
 
 // QuobyteVolumeSourceAssertion is the assertion struct for QuobyteVolumeSource.
 type QuobyteVolumeSourceAssertion struct {
@@ -3429,9 +4951,14 @@ type QuobyteVolumeSourceAssertion struct {
 	User     Opt[string]
 	Group    Opt[string]
 	Tenant   Opt[string]
+
+	absent bool
 }
 
 func (_ QuobyteVolumeSourceAssertion) isAssertable() {}
+func (a *QuobyteVolumeSourceAssertion) markAbsent() {
+	a.absent = true
+}
 
 // RBDPersistentVolumeSourceAssertion is the assertion struct for RBDPersistentVolumeSource.
 type RBDPersistentVolumeSourceAssertion struct {
@@ -3443,9 +4970,14 @@ type RBDPersistentVolumeSourceAssertion struct {
 	Keyring      Opt[string]
 	SecretRef    SecretReferenceAssertion
 	ReadOnly     Opt[bool]
+
+	absent bool
 }
 
 func (_ RBDPersistentVolumeSourceAssertion) isAssertable() {}
+func (a *RBDPersistentVolumeSourceAssertion) markAbsent() {
+	a.absent = true
+}
 
 // RBDVolumeSourceAssertion is the assertion struct for RBDVolumeSource.
 type RBDVolumeSourceAssertion struct {
@@ -3457,9 +4989,14 @@ type RBDVolumeSourceAssertion struct {
 	Keyring      Opt[string]
 	SecretRef    CoreLocalObjectReferenceAssertion
 	ReadOnly     Opt[bool]
+
+	absent bool
 }
 
 func (_ RBDVolumeSourceAssertion) isAssertable() {}
+func (a *RBDVolumeSourceAssertion) markAbsent() {
+	a.absent = true
+}
 
 // RangeAllocationAssertion is the assertion struct for RangeAllocation.
 type RangeAllocationAssertion struct {
@@ -3467,9 +5004,14 @@ type RangeAllocationAssertion struct {
 	ObjectMeta ObjectMetaAssertion
 	Range      Opt[string]
 	Data       Opt[[]byte]
+
+	absent bool
 }
 
 func (_ RangeAllocationAssertion) isAssertable() {}
+func (a *RangeAllocationAssertion) markAbsent() {
+	a.absent = true
+}
 
 // ReplicationControllerAssertion is the assertion struct for ReplicationController.
 type ReplicationControllerAssertion struct {
@@ -3477,9 +5019,14 @@ type ReplicationControllerAssertion struct {
 	ObjectMeta ObjectMetaAssertion
 	Spec       ReplicationControllerSpecAssertion
 	Status     Opt[v11.ReplicationControllerStatus]
+
+	absent bool
 }
 
 func (_ ReplicationControllerAssertion) isAssertable() {}
+func (a *ReplicationControllerAssertion) markAbsent() {
+	a.absent = true
+}
 
 // ReplicationControllerConditionAssertion is the assertion struct for ReplicationControllerCondition.
 type ReplicationControllerConditionAssertion struct {
@@ -3488,9 +5035,14 @@ type ReplicationControllerConditionAssertion struct {
 	LastTransitionTime TimeAssertion
 	Reason             Opt[string]
 	Message            Opt[string]
+
+	absent bool
 }
 
 func (_ ReplicationControllerConditionAssertion) isAssertable() {}
+func (a *ReplicationControllerConditionAssertion) markAbsent() {
+	a.absent = true
+}
 
 // ReplicationControllerSpecAssertion is the assertion struct for ReplicationControllerSpec.
 type ReplicationControllerSpecAssertion struct {
@@ -3498,34 +5050,54 @@ type ReplicationControllerSpecAssertion struct {
 	MinReadySeconds Opt[int32]
 	Selector        Opt[map[string]string]
 	Template        PodTemplateSpecAssertion
+
+	absent bool
 }
 
 func (_ ReplicationControllerSpecAssertion) isAssertable() {}
+func (a *ReplicationControllerSpecAssertion) markAbsent() {
+	a.absent = true
+}
 
 // ResourceClaimAssertion is the assertion struct for ResourceClaim.
 type ResourceClaimAssertion struct {
 	Name    Opt[string]
 	Request Opt[string]
+
+	absent bool
 }
 
 func (_ ResourceClaimAssertion) isAssertable() {}
+func (a *ResourceClaimAssertion) markAbsent() {
+	a.absent = true
+}
 
 // ResourceFieldSelectorAssertion is the assertion struct for ResourceFieldSelector.
 type ResourceFieldSelectorAssertion struct {
 	ContainerName Opt[string]
 	Resource      Opt[string]
 	Divisor       QuantityAssertion
+
+	absent bool
 }
 
 func (_ ResourceFieldSelectorAssertion) isAssertable() {}
+func (a *ResourceFieldSelectorAssertion) markAbsent() {
+	a.absent = true
+}
 
 // ResourceHealthAssertion is the assertion struct for ResourceHealth.
 type ResourceHealthAssertion struct {
 	ResourceID Opt[v11.ResourceID]
 	Health     Opt[v11.ResourceHealthStatus]
+
+	absent bool
 }
 
 func (_ ResourceHealthAssertion) isAssertable() {}
+func (a *ResourceHealthAssertion) markAbsent() {
+	a.absent = true
+}
 
 // ResourceQuotaAssertion is the assertion struct for ResourceQuota.
 type ResourceQuotaAssertion struct {
@@ -3533,27 +5105,42 @@ type ResourceQuotaAssertion struct {
 	ObjectMeta ObjectMetaAssertion
 	Spec       ResourceQuotaSpecAssertion
 	Status     Opt[v11.ResourceQuotaStatus]
+
+	absent bool
 }
 
 func (_ ResourceQuotaAssertion) isAssertable() {}
+func (a *ResourceQuotaAssertion) markAbsent() {
+	a.absent = true
+}
 
 // ResourceQuotaSpecAssertion is the assertion struct for ResourceQuotaSpec.
 type ResourceQuotaSpecAssertion struct {
 	Hard          Opt[v11.ResourceList]
 	Scopes        Opt[[]v11.ResourceQuotaScope]
 	ScopeSelector ScopeSelectorAssertion
+
+	absent bool
 }
 
 func (_ ResourceQuotaSpecAssertion) isAssertable() {}
+func (a *ResourceQuotaSpecAssertion) markAbsent() {
+	a.absent = true
+}
 
 // ResourceRequirementsAssertion is the assertion struct for ResourceRequirements.
 type ResourceRequirementsAssertion struct {
 	Limits   Opt[v11.ResourceList]
 	Requests Opt[v11.ResourceList]
 	Claims   Opt[[]ResourceClaimAssertion]
+
+	absent bool
 }
 
 func (_ ResourceRequirementsAssertion) isAssertable() {}
+func (a *ResourceRequirementsAssertion) markAbsent() {
+	a.absent = true
+}
 
 // SELinuxOptionsAssertion is the assertion struct for SELinuxOptions.
 type SELinuxOptionsAssertion struct {
@@ -3561,9 +5148,14 @@ type SELinuxOptionsAssertion struct {
 	Role  Opt[string]
 	Type  Opt[string]
 	Level Opt[string]
+
+	absent bool
 }
 
 func (_ SELinuxOptionsAssertion) isAssertable() {}
+func (a *SELinuxOptionsAssertion) markAbsent() {
+	a.absent = true
+}
 
 // ScaleIOPersistentVolumeSourceAssertion is the assertion struct for ScaleIOPersistentVolumeSource.
 type ScaleIOPersistentVolumeSourceAssertion struct {
@@ -3577,9 +5169,14 @@ type ScaleIOPersistentVolumeSourceAssertion struct {
 	VolumeName       Opt[string]
 	FSType           Opt[string]
 	ReadOnly         Opt[bool]
+
+	absent bool
 }
 
 func (_ ScaleIOPersistentVolumeSourceAssertion) isAssertable() {}
+func (a *ScaleIOPersistentVolumeSourceAssertion) markAbsent() {
+	a.absent = true
+}
 
 // ScaleIOVolumeSourceAssertion is the assertion struct for ScaleIOVolumeSource.
 type ScaleIOVolumeSourceAssertion struct {
@@ -3593,41 +5190,66 @@ type ScaleIOVolumeSourceAssertion struct {
 	VolumeName       Opt[string]
 	FSType           Opt[string]
 	ReadOnly         Opt[bool]
+
+	absent bool
 }
 
 func (_ ScaleIOVolumeSourceAssertion) isAssertable() {}
+func (a *ScaleIOVolumeSourceAssertion) markAbsent() {
+	a.absent = true
+}
 
 // CoreSchemeGroupVersionAssertion is the assertion struct for GroupVersion.
 type CoreSchemeGroupVersionAssertion struct {
 	Group   Opt[string]
 	Version Opt[string]
+
+	absent bool
 }
 
 func (_ CoreSchemeGroupVersionAssertion) isAssertable() {}
+func (a *CoreSchemeGroupVersionAssertion) markAbsent() {
+	a.absent = true
+}
 
 // ScopeSelectorAssertion is the assertion struct for ScopeSelector.
 type ScopeSelectorAssertion struct {
 	MatchExpressions Opt[[]ScopedResourceSelectorRequirementAssertion]
+
+	absent bool
 }
 
 func (_ ScopeSelectorAssertion) isAssertable() {}
+func (a *ScopeSelectorAssertion) markAbsent() {
+	a.absent = true
+}
 
 // ScopedResourceSelectorRequirementAssertion is the assertion struct for ScopedResourceSelectorRequirement.
 type ScopedResourceSelectorRequirementAssertion struct {
 	ScopeName Opt[v11.ResourceQuotaScope]
 	Operator  Opt[v11.ScopeSelectorOperator]
 	Values    Opt[[]string]
+
+	absent bool
 }
 
 func (_ ScopedResourceSelectorRequirementAssertion) isAssertable() {}
+func (a *ScopedResourceSelectorRequirementAssertion) markAbsent() {
+	a.absent = true
+}
 
 // SeccompProfileAssertion is the assertion struct for SeccompProfile.
 type SeccompProfileAssertion struct {
 	Type             Opt[v11.SeccompProfileType]
 	LocalhostProfile Opt[*string]
+
+	absent bool
 }
 
 func (_ SeccompProfileAssertion) isAssertable() {}
+func (a *SeccompProfileAssertion) markAbsent() {
+	a.absent = true
+}
 
 // SecretAssertion is the assertion struct for Secret.
 type SecretAssertion struct {
@@ -3637,43 +5259,68 @@ type SecretAssertion struct {
 	Data       Opt[map[string][]byte]
 	StringData Opt[map[string]string]
 	Type       Opt[v11.SecretType]
+
+	absent bool
 }
 
 func (_ SecretAssertion) isAssertable() {}
+func (a *SecretAssertion) markAbsent() {
+	a.absent = true
+}
 
 // SecretEnvSourceAssertion is the assertion struct for SecretEnvSource.
 type SecretEnvSourceAssertion struct {
 	LocalObjectReference CoreLocalObjectReferenceAssertion
 	Optional             Opt[*bool]
+
+	absent bool
 }
 
 func (_ SecretEnvSourceAssertion) isAssertable() {}
+func (a *SecretEnvSourceAssertion) markAbsent() {
+	a.absent = true
+}
 
 // SecretKeySelectorAssertion is the assertion struct for SecretKeySelector.
 type SecretKeySelectorAssertion struct {
 	LocalObjectReference CoreLocalObjectReferenceAssertion
 	Key                  Opt[string]
 	Optional             Opt[*bool]
+
+	absent bool
 }
 
 func (_ SecretKeySelectorAssertion) isAssertable() {}
+func (a *SecretKeySelectorAssertion) markAbsent() {
+	a.absent = true
+}
 
 // SecretProjectionAssertion is the assertion struct for SecretProjection.
 type SecretProjectionAssertion struct {
 	LocalObjectReference CoreLocalObjectReferenceAssertion
 	Items                Opt[[]KeyToPathAssertion]
 	Optional             Opt[*bool]
+
+	absent bool
 }
 
 func (_ SecretProjectionAssertion) isAssertable() {}
+func (a *SecretProjectionAssertion) markAbsent() {
+	a.absent = true
+}
 
 // SecretReferenceAssertion is the assertion struct for SecretReference.
 type SecretReferenceAssertion struct {
 	Name      Opt[string]
 	Namespace Opt[string]
+
+	absent bool
 }
 
 func (_ SecretReferenceAssertion) isAssertable() {}
+func (a *SecretReferenceAssertion) markAbsent() {
+	a.absent = true
+}
 
 // SecretVolumeSourceAssertion is the assertion struct for SecretVolumeSource.
 type SecretVolumeSourceAssertion struct {
@@ -3681,9 +5328,14 @@ type SecretVolumeSourceAssertion struct {
 	Items       Opt[[]KeyToPathAssertion]
 	DefaultMode Opt[*int32]
 	Optional    Opt[*bool]
+
+	absent bool
 }
 
 func (_ SecretVolumeSourceAssertion) isAssertable() {}
+func (a *SecretVolumeSourceAssertion) markAbsent() {
+	a.absent = true
+}
 
 // SecurityContextAssertion is the assertion struct for SecurityContext.
 type SecurityContextAssertion struct {
@@ -3699,17 +5351,27 @@ type SecurityContextAssertion struct {
 	ProcMount                Opt[*v11.ProcMountType]
 	SeccompProfile           SeccompProfileAssertion
 	AppArmorProfile          AppArmorProfileAssertion
+
+	absent bool
 }
 
 func (_ SecurityContextAssertion) isAssertable() {}
+func (a *SecurityContextAssertion) markAbsent() {
+	a.absent = true
+}
 
 // SerializedReferenceAssertion is the assertion struct for SerializedReference.
 type SerializedReferenceAssertion struct {
 	TypeMeta  TypeMetaAssertion
 	Reference CoreObjectReferenceAssertion
+
+	absent bool
 }
 
 func (_ SerializedReferenceAssertion) isAssertable() {}
+func (a *SerializedReferenceAssertion) markAbsent() {
+	a.absent = true
+}
 
 // ServiceAssertion is the assertion struct for Service.
 type ServiceAssertion struct {
@@ -3717,9 +5379,14 @@ type ServiceAssertion struct {
 	ObjectMeta ObjectMetaAssertion
 	Spec       ServiceSpecAssertion
 	Status     Opt[v11.ServiceStatus]
+
+	absent bool
 }
 
 func (_ ServiceAssertion) isAssertable() {}
+func (a *ServiceAssertion) markAbsent() {
+	a.absent = true
+}
 
 // ServiceAccountAssertion is the assertion struct for ServiceAccount.
 type ServiceAccountAssertion struct {
@@ -3728,18 +5395,28 @@ type ServiceAccountAssertion struct {
 	Secrets                      Opt[[]CoreObjectReferenceAssertion]
 	ImagePullSecrets             Opt[[]CoreLocalObjectReferenceAssertion]
 	AutomountServiceAccountToken Opt[*bool]
+
+	absent bool
 }
 
 func (_ ServiceAccountAssertion) isAssertable() {}
+func (a *ServiceAccountAssertion) markAbsent() {
+	a.absent = true
+}
 
 // ServiceAccountTokenProjectionAssertion is the assertion struct for ServiceAccountTokenProjection.
 type ServiceAccountTokenProjectionAssertion struct {
 	Audience          Opt[string]
 	ExpirationSeconds Opt[*int64]
 	Path              Opt[string]
+
+	absent bool
 }
 
 func (_ ServiceAccountTokenProjectionAssertion) isAssertable() {}
+func (a *ServiceAccountTokenProjectionAssertion) markAbsent() {
+	a.absent = true
+}
 
 // ServicePortAssertion is the assertion struct for ServicePort.
 type ServicePortAssertion struct {
@@ -3749,17 +5426,27 @@ type ServicePortAssertion struct {
 	Port        Opt[int32]
 	TargetPort  IntOrStringAssertion
 	NodePort    Opt[int32]
+
+	absent bool
 }
 
 func (_ ServicePortAssertion) isAssertable() {}
+func (a *ServicePortAssertion) markAbsent() {
+	a.absent = true
+}
 
 // ServiceProxyOptionsAssertion is the assertion struct for ServiceProxyOptions.
 type ServiceProxyOptionsAssertion struct {
 	TypeMeta TypeMetaAssertion
 	Path     Opt[string]
+
+	absent bool
 }
 
 func (_ ServiceProxyOptionsAssertion) isAssertable() {}
+func (a *ServiceProxyOptionsAssertion) markAbsent() {
+	a.absent = true
+}
 
 // ServiceSpecAssertion is the assertion struct for ServiceSpec.
 type ServiceSpecAssertion struct {
@@ -3783,23 +5470,38 @@ type ServiceSpecAssertion struct {
 	LoadBalancerClass             Opt[*string]
 	InternalTrafficPolicy         Opt[*v11.ServiceInternalTrafficPolicy]
 	TrafficDistribution           Opt[*string]
+
+	absent bool
 }
 
 func (_ ServiceSpecAssertion) isAssertable() {}
+func (a *ServiceSpecAssertion) markAbsent() {
+	a.absent = true
+}
 
 // SessionAffinityConfigAssertion is the assertion struct for SessionAffinityConfig.
 type SessionAffinityConfigAssertion struct {
 	ClientIP ClientIPConfigAssertion
+
+	absent bool
 }
 
 func (_ SessionAffinityConfigAssertion) isAssertable() {}
+func (a *SessionAffinityConfigAssertion) markAbsent() {
+	a.absent = true
+}
 
 // SleepActionAssertion is the assertion struct for SleepAction.
 type SleepActionAssertion struct {
 	Seconds Opt[int64]
+
+	absent bool
 }
 
 func (_ SleepActionAssertion) isAssertable() {}
+func (a *SleepActionAssertion) markAbsent() {
+	a.absent = true
+}
 
 // StorageOSPersistentVolumeSourceAssertion is the assertion struct for StorageOSPersistentVolumeSource.
 type StorageOSPersistentVolumeSourceAssertion struct {
@@ -3808,9 +5510,14 @@ type StorageOSPersistentVolumeSourceAssertion struct {
 	FSType          Opt[string]
 	ReadOnly        Opt[bool]
 	SecretRef       CoreObjectReferenceAssertion
+
+	absent bool
 }
 
 func (_ StorageOSPersistentVolumeSourceAssertion) isAssertable() {}
+func (a *StorageOSPersistentVolumeSourceAssertion) markAbsent() {
+	a.absent = true
+}
 
 // StorageOSVolumeSourceAssertion is the assertion struct for StorageOSVolumeSource.
 type StorageOSVolumeSourceAssertion struct {
@@ -3819,25 +5526,40 @@ type StorageOSVolumeSourceAssertion struct {
 	FSType          Opt[string]
 	ReadOnly        Opt[bool]
 	SecretRef       CoreLocalObjectReferenceAssertion
+
+	absent bool
 }
 
 func (_ StorageOSVolumeSourceAssertion) isAssertable() {}
+func (a *StorageOSVolumeSourceAssertion) markAbsent() {
+	a.absent = true
+}
 
 // SysctlAssertion is the assertion struct for Sysctl.
 type SysctlAssertion struct {
 	Name  Opt[string]
 	Value Opt[string]
+
+	absent bool
 }
 
 func (_ SysctlAssertion) isAssertable() {}
+func (a *SysctlAssertion) markAbsent() {
+	a.absent = true
+}
 
 // TCPSocketActionAssertion is the assertion struct for TCPSocketAction.
 type TCPSocketActionAssertion struct {
 	Port IntOrStringAssertion
 	Host Opt[string]
+
+	absent bool
 }
 
 func (_ TCPSocketActionAssertion) isAssertable() {}
+func (a *TCPSocketActionAssertion) markAbsent() {
+	a.absent = true
+}
 
 // TaintAssertion is the assertion struct for Taint.
 type TaintAssertion struct {
@@ -3845,9 +5567,14 @@ type TaintAssertion struct {
 	Value     Opt[string]
 	Effect    Opt[v11.TaintEffect]
 	TimeAdded TimeAssertion
+
+	absent bool
 }
 
 func (_ TaintAssertion) isAssertable() {}
+func (a *TaintAssertion) markAbsent() {
+	a.absent = true
+}
 
 // TolerationAssertion is the assertion struct for Toleration.
 type TolerationAssertion struct {
@@ -3856,24 +5583,39 @@ type TolerationAssertion struct {
 	Value             Opt[string]
 	Effect            Opt[v11.TaintEffect]
 	TolerationSeconds Opt[*int64]
+
+	absent bool
 }
 
 func (_ TolerationAssertion) isAssertable() {}
+func (a *TolerationAssertion) markAbsent() {
+	a.absent = true
+}
 
 // TopologySelectorLabelRequirementAssertion is the assertion struct for TopologySelectorLabelRequirement.
 type TopologySelectorLabelRequirementAssertion struct {
 	Key    Opt[string]
 	Values Opt[[]string]
+
+	absent bool
 }
 
 func (_ TopologySelectorLabelRequirementAssertion) isAssertable() {}
+func (a *TopologySelectorLabelRequirementAssertion) markAbsent() {
+	a.absent = true
+}
 
 // TopologySelectorTermAssertion is the assertion struct for TopologySelectorTerm.
 type TopologySelectorTermAssertion struct {
 	MatchLabelExpressions Opt[[]TopologySelectorLabelRequirementAssertion]
+
+	absent bool
 }
 
 func (_ TopologySelectorTermAssertion) isAssertable() {}
+func (a *TopologySelectorTermAssertion) markAbsent() {
+	a.absent = true
+}
 
 // CoreTopologySpreadConstraintAssertion is the assertion struct for TopologySpreadConstraint.
 type CoreTopologySpreadConstraintAssertion struct {
@@ -3885,18 +5627,28 @@ type CoreTopologySpreadConstraintAssertion struct {
 	NodeAffinityPolicy Opt[*v11.NodeInclusionPolicy]
 	NodeTaintsPolicy   Opt[*v11.NodeInclusionPolicy]
 	MatchLabelKeys     Opt[[]string]
+
+	absent bool
 }
 
 func (_ CoreTopologySpreadConstraintAssertion) isAssertable() {}
+func (a *CoreTopologySpreadConstraintAssertion) markAbsent() {
+	a.absent = true
+}
 
 // TypedLocalObjectReferenceAssertion is the assertion struct for TypedLocalObjectReference.
 type TypedLocalObjectReferenceAssertion struct {
 	APIGroup Opt[*string]
 	Kind     Opt[string]
 	Name     Opt[string]
+
+	absent bool
 }
 
 func (_ TypedLocalObjectReferenceAssertion) isAssertable() {}
+func (a *TypedLocalObjectReferenceAssertion) markAbsent() {
+	a.absent = true
+}
 
 // TypedObjectReferenceAssertion is the assertion struct for TypedObjectReference.
 type TypedObjectReferenceAssertion struct {
@@ -3904,25 +5656,40 @@ type TypedObjectReferenceAssertion struct {
 	Kind      Opt[string]
 	Name      Opt[string]
 	Namespace Opt[*string]
+
+	absent bool
 }
 
 func (_ TypedObjectReferenceAssertion) isAssertable() {}
+func (a *TypedObjectReferenceAssertion) markAbsent() {
+	a.absent = true
+}
 
 // VolumeAssertion is the assertion struct for Volume.
 type VolumeAssertion struct {
 	Name         Opt[string]
 	VolumeSource VolumeSourceAssertion
+
+	absent bool
 }
 
 func (_ VolumeAssertion) isAssertable() {}
+func (a *VolumeAssertion) markAbsent() {
+	a.absent = true
+}
 
 // VolumeDeviceAssertion is the assertion struct for VolumeDevice.
 type VolumeDeviceAssertion struct {
 	Name       Opt[string]
 	DevicePath Opt[string]
+
+	absent bool
 }
 
 func (_ VolumeDeviceAssertion) isAssertable() {}
+func (a *VolumeDeviceAssertion) markAbsent() {
+	a.absent = true
+}
 
 // VolumeMountAssertion is the assertion struct for VolumeMount.
 type VolumeMountAssertion struct {
@@ -3933,16 +5700,26 @@ type VolumeMountAssertion struct {
 	SubPath           Opt[string]
 	MountPropagation  Opt[*v11.MountPropagationMode]
 	SubPathExpr       Opt[string]
+
+	absent bool
 }
 
 func (_ VolumeMountAssertion) isAssertable() {}
+func (a *VolumeMountAssertion) markAbsent() {
+	a.absent = true
+}
 
 // VolumeNodeAffinityAssertion is the assertion struct for VolumeNodeAffinity.
 type VolumeNodeAffinityAssertion struct {
 	Required NodeSelectorAssertion
+
+	absent bool
 }
 
 func (_ VolumeNodeAffinityAssertion) isAssertable() {}
+func (a *VolumeNodeAffinityAssertion) markAbsent() {
+	a.absent = true
+}
 
 // VolumeProjectionAssertion is the assertion struct for VolumeProjection.
 type VolumeProjectionAssertion struct {
@@ -3952,17 +5729,27 @@ type VolumeProjectionAssertion struct {
 	ServiceAccountToken ServiceAccountTokenProjectionAssertion
 	ClusterTrustBundle  ClusterTrustBundleProjectionAssertion
 	PodCertificate      PodCertificateProjectionAssertion
+
+	absent bool
 }
 
 func (_ VolumeProjectionAssertion) isAssertable() {}
+func (a *VolumeProjectionAssertion) markAbsent() {
+	a.absent = true
+}
 
 // VolumeResourceRequirementsAssertion is the assertion struct for VolumeResourceRequirements.
 type VolumeResourceRequirementsAssertion struct {
 	Limits   Opt[v11.ResourceList]
 	Requests Opt[v11.ResourceList]
+
+	absent bool
 }
 
 func (_ VolumeResourceRequirementsAssertion) isAssertable() {}
+func (a *VolumeResourceRequirementsAssertion) markAbsent() {
+	a.absent = true
+}
 
 // VolumeSourceAssertion is the assertion struct for VolumeSource.
 type VolumeSourceAssertion struct {
@@ -3996,9 +5783,14 @@ type VolumeSourceAssertion struct {
 	CSI                   CSIVolumeSourceAssertion
 	Ephemeral             EphemeralVolumeSourceAssertion
 	Image                 ImageVolumeSourceAssertion
+
+	absent bool
 }
 
 func (_ VolumeSourceAssertion) isAssertable() {}
+func (a *VolumeSourceAssertion) markAbsent() {
+	a.absent = true
+}
 
 // VsphereVirtualDiskVolumeSourceAssertion is the assertion struct for VsphereVirtualDiskVolumeSource.
 type VsphereVirtualDiskVolumeSourceAssertion struct {
@@ -4006,17 +5798,27 @@ type VsphereVirtualDiskVolumeSourceAssertion struct {
 	FSType            Opt[string]
 	StoragePolicyName Opt[string]
 	StoragePolicyID   Opt[string]
+
+	absent bool
 }
 
 func (_ VsphereVirtualDiskVolumeSourceAssertion) isAssertable() {}
+func (a *VsphereVirtualDiskVolumeSourceAssertion) markAbsent() {
+	a.absent = true
+}
 
 // WeightedPodAffinityTermAssertion is the assertion struct for WeightedPodAffinityTerm.
 type WeightedPodAffinityTermAssertion struct {
 	Weight          Opt[int32]
 	PodAffinityTerm PodAffinityTermAssertion
+
+	absent bool
 }
 
 func (_ WeightedPodAffinityTermAssertion) isAssertable() {}
+func (a *WeightedPodAffinityTermAssertion) markAbsent() {
+	a.absent = true
+}
 
 // WindowsSecurityContextOptionsAssertion is the assertion struct for WindowsSecurityContextOptions.
 type WindowsSecurityContextOptionsAssertion struct {
@@ -4024,58 +5826,93 @@ type WindowsSecurityContextOptionsAssertion struct {
 	GMSACredentialSpec     Opt[*string]
 	RunAsUserName          Opt[*string]
 	HostProcess            Opt[*bool]
+
+	absent bool
 }
 
 func (_ WindowsSecurityContextOptionsAssertion) isAssertable() {}
+func (a *WindowsSecurityContextOptionsAssertion) markAbsent() {
+	a.absent = true
+}
 
 // WorkloadReferenceAssertion is the assertion struct for WorkloadReference.
 type WorkloadReferenceAssertion struct {
 	Name               Opt[string]
 	PodGroup           Opt[string]
 	PodGroupReplicaKey Opt[string]
+
+	absent bool
 }
 
 func (_ WorkloadReferenceAssertion) isAssertable() {}
+func (a *WorkloadReferenceAssertion) markAbsent() {
+	a.absent = true
+}
 
 // HTTPIngressPathAssertion is the assertion struct for HTTPIngressPath.
 type HTTPIngressPathAssertion struct {
 	Path     Opt[string]
 	PathType Opt[*v14.PathType]
 	Backend  IngressBackendAssertion
+
+	absent bool
 }
 
 func (_ HTTPIngressPathAssertion) isAssertable() {}
+func (a *HTTPIngressPathAssertion) markAbsent() {
+	a.absent = true
+}
 
 // HTTPIngressRuleValueAssertion is the assertion struct for HTTPIngressRuleValue.
 type HTTPIngressRuleValueAssertion struct {
 	Paths Opt[[]HTTPIngressPathAssertion]
+
+	absent bool
 }
 
 func (_ HTTPIngressRuleValueAssertion) isAssertable() {}
+func (a *HTTPIngressRuleValueAssertion) markAbsent() {
+	a.absent = true
+}
 
 // IPAddressAssertion is the assertion struct for IPAddress.
 type IPAddressAssertion struct {
 	TypeMeta   TypeMetaAssertion
 	ObjectMeta ObjectMetaAssertion
 	Spec       IPAddressSpecAssertion
+
+	absent bool
 }
 
 func (_ IPAddressAssertion) isAssertable() {}
+func (a *IPAddressAssertion) markAbsent() {
+	a.absent = true
+}
 
 // IPAddressSpecAssertion is the assertion struct for IPAddressSpec.
 type IPAddressSpecAssertion struct {
 	ParentRef NetworkingParentReferenceAssertion
+
+	absent bool
 }
 
 func (_ IPAddressSpecAssertion) isAssertable() {}
+func (a *IPAddressSpecAssertion) markAbsent() {
+	a.absent = true
+}
 
 // IPBlockAssertion is the assertion struct for IPBlock.
 type IPBlockAssertion struct {
 	CIDR   Opt[string]
 	Except Opt[[]string]
+
+	absent bool
 }
 
 func (_ IPBlockAssertion) isAssertable() {}
+func (a *IPBlockAssertion) markAbsent() {
+	a.absent = true
+}
 
 // IngressAssertion is the assertion struct for Ingress.
 type IngressAssertion struct {
@@ -4083,26 +5920,41 @@ type IngressAssertion struct {
 	ObjectMeta ObjectMetaAssertion
 	Spec       IngressSpecAssertion
 	Status     Opt[v14.IngressStatus]
+
+	absent bool
 }
 
 func (_ IngressAssertion) isAssertable() {}
+func (a *IngressAssertion) markAbsent() {
+	a.absent = true
+}
 
 // IngressBackendAssertion is the assertion struct for IngressBackend.
 type IngressBackendAssertion struct {
 	Service  IngressServiceBackendAssertion
 	Resource TypedLocalObjectReferenceAssertion
+
+	absent bool
 }
 
 func (_ IngressBackendAssertion) isAssertable() {}
+func (a *IngressBackendAssertion) markAbsent() {
+	a.absent = true
+}
 
 // IngressClassAssertion is the assertion struct for IngressClass.
 type IngressClassAssertion struct {
 	TypeMeta   TypeMetaAssertion
 	ObjectMeta ObjectMetaAssertion
 	Spec       IngressClassSpecAssertion
+
+	absent bool
 }
 
 func (_ IngressClassAssertion) isAssertable() {}
+func (a *IngressClassAssertion) markAbsent() {
+	a.absent = true
+}
 
 // IngressClassParametersReferenceAssertion is the assertion struct for IngressClassParametersReference.
 type IngressClassParametersReferenceAssertion struct {
@@ -4111,49 +5963,79 @@ type IngressClassParametersReferenceAssertion struct {
 	Name      Opt[string]
 	Scope     Opt[*string]
 	Namespace Opt[*string]
+
+	absent bool
 }
 
 func (_ IngressClassParametersReferenceAssertion) isAssertable() {}
+func (a *IngressClassParametersReferenceAssertion) markAbsent() {
+	a.absent = true
+}
 
 // IngressClassSpecAssertion is the assertion struct for IngressClassSpec.
 type IngressClassSpecAssertion struct {
 	Controller Opt[string]
 	Parameters IngressClassParametersReferenceAssertion
+
+	absent bool
 }
 
 func (_ IngressClassSpecAssertion) isAssertable() {}
+func (a *IngressClassSpecAssertion) markAbsent() {
+	a.absent = true
+}
 
 // IngressLoadBalancerIngressAssertion is the assertion struct for IngressLoadBalancerIngress.
 type IngressLoadBalancerIngressAssertion struct {
 	IP       Opt[string]
 	Hostname Opt[string]
 	Ports    Opt[[]v14.IngressPortStatus]
+
+	absent bool
 }
 
 func (_ IngressLoadBalancerIngressAssertion) isAssertable() {}
+func (a *IngressLoadBalancerIngressAssertion) markAbsent() {
+	a.absent = true
+}
 
 // IngressRuleAssertion is the assertion struct for IngressRule.
 type IngressRuleAssertion struct {
 	Host             Opt[string]
 	IngressRuleValue IngressRuleValueAssertion
+
+	absent bool
 }
 
 func (_ IngressRuleAssertion) isAssertable() {}
+func (a *IngressRuleAssertion) markAbsent() {
+	a.absent = true
+}
 
 // IngressRuleValueAssertion is the assertion struct for IngressRuleValue.
 type IngressRuleValueAssertion struct {
 	HTTP HTTPIngressRuleValueAssertion
+
+	absent bool
 }
 
 func (_ IngressRuleValueAssertion) isAssertable() {}
+func (a *IngressRuleValueAssertion) markAbsent() {
+	a.absent = true
+}
 
 // IngressServiceBackendAssertion is the assertion struct for IngressServiceBackend.
 type IngressServiceBackendAssertion struct {
 	Name Opt[string]
 	Port ServiceBackendPortAssertion
+
+	absent bool
 }
 
 func (_ IngressServiceBackendAssertion) isAssertable() {}
+func (a *IngressServiceBackendAssertion) markAbsent() {
+	a.absent = true
+}
 
 // IngressSpecAssertion is the assertion struct for IngressSpec.
 type IngressSpecAssertion struct {
@@ -4161,60 +6043,95 @@ type IngressSpecAssertion struct {
 	DefaultBackend   IngressBackendAssertion
 	TLS              Opt[[]IngressTLSAssertion]
 	Rules            Opt[[]IngressRuleAssertion]
+
+	absent bool
 }
 
 func (_ IngressSpecAssertion) isAssertable() {}
+func (a *IngressSpecAssertion) markAbsent() {
+	a.absent = true
+}
 
 // IngressTLSAssertion is the assertion struct for IngressTLS.
 type IngressTLSAssertion struct {
 	Hosts      Opt[[]string]
 	SecretName Opt[string]
+
+	absent bool
 }
 
 func (_ IngressTLSAssertion) isAssertable() {}
+func (a *IngressTLSAssertion) markAbsent() {
+	a.absent = true
+}
 
 // NetworkPolicyAssertion is the assertion struct for NetworkPolicy.
 type NetworkPolicyAssertion struct {
 	TypeMeta   TypeMetaAssertion
 	ObjectMeta ObjectMetaAssertion
 	Spec       NetworkPolicySpecAssertion
+
+	absent bool
 }
 
 func (_ NetworkPolicyAssertion) isAssertable() {}
+func (a *NetworkPolicyAssertion) markAbsent() {
+	a.absent = true
+}
 
 // NetworkPolicyEgressRuleAssertion is the assertion struct for NetworkPolicyEgressRule.
 type NetworkPolicyEgressRuleAssertion struct {
 	Ports Opt[[]NetworkPolicyPortAssertion]
 	To    Opt[[]NetworkPolicyPeerAssertion]
+
+	absent bool
 }
 
 func (_ NetworkPolicyEgressRuleAssertion) isAssertable() {}
+func (a *NetworkPolicyEgressRuleAssertion) markAbsent() {
+	a.absent = true
+}
 
 // NetworkPolicyIngressRuleAssertion is the assertion struct for NetworkPolicyIngressRule.
 type NetworkPolicyIngressRuleAssertion struct {
 	Ports Opt[[]NetworkPolicyPortAssertion]
 	From  Opt[[]NetworkPolicyPeerAssertion]
+
+	absent bool
 }
 
 func (_ NetworkPolicyIngressRuleAssertion) isAssertable() {}
+func (a *NetworkPolicyIngressRuleAssertion) markAbsent() {
+	a.absent = true
+}
 
 // NetworkPolicyPeerAssertion is the assertion struct for NetworkPolicyPeer.
 type NetworkPolicyPeerAssertion struct {
 	PodSelector       LabelSelectorAssertion
 	NamespaceSelector LabelSelectorAssertion
 	IPBlock           IPBlockAssertion
+
+	absent bool
 }
 
 func (_ NetworkPolicyPeerAssertion) isAssertable() {}
+func (a *NetworkPolicyPeerAssertion) markAbsent() {
+	a.absent = true
+}
 
 // NetworkPolicyPortAssertion is the assertion struct for NetworkPolicyPort.
 type NetworkPolicyPortAssertion struct {
 	Protocol Opt[*v11.Protocol]
 	Port     IntOrStringAssertion
 	EndPort  Opt[*int32]
+
+	absent bool
 }
 
 func (_ NetworkPolicyPortAssertion) isAssertable() {}
+func (a *NetworkPolicyPortAssertion) markAbsent() {
+	a.absent = true
+}
 
 // NetworkPolicySpecAssertion is the assertion struct for NetworkPolicySpec.
 type NetworkPolicySpecAssertion struct {
@@ -4222,9 +6139,14 @@ type NetworkPolicySpecAssertion struct {
 	Ingress     Opt[[]NetworkPolicyIngressRuleAssertion]
 	Egress      Opt[[]NetworkPolicyEgressRuleAssertion]
 	PolicyTypes Opt[[]v14.PolicyType]
+
+	absent bool
 }
 
 func (_ NetworkPolicySpecAssertion) isAssertable() {}
+func (a *NetworkPolicySpecAssertion) markAbsent() {
+	a.absent = true
+}
 
 // NetworkingParentReferenceAssertion is the assertion struct for ParentReference.
 type NetworkingParentReferenceAssertion struct {
@@ -4232,25 +6154,40 @@ type NetworkingParentReferenceAssertion struct {
 	Resource  Opt[string]
 	Namespace Opt[string]
 	Name      Opt[string]
+
+	absent bool
 }
 
 func (_ NetworkingParentReferenceAssertion) isAssertable() {}
+func (a *NetworkingParentReferenceAssertion) markAbsent() {
+	a.absent = true
+}
 
 // NetworkingSchemeGroupVersionAssertion is the assertion struct for GroupVersion.
 type NetworkingSchemeGroupVersionAssertion struct {
 	Group   Opt[string]
 	Version Opt[string]
+
+	absent bool
 }
 
 func (_ NetworkingSchemeGroupVersionAssertion) isAssertable() {}
+func (a *NetworkingSchemeGroupVersionAssertion) markAbsent() {
+	a.absent = true
+}
 
 // ServiceBackendPortAssertion is the assertion struct for ServiceBackendPort.
 type ServiceBackendPortAssertion struct {
 	Name   Opt[string]
 	Number Opt[int32]
+
+	absent bool
 }
 
 func (_ ServiceBackendPortAssertion) isAssertable() {}
+func (a *ServiceBackendPortAssertion) markAbsent() {
+	a.absent = true
+}
 
 // ServiceCIDRAssertion is the assertion struct for ServiceCIDR.
 type ServiceCIDRAssertion struct {
@@ -4258,25 +6195,40 @@ type ServiceCIDRAssertion struct {
 	ObjectMeta ObjectMetaAssertion
 	Spec       ServiceCIDRSpecAssertion
 	Status     Opt[v14.ServiceCIDRStatus]
+
+	absent bool
 }
 
 func (_ ServiceCIDRAssertion) isAssertable() {}
+func (a *ServiceCIDRAssertion) markAbsent() {
+	a.absent = true
+}
 
 // ServiceCIDRSpecAssertion is the assertion struct for ServiceCIDRSpec.
 type ServiceCIDRSpecAssertion struct {
 	CIDRs Opt[[]string]
+
+	absent bool
 }
 
 func (_ ServiceCIDRSpecAssertion) isAssertable() {}
+func (a *ServiceCIDRSpecAssertion) markAbsent() {
+	a.absent = true
+}
 
 // EvictionAssertion is the assertion struct for Eviction.
 type EvictionAssertion struct {
 	TypeMeta      TypeMetaAssertion
 	ObjectMeta    ObjectMetaAssertion
 	DeleteOptions DeleteOptionsAssertion
+
+	absent bool
 }
 
 func (_ EvictionAssertion) isAssertable() {}
+func (a *EvictionAssertion) markAbsent() {
+	a.absent = true
+}
 
 // PodDisruptionBudgetAssertion is the assertion struct for PodDisruptionBudget.
 type PodDisruptionBudgetAssertion struct {
@@ -4284,9 +6236,14 @@ type PodDisruptionBudgetAssertion struct {
 	ObjectMeta ObjectMetaAssertion
 	Spec       PodDisruptionBudgetSpecAssertion
 	Status     Opt[v15.PodDisruptionBudgetStatus]
+
+	absent bool
 }
 
 func (_ PodDisruptionBudgetAssertion) isAssertable() {}
+func (a *PodDisruptionBudgetAssertion) markAbsent() {
+	a.absent = true
+}
 
 // PodDisruptionBudgetSpecAssertion is the assertion struct for PodDisruptionBudgetSpec.
 type PodDisruptionBudgetSpecAssertion struct {
@@ -4294,24 +6251,39 @@ type PodDisruptionBudgetSpecAssertion struct {
 	Selector                   LabelSelectorAssertion
 	MaxUnavailable             IntOrStringAssertion
 	UnhealthyPodEvictionPolicy Opt[*v15.UnhealthyPodEvictionPolicyType]
+
+	absent bool
 }
 
 func (_ PodDisruptionBudgetSpecAssertion) isAssertable() {}
+func (a *PodDisruptionBudgetSpecAssertion) markAbsent() {
+	a.absent = true
+}
 
 // PolicySchemeGroupVersionAssertion is the assertion struct for GroupVersion.
 type PolicySchemeGroupVersionAssertion struct {
 	Group   Opt[string]
 	Version Opt[string]
+
+	absent bool
 }
 
 func (_ PolicySchemeGroupVersionAssertion) isAssertable() {}
+func (a *PolicySchemeGroupVersionAssertion) markAbsent() {
+	a.absent = true
+}
 
 // AggregationRuleAssertion is the assertion struct for AggregationRule.
 type AggregationRuleAssertion struct {
 	ClusterRoleSelectors Opt[[]LabelSelectorAssertion]
+
+	absent bool
 }
 
 func (_ AggregationRuleAssertion) isAssertable() {}
+func (a *AggregationRuleAssertion) markAbsent() {
+	a.absent = true
+}
 
 // ClusterRoleAssertion is the assertion struct for ClusterRole.
 type ClusterRoleAssertion struct {
@@ -4319,9 +6291,14 @@ type ClusterRoleAssertion struct {
 	ObjectMeta      ObjectMetaAssertion
 	Rules           Opt[[]PolicyRuleAssertion]
 	AggregationRule AggregationRuleAssertion
+
+	absent bool
 }
 
 func (_ ClusterRoleAssertion) isAssertable() {}
+func (a *ClusterRoleAssertion) markAbsent() {
+	a.absent = true
+}
 
 // ClusterRoleBindingAssertion is the assertion struct for ClusterRoleBinding.
 type ClusterRoleBindingAssertion struct {
@@ -4329,9 +6306,14 @@ type ClusterRoleBindingAssertion struct {
 	ObjectMeta ObjectMetaAssertion
 	Subjects   Opt[[]SubjectAssertion]
 	RoleRef    RoleRefAssertion
+
+	absent bool
 }
 
 func (_ ClusterRoleBindingAssertion) isAssertable() {}
+func (a *ClusterRoleBindingAssertion) markAbsent() {
+	a.absent = true
+}
 
 // PolicyRuleAssertion is the assertion struct for PolicyRule.
 type PolicyRuleAssertion struct {
@@ -4340,18 +6322,28 @@ type PolicyRuleAssertion struct {
 	Resources       Opt[[]string]
 	ResourceNames   Opt[[]string]
 	NonResourceURLs Opt[[]string]
+
+	absent bool
 }
 
 func (_ PolicyRuleAssertion) isAssertable() {}
+func (a *PolicyRuleAssertion) markAbsent() {
+	a.absent = true
+}
 
 // RoleAssertion is the assertion struct for Role.
 type RoleAssertion struct {
 	TypeMeta   TypeMetaAssertion
 	ObjectMeta ObjectMetaAssertion
 	Rules      Opt[[]PolicyRuleAssertion]
+
+	absent bool
 }
 
 func (_ RoleAssertion) isAssertable() {}
+func (a *RoleAssertion) markAbsent() {
+	a.absent = true
+}
 
 // RoleBindingAssertion is the assertion struct for RoleBinding.
 type RoleBindingAssertion struct {
@@ -4359,26 +6351,41 @@ type RoleBindingAssertion struct {
 	ObjectMeta ObjectMetaAssertion
 	Subjects   Opt[[]SubjectAssertion]
 	RoleRef    RoleRefAssertion
+
+	absent bool
 }
 
 func (_ RoleBindingAssertion) isAssertable() {}
+func (a *RoleBindingAssertion) markAbsent() {
+	a.absent = true
+}
 
 // RoleRefAssertion is the assertion struct for RoleRef.
 type RoleRefAssertion struct {
 	APIGroup Opt[string]
 	Kind     Opt[string]
 	Name     Opt[string]
+
+	absent bool
 }
 
 func (_ RoleRefAssertion) isAssertable() {}
+func (a *RoleRefAssertion) markAbsent() {
+	a.absent = true
+}
 
 // RbacSchemeGroupVersionAssertion is the assertion struct for GroupVersion.
 type RbacSchemeGroupVersionAssertion struct {
 	Group   Opt[string]
 	Version Opt[string]
+
+	absent bool
 }
 
 func (_ RbacSchemeGroupVersionAssertion) isAssertable() {}
+func (a *RbacSchemeGroupVersionAssertion) markAbsent() {
+	a.absent = true
+}
 
 // SubjectAssertion is the assertion struct for Subject.
 type SubjectAssertion struct {
@@ -4386,18 +6393,28 @@ type SubjectAssertion struct {
 	APIGroup  Opt[string]
 	Name      Opt[string]
 	Namespace Opt[string]
+
+	absent bool
 }
 
 func (_ SubjectAssertion) isAssertable() {}
+func (a *SubjectAssertion) markAbsent() {
+	a.absent = true
+}
 
 // CSIDriverAssertion is the assertion struct for CSIDriver.
 type CSIDriverAssertion struct {
 	TypeMeta   TypeMetaAssertion
 	ObjectMeta ObjectMetaAssertion
 	Spec       CSIDriverSpecAssertion
+
+	absent bool
 }
 
 func (_ CSIDriverAssertion) isAssertable() {}
+func (a *CSIDriverAssertion) markAbsent() {
+	a.absent = true
+}
 
 // CSIDriverSpecAssertion is the assertion struct for CSIDriverSpec.
 type CSIDriverSpecAssertion struct {
@@ -4411,18 +6428,28 @@ type CSIDriverSpecAssertion struct {
 	SELinuxMount                       Opt[*bool]
 	NodeAllocatableUpdatePeriodSeconds Opt[*int64]
 	ServiceAccountTokenInSecrets       Opt[*bool]
+
+	absent bool
 }
 
 func (_ CSIDriverSpecAssertion) isAssertable() {}
+func (a *CSIDriverSpecAssertion) markAbsent() {
+	a.absent = true
+}
 
 // CSINodeAssertion is the assertion struct for CSINode.
 type CSINodeAssertion struct {
 	TypeMeta   TypeMetaAssertion
 	ObjectMeta ObjectMetaAssertion
 	Spec       CSINodeSpecAssertion
+
+	absent bool
 }
 
 func (_ CSINodeAssertion) isAssertable() {}
+func (a *CSINodeAssertion) markAbsent() {
+	a.absent = true
+}
 
 // CSINodeDriverAssertion is the assertion struct for CSINodeDriver.
 type CSINodeDriverAssertion struct {
@@ -4430,16 +6457,26 @@ type CSINodeDriverAssertion struct {
 	NodeID       Opt[string]
 	TopologyKeys Opt[[]string]
 	Allocatable  VolumeNodeResourcesAssertion
+
+	absent bool
 }
 
 func (_ CSINodeDriverAssertion) isAssertable() {}
+func (a *CSINodeDriverAssertion) markAbsent() {
+	a.absent = true
+}
 
 // CSINodeSpecAssertion is the assertion struct for CSINodeSpec.
 type CSINodeSpecAssertion struct {
 	Drivers Opt[[]CSINodeDriverAssertion]
+
+	absent bool
 }
 
 func (_ CSINodeSpecAssertion) isAssertable() {}
+func (a *CSINodeSpecAssertion) markAbsent() {
+	a.absent = true
+}
 
 // CSIStorageCapacityAssertion is the assertion struct for CSIStorageCapacity.
 type CSIStorageCapacityAssertion struct {
@@ -4449,17 +6486,27 @@ type CSIStorageCapacityAssertion struct {
 	StorageClassName  Opt[string]
 	Capacity          QuantityAssertion
 	MaximumVolumeSize QuantityAssertion
+
+	absent bool
 }
 
 func (_ CSIStorageCapacityAssertion) isAssertable() {}
+func (a *CSIStorageCapacityAssertion) markAbsent() {
+	a.absent = true
+}
 
 // StorageSchemeGroupVersionAssertion is the assertion struct for GroupVersion.
 type StorageSchemeGroupVersionAssertion struct {
 	Group   Opt[string]
 	Version Opt[string]
+
+	absent bool
 }
 
 func (_ StorageSchemeGroupVersionAssertion) isAssertable() {}
+func (a *StorageSchemeGroupVersionAssertion) markAbsent() {
+	a.absent = true
+}
 
 // StorageClassAssertion is the assertion struct for StorageClass.
 type StorageClassAssertion struct {
@@ -4472,17 +6519,27 @@ type StorageClassAssertion struct {
 	AllowVolumeExpansion Opt[*bool]
 	VolumeBindingMode    Opt[*v16.VolumeBindingMode]
 	AllowedTopologies    Opt[[]TopologySelectorTermAssertion]
+
+	absent bool
 }
 
 func (_ StorageClassAssertion) isAssertable() {}
+func (a *StorageClassAssertion) markAbsent() {
+	a.absent = true
+}
 
 // TokenRequestAssertion is the assertion struct for TokenRequest.
 type TokenRequestAssertion struct {
 	Audience          Opt[string]
 	ExpirationSeconds Opt[*int64]
+
+	absent bool
 }
 
 func (_ TokenRequestAssertion) isAssertable() {}
+func (a *TokenRequestAssertion) markAbsent() {
+	a.absent = true
+}
 
 // VolumeAttachmentAssertion is the assertion struct for VolumeAttachment.
 type VolumeAttachmentAssertion struct {
@@ -4490,26 +6547,41 @@ type VolumeAttachmentAssertion struct {
 	ObjectMeta ObjectMetaAssertion
 	Spec       VolumeAttachmentSpecAssertion
 	Status     Opt[v16.VolumeAttachmentStatus]
+
+	absent bool
 }
 
 func (_ VolumeAttachmentAssertion) isAssertable() {}
+func (a *VolumeAttachmentAssertion) markAbsent() {
+	a.absent = true
+}
 
 // VolumeAttachmentSourceAssertion is the assertion struct for VolumeAttachmentSource.
 type VolumeAttachmentSourceAssertion struct {
 	PersistentVolumeName Opt[*string]
 	InlineVolumeSpec     PersistentVolumeSpecAssertion
+
+	absent bool
 }
 
 func (_ VolumeAttachmentSourceAssertion) isAssertable() {}
+func (a *VolumeAttachmentSourceAssertion) markAbsent() {
+	a.absent = true
+}
 
 // VolumeAttachmentSpecAssertion is the assertion struct for VolumeAttachmentSpec.
 type VolumeAttachmentSpecAssertion struct {
 	Attacher Opt[string]
 	Source   VolumeAttachmentSourceAssertion
 	NodeName Opt[string]
+
+	absent bool
 }
 
 func (_ VolumeAttachmentSpecAssertion) isAssertable() {}
+func (a *VolumeAttachmentSpecAssertion) markAbsent() {
+	a.absent = true
+}
 
 // VolumeAttributesClassAssertion is the assertion struct for VolumeAttributesClass.
 type VolumeAttributesClassAssertion struct {
@@ -4517,44 +6589,74 @@ type VolumeAttributesClassAssertion struct {
 	ObjectMeta ObjectMetaAssertion
 	DriverName Opt[string]
 	Parameters Opt[map[string]string]
+
+	absent bool
 }
 
 func (_ VolumeAttributesClassAssertion) isAssertable() {}
+func (a *VolumeAttributesClassAssertion) markAbsent() {
+	a.absent = true
+}
 
 // VolumeErrorAssertion is the assertion struct for VolumeError.
 type VolumeErrorAssertion struct {
 	Time      TimeAssertion
 	Message   Opt[string]
 	ErrorCode Opt[*int32]
+
+	absent bool
 }
 
 func (_ VolumeErrorAssertion) isAssertable() {}
+func (a *VolumeErrorAssertion) markAbsent() {
+	a.absent = true
+}
 
 // VolumeNodeResourcesAssertion is the assertion struct for VolumeNodeResources.
 type VolumeNodeResourcesAssertion struct {
 	Count Opt[*int32]
+
+	absent bool
 }
 
 func (_ VolumeNodeResourcesAssertion) isAssertable() {}
+func (a *VolumeNodeResourcesAssertion) markAbsent() {
+	a.absent = true
+}
 
 // QuantityAssertion is the assertion struct for Quantity.
 type QuantityAssertion struct {
 	Format Opt[resource.Format]
+
+	absent bool
 }
 
 func (_ QuantityAssertion) isAssertable() {}
+func (a *QuantityAssertion) markAbsent() {
+	a.absent = true
+}
 
 // QuantityValueAssertion is the assertion struct for QuantityValue.
 type QuantityValueAssertion struct {
 	Quantity QuantityAssertion
+
+	absent bool
 }
 
 func (_ QuantityValueAssertion) isAssertable() {}
+func (a *QuantityValueAssertion) markAbsent() {
+	a.absent = true
+}
 
 // ZeroAssertion is the assertion struct for int64Amount.
-type ZeroAssertion struct{}
+type ZeroAssertion struct {
+	absent bool
+}
 
 func (_ ZeroAssertion) isAssertable() {}
+func (a *ZeroAssertion) markAbsent() {
+	a.absent = true
+}
 
 // APIGroupAssertion is the assertion struct for APIGroup.
 type APIGroupAssertion struct {
@@ -4563,9 +6665,14 @@ type APIGroupAssertion struct {
 	Versions                   Opt[[]GroupVersionForDiscoveryAssertion]
 	PreferredVersion           GroupVersionForDiscoveryAssertion
 	ServerAddressByClientCIDRs Opt[[]ServerAddressByClientCIDRAssertion]
+
+	absent bool
 }
 
 func (_ APIGroupAssertion) isAssertable() {}
+func (a *APIGroupAssertion) markAbsent() {
+	a.absent = true
+}
 
 // APIResourceAssertion is the assertion struct for APIResource.
 type APIResourceAssertion struct {
@@ -4579,18 +6686,28 @@ type APIResourceAssertion struct {
 	ShortNames         Opt[[]string]
 	Categories         Opt[[]string]
 	StorageVersionHash Opt[string]
+
+	absent bool
 }
 
 func (_ APIResourceAssertion) isAssertable() {}
+func (a *APIResourceAssertion) markAbsent() {
+	a.absent = true
+}
 
 // APIVersionsAssertion is the assertion struct for APIVersions.
 type APIVersionsAssertion struct {
 	TypeMeta                   TypeMetaAssertion
 	Versions                   Opt[[]string]
 	ServerAddressByClientCIDRs Opt[[]ServerAddressByClientCIDRAssertion]
+
+	absent bool
 }
 
 func (_ APIVersionsAssertion) isAssertable() {}
+func (a *APIVersionsAssertion) markAbsent() {
+	a.absent = true
+}
 
 // ApplyOptionsAssertion is the assertion struct for ApplyOptions.
 type ApplyOptionsAssertion struct {
@@ -4598,9 +6715,14 @@ type ApplyOptionsAssertion struct {
 	DryRun       Opt[[]string]
 	Force        Opt[bool]
 	FieldManager Opt[string]
+
+	absent bool
 }
 
 func (_ ApplyOptionsAssertion) isAssertable() {}
+func (a *ApplyOptionsAssertion) markAbsent() {
+	a.absent = true
+}
 
 // MetaConditionAssertion is the assertion struct for Condition.
 type MetaConditionAssertion struct {
@@ -4610,9 +6732,14 @@ type MetaConditionAssertion struct {
 	LastTransitionTime TimeAssertion
 	Reason             Opt[string]
 	Message            Opt[string]
+
+	absent bool
 }
 
 func (_ MetaConditionAssertion) isAssertable() {}
+func (a *MetaConditionAssertion) markAbsent() {
+	a.absent = true
+}
 
 // CreateOptionsAssertion is the assertion struct for CreateOptions.
 type CreateOptionsAssertion struct {
@@ -4620,9 +6747,14 @@ type CreateOptionsAssertion struct {
 	DryRun          Opt[[]string]
 	FieldManager    Opt[string]
 	FieldValidation Opt[string]
+
+	absent bool
 }
 
 func (_ CreateOptionsAssertion) isAssertable() {}
+func (a *CreateOptionsAssertion) markAbsent() {
+	a.absent = true
+}
 
 // DeleteOptionsAssertion is the assertion struct for DeleteOptions.
 type DeleteOptionsAssertion struct {
@@ -4633,115 +6765,185 @@ type DeleteOptionsAssertion struct {
 	PropagationPolicy                                Opt[*v17.DeletionPropagation]
 	DryRun                                           Opt[[]string]
 	IgnoreStoreReadErrorWithClusterBreakingPotential Opt[*bool]
+
+	absent bool
 }
 
 func (_ DeleteOptionsAssertion) isAssertable() {}
+func (a *DeleteOptionsAssertion) markAbsent() {
+	a.absent = true
+}
 
 // DurationAssertion is the assertion struct for Duration.
 type DurationAssertion struct {
 	Duration Opt[time.Duration]
+
+	absent bool
 }
 
 func (_ DurationAssertion) isAssertable() {}
+func (a *DurationAssertion) markAbsent() {
+	a.absent = true
+}
 
 // FieldSelectorRequirementAssertion is the assertion struct for FieldSelectorRequirement.
 type FieldSelectorRequirementAssertion struct {
 	Key      Opt[string]
 	Operator Opt[v17.FieldSelectorOperator]
 	Values   Opt[[]string]
+
+	absent bool
 }
 
 func (_ FieldSelectorRequirementAssertion) isAssertable() {}
+func (a *FieldSelectorRequirementAssertion) markAbsent() {
+	a.absent = true
+}
 
 // FieldsV1Assertion is the assertion struct for FieldsV1.
 type FieldsV1Assertion struct {
 	Raw Opt[[]byte]
+
+	absent bool
 }
 
 func (_ FieldsV1Assertion) isAssertable() {}
+func (a *FieldsV1Assertion) markAbsent() {
+	a.absent = true
+}
 
 // GetOptionsAssertion is the assertion struct for GetOptions.
 type GetOptionsAssertion struct {
 	TypeMeta        TypeMetaAssertion
 	ResourceVersion Opt[string]
+
+	absent bool
 }
 
 func (_ GetOptionsAssertion) isAssertable() {}
+func (a *GetOptionsAssertion) markAbsent() {
+	a.absent = true
+}
 
 // GroupKindAssertion is the assertion struct for GroupKind.
 type GroupKindAssertion struct {
 	Group Opt[string]
 	Kind  Opt[string]
+
+	absent bool
 }
 
 func (_ GroupKindAssertion) isAssertable() {}
+func (a *GroupKindAssertion) markAbsent() {
+	a.absent = true
+}
 
 // GroupResourceAssertion is the assertion struct for GroupResource.
 type GroupResourceAssertion struct {
 	Group    Opt[string]
 	Resource Opt[string]
+
+	absent bool
 }
 
 func (_ GroupResourceAssertion) isAssertable() {}
+func (a *GroupResourceAssertion) markAbsent() {
+	a.absent = true
+}
 
 // MetaGroupVersionAssertion is the assertion struct for GroupVersion.
 type MetaGroupVersionAssertion struct {
 	Group   Opt[string]
 	Version Opt[string]
+
+	absent bool
 }
 
 func (_ MetaGroupVersionAssertion) isAssertable() {}
+func (a *MetaGroupVersionAssertion) markAbsent() {
+	a.absent = true
+}
 
 // GroupVersionForDiscoveryAssertion is the assertion struct for GroupVersionForDiscovery.
 type GroupVersionForDiscoveryAssertion struct {
 	GroupVersion Opt[string]
 	Version      Opt[string]
+
+	absent bool
 }
 
 func (_ GroupVersionForDiscoveryAssertion) isAssertable() {}
+func (a *GroupVersionForDiscoveryAssertion) markAbsent() {
+	a.absent = true
+}
 
 // GroupVersionKindAssertion is the assertion struct for GroupVersionKind.
 type GroupVersionKindAssertion struct {
 	Group   Opt[string]
 	Version Opt[string]
 	Kind    Opt[string]
+
+	absent bool
 }
 
 func (_ GroupVersionKindAssertion) isAssertable() {}
+func (a *GroupVersionKindAssertion) markAbsent() {
+	a.absent = true
+}
 
 // GroupVersionResourceAssertion is the assertion struct for GroupVersionResource.
 type GroupVersionResourceAssertion struct {
 	Group    Opt[string]
 	Version  Opt[string]
 	Resource Opt[string]
+
+	absent bool
 }
 
 func (_ GroupVersionResourceAssertion) isAssertable() {}
+func (a *GroupVersionResourceAssertion) markAbsent() {
+	a.absent = true
+}
 
 // InternalEventAssertion is the assertion struct for InternalEvent.
 type InternalEventAssertion struct {
 	Type   Opt[watch.EventType]
 	Object Opt[runtime.Object]
+
+	absent bool
 }
 
 func (_ InternalEventAssertion) isAssertable() {}
+func (a *InternalEventAssertion) markAbsent() {
+	a.absent = true
+}
 
 // LabelSelectorAssertion is the assertion struct for LabelSelector.
 type LabelSelectorAssertion struct {
 	MatchLabels      Opt[map[string]string]
 	MatchExpressions Opt[[]LabelSelectorRequirementAssertion]
+
+	absent bool
 }
 
 func (_ LabelSelectorAssertion) isAssertable() {}
+func (a *LabelSelectorAssertion) markAbsent() {
+	a.absent = true
+}
 
 // LabelSelectorRequirementAssertion is the assertion struct for LabelSelectorRequirement.
 type LabelSelectorRequirementAssertion struct {
 	Key      Opt[string]
 	Operator Opt[v17.LabelSelectorOperator]
 	Values   Opt[[]string]
+
+	absent bool
 }
 
 func (_ LabelSelectorRequirementAssertion) isAssertable() {}
+func (a *LabelSelectorRequirementAssertion) markAbsent() {
+	a.absent = true
+}
 
 // ListMetaAssertion is the assertion struct for ListMeta.
 type ListMetaAssertion struct {
@@ -4749,9 +6951,14 @@ type ListMetaAssertion struct {
 	ResourceVersion    Opt[string]
 	Continue           Opt[string]
 	RemainingItemCount Opt[*int64]
+
+	absent bool
 }
 
 func (_ ListMetaAssertion) isAssertable() {}
+func (a *ListMetaAssertion) markAbsent() {
+	a.absent = true
+}
 
 // ListOptionsAssertion is the assertion struct for ListOptions.
 type ListOptionsAssertion struct {
@@ -4766,9 +6973,14 @@ type ListOptionsAssertion struct {
 	Limit                Opt[int64]
 	Continue             Opt[string]
 	SendInitialEvents    Opt[*bool]
+
+	absent bool
 }
 
 func (_ ListOptionsAssertion) isAssertable() {}
+func (a *ListOptionsAssertion) markAbsent() {
+	a.absent = true
+}
 
 // ManagedFieldsEntryAssertion is the assertion struct for ManagedFieldsEntry.
 type ManagedFieldsEntryAssertion struct {
@@ -4779,16 +6991,26 @@ type ManagedFieldsEntryAssertion struct {
 	FieldsType  Opt[string]
 	FieldsV1    FieldsV1Assertion
 	Subresource Opt[string]
+
+	absent bool
 }
 
 func (_ ManagedFieldsEntryAssertion) isAssertable() {}
+func (a *ManagedFieldsEntryAssertion) markAbsent() {
+	a.absent = true
+}
 
 // MicroTimeAssertion is the assertion struct for MicroTime.
 type MicroTimeAssertion struct {
 	Time Opt[time.Time]
+
+	absent bool
 }
 
 func (_ MicroTimeAssertion) isAssertable() {}
+func (a *MicroTimeAssertion) markAbsent() {
+	a.absent = true
+}
 
 // ObjectMetaAssertion is the assertion struct for ObjectMeta.
 type ObjectMetaAssertion struct {
@@ -4807,9 +7029,14 @@ type ObjectMetaAssertion struct {
 	OwnerReferences            Opt[[]OwnerReferenceAssertion]
 	Finalizers                 Opt[[]string]
 	ManagedFields              Opt[[]ManagedFieldsEntryAssertion]
+
+	absent bool
 }
 
 func (_ ObjectMetaAssertion) isAssertable() {}
+func (a *ObjectMetaAssertion) markAbsent() {
+	a.absent = true
+}
 
 // OwnerReferenceAssertion is the assertion struct for OwnerReference.
 type OwnerReferenceAssertion struct {
@@ -4819,22 +7046,37 @@ type OwnerReferenceAssertion struct {
 	UID                Opt[types1.UID]
 	Controller         Opt[*bool]
 	BlockOwnerDeletion Opt[*bool]
+
+	absent bool
 }
 
 func (_ OwnerReferenceAssertion) isAssertable() {}
+func (a *OwnerReferenceAssertion) markAbsent() {
+	a.absent = true
+}
 
 // PartialObjectMetadataAssertion is the assertion struct for PartialObjectMetadata.
 type PartialObjectMetadataAssertion struct {
 	TypeMeta   TypeMetaAssertion
 	ObjectMeta ObjectMetaAssertion
+
+	absent bool
 }
 
 func (_ PartialObjectMetadataAssertion) isAssertable() {}
+func (a *PartialObjectMetadataAssertion) markAbsent() {
+	a.absent = true
+}
 
 // PatchAssertion is the assertion struct for Patch.
-type PatchAssertion struct{}
+type PatchAssertion struct {
+	absent bool
+}
 
 func (_ PatchAssertion) isAssertable() {}
+func (a *PatchAssertion) markAbsent() {
+	a.absent = true
+}
 
 // PatchOptionsAssertion is the assertion struct for PatchOptions.
 type PatchOptionsAssertion struct {
@@ -4843,49 +7085,79 @@ type PatchOptionsAssertion struct {
 	Force           Opt[*bool]
 	FieldManager    Opt[string]
 	FieldValidation Opt[string]
+
+	absent bool
 }
 
 func (_ PatchOptionsAssertion) isAssertable() {}
+func (a *PatchOptionsAssertion) markAbsent() {
+	a.absent = true
+}
 
 // MetaPreconditionsAssertion is the assertion struct for Preconditions.
 type MetaPreconditionsAssertion struct {
 	UID             Opt[*types1.UID]
 	ResourceVersion Opt[*string]
+
+	absent bool
 }
 
 func (_ MetaPreconditionsAssertion) isAssertable() {}
+func (a *MetaPreconditionsAssertion) markAbsent() {
+	a.absent = true
+}
 
 // RootPathsAssertion is the assertion struct for RootPaths.
 type RootPathsAssertion struct {
 	Paths Opt[[]string]
+
+	absent bool
 }
 
 func (_ RootPathsAssertion) isAssertable() {}
+func (a *RootPathsAssertion) markAbsent() {
+	a.absent = true
+}
 
 // MetaSchemeGroupVersionAssertion is the assertion struct for GroupVersion.
 type MetaSchemeGroupVersionAssertion struct {
 	Group   Opt[string]
 	Version Opt[string]
+
+	absent bool
 }
 
 func (_ MetaSchemeGroupVersionAssertion) isAssertable() {}
+func (a *MetaSchemeGroupVersionAssertion) markAbsent() {
+	a.absent = true
+}
 
 // ServerAddressByClientCIDRAssertion is the assertion struct for ServerAddressByClientCIDR.
 type ServerAddressByClientCIDRAssertion struct {
 	ClientCIDR    Opt[string]
 	ServerAddress Opt[string]
+
+	absent bool
 }
 
 func (_ ServerAddressByClientCIDRAssertion) isAssertable() {}
+func (a *ServerAddressByClientCIDRAssertion) markAbsent() {
+	a.absent = true
+}
 
 // StatusCauseAssertion is the assertion struct for StatusCause.
 type StatusCauseAssertion struct {
 	Type    Opt[v17.CauseType]
 	Message Opt[string]
 	Field   Opt[string]
+
+	absent bool
 }
 
 func (_ StatusCauseAssertion) isAssertable() {}
+func (a *StatusCauseAssertion) markAbsent() {
+	a.absent = true
+}
 
 // StatusDetailsAssertion is the assertion struct for StatusDetails.
 type StatusDetailsAssertion struct {
@@ -4895,9 +7167,14 @@ type StatusDetailsAssertion struct {
 	UID               Opt[types1.UID]
 	Causes            Opt[[]StatusCauseAssertion]
 	RetryAfterSeconds Opt[int32]
+
+	absent bool
 }
 
 func (_ StatusDetailsAssertion) isAssertable() {}
+func (a *StatusDetailsAssertion) markAbsent() {
+	a.absent = true
+}
 
 // TableAssertion is the assertion struct for Table.
 type TableAssertion struct {
@@ -4905,9 +7182,14 @@ type TableAssertion struct {
 	ListMeta          ListMetaAssertion
 	ColumnDefinitions Opt[[]TableColumnDefinitionAssertion]
 	Rows              Opt[[]TableRowAssertion]
+
+	absent bool
 }
 
 func (_ TableAssertion) isAssertable() {}
+func (a *TableAssertion) markAbsent() {
+	a.absent = true
+}
 
 // TableColumnDefinitionAssertion is the assertion struct for TableColumnDefinition.
 type TableColumnDefinitionAssertion struct {
@@ -4916,27 +7198,42 @@ type TableColumnDefinitionAssertion struct {
 	Format      Opt[string]
 	Description Opt[string]
 	Priority    Opt[int32]
+
+	absent bool
 }
 
 func (_ TableColumnDefinitionAssertion) isAssertable() {}
+func (a *TableColumnDefinitionAssertion) markAbsent() {
+	a.absent = true
+}
 
 // TableOptionsAssertion is the assertion struct for TableOptions.
 type TableOptionsAssertion struct {
 	TypeMeta      TypeMetaAssertion
 	NoHeaders     Opt[bool]
 	IncludeObject Opt[v17.IncludeObjectPolicy]
+
+	absent bool
 }
 
 func (_ TableOptionsAssertion) isAssertable() {}
+func (a *TableOptionsAssertion) markAbsent() {
+	a.absent = true
+}
 
 // TableRowAssertion is the assertion struct for TableRow.
 type TableRowAssertion struct {
 	Cells      Opt[[]any]
 	Conditions Opt[[]TableRowConditionAssertion]
 	Object     Opt[runtime.RawExtension]
+
+	absent bool
 }
 
 func (_ TableRowAssertion) isAssertable() {}
+func (a *TableRowAssertion) markAbsent() {
+	a.absent = true
+}
 
 // TableRowConditionAssertion is the assertion struct for TableRowCondition.
 type TableRowConditionAssertion struct {
@@ -4944,40 +7241,65 @@ type TableRowConditionAssertion struct {
 	Status  Opt[v17.ConditionStatus]
 	Reason  Opt[string]
 	Message Opt[string]
+
+	absent bool
 }
 
 func (_ TableRowConditionAssertion) isAssertable() {}
+func (a *TableRowConditionAssertion) markAbsent() {
+	a.absent = true
+}
 
 // TimeAssertion is the assertion struct for Time.
 type TimeAssertion struct {
 	Time Opt[time.Time]
+
+	absent bool
 }
 
 func (_ TimeAssertion) isAssertable() {}
+func (a *TimeAssertion) markAbsent() {
+	a.absent = true
+}
 
 // TimestampAssertion is the assertion struct for Timestamp.
 type TimestampAssertion struct {
 	Seconds Opt[int64]
 	Nanos   Opt[int32]
+
+	absent bool
 }
 
 func (_ TimestampAssertion) isAssertable() {}
+func (a *TimestampAssertion) markAbsent() {
+	a.absent = true
+}
 
 // TypeMetaAssertion is the assertion struct for TypeMeta.
 type TypeMetaAssertion struct {
 	Kind       Opt[string]
 	APIVersion Opt[string]
+
+	absent bool
 }
 
 func (_ TypeMetaAssertion) isAssertable() {}
+func (a *TypeMetaAssertion) markAbsent() {
+	a.absent = true
+}
 
 // UnversionedAssertion is the assertion struct for GroupVersion.
 type UnversionedAssertion struct {
 	Group   Opt[string]
 	Version Opt[string]
+
+	absent bool
 }
 
 func (_ UnversionedAssertion) isAssertable() {}
+func (a *UnversionedAssertion) markAbsent() {
+	a.absent = true
+}
 
 // UpdateOptionsAssertion is the assertion struct for UpdateOptions.
 type UpdateOptionsAssertion struct {
@@ -4985,41 +7307,66 @@ type UpdateOptionsAssertion struct {
 	DryRun          Opt[[]string]
 	FieldManager    Opt[string]
 	FieldValidation Opt[string]
+
+	absent bool
 }
 
 func (_ UpdateOptionsAssertion) isAssertable() {}
+func (a *UpdateOptionsAssertion) markAbsent() {
+	a.absent = true
+}
 
 // WatchEventAssertion is the assertion struct for WatchEvent.
 type WatchEventAssertion struct {
 	Type   Opt[string]
 	Object Opt[runtime.RawExtension]
+
+	absent bool
 }
 
 func (_ WatchEventAssertion) isAssertable() {}
+func (a *WatchEventAssertion) markAbsent() {
+	a.absent = true
+}
 
 // IntOrStringAssertion is the assertion struct for IntOrString.
 type IntOrStringAssertion struct {
 	Type   Opt[intstr.Type]
 	IntVal Opt[int32]
 	StrVal Opt[string]
+
+	absent bool
 }
 
 func (_ IntOrStringAssertion) isAssertable() {}
+func (a *IntOrStringAssertion) markAbsent() {
+	a.absent = true
+}
 
 // AllowedListenersAssertion is the assertion struct for AllowedListeners.
 type AllowedListenersAssertion struct {
 	Namespaces ListenerNamespacesAssertion
+
+	absent bool
 }
 
 func (_ AllowedListenersAssertion) isAssertable() {}
+func (a *AllowedListenersAssertion) markAbsent() {
+	a.absent = true
+}
 
 // AllowedRoutesAssertion is the assertion struct for AllowedRoutes.
 type AllowedRoutesAssertion struct {
 	Namespaces RouteNamespacesAssertion
 	Kinds      Opt[[]RouteGroupKindAssertion]
+
+	absent bool
 }
 
 func (_ AllowedRoutesAssertion) isAssertable() {}
+func (a *AllowedRoutesAssertion) markAbsent() {
+	a.absent = true
+}
 
 // BackendObjectReferenceAssertion is the assertion struct for BackendObjectReference.
 type BackendObjectReferenceAssertion struct {
@@ -5028,17 +7375,27 @@ type BackendObjectReferenceAssertion struct {
 	Name      Opt[v18.ObjectName]
 	Namespace Opt[*v18.Namespace]
 	Port      Opt[*v18.PortNumber]
+
+	absent bool
 }
 
 func (_ BackendObjectReferenceAssertion) isAssertable() {}
+func (a *BackendObjectReferenceAssertion) markAbsent() {
+	a.absent = true
+}
 
 // BackendRefAssertion is the assertion struct for BackendRef.
 type BackendRefAssertion struct {
 	BackendObjectReference BackendObjectReferenceAssertion
 	Weight                 Opt[*int32]
+
+	absent bool
 }
 
 func (_ BackendRefAssertion) isAssertable() {}
+func (a *BackendRefAssertion) markAbsent() {
+	a.absent = true
+}
 
 // BackendTLSPolicyAssertion is the assertion struct for BackendTLSPolicy.
 type BackendTLSPolicyAssertion struct {
@@ -5046,18 +7403,28 @@ type BackendTLSPolicyAssertion struct {
 	ObjectMeta ObjectMetaAssertion
 	Spec       BackendTLSPolicySpecAssertion
 	Status     Opt[v18.PolicyStatus]
+
+	absent bool
 }
 
 func (_ BackendTLSPolicyAssertion) isAssertable() {}
+func (a *BackendTLSPolicyAssertion) markAbsent() {
+	a.absent = true
+}
 
 // BackendTLSPolicySpecAssertion is the assertion struct for BackendTLSPolicySpec.
 type BackendTLSPolicySpecAssertion struct {
 	TargetRefs Opt[[]LocalPolicyTargetReferenceWithSectionNameAssertion]
 	Validation BackendTLSPolicyValidationAssertion
 	Options    Opt[map[v18.AnnotationKey]v18.AnnotationValue]
+
+	absent bool
 }
 
 func (_ BackendTLSPolicySpecAssertion) isAssertable() {}
+func (a *BackendTLSPolicySpecAssertion) markAbsent() {
+	a.absent = true
+}
 
 // BackendTLSPolicyValidationAssertion is the assertion struct for BackendTLSPolicyValidation.
 type BackendTLSPolicyValidationAssertion struct {
@@ -5065,88 +7432,143 @@ type BackendTLSPolicyValidationAssertion struct {
 	WellKnownCACertificates Opt[*v18.WellKnownCACertificatesType]
 	Hostname                Opt[v18.PreciseHostname]
 	SubjectAltNames         Opt[[]SubjectAltNameAssertion]
+
+	absent bool
 }
 
 func (_ BackendTLSPolicyValidationAssertion) isAssertable() {}
+func (a *BackendTLSPolicyValidationAssertion) markAbsent() {
+	a.absent = true
+}
 
 // CommonRouteSpecAssertion is the assertion struct for CommonRouteSpec.
 type CommonRouteSpecAssertion struct {
 	ParentRefs         Opt[[]ApisParentReferenceAssertion]
 	UseDefaultGateways Opt[v18.GatewayDefaultScope]
+
+	absent bool
 }
 
 func (_ CommonRouteSpecAssertion) isAssertable() {}
+func (a *CommonRouteSpecAssertion) markAbsent() {
+	a.absent = true
+}
 
 // CookieConfigAssertion is the assertion struct for CookieConfig.
 type CookieConfigAssertion struct {
 	LifetimeType Opt[*v18.CookieLifetimeType]
+
+	absent bool
 }
 
 func (_ CookieConfigAssertion) isAssertable() {}
+func (a *CookieConfigAssertion) markAbsent() {
+	a.absent = true
+}
 
 // ForwardBodyConfigAssertion is the assertion struct for ForwardBodyConfig.
 type ForwardBodyConfigAssertion struct {
 	MaxSize Opt[uint16]
+
+	absent bool
 }
 
 func (_ ForwardBodyConfigAssertion) isAssertable() {}
+func (a *ForwardBodyConfigAssertion) markAbsent() {
+	a.absent = true
+}
 
 // FractionAssertion is the assertion struct for Fraction.
 type FractionAssertion struct {
 	Numerator   Opt[int32]
 	Denominator Opt[*int32]
+
+	absent bool
 }
 
 func (_ FractionAssertion) isAssertable() {}
+func (a *FractionAssertion) markAbsent() {
+	a.absent = true
+}
 
 // FrontendTLSConfigAssertion is the assertion struct for FrontendTLSConfig.
 type FrontendTLSConfigAssertion struct {
 	Default ApisTLSConfigAssertion
 	PerPort Opt[[]TLSPortConfigAssertion]
+
+	absent bool
 }
 
 func (_ FrontendTLSConfigAssertion) isAssertable() {}
+func (a *FrontendTLSConfigAssertion) markAbsent() {
+	a.absent = true
+}
 
 // FrontendTLSValidationAssertion is the assertion struct for FrontendTLSValidation.
 type FrontendTLSValidationAssertion struct {
 	CACertificateRefs Opt[[]ApisObjectReferenceAssertion]
 	Mode              Opt[v18.FrontendValidationModeType]
+
+	absent bool
 }
 
 func (_ FrontendTLSValidationAssertion) isAssertable() {}
+func (a *FrontendTLSValidationAssertion) markAbsent() {
+	a.absent = true
+}
 
 // GRPCAuthConfigAssertion is the assertion struct for GRPCAuthConfig.
 type GRPCAuthConfigAssertion struct {
 	AllowedRequestHeaders Opt[[]string]
+
+	absent bool
 }
 
 func (_ GRPCAuthConfigAssertion) isAssertable() {}
+func (a *GRPCAuthConfigAssertion) markAbsent() {
+	a.absent = true
+}
 
 // GRPCBackendRefAssertion is the assertion struct for GRPCBackendRef.
 type GRPCBackendRefAssertion struct {
 	BackendRef BackendRefAssertion
 	Filters    Opt[[]GRPCRouteFilterAssertion]
+
+	absent bool
 }
 
 func (_ GRPCBackendRefAssertion) isAssertable() {}
+func (a *GRPCBackendRefAssertion) markAbsent() {
+	a.absent = true
+}
 
 // GRPCHeaderMatchAssertion is the assertion struct for GRPCHeaderMatch.
 type GRPCHeaderMatchAssertion struct {
 	Type  Opt[*v18.GRPCHeaderMatchType]
 	Name  Opt[v18.GRPCHeaderName]
 	Value Opt[string]
+
+	absent bool
 }
 
 func (_ GRPCHeaderMatchAssertion) isAssertable() {}
+func (a *GRPCHeaderMatchAssertion) markAbsent() {
+	a.absent = true
+}
 
 // GRPCMethodMatchAssertion is the assertion struct for GRPCMethodMatch.
 type GRPCMethodMatchAssertion struct {
 	Type    Opt[*v18.GRPCMethodMatchType]
 	Service Opt[*string]
 	Method  Opt[*string]
+
+	absent bool
 }
 
 func (_ GRPCMethodMatchAssertion) isAssertable() {}
+func (a *GRPCMethodMatchAssertion) markAbsent() {
+	a.absent = true
+}
 
 // GRPCRouteAssertion is the assertion struct for GRPCRoute.
 type GRPCRouteAssertion struct {
@@ -5154,9 +7576,14 @@ type GRPCRouteAssertion struct {
 	ObjectMeta ObjectMetaAssertion
 	Spec       GRPCRouteSpecAssertion
 	Status     Opt[v18.GRPCRouteStatus]
+
+	absent bool
 }
 
 func (_ GRPCRouteAssertion) isAssertable() {}
+func (a *GRPCRouteAssertion) markAbsent() {
+	a.absent = true
+}
 
 // GRPCRouteFilterAssertion is the assertion struct for GRPCRouteFilter.
 type GRPCRouteFilterAssertion struct {
@@ -5165,17 +7592,27 @@ type GRPCRouteFilterAssertion struct {
 	ResponseHeaderModifier HTTPHeaderFilterAssertion
 	RequestMirror          HTTPRequestMirrorFilterAssertion
 	ExtensionRef           ApisLocalObjectReferenceAssertion
+
+	absent bool
 }
 
 func (_ GRPCRouteFilterAssertion) isAssertable() {}
+func (a *GRPCRouteFilterAssertion) markAbsent() {
+	a.absent = true
+}
 
 // GRPCRouteMatchAssertion is the assertion struct for GRPCRouteMatch.
 type GRPCRouteMatchAssertion struct {
 	Method  GRPCMethodMatchAssertion
 	Headers Opt[[]GRPCHeaderMatchAssertion]
+
+	absent bool
 }
 
 func (_ GRPCRouteMatchAssertion) isAssertable() {}
+func (a *GRPCRouteMatchAssertion) markAbsent() {
+	a.absent = true
+}
 
 // GRPCRouteRuleAssertion is the assertion struct for GRPCRouteRule.
 type GRPCRouteRuleAssertion struct {
@@ -5184,18 +7621,28 @@ type GRPCRouteRuleAssertion struct {
 	Filters            Opt[[]GRPCRouteFilterAssertion]
 	BackendRefs        Opt[[]GRPCBackendRefAssertion]
 	SessionPersistence SessionPersistenceAssertion
+
+	absent bool
 }
 
 func (_ GRPCRouteRuleAssertion) isAssertable() {}
+func (a *GRPCRouteRuleAssertion) markAbsent() {
+	a.absent = true
+}
 
 // GRPCRouteSpecAssertion is the assertion struct for GRPCRouteSpec.
 type GRPCRouteSpecAssertion struct {
 	CommonRouteSpec CommonRouteSpecAssertion
 	Hostnames       Opt[[]v18.Hostname]
 	Rules           Opt[[]GRPCRouteRuleAssertion]
+
+	absent bool
 }
 
 func (_ GRPCRouteSpecAssertion) isAssertable() {}
+func (a *GRPCRouteSpecAssertion) markAbsent() {
+	a.absent = true
+}
 
 // GatewayAssertion is the assertion struct for Gateway.
 type GatewayAssertion struct {
@@ -5203,16 +7650,26 @@ type GatewayAssertion struct {
 	ObjectMeta ObjectMetaAssertion
 	Spec       GatewaySpecAssertion
 	Status     Opt[v18.GatewayStatus]
+
+	absent bool
 }
 
 func (_ GatewayAssertion) isAssertable() {}
+func (a *GatewayAssertion) markAbsent() {
+	a.absent = true
+}
 
 // GatewayBackendTLSAssertion is the assertion struct for GatewayBackendTLS.
 type GatewayBackendTLSAssertion struct {
 	ClientCertificateRef SecretObjectReferenceAssertion
+
+	absent bool
 }
 
 func (_ GatewayBackendTLSAssertion) isAssertable() {}
+func (a *GatewayBackendTLSAssertion) markAbsent() {
+	a.absent = true
+}
 
 // GatewayClassAssertion is the assertion struct for GatewayClass.
 type GatewayClassAssertion struct {
@@ -5220,27 +7677,42 @@ type GatewayClassAssertion struct {
 	ObjectMeta ObjectMetaAssertion
 	Spec       GatewayClassSpecAssertion
 	Status     Opt[v18.GatewayClassStatus]
+
+	absent bool
 }
 
 func (_ GatewayClassAssertion) isAssertable() {}
+func (a *GatewayClassAssertion) markAbsent() {
+	a.absent = true
+}
 
 // GatewayClassSpecAssertion is the assertion struct for GatewayClassSpec.
 type GatewayClassSpecAssertion struct {
 	ControllerName Opt[v18.GatewayController]
 	ParametersRef  ParametersReferenceAssertion
 	Description    Opt[*string]
+
+	absent bool
 }
 
 func (_ GatewayClassSpecAssertion) isAssertable() {}
+func (a *GatewayClassSpecAssertion) markAbsent() {
+	a.absent = true
+}
 
 // GatewayInfrastructureAssertion is the assertion struct for GatewayInfrastructure.
 type GatewayInfrastructureAssertion struct {
 	Labels        Opt[map[v18.LabelKey]v18.LabelValue]
 	Annotations   Opt[map[v18.AnnotationKey]v18.AnnotationValue]
 	ParametersRef LocalParametersReferenceAssertion
+
+	absent bool
 }
 
 func (_ GatewayInfrastructureAssertion) isAssertable() {}
+func (a *GatewayInfrastructureAssertion) markAbsent() {
+	a.absent = true
+}
 
 // GatewaySpecAssertion is the assertion struct for GatewaySpec.
 type GatewaySpecAssertion struct {
@@ -5251,58 +7723,93 @@ type GatewaySpecAssertion struct {
 	AllowedListeners AllowedListenersAssertion
 	TLS              GatewayTLSConfigAssertion
 	DefaultScope     Opt[v18.GatewayDefaultScope]
+
+	absent bool
 }
 
 func (_ GatewaySpecAssertion) isAssertable() {}
+func (a *GatewaySpecAssertion) markAbsent() {
+	a.absent = true
+}
 
 // GatewaySpecAddressAssertion is the assertion struct for GatewaySpecAddress.
 type GatewaySpecAddressAssertion struct {
 	Type  Opt[*v18.AddressType]
 	Value Opt[string]
+
+	absent bool
 }
 
 func (_ GatewaySpecAddressAssertion) isAssertable() {}
+func (a *GatewaySpecAddressAssertion) markAbsent() {
+	a.absent = true
+}
 
 // GatewayStatusAddressAssertion is the assertion struct for GatewayStatusAddress.
 type GatewayStatusAddressAssertion struct {
 	Type  Opt[*v18.AddressType]
 	Value Opt[string]
+
+	absent bool
 }
 
 func (_ GatewayStatusAddressAssertion) isAssertable() {}
+func (a *GatewayStatusAddressAssertion) markAbsent() {
+	a.absent = true
+}
 
 // GatewayTLSConfigAssertion is the assertion struct for GatewayTLSConfig.
 type GatewayTLSConfigAssertion struct {
 	Backend  GatewayBackendTLSAssertion
 	Frontend FrontendTLSConfigAssertion
+
+	absent bool
 }
 
 func (_ GatewayTLSConfigAssertion) isAssertable() {}
+func (a *GatewayTLSConfigAssertion) markAbsent() {
+	a.absent = true
+}
 
 // ApisGroupVersionAssertion is the assertion struct for GroupVersion.
 type ApisGroupVersionAssertion struct {
 	Group   Opt[string]
 	Version Opt[string]
+
+	absent bool
 }
 
 func (_ ApisGroupVersionAssertion) isAssertable() {}
+func (a *ApisGroupVersionAssertion) markAbsent() {
+	a.absent = true
+}
 
 // HTTPAuthConfigAssertion is the assertion struct for HTTPAuthConfig.
 type HTTPAuthConfigAssertion struct {
 	Path                   Opt[string]
 	AllowedRequestHeaders  Opt[[]string]
 	AllowedResponseHeaders Opt[[]string]
+
+	absent bool
 }
 
 func (_ HTTPAuthConfigAssertion) isAssertable() {}
+func (a *HTTPAuthConfigAssertion) markAbsent() {
+	a.absent = true
+}
 
 // HTTPBackendRefAssertion is the assertion struct for HTTPBackendRef.
 type HTTPBackendRefAssertion struct {
 	BackendRef BackendRefAssertion
 	Filters    Opt[[]HTTPRouteFilterAssertion]
+
+	absent bool
 }
 
 func (_ HTTPBackendRefAssertion) isAssertable() {}
+func (a *HTTPBackendRefAssertion) markAbsent() {
+	a.absent = true
+}
 
 // HTTPCORSFilterAssertion is the assertion struct for HTTPCORSFilter.
 type HTTPCORSFilterAssertion struct {
@@ -5312,9 +7819,14 @@ type HTTPCORSFilterAssertion struct {
 	AllowHeaders     Opt[[]v18.HTTPHeaderName]
 	ExposeHeaders    Opt[[]v18.HTTPHeaderName]
 	MaxAge           Opt[int32]
+
+	absent bool
 }
 
 func (_ HTTPCORSFilterAssertion) isAssertable() {}
+func (a *HTTPCORSFilterAssertion) markAbsent() {
+	a.absent = true
+}
 
 // HTTPExternalAuthFilterAssertion is the assertion struct for HTTPExternalAuthFilter.
 type HTTPExternalAuthFilterAssertion struct {
@@ -5323,70 +7835,110 @@ type HTTPExternalAuthFilterAssertion struct {
 	GRPCAuthConfig       GRPCAuthConfigAssertion
 	HTTPAuthConfig       HTTPAuthConfigAssertion
 	ForwardBody          ForwardBodyConfigAssertion
+
+	absent bool
 }
 
 func (_ HTTPExternalAuthFilterAssertion) isAssertable() {}
+func (a *HTTPExternalAuthFilterAssertion) markAbsent() {
+	a.absent = true
+}
 
 // ApisHTTPHeaderAssertion is the assertion struct for HTTPHeader.
 type ApisHTTPHeaderAssertion struct {
 	Name  Opt[v18.HTTPHeaderName]
 	Value Opt[string]
+
+	absent bool
 }
 
 func (_ ApisHTTPHeaderAssertion) isAssertable() {}
+func (a *ApisHTTPHeaderAssertion) markAbsent() {
+	a.absent = true
+}
 
 // HTTPHeaderFilterAssertion is the assertion struct for HTTPHeaderFilter.
 type HTTPHeaderFilterAssertion struct {
 	Set    Opt[[]ApisHTTPHeaderAssertion]
 	Add    Opt[[]ApisHTTPHeaderAssertion]
 	Remove Opt[[]string]
+
+	absent bool
 }
 
 func (_ HTTPHeaderFilterAssertion) isAssertable() {}
+func (a *HTTPHeaderFilterAssertion) markAbsent() {
+	a.absent = true
+}
 
 // HTTPHeaderMatchAssertion is the assertion struct for HTTPHeaderMatch.
 type HTTPHeaderMatchAssertion struct {
 	Type  Opt[*v18.HeaderMatchType]
 	Name  Opt[v18.HTTPHeaderName]
 	Value Opt[string]
+
+	absent bool
 }
 
 func (_ HTTPHeaderMatchAssertion) isAssertable() {}
+func (a *HTTPHeaderMatchAssertion) markAbsent() {
+	a.absent = true
+}
 
 // HTTPPathMatchAssertion is the assertion struct for HTTPPathMatch.
 type HTTPPathMatchAssertion struct {
 	Type  Opt[*v18.PathMatchType]
 	Value Opt[*string]
+
+	absent bool
 }
 
 func (_ HTTPPathMatchAssertion) isAssertable() {}
+func (a *HTTPPathMatchAssertion) markAbsent() {
+	a.absent = true
+}
 
 // HTTPPathModifierAssertion is the assertion struct for HTTPPathModifier.
 type HTTPPathModifierAssertion struct {
 	Type               Opt[v18.HTTPPathModifierType]
 	ReplaceFullPath    Opt[*string]
 	ReplacePrefixMatch Opt[*string]
+
+	absent bool
 }
 
 func (_ HTTPPathModifierAssertion) isAssertable() {}
+func (a *HTTPPathModifierAssertion) markAbsent() {
+	a.absent = true
+}
 
 // HTTPQueryParamMatchAssertion is the assertion struct for HTTPQueryParamMatch.
 type HTTPQueryParamMatchAssertion struct {
 	Type  Opt[*v18.QueryParamMatchType]
 	Name  Opt[v18.HTTPHeaderName]
 	Value Opt[string]
+
+	absent bool
 }
 
 func (_ HTTPQueryParamMatchAssertion) isAssertable() {}
+func (a *HTTPQueryParamMatchAssertion) markAbsent() {
+	a.absent = true
+}
 
 // HTTPRequestMirrorFilterAssertion is the assertion struct for HTTPRequestMirrorFilter.
 type HTTPRequestMirrorFilterAssertion struct {
 	BackendRef BackendObjectReferenceAssertion
 	Percent    Opt[*int32]
 	Fraction   FractionAssertion
+
+	absent bool
 }
 
 func (_ HTTPRequestMirrorFilterAssertion) isAssertable() {}
+func (a *HTTPRequestMirrorFilterAssertion) markAbsent() {
+	a.absent = true
+}
 
 // HTTPRequestRedirectFilterAssertion is the assertion struct for HTTPRequestRedirectFilter.
 type HTTPRequestRedirectFilterAssertion struct {
@@ -5395,9 +7947,14 @@ type HTTPRequestRedirectFilterAssertion struct {
 	Path       HTTPPathModifierAssertion
 	Port       Opt[*v18.PortNumber]
 	StatusCode Opt[*int]
+
+	absent bool
 }
 
 func (_ HTTPRequestRedirectFilterAssertion) isAssertable() {}
+func (a *HTTPRequestRedirectFilterAssertion) markAbsent() {
+	a.absent = true
+}
 
 // HTTPRouteAssertion is the assertion struct for HTTPRoute.
 type HTTPRouteAssertion struct {
@@ -5405,9 +7962,14 @@ type HTTPRouteAssertion struct {
 	ObjectMeta ObjectMetaAssertion
 	Spec       HTTPRouteSpecAssertion
 	Status     Opt[v18.HTTPRouteStatus]
+
+	absent bool
 }
 
 func (_ HTTPRouteAssertion) isAssertable() {}
+func (a *HTTPRouteAssertion) markAbsent() {
+	a.absent = true
+}
 
 // HTTPRouteFilterAssertion is the assertion struct for HTTPRouteFilter.
 type HTTPRouteFilterAssertion struct {
@@ -5420,9 +7982,14 @@ type HTTPRouteFilterAssertion struct {
 	CORS                   HTTPCORSFilterAssertion
 	ExternalAuth           HTTPExternalAuthFilterAssertion
 	ExtensionRef           ApisLocalObjectReferenceAssertion
+
+	absent bool
 }
 
 func (_ HTTPRouteFilterAssertion) isAssertable() {}
+func (a *HTTPRouteFilterAssertion) markAbsent() {
+	a.absent = true
+}
 
 // HTTPRouteMatchAssertion is the assertion struct for HTTPRouteMatch.
 type HTTPRouteMatchAssertion struct {
@@ -5430,18 +7997,28 @@ type HTTPRouteMatchAssertion struct {
 	Headers     Opt[[]HTTPHeaderMatchAssertion]
 	QueryParams Opt[[]HTTPQueryParamMatchAssertion]
 	Method      Opt[*v18.HTTPMethod]
+
+	absent bool
 }
 
 func (_ HTTPRouteMatchAssertion) isAssertable() {}
+func (a *HTTPRouteMatchAssertion) markAbsent() {
+	a.absent = true
+}
 
 // HTTPRouteRetryAssertion is the assertion struct for HTTPRouteRetry.
 type HTTPRouteRetryAssertion struct {
 	Codes    Opt[[]v18.HTTPRouteRetryStatusCode]
 	Attempts Opt[*int]
 	Backoff  Opt[*v18.Duration]
+
+	absent bool
 }
 
 func (_ HTTPRouteRetryAssertion) isAssertable() {}
+func (a *HTTPRouteRetryAssertion) markAbsent() {
+	a.absent = true
+}
 
 // HTTPRouteRuleAssertion is the assertion struct for HTTPRouteRule.
 type HTTPRouteRuleAssertion struct {
@@ -5452,34 +8029,54 @@ type HTTPRouteRuleAssertion struct {
 	Timeouts           HTTPRouteTimeoutsAssertion
 	Retry              HTTPRouteRetryAssertion
 	SessionPersistence SessionPersistenceAssertion
+
+	absent bool
 }
 
 func (_ HTTPRouteRuleAssertion) isAssertable() {}
+func (a *HTTPRouteRuleAssertion) markAbsent() {
+	a.absent = true
+}
 
 // HTTPRouteSpecAssertion is the assertion struct for HTTPRouteSpec.
 type HTTPRouteSpecAssertion struct {
 	CommonRouteSpec CommonRouteSpecAssertion
 	Hostnames       Opt[[]v18.Hostname]
 	Rules           Opt[[]HTTPRouteRuleAssertion]
+
+	absent bool
 }
 
 func (_ HTTPRouteSpecAssertion) isAssertable() {}
+func (a *HTTPRouteSpecAssertion) markAbsent() {
+	a.absent = true
+}
 
 // HTTPRouteTimeoutsAssertion is the assertion struct for HTTPRouteTimeouts.
 type HTTPRouteTimeoutsAssertion struct {
 	Request        Opt[*v18.Duration]
 	BackendRequest Opt[*v18.Duration]
+
+	absent bool
 }
 
 func (_ HTTPRouteTimeoutsAssertion) isAssertable() {}
+func (a *HTTPRouteTimeoutsAssertion) markAbsent() {
+	a.absent = true
+}
 
 // HTTPURLRewriteFilterAssertion is the assertion struct for HTTPURLRewriteFilter.
 type HTTPURLRewriteFilterAssertion struct {
 	Hostname Opt[*v18.PreciseHostname]
 	Path     HTTPPathModifierAssertion
+
+	absent bool
 }
 
 func (_ HTTPURLRewriteFilterAssertion) isAssertable() {}
+func (a *HTTPURLRewriteFilterAssertion) markAbsent() {
+	a.absent = true
+}
 
 // ListenerAssertion is the assertion struct for Listener.
 type ListenerAssertion struct {
@@ -5489,9 +8086,14 @@ type ListenerAssertion struct {
 	Protocol      Opt[v18.ProtocolType]
 	TLS           ListenerTLSConfigAssertion
 	AllowedRoutes AllowedRoutesAssertion
+
+	absent bool
 }
 
 func (_ ListenerAssertion) isAssertable() {}
+func (a *ListenerAssertion) markAbsent() {
+	a.absent = true
+}
 
 // ListenerEntryAssertion is the assertion struct for ListenerEntry.
 type ListenerEntryAssertion struct {
@@ -5501,17 +8103,27 @@ type ListenerEntryAssertion struct {
 	Protocol      Opt[v18.ProtocolType]
 	TLS           ListenerTLSConfigAssertion
 	AllowedRoutes AllowedRoutesAssertion
+
+	absent bool
 }
 
 func (_ ListenerEntryAssertion) isAssertable() {}
+func (a *ListenerEntryAssertion) markAbsent() {
+	a.absent = true
+}
 
 // ListenerNamespacesAssertion is the assertion struct for ListenerNamespaces.
 type ListenerNamespacesAssertion struct {
 	From     Opt[*v18.FromNamespaces]
 	Selector LabelSelectorAssertion
+
+	absent bool
 }
 
 func (_ ListenerNamespacesAssertion) isAssertable() {}
+func (a *ListenerNamespacesAssertion) markAbsent() {
+	a.absent = true
+}
 
 // ListenerSetAssertion is the assertion struct for ListenerSet.
 type ListenerSetAssertion struct {
@@ -5519,61 +8131,96 @@ type ListenerSetAssertion struct {
 	ObjectMeta ObjectMetaAssertion
 	Spec       ListenerSetSpecAssertion
 	Status     Opt[v18.ListenerSetStatus]
+
+	absent bool
 }
 
 func (_ ListenerSetAssertion) isAssertable() {}
+func (a *ListenerSetAssertion) markAbsent() {
+	a.absent = true
+}
 
 // ListenerSetSpecAssertion is the assertion struct for ListenerSetSpec.
 type ListenerSetSpecAssertion struct {
 	ParentRef ParentGatewayReferenceAssertion
 	Listeners Opt[[]ListenerEntryAssertion]
+
+	absent bool
 }
 
 func (_ ListenerSetSpecAssertion) isAssertable() {}
+func (a *ListenerSetSpecAssertion) markAbsent() {
+	a.absent = true
+}
 
 // ListenerTLSConfigAssertion is the assertion struct for ListenerTLSConfig.
 type ListenerTLSConfigAssertion struct {
 	Mode            Opt[*v18.TLSModeType]
 	CertificateRefs Opt[[]SecretObjectReferenceAssertion]
 	Options         Opt[map[v18.AnnotationKey]v18.AnnotationValue]
+
+	absent bool
 }
 
 func (_ ListenerTLSConfigAssertion) isAssertable() {}
+func (a *ListenerTLSConfigAssertion) markAbsent() {
+	a.absent = true
+}
 
 // ApisLocalObjectReferenceAssertion is the assertion struct for LocalObjectReference.
 type ApisLocalObjectReferenceAssertion struct {
 	Group Opt[v18.Group]
 	Kind  Opt[v18.Kind]
 	Name  Opt[v18.ObjectName]
+
+	absent bool
 }
 
 func (_ ApisLocalObjectReferenceAssertion) isAssertable() {}
+func (a *ApisLocalObjectReferenceAssertion) markAbsent() {
+	a.absent = true
+}
 
 // LocalParametersReferenceAssertion is the assertion struct for LocalParametersReference.
 type LocalParametersReferenceAssertion struct {
 	Group Opt[v18.Group]
 	Kind  Opt[v18.Kind]
 	Name  Opt[string]
+
+	absent bool
 }
 
 func (_ LocalParametersReferenceAssertion) isAssertable() {}
+func (a *LocalParametersReferenceAssertion) markAbsent() {
+	a.absent = true
+}
 
 // LocalPolicyTargetReferenceAssertion is the assertion struct for LocalPolicyTargetReference.
 type LocalPolicyTargetReferenceAssertion struct {
 	Group Opt[v18.Group]
 	Kind  Opt[v18.Kind]
 	Name  Opt[v18.ObjectName]
+
+	absent bool
 }
 
 func (_ LocalPolicyTargetReferenceAssertion) isAssertable() {}
+func (a *LocalPolicyTargetReferenceAssertion) markAbsent() {
+	a.absent = true
+}
 
 // LocalPolicyTargetReferenceWithSectionNameAssertion is the assertion struct for LocalPolicyTargetReferenceWithSectionName.
 type LocalPolicyTargetReferenceWithSectionNameAssertion struct {
 	LocalPolicyTargetReference LocalPolicyTargetReferenceAssertion
 	SectionName                Opt[*v18.SectionName]
+
+	absent bool
 }
 
 func (_ LocalPolicyTargetReferenceWithSectionNameAssertion) isAssertable() {}
+func (a *LocalPolicyTargetReferenceWithSectionNameAssertion) markAbsent() {
+	a.absent = true
+}
 
 // NamespacedPolicyTargetReferenceAssertion is the assertion struct for NamespacedPolicyTargetReference.
 type NamespacedPolicyTargetReferenceAssertion struct {
@@ -5581,9 +8228,14 @@ type NamespacedPolicyTargetReferenceAssertion struct {
 	Kind      Opt[v18.Kind]
 	Name      Opt[v18.ObjectName]
 	Namespace Opt[*v18.Namespace]
+
+	absent bool
 }
 
 func (_ NamespacedPolicyTargetReferenceAssertion) isAssertable() {}
+func (a *NamespacedPolicyTargetReferenceAssertion) markAbsent() {
+	a.absent = true
+}
 
 // ApisObjectReferenceAssertion is the assertion struct for ObjectReference.
 type ApisObjectReferenceAssertion struct {
@@ -5591,9 +8243,14 @@ type ApisObjectReferenceAssertion struct {
 	Kind      Opt[v18.Kind]
 	Name      Opt[v18.ObjectName]
 	Namespace Opt[*v18.Namespace]
+
+	absent bool
 }
 
 func (_ ApisObjectReferenceAssertion) isAssertable() {}
+func (a *ApisObjectReferenceAssertion) markAbsent() {
+	a.absent = true
+}
 
 // ParametersReferenceAssertion is the assertion struct for ParametersReference.
 type ParametersReferenceAssertion struct {
@@ -5601,9 +8258,14 @@ type ParametersReferenceAssertion struct {
 	Kind      Opt[v18.Kind]
 	Name      Opt[string]
 	Namespace Opt[*v18.Namespace]
+
+	absent bool
 }
 
 func (_ ParametersReferenceAssertion) isAssertable() {}
+func (a *ParametersReferenceAssertion) markAbsent() {
+	a.absent = true
+}
 
 // ParentGatewayReferenceAssertion is the assertion struct for ParentGatewayReference.
 type ParentGatewayReferenceAssertion struct {
@@ -5611,9 +8273,14 @@ type ParentGatewayReferenceAssertion struct {
 	Kind      Opt[*v18.Kind]
 	Name      Opt[v18.ObjectName]
 	Namespace Opt[*v18.Namespace]
+
+	absent bool
 }
 
 func (_ ParentGatewayReferenceAssertion) isAssertable() {}
+func (a *ParentGatewayReferenceAssertion) markAbsent() {
+	a.absent = true
+}
 
 // ApisParentReferenceAssertion is the assertion struct for ParentReference.
 type ApisParentReferenceAssertion struct {
@@ -5623,68 +8290,108 @@ type ApisParentReferenceAssertion struct {
 	Name        Opt[v18.ObjectName]
 	SectionName Opt[*v18.SectionName]
 	Port        Opt[*v18.PortNumber]
+
+	absent bool
 }
 
 func (_ ApisParentReferenceAssertion) isAssertable() {}
+func (a *ApisParentReferenceAssertion) markAbsent() {
+	a.absent = true
+}
 
 // ReferenceGrantAssertion is the assertion struct for ReferenceGrant.
 type ReferenceGrantAssertion struct {
 	TypeMeta   TypeMetaAssertion
 	ObjectMeta ObjectMetaAssertion
 	Spec       ReferenceGrantSpecAssertion
+
+	absent bool
 }
 
 func (_ ReferenceGrantAssertion) isAssertable() {}
+func (a *ReferenceGrantAssertion) markAbsent() {
+	a.absent = true
+}
 
 // ReferenceGrantFromAssertion is the assertion struct for ReferenceGrantFrom.
 type ReferenceGrantFromAssertion struct {
 	Group     Opt[v18.Group]
 	Kind      Opt[v18.Kind]
 	Namespace Opt[v18.Namespace]
+
+	absent bool
 }
 
 func (_ ReferenceGrantFromAssertion) isAssertable() {}
+func (a *ReferenceGrantFromAssertion) markAbsent() {
+	a.absent = true
+}
 
 // ReferenceGrantSpecAssertion is the assertion struct for ReferenceGrantSpec.
 type ReferenceGrantSpecAssertion struct {
 	From Opt[[]ReferenceGrantFromAssertion]
 	To   Opt[[]ReferenceGrantToAssertion]
+
+	absent bool
 }
 
 func (_ ReferenceGrantSpecAssertion) isAssertable() {}
+func (a *ReferenceGrantSpecAssertion) markAbsent() {
+	a.absent = true
+}
 
 // ReferenceGrantToAssertion is the assertion struct for ReferenceGrantTo.
 type ReferenceGrantToAssertion struct {
 	Group Opt[v18.Group]
 	Kind  Opt[v18.Kind]
 	Name  Opt[*v18.ObjectName]
+
+	absent bool
 }
 
 func (_ ReferenceGrantToAssertion) isAssertable() {}
+func (a *ReferenceGrantToAssertion) markAbsent() {
+	a.absent = true
+}
 
 // RouteGroupKindAssertion is the assertion struct for RouteGroupKind.
 type RouteGroupKindAssertion struct {
 	Group Opt[*v18.Group]
 	Kind  Opt[v18.Kind]
+
+	absent bool
 }
 
 func (_ RouteGroupKindAssertion) isAssertable() {}
+func (a *RouteGroupKindAssertion) markAbsent() {
+	a.absent = true
+}
 
 // RouteNamespacesAssertion is the assertion struct for RouteNamespaces.
 type RouteNamespacesAssertion struct {
 	From     Opt[*v18.FromNamespaces]
 	Selector LabelSelectorAssertion
+
+	absent bool
 }
 
 func (_ RouteNamespacesAssertion) isAssertable() {}
+func (a *RouteNamespacesAssertion) markAbsent() {
+	a.absent = true
+}
 
 // ApisSchemeGroupVersionAssertion is the assertion struct for GroupVersion.
 type ApisSchemeGroupVersionAssertion struct {
 	Group   Opt[string]
 	Version Opt[string]
+
+	absent bool
 }
 
 func (_ ApisSchemeGroupVersionAssertion) isAssertable() {}
+func (a *ApisSchemeGroupVersionAssertion) markAbsent() {
+	a.absent = true
+}
 
 // SecretObjectReferenceAssertion is the assertion struct for SecretObjectReference.
 type SecretObjectReferenceAssertion struct {
@@ -5692,9 +8399,14 @@ type SecretObjectReferenceAssertion struct {
 	Kind      Opt[*v18.Kind]
 	Name      Opt[v18.ObjectName]
 	Namespace Opt[*v18.Namespace]
+
+	absent bool
 }
 
 func (_ SecretObjectReferenceAssertion) isAssertable() {}
+func (a *SecretObjectReferenceAssertion) markAbsent() {
+	a.absent = true
+}
 
 // SessionPersistenceAssertion is the assertion struct for SessionPersistence.
 type SessionPersistenceAssertion struct {
@@ -5703,40 +8415,65 @@ type SessionPersistenceAssertion struct {
 	IdleTimeout     Opt[*v18.Duration]
 	Type            Opt[*v18.SessionPersistenceType]
 	CookieConfig    CookieConfigAssertion
+
+	absent bool
 }
 
 func (_ SessionPersistenceAssertion) isAssertable() {}
+func (a *SessionPersistenceAssertion) markAbsent() {
+	a.absent = true
+}
 
 // SubjectAltNameAssertion is the assertion struct for SubjectAltName.
 type SubjectAltNameAssertion struct {
 	Type     Opt[v18.SubjectAltNameType]
 	Hostname Opt[v18.Hostname]
 	URI      Opt[v18.AbsoluteURI]
+
+	absent bool
 }
 
 func (_ SubjectAltNameAssertion) isAssertable() {}
+func (a *SubjectAltNameAssertion) markAbsent() {
+	a.absent = true
+}
 
 // SupportedFeatureAssertion is the assertion struct for SupportedFeature.
 type SupportedFeatureAssertion struct {
 	Name Opt[v18.FeatureName]
+
+	absent bool
 }
 
 func (_ SupportedFeatureAssertion) isAssertable() {}
+func (a *SupportedFeatureAssertion) markAbsent() {
+	a.absent = true
+}
 
 // ApisTLSConfigAssertion is the assertion struct for TLSConfig.
 type ApisTLSConfigAssertion struct {
 	Validation FrontendTLSValidationAssertion
+
+	absent bool
 }
 
 func (_ ApisTLSConfigAssertion) isAssertable() {}
+func (a *ApisTLSConfigAssertion) markAbsent() {
+	a.absent = true
+}
 
 // TLSPortConfigAssertion is the assertion struct for TLSPortConfig.
 type TLSPortConfigAssertion struct {
 	Port Opt[v18.PortNumber]
 	TLS  ApisTLSConfigAssertion
+
+	absent bool
 }
 
 func (_ TLSPortConfigAssertion) isAssertable() {}
+func (a *TLSPortConfigAssertion) markAbsent() {
+	a.absent = true
+}
 
 // TLSRouteAssertion is the assertion struct for TLSRoute.
 type TLSRouteAssertion struct {
@@ -5744,26 +8481,84 @@ type TLSRouteAssertion struct {
 	ObjectMeta ObjectMetaAssertion
 	Spec       TLSRouteSpecAssertion
 	Status     Opt[v18.TLSRouteStatus]
+
+	absent bool
 }
 
 func (_ TLSRouteAssertion) isAssertable() {}
+func (a *TLSRouteAssertion) markAbsent() {
+	a.absent = true
+}
 
 // TLSRouteRuleAssertion is the assertion struct for TLSRouteRule.
 type TLSRouteRuleAssertion struct {
 	Name        Opt[*v18.SectionName]
 	BackendRefs Opt[[]BackendRefAssertion]
+
+	absent bool
 }
 
 func (_ TLSRouteRuleAssertion) isAssertable() {}
+func (a *TLSRouteRuleAssertion) markAbsent() {
+	a.absent = true
+}
 
 // TLSRouteSpecAssertion is the assertion struct for TLSRouteSpec.
 type TLSRouteSpecAssertion struct {
 	CommonRouteSpec CommonRouteSpecAssertion
 	Hostnames       Opt[[]v18.Hostname]
 	Rules           Opt[[]TLSRouteRuleAssertion]
+
+	absent bool
 }
 
 func (_ TLSRouteSpecAssertion) isAssertable() {}
+func (a *TLSRouteSpecAssertion) markAbsent() {
+	a.absent = true
+}
+
+// naturalKeys maps slice element assertion types to the field ByKey pairs
+// elements by.
+var naturalKeys = map[reflect.Type]string{
+	reflect.TypeOf(AlertmanagerEndpointsAssertion{}):        "Name",
+	reflect.TypeOf(ArgumentAssertion{}):                     "Name",
+	reflect.TypeOf(MonitoringObjectReferenceAssertion{}):    "Name",
+	reflect.TypeOf(MonitoringPodDNSConfigOptionAssertion{}): "Name",
+	reflect.TypeOf(ProbeParamAssertion{}):                   "Name",
+	reflect.TypeOf(RemoteReadSpecAssertion{}):               "Name",
+	reflect.TypeOf(RuleGroupAssertion{}):                    "Name",
+	reflect.TypeOf(ScrapeClassAssertion{}):                  "Name",
+	reflect.TypeOf(ContainerAssertion{}):                    "Name",
+	reflect.TypeOf(ContainerPortAssertion{}):                "Name",
+	reflect.TypeOf(EndpointPortAssertion{}):                 "Name",
+	reflect.TypeOf(EnvVarAssertion{}):                       "Name",
+	reflect.TypeOf(CoreHTTPHeaderAssertion{}):               "Name",
+	reflect.TypeOf(CoreLocalObjectReferenceAssertion{}):     "Name",
+	reflect.TypeOf(CoreObjectReferenceAssertion{}):          "Name",
+	reflect.TypeOf(CorePodDNSConfigOptionAssertion{}):       "Name",
+	reflect.TypeOf(PodResourceClaimAssertion{}):             "Name",
+	reflect.TypeOf(PodSchedulingGateAssertion{}):            "Name",
+	reflect.TypeOf(ResourceClaimAssertion{}):                "Name",
+	reflect.TypeOf(ServicePortAssertion{}):                  "Name",
+	reflect.TypeOf(SysctlAssertion{}):                       "Name",
+	reflect.TypeOf(VolumeAssertion{}):                       "Name",
+	reflect.TypeOf(VolumeDeviceAssertion{}):                 "Name",
+	reflect.TypeOf(VolumeMountAssertion{}):                  "Name",
+	reflect.TypeOf(SubjectAssertion{}):                      "Name",
+	reflect.TypeOf(CSINodeDriverAssertion{}):                "Name",
+	reflect.TypeOf(OwnerReferenceAssertion{}):               "Name",
+	reflect.TypeOf(TableColumnDefinitionAssertion{}):        "Name",
+	reflect.TypeOf(GRPCHeaderMatchAssertion{}):              "Name",
+	reflect.TypeOf(ApisHTTPHeaderAssertion{}):               "Name",
+	reflect.TypeOf(HTTPHeaderMatchAssertion{}):              "Name",
+	reflect.TypeOf(HTTPQueryParamMatchAssertion{}):          "Name",
+	reflect.TypeOf(ListenerAssertion{}):                     "Name",
+	reflect.TypeOf(ListenerEntryAssertion{}):                "Name",
+	reflect.TypeOf(ApisLocalObjectReferenceAssertion{}):     "Name",
+	reflect.TypeOf(ApisObjectReferenceAssertion{}):          "Name",
+	reflect.TypeOf(ApisParentReferenceAssertion{}):          "Name",
+	reflect.TypeOf(SecretObjectReferenceAssertion{}):        "Name",
+}
 
 // Ptr returns a pointer to the given value.
 // Use Ptr(int32(60)) instead of defining local int32Ptr closures.
@@ -5775,20 +8570,363 @@ func SomePtr[T any](v T) Opt[*T] { return Some(&v) }
 
 var assertableType = reflect.TypeOf((*Assertable)(nil)).Elem()
 
+// matchMode selects how an Opt is compared against the actual field. For an
+// Opt[[]E] of assertion structs the first four modes select how its elements
+// are paired with the elements of the actual slice.
+type matchMode int
+
+const (
+	// matchExact requires equal lengths and compares elements by position.
+	matchExact matchMode = iota
+	// matchContains requires every expected element to match a distinct
+	// actual element, in any order. Extra actual elements are ignored.
+	matchContains
+	// matchAnyOrder is matchContains with equal lengths.
+	matchAnyOrder
+	// matchByKey pairs elements by their natural key. Extra actual elements
+	// are ignored.
+	matchByKey
+	// matchAbsent requires the actual field to be unset.
+	matchAbsent
+	// matchNotContains requires that no actual element matches any expected one.
+	matchNotContains
+	// matchNotContainsKeys requires that the actual map has none of the
+	// expected map's keys.
+	matchNotContainsKeys
+)
+
+// Absent creates an Opt that passes if the actual field is unset: a nil
+// pointer, an empty slice or map, or the zero value.
+func Absent[T any]() Opt[T] {
+	return Opt[T]{match: matchAbsent}
+}
+
+// Unset returns an assertion struct that passes if the actual nested field
+// is unset: a nil pointer or the zero value. Use it for optional blocks such
+// as a container's StartupProbe, e.g. Unset[ProbeAssertion]().
+func Unset[T any, PT interface {
+	*T
+	markAbsent()
+}]() T {
+	var v T
+	PT(&v).markAbsent()
+	return v
+}
+
+// NotContains creates an Opt that passes if no element of the actual slice
+// matches any of the given elements. Assertion structs are matched partially,
+// so NotContains(ContainerAssertion{Name: Some("x")}) rejects any container
+// named x; other element types are compared for equality.
+func NotContains[E any](elems ...E) Opt[[]E] {
+	return Opt[[]E]{Val: &elems, match: matchNotContains}
+}
+
+// NotContainsKeys creates an Opt that passes if the actual map has none of
+// the given keys, e.g. NotContainsKeys[string]("prometheus.io/scrape").
+func NotContainsKeys[V any, K comparable](keys ...K) Opt[map[K]V] {
+	m := make(map[K]V, len(keys))
+	for _, k := range keys {
+		var zero V
+		m[k] = zero
+	}
+	return Opt[map[K]V]{Val: &m, match: matchNotContainsKeys}
+}
+
+// ContainsElements creates an Opt that passes if every given element partially
+// matches a distinct element of the actual slice, in any order. Actual elements
+// not matched by any of them are ignored.
+func ContainsElements[E Assertable](elems ...E) Opt[[]E] {
+	return Opt[[]E]{Val: &elems, match: matchContains}
+}
+
+// ConsistsOf creates an Opt that passes if the actual slice has exactly as many
+// elements as given and each given element partially matches a distinct one
+// of them, in any order.
+func ConsistsOf[E Assertable](elems ...E) Opt[[]E] {
+	return Opt[[]E]{Val: &elems, match: matchAnyOrder}
+}
+
+// ByKey creates an Opt that pairs each given element with the actual element
+// sharing its natural key (the Name of a container, env var, volume, port, …)
+// and compares the pair partially. Actual elements with other keys are
+// ignored. It panics if E has no natural key or an element leaves it unset.
+func ByKey[E Assertable](elems ...E) Opt[[]E] {
+	elemType := reflect.TypeOf((*E)(nil)).Elem()
+	keyField, ok := naturalKeys[elemType]
+	if !ok {
+		panic(fmt.Sprintf("ByKey: %v has no natural key", elemType))
+	}
+	for i, elem := range elems {
+		if _, ok := assertionKey(reflect.ValueOf(elem), keyField); !ok {
+			panic(fmt.Sprintf("ByKey: element %d of %v does not set %s", i, elemType, keyField))
+		}
+	}
+	return Opt[[]E]{Val: &elems, match: matchByKey}
+}
+
+// keyedElement returns the element of opt whose natural key is name,
+// appending a new element with that key if there is none. It backs the keyed
+// accessors of the fluent builders, which always use ByKey semantics.
+func keyedElement[E Assertable](opt *Opt[[]E], name string) *E {
+	if opt.Val == nil {
+		*opt = Opt[[]E]{Val: &[]E{}, match: matchByKey}
+	}
+	if opt.match != matchByKey {
+		panic(fmt.Sprintf("keyed builder access to a slice already set with another matching mode (%T)", *new(E)))
+	}
+	keyField := naturalKeys[reflect.TypeOf((*E)(nil)).Elem()]
+	elems := *opt.Val
+	for i := range elems {
+		if key, ok := assertionKey(reflect.ValueOf(elems[i]), keyField); ok && key.String() == name {
+			return &elems[i]
+		}
+	}
+	var elem E
+	keyOpt := reflect.ValueOf(&elem).Elem().FieldByName(keyField).FieldByName("Val")
+	key := reflect.New(keyOpt.Type().Elem())
+	key.Elem().SetString(name)
+	keyOpt.Set(key)
+	*opt.Val = append(elems, elem)
+	return &(*opt.Val)[len(*opt.Val)-1]
+}
+
+// assertionKey returns the expected value of keyField in an assertion struct.
+func assertionKey(assertion reflect.Value, keyField string) (reflect.Value, bool) {
+	val := assertion.FieldByName(keyField).FieldByName("Val")
+	if !val.IsValid() || val.IsNil() {
+		return reflect.Value{}, false
+	}
+	return val.Elem(), true
+}
+
+// Mismatch describes a single field of an assertion that actual did not satisfy.
+type Mismatch struct {
+	// Path is the field path from the asserted object, e.g.
+	// Spec.Template.Spec.Containers[0].SecurityContext.RunAsUser.
+	Path string
+	// Message explains the mismatch.
+	Message string
+	// Expected and Actual hold the compared values for plain value
+	// comparisons. They are nil for matcher and structural mismatches.
+	Expected any
+	Actual   any
+
+	compared bool
+}
+
+// String renders the mismatch as its path and message followed, for value
+// comparisons, by a unified diff of the expected and actual subtree as YAML.
+func (m Mismatch) String() string {
+	if !m.compared {
+		return fmt.Sprintf("%s: %s", m.Path, m.Message)
+	}
+	diff := yamlDiff(m.Expected, m.Actual)
+	return fmt.Sprintf("%s: %s\n  %s", m.Path, m.Message, strings.ReplaceAll(diff, "\n", "\n  "))
+}
+
+// yamlDiff renders a unified diff between expected and actual marshalled as
+// YAML. Lines are coloured only if useColor reports so.
+func yamlDiff(expected, actual any) string {
+	diff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(strings.TrimSuffix(toYAML(expected), "\n")),
+		B:        difflib.SplitLines(strings.TrimSuffix(toYAML(actual), "\n")),
+		FromFile: "expected",
+		ToFile:   "actual",
+		Context:  3,
+	})
+	if err != nil {
+		return fmt.Sprintf("expected: %s\nactual:   %s", toYAML(expected), toYAML(actual))
+	}
+	return colorizeDiff(strings.TrimRight(diff, "\n"))
+}
+
+// toYAML marshals v the way it would appear in a manifest. Pointers are
+// followed so that Opt[*T] values show the pointee rather than an address.
+func toYAML(v any) string {
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Ptr && !rv.IsNil() {
+		rv = rv.Elem()
+	}
+	if !rv.IsValid() || (rv.Kind() == reflect.Ptr && rv.IsNil()) {
+		return "null\n"
+	}
+	out, err := yaml.Marshal(rv.Interface())
+	if err != nil {
+		return fmt.Sprintf("%#v\n", rv.Interface())
+	}
+	return string(out)
+}
+
+const (
+	ansiReset = "\x1b[0m"
+	ansiRed   = "\x1b[31m"
+	ansiGreen = "\x1b[32m"
+	ansiCyan  = "\x1b[36m"
+)
+
+// ColorEnvVar enables coloured diffs when stdout is not a terminal, as is
+// the case under go test, for logs that render ANSI escapes.
+const ColorEnvVar = "ZITADEL_TEST_COLOR"
+
+// isTerminal reports whether stdout is a terminal. Tests replace it, since
+// the answer depends on how go test was run.
+var isTerminal = func() bool {
+	info, err := os.Stdout.Stat()
+	return err == nil && info.Mode()&os.ModeCharDevice != 0
+}
+
+// useColor reports whether diffs are coloured: never if NO_COLOR is set
+// (https://no-color.org), otherwise if ColorEnvVar is set or isTerminal
+// reports so.
+func useColor() bool {
+	if os.Getenv("NO_COLOR") != "" {
+		return false
+	}
+	if os.Getenv(ColorEnvVar) != "" {
+		return true
+	}
+	return isTerminal()
+}
+
+// colorizeDiff adds ANSI colours to a unified diff when useColor reports so:
+// removed (expected) lines in red, added (actual) lines in green and hunk
+// headers in cyan.
+func colorizeDiff(diff string) string {
+	if !useColor() {
+		return diff
+	}
+	lines := strings.Split(diff, "\n")
+	for i, line := range lines {
+		switch {
+		case strings.HasPrefix(line, "---"), strings.HasPrefix(line, "+++"):
+		case strings.HasPrefix(line, "-"):
+			lines[i] = ansiRed + line + ansiReset
+		case strings.HasPrefix(line, "+"):
+			lines[i] = ansiGreen + line + ansiReset
+		case strings.HasPrefix(line, "@@"):
+			lines[i] = ansiCyan + line + ansiReset
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// objectRef returns "Kind name" for a Kubernetes object, or "" if actual is
+// not one. The kind is taken from the Go type because objects read through
+// typed clients have an empty TypeMeta.
+func objectRef(actual any) string {
+	obj, ok := actual.(interface{ GetName() string })
+	if !ok {
+		return ""
+	}
+	rv := reflect.ValueOf(actual)
+	if rv.Kind() == reflect.Ptr && rv.IsNil() {
+		return ""
+	}
+	kind := reflect.Indirect(rv).Type().Name()
+	if obj.GetName() == "" {
+		return kind
+	}
+	return kind + " " + obj.GetName()
+}
+
+// failureHeader prefixes summary with the object the assertion ran against
+// and appends the caller's message, if any.
+func failureHeader(actual any, summary string, msgAndArgs []any) string {
+	if ref := objectRef(actual); ref != "" {
+		summary = ref + ": " + summary
+	}
+	if extra := formatMsgAndArgs(msgAndArgs); extra != "" {
+		summary += " (" + extra + ")"
+	}
+	return summary
+}
+
+// reporter receives the mismatches found while walking an assertion tree.
+// testingT returns the test to mark helpers on, or nil if the reporter does
+// not fail a test directly.
+type reporter interface {
+	testingT() *testing.T
+	report(m Mismatch)
+}
+
+// failFastReporter fails the test on the first mismatch.
+type failFastReporter struct {
+	t          *testing.T
+	actual     any
+	msgAndArgs []any
+}
+
+func (r failFastReporter) testingT() *testing.T { return r.t }
+
+func (r failFastReporter) report(m Mismatch) {
+	r.t.Helper()
+	require.Fail(r.t, m.String(), failureHeader(r.actual, fmt.Sprintf("field %q did not match", m.Path), r.msgAndArgs))
+}
+
+// formatMsgAndArgs renders testify-style msgAndArgs, where a leading string
+// is used as the format for the remaining arguments.
+func formatMsgAndArgs(msgAndArgs []any) string {
+	if len(msgAndArgs) == 0 {
+		return ""
+	}
+	if format, ok := msgAndArgs[0].(string); ok && len(msgAndArgs) > 1 {
+		return fmt.Sprintf(format, msgAndArgs[1:]...)
+	}
+	return fmt.Sprint(msgAndArgs...)
+}
+
+// collectingReporter records every mismatch and lets the walk continue.
+type collectingReporter struct {
+	mismatches []Mismatch
+}
+
+func (r *collectingReporter) testingT() *testing.T { return nil }
+
+func (r *collectingReporter) report(m Mismatch) {
+	r.mismatches = append(r.mismatches, m)
+}
+
 // AssertPartial walks the assertion struct via reflection, comparing set fields against actual.
+// It fails the test on the first mismatching field.
 func AssertPartial[T Assertable](t *testing.T, actual any, assertion T, msgAndArgs ...any) {
 	t.Helper()
-	doAssertPartial(t, reflect.ValueOf(actual), reflect.ValueOf(assertion), msgAndArgs)
+	doAssertPartial(failFastReporter{t: t, actual: actual, msgAndArgs: msgAndArgs}, "", reflect.ValueOf(actual), reflect.ValueOf(assertion))
 }
 
-func doAssertPartial(t *testing.T, actualVal reflect.Value, assertionVal reflect.Value, msgAndArgs []any) {
+// AssertPartialAll is like AssertPartial but walks the whole assertion tree
+// first and then fails once, listing every mismatching field with its path.
+func AssertPartialAll[T Assertable](t *testing.T, actual any, assertion T, msgAndArgs ...any) {
 	t.Helper()
+	mismatches := Mismatches(actual, assertion)
+	if len(mismatches) == 0 {
+		return
+	}
+	var report strings.Builder
+	fmt.Fprintf(&report, "%d field(s) did not match:", len(mismatches))
+	for _, m := range mismatches {
+		report.WriteString("\n  ")
+		report.WriteString(strings.ReplaceAll(m.String(), "\n", "\n  "))
+	}
+	require.Fail(t, report.String(), failureHeader(actual, "assertion failed", msgAndArgs))
+}
+
+// Mismatches walks the whole assertion tree and returns every field of
+// actual that does not satisfy assertion, in field order.
+func Mismatches[T Assertable](actual any, assertion T) []Mismatch {
+	r := &collectingReporter{}
+	doAssertPartial(r, "", reflect.ValueOf(actual), reflect.ValueOf(assertion))
+	return r.mismatches
+}
+
+func doAssertPartial(r reporter, path string, actualVal reflect.Value, assertionVal reflect.Value) {
+	if t := r.testingT(); t != nil {
+		t.Helper()
+	}
 
 	// Dereference pointers on actual
 	for actualVal.Kind() == reflect.Ptr {
 		if actualVal.IsNil() {
-			require.Fail(t, "actual is nil but assertion has fields to check",
-				append([]any{"got nil pointer when asserting against %v"}, msgAndArgs...)...)
+			r.report(Mismatch{Path: rootPath(path), Message: "actual is nil but assertion has fields to check"})
 			return
 		}
 		actualVal = actualVal.Elem()
@@ -5797,8 +8935,7 @@ func doAssertPartial(t *testing.T, actualVal reflect.Value, assertionVal reflect
 	// Dereference pointers on assertion
 	for assertionVal.Kind() == reflect.Ptr {
 		if assertionVal.IsNil() {
-			require.Fail(t, "assertion is nil but has fields to check",
-				append([]any{"got nil pointer for assertion"}, msgAndArgs...)...)
+			r.report(Mismatch{Path: rootPath(path), Message: "assertion is nil but has fields to check"})
 			return
 		}
 		assertionVal = assertionVal.Elem()
@@ -5812,7 +8949,11 @@ func doAssertPartial(t *testing.T, actualVal reflect.Value, assertionVal reflect
 
 	for i := 0; i < assertionType.NumField(); i++ {
 		fieldInfo := assertionType.Field(i)
+		if !fieldInfo.IsExported() {
+			continue
+		}
 		fieldVal := assertionVal.Field(i)
+		fieldPath := joinPath(path, fieldInfo.Name)
 
 		// Get the corresponding field in actual
 		actualField := actualVal.FieldByName(fieldInfo.Name)
@@ -5826,20 +8967,34 @@ func doAssertPartial(t *testing.T, actualVal reflect.Value, assertionVal reflect
 			if fieldVal.IsZero() {
 				continue
 			}
-			doAssertPartial(t, actualField, fieldVal, msgAndArgs)
+			// Built by Unset: the actual field must be nil or zero
+			if fieldVal.FieldByName("absent").Bool() {
+				if !isUnset(actualField) {
+					reportPresent(r, fieldPath, actualField)
+				}
+				continue
+			}
+			doAssertPartial(r, fieldPath, actualField, fieldVal)
+			continue
+		}
+
+		// Must be Opt[T] — check Absent first, then Matcher, then Val
+		mode := matchMode(fieldVal.FieldByName("match").Int())
+		if mode == matchAbsent {
+			if !isUnset(actualField) {
+				reportPresent(r, fieldPath, actualField)
+			}
 			continue
 		}
 
-		// Must be Opt[T] — check Matcher first, then Val
 		matcherField := fieldVal.FieldByName("Matcher")
 		if matcherField.IsValid() && !matcherField.IsNil() {
 			matcher := matcherField.Interface().(types.GomegaMatcher)
 			success, err := matcher.Match(actualField.Interface())
-			require.NoError(t, err,
-				append([]any{fmt.Sprintf("field %q: matcher error", fieldInfo.Name)}, msgAndArgs...)...)
-			if !success {
-				require.Fail(t, matcher.FailureMessage(actualField.Interface()),
-					append([]any{fmt.Sprintf("field %q", fieldInfo.Name)}, msgAndArgs...)...)
+			if err != nil {
+				r.report(Mismatch{Path: fieldPath, Message: fmt.Sprintf("matcher error: %v", err)})
+			} else if !success {
+				r.report(Mismatch{Path: fieldPath, Message: matcher.FailureMessage(actualField.Interface())})
 			}
 			continue
 		}
@@ -5855,17 +9010,26 @@ func doAssertPartial(t *testing.T, actualVal reflect.Value, assertionVal reflect
 		// val is set — compare
 		expectedVal := valField.Elem()
 
-		// Slice of Assertable: compare element-by-element with partial matching
-		if expectedVal.Kind() == reflect.Slice && expectedVal.Type().Elem().Implements(assertableType) {
-			require.Equal(t, expectedVal.Len(), actualField.Len(),
-				append([]any{fmt.Sprintf("field %q: slice length mismatch", fieldInfo.Name)}, msgAndArgs...)...)
-			for j := 0; j < expectedVal.Len(); j++ {
-				elemMsg := append([]any{fmt.Sprintf("field %q[%d]", fieldInfo.Name, j)}, msgAndArgs...)
-				doAssertPartial(t, actualField.Index(j), expectedVal.Index(j), elemMsg)
+		if mode == matchNotContains {
+			compareNotContains(r, fieldPath, expectedVal, actualField)
+			continue
+		}
+		if mode == matchNotContainsKeys {
+			for _, k := range sortedMapKeys(expectedVal) {
+				if actualField.MapIndex(k).IsValid() {
+					r.report(Mismatch{Path: fmt.Sprintf("%s[%v]", fieldPath, k.Interface()), Message: "key should be absent"})
+				}
 			}
 			continue
 		}
 
+		// Slice of Assertable: compare elements with partial matching, paired
+		// according to the constructor that built the Opt.
+		if expectedVal.Kind() == reflect.Slice && expectedVal.Type().Elem().Implements(assertableType) {
+			compareSlice(r, fieldPath, mode, expectedVal, actualField)
+			continue
+		}
+
 		// Map subset matching: verify all expected entries exist in actual.
 		// This allows actual to have extra keys (e.g. Helm metadata annotations).
 		// An empty expected map means "assert no entries" — treat nil actual as empty.
@@ -5873,27 +9037,255 @@ func doAssertPartial(t *testing.T, actualVal reflect.Value, assertionVal reflect
 			if expectedVal.Len() == 0 {
 				// Expected empty map — actual must also be empty (or nil)
 				if actualField.Kind() == reflect.Map && actualField.Len() > 0 {
-					require.Fail(t, fmt.Sprintf("field %q: expected empty map but actual has %d entries", fieldInfo.Name, actualField.Len()),
-						msgAndArgs...)
+					r.report(Mismatch{Path: fieldPath, Message: fmt.Sprintf("expected empty map but actual has %d entries", actualField.Len())})
 				}
 				continue
 			}
-			for _, k := range expectedVal.MapKeys() {
+			for _, k := range sortedMapKeys(expectedVal) {
+				entryPath := fmt.Sprintf("%s[%v]", fieldPath, k.Interface())
 				actualEntry := actualField.MapIndex(k)
-				require.True(t, actualEntry.IsValid(),
-					append([]any{fmt.Sprintf("field %q: expected key %v not found in actual map", fieldInfo.Name, k.Interface())}, msgAndArgs...)...)
-				require.Equal(t, expectedVal.MapIndex(k).Interface(), actualEntry.Interface(),
-					append([]any{fmt.Sprintf("field %q[%v] mismatch", fieldInfo.Name, k.Interface())}, msgAndArgs...)...)
+				if !actualEntry.IsValid() {
+					r.report(Mismatch{Path: entryPath, Message: "expected key not found in actual map"})
+					continue
+				}
+				compareValues(r, entryPath, expectedVal.MapIndex(k), actualEntry)
 			}
 			continue
 		}
 
-		if !expectedVal.CanInterface() || !actualField.CanInterface() {
-			require.True(t, reflect.DeepEqual(expectedVal, actualField),
-				append([]any{fmt.Sprintf("field %q mismatch\nexpected: %v\nactual:   %v", fieldInfo.Name, expectedVal, actualField)}, msgAndArgs...)...)
+		compareValues(r, fieldPath, expectedVal, actualField)
+	}
+}
+
+// isUnset reports whether v is a nil pointer, an empty slice or map, or the
+// zero value of its type.
+func isUnset(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Slice, reflect.Map:
+		return v.Len() == 0
+	default:
+		return v.IsZero()
+	}
+}
+
+// reportPresent reports that the field at path should be unset but is not.
+func reportPresent(r reporter, path string, actual reflect.Value) {
+	if t := r.testingT(); t != nil {
+		t.Helper()
+	}
+	if !actual.CanInterface() {
+		r.report(Mismatch{Path: path, Message: fmt.Sprintf("expected to be unset, got %v", actual)})
+		return
+	}
+	r.report(Mismatch{Path: path, Message: "expected to be unset", Expected: nil, Actual: actual.Interface(), compared: true})
+}
+
+// compareNotContains reports every actual element that matches one of the
+// excluded elements.
+func compareNotContains(r reporter, path string, excluded, actual reflect.Value) {
+	if t := r.testingT(); t != nil {
+		t.Helper()
+	}
+	partial := excluded.Type().Elem().Implements(assertableType)
+	for j := 0; j < actual.Len(); j++ {
+		for i := 0; i < excluded.Len(); i++ {
+			var matched bool
+			if partial {
+				matched = matchesPartially(actual.Index(j), excluded.Index(i))
+			} else {
+				matched = tassert.ObjectsAreEqual(excluded.Index(i).Interface(), actual.Index(j).Interface())
+			}
+			if matched {
+				r.report(Mismatch{Path: fmt.Sprintf("%s[%d]", path, j), Message: fmt.Sprintf("matches excluded element %d", i)})
+				break
+			}
+		}
+	}
+}
+
+// compareSlice compares a slice of assertion structs against actual using
+// the given pairing mode.
+func compareSlice(r reporter, path string, mode matchMode, expected, actual reflect.Value) {
+	if t := r.testingT(); t != nil {
+		t.Helper()
+	}
+
+	if (mode == matchExact || mode == matchAnyOrder) && expected.Len() != actual.Len() {
+		r.report(Mismatch{
+			Path:     path,
+			Message:  "slice length mismatch",
+			Expected: expected.Len(),
+			Actual:   actual.Len(),
+			compared: true,
+		})
+	}
+
+	switch mode {
+	case matchExact:
+		// On a length mismatch the common prefix is still compared so that
+		// element-level problems are reported alongside it.
+		for j := 0; j < min(expected.Len(), actual.Len()); j++ {
+			doAssertPartial(r, fmt.Sprintf("%s[%d]", path, j), actual.Index(j), expected.Index(j))
+		}
+
+	case matchContains, matchAnyOrder:
+		pairing := pairElements(expected, actual)
+		for i, j := range pairing {
+			if j >= 0 {
+				continue
+			}
+			r.report(Mismatch{Path: path, Message: unmatchedMessage(expected.Index(i), actual, i, pairing)})
+		}
+
+	case matchByKey:
+		keyField := naturalKeys[expected.Type().Elem()]
+		for i := 0; i < expected.Len(); i++ {
+			key, _ := assertionKey(expected.Index(i), keyField)
+			elemPath := fmt.Sprintf("%s[%s=%v]", path, keyField, key.Interface())
+			found := false
+			for j := 0; j < actual.Len(); j++ {
+				elem := reflect.Indirect(actual.Index(j))
+				if reflect.DeepEqual(elem.FieldByName(keyField).Interface(), key.Interface()) {
+					doAssertPartial(r, elemPath, actual.Index(j), expected.Index(i))
+					found = true
+					break
+				}
+			}
+			if !found {
+				r.report(Mismatch{Path: elemPath, Message: "no element with this key in actual"})
+			}
+		}
+	}
+}
+
+// matchesPartially reports whether actual satisfies every field of assertion.
+func matchesPartially(actual, assertion reflect.Value) bool {
+	r := &collectingReporter{}
+	doAssertPartial(r, "", actual, assertion)
+	return len(r.mismatches) == 0
+}
+
+// pairElements assigns each expected element a distinct matching actual
+// element, maximising the number of pairs. The result holds the actual index
+// for each expected index, or -1 if it could not be paired.
+func pairElements(expected, actual reflect.Value) []int {
+	candidates := make([][]int, expected.Len())
+	for i := range candidates {
+		for j := 0; j < actual.Len(); j++ {
+			if matchesPartially(actual.Index(j), expected.Index(i)) {
+				candidates[i] = append(candidates[i], j)
+			}
+		}
+	}
+
+	// Augmenting paths (Kuhn's algorithm); slices in manifests are small.
+	owner := make([]int, actual.Len())
+	for j := range owner {
+		owner[j] = -1
+	}
+	var augment func(i int, seen []bool) bool
+	augment = func(i int, seen []bool) bool {
+		for _, j := range candidates[i] {
+			if seen[j] {
+				continue
+			}
+			seen[j] = true
+			if owner[j] < 0 || augment(owner[j], seen) {
+				owner[j] = i
+				return true
+			}
+		}
+		return false
+	}
+	for i := range candidates {
+		augment(i, make([]bool, actual.Len()))
+	}
+
+	pairing := make([]int, expected.Len())
+	for i := range pairing {
+		pairing[i] = -1
+	}
+	for j, i := range owner {
+		if i >= 0 {
+			pairing[i] = j
+		}
+	}
+	return pairing
+}
+
+// unmatchedMessage explains why expected element i found no partner, using
+// the unpaired actual element with the fewest mismatches as the closest one.
+func unmatchedMessage(expected, actual reflect.Value, i int, pairing []int) string {
+	paired := map[int]bool{}
+	for _, j := range pairing {
+		if j >= 0 {
+			paired[j] = true
+		}
+	}
+	closest, closestMismatches := -1, []Mismatch(nil)
+	for j := 0; j < actual.Len(); j++ {
+		if paired[j] {
 			continue
 		}
-		require.Equal(t, expectedVal.Interface(), actualField.Interface(),
-			append([]any{fmt.Sprintf("field %q mismatch", fieldInfo.Name)}, msgAndArgs...)...)
+		r := &collectingReporter{}
+		doAssertPartial(r, "", actual.Index(j), expected)
+		if closest < 0 || len(r.mismatches) < len(closestMismatches) {
+			closest, closestMismatches = j, r.mismatches
+		}
+	}
+	if closest < 0 {
+		return fmt.Sprintf("no element matches expected element %d (all %d actual elements are taken)", i, actual.Len())
+	}
+	paths := make([]string, 0, len(closestMismatches))
+	for _, m := range closestMismatches {
+		paths = append(paths, m.Path)
+	}
+	return fmt.Sprintf("no element matches expected element %d; closest is [%d], which differs in %s",
+		i, closest, strings.Join(paths, ", "))
+}
+
+// compareValues reports a mismatch at path unless expected and actual are equal.
+func compareValues(r reporter, path string, expected, actual reflect.Value) {
+	if t := r.testingT(); t != nil {
+		t.Helper()
+	}
+	if !expected.CanInterface() || !actual.CanInterface() {
+		if !reflect.DeepEqual(expected, actual) {
+			r.report(Mismatch{Path: path, Message: fmt.Sprintf("mismatch\nexpected: %v\nactual:   %v", expected, actual)})
+		}
+		return
+	}
+	if !tassert.ObjectsAreEqual(expected.Interface(), actual.Interface()) {
+		r.report(Mismatch{
+			Path:     path,
+			Message:  "mismatch",
+			Expected: expected.Interface(),
+			Actual:   actual.Interface(),
+			compared: true,
+		})
+	}
+}
+
+// sortedMapKeys returns the keys of m ordered by their formatted value so
+// that reports list map entries deterministically.
+func sortedMapKeys(m reflect.Value) []reflect.Value {
+	keys := m.MapKeys()
+	sort.Slice(keys, func(i, j int) bool {
+		return fmt.Sprint(keys[i].Interface()) < fmt.Sprint(keys[j].Interface())
+	})
+	return keys
+}
+
+func joinPath(path, field string) string {
+	if path == "" {
+		return field
+	}
+	return path + "." + field
+}
+
+func rootPath(path string) string {
+	if path == "" {
+		return "."
 	}
+	return path
 }
@@ -0,0 +1,11909 @@
+// Code generated by assertgen. DO NOT EDIT.
+
+package assert
+
+import (
+	v1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	v12 "k8s.io/api/apps/v1"
+	v2 "k8s.io/api/autoscaling/v2"
+	v13 "k8s.io/api/batch/v1"
+	v11 "k8s.io/api/core/v1"
+	v14 "k8s.io/api/networking/v1"
+	v15 "k8s.io/api/policy/v1"
+	resource "k8s.io/apimachinery/pkg/api/resource"
+	v16 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	intstr "k8s.io/apimachinery/pkg/util/intstr"
+	v17 "sigs.k8s.io/gateway-api/apis/v1"
+	"time"
+)
+
+// Deployment starts a fluent builder for a DeploymentAssertion.
+// Call Build to obtain the assertion struct.
+func Deployment() DeploymentBuilder[DeploymentAssertion] {
+	root := &DeploymentAssertion{}
+	return DeploymentBuilder[DeploymentAssertion]{
+		get: func() *DeploymentAssertion {
+			return root
+		},
+		root: root,
+	}
+}
+
+// HorizontalPodAutoscaler starts a fluent builder for a HorizontalPodAutoscalerAssertion.
+// Call Build to obtain the assertion struct.
+func HorizontalPodAutoscaler() HorizontalPodAutoscalerBuilder[HorizontalPodAutoscalerAssertion] {
+	root := &HorizontalPodAutoscalerAssertion{}
+	return HorizontalPodAutoscalerBuilder[HorizontalPodAutoscalerAssertion]{
+		get: func() *HorizontalPodAutoscalerAssertion {
+			return root
+		},
+		root: root,
+	}
+}
+
+// Job starts a fluent builder for a JobAssertion.
+// Call Build to obtain the assertion struct.
+func Job() JobBuilder[JobAssertion] {
+	root := &JobAssertion{}
+	return JobBuilder[JobAssertion]{
+		get: func() *JobAssertion {
+			return root
+		},
+		root: root,
+	}
+}
+
+// ConfigMap starts a fluent builder for a ConfigMapAssertion.
+// Call Build to obtain the assertion struct.
+func ConfigMap() ConfigMapBuilder[ConfigMapAssertion] {
+	root := &ConfigMapAssertion{}
+	return ConfigMapBuilder[ConfigMapAssertion]{
+		get: func() *ConfigMapAssertion {
+			return root
+		},
+		root: root,
+	}
+}
+
+// Secret starts a fluent builder for a SecretAssertion.
+// Call Build to obtain the assertion struct.
+func Secret() SecretBuilder[SecretAssertion] {
+	root := &SecretAssertion{}
+	return SecretBuilder[SecretAssertion]{
+		get: func() *SecretAssertion {
+			return root
+		},
+		root: root,
+	}
+}
+
+// Service starts a fluent builder for a ServiceAssertion.
+// Call Build to obtain the assertion struct.
+func Service() ServiceBuilder[ServiceAssertion] {
+	root := &ServiceAssertion{}
+	return ServiceBuilder[ServiceAssertion]{
+		get: func() *ServiceAssertion {
+			return root
+		},
+		root: root,
+	}
+}
+
+// ServiceAccount starts a fluent builder for a ServiceAccountAssertion.
+// Call Build to obtain the assertion struct.
+func ServiceAccount() ServiceAccountBuilder[ServiceAccountAssertion] {
+	root := &ServiceAccountAssertion{}
+	return ServiceAccountBuilder[ServiceAccountAssertion]{
+		get: func() *ServiceAccountAssertion {
+			return root
+		},
+		root: root,
+	}
+}
+
+// Ingress starts a fluent builder for a IngressAssertion.
+// Call Build to obtain the assertion struct.
+func Ingress() IngressBuilder[IngressAssertion] {
+	root := &IngressAssertion{}
+	return IngressBuilder[IngressAssertion]{
+		get: func() *IngressAssertion {
+			return root
+		},
+		root: root,
+	}
+}
+
+// PodDisruptionBudget starts a fluent builder for a PodDisruptionBudgetAssertion.
+// Call Build to obtain the assertion struct.
+func PodDisruptionBudget() PodDisruptionBudgetBuilder[PodDisruptionBudgetAssertion] {
+	root := &PodDisruptionBudgetAssertion{}
+	return PodDisruptionBudgetBuilder[PodDisruptionBudgetAssertion]{
+		get: func() *PodDisruptionBudgetAssertion {
+			return root
+		},
+		root: root,
+	}
+}
+
+// Role starts a fluent builder for a RoleAssertion.
+// Call Build to obtain the assertion struct.
+func Role() RoleBuilder[RoleAssertion] {
+	root := &RoleAssertion{}
+	return RoleBuilder[RoleAssertion]{
+		get: func() *RoleAssertion {
+			return root
+		},
+		root: root,
+	}
+}
+
+// RoleBinding starts a fluent builder for a RoleBindingAssertion.
+// Call Build to obtain the assertion struct.
+func RoleBinding() RoleBindingBuilder[RoleBindingAssertion] {
+	root := &RoleBindingAssertion{}
+	return RoleBindingBuilder[RoleBindingAssertion]{
+		get: func() *RoleBindingAssertion {
+			return root
+		},
+		root: root,
+	}
+}
+
+// GRPCRoute starts a fluent builder for a GRPCRouteAssertion.
+// Call Build to obtain the assertion struct.
+func GRPCRoute() GRPCRouteBuilder[GRPCRouteAssertion] {
+	root := &GRPCRouteAssertion{}
+	return GRPCRouteBuilder[GRPCRouteAssertion]{
+		get: func() *GRPCRouteAssertion {
+			return root
+		},
+		root: root,
+	}
+}
+
+// HTTPRoute starts a fluent builder for a HTTPRouteAssertion.
+// Call Build to obtain the assertion struct.
+func HTTPRoute() HTTPRouteBuilder[HTTPRouteAssertion] {
+	root := &HTTPRouteAssertion{}
+	return HTTPRouteBuilder[HTTPRouteAssertion]{
+		get: func() *HTTPRouteAssertion {
+			return root
+		},
+		root: root,
+	}
+}
+
+// ServiceMonitor starts a fluent builder for a ServiceMonitorAssertion.
+// Call Build to obtain the assertion struct.
+func ServiceMonitor() ServiceMonitorBuilder[ServiceMonitorAssertion] {
+	root := &ServiceMonitorAssertion{}
+	return ServiceMonitorBuilder[ServiceMonitorAssertion]{
+		get: func() *ServiceMonitorAssertion {
+			return root
+		},
+		root: root,
+	}
+}
+
+// AttachMetadataBuilder fluently fills in a AttachMetadataAssertion that is part of the
+// assertion struct R returned by Build.
+type AttachMetadataBuilder[R any] struct {
+	root *R
+	get  func() *AttachMetadataAssertion
+}
+
+// Build returns the root assertion struct.
+func (b AttachMetadataBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying AttachMetadataAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b AttachMetadataBuilder[R]) With(fn func(*AttachMetadataAssertion)) AttachMetadataBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// Node sets the expected Node.
+func (b AttachMetadataBuilder[R]) Node(v bool) AttachMetadataBuilder[R] {
+	b.get().Node = SomePtr(v)
+	return b
+}
+
+// BasicAuthBuilder fluently fills in a BasicAuthAssertion that is part of the
+// assertion struct R returned by Build.
+type BasicAuthBuilder[R any] struct {
+	root *R
+	get  func() *BasicAuthAssertion
+}
+
+// Build returns the root assertion struct.
+func (b BasicAuthBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying BasicAuthAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b BasicAuthBuilder[R]) With(fn func(*BasicAuthAssertion)) BasicAuthBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// Username descends into the Username field.
+func (b BasicAuthBuilder[R]) Username() SecretKeySelectorBuilder[R] {
+	get := b.get
+	return SecretKeySelectorBuilder[R]{
+		get: func() *SecretKeySelectorAssertion {
+			return &get().Username
+		},
+		root: b.root,
+	}
+}
+
+// Password descends into the Password field.
+func (b BasicAuthBuilder[R]) Password() SecretKeySelectorBuilder[R] {
+	get := b.get
+	return SecretKeySelectorBuilder[R]{
+		get: func() *SecretKeySelectorAssertion {
+			return &get().Password
+		},
+		root: b.root,
+	}
+}
+
+// EndpointBuilder fluently fills in a EndpointAssertion that is part of the
+// assertion struct R returned by Build.
+type EndpointBuilder[R any] struct {
+	root *R
+	get  func() *EndpointAssertion
+}
+
+// Build returns the root assertion struct.
+func (b EndpointBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying EndpointAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b EndpointBuilder[R]) With(fn func(*EndpointAssertion)) EndpointBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// Port sets the expected Port.
+func (b EndpointBuilder[R]) Port(v string) EndpointBuilder[R] {
+	b.get().Port = Some(v)
+	return b
+}
+
+// TargetPort descends into the TargetPort field.
+func (b EndpointBuilder[R]) TargetPort() IntOrStringBuilder[R] {
+	get := b.get
+	return IntOrStringBuilder[R]{
+		get: func() *IntOrStringAssertion {
+			return &get().TargetPort
+		},
+		root: b.root,
+	}
+}
+
+// Path sets the expected Path.
+func (b EndpointBuilder[R]) Path(v string) EndpointBuilder[R] {
+	b.get().Path = Some(v)
+	return b
+}
+
+// Scheme sets the expected Scheme.
+func (b EndpointBuilder[R]) Scheme(v v1.Scheme) EndpointBuilder[R] {
+	b.get().Scheme = SomePtr(v)
+	return b
+}
+
+// Params sets the expected Params.
+func (b EndpointBuilder[R]) Params(v map[string][]string) EndpointBuilder[R] {
+	b.get().Params = Some(v)
+	return b
+}
+
+// Interval sets the expected Interval.
+func (b EndpointBuilder[R]) Interval(v v1.Duration) EndpointBuilder[R] {
+	b.get().Interval = Some(v)
+	return b
+}
+
+// ScrapeTimeout sets the expected ScrapeTimeout.
+func (b EndpointBuilder[R]) ScrapeTimeout(v v1.Duration) EndpointBuilder[R] {
+	b.get().ScrapeTimeout = Some(v)
+	return b
+}
+
+// HonorLabels sets the expected HonorLabels.
+func (b EndpointBuilder[R]) HonorLabels(v bool) EndpointBuilder[R] {
+	b.get().HonorLabels = Some(v)
+	return b
+}
+
+// HonorTimestamps sets the expected HonorTimestamps.
+func (b EndpointBuilder[R]) HonorTimestamps(v bool) EndpointBuilder[R] {
+	b.get().HonorTimestamps = SomePtr(v)
+	return b
+}
+
+// TrackTimestampsStaleness sets the expected TrackTimestampsStaleness.
+func (b EndpointBuilder[R]) TrackTimestampsStaleness(v bool) EndpointBuilder[R] {
+	b.get().TrackTimestampsStaleness = SomePtr(v)
+	return b
+}
+
+// MetricRelabelConfigs sets MetricRelabelConfigs to exactly the given elements, compared by position.
+func (b EndpointBuilder[R]) MetricRelabelConfigs(v []RelabelConfigAssertion) EndpointBuilder[R] {
+	b.get().MetricRelabelConfigs = Some(v)
+	return b
+}
+
+// RelabelConfigs sets RelabelConfigs to exactly the given elements, compared by position.
+func (b EndpointBuilder[R]) RelabelConfigs(v []RelabelConfigAssertion) EndpointBuilder[R] {
+	b.get().RelabelConfigs = Some(v)
+	return b
+}
+
+// FilterRunning sets the expected FilterRunning.
+func (b EndpointBuilder[R]) FilterRunning(v bool) EndpointBuilder[R] {
+	b.get().FilterRunning = SomePtr(v)
+	return b
+}
+
+// BearerTokenFile sets the expected BearerTokenFile.
+func (b EndpointBuilder[R]) BearerTokenFile(v string) EndpointBuilder[R] {
+	b.get().BearerTokenFile = Some(v)
+	return b
+}
+
+// HTTPConfigWithProxyAndTLSFiles descends into the HTTPConfigWithProxyAndTLSFiles field.
+func (b EndpointBuilder[R]) HTTPConfigWithProxyAndTLSFiles() HTTPConfigWithProxyAndTLSFilesBuilder[R] {
+	get := b.get
+	return HTTPConfigWithProxyAndTLSFilesBuilder[R]{
+		get: func() *HTTPConfigWithProxyAndTLSFilesAssertion {
+			return &get().HTTPConfigWithProxyAndTLSFiles
+		},
+		root: b.root,
+	}
+}
+
+// HTTPConfigWithProxyAndTLSFilesBuilder fluently fills in a HTTPConfigWithProxyAndTLSFilesAssertion that is part of the
+// assertion struct R returned by Build.
+type HTTPConfigWithProxyAndTLSFilesBuilder[R any] struct {
+	root *R
+	get  func() *HTTPConfigWithProxyAndTLSFilesAssertion
+}
+
+// Build returns the root assertion struct.
+func (b HTTPConfigWithProxyAndTLSFilesBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying HTTPConfigWithProxyAndTLSFilesAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b HTTPConfigWithProxyAndTLSFilesBuilder[R]) With(fn func(*HTTPConfigWithProxyAndTLSFilesAssertion)) HTTPConfigWithProxyAndTLSFilesBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// HTTPConfigWithTLSFiles descends into the HTTPConfigWithTLSFiles field.
+func (b HTTPConfigWithProxyAndTLSFilesBuilder[R]) HTTPConfigWithTLSFiles() HTTPConfigWithTLSFilesBuilder[R] {
+	get := b.get
+	return HTTPConfigWithTLSFilesBuilder[R]{
+		get: func() *HTTPConfigWithTLSFilesAssertion {
+			return &get().HTTPConfigWithTLSFiles
+		},
+		root: b.root,
+	}
+}
+
+// ProxyConfig descends into the ProxyConfig field.
+func (b HTTPConfigWithProxyAndTLSFilesBuilder[R]) ProxyConfig() ProxyConfigBuilder[R] {
+	get := b.get
+	return ProxyConfigBuilder[R]{
+		get: func() *ProxyConfigAssertion {
+			return &get().ProxyConfig
+		},
+		root: b.root,
+	}
+}
+
+// HTTPConfigWithTLSFilesBuilder fluently fills in a HTTPConfigWithTLSFilesAssertion that is part of the
+// assertion struct R returned by Build.
+type HTTPConfigWithTLSFilesBuilder[R any] struct {
+	root *R
+	get  func() *HTTPConfigWithTLSFilesAssertion
+}
+
+// Build returns the root assertion struct.
+func (b HTTPConfigWithTLSFilesBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying HTTPConfigWithTLSFilesAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b HTTPConfigWithTLSFilesBuilder[R]) With(fn func(*HTTPConfigWithTLSFilesAssertion)) HTTPConfigWithTLSFilesBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// HTTPConfigWithoutTLS descends into the HTTPConfigWithoutTLS field.
+func (b HTTPConfigWithTLSFilesBuilder[R]) HTTPConfigWithoutTLS() HTTPConfigWithoutTLSBuilder[R] {
+	get := b.get
+	return HTTPConfigWithoutTLSBuilder[R]{
+		get: func() *HTTPConfigWithoutTLSAssertion {
+			return &get().HTTPConfigWithoutTLS
+		},
+		root: b.root,
+	}
+}
+
+// TLSConfig descends into the TLSConfig field.
+func (b HTTPConfigWithTLSFilesBuilder[R]) TLSConfig() MonitoringTLSConfigBuilder[R] {
+	get := b.get
+	return MonitoringTLSConfigBuilder[R]{
+		get: func() *MonitoringTLSConfigAssertion {
+			return &get().TLSConfig
+		},
+		root: b.root,
+	}
+}
+
+// HTTPConfigWithoutTLSBuilder fluently fills in a HTTPConfigWithoutTLSAssertion that is part of the
+// assertion struct R returned by Build.
+type HTTPConfigWithoutTLSBuilder[R any] struct {
+	root *R
+	get  func() *HTTPConfigWithoutTLSAssertion
+}
+
+// Build returns the root assertion struct.
+func (b HTTPConfigWithoutTLSBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying HTTPConfigWithoutTLSAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b HTTPConfigWithoutTLSBuilder[R]) With(fn func(*HTTPConfigWithoutTLSAssertion)) HTTPConfigWithoutTLSBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// Authorization descends into the Authorization field.
+func (b HTTPConfigWithoutTLSBuilder[R]) Authorization() SafeAuthorizationBuilder[R] {
+	get := b.get
+	return SafeAuthorizationBuilder[R]{
+		get: func() *SafeAuthorizationAssertion {
+			return &get().Authorization
+		},
+		root: b.root,
+	}
+}
+
+// BasicAuth descends into the BasicAuth field.
+func (b HTTPConfigWithoutTLSBuilder[R]) BasicAuth() BasicAuthBuilder[R] {
+	get := b.get
+	return BasicAuthBuilder[R]{
+		get: func() *BasicAuthAssertion {
+			return &get().BasicAuth
+		},
+		root: b.root,
+	}
+}
+
+// OAuth2 descends into the OAuth2 field.
+func (b HTTPConfigWithoutTLSBuilder[R]) OAuth2() OAuth2Builder[R] {
+	get := b.get
+	return OAuth2Builder[R]{
+		get: func() *OAuth2Assertion {
+			return &get().OAuth2
+		},
+		root: b.root,
+	}
+}
+
+// BearerTokenSecret descends into the BearerTokenSecret field.
+func (b HTTPConfigWithoutTLSBuilder[R]) BearerTokenSecret() SecretKeySelectorBuilder[R] {
+	get := b.get
+	return SecretKeySelectorBuilder[R]{
+		get: func() *SecretKeySelectorAssertion {
+			return &get().BearerTokenSecret
+		},
+		root: b.root,
+	}
+}
+
+// FollowRedirects sets the expected FollowRedirects.
+func (b HTTPConfigWithoutTLSBuilder[R]) FollowRedirects(v bool) HTTPConfigWithoutTLSBuilder[R] {
+	b.get().FollowRedirects = SomePtr(v)
+	return b
+}
+
+// EnableHTTP2 sets the expected EnableHTTP2.
+func (b HTTPConfigWithoutTLSBuilder[R]) EnableHTTP2(v bool) HTTPConfigWithoutTLSBuilder[R] {
+	b.get().EnableHTTP2 = SomePtr(v)
+	return b
+}
+
+// NamespaceSelectorBuilder fluently fills in a NamespaceSelectorAssertion that is part of the
+// assertion struct R returned by Build.
+type NamespaceSelectorBuilder[R any] struct {
+	root *R
+	get  func() *NamespaceSelectorAssertion
+}
+
+// Build returns the root assertion struct.
+func (b NamespaceSelectorBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying NamespaceSelectorAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b NamespaceSelectorBuilder[R]) With(fn func(*NamespaceSelectorAssertion)) NamespaceSelectorBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// Any sets the expected Any.
+func (b NamespaceSelectorBuilder[R]) Any(v bool) NamespaceSelectorBuilder[R] {
+	b.get().Any = Some(v)
+	return b
+}
+
+// MatchNames sets the expected MatchNames.
+func (b NamespaceSelectorBuilder[R]) MatchNames(v []string) NamespaceSelectorBuilder[R] {
+	b.get().MatchNames = Some(v)
+	return b
+}
+
+// NativeHistogramConfigBuilder fluently fills in a NativeHistogramConfigAssertion that is part of the
+// assertion struct R returned by Build.
+type NativeHistogramConfigBuilder[R any] struct {
+	root *R
+	get  func() *NativeHistogramConfigAssertion
+}
+
+// Build returns the root assertion struct.
+func (b NativeHistogramConfigBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying NativeHistogramConfigAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b NativeHistogramConfigBuilder[R]) With(fn func(*NativeHistogramConfigAssertion)) NativeHistogramConfigBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// ScrapeNativeHistograms sets the expected ScrapeNativeHistograms.
+func (b NativeHistogramConfigBuilder[R]) ScrapeNativeHistograms(v bool) NativeHistogramConfigBuilder[R] {
+	b.get().ScrapeNativeHistograms = SomePtr(v)
+	return b
+}
+
+// ScrapeClassicHistograms sets the expected ScrapeClassicHistograms.
+func (b NativeHistogramConfigBuilder[R]) ScrapeClassicHistograms(v bool) NativeHistogramConfigBuilder[R] {
+	b.get().ScrapeClassicHistograms = SomePtr(v)
+	return b
+}
+
+// NativeHistogramBucketLimit sets the expected NativeHistogramBucketLimit.
+func (b NativeHistogramConfigBuilder[R]) NativeHistogramBucketLimit(v uint64) NativeHistogramConfigBuilder[R] {
+	b.get().NativeHistogramBucketLimit = SomePtr(v)
+	return b
+}
+
+// NativeHistogramMinBucketFactor descends into the NativeHistogramMinBucketFactor field.
+func (b NativeHistogramConfigBuilder[R]) NativeHistogramMinBucketFactor() QuantityBuilder[R] {
+	get := b.get
+	return QuantityBuilder[R]{
+		get: func() *QuantityAssertion {
+			return &get().NativeHistogramMinBucketFactor
+		},
+		root: b.root,
+	}
+}
+
+// ConvertClassicHistogramsToNHCB sets the expected ConvertClassicHistogramsToNHCB.
+func (b NativeHistogramConfigBuilder[R]) ConvertClassicHistogramsToNHCB(v bool) NativeHistogramConfigBuilder[R] {
+	b.get().ConvertClassicHistogramsToNHCB = SomePtr(v)
+	return b
+}
+
+// OAuth2Builder fluently fills in a OAuth2Assertion that is part of the
+// assertion struct R returned by Build.
+type OAuth2Builder[R any] struct {
+	root *R
+	get  func() *OAuth2Assertion
+}
+
+// Build returns the root assertion struct.
+func (b OAuth2Builder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying OAuth2Assertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b OAuth2Builder[R]) With(fn func(*OAuth2Assertion)) OAuth2Builder[R] {
+	fn(b.get())
+	return b
+}
+
+// ClientID descends into the ClientID field.
+func (b OAuth2Builder[R]) ClientID() SecretOrConfigMapBuilder[R] {
+	get := b.get
+	return SecretOrConfigMapBuilder[R]{
+		get: func() *SecretOrConfigMapAssertion {
+			return &get().ClientID
+		},
+		root: b.root,
+	}
+}
+
+// ClientSecret descends into the ClientSecret field.
+func (b OAuth2Builder[R]) ClientSecret() SecretKeySelectorBuilder[R] {
+	get := b.get
+	return SecretKeySelectorBuilder[R]{
+		get: func() *SecretKeySelectorAssertion {
+			return &get().ClientSecret
+		},
+		root: b.root,
+	}
+}
+
+// TokenURL sets the expected TokenURL.
+func (b OAuth2Builder[R]) TokenURL(v string) OAuth2Builder[R] {
+	b.get().TokenURL = Some(v)
+	return b
+}
+
+// Scopes sets the expected Scopes.
+func (b OAuth2Builder[R]) Scopes(v []string) OAuth2Builder[R] {
+	b.get().Scopes = Some(v)
+	return b
+}
+
+// EndpointParams sets the expected EndpointParams.
+func (b OAuth2Builder[R]) EndpointParams(v map[string]string) OAuth2Builder[R] {
+	b.get().EndpointParams = Some(v)
+	return b
+}
+
+// TLSConfig descends into the TLSConfig field.
+func (b OAuth2Builder[R]) TLSConfig() SafeTLSConfigBuilder[R] {
+	get := b.get
+	return SafeTLSConfigBuilder[R]{
+		get: func() *SafeTLSConfigAssertion {
+			return &get().TLSConfig
+		},
+		root: b.root,
+	}
+}
+
+// ProxyConfig descends into the ProxyConfig field.
+func (b OAuth2Builder[R]) ProxyConfig() ProxyConfigBuilder[R] {
+	get := b.get
+	return ProxyConfigBuilder[R]{
+		get: func() *ProxyConfigAssertion {
+			return &get().ProxyConfig
+		},
+		root: b.root,
+	}
+}
+
+// ProxyConfigBuilder fluently fills in a ProxyConfigAssertion that is part of the
+// assertion struct R returned by Build.
+type ProxyConfigBuilder[R any] struct {
+	root *R
+	get  func() *ProxyConfigAssertion
+}
+
+// Build returns the root assertion struct.
+func (b ProxyConfigBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying ProxyConfigAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b ProxyConfigBuilder[R]) With(fn func(*ProxyConfigAssertion)) ProxyConfigBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// ProxyURL sets the expected ProxyURL.
+func (b ProxyConfigBuilder[R]) ProxyURL(v string) ProxyConfigBuilder[R] {
+	b.get().ProxyURL = SomePtr(v)
+	return b
+}
+
+// NoProxy sets the expected NoProxy.
+func (b ProxyConfigBuilder[R]) NoProxy(v string) ProxyConfigBuilder[R] {
+	b.get().NoProxy = SomePtr(v)
+	return b
+}
+
+// ProxyFromEnvironment sets the expected ProxyFromEnvironment.
+func (b ProxyConfigBuilder[R]) ProxyFromEnvironment(v bool) ProxyConfigBuilder[R] {
+	b.get().ProxyFromEnvironment = SomePtr(v)
+	return b
+}
+
+// ProxyConnectHeader sets the expected ProxyConnectHeader.
+func (b ProxyConfigBuilder[R]) ProxyConnectHeader(v map[string][]v11.SecretKeySelector) ProxyConfigBuilder[R] {
+	b.get().ProxyConnectHeader = Some(v)
+	return b
+}
+
+// RelabelConfigBuilder fluently fills in a RelabelConfigAssertion that is part of the
+// assertion struct R returned by Build.
+type RelabelConfigBuilder[R any] struct {
+	root *R
+	get  func() *RelabelConfigAssertion
+}
+
+// Build returns the root assertion struct.
+func (b RelabelConfigBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying RelabelConfigAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b RelabelConfigBuilder[R]) With(fn func(*RelabelConfigAssertion)) RelabelConfigBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// SourceLabels sets the expected SourceLabels.
+func (b RelabelConfigBuilder[R]) SourceLabels(v []v1.LabelName) RelabelConfigBuilder[R] {
+	b.get().SourceLabels = Some(v)
+	return b
+}
+
+// Separator sets the expected Separator.
+func (b RelabelConfigBuilder[R]) Separator(v string) RelabelConfigBuilder[R] {
+	b.get().Separator = SomePtr(v)
+	return b
+}
+
+// TargetLabel sets the expected TargetLabel.
+func (b RelabelConfigBuilder[R]) TargetLabel(v string) RelabelConfigBuilder[R] {
+	b.get().TargetLabel = Some(v)
+	return b
+}
+
+// Regex sets the expected Regex.
+func (b RelabelConfigBuilder[R]) Regex(v string) RelabelConfigBuilder[R] {
+	b.get().Regex = Some(v)
+	return b
+}
+
+// Modulus sets the expected Modulus.
+func (b RelabelConfigBuilder[R]) Modulus(v uint64) RelabelConfigBuilder[R] {
+	b.get().Modulus = Some(v)
+	return b
+}
+
+// Replacement sets the expected Replacement.
+func (b RelabelConfigBuilder[R]) Replacement(v string) RelabelConfigBuilder[R] {
+	b.get().Replacement = SomePtr(v)
+	return b
+}
+
+// Action sets the expected Action.
+func (b RelabelConfigBuilder[R]) Action(v string) RelabelConfigBuilder[R] {
+	b.get().Action = Some(v)
+	return b
+}
+
+// SafeAuthorizationBuilder fluently fills in a SafeAuthorizationAssertion that is part of the
+// assertion struct R returned by Build.
+type SafeAuthorizationBuilder[R any] struct {
+	root *R
+	get  func() *SafeAuthorizationAssertion
+}
+
+// Build returns the root assertion struct.
+func (b SafeAuthorizationBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying SafeAuthorizationAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b SafeAuthorizationBuilder[R]) With(fn func(*SafeAuthorizationAssertion)) SafeAuthorizationBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// Type sets the expected Type.
+func (b SafeAuthorizationBuilder[R]) Type(v string) SafeAuthorizationBuilder[R] {
+	b.get().Type = Some(v)
+	return b
+}
+
+// Credentials descends into the Credentials field.
+func (b SafeAuthorizationBuilder[R]) Credentials() SecretKeySelectorBuilder[R] {
+	get := b.get
+	return SecretKeySelectorBuilder[R]{
+		get: func() *SecretKeySelectorAssertion {
+			return &get().Credentials
+		},
+		root: b.root,
+	}
+}
+
+// SafeTLSConfigBuilder fluently fills in a SafeTLSConfigAssertion that is part of the
+// assertion struct R returned by Build.
+type SafeTLSConfigBuilder[R any] struct {
+	root *R
+	get  func() *SafeTLSConfigAssertion
+}
+
+// Build returns the root assertion struct.
+func (b SafeTLSConfigBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying SafeTLSConfigAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b SafeTLSConfigBuilder[R]) With(fn func(*SafeTLSConfigAssertion)) SafeTLSConfigBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// CA descends into the CA field.
+func (b SafeTLSConfigBuilder[R]) CA() SecretOrConfigMapBuilder[R] {
+	get := b.get
+	return SecretOrConfigMapBuilder[R]{
+		get: func() *SecretOrConfigMapAssertion {
+			return &get().CA
+		},
+		root: b.root,
+	}
+}
+
+// Cert descends into the Cert field.
+func (b SafeTLSConfigBuilder[R]) Cert() SecretOrConfigMapBuilder[R] {
+	get := b.get
+	return SecretOrConfigMapBuilder[R]{
+		get: func() *SecretOrConfigMapAssertion {
+			return &get().Cert
+		},
+		root: b.root,
+	}
+}
+
+// KeySecret descends into the KeySecret field.
+func (b SafeTLSConfigBuilder[R]) KeySecret() SecretKeySelectorBuilder[R] {
+	get := b.get
+	return SecretKeySelectorBuilder[R]{
+		get: func() *SecretKeySelectorAssertion {
+			return &get().KeySecret
+		},
+		root: b.root,
+	}
+}
+
+// ServerName sets the expected ServerName.
+func (b SafeTLSConfigBuilder[R]) ServerName(v string) SafeTLSConfigBuilder[R] {
+	b.get().ServerName = SomePtr(v)
+	return b
+}
+
+// InsecureSkipVerify sets the expected InsecureSkipVerify.
+func (b SafeTLSConfigBuilder[R]) InsecureSkipVerify(v bool) SafeTLSConfigBuilder[R] {
+	b.get().InsecureSkipVerify = SomePtr(v)
+	return b
+}
+
+// MinVersion sets the expected MinVersion.
+func (b SafeTLSConfigBuilder[R]) MinVersion(v v1.TLSVersion) SafeTLSConfigBuilder[R] {
+	b.get().MinVersion = SomePtr(v)
+	return b
+}
+
+// MaxVersion sets the expected MaxVersion.
+func (b SafeTLSConfigBuilder[R]) MaxVersion(v v1.TLSVersion) SafeTLSConfigBuilder[R] {
+	b.get().MaxVersion = SomePtr(v)
+	return b
+}
+
+// SecretOrConfigMapBuilder fluently fills in a SecretOrConfigMapAssertion that is part of the
+// assertion struct R returned by Build.
+type SecretOrConfigMapBuilder[R any] struct {
+	root *R
+	get  func() *SecretOrConfigMapAssertion
+}
+
+// Build returns the root assertion struct.
+func (b SecretOrConfigMapBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying SecretOrConfigMapAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b SecretOrConfigMapBuilder[R]) With(fn func(*SecretOrConfigMapAssertion)) SecretOrConfigMapBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// Secret descends into the Secret field.
+func (b SecretOrConfigMapBuilder[R]) Secret() SecretKeySelectorBuilder[R] {
+	get := b.get
+	return SecretKeySelectorBuilder[R]{
+		get: func() *SecretKeySelectorAssertion {
+			return &get().Secret
+		},
+		root: b.root,
+	}
+}
+
+// ConfigMap descends into the ConfigMap field.
+func (b SecretOrConfigMapBuilder[R]) ConfigMap() ConfigMapKeySelectorBuilder[R] {
+	get := b.get
+	return ConfigMapKeySelectorBuilder[R]{
+		get: func() *ConfigMapKeySelectorAssertion {
+			return &get().ConfigMap
+		},
+		root: b.root,
+	}
+}
+
+// ServiceMonitorBuilder fluently fills in a ServiceMonitorAssertion that is part of the
+// assertion struct R returned by Build.
+type ServiceMonitorBuilder[R any] struct {
+	root *R
+	get  func() *ServiceMonitorAssertion
+}
+
+// Build returns the root assertion struct.
+func (b ServiceMonitorBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying ServiceMonitorAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b ServiceMonitorBuilder[R]) With(fn func(*ServiceMonitorAssertion)) ServiceMonitorBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// TypeMeta descends into the TypeMeta field.
+func (b ServiceMonitorBuilder[R]) TypeMeta() TypeMetaBuilder[R] {
+	get := b.get
+	return TypeMetaBuilder[R]{
+		get: func() *TypeMetaAssertion {
+			return &get().TypeMeta
+		},
+		root: b.root,
+	}
+}
+
+// ObjectMeta descends into the ObjectMeta field.
+func (b ServiceMonitorBuilder[R]) ObjectMeta() ObjectMetaBuilder[R] {
+	get := b.get
+	return ObjectMetaBuilder[R]{
+		get: func() *ObjectMetaAssertion {
+			return &get().ObjectMeta
+		},
+		root: b.root,
+	}
+}
+
+// Spec descends into the Spec field.
+func (b ServiceMonitorBuilder[R]) Spec() ServiceMonitorSpecBuilder[R] {
+	get := b.get
+	return ServiceMonitorSpecBuilder[R]{
+		get: func() *ServiceMonitorSpecAssertion {
+			return &get().Spec
+		},
+		root: b.root,
+	}
+}
+
+// Status sets the expected Status.
+func (b ServiceMonitorBuilder[R]) Status(v v1.ConfigResourceStatus) ServiceMonitorBuilder[R] {
+	b.get().Status = Some(v)
+	return b
+}
+
+// ServiceMonitorSpecBuilder fluently fills in a ServiceMonitorSpecAssertion that is part of the
+// assertion struct R returned by Build.
+type ServiceMonitorSpecBuilder[R any] struct {
+	root *R
+	get  func() *ServiceMonitorSpecAssertion
+}
+
+// Build returns the root assertion struct.
+func (b ServiceMonitorSpecBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying ServiceMonitorSpecAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b ServiceMonitorSpecBuilder[R]) With(fn func(*ServiceMonitorSpecAssertion)) ServiceMonitorSpecBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// JobLabel sets the expected JobLabel.
+func (b ServiceMonitorSpecBuilder[R]) JobLabel(v string) ServiceMonitorSpecBuilder[R] {
+	b.get().JobLabel = Some(v)
+	return b
+}
+
+// TargetLabels sets the expected TargetLabels.
+func (b ServiceMonitorSpecBuilder[R]) TargetLabels(v []string) ServiceMonitorSpecBuilder[R] {
+	b.get().TargetLabels = Some(v)
+	return b
+}
+
+// PodTargetLabels sets the expected PodTargetLabels.
+func (b ServiceMonitorSpecBuilder[R]) PodTargetLabels(v []string) ServiceMonitorSpecBuilder[R] {
+	b.get().PodTargetLabels = Some(v)
+	return b
+}
+
+// Endpoints sets Endpoints to exactly the given elements, compared by position.
+func (b ServiceMonitorSpecBuilder[R]) Endpoints(v []EndpointAssertion) ServiceMonitorSpecBuilder[R] {
+	b.get().Endpoints = Some(v)
+	return b
+}
+
+// Selector descends into the Selector field.
+func (b ServiceMonitorSpecBuilder[R]) Selector() LabelSelectorBuilder[R] {
+	get := b.get
+	return LabelSelectorBuilder[R]{
+		get: func() *LabelSelectorAssertion {
+			return &get().Selector
+		},
+		root: b.root,
+	}
+}
+
+// SelectorMechanism sets the expected SelectorMechanism.
+func (b ServiceMonitorSpecBuilder[R]) SelectorMechanism(v v1.SelectorMechanism) ServiceMonitorSpecBuilder[R] {
+	b.get().SelectorMechanism = SomePtr(v)
+	return b
+}
+
+// NamespaceSelector descends into the NamespaceSelector field.
+func (b ServiceMonitorSpecBuilder[R]) NamespaceSelector() NamespaceSelectorBuilder[R] {
+	get := b.get
+	return NamespaceSelectorBuilder[R]{
+		get: func() *NamespaceSelectorAssertion {
+			return &get().NamespaceSelector
+		},
+		root: b.root,
+	}
+}
+
+// SampleLimit sets the expected SampleLimit.
+func (b ServiceMonitorSpecBuilder[R]) SampleLimit(v uint64) ServiceMonitorSpecBuilder[R] {
+	b.get().SampleLimit = SomePtr(v)
+	return b
+}
+
+// ScrapeProtocols sets the expected ScrapeProtocols.
+func (b ServiceMonitorSpecBuilder[R]) ScrapeProtocols(v []v1.ScrapeProtocol) ServiceMonitorSpecBuilder[R] {
+	b.get().ScrapeProtocols = Some(v)
+	return b
+}
+
+// FallbackScrapeProtocol sets the expected FallbackScrapeProtocol.
+func (b ServiceMonitorSpecBuilder[R]) FallbackScrapeProtocol(v v1.ScrapeProtocol) ServiceMonitorSpecBuilder[R] {
+	b.get().FallbackScrapeProtocol = SomePtr(v)
+	return b
+}
+
+// TargetLimit sets the expected TargetLimit.
+func (b ServiceMonitorSpecBuilder[R]) TargetLimit(v uint64) ServiceMonitorSpecBuilder[R] {
+	b.get().TargetLimit = SomePtr(v)
+	return b
+}
+
+// LabelLimit sets the expected LabelLimit.
+func (b ServiceMonitorSpecBuilder[R]) LabelLimit(v uint64) ServiceMonitorSpecBuilder[R] {
+	b.get().LabelLimit = SomePtr(v)
+	return b
+}
+
+// LabelNameLengthLimit sets the expected LabelNameLengthLimit.
+func (b ServiceMonitorSpecBuilder[R]) LabelNameLengthLimit(v uint64) ServiceMonitorSpecBuilder[R] {
+	b.get().LabelNameLengthLimit = SomePtr(v)
+	return b
+}
+
+// LabelValueLengthLimit sets the expected LabelValueLengthLimit.
+func (b ServiceMonitorSpecBuilder[R]) LabelValueLengthLimit(v uint64) ServiceMonitorSpecBuilder[R] {
+	b.get().LabelValueLengthLimit = SomePtr(v)
+	return b
+}
+
+// NativeHistogramConfig descends into the NativeHistogramConfig field.
+func (b ServiceMonitorSpecBuilder[R]) NativeHistogramConfig() NativeHistogramConfigBuilder[R] {
+	get := b.get
+	return NativeHistogramConfigBuilder[R]{
+		get: func() *NativeHistogramConfigAssertion {
+			return &get().NativeHistogramConfig
+		},
+		root: b.root,
+	}
+}
+
+// KeepDroppedTargets sets the expected KeepDroppedTargets.
+func (b ServiceMonitorSpecBuilder[R]) KeepDroppedTargets(v uint64) ServiceMonitorSpecBuilder[R] {
+	b.get().KeepDroppedTargets = SomePtr(v)
+	return b
+}
+
+// AttachMetadata descends into the AttachMetadata field.
+func (b ServiceMonitorSpecBuilder[R]) AttachMetadata() AttachMetadataBuilder[R] {
+	get := b.get
+	return AttachMetadataBuilder[R]{
+		get: func() *AttachMetadataAssertion {
+			return &get().AttachMetadata
+		},
+		root: b.root,
+	}
+}
+
+// ScrapeClassName sets the expected ScrapeClassName.
+func (b ServiceMonitorSpecBuilder[R]) ScrapeClassName(v string) ServiceMonitorSpecBuilder[R] {
+	b.get().ScrapeClassName = SomePtr(v)
+	return b
+}
+
+// BodySizeLimit sets the expected BodySizeLimit.
+func (b ServiceMonitorSpecBuilder[R]) BodySizeLimit(v v1.ByteSize) ServiceMonitorSpecBuilder[R] {
+	b.get().BodySizeLimit = SomePtr(v)
+	return b
+}
+
+// ServiceDiscoveryRole sets the expected ServiceDiscoveryRole.
+func (b ServiceMonitorSpecBuilder[R]) ServiceDiscoveryRole(v v1.ServiceDiscoveryRole) ServiceMonitorSpecBuilder[R] {
+	b.get().ServiceDiscoveryRole = SomePtr(v)
+	return b
+}
+
+// MonitoringTLSConfigBuilder fluently fills in a MonitoringTLSConfigAssertion that is part of the
+// assertion struct R returned by Build.
+type MonitoringTLSConfigBuilder[R any] struct {
+	root *R
+	get  func() *MonitoringTLSConfigAssertion
+}
+
+// Build returns the root assertion struct.
+func (b MonitoringTLSConfigBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying MonitoringTLSConfigAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b MonitoringTLSConfigBuilder[R]) With(fn func(*MonitoringTLSConfigAssertion)) MonitoringTLSConfigBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// SafeTLSConfig descends into the SafeTLSConfig field.
+func (b MonitoringTLSConfigBuilder[R]) SafeTLSConfig() SafeTLSConfigBuilder[R] {
+	get := b.get
+	return SafeTLSConfigBuilder[R]{
+		get: func() *SafeTLSConfigAssertion {
+			return &get().SafeTLSConfig
+		},
+		root: b.root,
+	}
+}
+
+// TLSFilesConfig descends into the TLSFilesConfig field.
+func (b MonitoringTLSConfigBuilder[R]) TLSFilesConfig() TLSFilesConfigBuilder[R] {
+	get := b.get
+	return TLSFilesConfigBuilder[R]{
+		get: func() *TLSFilesConfigAssertion {
+			return &get().TLSFilesConfig
+		},
+		root: b.root,
+	}
+}
+
+// TLSFilesConfigBuilder fluently fills in a TLSFilesConfigAssertion that is part of the
+// assertion struct R returned by Build.
+type TLSFilesConfigBuilder[R any] struct {
+	root *R
+	get  func() *TLSFilesConfigAssertion
+}
+
+// Build returns the root assertion struct.
+func (b TLSFilesConfigBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying TLSFilesConfigAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b TLSFilesConfigBuilder[R]) With(fn func(*TLSFilesConfigAssertion)) TLSFilesConfigBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// CAFile sets the expected CAFile.
+func (b TLSFilesConfigBuilder[R]) CAFile(v string) TLSFilesConfigBuilder[R] {
+	b.get().CAFile = Some(v)
+	return b
+}
+
+// CertFile sets the expected CertFile.
+func (b TLSFilesConfigBuilder[R]) CertFile(v string) TLSFilesConfigBuilder[R] {
+	b.get().CertFile = Some(v)
+	return b
+}
+
+// KeyFile sets the expected KeyFile.
+func (b TLSFilesConfigBuilder[R]) KeyFile(v string) TLSFilesConfigBuilder[R] {
+	b.get().KeyFile = Some(v)
+	return b
+}
+
+// DeploymentBuilder fluently fills in a DeploymentAssertion that is part of the
+// assertion struct R returned by Build.
+type DeploymentBuilder[R any] struct {
+	root *R
+	get  func() *DeploymentAssertion
+}
+
+// Build returns the root assertion struct.
+func (b DeploymentBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying DeploymentAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b DeploymentBuilder[R]) With(fn func(*DeploymentAssertion)) DeploymentBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// TypeMeta descends into the TypeMeta field.
+func (b DeploymentBuilder[R]) TypeMeta() TypeMetaBuilder[R] {
+	get := b.get
+	return TypeMetaBuilder[R]{
+		get: func() *TypeMetaAssertion {
+			return &get().TypeMeta
+		},
+		root: b.root,
+	}
+}
+
+// ObjectMeta descends into the ObjectMeta field.
+func (b DeploymentBuilder[R]) ObjectMeta() ObjectMetaBuilder[R] {
+	get := b.get
+	return ObjectMetaBuilder[R]{
+		get: func() *ObjectMetaAssertion {
+			return &get().ObjectMeta
+		},
+		root: b.root,
+	}
+}
+
+// Spec descends into the Spec field.
+func (b DeploymentBuilder[R]) Spec() DeploymentSpecBuilder[R] {
+	get := b.get
+	return DeploymentSpecBuilder[R]{
+		get: func() *DeploymentSpecAssertion {
+			return &get().Spec
+		},
+		root: b.root,
+	}
+}
+
+// Status sets the expected Status.
+func (b DeploymentBuilder[R]) Status(v v12.DeploymentStatus) DeploymentBuilder[R] {
+	b.get().Status = Some(v)
+	return b
+}
+
+// DeploymentSpecBuilder fluently fills in a DeploymentSpecAssertion that is part of the
+// assertion struct R returned by Build.
+type DeploymentSpecBuilder[R any] struct {
+	root *R
+	get  func() *DeploymentSpecAssertion
+}
+
+// Build returns the root assertion struct.
+func (b DeploymentSpecBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying DeploymentSpecAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b DeploymentSpecBuilder[R]) With(fn func(*DeploymentSpecAssertion)) DeploymentSpecBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// Replicas sets the expected Replicas.
+func (b DeploymentSpecBuilder[R]) Replicas(v int32) DeploymentSpecBuilder[R] {
+	b.get().Replicas = SomePtr(v)
+	return b
+}
+
+// Selector descends into the Selector field.
+func (b DeploymentSpecBuilder[R]) Selector() LabelSelectorBuilder[R] {
+	get := b.get
+	return LabelSelectorBuilder[R]{
+		get: func() *LabelSelectorAssertion {
+			return &get().Selector
+		},
+		root: b.root,
+	}
+}
+
+// Template descends into the Template field.
+func (b DeploymentSpecBuilder[R]) Template() PodTemplateSpecBuilder[R] {
+	get := b.get
+	return PodTemplateSpecBuilder[R]{
+		get: func() *PodTemplateSpecAssertion {
+			return &get().Template
+		},
+		root: b.root,
+	}
+}
+
+// Strategy descends into the Strategy field.
+func (b DeploymentSpecBuilder[R]) Strategy() DeploymentStrategyBuilder[R] {
+	get := b.get
+	return DeploymentStrategyBuilder[R]{
+		get: func() *DeploymentStrategyAssertion {
+			return &get().Strategy
+		},
+		root: b.root,
+	}
+}
+
+// MinReadySeconds sets the expected MinReadySeconds.
+func (b DeploymentSpecBuilder[R]) MinReadySeconds(v int32) DeploymentSpecBuilder[R] {
+	b.get().MinReadySeconds = Some(v)
+	return b
+}
+
+// RevisionHistoryLimit sets the expected RevisionHistoryLimit.
+func (b DeploymentSpecBuilder[R]) RevisionHistoryLimit(v int32) DeploymentSpecBuilder[R] {
+	b.get().RevisionHistoryLimit = SomePtr(v)
+	return b
+}
+
+// Paused sets the expected Paused.
+func (b DeploymentSpecBuilder[R]) Paused(v bool) DeploymentSpecBuilder[R] {
+	b.get().Paused = Some(v)
+	return b
+}
+
+// ProgressDeadlineSeconds sets the expected ProgressDeadlineSeconds.
+func (b DeploymentSpecBuilder[R]) ProgressDeadlineSeconds(v int32) DeploymentSpecBuilder[R] {
+	b.get().ProgressDeadlineSeconds = SomePtr(v)
+	return b
+}
+
+// DeploymentStrategyBuilder fluently fills in a DeploymentStrategyAssertion that is part of the
+// assertion struct R returned by Build.
+type DeploymentStrategyBuilder[R any] struct {
+	root *R
+	get  func() *DeploymentStrategyAssertion
+}
+
+// Build returns the root assertion struct.
+func (b DeploymentStrategyBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying DeploymentStrategyAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b DeploymentStrategyBuilder[R]) With(fn func(*DeploymentStrategyAssertion)) DeploymentStrategyBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// Type sets the expected Type.
+func (b DeploymentStrategyBuilder[R]) Type(v v12.DeploymentStrategyType) DeploymentStrategyBuilder[R] {
+	b.get().Type = Some(v)
+	return b
+}
+
+// RollingUpdate descends into the RollingUpdate field.
+func (b DeploymentStrategyBuilder[R]) RollingUpdate() RollingUpdateDeploymentBuilder[R] {
+	get := b.get
+	return RollingUpdateDeploymentBuilder[R]{
+		get: func() *RollingUpdateDeploymentAssertion {
+			return &get().RollingUpdate
+		},
+		root: b.root,
+	}
+}
+
+// RollingUpdateDeploymentBuilder fluently fills in a RollingUpdateDeploymentAssertion that is part of the
+// assertion struct R returned by Build.
+type RollingUpdateDeploymentBuilder[R any] struct {
+	root *R
+	get  func() *RollingUpdateDeploymentAssertion
+}
+
+// Build returns the root assertion struct.
+func (b RollingUpdateDeploymentBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying RollingUpdateDeploymentAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b RollingUpdateDeploymentBuilder[R]) With(fn func(*RollingUpdateDeploymentAssertion)) RollingUpdateDeploymentBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// MaxUnavailable descends into the MaxUnavailable field.
+func (b RollingUpdateDeploymentBuilder[R]) MaxUnavailable() IntOrStringBuilder[R] {
+	get := b.get
+	return IntOrStringBuilder[R]{
+		get: func() *IntOrStringAssertion {
+			return &get().MaxUnavailable
+		},
+		root: b.root,
+	}
+}
+
+// MaxSurge descends into the MaxSurge field.
+func (b RollingUpdateDeploymentBuilder[R]) MaxSurge() IntOrStringBuilder[R] {
+	get := b.get
+	return IntOrStringBuilder[R]{
+		get: func() *IntOrStringAssertion {
+			return &get().MaxSurge
+		},
+		root: b.root,
+	}
+}
+
+// ContainerResourceMetricSourceBuilder fluently fills in a ContainerResourceMetricSourceAssertion that is part of the
+// assertion struct R returned by Build.
+type ContainerResourceMetricSourceBuilder[R any] struct {
+	root *R
+	get  func() *ContainerResourceMetricSourceAssertion
+}
+
+// Build returns the root assertion struct.
+func (b ContainerResourceMetricSourceBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying ContainerResourceMetricSourceAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b ContainerResourceMetricSourceBuilder[R]) With(fn func(*ContainerResourceMetricSourceAssertion)) ContainerResourceMetricSourceBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// Name sets the expected Name.
+func (b ContainerResourceMetricSourceBuilder[R]) Name(v v11.ResourceName) ContainerResourceMetricSourceBuilder[R] {
+	b.get().Name = Some(v)
+	return b
+}
+
+// Target descends into the Target field.
+func (b ContainerResourceMetricSourceBuilder[R]) Target() MetricTargetBuilder[R] {
+	get := b.get
+	return MetricTargetBuilder[R]{
+		get: func() *MetricTargetAssertion {
+			return &get().Target
+		},
+		root: b.root,
+	}
+}
+
+// Container sets the expected Container.
+func (b ContainerResourceMetricSourceBuilder[R]) Container(v string) ContainerResourceMetricSourceBuilder[R] {
+	b.get().Container = Some(v)
+	return b
+}
+
+// CrossVersionObjectReferenceBuilder fluently fills in a CrossVersionObjectReferenceAssertion that is part of the
+// assertion struct R returned by Build.
+type CrossVersionObjectReferenceBuilder[R any] struct {
+	root *R
+	get  func() *CrossVersionObjectReferenceAssertion
+}
+
+// Build returns the root assertion struct.
+func (b CrossVersionObjectReferenceBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying CrossVersionObjectReferenceAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b CrossVersionObjectReferenceBuilder[R]) With(fn func(*CrossVersionObjectReferenceAssertion)) CrossVersionObjectReferenceBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// Kind sets the expected Kind.
+func (b CrossVersionObjectReferenceBuilder[R]) Kind(v string) CrossVersionObjectReferenceBuilder[R] {
+	b.get().Kind = Some(v)
+	return b
+}
+
+// Name sets the expected Name.
+func (b CrossVersionObjectReferenceBuilder[R]) Name(v string) CrossVersionObjectReferenceBuilder[R] {
+	b.get().Name = Some(v)
+	return b
+}
+
+// APIVersion sets the expected APIVersion.
+func (b CrossVersionObjectReferenceBuilder[R]) APIVersion(v string) CrossVersionObjectReferenceBuilder[R] {
+	b.get().APIVersion = Some(v)
+	return b
+}
+
+// ExternalMetricSourceBuilder fluently fills in a ExternalMetricSourceAssertion that is part of the
+// assertion struct R returned by Build.
+type ExternalMetricSourceBuilder[R any] struct {
+	root *R
+	get  func() *ExternalMetricSourceAssertion
+}
+
+// Build returns the root assertion struct.
+func (b ExternalMetricSourceBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying ExternalMetricSourceAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b ExternalMetricSourceBuilder[R]) With(fn func(*ExternalMetricSourceAssertion)) ExternalMetricSourceBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// Metric descends into the Metric field.
+func (b ExternalMetricSourceBuilder[R]) Metric() MetricIdentifierBuilder[R] {
+	get := b.get
+	return MetricIdentifierBuilder[R]{
+		get: func() *MetricIdentifierAssertion {
+			return &get().Metric
+		},
+		root: b.root,
+	}
+}
+
+// Target descends into the Target field.
+func (b ExternalMetricSourceBuilder[R]) Target() MetricTargetBuilder[R] {
+	get := b.get
+	return MetricTargetBuilder[R]{
+		get: func() *MetricTargetAssertion {
+			return &get().Target
+		},
+		root: b.root,
+	}
+}
+
+// HPAScalingPolicyBuilder fluently fills in a HPAScalingPolicyAssertion that is part of the
+// assertion struct R returned by Build.
+type HPAScalingPolicyBuilder[R any] struct {
+	root *R
+	get  func() *HPAScalingPolicyAssertion
+}
+
+// Build returns the root assertion struct.
+func (b HPAScalingPolicyBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying HPAScalingPolicyAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b HPAScalingPolicyBuilder[R]) With(fn func(*HPAScalingPolicyAssertion)) HPAScalingPolicyBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// Type sets the expected Type.
+func (b HPAScalingPolicyBuilder[R]) Type(v v2.HPAScalingPolicyType) HPAScalingPolicyBuilder[R] {
+	b.get().Type = Some(v)
+	return b
+}
+
+// Value sets the expected Value.
+func (b HPAScalingPolicyBuilder[R]) Value(v int32) HPAScalingPolicyBuilder[R] {
+	b.get().Value = Some(v)
+	return b
+}
+
+// PeriodSeconds sets the expected PeriodSeconds.
+func (b HPAScalingPolicyBuilder[R]) PeriodSeconds(v int32) HPAScalingPolicyBuilder[R] {
+	b.get().PeriodSeconds = Some(v)
+	return b
+}
+
+// HPAScalingRulesBuilder fluently fills in a HPAScalingRulesAssertion that is part of the
+// assertion struct R returned by Build.
+type HPAScalingRulesBuilder[R any] struct {
+	root *R
+	get  func() *HPAScalingRulesAssertion
+}
+
+// Build returns the root assertion struct.
+func (b HPAScalingRulesBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying HPAScalingRulesAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b HPAScalingRulesBuilder[R]) With(fn func(*HPAScalingRulesAssertion)) HPAScalingRulesBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// StabilizationWindowSeconds sets the expected StabilizationWindowSeconds.
+func (b HPAScalingRulesBuilder[R]) StabilizationWindowSeconds(v int32) HPAScalingRulesBuilder[R] {
+	b.get().StabilizationWindowSeconds = SomePtr(v)
+	return b
+}
+
+// SelectPolicy sets the expected SelectPolicy.
+func (b HPAScalingRulesBuilder[R]) SelectPolicy(v v2.ScalingPolicySelect) HPAScalingRulesBuilder[R] {
+	b.get().SelectPolicy = SomePtr(v)
+	return b
+}
+
+// Policies sets Policies to exactly the given elements, compared by position.
+func (b HPAScalingRulesBuilder[R]) Policies(v []HPAScalingPolicyAssertion) HPAScalingRulesBuilder[R] {
+	b.get().Policies = Some(v)
+	return b
+}
+
+// Tolerance descends into the Tolerance field.
+func (b HPAScalingRulesBuilder[R]) Tolerance() QuantityBuilder[R] {
+	get := b.get
+	return QuantityBuilder[R]{
+		get: func() *QuantityAssertion {
+			return &get().Tolerance
+		},
+		root: b.root,
+	}
+}
+
+// HorizontalPodAutoscalerBuilder fluently fills in a HorizontalPodAutoscalerAssertion that is part of the
+// assertion struct R returned by Build.
+type HorizontalPodAutoscalerBuilder[R any] struct {
+	root *R
+	get  func() *HorizontalPodAutoscalerAssertion
+}
+
+// Build returns the root assertion struct.
+func (b HorizontalPodAutoscalerBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying HorizontalPodAutoscalerAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b HorizontalPodAutoscalerBuilder[R]) With(fn func(*HorizontalPodAutoscalerAssertion)) HorizontalPodAutoscalerBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// TypeMeta descends into the TypeMeta field.
+func (b HorizontalPodAutoscalerBuilder[R]) TypeMeta() TypeMetaBuilder[R] {
+	get := b.get
+	return TypeMetaBuilder[R]{
+		get: func() *TypeMetaAssertion {
+			return &get().TypeMeta
+		},
+		root: b.root,
+	}
+}
+
+// ObjectMeta descends into the ObjectMeta field.
+func (b HorizontalPodAutoscalerBuilder[R]) ObjectMeta() ObjectMetaBuilder[R] {
+	get := b.get
+	return ObjectMetaBuilder[R]{
+		get: func() *ObjectMetaAssertion {
+			return &get().ObjectMeta
+		},
+		root: b.root,
+	}
+}
+
+// Spec descends into the Spec field.
+func (b HorizontalPodAutoscalerBuilder[R]) Spec() HorizontalPodAutoscalerSpecBuilder[R] {
+	get := b.get
+	return HorizontalPodAutoscalerSpecBuilder[R]{
+		get: func() *HorizontalPodAutoscalerSpecAssertion {
+			return &get().Spec
+		},
+		root: b.root,
+	}
+}
+
+// Status sets the expected Status.
+func (b HorizontalPodAutoscalerBuilder[R]) Status(v v2.HorizontalPodAutoscalerStatus) HorizontalPodAutoscalerBuilder[R] {
+	b.get().Status = Some(v)
+	return b
+}
+
+// HorizontalPodAutoscalerBehaviorBuilder fluently fills in a HorizontalPodAutoscalerBehaviorAssertion that is part of the
+// assertion struct R returned by Build.
+type HorizontalPodAutoscalerBehaviorBuilder[R any] struct {
+	root *R
+	get  func() *HorizontalPodAutoscalerBehaviorAssertion
+}
+
+// Build returns the root assertion struct.
+func (b HorizontalPodAutoscalerBehaviorBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying HorizontalPodAutoscalerBehaviorAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b HorizontalPodAutoscalerBehaviorBuilder[R]) With(fn func(*HorizontalPodAutoscalerBehaviorAssertion)) HorizontalPodAutoscalerBehaviorBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// ScaleUp descends into the ScaleUp field.
+func (b HorizontalPodAutoscalerBehaviorBuilder[R]) ScaleUp() HPAScalingRulesBuilder[R] {
+	get := b.get
+	return HPAScalingRulesBuilder[R]{
+		get: func() *HPAScalingRulesAssertion {
+			return &get().ScaleUp
+		},
+		root: b.root,
+	}
+}
+
+// ScaleDown descends into the ScaleDown field.
+func (b HorizontalPodAutoscalerBehaviorBuilder[R]) ScaleDown() HPAScalingRulesBuilder[R] {
+	get := b.get
+	return HPAScalingRulesBuilder[R]{
+		get: func() *HPAScalingRulesAssertion {
+			return &get().ScaleDown
+		},
+		root: b.root,
+	}
+}
+
+// HorizontalPodAutoscalerSpecBuilder fluently fills in a HorizontalPodAutoscalerSpecAssertion that is part of the
+// assertion struct R returned by Build.
+type HorizontalPodAutoscalerSpecBuilder[R any] struct {
+	root *R
+	get  func() *HorizontalPodAutoscalerSpecAssertion
+}
+
+// Build returns the root assertion struct.
+func (b HorizontalPodAutoscalerSpecBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying HorizontalPodAutoscalerSpecAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b HorizontalPodAutoscalerSpecBuilder[R]) With(fn func(*HorizontalPodAutoscalerSpecAssertion)) HorizontalPodAutoscalerSpecBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// ScaleTargetRef descends into the ScaleTargetRef field.
+func (b HorizontalPodAutoscalerSpecBuilder[R]) ScaleTargetRef() CrossVersionObjectReferenceBuilder[R] {
+	get := b.get
+	return CrossVersionObjectReferenceBuilder[R]{
+		get: func() *CrossVersionObjectReferenceAssertion {
+			return &get().ScaleTargetRef
+		},
+		root: b.root,
+	}
+}
+
+// MinReplicas sets the expected MinReplicas.
+func (b HorizontalPodAutoscalerSpecBuilder[R]) MinReplicas(v int32) HorizontalPodAutoscalerSpecBuilder[R] {
+	b.get().MinReplicas = SomePtr(v)
+	return b
+}
+
+// MaxReplicas sets the expected MaxReplicas.
+func (b HorizontalPodAutoscalerSpecBuilder[R]) MaxReplicas(v int32) HorizontalPodAutoscalerSpecBuilder[R] {
+	b.get().MaxReplicas = Some(v)
+	return b
+}
+
+// Metrics sets Metrics to exactly the given elements, compared by position.
+func (b HorizontalPodAutoscalerSpecBuilder[R]) Metrics(v []MetricSpecAssertion) HorizontalPodAutoscalerSpecBuilder[R] {
+	b.get().Metrics = Some(v)
+	return b
+}
+
+// Behavior descends into the Behavior field.
+func (b HorizontalPodAutoscalerSpecBuilder[R]) Behavior() HorizontalPodAutoscalerBehaviorBuilder[R] {
+	get := b.get
+	return HorizontalPodAutoscalerBehaviorBuilder[R]{
+		get: func() *HorizontalPodAutoscalerBehaviorAssertion {
+			return &get().Behavior
+		},
+		root: b.root,
+	}
+}
+
+// MetricIdentifierBuilder fluently fills in a MetricIdentifierAssertion that is part of the
+// assertion struct R returned by Build.
+type MetricIdentifierBuilder[R any] struct {
+	root *R
+	get  func() *MetricIdentifierAssertion
+}
+
+// Build returns the root assertion struct.
+func (b MetricIdentifierBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying MetricIdentifierAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b MetricIdentifierBuilder[R]) With(fn func(*MetricIdentifierAssertion)) MetricIdentifierBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// Name sets the expected Name.
+func (b MetricIdentifierBuilder[R]) Name(v string) MetricIdentifierBuilder[R] {
+	b.get().Name = Some(v)
+	return b
+}
+
+// Selector descends into the Selector field.
+func (b MetricIdentifierBuilder[R]) Selector() LabelSelectorBuilder[R] {
+	get := b.get
+	return LabelSelectorBuilder[R]{
+		get: func() *LabelSelectorAssertion {
+			return &get().Selector
+		},
+		root: b.root,
+	}
+}
+
+// MetricSpecBuilder fluently fills in a MetricSpecAssertion that is part of the
+// assertion struct R returned by Build.
+type MetricSpecBuilder[R any] struct {
+	root *R
+	get  func() *MetricSpecAssertion
+}
+
+// Build returns the root assertion struct.
+func (b MetricSpecBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying MetricSpecAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b MetricSpecBuilder[R]) With(fn func(*MetricSpecAssertion)) MetricSpecBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// Type sets the expected Type.
+func (b MetricSpecBuilder[R]) Type(v v2.MetricSourceType) MetricSpecBuilder[R] {
+	b.get().Type = Some(v)
+	return b
+}
+
+// Object descends into the Object field.
+func (b MetricSpecBuilder[R]) Object() ObjectMetricSourceBuilder[R] {
+	get := b.get
+	return ObjectMetricSourceBuilder[R]{
+		get: func() *ObjectMetricSourceAssertion {
+			return &get().Object
+		},
+		root: b.root,
+	}
+}
+
+// Pods descends into the Pods field.
+func (b MetricSpecBuilder[R]) Pods() PodsMetricSourceBuilder[R] {
+	get := b.get
+	return PodsMetricSourceBuilder[R]{
+		get: func() *PodsMetricSourceAssertion {
+			return &get().Pods
+		},
+		root: b.root,
+	}
+}
+
+// Resource descends into the Resource field.
+func (b MetricSpecBuilder[R]) Resource() ResourceMetricSourceBuilder[R] {
+	get := b.get
+	return ResourceMetricSourceBuilder[R]{
+		get: func() *ResourceMetricSourceAssertion {
+			return &get().Resource
+		},
+		root: b.root,
+	}
+}
+
+// ContainerResource descends into the ContainerResource field.
+func (b MetricSpecBuilder[R]) ContainerResource() ContainerResourceMetricSourceBuilder[R] {
+	get := b.get
+	return ContainerResourceMetricSourceBuilder[R]{
+		get: func() *ContainerResourceMetricSourceAssertion {
+			return &get().ContainerResource
+		},
+		root: b.root,
+	}
+}
+
+// External descends into the External field.
+func (b MetricSpecBuilder[R]) External() ExternalMetricSourceBuilder[R] {
+	get := b.get
+	return ExternalMetricSourceBuilder[R]{
+		get: func() *ExternalMetricSourceAssertion {
+			return &get().External
+		},
+		root: b.root,
+	}
+}
+
+// MetricTargetBuilder fluently fills in a MetricTargetAssertion that is part of the
+// assertion struct R returned by Build.
+type MetricTargetBuilder[R any] struct {
+	root *R
+	get  func() *MetricTargetAssertion
+}
+
+// Build returns the root assertion struct.
+func (b MetricTargetBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying MetricTargetAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b MetricTargetBuilder[R]) With(fn func(*MetricTargetAssertion)) MetricTargetBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// Type sets the expected Type.
+func (b MetricTargetBuilder[R]) Type(v v2.MetricTargetType) MetricTargetBuilder[R] {
+	b.get().Type = Some(v)
+	return b
+}
+
+// Value descends into the Value field.
+func (b MetricTargetBuilder[R]) Value() QuantityBuilder[R] {
+	get := b.get
+	return QuantityBuilder[R]{
+		get: func() *QuantityAssertion {
+			return &get().Value
+		},
+		root: b.root,
+	}
+}
+
+// AverageValue descends into the AverageValue field.
+func (b MetricTargetBuilder[R]) AverageValue() QuantityBuilder[R] {
+	get := b.get
+	return QuantityBuilder[R]{
+		get: func() *QuantityAssertion {
+			return &get().AverageValue
+		},
+		root: b.root,
+	}
+}
+
+// AverageUtilization sets the expected AverageUtilization.
+func (b MetricTargetBuilder[R]) AverageUtilization(v int32) MetricTargetBuilder[R] {
+	b.get().AverageUtilization = SomePtr(v)
+	return b
+}
+
+// ObjectMetricSourceBuilder fluently fills in a ObjectMetricSourceAssertion that is part of the
+// assertion struct R returned by Build.
+type ObjectMetricSourceBuilder[R any] struct {
+	root *R
+	get  func() *ObjectMetricSourceAssertion
+}
+
+// Build returns the root assertion struct.
+func (b ObjectMetricSourceBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying ObjectMetricSourceAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b ObjectMetricSourceBuilder[R]) With(fn func(*ObjectMetricSourceAssertion)) ObjectMetricSourceBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// DescribedObject descends into the DescribedObject field.
+func (b ObjectMetricSourceBuilder[R]) DescribedObject() CrossVersionObjectReferenceBuilder[R] {
+	get := b.get
+	return CrossVersionObjectReferenceBuilder[R]{
+		get: func() *CrossVersionObjectReferenceAssertion {
+			return &get().DescribedObject
+		},
+		root: b.root,
+	}
+}
+
+// Target descends into the Target field.
+func (b ObjectMetricSourceBuilder[R]) Target() MetricTargetBuilder[R] {
+	get := b.get
+	return MetricTargetBuilder[R]{
+		get: func() *MetricTargetAssertion {
+			return &get().Target
+		},
+		root: b.root,
+	}
+}
+
+// Metric descends into the Metric field.
+func (b ObjectMetricSourceBuilder[R]) Metric() MetricIdentifierBuilder[R] {
+	get := b.get
+	return MetricIdentifierBuilder[R]{
+		get: func() *MetricIdentifierAssertion {
+			return &get().Metric
+		},
+		root: b.root,
+	}
+}
+
+// PodsMetricSourceBuilder fluently fills in a PodsMetricSourceAssertion that is part of the
+// assertion struct R returned by Build.
+type PodsMetricSourceBuilder[R any] struct {
+	root *R
+	get  func() *PodsMetricSourceAssertion
+}
+
+// Build returns the root assertion struct.
+func (b PodsMetricSourceBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying PodsMetricSourceAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b PodsMetricSourceBuilder[R]) With(fn func(*PodsMetricSourceAssertion)) PodsMetricSourceBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// Metric descends into the Metric field.
+func (b PodsMetricSourceBuilder[R]) Metric() MetricIdentifierBuilder[R] {
+	get := b.get
+	return MetricIdentifierBuilder[R]{
+		get: func() *MetricIdentifierAssertion {
+			return &get().Metric
+		},
+		root: b.root,
+	}
+}
+
+// Target descends into the Target field.
+func (b PodsMetricSourceBuilder[R]) Target() MetricTargetBuilder[R] {
+	get := b.get
+	return MetricTargetBuilder[R]{
+		get: func() *MetricTargetAssertion {
+			return &get().Target
+		},
+		root: b.root,
+	}
+}
+
+// ResourceMetricSourceBuilder fluently fills in a ResourceMetricSourceAssertion that is part of the
+// assertion struct R returned by Build.
+type ResourceMetricSourceBuilder[R any] struct {
+	root *R
+	get  func() *ResourceMetricSourceAssertion
+}
+
+// Build returns the root assertion struct.
+func (b ResourceMetricSourceBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying ResourceMetricSourceAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b ResourceMetricSourceBuilder[R]) With(fn func(*ResourceMetricSourceAssertion)) ResourceMetricSourceBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// Name sets the expected Name.
+func (b ResourceMetricSourceBuilder[R]) Name(v v11.ResourceName) ResourceMetricSourceBuilder[R] {
+	b.get().Name = Some(v)
+	return b
+}
+
+// Target descends into the Target field.
+func (b ResourceMetricSourceBuilder[R]) Target() MetricTargetBuilder[R] {
+	get := b.get
+	return MetricTargetBuilder[R]{
+		get: func() *MetricTargetAssertion {
+			return &get().Target
+		},
+		root: b.root,
+	}
+}
+
+// JobBuilder fluently fills in a JobAssertion that is part of the
+// assertion struct R returned by Build.
+type JobBuilder[R any] struct {
+	root *R
+	get  func() *JobAssertion
+}
+
+// Build returns the root assertion struct.
+func (b JobBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying JobAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b JobBuilder[R]) With(fn func(*JobAssertion)) JobBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// TypeMeta descends into the TypeMeta field.
+func (b JobBuilder[R]) TypeMeta() TypeMetaBuilder[R] {
+	get := b.get
+	return TypeMetaBuilder[R]{
+		get: func() *TypeMetaAssertion {
+			return &get().TypeMeta
+		},
+		root: b.root,
+	}
+}
+
+// ObjectMeta descends into the ObjectMeta field.
+func (b JobBuilder[R]) ObjectMeta() ObjectMetaBuilder[R] {
+	get := b.get
+	return ObjectMetaBuilder[R]{
+		get: func() *ObjectMetaAssertion {
+			return &get().ObjectMeta
+		},
+		root: b.root,
+	}
+}
+
+// Spec descends into the Spec field.
+func (b JobBuilder[R]) Spec() JobSpecBuilder[R] {
+	get := b.get
+	return JobSpecBuilder[R]{
+		get: func() *JobSpecAssertion {
+			return &get().Spec
+		},
+		root: b.root,
+	}
+}
+
+// Status sets the expected Status.
+func (b JobBuilder[R]) Status(v v13.JobStatus) JobBuilder[R] {
+	b.get().Status = Some(v)
+	return b
+}
+
+// JobSpecBuilder fluently fills in a JobSpecAssertion that is part of the
+// assertion struct R returned by Build.
+type JobSpecBuilder[R any] struct {
+	root *R
+	get  func() *JobSpecAssertion
+}
+
+// Build returns the root assertion struct.
+func (b JobSpecBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying JobSpecAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b JobSpecBuilder[R]) With(fn func(*JobSpecAssertion)) JobSpecBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// Parallelism sets the expected Parallelism.
+func (b JobSpecBuilder[R]) Parallelism(v int32) JobSpecBuilder[R] {
+	b.get().Parallelism = SomePtr(v)
+	return b
+}
+
+// Completions sets the expected Completions.
+func (b JobSpecBuilder[R]) Completions(v int32) JobSpecBuilder[R] {
+	b.get().Completions = SomePtr(v)
+	return b
+}
+
+// ActiveDeadlineSeconds sets the expected ActiveDeadlineSeconds.
+func (b JobSpecBuilder[R]) ActiveDeadlineSeconds(v int64) JobSpecBuilder[R] {
+	b.get().ActiveDeadlineSeconds = SomePtr(v)
+	return b
+}
+
+// PodFailurePolicy descends into the PodFailurePolicy field.
+func (b JobSpecBuilder[R]) PodFailurePolicy() PodFailurePolicyBuilder[R] {
+	get := b.get
+	return PodFailurePolicyBuilder[R]{
+		get: func() *PodFailurePolicyAssertion {
+			return &get().PodFailurePolicy
+		},
+		root: b.root,
+	}
+}
+
+// SuccessPolicy descends into the SuccessPolicy field.
+func (b JobSpecBuilder[R]) SuccessPolicy() SuccessPolicyBuilder[R] {
+	get := b.get
+	return SuccessPolicyBuilder[R]{
+		get: func() *SuccessPolicyAssertion {
+			return &get().SuccessPolicy
+		},
+		root: b.root,
+	}
+}
+
+// BackoffLimit sets the expected BackoffLimit.
+func (b JobSpecBuilder[R]) BackoffLimit(v int32) JobSpecBuilder[R] {
+	b.get().BackoffLimit = SomePtr(v)
+	return b
+}
+
+// BackoffLimitPerIndex sets the expected BackoffLimitPerIndex.
+func (b JobSpecBuilder[R]) BackoffLimitPerIndex(v int32) JobSpecBuilder[R] {
+	b.get().BackoffLimitPerIndex = SomePtr(v)
+	return b
+}
+
+// MaxFailedIndexes sets the expected MaxFailedIndexes.
+func (b JobSpecBuilder[R]) MaxFailedIndexes(v int32) JobSpecBuilder[R] {
+	b.get().MaxFailedIndexes = SomePtr(v)
+	return b
+}
+
+// Selector descends into the Selector field.
+func (b JobSpecBuilder[R]) Selector() LabelSelectorBuilder[R] {
+	get := b.get
+	return LabelSelectorBuilder[R]{
+		get: func() *LabelSelectorAssertion {
+			return &get().Selector
+		},
+		root: b.root,
+	}
+}
+
+// ManualSelector sets the expected ManualSelector.
+func (b JobSpecBuilder[R]) ManualSelector(v bool) JobSpecBuilder[R] {
+	b.get().ManualSelector = SomePtr(v)
+	return b
+}
+
+// Template descends into the Template field.
+func (b JobSpecBuilder[R]) Template() PodTemplateSpecBuilder[R] {
+	get := b.get
+	return PodTemplateSpecBuilder[R]{
+		get: func() *PodTemplateSpecAssertion {
+			return &get().Template
+		},
+		root: b.root,
+	}
+}
+
+// TTLSecondsAfterFinished sets the expected TTLSecondsAfterFinished.
+func (b JobSpecBuilder[R]) TTLSecondsAfterFinished(v int32) JobSpecBuilder[R] {
+	b.get().TTLSecondsAfterFinished = SomePtr(v)
+	return b
+}
+
+// CompletionMode sets the expected CompletionMode.
+func (b JobSpecBuilder[R]) CompletionMode(v v13.CompletionMode) JobSpecBuilder[R] {
+	b.get().CompletionMode = SomePtr(v)
+	return b
+}
+
+// Suspend sets the expected Suspend.
+func (b JobSpecBuilder[R]) Suspend(v bool) JobSpecBuilder[R] {
+	b.get().Suspend = SomePtr(v)
+	return b
+}
+
+// PodReplacementPolicy sets the expected PodReplacementPolicy.
+func (b JobSpecBuilder[R]) PodReplacementPolicy(v v13.PodReplacementPolicy) JobSpecBuilder[R] {
+	b.get().PodReplacementPolicy = SomePtr(v)
+	return b
+}
+
+// ManagedBy sets the expected ManagedBy.
+func (b JobSpecBuilder[R]) ManagedBy(v string) JobSpecBuilder[R] {
+	b.get().ManagedBy = SomePtr(v)
+	return b
+}
+
+// PodFailurePolicyBuilder fluently fills in a PodFailurePolicyAssertion that is part of the
+// assertion struct R returned by Build.
+type PodFailurePolicyBuilder[R any] struct {
+	root *R
+	get  func() *PodFailurePolicyAssertion
+}
+
+// Build returns the root assertion struct.
+func (b PodFailurePolicyBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying PodFailurePolicyAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b PodFailurePolicyBuilder[R]) With(fn func(*PodFailurePolicyAssertion)) PodFailurePolicyBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// Rules sets Rules to exactly the given elements, compared by position.
+func (b PodFailurePolicyBuilder[R]) Rules(v []PodFailurePolicyRuleAssertion) PodFailurePolicyBuilder[R] {
+	b.get().Rules = Some(v)
+	return b
+}
+
+// PodFailurePolicyOnExitCodesRequirementBuilder fluently fills in a PodFailurePolicyOnExitCodesRequirementAssertion that is part of the
+// assertion struct R returned by Build.
+type PodFailurePolicyOnExitCodesRequirementBuilder[R any] struct {
+	root *R
+	get  func() *PodFailurePolicyOnExitCodesRequirementAssertion
+}
+
+// Build returns the root assertion struct.
+func (b PodFailurePolicyOnExitCodesRequirementBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying PodFailurePolicyOnExitCodesRequirementAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b PodFailurePolicyOnExitCodesRequirementBuilder[R]) With(fn func(*PodFailurePolicyOnExitCodesRequirementAssertion)) PodFailurePolicyOnExitCodesRequirementBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// ContainerName sets the expected ContainerName.
+func (b PodFailurePolicyOnExitCodesRequirementBuilder[R]) ContainerName(v string) PodFailurePolicyOnExitCodesRequirementBuilder[R] {
+	b.get().ContainerName = SomePtr(v)
+	return b
+}
+
+// Operator sets the expected Operator.
+func (b PodFailurePolicyOnExitCodesRequirementBuilder[R]) Operator(v v13.PodFailurePolicyOnExitCodesOperator) PodFailurePolicyOnExitCodesRequirementBuilder[R] {
+	b.get().Operator = Some(v)
+	return b
+}
+
+// Values sets the expected Values.
+func (b PodFailurePolicyOnExitCodesRequirementBuilder[R]) Values(v []int32) PodFailurePolicyOnExitCodesRequirementBuilder[R] {
+	b.get().Values = Some(v)
+	return b
+}
+
+// PodFailurePolicyOnPodConditionsPatternBuilder fluently fills in a PodFailurePolicyOnPodConditionsPatternAssertion that is part of the
+// assertion struct R returned by Build.
+type PodFailurePolicyOnPodConditionsPatternBuilder[R any] struct {
+	root *R
+	get  func() *PodFailurePolicyOnPodConditionsPatternAssertion
+}
+
+// Build returns the root assertion struct.
+func (b PodFailurePolicyOnPodConditionsPatternBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying PodFailurePolicyOnPodConditionsPatternAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b PodFailurePolicyOnPodConditionsPatternBuilder[R]) With(fn func(*PodFailurePolicyOnPodConditionsPatternAssertion)) PodFailurePolicyOnPodConditionsPatternBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// Type sets the expected Type.
+func (b PodFailurePolicyOnPodConditionsPatternBuilder[R]) Type(v v11.PodConditionType) PodFailurePolicyOnPodConditionsPatternBuilder[R] {
+	b.get().Type = Some(v)
+	return b
+}
+
+// Status sets the expected Status.
+func (b PodFailurePolicyOnPodConditionsPatternBuilder[R]) Status(v v11.ConditionStatus) PodFailurePolicyOnPodConditionsPatternBuilder[R] {
+	b.get().Status = Some(v)
+	return b
+}
+
+// PodFailurePolicyRuleBuilder fluently fills in a PodFailurePolicyRuleAssertion that is part of the
+// assertion struct R returned by Build.
+type PodFailurePolicyRuleBuilder[R any] struct {
+	root *R
+	get  func() *PodFailurePolicyRuleAssertion
+}
+
+// Build returns the root assertion struct.
+func (b PodFailurePolicyRuleBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying PodFailurePolicyRuleAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b PodFailurePolicyRuleBuilder[R]) With(fn func(*PodFailurePolicyRuleAssertion)) PodFailurePolicyRuleBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// Action sets the expected Action.
+func (b PodFailurePolicyRuleBuilder[R]) Action(v v13.PodFailurePolicyAction) PodFailurePolicyRuleBuilder[R] {
+	b.get().Action = Some(v)
+	return b
+}
+
+// OnExitCodes descends into the OnExitCodes field.
+func (b PodFailurePolicyRuleBuilder[R]) OnExitCodes() PodFailurePolicyOnExitCodesRequirementBuilder[R] {
+	get := b.get
+	return PodFailurePolicyOnExitCodesRequirementBuilder[R]{
+		get: func() *PodFailurePolicyOnExitCodesRequirementAssertion {
+			return &get().OnExitCodes
+		},
+		root: b.root,
+	}
+}
+
+// OnPodConditions sets OnPodConditions to exactly the given elements, compared by position.
+func (b PodFailurePolicyRuleBuilder[R]) OnPodConditions(v []PodFailurePolicyOnPodConditionsPatternAssertion) PodFailurePolicyRuleBuilder[R] {
+	b.get().OnPodConditions = Some(v)
+	return b
+}
+
+// SuccessPolicyBuilder fluently fills in a SuccessPolicyAssertion that is part of the
+// assertion struct R returned by Build.
+type SuccessPolicyBuilder[R any] struct {
+	root *R
+	get  func() *SuccessPolicyAssertion
+}
+
+// Build returns the root assertion struct.
+func (b SuccessPolicyBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying SuccessPolicyAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b SuccessPolicyBuilder[R]) With(fn func(*SuccessPolicyAssertion)) SuccessPolicyBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// Rules sets Rules to exactly the given elements, compared by position.
+func (b SuccessPolicyBuilder[R]) Rules(v []SuccessPolicyRuleAssertion) SuccessPolicyBuilder[R] {
+	b.get().Rules = Some(v)
+	return b
+}
+
+// SuccessPolicyRuleBuilder fluently fills in a SuccessPolicyRuleAssertion that is part of the
+// assertion struct R returned by Build.
+type SuccessPolicyRuleBuilder[R any] struct {
+	root *R
+	get  func() *SuccessPolicyRuleAssertion
+}
+
+// Build returns the root assertion struct.
+func (b SuccessPolicyRuleBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying SuccessPolicyRuleAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b SuccessPolicyRuleBuilder[R]) With(fn func(*SuccessPolicyRuleAssertion)) SuccessPolicyRuleBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// SucceededIndexes sets the expected SucceededIndexes.
+func (b SuccessPolicyRuleBuilder[R]) SucceededIndexes(v string) SuccessPolicyRuleBuilder[R] {
+	b.get().SucceededIndexes = SomePtr(v)
+	return b
+}
+
+// SucceededCount sets the expected SucceededCount.
+func (b SuccessPolicyRuleBuilder[R]) SucceededCount(v int32) SuccessPolicyRuleBuilder[R] {
+	b.get().SucceededCount = SomePtr(v)
+	return b
+}
+
+// AWSElasticBlockStoreVolumeSourceBuilder fluently fills in a AWSElasticBlockStoreVolumeSourceAssertion that is part of the
+// assertion struct R returned by Build.
+type AWSElasticBlockStoreVolumeSourceBuilder[R any] struct {
+	root *R
+	get  func() *AWSElasticBlockStoreVolumeSourceAssertion
+}
+
+// Build returns the root assertion struct.
+func (b AWSElasticBlockStoreVolumeSourceBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying AWSElasticBlockStoreVolumeSourceAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b AWSElasticBlockStoreVolumeSourceBuilder[R]) With(fn func(*AWSElasticBlockStoreVolumeSourceAssertion)) AWSElasticBlockStoreVolumeSourceBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// VolumeID sets the expected VolumeID.
+func (b AWSElasticBlockStoreVolumeSourceBuilder[R]) VolumeID(v string) AWSElasticBlockStoreVolumeSourceBuilder[R] {
+	b.get().VolumeID = Some(v)
+	return b
+}
+
+// FSType sets the expected FSType.
+func (b AWSElasticBlockStoreVolumeSourceBuilder[R]) FSType(v string) AWSElasticBlockStoreVolumeSourceBuilder[R] {
+	b.get().FSType = Some(v)
+	return b
+}
+
+// Partition sets the expected Partition.
+func (b AWSElasticBlockStoreVolumeSourceBuilder[R]) Partition(v int32) AWSElasticBlockStoreVolumeSourceBuilder[R] {
+	b.get().Partition = Some(v)
+	return b
+}
+
+// ReadOnly sets the expected ReadOnly.
+func (b AWSElasticBlockStoreVolumeSourceBuilder[R]) ReadOnly(v bool) AWSElasticBlockStoreVolumeSourceBuilder[R] {
+	b.get().ReadOnly = Some(v)
+	return b
+}
+
+// AffinityBuilder fluently fills in a AffinityAssertion that is part of the
+// assertion struct R returned by Build.
+type AffinityBuilder[R any] struct {
+	root *R
+	get  func() *AffinityAssertion
+}
+
+// Build returns the root assertion struct.
+func (b AffinityBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying AffinityAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b AffinityBuilder[R]) With(fn func(*AffinityAssertion)) AffinityBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// NodeAffinity descends into the NodeAffinity field.
+func (b AffinityBuilder[R]) NodeAffinity() NodeAffinityBuilder[R] {
+	get := b.get
+	return NodeAffinityBuilder[R]{
+		get: func() *NodeAffinityAssertion {
+			return &get().NodeAffinity
+		},
+		root: b.root,
+	}
+}
+
+// PodAffinity descends into the PodAffinity field.
+func (b AffinityBuilder[R]) PodAffinity() PodAffinityBuilder[R] {
+	get := b.get
+	return PodAffinityBuilder[R]{
+		get: func() *PodAffinityAssertion {
+			return &get().PodAffinity
+		},
+		root: b.root,
+	}
+}
+
+// PodAntiAffinity descends into the PodAntiAffinity field.
+func (b AffinityBuilder[R]) PodAntiAffinity() PodAntiAffinityBuilder[R] {
+	get := b.get
+	return PodAntiAffinityBuilder[R]{
+		get: func() *PodAntiAffinityAssertion {
+			return &get().PodAntiAffinity
+		},
+		root: b.root,
+	}
+}
+
+// AppArmorProfileBuilder fluently fills in a AppArmorProfileAssertion that is part of the
+// assertion struct R returned by Build.
+type AppArmorProfileBuilder[R any] struct {
+	root *R
+	get  func() *AppArmorProfileAssertion
+}
+
+// Build returns the root assertion struct.
+func (b AppArmorProfileBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying AppArmorProfileAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b AppArmorProfileBuilder[R]) With(fn func(*AppArmorProfileAssertion)) AppArmorProfileBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// Type sets the expected Type.
+func (b AppArmorProfileBuilder[R]) Type(v v11.AppArmorProfileType) AppArmorProfileBuilder[R] {
+	b.get().Type = Some(v)
+	return b
+}
+
+// LocalhostProfile sets the expected LocalhostProfile.
+func (b AppArmorProfileBuilder[R]) LocalhostProfile(v string) AppArmorProfileBuilder[R] {
+	b.get().LocalhostProfile = SomePtr(v)
+	return b
+}
+
+// AzureDiskVolumeSourceBuilder fluently fills in a AzureDiskVolumeSourceAssertion that is part of the
+// assertion struct R returned by Build.
+type AzureDiskVolumeSourceBuilder[R any] struct {
+	root *R
+	get  func() *AzureDiskVolumeSourceAssertion
+}
+
+// Build returns the root assertion struct.
+func (b AzureDiskVolumeSourceBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying AzureDiskVolumeSourceAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b AzureDiskVolumeSourceBuilder[R]) With(fn func(*AzureDiskVolumeSourceAssertion)) AzureDiskVolumeSourceBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// DiskName sets the expected DiskName.
+func (b AzureDiskVolumeSourceBuilder[R]) DiskName(v string) AzureDiskVolumeSourceBuilder[R] {
+	b.get().DiskName = Some(v)
+	return b
+}
+
+// DataDiskURI sets the expected DataDiskURI.
+func (b AzureDiskVolumeSourceBuilder[R]) DataDiskURI(v string) AzureDiskVolumeSourceBuilder[R] {
+	b.get().DataDiskURI = Some(v)
+	return b
+}
+
+// CachingMode sets the expected CachingMode.
+func (b AzureDiskVolumeSourceBuilder[R]) CachingMode(v v11.AzureDataDiskCachingMode) AzureDiskVolumeSourceBuilder[R] {
+	b.get().CachingMode = SomePtr(v)
+	return b
+}
+
+// FSType sets the expected FSType.
+func (b AzureDiskVolumeSourceBuilder[R]) FSType(v string) AzureDiskVolumeSourceBuilder[R] {
+	b.get().FSType = SomePtr(v)
+	return b
+}
+
+// ReadOnly sets the expected ReadOnly.
+func (b AzureDiskVolumeSourceBuilder[R]) ReadOnly(v bool) AzureDiskVolumeSourceBuilder[R] {
+	b.get().ReadOnly = SomePtr(v)
+	return b
+}
+
+// Kind sets the expected Kind.
+func (b AzureDiskVolumeSourceBuilder[R]) Kind(v v11.AzureDataDiskKind) AzureDiskVolumeSourceBuilder[R] {
+	b.get().Kind = SomePtr(v)
+	return b
+}
+
+// AzureFileVolumeSourceBuilder fluently fills in a AzureFileVolumeSourceAssertion that is part of the
+// assertion struct R returned by Build.
+type AzureFileVolumeSourceBuilder[R any] struct {
+	root *R
+	get  func() *AzureFileVolumeSourceAssertion
+}
+
+// Build returns the root assertion struct.
+func (b AzureFileVolumeSourceBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying AzureFileVolumeSourceAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b AzureFileVolumeSourceBuilder[R]) With(fn func(*AzureFileVolumeSourceAssertion)) AzureFileVolumeSourceBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// SecretName sets the expected SecretName.
+func (b AzureFileVolumeSourceBuilder[R]) SecretName(v string) AzureFileVolumeSourceBuilder[R] {
+	b.get().SecretName = Some(v)
+	return b
+}
+
+// ShareName sets the expected ShareName.
+func (b AzureFileVolumeSourceBuilder[R]) ShareName(v string) AzureFileVolumeSourceBuilder[R] {
+	b.get().ShareName = Some(v)
+	return b
+}
+
+// ReadOnly sets the expected ReadOnly.
+func (b AzureFileVolumeSourceBuilder[R]) ReadOnly(v bool) AzureFileVolumeSourceBuilder[R] {
+	b.get().ReadOnly = Some(v)
+	return b
+}
+
+// CSIVolumeSourceBuilder fluently fills in a CSIVolumeSourceAssertion that is part of the
+// assertion struct R returned by Build.
+type CSIVolumeSourceBuilder[R any] struct {
+	root *R
+	get  func() *CSIVolumeSourceAssertion
+}
+
+// Build returns the root assertion struct.
+func (b CSIVolumeSourceBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying CSIVolumeSourceAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b CSIVolumeSourceBuilder[R]) With(fn func(*CSIVolumeSourceAssertion)) CSIVolumeSourceBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// Driver sets the expected Driver.
+func (b CSIVolumeSourceBuilder[R]) Driver(v string) CSIVolumeSourceBuilder[R] {
+	b.get().Driver = Some(v)
+	return b
+}
+
+// ReadOnly sets the expected ReadOnly.
+func (b CSIVolumeSourceBuilder[R]) ReadOnly(v bool) CSIVolumeSourceBuilder[R] {
+	b.get().ReadOnly = SomePtr(v)
+	return b
+}
+
+// FSType sets the expected FSType.
+func (b CSIVolumeSourceBuilder[R]) FSType(v string) CSIVolumeSourceBuilder[R] {
+	b.get().FSType = SomePtr(v)
+	return b
+}
+
+// VolumeAttributes sets the expected VolumeAttributes.
+func (b CSIVolumeSourceBuilder[R]) VolumeAttributes(v map[string]string) CSIVolumeSourceBuilder[R] {
+	b.get().VolumeAttributes = Some(v)
+	return b
+}
+
+// NodePublishSecretRef descends into the NodePublishSecretRef field.
+func (b CSIVolumeSourceBuilder[R]) NodePublishSecretRef() CoreLocalObjectReferenceBuilder[R] {
+	get := b.get
+	return CoreLocalObjectReferenceBuilder[R]{
+		get: func() *CoreLocalObjectReferenceAssertion {
+			return &get().NodePublishSecretRef
+		},
+		root: b.root,
+	}
+}
+
+// CapabilitiesBuilder fluently fills in a CapabilitiesAssertion that is part of the
+// assertion struct R returned by Build.
+type CapabilitiesBuilder[R any] struct {
+	root *R
+	get  func() *CapabilitiesAssertion
+}
+
+// Build returns the root assertion struct.
+func (b CapabilitiesBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying CapabilitiesAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b CapabilitiesBuilder[R]) With(fn func(*CapabilitiesAssertion)) CapabilitiesBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// Add sets the expected Add.
+func (b CapabilitiesBuilder[R]) Add(v []v11.Capability) CapabilitiesBuilder[R] {
+	b.get().Add = Some(v)
+	return b
+}
+
+// Drop sets the expected Drop.
+func (b CapabilitiesBuilder[R]) Drop(v []v11.Capability) CapabilitiesBuilder[R] {
+	b.get().Drop = Some(v)
+	return b
+}
+
+// CephFSVolumeSourceBuilder fluently fills in a CephFSVolumeSourceAssertion that is part of the
+// assertion struct R returned by Build.
+type CephFSVolumeSourceBuilder[R any] struct {
+	root *R
+	get  func() *CephFSVolumeSourceAssertion
+}
+
+// Build returns the root assertion struct.
+func (b CephFSVolumeSourceBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying CephFSVolumeSourceAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b CephFSVolumeSourceBuilder[R]) With(fn func(*CephFSVolumeSourceAssertion)) CephFSVolumeSourceBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// Monitors sets the expected Monitors.
+func (b CephFSVolumeSourceBuilder[R]) Monitors(v []string) CephFSVolumeSourceBuilder[R] {
+	b.get().Monitors = Some(v)
+	return b
+}
+
+// Path sets the expected Path.
+func (b CephFSVolumeSourceBuilder[R]) Path(v string) CephFSVolumeSourceBuilder[R] {
+	b.get().Path = Some(v)
+	return b
+}
+
+// User sets the expected User.
+func (b CephFSVolumeSourceBuilder[R]) User(v string) CephFSVolumeSourceBuilder[R] {
+	b.get().User = Some(v)
+	return b
+}
+
+// SecretFile sets the expected SecretFile.
+func (b CephFSVolumeSourceBuilder[R]) SecretFile(v string) CephFSVolumeSourceBuilder[R] {
+	b.get().SecretFile = Some(v)
+	return b
+}
+
+// SecretRef descends into the SecretRef field.
+func (b CephFSVolumeSourceBuilder[R]) SecretRef() CoreLocalObjectReferenceBuilder[R] {
+	get := b.get
+	return CoreLocalObjectReferenceBuilder[R]{
+		get: func() *CoreLocalObjectReferenceAssertion {
+			return &get().SecretRef
+		},
+		root: b.root,
+	}
+}
+
+// ReadOnly sets the expected ReadOnly.
+func (b CephFSVolumeSourceBuilder[R]) ReadOnly(v bool) CephFSVolumeSourceBuilder[R] {
+	b.get().ReadOnly = Some(v)
+	return b
+}
+
+// CinderVolumeSourceBuilder fluently fills in a CinderVolumeSourceAssertion that is part of the
+// assertion struct R returned by Build.
+type CinderVolumeSourceBuilder[R any] struct {
+	root *R
+	get  func() *CinderVolumeSourceAssertion
+}
+
+// Build returns the root assertion struct.
+func (b CinderVolumeSourceBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying CinderVolumeSourceAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b CinderVolumeSourceBuilder[R]) With(fn func(*CinderVolumeSourceAssertion)) CinderVolumeSourceBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// VolumeID sets the expected VolumeID.
+func (b CinderVolumeSourceBuilder[R]) VolumeID(v string) CinderVolumeSourceBuilder[R] {
+	b.get().VolumeID = Some(v)
+	return b
+}
+
+// FSType sets the expected FSType.
+func (b CinderVolumeSourceBuilder[R]) FSType(v string) CinderVolumeSourceBuilder[R] {
+	b.get().FSType = Some(v)
+	return b
+}
+
+// ReadOnly sets the expected ReadOnly.
+func (b CinderVolumeSourceBuilder[R]) ReadOnly(v bool) CinderVolumeSourceBuilder[R] {
+	b.get().ReadOnly = Some(v)
+	return b
+}
+
+// SecretRef descends into the SecretRef field.
+func (b CinderVolumeSourceBuilder[R]) SecretRef() CoreLocalObjectReferenceBuilder[R] {
+	get := b.get
+	return CoreLocalObjectReferenceBuilder[R]{
+		get: func() *CoreLocalObjectReferenceAssertion {
+			return &get().SecretRef
+		},
+		root: b.root,
+	}
+}
+
+// ClientIPConfigBuilder fluently fills in a ClientIPConfigAssertion that is part of the
+// assertion struct R returned by Build.
+type ClientIPConfigBuilder[R any] struct {
+	root *R
+	get  func() *ClientIPConfigAssertion
+}
+
+// Build returns the root assertion struct.
+func (b ClientIPConfigBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying ClientIPConfigAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b ClientIPConfigBuilder[R]) With(fn func(*ClientIPConfigAssertion)) ClientIPConfigBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// TimeoutSeconds sets the expected TimeoutSeconds.
+func (b ClientIPConfigBuilder[R]) TimeoutSeconds(v int32) ClientIPConfigBuilder[R] {
+	b.get().TimeoutSeconds = SomePtr(v)
+	return b
+}
+
+// ClusterTrustBundleProjectionBuilder fluently fills in a ClusterTrustBundleProjectionAssertion that is part of the
+// assertion struct R returned by Build.
+type ClusterTrustBundleProjectionBuilder[R any] struct {
+	root *R
+	get  func() *ClusterTrustBundleProjectionAssertion
+}
+
+// Build returns the root assertion struct.
+func (b ClusterTrustBundleProjectionBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying ClusterTrustBundleProjectionAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b ClusterTrustBundleProjectionBuilder[R]) With(fn func(*ClusterTrustBundleProjectionAssertion)) ClusterTrustBundleProjectionBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// Name sets the expected Name.
+func (b ClusterTrustBundleProjectionBuilder[R]) Name(v string) ClusterTrustBundleProjectionBuilder[R] {
+	b.get().Name = SomePtr(v)
+	return b
+}
+
+// SignerName sets the expected SignerName.
+func (b ClusterTrustBundleProjectionBuilder[R]) SignerName(v string) ClusterTrustBundleProjectionBuilder[R] {
+	b.get().SignerName = SomePtr(v)
+	return b
+}
+
+// LabelSelector descends into the LabelSelector field.
+func (b ClusterTrustBundleProjectionBuilder[R]) LabelSelector() LabelSelectorBuilder[R] {
+	get := b.get
+	return LabelSelectorBuilder[R]{
+		get: func() *LabelSelectorAssertion {
+			return &get().LabelSelector
+		},
+		root: b.root,
+	}
+}
+
+// Optional sets the expected Optional.
+func (b ClusterTrustBundleProjectionBuilder[R]) Optional(v bool) ClusterTrustBundleProjectionBuilder[R] {
+	b.get().Optional = SomePtr(v)
+	return b
+}
+
+// Path sets the expected Path.
+func (b ClusterTrustBundleProjectionBuilder[R]) Path(v string) ClusterTrustBundleProjectionBuilder[R] {
+	b.get().Path = Some(v)
+	return b
+}
+
+// ConfigMapBuilder fluently fills in a ConfigMapAssertion that is part of the
+// assertion struct R returned by Build.
+type ConfigMapBuilder[R any] struct {
+	root *R
+	get  func() *ConfigMapAssertion
+}
+
+// Build returns the root assertion struct.
+func (b ConfigMapBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying ConfigMapAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b ConfigMapBuilder[R]) With(fn func(*ConfigMapAssertion)) ConfigMapBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// TypeMeta descends into the TypeMeta field.
+func (b ConfigMapBuilder[R]) TypeMeta() TypeMetaBuilder[R] {
+	get := b.get
+	return TypeMetaBuilder[R]{
+		get: func() *TypeMetaAssertion {
+			return &get().TypeMeta
+		},
+		root: b.root,
+	}
+}
+
+// ObjectMeta descends into the ObjectMeta field.
+func (b ConfigMapBuilder[R]) ObjectMeta() ObjectMetaBuilder[R] {
+	get := b.get
+	return ObjectMetaBuilder[R]{
+		get: func() *ObjectMetaAssertion {
+			return &get().ObjectMeta
+		},
+		root: b.root,
+	}
+}
+
+// Immutable sets the expected Immutable.
+func (b ConfigMapBuilder[R]) Immutable(v bool) ConfigMapBuilder[R] {
+	b.get().Immutable = SomePtr(v)
+	return b
+}
+
+// Data sets the expected Data.
+func (b ConfigMapBuilder[R]) Data(v map[string]string) ConfigMapBuilder[R] {
+	b.get().Data = Some(v)
+	return b
+}
+
+// BinaryData sets the expected BinaryData.
+func (b ConfigMapBuilder[R]) BinaryData(v map[string][]byte) ConfigMapBuilder[R] {
+	b.get().BinaryData = Some(v)
+	return b
+}
+
+// ConfigMapEnvSourceBuilder fluently fills in a ConfigMapEnvSourceAssertion that is part of the
+// assertion struct R returned by Build.
+type ConfigMapEnvSourceBuilder[R any] struct {
+	root *R
+	get  func() *ConfigMapEnvSourceAssertion
+}
+
+// Build returns the root assertion struct.
+func (b ConfigMapEnvSourceBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying ConfigMapEnvSourceAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b ConfigMapEnvSourceBuilder[R]) With(fn func(*ConfigMapEnvSourceAssertion)) ConfigMapEnvSourceBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// LocalObjectReference descends into the LocalObjectReference field.
+func (b ConfigMapEnvSourceBuilder[R]) LocalObjectReference() CoreLocalObjectReferenceBuilder[R] {
+	get := b.get
+	return CoreLocalObjectReferenceBuilder[R]{
+		get: func() *CoreLocalObjectReferenceAssertion {
+			return &get().LocalObjectReference
+		},
+		root: b.root,
+	}
+}
+
+// Optional sets the expected Optional.
+func (b ConfigMapEnvSourceBuilder[R]) Optional(v bool) ConfigMapEnvSourceBuilder[R] {
+	b.get().Optional = SomePtr(v)
+	return b
+}
+
+// ConfigMapKeySelectorBuilder fluently fills in a ConfigMapKeySelectorAssertion that is part of the
+// assertion struct R returned by Build.
+type ConfigMapKeySelectorBuilder[R any] struct {
+	root *R
+	get  func() *ConfigMapKeySelectorAssertion
+}
+
+// Build returns the root assertion struct.
+func (b ConfigMapKeySelectorBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying ConfigMapKeySelectorAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b ConfigMapKeySelectorBuilder[R]) With(fn func(*ConfigMapKeySelectorAssertion)) ConfigMapKeySelectorBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// LocalObjectReference descends into the LocalObjectReference field.
+func (b ConfigMapKeySelectorBuilder[R]) LocalObjectReference() CoreLocalObjectReferenceBuilder[R] {
+	get := b.get
+	return CoreLocalObjectReferenceBuilder[R]{
+		get: func() *CoreLocalObjectReferenceAssertion {
+			return &get().LocalObjectReference
+		},
+		root: b.root,
+	}
+}
+
+// Key sets the expected Key.
+func (b ConfigMapKeySelectorBuilder[R]) Key(v string) ConfigMapKeySelectorBuilder[R] {
+	b.get().Key = Some(v)
+	return b
+}
+
+// Optional sets the expected Optional.
+func (b ConfigMapKeySelectorBuilder[R]) Optional(v bool) ConfigMapKeySelectorBuilder[R] {
+	b.get().Optional = SomePtr(v)
+	return b
+}
+
+// ConfigMapProjectionBuilder fluently fills in a ConfigMapProjectionAssertion that is part of the
+// assertion struct R returned by Build.
+type ConfigMapProjectionBuilder[R any] struct {
+	root *R
+	get  func() *ConfigMapProjectionAssertion
+}
+
+// Build returns the root assertion struct.
+func (b ConfigMapProjectionBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying ConfigMapProjectionAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b ConfigMapProjectionBuilder[R]) With(fn func(*ConfigMapProjectionAssertion)) ConfigMapProjectionBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// LocalObjectReference descends into the LocalObjectReference field.
+func (b ConfigMapProjectionBuilder[R]) LocalObjectReference() CoreLocalObjectReferenceBuilder[R] {
+	get := b.get
+	return CoreLocalObjectReferenceBuilder[R]{
+		get: func() *CoreLocalObjectReferenceAssertion {
+			return &get().LocalObjectReference
+		},
+		root: b.root,
+	}
+}
+
+// Items sets Items to exactly the given elements, compared by position.
+func (b ConfigMapProjectionBuilder[R]) Items(v []KeyToPathAssertion) ConfigMapProjectionBuilder[R] {
+	b.get().Items = Some(v)
+	return b
+}
+
+// Optional sets the expected Optional.
+func (b ConfigMapProjectionBuilder[R]) Optional(v bool) ConfigMapProjectionBuilder[R] {
+	b.get().Optional = SomePtr(v)
+	return b
+}
+
+// ConfigMapVolumeSourceBuilder fluently fills in a ConfigMapVolumeSourceAssertion that is part of the
+// assertion struct R returned by Build.
+type ConfigMapVolumeSourceBuilder[R any] struct {
+	root *R
+	get  func() *ConfigMapVolumeSourceAssertion
+}
+
+// Build returns the root assertion struct.
+func (b ConfigMapVolumeSourceBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying ConfigMapVolumeSourceAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b ConfigMapVolumeSourceBuilder[R]) With(fn func(*ConfigMapVolumeSourceAssertion)) ConfigMapVolumeSourceBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// LocalObjectReference descends into the LocalObjectReference field.
+func (b ConfigMapVolumeSourceBuilder[R]) LocalObjectReference() CoreLocalObjectReferenceBuilder[R] {
+	get := b.get
+	return CoreLocalObjectReferenceBuilder[R]{
+		get: func() *CoreLocalObjectReferenceAssertion {
+			return &get().LocalObjectReference
+		},
+		root: b.root,
+	}
+}
+
+// Items sets Items to exactly the given elements, compared by position.
+func (b ConfigMapVolumeSourceBuilder[R]) Items(v []KeyToPathAssertion) ConfigMapVolumeSourceBuilder[R] {
+	b.get().Items = Some(v)
+	return b
+}
+
+// DefaultMode sets the expected DefaultMode.
+func (b ConfigMapVolumeSourceBuilder[R]) DefaultMode(v int32) ConfigMapVolumeSourceBuilder[R] {
+	b.get().DefaultMode = SomePtr(v)
+	return b
+}
+
+// Optional sets the expected Optional.
+func (b ConfigMapVolumeSourceBuilder[R]) Optional(v bool) ConfigMapVolumeSourceBuilder[R] {
+	b.get().Optional = SomePtr(v)
+	return b
+}
+
+// ContainerBuilder fluently fills in a ContainerAssertion that is part of the
+// assertion struct R returned by Build.
+type ContainerBuilder[R any] struct {
+	root *R
+	get  func() *ContainerAssertion
+}
+
+// Build returns the root assertion struct.
+func (b ContainerBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying ContainerAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b ContainerBuilder[R]) With(fn func(*ContainerAssertion)) ContainerBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// Name sets the expected Name.
+func (b ContainerBuilder[R]) Name(v string) ContainerBuilder[R] {
+	b.get().Name = Some(v)
+	return b
+}
+
+// Image sets the expected Image.
+func (b ContainerBuilder[R]) Image(v string) ContainerBuilder[R] {
+	b.get().Image = Some(v)
+	return b
+}
+
+// Command sets the expected Command.
+func (b ContainerBuilder[R]) Command(v []string) ContainerBuilder[R] {
+	b.get().Command = Some(v)
+	return b
+}
+
+// Args sets the expected Args.
+func (b ContainerBuilder[R]) Args(v []string) ContainerBuilder[R] {
+	b.get().Args = Some(v)
+	return b
+}
+
+// WorkingDir sets the expected WorkingDir.
+func (b ContainerBuilder[R]) WorkingDir(v string) ContainerBuilder[R] {
+	b.get().WorkingDir = Some(v)
+	return b
+}
+
+// Ports sets Ports to exactly the given elements, compared by position.
+func (b ContainerBuilder[R]) Ports(v []ContainerPortAssertion) ContainerBuilder[R] {
+	b.get().Ports = Some(v)
+	return b
+}
+
+// Port descends into the element of Ports with the given Name,
+// adding it if needed. Ports is compared with ByKey semantics.
+func (b ContainerBuilder[R]) Port(name string) ContainerPortBuilder[R] {
+	get := b.get
+	keyedElement(&get().Ports, name)
+	return ContainerPortBuilder[R]{
+		get: func() *ContainerPortAssertion {
+			return keyedElement(&get().Ports, name)
+		},
+		root: b.root,
+	}
+}
+
+// EnvFrom sets EnvFrom to exactly the given elements, compared by position.
+func (b ContainerBuilder[R]) EnvFrom(v []EnvFromSourceAssertion) ContainerBuilder[R] {
+	b.get().EnvFrom = Some(v)
+	return b
+}
+
+// Env sets Env to exactly the given elements, compared by position.
+func (b ContainerBuilder[R]) Env(v []EnvVarAssertion) ContainerBuilder[R] {
+	b.get().Env = Some(v)
+	return b
+}
+
+// EnvVar descends into the element of Env with the given Name,
+// adding it if needed. Env is compared with ByKey semantics.
+func (b ContainerBuilder[R]) EnvVar(name string) EnvVarBuilder[R] {
+	get := b.get
+	keyedElement(&get().Env, name)
+	return EnvVarBuilder[R]{
+		get: func() *EnvVarAssertion {
+			return keyedElement(&get().Env, name)
+		},
+		root: b.root,
+	}
+}
+
+// Resources descends into the Resources field.
+func (b ContainerBuilder[R]) Resources() ResourceRequirementsBuilder[R] {
+	get := b.get
+	return ResourceRequirementsBuilder[R]{
+		get: func() *ResourceRequirementsAssertion {
+			return &get().Resources
+		},
+		root: b.root,
+	}
+}
+
+// ResizePolicy sets ResizePolicy to exactly the given elements, compared by position.
+func (b ContainerBuilder[R]) ResizePolicy(v []ContainerResizePolicyAssertion) ContainerBuilder[R] {
+	b.get().ResizePolicy = Some(v)
+	return b
+}
+
+// RestartPolicy sets the expected RestartPolicy.
+func (b ContainerBuilder[R]) RestartPolicy(v v11.ContainerRestartPolicy) ContainerBuilder[R] {
+	b.get().RestartPolicy = SomePtr(v)
+	return b
+}
+
+// RestartPolicyRules sets RestartPolicyRules to exactly the given elements, compared by position.
+func (b ContainerBuilder[R]) RestartPolicyRules(v []ContainerRestartRuleAssertion) ContainerBuilder[R] {
+	b.get().RestartPolicyRules = Some(v)
+	return b
+}
+
+// VolumeMounts sets VolumeMounts to exactly the given elements, compared by position.
+func (b ContainerBuilder[R]) VolumeMounts(v []VolumeMountAssertion) ContainerBuilder[R] {
+	b.get().VolumeMounts = Some(v)
+	return b
+}
+
+// VolumeMount descends into the element of VolumeMounts with the given Name,
+// adding it if needed. VolumeMounts is compared with ByKey semantics.
+func (b ContainerBuilder[R]) VolumeMount(name string) VolumeMountBuilder[R] {
+	get := b.get
+	keyedElement(&get().VolumeMounts, name)
+	return VolumeMountBuilder[R]{
+		get: func() *VolumeMountAssertion {
+			return keyedElement(&get().VolumeMounts, name)
+		},
+		root: b.root,
+	}
+}
+
+// VolumeDevices sets VolumeDevices to exactly the given elements, compared by position.
+func (b ContainerBuilder[R]) VolumeDevices(v []VolumeDeviceAssertion) ContainerBuilder[R] {
+	b.get().VolumeDevices = Some(v)
+	return b
+}
+
+// VolumeDevice descends into the element of VolumeDevices with the given Name,
+// adding it if needed. VolumeDevices is compared with ByKey semantics.
+func (b ContainerBuilder[R]) VolumeDevice(name string) VolumeDeviceBuilder[R] {
+	get := b.get
+	keyedElement(&get().VolumeDevices, name)
+	return VolumeDeviceBuilder[R]{
+		get: func() *VolumeDeviceAssertion {
+			return keyedElement(&get().VolumeDevices, name)
+		},
+		root: b.root,
+	}
+}
+
+// LivenessProbe descends into the LivenessProbe field.
+func (b ContainerBuilder[R]) LivenessProbe() CoreProbeBuilder[R] {
+	get := b.get
+	return CoreProbeBuilder[R]{
+		get: func() *CoreProbeAssertion {
+			return &get().LivenessProbe
+		},
+		root: b.root,
+	}
+}
+
+// ReadinessProbe descends into the ReadinessProbe field.
+func (b ContainerBuilder[R]) ReadinessProbe() CoreProbeBuilder[R] {
+	get := b.get
+	return CoreProbeBuilder[R]{
+		get: func() *CoreProbeAssertion {
+			return &get().ReadinessProbe
+		},
+		root: b.root,
+	}
+}
+
+// StartupProbe descends into the StartupProbe field.
+func (b ContainerBuilder[R]) StartupProbe() CoreProbeBuilder[R] {
+	get := b.get
+	return CoreProbeBuilder[R]{
+		get: func() *CoreProbeAssertion {
+			return &get().StartupProbe
+		},
+		root: b.root,
+	}
+}
+
+// Lifecycle descends into the Lifecycle field.
+func (b ContainerBuilder[R]) Lifecycle() LifecycleBuilder[R] {
+	get := b.get
+	return LifecycleBuilder[R]{
+		get: func() *LifecycleAssertion {
+			return &get().Lifecycle
+		},
+		root: b.root,
+	}
+}
+
+// TerminationMessagePath sets the expected TerminationMessagePath.
+func (b ContainerBuilder[R]) TerminationMessagePath(v string) ContainerBuilder[R] {
+	b.get().TerminationMessagePath = Some(v)
+	return b
+}
+
+// TerminationMessagePolicy sets the expected TerminationMessagePolicy.
+func (b ContainerBuilder[R]) TerminationMessagePolicy(v v11.TerminationMessagePolicy) ContainerBuilder[R] {
+	b.get().TerminationMessagePolicy = Some(v)
+	return b
+}
+
+// ImagePullPolicy sets the expected ImagePullPolicy.
+func (b ContainerBuilder[R]) ImagePullPolicy(v v11.PullPolicy) ContainerBuilder[R] {
+	b.get().ImagePullPolicy = Some(v)
+	return b
+}
+
+// SecurityContext descends into the SecurityContext field.
+func (b ContainerBuilder[R]) SecurityContext() SecurityContextBuilder[R] {
+	get := b.get
+	return SecurityContextBuilder[R]{
+		get: func() *SecurityContextAssertion {
+			return &get().SecurityContext
+		},
+		root: b.root,
+	}
+}
+
+// Stdin sets the expected Stdin.
+func (b ContainerBuilder[R]) Stdin(v bool) ContainerBuilder[R] {
+	b.get().Stdin = Some(v)
+	return b
+}
+
+// StdinOnce sets the expected StdinOnce.
+func (b ContainerBuilder[R]) StdinOnce(v bool) ContainerBuilder[R] {
+	b.get().StdinOnce = Some(v)
+	return b
+}
+
+// TTY sets the expected TTY.
+func (b ContainerBuilder[R]) TTY(v bool) ContainerBuilder[R] {
+	b.get().TTY = Some(v)
+	return b
+}
+
+// ContainerPortBuilder fluently fills in a ContainerPortAssertion that is part of the
+// assertion struct R returned by Build.
+type ContainerPortBuilder[R any] struct {
+	root *R
+	get  func() *ContainerPortAssertion
+}
+
+// Build returns the root assertion struct.
+func (b ContainerPortBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying ContainerPortAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b ContainerPortBuilder[R]) With(fn func(*ContainerPortAssertion)) ContainerPortBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// Name sets the expected Name.
+func (b ContainerPortBuilder[R]) Name(v string) ContainerPortBuilder[R] {
+	b.get().Name = Some(v)
+	return b
+}
+
+// HostPort sets the expected HostPort.
+func (b ContainerPortBuilder[R]) HostPort(v int32) ContainerPortBuilder[R] {
+	b.get().HostPort = Some(v)
+	return b
+}
+
+// ContainerPort sets the expected ContainerPort.
+func (b ContainerPortBuilder[R]) ContainerPort(v int32) ContainerPortBuilder[R] {
+	b.get().ContainerPort = Some(v)
+	return b
+}
+
+// Protocol sets the expected Protocol.
+func (b ContainerPortBuilder[R]) Protocol(v v11.Protocol) ContainerPortBuilder[R] {
+	b.get().Protocol = Some(v)
+	return b
+}
+
+// HostIP sets the expected HostIP.
+func (b ContainerPortBuilder[R]) HostIP(v string) ContainerPortBuilder[R] {
+	b.get().HostIP = Some(v)
+	return b
+}
+
+// ContainerResizePolicyBuilder fluently fills in a ContainerResizePolicyAssertion that is part of the
+// assertion struct R returned by Build.
+type ContainerResizePolicyBuilder[R any] struct {
+	root *R
+	get  func() *ContainerResizePolicyAssertion
+}
+
+// Build returns the root assertion struct.
+func (b ContainerResizePolicyBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying ContainerResizePolicyAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b ContainerResizePolicyBuilder[R]) With(fn func(*ContainerResizePolicyAssertion)) ContainerResizePolicyBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// ResourceName sets the expected ResourceName.
+func (b ContainerResizePolicyBuilder[R]) ResourceName(v v11.ResourceName) ContainerResizePolicyBuilder[R] {
+	b.get().ResourceName = Some(v)
+	return b
+}
+
+// RestartPolicy sets the expected RestartPolicy.
+func (b ContainerResizePolicyBuilder[R]) RestartPolicy(v v11.ResourceResizeRestartPolicy) ContainerResizePolicyBuilder[R] {
+	b.get().RestartPolicy = Some(v)
+	return b
+}
+
+// ContainerRestartRuleBuilder fluently fills in a ContainerRestartRuleAssertion that is part of the
+// assertion struct R returned by Build.
+type ContainerRestartRuleBuilder[R any] struct {
+	root *R
+	get  func() *ContainerRestartRuleAssertion
+}
+
+// Build returns the root assertion struct.
+func (b ContainerRestartRuleBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying ContainerRestartRuleAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b ContainerRestartRuleBuilder[R]) With(fn func(*ContainerRestartRuleAssertion)) ContainerRestartRuleBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// Action sets the expected Action.
+func (b ContainerRestartRuleBuilder[R]) Action(v v11.ContainerRestartRuleAction) ContainerRestartRuleBuilder[R] {
+	b.get().Action = Some(v)
+	return b
+}
+
+// ExitCodes descends into the ExitCodes field.
+func (b ContainerRestartRuleBuilder[R]) ExitCodes() ContainerRestartRuleOnExitCodesBuilder[R] {
+	get := b.get
+	return ContainerRestartRuleOnExitCodesBuilder[R]{
+		get: func() *ContainerRestartRuleOnExitCodesAssertion {
+			return &get().ExitCodes
+		},
+		root: b.root,
+	}
+}
+
+// ContainerRestartRuleOnExitCodesBuilder fluently fills in a ContainerRestartRuleOnExitCodesAssertion that is part of the
+// assertion struct R returned by Build.
+type ContainerRestartRuleOnExitCodesBuilder[R any] struct {
+	root *R
+	get  func() *ContainerRestartRuleOnExitCodesAssertion
+}
+
+// Build returns the root assertion struct.
+func (b ContainerRestartRuleOnExitCodesBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying ContainerRestartRuleOnExitCodesAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b ContainerRestartRuleOnExitCodesBuilder[R]) With(fn func(*ContainerRestartRuleOnExitCodesAssertion)) ContainerRestartRuleOnExitCodesBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// Operator sets the expected Operator.
+func (b ContainerRestartRuleOnExitCodesBuilder[R]) Operator(v v11.ContainerRestartRuleOnExitCodesOperator) ContainerRestartRuleOnExitCodesBuilder[R] {
+	b.get().Operator = Some(v)
+	return b
+}
+
+// Values sets the expected Values.
+func (b ContainerRestartRuleOnExitCodesBuilder[R]) Values(v []int32) ContainerRestartRuleOnExitCodesBuilder[R] {
+	b.get().Values = Some(v)
+	return b
+}
+
+// DownwardAPIProjectionBuilder fluently fills in a DownwardAPIProjectionAssertion that is part of the
+// assertion struct R returned by Build.
+type DownwardAPIProjectionBuilder[R any] struct {
+	root *R
+	get  func() *DownwardAPIProjectionAssertion
+}
+
+// Build returns the root assertion struct.
+func (b DownwardAPIProjectionBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying DownwardAPIProjectionAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b DownwardAPIProjectionBuilder[R]) With(fn func(*DownwardAPIProjectionAssertion)) DownwardAPIProjectionBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// Items sets Items to exactly the given elements, compared by position.
+func (b DownwardAPIProjectionBuilder[R]) Items(v []DownwardAPIVolumeFileAssertion) DownwardAPIProjectionBuilder[R] {
+	b.get().Items = Some(v)
+	return b
+}
+
+// DownwardAPIVolumeFileBuilder fluently fills in a DownwardAPIVolumeFileAssertion that is part of the
+// assertion struct R returned by Build.
+type DownwardAPIVolumeFileBuilder[R any] struct {
+	root *R
+	get  func() *DownwardAPIVolumeFileAssertion
+}
+
+// Build returns the root assertion struct.
+func (b DownwardAPIVolumeFileBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying DownwardAPIVolumeFileAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b DownwardAPIVolumeFileBuilder[R]) With(fn func(*DownwardAPIVolumeFileAssertion)) DownwardAPIVolumeFileBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// Path sets the expected Path.
+func (b DownwardAPIVolumeFileBuilder[R]) Path(v string) DownwardAPIVolumeFileBuilder[R] {
+	b.get().Path = Some(v)
+	return b
+}
+
+// FieldRef descends into the FieldRef field.
+func (b DownwardAPIVolumeFileBuilder[R]) FieldRef() ObjectFieldSelectorBuilder[R] {
+	get := b.get
+	return ObjectFieldSelectorBuilder[R]{
+		get: func() *ObjectFieldSelectorAssertion {
+			return &get().FieldRef
+		},
+		root: b.root,
+	}
+}
+
+// ResourceFieldRef descends into the ResourceFieldRef field.
+func (b DownwardAPIVolumeFileBuilder[R]) ResourceFieldRef() ResourceFieldSelectorBuilder[R] {
+	get := b.get
+	return ResourceFieldSelectorBuilder[R]{
+		get: func() *ResourceFieldSelectorAssertion {
+			return &get().ResourceFieldRef
+		},
+		root: b.root,
+	}
+}
+
+// Mode sets the expected Mode.
+func (b DownwardAPIVolumeFileBuilder[R]) Mode(v int32) DownwardAPIVolumeFileBuilder[R] {
+	b.get().Mode = SomePtr(v)
+	return b
+}
+
+// DownwardAPIVolumeSourceBuilder fluently fills in a DownwardAPIVolumeSourceAssertion that is part of the
+// assertion struct R returned by Build.
+type DownwardAPIVolumeSourceBuilder[R any] struct {
+	root *R
+	get  func() *DownwardAPIVolumeSourceAssertion
+}
+
+// Build returns the root assertion struct.
+func (b DownwardAPIVolumeSourceBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying DownwardAPIVolumeSourceAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b DownwardAPIVolumeSourceBuilder[R]) With(fn func(*DownwardAPIVolumeSourceAssertion)) DownwardAPIVolumeSourceBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// Items sets Items to exactly the given elements, compared by position.
+func (b DownwardAPIVolumeSourceBuilder[R]) Items(v []DownwardAPIVolumeFileAssertion) DownwardAPIVolumeSourceBuilder[R] {
+	b.get().Items = Some(v)
+	return b
+}
+
+// DefaultMode sets the expected DefaultMode.
+func (b DownwardAPIVolumeSourceBuilder[R]) DefaultMode(v int32) DownwardAPIVolumeSourceBuilder[R] {
+	b.get().DefaultMode = SomePtr(v)
+	return b
+}
+
+// EmptyDirVolumeSourceBuilder fluently fills in a EmptyDirVolumeSourceAssertion that is part of the
+// assertion struct R returned by Build.
+type EmptyDirVolumeSourceBuilder[R any] struct {
+	root *R
+	get  func() *EmptyDirVolumeSourceAssertion
+}
+
+// Build returns the root assertion struct.
+func (b EmptyDirVolumeSourceBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying EmptyDirVolumeSourceAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b EmptyDirVolumeSourceBuilder[R]) With(fn func(*EmptyDirVolumeSourceAssertion)) EmptyDirVolumeSourceBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// Medium sets the expected Medium.
+func (b EmptyDirVolumeSourceBuilder[R]) Medium(v v11.StorageMedium) EmptyDirVolumeSourceBuilder[R] {
+	b.get().Medium = Some(v)
+	return b
+}
+
+// SizeLimit descends into the SizeLimit field.
+func (b EmptyDirVolumeSourceBuilder[R]) SizeLimit() QuantityBuilder[R] {
+	get := b.get
+	return QuantityBuilder[R]{
+		get: func() *QuantityAssertion {
+			return &get().SizeLimit
+		},
+		root: b.root,
+	}
+}
+
+// EnvFromSourceBuilder fluently fills in a EnvFromSourceAssertion that is part of the
+// assertion struct R returned by Build.
+type EnvFromSourceBuilder[R any] struct {
+	root *R
+	get  func() *EnvFromSourceAssertion
+}
+
+// Build returns the root assertion struct.
+func (b EnvFromSourceBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying EnvFromSourceAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b EnvFromSourceBuilder[R]) With(fn func(*EnvFromSourceAssertion)) EnvFromSourceBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// Prefix sets the expected Prefix.
+func (b EnvFromSourceBuilder[R]) Prefix(v string) EnvFromSourceBuilder[R] {
+	b.get().Prefix = Some(v)
+	return b
+}
+
+// ConfigMapRef descends into the ConfigMapRef field.
+func (b EnvFromSourceBuilder[R]) ConfigMapRef() ConfigMapEnvSourceBuilder[R] {
+	get := b.get
+	return ConfigMapEnvSourceBuilder[R]{
+		get: func() *ConfigMapEnvSourceAssertion {
+			return &get().ConfigMapRef
+		},
+		root: b.root,
+	}
+}
+
+// SecretRef descends into the SecretRef field.
+func (b EnvFromSourceBuilder[R]) SecretRef() SecretEnvSourceBuilder[R] {
+	get := b.get
+	return SecretEnvSourceBuilder[R]{
+		get: func() *SecretEnvSourceAssertion {
+			return &get().SecretRef
+		},
+		root: b.root,
+	}
+}
+
+// EnvVarBuilder fluently fills in a EnvVarAssertion that is part of the
+// assertion struct R returned by Build.
+type EnvVarBuilder[R any] struct {
+	root *R
+	get  func() *EnvVarAssertion
+}
+
+// Build returns the root assertion struct.
+func (b EnvVarBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying EnvVarAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b EnvVarBuilder[R]) With(fn func(*EnvVarAssertion)) EnvVarBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// Name sets the expected Name.
+func (b EnvVarBuilder[R]) Name(v string) EnvVarBuilder[R] {
+	b.get().Name = Some(v)
+	return b
+}
+
+// Value sets the expected Value.
+func (b EnvVarBuilder[R]) Value(v string) EnvVarBuilder[R] {
+	b.get().Value = Some(v)
+	return b
+}
+
+// ValueFrom descends into the ValueFrom field.
+func (b EnvVarBuilder[R]) ValueFrom() EnvVarSourceBuilder[R] {
+	get := b.get
+	return EnvVarSourceBuilder[R]{
+		get: func() *EnvVarSourceAssertion {
+			return &get().ValueFrom
+		},
+		root: b.root,
+	}
+}
+
+// EnvVarSourceBuilder fluently fills in a EnvVarSourceAssertion that is part of the
+// assertion struct R returned by Build.
+type EnvVarSourceBuilder[R any] struct {
+	root *R
+	get  func() *EnvVarSourceAssertion
+}
+
+// Build returns the root assertion struct.
+func (b EnvVarSourceBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying EnvVarSourceAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b EnvVarSourceBuilder[R]) With(fn func(*EnvVarSourceAssertion)) EnvVarSourceBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// FieldRef descends into the FieldRef field.
+func (b EnvVarSourceBuilder[R]) FieldRef() ObjectFieldSelectorBuilder[R] {
+	get := b.get
+	return ObjectFieldSelectorBuilder[R]{
+		get: func() *ObjectFieldSelectorAssertion {
+			return &get().FieldRef
+		},
+		root: b.root,
+	}
+}
+
+// ResourceFieldRef descends into the ResourceFieldRef field.
+func (b EnvVarSourceBuilder[R]) ResourceFieldRef() ResourceFieldSelectorBuilder[R] {
+	get := b.get
+	return ResourceFieldSelectorBuilder[R]{
+		get: func() *ResourceFieldSelectorAssertion {
+			return &get().ResourceFieldRef
+		},
+		root: b.root,
+	}
+}
+
+// ConfigMapKeyRef descends into the ConfigMapKeyRef field.
+func (b EnvVarSourceBuilder[R]) ConfigMapKeyRef() ConfigMapKeySelectorBuilder[R] {
+	get := b.get
+	return ConfigMapKeySelectorBuilder[R]{
+		get: func() *ConfigMapKeySelectorAssertion {
+			return &get().ConfigMapKeyRef
+		},
+		root: b.root,
+	}
+}
+
+// SecretKeyRef descends into the SecretKeyRef field.
+func (b EnvVarSourceBuilder[R]) SecretKeyRef() SecretKeySelectorBuilder[R] {
+	get := b.get
+	return SecretKeySelectorBuilder[R]{
+		get: func() *SecretKeySelectorAssertion {
+			return &get().SecretKeyRef
+		},
+		root: b.root,
+	}
+}
+
+// FileKeyRef descends into the FileKeyRef field.
+func (b EnvVarSourceBuilder[R]) FileKeyRef() FileKeySelectorBuilder[R] {
+	get := b.get
+	return FileKeySelectorBuilder[R]{
+		get: func() *FileKeySelectorAssertion {
+			return &get().FileKeyRef
+		},
+		root: b.root,
+	}
+}
+
+// EphemeralContainerBuilder fluently fills in a EphemeralContainerAssertion that is part of the
+// assertion struct R returned by Build.
+type EphemeralContainerBuilder[R any] struct {
+	root *R
+	get  func() *EphemeralContainerAssertion
+}
+
+// Build returns the root assertion struct.
+func (b EphemeralContainerBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying EphemeralContainerAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b EphemeralContainerBuilder[R]) With(fn func(*EphemeralContainerAssertion)) EphemeralContainerBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// EphemeralContainerCommon descends into the EphemeralContainerCommon field.
+func (b EphemeralContainerBuilder[R]) EphemeralContainerCommon() EphemeralContainerCommonBuilder[R] {
+	get := b.get
+	return EphemeralContainerCommonBuilder[R]{
+		get: func() *EphemeralContainerCommonAssertion {
+			return &get().EphemeralContainerCommon
+		},
+		root: b.root,
+	}
+}
+
+// TargetContainerName sets the expected TargetContainerName.
+func (b EphemeralContainerBuilder[R]) TargetContainerName(v string) EphemeralContainerBuilder[R] {
+	b.get().TargetContainerName = Some(v)
+	return b
+}
+
+// EphemeralContainerCommonBuilder fluently fills in a EphemeralContainerCommonAssertion that is part of the
+// assertion struct R returned by Build.
+type EphemeralContainerCommonBuilder[R any] struct {
+	root *R
+	get  func() *EphemeralContainerCommonAssertion
+}
+
+// Build returns the root assertion struct.
+func (b EphemeralContainerCommonBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying EphemeralContainerCommonAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b EphemeralContainerCommonBuilder[R]) With(fn func(*EphemeralContainerCommonAssertion)) EphemeralContainerCommonBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// Name sets the expected Name.
+func (b EphemeralContainerCommonBuilder[R]) Name(v string) EphemeralContainerCommonBuilder[R] {
+	b.get().Name = Some(v)
+	return b
+}
+
+// Image sets the expected Image.
+func (b EphemeralContainerCommonBuilder[R]) Image(v string) EphemeralContainerCommonBuilder[R] {
+	b.get().Image = Some(v)
+	return b
+}
+
+// Command sets the expected Command.
+func (b EphemeralContainerCommonBuilder[R]) Command(v []string) EphemeralContainerCommonBuilder[R] {
+	b.get().Command = Some(v)
+	return b
+}
+
+// Args sets the expected Args.
+func (b EphemeralContainerCommonBuilder[R]) Args(v []string) EphemeralContainerCommonBuilder[R] {
+	b.get().Args = Some(v)
+	return b
+}
+
+// WorkingDir sets the expected WorkingDir.
+func (b EphemeralContainerCommonBuilder[R]) WorkingDir(v string) EphemeralContainerCommonBuilder[R] {
+	b.get().WorkingDir = Some(v)
+	return b
+}
+
+// Ports sets Ports to exactly the given elements, compared by position.
+func (b EphemeralContainerCommonBuilder[R]) Ports(v []ContainerPortAssertion) EphemeralContainerCommonBuilder[R] {
+	b.get().Ports = Some(v)
+	return b
+}
+
+// Port descends into the element of Ports with the given Name,
+// adding it if needed. Ports is compared with ByKey semantics.
+func (b EphemeralContainerCommonBuilder[R]) Port(name string) ContainerPortBuilder[R] {
+	get := b.get
+	keyedElement(&get().Ports, name)
+	return ContainerPortBuilder[R]{
+		get: func() *ContainerPortAssertion {
+			return keyedElement(&get().Ports, name)
+		},
+		root: b.root,
+	}
+}
+
+// EnvFrom sets EnvFrom to exactly the given elements, compared by position.
+func (b EphemeralContainerCommonBuilder[R]) EnvFrom(v []EnvFromSourceAssertion) EphemeralContainerCommonBuilder[R] {
+	b.get().EnvFrom = Some(v)
+	return b
+}
+
+// Env sets Env to exactly the given elements, compared by position.
+func (b EphemeralContainerCommonBuilder[R]) Env(v []EnvVarAssertion) EphemeralContainerCommonBuilder[R] {
+	b.get().Env = Some(v)
+	return b
+}
+
+// EnvVar descends into the element of Env with the given Name,
+// adding it if needed. Env is compared with ByKey semantics.
+func (b EphemeralContainerCommonBuilder[R]) EnvVar(name string) EnvVarBuilder[R] {
+	get := b.get
+	keyedElement(&get().Env, name)
+	return EnvVarBuilder[R]{
+		get: func() *EnvVarAssertion {
+			return keyedElement(&get().Env, name)
+		},
+		root: b.root,
+	}
+}
+
+// Resources descends into the Resources field.
+func (b EphemeralContainerCommonBuilder[R]) Resources() ResourceRequirementsBuilder[R] {
+	get := b.get
+	return ResourceRequirementsBuilder[R]{
+		get: func() *ResourceRequirementsAssertion {
+			return &get().Resources
+		},
+		root: b.root,
+	}
+}
+
+// ResizePolicy sets ResizePolicy to exactly the given elements, compared by position.
+func (b EphemeralContainerCommonBuilder[R]) ResizePolicy(v []ContainerResizePolicyAssertion) EphemeralContainerCommonBuilder[R] {
+	b.get().ResizePolicy = Some(v)
+	return b
+}
+
+// RestartPolicy sets the expected RestartPolicy.
+func (b EphemeralContainerCommonBuilder[R]) RestartPolicy(v v11.ContainerRestartPolicy) EphemeralContainerCommonBuilder[R] {
+	b.get().RestartPolicy = SomePtr(v)
+	return b
+}
+
+// RestartPolicyRules sets RestartPolicyRules to exactly the given elements, compared by position.
+func (b EphemeralContainerCommonBuilder[R]) RestartPolicyRules(v []ContainerRestartRuleAssertion) EphemeralContainerCommonBuilder[R] {
+	b.get().RestartPolicyRules = Some(v)
+	return b
+}
+
+// VolumeMounts sets VolumeMounts to exactly the given elements, compared by position.
+func (b EphemeralContainerCommonBuilder[R]) VolumeMounts(v []VolumeMountAssertion) EphemeralContainerCommonBuilder[R] {
+	b.get().VolumeMounts = Some(v)
+	return b
+}
+
+// VolumeMount descends into the element of VolumeMounts with the given Name,
+// adding it if needed. VolumeMounts is compared with ByKey semantics.
+func (b EphemeralContainerCommonBuilder[R]) VolumeMount(name string) VolumeMountBuilder[R] {
+	get := b.get
+	keyedElement(&get().VolumeMounts, name)
+	return VolumeMountBuilder[R]{
+		get: func() *VolumeMountAssertion {
+			return keyedElement(&get().VolumeMounts, name)
+		},
+		root: b.root,
+	}
+}
+
+// VolumeDevices sets VolumeDevices to exactly the given elements, compared by position.
+func (b EphemeralContainerCommonBuilder[R]) VolumeDevices(v []VolumeDeviceAssertion) EphemeralContainerCommonBuilder[R] {
+	b.get().VolumeDevices = Some(v)
+	return b
+}
+
+// VolumeDevice descends into the element of VolumeDevices with the given Name,
+// adding it if needed. VolumeDevices is compared with ByKey semantics.
+func (b EphemeralContainerCommonBuilder[R]) VolumeDevice(name string) VolumeDeviceBuilder[R] {
+	get := b.get
+	keyedElement(&get().VolumeDevices, name)
+	return VolumeDeviceBuilder[R]{
+		get: func() *VolumeDeviceAssertion {
+			return keyedElement(&get().VolumeDevices, name)
+		},
+		root: b.root,
+	}
+}
+
+// LivenessProbe descends into the LivenessProbe field.
+func (b EphemeralContainerCommonBuilder[R]) LivenessProbe() CoreProbeBuilder[R] {
+	get := b.get
+	return CoreProbeBuilder[R]{
+		get: func() *CoreProbeAssertion {
+			return &get().LivenessProbe
+		},
+		root: b.root,
+	}
+}
+
+// ReadinessProbe descends into the ReadinessProbe field.
+func (b EphemeralContainerCommonBuilder[R]) ReadinessProbe() CoreProbeBuilder[R] {
+	get := b.get
+	return CoreProbeBuilder[R]{
+		get: func() *CoreProbeAssertion {
+			return &get().ReadinessProbe
+		},
+		root: b.root,
+	}
+}
+
+// StartupProbe descends into the StartupProbe field.
+func (b EphemeralContainerCommonBuilder[R]) StartupProbe() CoreProbeBuilder[R] {
+	get := b.get
+	return CoreProbeBuilder[R]{
+		get: func() *CoreProbeAssertion {
+			return &get().StartupProbe
+		},
+		root: b.root,
+	}
+}
+
+// Lifecycle descends into the Lifecycle field.
+func (b EphemeralContainerCommonBuilder[R]) Lifecycle() LifecycleBuilder[R] {
+	get := b.get
+	return LifecycleBuilder[R]{
+		get: func() *LifecycleAssertion {
+			return &get().Lifecycle
+		},
+		root: b.root,
+	}
+}
+
+// TerminationMessagePath sets the expected TerminationMessagePath.
+func (b EphemeralContainerCommonBuilder[R]) TerminationMessagePath(v string) EphemeralContainerCommonBuilder[R] {
+	b.get().TerminationMessagePath = Some(v)
+	return b
+}
+
+// TerminationMessagePolicy sets the expected TerminationMessagePolicy.
+func (b EphemeralContainerCommonBuilder[R]) TerminationMessagePolicy(v v11.TerminationMessagePolicy) EphemeralContainerCommonBuilder[R] {
+	b.get().TerminationMessagePolicy = Some(v)
+	return b
+}
+
+// ImagePullPolicy sets the expected ImagePullPolicy.
+func (b EphemeralContainerCommonBuilder[R]) ImagePullPolicy(v v11.PullPolicy) EphemeralContainerCommonBuilder[R] {
+	b.get().ImagePullPolicy = Some(v)
+	return b
+}
+
+// SecurityContext descends into the SecurityContext field.
+func (b EphemeralContainerCommonBuilder[R]) SecurityContext() SecurityContextBuilder[R] {
+	get := b.get
+	return SecurityContextBuilder[R]{
+		get: func() *SecurityContextAssertion {
+			return &get().SecurityContext
+		},
+		root: b.root,
+	}
+}
+
+// Stdin sets the expected Stdin.
+func (b EphemeralContainerCommonBuilder[R]) Stdin(v bool) EphemeralContainerCommonBuilder[R] {
+	b.get().Stdin = Some(v)
+	return b
+}
+
+// StdinOnce sets the expected StdinOnce.
+func (b EphemeralContainerCommonBuilder[R]) StdinOnce(v bool) EphemeralContainerCommonBuilder[R] {
+	b.get().StdinOnce = Some(v)
+	return b
+}
+
+// TTY sets the expected TTY.
+func (b EphemeralContainerCommonBuilder[R]) TTY(v bool) EphemeralContainerCommonBuilder[R] {
+	b.get().TTY = Some(v)
+	return b
+}
+
+// EphemeralVolumeSourceBuilder fluently fills in a EphemeralVolumeSourceAssertion that is part of the
+// assertion struct R returned by Build.
+type EphemeralVolumeSourceBuilder[R any] struct {
+	root *R
+	get  func() *EphemeralVolumeSourceAssertion
+}
+
+// Build returns the root assertion struct.
+func (b EphemeralVolumeSourceBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying EphemeralVolumeSourceAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b EphemeralVolumeSourceBuilder[R]) With(fn func(*EphemeralVolumeSourceAssertion)) EphemeralVolumeSourceBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// VolumeClaimTemplate descends into the VolumeClaimTemplate field.
+func (b EphemeralVolumeSourceBuilder[R]) VolumeClaimTemplate() PersistentVolumeClaimTemplateBuilder[R] {
+	get := b.get
+	return PersistentVolumeClaimTemplateBuilder[R]{
+		get: func() *PersistentVolumeClaimTemplateAssertion {
+			return &get().VolumeClaimTemplate
+		},
+		root: b.root,
+	}
+}
+
+// ExecActionBuilder fluently fills in a ExecActionAssertion that is part of the
+// assertion struct R returned by Build.
+type ExecActionBuilder[R any] struct {
+	root *R
+	get  func() *ExecActionAssertion
+}
+
+// Build returns the root assertion struct.
+func (b ExecActionBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying ExecActionAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b ExecActionBuilder[R]) With(fn func(*ExecActionAssertion)) ExecActionBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// Command sets the expected Command.
+func (b ExecActionBuilder[R]) Command(v []string) ExecActionBuilder[R] {
+	b.get().Command = Some(v)
+	return b
+}
+
+// FCVolumeSourceBuilder fluently fills in a FCVolumeSourceAssertion that is part of the
+// assertion struct R returned by Build.
+type FCVolumeSourceBuilder[R any] struct {
+	root *R
+	get  func() *FCVolumeSourceAssertion
+}
+
+// Build returns the root assertion struct.
+func (b FCVolumeSourceBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying FCVolumeSourceAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b FCVolumeSourceBuilder[R]) With(fn func(*FCVolumeSourceAssertion)) FCVolumeSourceBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// TargetWWNs sets the expected TargetWWNs.
+func (b FCVolumeSourceBuilder[R]) TargetWWNs(v []string) FCVolumeSourceBuilder[R] {
+	b.get().TargetWWNs = Some(v)
+	return b
+}
+
+// Lun sets the expected Lun.
+func (b FCVolumeSourceBuilder[R]) Lun(v int32) FCVolumeSourceBuilder[R] {
+	b.get().Lun = SomePtr(v)
+	return b
+}
+
+// FSType sets the expected FSType.
+func (b FCVolumeSourceBuilder[R]) FSType(v string) FCVolumeSourceBuilder[R] {
+	b.get().FSType = Some(v)
+	return b
+}
+
+// ReadOnly sets the expected ReadOnly.
+func (b FCVolumeSourceBuilder[R]) ReadOnly(v bool) FCVolumeSourceBuilder[R] {
+	b.get().ReadOnly = Some(v)
+	return b
+}
+
+// WWIDs sets the expected WWIDs.
+func (b FCVolumeSourceBuilder[R]) WWIDs(v []string) FCVolumeSourceBuilder[R] {
+	b.get().WWIDs = Some(v)
+	return b
+}
+
+// FileKeySelectorBuilder fluently fills in a FileKeySelectorAssertion that is part of the
+// assertion struct R returned by Build.
+type FileKeySelectorBuilder[R any] struct {
+	root *R
+	get  func() *FileKeySelectorAssertion
+}
+
+// Build returns the root assertion struct.
+func (b FileKeySelectorBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying FileKeySelectorAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b FileKeySelectorBuilder[R]) With(fn func(*FileKeySelectorAssertion)) FileKeySelectorBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// VolumeName sets the expected VolumeName.
+func (b FileKeySelectorBuilder[R]) VolumeName(v string) FileKeySelectorBuilder[R] {
+	b.get().VolumeName = Some(v)
+	return b
+}
+
+// Path sets the expected Path.
+func (b FileKeySelectorBuilder[R]) Path(v string) FileKeySelectorBuilder[R] {
+	b.get().Path = Some(v)
+	return b
+}
+
+// Key sets the expected Key.
+func (b FileKeySelectorBuilder[R]) Key(v string) FileKeySelectorBuilder[R] {
+	b.get().Key = Some(v)
+	return b
+}
+
+// Optional sets the expected Optional.
+func (b FileKeySelectorBuilder[R]) Optional(v bool) FileKeySelectorBuilder[R] {
+	b.get().Optional = SomePtr(v)
+	return b
+}
+
+// FlexVolumeSourceBuilder fluently fills in a FlexVolumeSourceAssertion that is part of the
+// assertion struct R returned by Build.
+type FlexVolumeSourceBuilder[R any] struct {
+	root *R
+	get  func() *FlexVolumeSourceAssertion
+}
+
+// Build returns the root assertion struct.
+func (b FlexVolumeSourceBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying FlexVolumeSourceAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b FlexVolumeSourceBuilder[R]) With(fn func(*FlexVolumeSourceAssertion)) FlexVolumeSourceBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// Driver sets the expected Driver.
+func (b FlexVolumeSourceBuilder[R]) Driver(v string) FlexVolumeSourceBuilder[R] {
+	b.get().Driver = Some(v)
+	return b
+}
+
+// FSType sets the expected FSType.
+func (b FlexVolumeSourceBuilder[R]) FSType(v string) FlexVolumeSourceBuilder[R] {
+	b.get().FSType = Some(v)
+	return b
+}
+
+// SecretRef descends into the SecretRef field.
+func (b FlexVolumeSourceBuilder[R]) SecretRef() CoreLocalObjectReferenceBuilder[R] {
+	get := b.get
+	return CoreLocalObjectReferenceBuilder[R]{
+		get: func() *CoreLocalObjectReferenceAssertion {
+			return &get().SecretRef
+		},
+		root: b.root,
+	}
+}
+
+// ReadOnly sets the expected ReadOnly.
+func (b FlexVolumeSourceBuilder[R]) ReadOnly(v bool) FlexVolumeSourceBuilder[R] {
+	b.get().ReadOnly = Some(v)
+	return b
+}
+
+// Options sets the expected Options.
+func (b FlexVolumeSourceBuilder[R]) Options(v map[string]string) FlexVolumeSourceBuilder[R] {
+	b.get().Options = Some(v)
+	return b
+}
+
+// FlockerVolumeSourceBuilder fluently fills in a FlockerVolumeSourceAssertion that is part of the
+// assertion struct R returned by Build.
+type FlockerVolumeSourceBuilder[R any] struct {
+	root *R
+	get  func() *FlockerVolumeSourceAssertion
+}
+
+// Build returns the root assertion struct.
+func (b FlockerVolumeSourceBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying FlockerVolumeSourceAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b FlockerVolumeSourceBuilder[R]) With(fn func(*FlockerVolumeSourceAssertion)) FlockerVolumeSourceBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// DatasetName sets the expected DatasetName.
+func (b FlockerVolumeSourceBuilder[R]) DatasetName(v string) FlockerVolumeSourceBuilder[R] {
+	b.get().DatasetName = Some(v)
+	return b
+}
+
+// DatasetUUID sets the expected DatasetUUID.
+func (b FlockerVolumeSourceBuilder[R]) DatasetUUID(v string) FlockerVolumeSourceBuilder[R] {
+	b.get().DatasetUUID = Some(v)
+	return b
+}
+
+// GCEPersistentDiskVolumeSourceBuilder fluently fills in a GCEPersistentDiskVolumeSourceAssertion that is part of the
+// assertion struct R returned by Build.
+type GCEPersistentDiskVolumeSourceBuilder[R any] struct {
+	root *R
+	get  func() *GCEPersistentDiskVolumeSourceAssertion
+}
+
+// Build returns the root assertion struct.
+func (b GCEPersistentDiskVolumeSourceBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying GCEPersistentDiskVolumeSourceAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b GCEPersistentDiskVolumeSourceBuilder[R]) With(fn func(*GCEPersistentDiskVolumeSourceAssertion)) GCEPersistentDiskVolumeSourceBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// PDName sets the expected PDName.
+func (b GCEPersistentDiskVolumeSourceBuilder[R]) PDName(v string) GCEPersistentDiskVolumeSourceBuilder[R] {
+	b.get().PDName = Some(v)
+	return b
+}
+
+// FSType sets the expected FSType.
+func (b GCEPersistentDiskVolumeSourceBuilder[R]) FSType(v string) GCEPersistentDiskVolumeSourceBuilder[R] {
+	b.get().FSType = Some(v)
+	return b
+}
+
+// Partition sets the expected Partition.
+func (b GCEPersistentDiskVolumeSourceBuilder[R]) Partition(v int32) GCEPersistentDiskVolumeSourceBuilder[R] {
+	b.get().Partition = Some(v)
+	return b
+}
+
+// ReadOnly sets the expected ReadOnly.
+func (b GCEPersistentDiskVolumeSourceBuilder[R]) ReadOnly(v bool) GCEPersistentDiskVolumeSourceBuilder[R] {
+	b.get().ReadOnly = Some(v)
+	return b
+}
+
+// GRPCActionBuilder fluently fills in a GRPCActionAssertion that is part of the
+// assertion struct R returned by Build.
+type GRPCActionBuilder[R any] struct {
+	root *R
+	get  func() *GRPCActionAssertion
+}
+
+// Build returns the root assertion struct.
+func (b GRPCActionBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying GRPCActionAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b GRPCActionBuilder[R]) With(fn func(*GRPCActionAssertion)) GRPCActionBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// Port sets the expected Port.
+func (b GRPCActionBuilder[R]) Port(v int32) GRPCActionBuilder[R] {
+	b.get().Port = Some(v)
+	return b
+}
+
+// Service sets the expected Service.
+func (b GRPCActionBuilder[R]) Service(v string) GRPCActionBuilder[R] {
+	b.get().Service = SomePtr(v)
+	return b
+}
+
+// GitRepoVolumeSourceBuilder fluently fills in a GitRepoVolumeSourceAssertion that is part of the
+// assertion struct R returned by Build.
+type GitRepoVolumeSourceBuilder[R any] struct {
+	root *R
+	get  func() *GitRepoVolumeSourceAssertion
+}
+
+// Build returns the root assertion struct.
+func (b GitRepoVolumeSourceBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying GitRepoVolumeSourceAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b GitRepoVolumeSourceBuilder[R]) With(fn func(*GitRepoVolumeSourceAssertion)) GitRepoVolumeSourceBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// Repository sets the expected Repository.
+func (b GitRepoVolumeSourceBuilder[R]) Repository(v string) GitRepoVolumeSourceBuilder[R] {
+	b.get().Repository = Some(v)
+	return b
+}
+
+// Revision sets the expected Revision.
+func (b GitRepoVolumeSourceBuilder[R]) Revision(v string) GitRepoVolumeSourceBuilder[R] {
+	b.get().Revision = Some(v)
+	return b
+}
+
+// Directory sets the expected Directory.
+func (b GitRepoVolumeSourceBuilder[R]) Directory(v string) GitRepoVolumeSourceBuilder[R] {
+	b.get().Directory = Some(v)
+	return b
+}
+
+// GlusterfsVolumeSourceBuilder fluently fills in a GlusterfsVolumeSourceAssertion that is part of the
+// assertion struct R returned by Build.
+type GlusterfsVolumeSourceBuilder[R any] struct {
+	root *R
+	get  func() *GlusterfsVolumeSourceAssertion
+}
+
+// Build returns the root assertion struct.
+func (b GlusterfsVolumeSourceBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying GlusterfsVolumeSourceAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b GlusterfsVolumeSourceBuilder[R]) With(fn func(*GlusterfsVolumeSourceAssertion)) GlusterfsVolumeSourceBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// EndpointsName sets the expected EndpointsName.
+func (b GlusterfsVolumeSourceBuilder[R]) EndpointsName(v string) GlusterfsVolumeSourceBuilder[R] {
+	b.get().EndpointsName = Some(v)
+	return b
+}
+
+// Path sets the expected Path.
+func (b GlusterfsVolumeSourceBuilder[R]) Path(v string) GlusterfsVolumeSourceBuilder[R] {
+	b.get().Path = Some(v)
+	return b
+}
+
+// ReadOnly sets the expected ReadOnly.
+func (b GlusterfsVolumeSourceBuilder[R]) ReadOnly(v bool) GlusterfsVolumeSourceBuilder[R] {
+	b.get().ReadOnly = Some(v)
+	return b
+}
+
+// HTTPGetActionBuilder fluently fills in a HTTPGetActionAssertion that is part of the
+// assertion struct R returned by Build.
+type HTTPGetActionBuilder[R any] struct {
+	root *R
+	get  func() *HTTPGetActionAssertion
+}
+
+// Build returns the root assertion struct.
+func (b HTTPGetActionBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying HTTPGetActionAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b HTTPGetActionBuilder[R]) With(fn func(*HTTPGetActionAssertion)) HTTPGetActionBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// Path sets the expected Path.
+func (b HTTPGetActionBuilder[R]) Path(v string) HTTPGetActionBuilder[R] {
+	b.get().Path = Some(v)
+	return b
+}
+
+// Port descends into the Port field.
+func (b HTTPGetActionBuilder[R]) Port() IntOrStringBuilder[R] {
+	get := b.get
+	return IntOrStringBuilder[R]{
+		get: func() *IntOrStringAssertion {
+			return &get().Port
+		},
+		root: b.root,
+	}
+}
+
+// Host sets the expected Host.
+func (b HTTPGetActionBuilder[R]) Host(v string) HTTPGetActionBuilder[R] {
+	b.get().Host = Some(v)
+	return b
+}
+
+// Scheme sets the expected Scheme.
+func (b HTTPGetActionBuilder[R]) Scheme(v v11.URIScheme) HTTPGetActionBuilder[R] {
+	b.get().Scheme = Some(v)
+	return b
+}
+
+// HTTPHeaders sets HTTPHeaders to exactly the given elements, compared by position.
+func (b HTTPGetActionBuilder[R]) HTTPHeaders(v []CoreHTTPHeaderAssertion) HTTPGetActionBuilder[R] {
+	b.get().HTTPHeaders = Some(v)
+	return b
+}
+
+// HTTPHeader descends into the element of HTTPHeaders with the given Name,
+// adding it if needed. HTTPHeaders is compared with ByKey semantics.
+func (b HTTPGetActionBuilder[R]) HTTPHeader(name string) CoreHTTPHeaderBuilder[R] {
+	get := b.get
+	keyedElement(&get().HTTPHeaders, name)
+	return CoreHTTPHeaderBuilder[R]{
+		get: func() *CoreHTTPHeaderAssertion {
+			return keyedElement(&get().HTTPHeaders, name)
+		},
+		root: b.root,
+	}
+}
+
+// CoreHTTPHeaderBuilder fluently fills in a CoreHTTPHeaderAssertion that is part of the
+// assertion struct R returned by Build.
+type CoreHTTPHeaderBuilder[R any] struct {
+	root *R
+	get  func() *CoreHTTPHeaderAssertion
+}
+
+// Build returns the root assertion struct.
+func (b CoreHTTPHeaderBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying CoreHTTPHeaderAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b CoreHTTPHeaderBuilder[R]) With(fn func(*CoreHTTPHeaderAssertion)) CoreHTTPHeaderBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// Name sets the expected Name.
+func (b CoreHTTPHeaderBuilder[R]) Name(v string) CoreHTTPHeaderBuilder[R] {
+	b.get().Name = Some(v)
+	return b
+}
+
+// Value sets the expected Value.
+func (b CoreHTTPHeaderBuilder[R]) Value(v string) CoreHTTPHeaderBuilder[R] {
+	b.get().Value = Some(v)
+	return b
+}
+
+// CoreHostAliasBuilder fluently fills in a CoreHostAliasAssertion that is part of the
+// assertion struct R returned by Build.
+type CoreHostAliasBuilder[R any] struct {
+	root *R
+	get  func() *CoreHostAliasAssertion
+}
+
+// Build returns the root assertion struct.
+func (b CoreHostAliasBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying CoreHostAliasAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b CoreHostAliasBuilder[R]) With(fn func(*CoreHostAliasAssertion)) CoreHostAliasBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// IP sets the expected IP.
+func (b CoreHostAliasBuilder[R]) IP(v string) CoreHostAliasBuilder[R] {
+	b.get().IP = Some(v)
+	return b
+}
+
+// Hostnames sets the expected Hostnames.
+func (b CoreHostAliasBuilder[R]) Hostnames(v []string) CoreHostAliasBuilder[R] {
+	b.get().Hostnames = Some(v)
+	return b
+}
+
+// HostPathVolumeSourceBuilder fluently fills in a HostPathVolumeSourceAssertion that is part of the
+// assertion struct R returned by Build.
+type HostPathVolumeSourceBuilder[R any] struct {
+	root *R
+	get  func() *HostPathVolumeSourceAssertion
+}
+
+// Build returns the root assertion struct.
+func (b HostPathVolumeSourceBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying HostPathVolumeSourceAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b HostPathVolumeSourceBuilder[R]) With(fn func(*HostPathVolumeSourceAssertion)) HostPathVolumeSourceBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// Path sets the expected Path.
+func (b HostPathVolumeSourceBuilder[R]) Path(v string) HostPathVolumeSourceBuilder[R] {
+	b.get().Path = Some(v)
+	return b
+}
+
+// Type sets the expected Type.
+func (b HostPathVolumeSourceBuilder[R]) Type(v v11.HostPathType) HostPathVolumeSourceBuilder[R] {
+	b.get().Type = SomePtr(v)
+	return b
+}
+
+// ISCSIVolumeSourceBuilder fluently fills in a ISCSIVolumeSourceAssertion that is part of the
+// assertion struct R returned by Build.
+type ISCSIVolumeSourceBuilder[R any] struct {
+	root *R
+	get  func() *ISCSIVolumeSourceAssertion
+}
+
+// Build returns the root assertion struct.
+func (b ISCSIVolumeSourceBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying ISCSIVolumeSourceAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b ISCSIVolumeSourceBuilder[R]) With(fn func(*ISCSIVolumeSourceAssertion)) ISCSIVolumeSourceBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// TargetPortal sets the expected TargetPortal.
+func (b ISCSIVolumeSourceBuilder[R]) TargetPortal(v string) ISCSIVolumeSourceBuilder[R] {
+	b.get().TargetPortal = Some(v)
+	return b
+}
+
+// IQN sets the expected IQN.
+func (b ISCSIVolumeSourceBuilder[R]) IQN(v string) ISCSIVolumeSourceBuilder[R] {
+	b.get().IQN = Some(v)
+	return b
+}
+
+// Lun sets the expected Lun.
+func (b ISCSIVolumeSourceBuilder[R]) Lun(v int32) ISCSIVolumeSourceBuilder[R] {
+	b.get().Lun = Some(v)
+	return b
+}
+
+// ISCSIInterface sets the expected ISCSIInterface.
+func (b ISCSIVolumeSourceBuilder[R]) ISCSIInterface(v string) ISCSIVolumeSourceBuilder[R] {
+	b.get().ISCSIInterface = Some(v)
+	return b
+}
+
+// FSType sets the expected FSType.
+func (b ISCSIVolumeSourceBuilder[R]) FSType(v string) ISCSIVolumeSourceBuilder[R] {
+	b.get().FSType = Some(v)
+	return b
+}
+
+// ReadOnly sets the expected ReadOnly.
+func (b ISCSIVolumeSourceBuilder[R]) ReadOnly(v bool) ISCSIVolumeSourceBuilder[R] {
+	b.get().ReadOnly = Some(v)
+	return b
+}
+
+// Portals sets the expected Portals.
+func (b ISCSIVolumeSourceBuilder[R]) Portals(v []string) ISCSIVolumeSourceBuilder[R] {
+	b.get().Portals = Some(v)
+	return b
+}
+
+// DiscoveryCHAPAuth sets the expected DiscoveryCHAPAuth.
+func (b ISCSIVolumeSourceBuilder[R]) DiscoveryCHAPAuth(v bool) ISCSIVolumeSourceBuilder[R] {
+	b.get().DiscoveryCHAPAuth = Some(v)
+	return b
+}
+
+// SessionCHAPAuth sets the expected SessionCHAPAuth.
+func (b ISCSIVolumeSourceBuilder[R]) SessionCHAPAuth(v bool) ISCSIVolumeSourceBuilder[R] {
+	b.get().SessionCHAPAuth = Some(v)
+	return b
+}
+
+// SecretRef descends into the SecretRef field.
+func (b ISCSIVolumeSourceBuilder[R]) SecretRef() CoreLocalObjectReferenceBuilder[R] {
+	get := b.get
+	return CoreLocalObjectReferenceBuilder[R]{
+		get: func() *CoreLocalObjectReferenceAssertion {
+			return &get().SecretRef
+		},
+		root: b.root,
+	}
+}
+
+// InitiatorName sets the expected InitiatorName.
+func (b ISCSIVolumeSourceBuilder[R]) InitiatorName(v string) ISCSIVolumeSourceBuilder[R] {
+	b.get().InitiatorName = SomePtr(v)
+	return b
+}
+
+// ImageVolumeSourceBuilder fluently fills in a ImageVolumeSourceAssertion that is part of the
+// assertion struct R returned by Build.
+type ImageVolumeSourceBuilder[R any] struct {
+	root *R
+	get  func() *ImageVolumeSourceAssertion
+}
+
+// Build returns the root assertion struct.
+func (b ImageVolumeSourceBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying ImageVolumeSourceAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b ImageVolumeSourceBuilder[R]) With(fn func(*ImageVolumeSourceAssertion)) ImageVolumeSourceBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// Reference sets the expected Reference.
+func (b ImageVolumeSourceBuilder[R]) Reference(v string) ImageVolumeSourceBuilder[R] {
+	b.get().Reference = Some(v)
+	return b
+}
+
+// PullPolicy sets the expected PullPolicy.
+func (b ImageVolumeSourceBuilder[R]) PullPolicy(v v11.PullPolicy) ImageVolumeSourceBuilder[R] {
+	b.get().PullPolicy = Some(v)
+	return b
+}
+
+// KeyToPathBuilder fluently fills in a KeyToPathAssertion that is part of the
+// assertion struct R returned by Build.
+type KeyToPathBuilder[R any] struct {
+	root *R
+	get  func() *KeyToPathAssertion
+}
+
+// Build returns the root assertion struct.
+func (b KeyToPathBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying KeyToPathAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b KeyToPathBuilder[R]) With(fn func(*KeyToPathAssertion)) KeyToPathBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// Key sets the expected Key.
+func (b KeyToPathBuilder[R]) Key(v string) KeyToPathBuilder[R] {
+	b.get().Key = Some(v)
+	return b
+}
+
+// Path sets the expected Path.
+func (b KeyToPathBuilder[R]) Path(v string) KeyToPathBuilder[R] {
+	b.get().Path = Some(v)
+	return b
+}
+
+// Mode sets the expected Mode.
+func (b KeyToPathBuilder[R]) Mode(v int32) KeyToPathBuilder[R] {
+	b.get().Mode = SomePtr(v)
+	return b
+}
+
+// LifecycleBuilder fluently fills in a LifecycleAssertion that is part of the
+// assertion struct R returned by Build.
+type LifecycleBuilder[R any] struct {
+	root *R
+	get  func() *LifecycleAssertion
+}
+
+// Build returns the root assertion struct.
+func (b LifecycleBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying LifecycleAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b LifecycleBuilder[R]) With(fn func(*LifecycleAssertion)) LifecycleBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// PostStart descends into the PostStart field.
+func (b LifecycleBuilder[R]) PostStart() LifecycleHandlerBuilder[R] {
+	get := b.get
+	return LifecycleHandlerBuilder[R]{
+		get: func() *LifecycleHandlerAssertion {
+			return &get().PostStart
+		},
+		root: b.root,
+	}
+}
+
+// PreStop descends into the PreStop field.
+func (b LifecycleBuilder[R]) PreStop() LifecycleHandlerBuilder[R] {
+	get := b.get
+	return LifecycleHandlerBuilder[R]{
+		get: func() *LifecycleHandlerAssertion {
+			return &get().PreStop
+		},
+		root: b.root,
+	}
+}
+
+// StopSignal sets the expected StopSignal.
+func (b LifecycleBuilder[R]) StopSignal(v v11.Signal) LifecycleBuilder[R] {
+	b.get().StopSignal = SomePtr(v)
+	return b
+}
+
+// LifecycleHandlerBuilder fluently fills in a LifecycleHandlerAssertion that is part of the
+// assertion struct R returned by Build.
+type LifecycleHandlerBuilder[R any] struct {
+	root *R
+	get  func() *LifecycleHandlerAssertion
+}
+
+// Build returns the root assertion struct.
+func (b LifecycleHandlerBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying LifecycleHandlerAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b LifecycleHandlerBuilder[R]) With(fn func(*LifecycleHandlerAssertion)) LifecycleHandlerBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// Exec descends into the Exec field.
+func (b LifecycleHandlerBuilder[R]) Exec() ExecActionBuilder[R] {
+	get := b.get
+	return ExecActionBuilder[R]{
+		get: func() *ExecActionAssertion {
+			return &get().Exec
+		},
+		root: b.root,
+	}
+}
+
+// HTTPGet descends into the HTTPGet field.
+func (b LifecycleHandlerBuilder[R]) HTTPGet() HTTPGetActionBuilder[R] {
+	get := b.get
+	return HTTPGetActionBuilder[R]{
+		get: func() *HTTPGetActionAssertion {
+			return &get().HTTPGet
+		},
+		root: b.root,
+	}
+}
+
+// TCPSocket descends into the TCPSocket field.
+func (b LifecycleHandlerBuilder[R]) TCPSocket() TCPSocketActionBuilder[R] {
+	get := b.get
+	return TCPSocketActionBuilder[R]{
+		get: func() *TCPSocketActionAssertion {
+			return &get().TCPSocket
+		},
+		root: b.root,
+	}
+}
+
+// Sleep descends into the Sleep field.
+func (b LifecycleHandlerBuilder[R]) Sleep() SleepActionBuilder[R] {
+	get := b.get
+	return SleepActionBuilder[R]{
+		get: func() *SleepActionAssertion {
+			return &get().Sleep
+		},
+		root: b.root,
+	}
+}
+
+// CoreLocalObjectReferenceBuilder fluently fills in a CoreLocalObjectReferenceAssertion that is part of the
+// assertion struct R returned by Build.
+type CoreLocalObjectReferenceBuilder[R any] struct {
+	root *R
+	get  func() *CoreLocalObjectReferenceAssertion
+}
+
+// Build returns the root assertion struct.
+func (b CoreLocalObjectReferenceBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying CoreLocalObjectReferenceAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b CoreLocalObjectReferenceBuilder[R]) With(fn func(*CoreLocalObjectReferenceAssertion)) CoreLocalObjectReferenceBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// Name sets the expected Name.
+func (b CoreLocalObjectReferenceBuilder[R]) Name(v string) CoreLocalObjectReferenceBuilder[R] {
+	b.get().Name = Some(v)
+	return b
+}
+
+// NFSVolumeSourceBuilder fluently fills in a NFSVolumeSourceAssertion that is part of the
+// assertion struct R returned by Build.
+type NFSVolumeSourceBuilder[R any] struct {
+	root *R
+	get  func() *NFSVolumeSourceAssertion
+}
+
+// Build returns the root assertion struct.
+func (b NFSVolumeSourceBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying NFSVolumeSourceAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b NFSVolumeSourceBuilder[R]) With(fn func(*NFSVolumeSourceAssertion)) NFSVolumeSourceBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// Server sets the expected Server.
+func (b NFSVolumeSourceBuilder[R]) Server(v string) NFSVolumeSourceBuilder[R] {
+	b.get().Server = Some(v)
+	return b
+}
+
+// Path sets the expected Path.
+func (b NFSVolumeSourceBuilder[R]) Path(v string) NFSVolumeSourceBuilder[R] {
+	b.get().Path = Some(v)
+	return b
+}
+
+// ReadOnly sets the expected ReadOnly.
+func (b NFSVolumeSourceBuilder[R]) ReadOnly(v bool) NFSVolumeSourceBuilder[R] {
+	b.get().ReadOnly = Some(v)
+	return b
+}
+
+// NodeAffinityBuilder fluently fills in a NodeAffinityAssertion that is part of the
+// assertion struct R returned by Build.
+type NodeAffinityBuilder[R any] struct {
+	root *R
+	get  func() *NodeAffinityAssertion
+}
+
+// Build returns the root assertion struct.
+func (b NodeAffinityBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying NodeAffinityAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b NodeAffinityBuilder[R]) With(fn func(*NodeAffinityAssertion)) NodeAffinityBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// RequiredDuringSchedulingIgnoredDuringExecution descends into the RequiredDuringSchedulingIgnoredDuringExecution field.
+func (b NodeAffinityBuilder[R]) RequiredDuringSchedulingIgnoredDuringExecution() NodeSelectorBuilder[R] {
+	get := b.get
+	return NodeSelectorBuilder[R]{
+		get: func() *NodeSelectorAssertion {
+			return &get().RequiredDuringSchedulingIgnoredDuringExecution
+		},
+		root: b.root,
+	}
+}
+
+// PreferredDuringSchedulingIgnoredDuringExecution sets PreferredDuringSchedulingIgnoredDuringExecution to exactly the given elements, compared by position.
+func (b NodeAffinityBuilder[R]) PreferredDuringSchedulingIgnoredDuringExecution(v []PreferredSchedulingTermAssertion) NodeAffinityBuilder[R] {
+	b.get().PreferredDuringSchedulingIgnoredDuringExecution = Some(v)
+	return b
+}
+
+// NodeSelectorBuilder fluently fills in a NodeSelectorAssertion that is part of the
+// assertion struct R returned by Build.
+type NodeSelectorBuilder[R any] struct {
+	root *R
+	get  func() *NodeSelectorAssertion
+}
+
+// Build returns the root assertion struct.
+func (b NodeSelectorBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying NodeSelectorAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b NodeSelectorBuilder[R]) With(fn func(*NodeSelectorAssertion)) NodeSelectorBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// NodeSelectorTerms sets NodeSelectorTerms to exactly the given elements, compared by position.
+func (b NodeSelectorBuilder[R]) NodeSelectorTerms(v []NodeSelectorTermAssertion) NodeSelectorBuilder[R] {
+	b.get().NodeSelectorTerms = Some(v)
+	return b
+}
+
+// NodeSelectorRequirementBuilder fluently fills in a NodeSelectorRequirementAssertion that is part of the
+// assertion struct R returned by Build.
+type NodeSelectorRequirementBuilder[R any] struct {
+	root *R
+	get  func() *NodeSelectorRequirementAssertion
+}
+
+// Build returns the root assertion struct.
+func (b NodeSelectorRequirementBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying NodeSelectorRequirementAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b NodeSelectorRequirementBuilder[R]) With(fn func(*NodeSelectorRequirementAssertion)) NodeSelectorRequirementBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// Key sets the expected Key.
+func (b NodeSelectorRequirementBuilder[R]) Key(v string) NodeSelectorRequirementBuilder[R] {
+	b.get().Key = Some(v)
+	return b
+}
+
+// Operator sets the expected Operator.
+func (b NodeSelectorRequirementBuilder[R]) Operator(v v11.NodeSelectorOperator) NodeSelectorRequirementBuilder[R] {
+	b.get().Operator = Some(v)
+	return b
+}
+
+// Values sets the expected Values.
+func (b NodeSelectorRequirementBuilder[R]) Values(v []string) NodeSelectorRequirementBuilder[R] {
+	b.get().Values = Some(v)
+	return b
+}
+
+// NodeSelectorTermBuilder fluently fills in a NodeSelectorTermAssertion that is part of the
+// assertion struct R returned by Build.
+type NodeSelectorTermBuilder[R any] struct {
+	root *R
+	get  func() *NodeSelectorTermAssertion
+}
+
+// Build returns the root assertion struct.
+func (b NodeSelectorTermBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying NodeSelectorTermAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b NodeSelectorTermBuilder[R]) With(fn func(*NodeSelectorTermAssertion)) NodeSelectorTermBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// MatchExpressions sets MatchExpressions to exactly the given elements, compared by position.
+func (b NodeSelectorTermBuilder[R]) MatchExpressions(v []NodeSelectorRequirementAssertion) NodeSelectorTermBuilder[R] {
+	b.get().MatchExpressions = Some(v)
+	return b
+}
+
+// MatchFields sets MatchFields to exactly the given elements, compared by position.
+func (b NodeSelectorTermBuilder[R]) MatchFields(v []NodeSelectorRequirementAssertion) NodeSelectorTermBuilder[R] {
+	b.get().MatchFields = Some(v)
+	return b
+}
+
+// ObjectFieldSelectorBuilder fluently fills in a ObjectFieldSelectorAssertion that is part of the
+// assertion struct R returned by Build.
+type ObjectFieldSelectorBuilder[R any] struct {
+	root *R
+	get  func() *ObjectFieldSelectorAssertion
+}
+
+// Build returns the root assertion struct.
+func (b ObjectFieldSelectorBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying ObjectFieldSelectorAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b ObjectFieldSelectorBuilder[R]) With(fn func(*ObjectFieldSelectorAssertion)) ObjectFieldSelectorBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// APIVersion sets the expected APIVersion.
+func (b ObjectFieldSelectorBuilder[R]) APIVersion(v string) ObjectFieldSelectorBuilder[R] {
+	b.get().APIVersion = Some(v)
+	return b
+}
+
+// FieldPath sets the expected FieldPath.
+func (b ObjectFieldSelectorBuilder[R]) FieldPath(v string) ObjectFieldSelectorBuilder[R] {
+	b.get().FieldPath = Some(v)
+	return b
+}
+
+// CoreObjectReferenceBuilder fluently fills in a CoreObjectReferenceAssertion that is part of the
+// assertion struct R returned by Build.
+type CoreObjectReferenceBuilder[R any] struct {
+	root *R
+	get  func() *CoreObjectReferenceAssertion
+}
+
+// Build returns the root assertion struct.
+func (b CoreObjectReferenceBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying CoreObjectReferenceAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b CoreObjectReferenceBuilder[R]) With(fn func(*CoreObjectReferenceAssertion)) CoreObjectReferenceBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// Kind sets the expected Kind.
+func (b CoreObjectReferenceBuilder[R]) Kind(v string) CoreObjectReferenceBuilder[R] {
+	b.get().Kind = Some(v)
+	return b
+}
+
+// Namespace sets the expected Namespace.
+func (b CoreObjectReferenceBuilder[R]) Namespace(v string) CoreObjectReferenceBuilder[R] {
+	b.get().Namespace = Some(v)
+	return b
+}
+
+// Name sets the expected Name.
+func (b CoreObjectReferenceBuilder[R]) Name(v string) CoreObjectReferenceBuilder[R] {
+	b.get().Name = Some(v)
+	return b
+}
+
+// UID sets the expected UID.
+func (b CoreObjectReferenceBuilder[R]) UID(v types.UID) CoreObjectReferenceBuilder[R] {
+	b.get().UID = Some(v)
+	return b
+}
+
+// APIVersion sets the expected APIVersion.
+func (b CoreObjectReferenceBuilder[R]) APIVersion(v string) CoreObjectReferenceBuilder[R] {
+	b.get().APIVersion = Some(v)
+	return b
+}
+
+// ResourceVersion sets the expected ResourceVersion.
+func (b CoreObjectReferenceBuilder[R]) ResourceVersion(v string) CoreObjectReferenceBuilder[R] {
+	b.get().ResourceVersion = Some(v)
+	return b
+}
+
+// FieldPath sets the expected FieldPath.
+func (b CoreObjectReferenceBuilder[R]) FieldPath(v string) CoreObjectReferenceBuilder[R] {
+	b.get().FieldPath = Some(v)
+	return b
+}
+
+// PersistentVolumeClaimSpecBuilder fluently fills in a PersistentVolumeClaimSpecAssertion that is part of the
+// assertion struct R returned by Build.
+type PersistentVolumeClaimSpecBuilder[R any] struct {
+	root *R
+	get  func() *PersistentVolumeClaimSpecAssertion
+}
+
+// Build returns the root assertion struct.
+func (b PersistentVolumeClaimSpecBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying PersistentVolumeClaimSpecAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b PersistentVolumeClaimSpecBuilder[R]) With(fn func(*PersistentVolumeClaimSpecAssertion)) PersistentVolumeClaimSpecBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// AccessModes sets the expected AccessModes.
+func (b PersistentVolumeClaimSpecBuilder[R]) AccessModes(v []v11.PersistentVolumeAccessMode) PersistentVolumeClaimSpecBuilder[R] {
+	b.get().AccessModes = Some(v)
+	return b
+}
+
+// Selector descends into the Selector field.
+func (b PersistentVolumeClaimSpecBuilder[R]) Selector() LabelSelectorBuilder[R] {
+	get := b.get
+	return LabelSelectorBuilder[R]{
+		get: func() *LabelSelectorAssertion {
+			return &get().Selector
+		},
+		root: b.root,
+	}
+}
+
+// Resources descends into the Resources field.
+func (b PersistentVolumeClaimSpecBuilder[R]) Resources() VolumeResourceRequirementsBuilder[R] {
+	get := b.get
+	return VolumeResourceRequirementsBuilder[R]{
+		get: func() *VolumeResourceRequirementsAssertion {
+			return &get().Resources
+		},
+		root: b.root,
+	}
+}
+
+// VolumeName sets the expected VolumeName.
+func (b PersistentVolumeClaimSpecBuilder[R]) VolumeName(v string) PersistentVolumeClaimSpecBuilder[R] {
+	b.get().VolumeName = Some(v)
+	return b
+}
+
+// StorageClassName sets the expected StorageClassName.
+func (b PersistentVolumeClaimSpecBuilder[R]) StorageClassName(v string) PersistentVolumeClaimSpecBuilder[R] {
+	b.get().StorageClassName = SomePtr(v)
+	return b
+}
+
+// VolumeMode sets the expected VolumeMode.
+func (b PersistentVolumeClaimSpecBuilder[R]) VolumeMode(v v11.PersistentVolumeMode) PersistentVolumeClaimSpecBuilder[R] {
+	b.get().VolumeMode = SomePtr(v)
+	return b
+}
+
+// DataSource descends into the DataSource field.
+func (b PersistentVolumeClaimSpecBuilder[R]) DataSource() TypedLocalObjectReferenceBuilder[R] {
+	get := b.get
+	return TypedLocalObjectReferenceBuilder[R]{
+		get: func() *TypedLocalObjectReferenceAssertion {
+			return &get().DataSource
+		},
+		root: b.root,
+	}
+}
+
+// DataSourceRef descends into the DataSourceRef field.
+func (b PersistentVolumeClaimSpecBuilder[R]) DataSourceRef() TypedObjectReferenceBuilder[R] {
+	get := b.get
+	return TypedObjectReferenceBuilder[R]{
+		get: func() *TypedObjectReferenceAssertion {
+			return &get().DataSourceRef
+		},
+		root: b.root,
+	}
+}
+
+// VolumeAttributesClassName sets the expected VolumeAttributesClassName.
+func (b PersistentVolumeClaimSpecBuilder[R]) VolumeAttributesClassName(v string) PersistentVolumeClaimSpecBuilder[R] {
+	b.get().VolumeAttributesClassName = SomePtr(v)
+	return b
+}
+
+// PersistentVolumeClaimTemplateBuilder fluently fills in a PersistentVolumeClaimTemplateAssertion that is part of the
+// assertion struct R returned by Build.
+type PersistentVolumeClaimTemplateBuilder[R any] struct {
+	root *R
+	get  func() *PersistentVolumeClaimTemplateAssertion
+}
+
+// Build returns the root assertion struct.
+func (b PersistentVolumeClaimTemplateBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying PersistentVolumeClaimTemplateAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b PersistentVolumeClaimTemplateBuilder[R]) With(fn func(*PersistentVolumeClaimTemplateAssertion)) PersistentVolumeClaimTemplateBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// ObjectMeta descends into the ObjectMeta field.
+func (b PersistentVolumeClaimTemplateBuilder[R]) ObjectMeta() ObjectMetaBuilder[R] {
+	get := b.get
+	return ObjectMetaBuilder[R]{
+		get: func() *ObjectMetaAssertion {
+			return &get().ObjectMeta
+		},
+		root: b.root,
+	}
+}
+
+// Spec descends into the Spec field.
+func (b PersistentVolumeClaimTemplateBuilder[R]) Spec() PersistentVolumeClaimSpecBuilder[R] {
+	get := b.get
+	return PersistentVolumeClaimSpecBuilder[R]{
+		get: func() *PersistentVolumeClaimSpecAssertion {
+			return &get().Spec
+		},
+		root: b.root,
+	}
+}
+
+// PersistentVolumeClaimVolumeSourceBuilder fluently fills in a PersistentVolumeClaimVolumeSourceAssertion that is part of the
+// assertion struct R returned by Build.
+type PersistentVolumeClaimVolumeSourceBuilder[R any] struct {
+	root *R
+	get  func() *PersistentVolumeClaimVolumeSourceAssertion
+}
+
+// Build returns the root assertion struct.
+func (b PersistentVolumeClaimVolumeSourceBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying PersistentVolumeClaimVolumeSourceAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b PersistentVolumeClaimVolumeSourceBuilder[R]) With(fn func(*PersistentVolumeClaimVolumeSourceAssertion)) PersistentVolumeClaimVolumeSourceBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// ClaimName sets the expected ClaimName.
+func (b PersistentVolumeClaimVolumeSourceBuilder[R]) ClaimName(v string) PersistentVolumeClaimVolumeSourceBuilder[R] {
+	b.get().ClaimName = Some(v)
+	return b
+}
+
+// ReadOnly sets the expected ReadOnly.
+func (b PersistentVolumeClaimVolumeSourceBuilder[R]) ReadOnly(v bool) PersistentVolumeClaimVolumeSourceBuilder[R] {
+	b.get().ReadOnly = Some(v)
+	return b
+}
+
+// PhotonPersistentDiskVolumeSourceBuilder fluently fills in a PhotonPersistentDiskVolumeSourceAssertion that is part of the
+// assertion struct R returned by Build.
+type PhotonPersistentDiskVolumeSourceBuilder[R any] struct {
+	root *R
+	get  func() *PhotonPersistentDiskVolumeSourceAssertion
+}
+
+// Build returns the root assertion struct.
+func (b PhotonPersistentDiskVolumeSourceBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying PhotonPersistentDiskVolumeSourceAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b PhotonPersistentDiskVolumeSourceBuilder[R]) With(fn func(*PhotonPersistentDiskVolumeSourceAssertion)) PhotonPersistentDiskVolumeSourceBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// PdID sets the expected PdID.
+func (b PhotonPersistentDiskVolumeSourceBuilder[R]) PdID(v string) PhotonPersistentDiskVolumeSourceBuilder[R] {
+	b.get().PdID = Some(v)
+	return b
+}
+
+// FSType sets the expected FSType.
+func (b PhotonPersistentDiskVolumeSourceBuilder[R]) FSType(v string) PhotonPersistentDiskVolumeSourceBuilder[R] {
+	b.get().FSType = Some(v)
+	return b
+}
+
+// PodAffinityBuilder fluently fills in a PodAffinityAssertion that is part of the
+// assertion struct R returned by Build.
+type PodAffinityBuilder[R any] struct {
+	root *R
+	get  func() *PodAffinityAssertion
+}
+
+// Build returns the root assertion struct.
+func (b PodAffinityBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying PodAffinityAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b PodAffinityBuilder[R]) With(fn func(*PodAffinityAssertion)) PodAffinityBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// RequiredDuringSchedulingIgnoredDuringExecution sets RequiredDuringSchedulingIgnoredDuringExecution to exactly the given elements, compared by position.
+func (b PodAffinityBuilder[R]) RequiredDuringSchedulingIgnoredDuringExecution(v []PodAffinityTermAssertion) PodAffinityBuilder[R] {
+	b.get().RequiredDuringSchedulingIgnoredDuringExecution = Some(v)
+	return b
+}
+
+// PreferredDuringSchedulingIgnoredDuringExecution sets PreferredDuringSchedulingIgnoredDuringExecution to exactly the given elements, compared by position.
+func (b PodAffinityBuilder[R]) PreferredDuringSchedulingIgnoredDuringExecution(v []WeightedPodAffinityTermAssertion) PodAffinityBuilder[R] {
+	b.get().PreferredDuringSchedulingIgnoredDuringExecution = Some(v)
+	return b
+}
+
+// PodAffinityTermBuilder fluently fills in a PodAffinityTermAssertion that is part of the
+// assertion struct R returned by Build.
+type PodAffinityTermBuilder[R any] struct {
+	root *R
+	get  func() *PodAffinityTermAssertion
+}
+
+// Build returns the root assertion struct.
+func (b PodAffinityTermBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying PodAffinityTermAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b PodAffinityTermBuilder[R]) With(fn func(*PodAffinityTermAssertion)) PodAffinityTermBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// LabelSelector descends into the LabelSelector field.
+func (b PodAffinityTermBuilder[R]) LabelSelector() LabelSelectorBuilder[R] {
+	get := b.get
+	return LabelSelectorBuilder[R]{
+		get: func() *LabelSelectorAssertion {
+			return &get().LabelSelector
+		},
+		root: b.root,
+	}
+}
+
+// Namespaces sets the expected Namespaces.
+func (b PodAffinityTermBuilder[R]) Namespaces(v []string) PodAffinityTermBuilder[R] {
+	b.get().Namespaces = Some(v)
+	return b
+}
+
+// TopologyKey sets the expected TopologyKey.
+func (b PodAffinityTermBuilder[R]) TopologyKey(v string) PodAffinityTermBuilder[R] {
+	b.get().TopologyKey = Some(v)
+	return b
+}
+
+// NamespaceSelector descends into the NamespaceSelector field.
+func (b PodAffinityTermBuilder[R]) NamespaceSelector() LabelSelectorBuilder[R] {
+	get := b.get
+	return LabelSelectorBuilder[R]{
+		get: func() *LabelSelectorAssertion {
+			return &get().NamespaceSelector
+		},
+		root: b.root,
+	}
+}
+
+// MatchLabelKeys sets the expected MatchLabelKeys.
+func (b PodAffinityTermBuilder[R]) MatchLabelKeys(v []string) PodAffinityTermBuilder[R] {
+	b.get().MatchLabelKeys = Some(v)
+	return b
+}
+
+// MismatchLabelKeys sets the expected MismatchLabelKeys.
+func (b PodAffinityTermBuilder[R]) MismatchLabelKeys(v []string) PodAffinityTermBuilder[R] {
+	b.get().MismatchLabelKeys = Some(v)
+	return b
+}
+
+// PodAntiAffinityBuilder fluently fills in a PodAntiAffinityAssertion that is part of the
+// assertion struct R returned by Build.
+type PodAntiAffinityBuilder[R any] struct {
+	root *R
+	get  func() *PodAntiAffinityAssertion
+}
+
+// Build returns the root assertion struct.
+func (b PodAntiAffinityBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying PodAntiAffinityAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b PodAntiAffinityBuilder[R]) With(fn func(*PodAntiAffinityAssertion)) PodAntiAffinityBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// RequiredDuringSchedulingIgnoredDuringExecution sets RequiredDuringSchedulingIgnoredDuringExecution to exactly the given elements, compared by position.
+func (b PodAntiAffinityBuilder[R]) RequiredDuringSchedulingIgnoredDuringExecution(v []PodAffinityTermAssertion) PodAntiAffinityBuilder[R] {
+	b.get().RequiredDuringSchedulingIgnoredDuringExecution = Some(v)
+	return b
+}
+
+// PreferredDuringSchedulingIgnoredDuringExecution sets PreferredDuringSchedulingIgnoredDuringExecution to exactly the given elements, compared by position.
+func (b PodAntiAffinityBuilder[R]) PreferredDuringSchedulingIgnoredDuringExecution(v []WeightedPodAffinityTermAssertion) PodAntiAffinityBuilder[R] {
+	b.get().PreferredDuringSchedulingIgnoredDuringExecution = Some(v)
+	return b
+}
+
+// PodCertificateProjectionBuilder fluently fills in a PodCertificateProjectionAssertion that is part of the
+// assertion struct R returned by Build.
+type PodCertificateProjectionBuilder[R any] struct {
+	root *R
+	get  func() *PodCertificateProjectionAssertion
+}
+
+// Build returns the root assertion struct.
+func (b PodCertificateProjectionBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying PodCertificateProjectionAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b PodCertificateProjectionBuilder[R]) With(fn func(*PodCertificateProjectionAssertion)) PodCertificateProjectionBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// SignerName sets the expected SignerName.
+func (b PodCertificateProjectionBuilder[R]) SignerName(v string) PodCertificateProjectionBuilder[R] {
+	b.get().SignerName = Some(v)
+	return b
+}
+
+// KeyType sets the expected KeyType.
+func (b PodCertificateProjectionBuilder[R]) KeyType(v string) PodCertificateProjectionBuilder[R] {
+	b.get().KeyType = Some(v)
+	return b
+}
+
+// MaxExpirationSeconds sets the expected MaxExpirationSeconds.
+func (b PodCertificateProjectionBuilder[R]) MaxExpirationSeconds(v int32) PodCertificateProjectionBuilder[R] {
+	b.get().MaxExpirationSeconds = SomePtr(v)
+	return b
+}
+
+// CredentialBundlePath sets the expected CredentialBundlePath.
+func (b PodCertificateProjectionBuilder[R]) CredentialBundlePath(v string) PodCertificateProjectionBuilder[R] {
+	b.get().CredentialBundlePath = Some(v)
+	return b
+}
+
+// KeyPath sets the expected KeyPath.
+func (b PodCertificateProjectionBuilder[R]) KeyPath(v string) PodCertificateProjectionBuilder[R] {
+	b.get().KeyPath = Some(v)
+	return b
+}
+
+// CertificateChainPath sets the expected CertificateChainPath.
+func (b PodCertificateProjectionBuilder[R]) CertificateChainPath(v string) PodCertificateProjectionBuilder[R] {
+	b.get().CertificateChainPath = Some(v)
+	return b
+}
+
+// UserAnnotations sets the expected UserAnnotations.
+func (b PodCertificateProjectionBuilder[R]) UserAnnotations(v map[string]string) PodCertificateProjectionBuilder[R] {
+	b.get().UserAnnotations = Some(v)
+	return b
+}
+
+// CorePodDNSConfigBuilder fluently fills in a CorePodDNSConfigAssertion that is part of the
+// assertion struct R returned by Build.
+type CorePodDNSConfigBuilder[R any] struct {
+	root *R
+	get  func() *CorePodDNSConfigAssertion
+}
+
+// Build returns the root assertion struct.
+func (b CorePodDNSConfigBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying CorePodDNSConfigAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b CorePodDNSConfigBuilder[R]) With(fn func(*CorePodDNSConfigAssertion)) CorePodDNSConfigBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// Nameservers sets the expected Nameservers.
+func (b CorePodDNSConfigBuilder[R]) Nameservers(v []string) CorePodDNSConfigBuilder[R] {
+	b.get().Nameservers = Some(v)
+	return b
+}
+
+// Searches sets the expected Searches.
+func (b CorePodDNSConfigBuilder[R]) Searches(v []string) CorePodDNSConfigBuilder[R] {
+	b.get().Searches = Some(v)
+	return b
+}
+
+// Options sets Options to exactly the given elements, compared by position.
+func (b CorePodDNSConfigBuilder[R]) Options(v []CorePodDNSConfigOptionAssertion) CorePodDNSConfigBuilder[R] {
+	b.get().Options = Some(v)
+	return b
+}
+
+// Option descends into the element of Options with the given Name,
+// adding it if needed. Options is compared with ByKey semantics.
+func (b CorePodDNSConfigBuilder[R]) Option(name string) CorePodDNSConfigOptionBuilder[R] {
+	get := b.get
+	keyedElement(&get().Options, name)
+	return CorePodDNSConfigOptionBuilder[R]{
+		get: func() *CorePodDNSConfigOptionAssertion {
+			return keyedElement(&get().Options, name)
+		},
+		root: b.root,
+	}
+}
+
+// CorePodDNSConfigOptionBuilder fluently fills in a CorePodDNSConfigOptionAssertion that is part of the
+// assertion struct R returned by Build.
+type CorePodDNSConfigOptionBuilder[R any] struct {
+	root *R
+	get  func() *CorePodDNSConfigOptionAssertion
+}
+
+// Build returns the root assertion struct.
+func (b CorePodDNSConfigOptionBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying CorePodDNSConfigOptionAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b CorePodDNSConfigOptionBuilder[R]) With(fn func(*CorePodDNSConfigOptionAssertion)) CorePodDNSConfigOptionBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// Name sets the expected Name.
+func (b CorePodDNSConfigOptionBuilder[R]) Name(v string) CorePodDNSConfigOptionBuilder[R] {
+	b.get().Name = Some(v)
+	return b
+}
+
+// Value sets the expected Value.
+func (b CorePodDNSConfigOptionBuilder[R]) Value(v string) CorePodDNSConfigOptionBuilder[R] {
+	b.get().Value = SomePtr(v)
+	return b
+}
+
+// PodOSBuilder fluently fills in a PodOSAssertion that is part of the
+// assertion struct R returned by Build.
+type PodOSBuilder[R any] struct {
+	root *R
+	get  func() *PodOSAssertion
+}
+
+// Build returns the root assertion struct.
+func (b PodOSBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying PodOSAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b PodOSBuilder[R]) With(fn func(*PodOSAssertion)) PodOSBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// Name sets the expected Name.
+func (b PodOSBuilder[R]) Name(v v11.OSName) PodOSBuilder[R] {
+	b.get().Name = Some(v)
+	return b
+}
+
+// PodReadinessGateBuilder fluently fills in a PodReadinessGateAssertion that is part of the
+// assertion struct R returned by Build.
+type PodReadinessGateBuilder[R any] struct {
+	root *R
+	get  func() *PodReadinessGateAssertion
+}
+
+// Build returns the root assertion struct.
+func (b PodReadinessGateBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying PodReadinessGateAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b PodReadinessGateBuilder[R]) With(fn func(*PodReadinessGateAssertion)) PodReadinessGateBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// ConditionType sets the expected ConditionType.
+func (b PodReadinessGateBuilder[R]) ConditionType(v v11.PodConditionType) PodReadinessGateBuilder[R] {
+	b.get().ConditionType = Some(v)
+	return b
+}
+
+// PodResourceClaimBuilder fluently fills in a PodResourceClaimAssertion that is part of the
+// assertion struct R returned by Build.
+type PodResourceClaimBuilder[R any] struct {
+	root *R
+	get  func() *PodResourceClaimAssertion
+}
+
+// Build returns the root assertion struct.
+func (b PodResourceClaimBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying PodResourceClaimAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b PodResourceClaimBuilder[R]) With(fn func(*PodResourceClaimAssertion)) PodResourceClaimBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// Name sets the expected Name.
+func (b PodResourceClaimBuilder[R]) Name(v string) PodResourceClaimBuilder[R] {
+	b.get().Name = Some(v)
+	return b
+}
+
+// ResourceClaimName sets the expected ResourceClaimName.
+func (b PodResourceClaimBuilder[R]) ResourceClaimName(v string) PodResourceClaimBuilder[R] {
+	b.get().ResourceClaimName = SomePtr(v)
+	return b
+}
+
+// ResourceClaimTemplateName sets the expected ResourceClaimTemplateName.
+func (b PodResourceClaimBuilder[R]) ResourceClaimTemplateName(v string) PodResourceClaimBuilder[R] {
+	b.get().ResourceClaimTemplateName = SomePtr(v)
+	return b
+}
+
+// PodSchedulingGateBuilder fluently fills in a PodSchedulingGateAssertion that is part of the
+// assertion struct R returned by Build.
+type PodSchedulingGateBuilder[R any] struct {
+	root *R
+	get  func() *PodSchedulingGateAssertion
+}
+
+// Build returns the root assertion struct.
+func (b PodSchedulingGateBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying PodSchedulingGateAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b PodSchedulingGateBuilder[R]) With(fn func(*PodSchedulingGateAssertion)) PodSchedulingGateBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// Name sets the expected Name.
+func (b PodSchedulingGateBuilder[R]) Name(v string) PodSchedulingGateBuilder[R] {
+	b.get().Name = Some(v)
+	return b
+}
+
+// PodSecurityContextBuilder fluently fills in a PodSecurityContextAssertion that is part of the
+// assertion struct R returned by Build.
+type PodSecurityContextBuilder[R any] struct {
+	root *R
+	get  func() *PodSecurityContextAssertion
+}
+
+// Build returns the root assertion struct.
+func (b PodSecurityContextBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying PodSecurityContextAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b PodSecurityContextBuilder[R]) With(fn func(*PodSecurityContextAssertion)) PodSecurityContextBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// SELinuxOptions descends into the SELinuxOptions field.
+func (b PodSecurityContextBuilder[R]) SELinuxOptions() SELinuxOptionsBuilder[R] {
+	get := b.get
+	return SELinuxOptionsBuilder[R]{
+		get: func() *SELinuxOptionsAssertion {
+			return &get().SELinuxOptions
+		},
+		root: b.root,
+	}
+}
+
+// WindowsOptions descends into the WindowsOptions field.
+func (b PodSecurityContextBuilder[R]) WindowsOptions() WindowsSecurityContextOptionsBuilder[R] {
+	get := b.get
+	return WindowsSecurityContextOptionsBuilder[R]{
+		get: func() *WindowsSecurityContextOptionsAssertion {
+			return &get().WindowsOptions
+		},
+		root: b.root,
+	}
+}
+
+// RunAsUser sets the expected RunAsUser.
+func (b PodSecurityContextBuilder[R]) RunAsUser(v int64) PodSecurityContextBuilder[R] {
+	b.get().RunAsUser = SomePtr(v)
+	return b
+}
+
+// RunAsGroup sets the expected RunAsGroup.
+func (b PodSecurityContextBuilder[R]) RunAsGroup(v int64) PodSecurityContextBuilder[R] {
+	b.get().RunAsGroup = SomePtr(v)
+	return b
+}
+
+// RunAsNonRoot sets the expected RunAsNonRoot.
+func (b PodSecurityContextBuilder[R]) RunAsNonRoot(v bool) PodSecurityContextBuilder[R] {
+	b.get().RunAsNonRoot = SomePtr(v)
+	return b
+}
+
+// SupplementalGroups sets the expected SupplementalGroups.
+func (b PodSecurityContextBuilder[R]) SupplementalGroups(v []int64) PodSecurityContextBuilder[R] {
+	b.get().SupplementalGroups = Some(v)
+	return b
+}
+
+// SupplementalGroupsPolicy sets the expected SupplementalGroupsPolicy.
+func (b PodSecurityContextBuilder[R]) SupplementalGroupsPolicy(v v11.SupplementalGroupsPolicy) PodSecurityContextBuilder[R] {
+	b.get().SupplementalGroupsPolicy = SomePtr(v)
+	return b
+}
+
+// FSGroup sets the expected FSGroup.
+func (b PodSecurityContextBuilder[R]) FSGroup(v int64) PodSecurityContextBuilder[R] {
+	b.get().FSGroup = SomePtr(v)
+	return b
+}
+
+// Sysctls sets Sysctls to exactly the given elements, compared by position.
+func (b PodSecurityContextBuilder[R]) Sysctls(v []SysctlAssertion) PodSecurityContextBuilder[R] {
+	b.get().Sysctls = Some(v)
+	return b
+}
+
+// Sysctl descends into the element of Sysctls with the given Name,
+// adding it if needed. Sysctls is compared with ByKey semantics.
+func (b PodSecurityContextBuilder[R]) Sysctl(name string) SysctlBuilder[R] {
+	get := b.get
+	keyedElement(&get().Sysctls, name)
+	return SysctlBuilder[R]{
+		get: func() *SysctlAssertion {
+			return keyedElement(&get().Sysctls, name)
+		},
+		root: b.root,
+	}
+}
+
+// FSGroupChangePolicy sets the expected FSGroupChangePolicy.
+func (b PodSecurityContextBuilder[R]) FSGroupChangePolicy(v v11.PodFSGroupChangePolicy) PodSecurityContextBuilder[R] {
+	b.get().FSGroupChangePolicy = SomePtr(v)
+	return b
+}
+
+// SeccompProfile descends into the SeccompProfile field.
+func (b PodSecurityContextBuilder[R]) SeccompProfile() SeccompProfileBuilder[R] {
+	get := b.get
+	return SeccompProfileBuilder[R]{
+		get: func() *SeccompProfileAssertion {
+			return &get().SeccompProfile
+		},
+		root: b.root,
+	}
+}
+
+// AppArmorProfile descends into the AppArmorProfile field.
+func (b PodSecurityContextBuilder[R]) AppArmorProfile() AppArmorProfileBuilder[R] {
+	get := b.get
+	return AppArmorProfileBuilder[R]{
+		get: func() *AppArmorProfileAssertion {
+			return &get().AppArmorProfile
+		},
+		root: b.root,
+	}
+}
+
+// SELinuxChangePolicy sets the expected SELinuxChangePolicy.
+func (b PodSecurityContextBuilder[R]) SELinuxChangePolicy(v v11.PodSELinuxChangePolicy) PodSecurityContextBuilder[R] {
+	b.get().SELinuxChangePolicy = SomePtr(v)
+	return b
+}
+
+// PodSpecBuilder fluently fills in a PodSpecAssertion that is part of the
+// assertion struct R returned by Build.
+type PodSpecBuilder[R any] struct {
+	root *R
+	get  func() *PodSpecAssertion
+}
+
+// Build returns the root assertion struct.
+func (b PodSpecBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying PodSpecAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b PodSpecBuilder[R]) With(fn func(*PodSpecAssertion)) PodSpecBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// Volumes sets Volumes to exactly the given elements, compared by position.
+func (b PodSpecBuilder[R]) Volumes(v []VolumeAssertion) PodSpecBuilder[R] {
+	b.get().Volumes = Some(v)
+	return b
+}
+
+// Volume descends into the element of Volumes with the given Name,
+// adding it if needed. Volumes is compared with ByKey semantics.
+func (b PodSpecBuilder[R]) Volume(name string) VolumeBuilder[R] {
+	get := b.get
+	keyedElement(&get().Volumes, name)
+	return VolumeBuilder[R]{
+		get: func() *VolumeAssertion {
+			return keyedElement(&get().Volumes, name)
+		},
+		root: b.root,
+	}
+}
+
+// InitContainers sets InitContainers to exactly the given elements, compared by position.
+func (b PodSpecBuilder[R]) InitContainers(v []ContainerAssertion) PodSpecBuilder[R] {
+	b.get().InitContainers = Some(v)
+	return b
+}
+
+// InitContainer descends into the element of InitContainers with the given Name,
+// adding it if needed. InitContainers is compared with ByKey semantics.
+func (b PodSpecBuilder[R]) InitContainer(name string) ContainerBuilder[R] {
+	get := b.get
+	keyedElement(&get().InitContainers, name)
+	return ContainerBuilder[R]{
+		get: func() *ContainerAssertion {
+			return keyedElement(&get().InitContainers, name)
+		},
+		root: b.root,
+	}
+}
+
+// Containers sets Containers to exactly the given elements, compared by position.
+func (b PodSpecBuilder[R]) Containers(v []ContainerAssertion) PodSpecBuilder[R] {
+	b.get().Containers = Some(v)
+	return b
+}
+
+// Container descends into the element of Containers with the given Name,
+// adding it if needed. Containers is compared with ByKey semantics.
+func (b PodSpecBuilder[R]) Container(name string) ContainerBuilder[R] {
+	get := b.get
+	keyedElement(&get().Containers, name)
+	return ContainerBuilder[R]{
+		get: func() *ContainerAssertion {
+			return keyedElement(&get().Containers, name)
+		},
+		root: b.root,
+	}
+}
+
+// EphemeralContainers sets EphemeralContainers to exactly the given elements, compared by position.
+func (b PodSpecBuilder[R]) EphemeralContainers(v []EphemeralContainerAssertion) PodSpecBuilder[R] {
+	b.get().EphemeralContainers = Some(v)
+	return b
+}
+
+// RestartPolicy sets the expected RestartPolicy.
+func (b PodSpecBuilder[R]) RestartPolicy(v v11.RestartPolicy) PodSpecBuilder[R] {
+	b.get().RestartPolicy = Some(v)
+	return b
+}
+
+// TerminationGracePeriodSeconds sets the expected TerminationGracePeriodSeconds.
+func (b PodSpecBuilder[R]) TerminationGracePeriodSeconds(v int64) PodSpecBuilder[R] {
+	b.get().TerminationGracePeriodSeconds = SomePtr(v)
+	return b
+}
+
+// ActiveDeadlineSeconds sets the expected ActiveDeadlineSeconds.
+func (b PodSpecBuilder[R]) ActiveDeadlineSeconds(v int64) PodSpecBuilder[R] {
+	b.get().ActiveDeadlineSeconds = SomePtr(v)
+	return b
+}
+
+// DNSPolicy sets the expected DNSPolicy.
+func (b PodSpecBuilder[R]) DNSPolicy(v v11.DNSPolicy) PodSpecBuilder[R] {
+	b.get().DNSPolicy = Some(v)
+	return b
+}
+
+// NodeSelector sets the expected NodeSelector.
+func (b PodSpecBuilder[R]) NodeSelector(v map[string]string) PodSpecBuilder[R] {
+	b.get().NodeSelector = Some(v)
+	return b
+}
+
+// ServiceAccountName sets the expected ServiceAccountName.
+func (b PodSpecBuilder[R]) ServiceAccountName(v string) PodSpecBuilder[R] {
+	b.get().ServiceAccountName = Some(v)
+	return b
+}
+
+// DeprecatedServiceAccount sets the expected DeprecatedServiceAccount.
+func (b PodSpecBuilder[R]) DeprecatedServiceAccount(v string) PodSpecBuilder[R] {
+	b.get().DeprecatedServiceAccount = Some(v)
+	return b
+}
+
+// AutomountServiceAccountToken sets the expected AutomountServiceAccountToken.
+func (b PodSpecBuilder[R]) AutomountServiceAccountToken(v bool) PodSpecBuilder[R] {
+	b.get().AutomountServiceAccountToken = SomePtr(v)
+	return b
+}
+
+// NodeName sets the expected NodeName.
+func (b PodSpecBuilder[R]) NodeName(v string) PodSpecBuilder[R] {
+	b.get().NodeName = Some(v)
+	return b
+}
+
+// HostNetwork sets the expected HostNetwork.
+func (b PodSpecBuilder[R]) HostNetwork(v bool) PodSpecBuilder[R] {
+	b.get().HostNetwork = Some(v)
+	return b
+}
+
+// HostPID sets the expected HostPID.
+func (b PodSpecBuilder[R]) HostPID(v bool) PodSpecBuilder[R] {
+	b.get().HostPID = Some(v)
+	return b
+}
+
+// HostIPC sets the expected HostIPC.
+func (b PodSpecBuilder[R]) HostIPC(v bool) PodSpecBuilder[R] {
+	b.get().HostIPC = Some(v)
+	return b
+}
+
+// ShareProcessNamespace sets the expected ShareProcessNamespace.
+func (b PodSpecBuilder[R]) ShareProcessNamespace(v bool) PodSpecBuilder[R] {
+	b.get().ShareProcessNamespace = SomePtr(v)
+	return b
+}
+
+// SecurityContext descends into the SecurityContext field.
+func (b PodSpecBuilder[R]) SecurityContext() PodSecurityContextBuilder[R] {
+	get := b.get
+	return PodSecurityContextBuilder[R]{
+		get: func() *PodSecurityContextAssertion {
+			return &get().SecurityContext
+		},
+		root: b.root,
+	}
+}
+
+// ImagePullSecrets sets ImagePullSecrets to exactly the given elements, compared by position.
+func (b PodSpecBuilder[R]) ImagePullSecrets(v []CoreLocalObjectReferenceAssertion) PodSpecBuilder[R] {
+	b.get().ImagePullSecrets = Some(v)
+	return b
+}
+
+// ImagePullSecret descends into the element of ImagePullSecrets with the given Name,
+// adding it if needed. ImagePullSecrets is compared with ByKey semantics.
+func (b PodSpecBuilder[R]) ImagePullSecret(name string) CoreLocalObjectReferenceBuilder[R] {
+	get := b.get
+	keyedElement(&get().ImagePullSecrets, name)
+	return CoreLocalObjectReferenceBuilder[R]{
+		get: func() *CoreLocalObjectReferenceAssertion {
+			return keyedElement(&get().ImagePullSecrets, name)
+		},
+		root: b.root,
+	}
+}
+
+// Hostname sets the expected Hostname.
+func (b PodSpecBuilder[R]) Hostname(v string) PodSpecBuilder[R] {
+	b.get().Hostname = Some(v)
+	return b
+}
+
+// Subdomain sets the expected Subdomain.
+func (b PodSpecBuilder[R]) Subdomain(v string) PodSpecBuilder[R] {
+	b.get().Subdomain = Some(v)
+	return b
+}
+
+// Affinity descends into the Affinity field.
+func (b PodSpecBuilder[R]) Affinity() AffinityBuilder[R] {
+	get := b.get
+	return AffinityBuilder[R]{
+		get: func() *AffinityAssertion {
+			return &get().Affinity
+		},
+		root: b.root,
+	}
+}
+
+// SchedulerName sets the expected SchedulerName.
+func (b PodSpecBuilder[R]) SchedulerName(v string) PodSpecBuilder[R] {
+	b.get().SchedulerName = Some(v)
+	return b
+}
+
+// Tolerations sets Tolerations to exactly the given elements, compared by position.
+func (b PodSpecBuilder[R]) Tolerations(v []TolerationAssertion) PodSpecBuilder[R] {
+	b.get().Tolerations = Some(v)
+	return b
+}
+
+// HostAliases sets HostAliases to exactly the given elements, compared by position.
+func (b PodSpecBuilder[R]) HostAliases(v []CoreHostAliasAssertion) PodSpecBuilder[R] {
+	b.get().HostAliases = Some(v)
+	return b
+}
+
+// PriorityClassName sets the expected PriorityClassName.
+func (b PodSpecBuilder[R]) PriorityClassName(v string) PodSpecBuilder[R] {
+	b.get().PriorityClassName = Some(v)
+	return b
+}
+
+// Priority sets the expected Priority.
+func (b PodSpecBuilder[R]) Priority(v int32) PodSpecBuilder[R] {
+	b.get().Priority = SomePtr(v)
+	return b
+}
+
+// DNSConfig descends into the DNSConfig field.
+func (b PodSpecBuilder[R]) DNSConfig() CorePodDNSConfigBuilder[R] {
+	get := b.get
+	return CorePodDNSConfigBuilder[R]{
+		get: func() *CorePodDNSConfigAssertion {
+			return &get().DNSConfig
+		},
+		root: b.root,
+	}
+}
+
+// ReadinessGates sets ReadinessGates to exactly the given elements, compared by position.
+func (b PodSpecBuilder[R]) ReadinessGates(v []PodReadinessGateAssertion) PodSpecBuilder[R] {
+	b.get().ReadinessGates = Some(v)
+	return b
+}
+
+// RuntimeClassName sets the expected RuntimeClassName.
+func (b PodSpecBuilder[R]) RuntimeClassName(v string) PodSpecBuilder[R] {
+	b.get().RuntimeClassName = SomePtr(v)
+	return b
+}
+
+// EnableServiceLinks sets the expected EnableServiceLinks.
+func (b PodSpecBuilder[R]) EnableServiceLinks(v bool) PodSpecBuilder[R] {
+	b.get().EnableServiceLinks = SomePtr(v)
+	return b
+}
+
+// PreemptionPolicy sets the expected PreemptionPolicy.
+func (b PodSpecBuilder[R]) PreemptionPolicy(v v11.PreemptionPolicy) PodSpecBuilder[R] {
+	b.get().PreemptionPolicy = SomePtr(v)
+	return b
+}
+
+// Overhead sets the expected Overhead.
+func (b PodSpecBuilder[R]) Overhead(v v11.ResourceList) PodSpecBuilder[R] {
+	b.get().Overhead = Some(v)
+	return b
+}
+
+// TopologySpreadConstraints sets TopologySpreadConstraints to exactly the given elements, compared by position.
+func (b PodSpecBuilder[R]) TopologySpreadConstraints(v []CoreTopologySpreadConstraintAssertion) PodSpecBuilder[R] {
+	b.get().TopologySpreadConstraints = Some(v)
+	return b
+}
+
+// SetHostnameAsFQDN sets the expected SetHostnameAsFQDN.
+func (b PodSpecBuilder[R]) SetHostnameAsFQDN(v bool) PodSpecBuilder[R] {
+	b.get().SetHostnameAsFQDN = SomePtr(v)
+	return b
+}
+
+// OS descends into the OS field.
+func (b PodSpecBuilder[R]) OS() PodOSBuilder[R] {
+	get := b.get
+	return PodOSBuilder[R]{
+		get: func() *PodOSAssertion {
+			return &get().OS
+		},
+		root: b.root,
+	}
+}
+
+// HostUsers sets the expected HostUsers.
+func (b PodSpecBuilder[R]) HostUsers(v bool) PodSpecBuilder[R] {
+	b.get().HostUsers = SomePtr(v)
+	return b
+}
+
+// SchedulingGates sets SchedulingGates to exactly the given elements, compared by position.
+func (b PodSpecBuilder[R]) SchedulingGates(v []PodSchedulingGateAssertion) PodSpecBuilder[R] {
+	b.get().SchedulingGates = Some(v)
+	return b
+}
+
+// SchedulingGate descends into the element of SchedulingGates with the given Name,
+// adding it if needed. SchedulingGates is compared with ByKey semantics.
+func (b PodSpecBuilder[R]) SchedulingGate(name string) PodSchedulingGateBuilder[R] {
+	get := b.get
+	keyedElement(&get().SchedulingGates, name)
+	return PodSchedulingGateBuilder[R]{
+		get: func() *PodSchedulingGateAssertion {
+			return keyedElement(&get().SchedulingGates, name)
+		},
+		root: b.root,
+	}
+}
+
+// ResourceClaims sets ResourceClaims to exactly the given elements, compared by position.
+func (b PodSpecBuilder[R]) ResourceClaims(v []PodResourceClaimAssertion) PodSpecBuilder[R] {
+	b.get().ResourceClaims = Some(v)
+	return b
+}
+
+// ResourceClaim descends into the element of ResourceClaims with the given Name,
+// adding it if needed. ResourceClaims is compared with ByKey semantics.
+func (b PodSpecBuilder[R]) ResourceClaim(name string) PodResourceClaimBuilder[R] {
+	get := b.get
+	keyedElement(&get().ResourceClaims, name)
+	return PodResourceClaimBuilder[R]{
+		get: func() *PodResourceClaimAssertion {
+			return keyedElement(&get().ResourceClaims, name)
+		},
+		root: b.root,
+	}
+}
+
+// Resources descends into the Resources field.
+func (b PodSpecBuilder[R]) Resources() ResourceRequirementsBuilder[R] {
+	get := b.get
+	return ResourceRequirementsBuilder[R]{
+		get: func() *ResourceRequirementsAssertion {
+			return &get().Resources
+		},
+		root: b.root,
+	}
+}
+
+// HostnameOverride sets the expected HostnameOverride.
+func (b PodSpecBuilder[R]) HostnameOverride(v string) PodSpecBuilder[R] {
+	b.get().HostnameOverride = SomePtr(v)
+	return b
+}
+
+// WorkloadRef descends into the WorkloadRef field.
+func (b PodSpecBuilder[R]) WorkloadRef() WorkloadReferenceBuilder[R] {
+	get := b.get
+	return WorkloadReferenceBuilder[R]{
+		get: func() *WorkloadReferenceAssertion {
+			return &get().WorkloadRef
+		},
+		root: b.root,
+	}
+}
+
+// PodTemplateSpecBuilder fluently fills in a PodTemplateSpecAssertion that is part of the
+// assertion struct R returned by Build.
+type PodTemplateSpecBuilder[R any] struct {
+	root *R
+	get  func() *PodTemplateSpecAssertion
+}
+
+// Build returns the root assertion struct.
+func (b PodTemplateSpecBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying PodTemplateSpecAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b PodTemplateSpecBuilder[R]) With(fn func(*PodTemplateSpecAssertion)) PodTemplateSpecBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// ObjectMeta descends into the ObjectMeta field.
+func (b PodTemplateSpecBuilder[R]) ObjectMeta() ObjectMetaBuilder[R] {
+	get := b.get
+	return ObjectMetaBuilder[R]{
+		get: func() *ObjectMetaAssertion {
+			return &get().ObjectMeta
+		},
+		root: b.root,
+	}
+}
+
+// Spec descends into the Spec field.
+func (b PodTemplateSpecBuilder[R]) Spec() PodSpecBuilder[R] {
+	get := b.get
+	return PodSpecBuilder[R]{
+		get: func() *PodSpecAssertion {
+			return &get().Spec
+		},
+		root: b.root,
+	}
+}
+
+// PortworxVolumeSourceBuilder fluently fills in a PortworxVolumeSourceAssertion that is part of the
+// assertion struct R returned by Build.
+type PortworxVolumeSourceBuilder[R any] struct {
+	root *R
+	get  func() *PortworxVolumeSourceAssertion
+}
+
+// Build returns the root assertion struct.
+func (b PortworxVolumeSourceBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying PortworxVolumeSourceAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b PortworxVolumeSourceBuilder[R]) With(fn func(*PortworxVolumeSourceAssertion)) PortworxVolumeSourceBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// VolumeID sets the expected VolumeID.
+func (b PortworxVolumeSourceBuilder[R]) VolumeID(v string) PortworxVolumeSourceBuilder[R] {
+	b.get().VolumeID = Some(v)
+	return b
+}
+
+// FSType sets the expected FSType.
+func (b PortworxVolumeSourceBuilder[R]) FSType(v string) PortworxVolumeSourceBuilder[R] {
+	b.get().FSType = Some(v)
+	return b
+}
+
+// ReadOnly sets the expected ReadOnly.
+func (b PortworxVolumeSourceBuilder[R]) ReadOnly(v bool) PortworxVolumeSourceBuilder[R] {
+	b.get().ReadOnly = Some(v)
+	return b
+}
+
+// PreferredSchedulingTermBuilder fluently fills in a PreferredSchedulingTermAssertion that is part of the
+// assertion struct R returned by Build.
+type PreferredSchedulingTermBuilder[R any] struct {
+	root *R
+	get  func() *PreferredSchedulingTermAssertion
+}
+
+// Build returns the root assertion struct.
+func (b PreferredSchedulingTermBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying PreferredSchedulingTermAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b PreferredSchedulingTermBuilder[R]) With(fn func(*PreferredSchedulingTermAssertion)) PreferredSchedulingTermBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// Weight sets the expected Weight.
+func (b PreferredSchedulingTermBuilder[R]) Weight(v int32) PreferredSchedulingTermBuilder[R] {
+	b.get().Weight = Some(v)
+	return b
+}
+
+// Preference descends into the Preference field.
+func (b PreferredSchedulingTermBuilder[R]) Preference() NodeSelectorTermBuilder[R] {
+	get := b.get
+	return NodeSelectorTermBuilder[R]{
+		get: func() *NodeSelectorTermAssertion {
+			return &get().Preference
+		},
+		root: b.root,
+	}
+}
+
+// CoreProbeBuilder fluently fills in a CoreProbeAssertion that is part of the
+// assertion struct R returned by Build.
+type CoreProbeBuilder[R any] struct {
+	root *R
+	get  func() *CoreProbeAssertion
+}
+
+// Build returns the root assertion struct.
+func (b CoreProbeBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying CoreProbeAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b CoreProbeBuilder[R]) With(fn func(*CoreProbeAssertion)) CoreProbeBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// ProbeHandler descends into the ProbeHandler field.
+func (b CoreProbeBuilder[R]) ProbeHandler() ProbeHandlerBuilder[R] {
+	get := b.get
+	return ProbeHandlerBuilder[R]{
+		get: func() *ProbeHandlerAssertion {
+			return &get().ProbeHandler
+		},
+		root: b.root,
+	}
+}
+
+// InitialDelaySeconds sets the expected InitialDelaySeconds.
+func (b CoreProbeBuilder[R]) InitialDelaySeconds(v int32) CoreProbeBuilder[R] {
+	b.get().InitialDelaySeconds = Some(v)
+	return b
+}
+
+// TimeoutSeconds sets the expected TimeoutSeconds.
+func (b CoreProbeBuilder[R]) TimeoutSeconds(v int32) CoreProbeBuilder[R] {
+	b.get().TimeoutSeconds = Some(v)
+	return b
+}
+
+// PeriodSeconds sets the expected PeriodSeconds.
+func (b CoreProbeBuilder[R]) PeriodSeconds(v int32) CoreProbeBuilder[R] {
+	b.get().PeriodSeconds = Some(v)
+	return b
+}
+
+// SuccessThreshold sets the expected SuccessThreshold.
+func (b CoreProbeBuilder[R]) SuccessThreshold(v int32) CoreProbeBuilder[R] {
+	b.get().SuccessThreshold = Some(v)
+	return b
+}
+
+// FailureThreshold sets the expected FailureThreshold.
+func (b CoreProbeBuilder[R]) FailureThreshold(v int32) CoreProbeBuilder[R] {
+	b.get().FailureThreshold = Some(v)
+	return b
+}
+
+// TerminationGracePeriodSeconds sets the expected TerminationGracePeriodSeconds.
+func (b CoreProbeBuilder[R]) TerminationGracePeriodSeconds(v int64) CoreProbeBuilder[R] {
+	b.get().TerminationGracePeriodSeconds = SomePtr(v)
+	return b
+}
+
+// ProbeHandlerBuilder fluently fills in a ProbeHandlerAssertion that is part of the
+// assertion struct R returned by Build.
+type ProbeHandlerBuilder[R any] struct {
+	root *R
+	get  func() *ProbeHandlerAssertion
+}
+
+// Build returns the root assertion struct.
+func (b ProbeHandlerBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying ProbeHandlerAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b ProbeHandlerBuilder[R]) With(fn func(*ProbeHandlerAssertion)) ProbeHandlerBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// Exec descends into the Exec field.
+func (b ProbeHandlerBuilder[R]) Exec() ExecActionBuilder[R] {
+	get := b.get
+	return ExecActionBuilder[R]{
+		get: func() *ExecActionAssertion {
+			return &get().Exec
+		},
+		root: b.root,
+	}
+}
+
+// HTTPGet descends into the HTTPGet field.
+func (b ProbeHandlerBuilder[R]) HTTPGet() HTTPGetActionBuilder[R] {
+	get := b.get
+	return HTTPGetActionBuilder[R]{
+		get: func() *HTTPGetActionAssertion {
+			return &get().HTTPGet
+		},
+		root: b.root,
+	}
+}
+
+// TCPSocket descends into the TCPSocket field.
+func (b ProbeHandlerBuilder[R]) TCPSocket() TCPSocketActionBuilder[R] {
+	get := b.get
+	return TCPSocketActionBuilder[R]{
+		get: func() *TCPSocketActionAssertion {
+			return &get().TCPSocket
+		},
+		root: b.root,
+	}
+}
+
+// GRPC descends into the GRPC field.
+func (b ProbeHandlerBuilder[R]) GRPC() GRPCActionBuilder[R] {
+	get := b.get
+	return GRPCActionBuilder[R]{
+		get: func() *GRPCActionAssertion {
+			return &get().GRPC
+		},
+		root: b.root,
+	}
+}
+
+// ProjectedVolumeSourceBuilder fluently fills in a ProjectedVolumeSourceAssertion that is part of the
+// assertion struct R returned by Build.
+type ProjectedVolumeSourceBuilder[R any] struct {
+	root *R
+	get  func() *ProjectedVolumeSourceAssertion
+}
+
+// Build returns the root assertion struct.
+func (b ProjectedVolumeSourceBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying ProjectedVolumeSourceAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b ProjectedVolumeSourceBuilder[R]) With(fn func(*ProjectedVolumeSourceAssertion)) ProjectedVolumeSourceBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// Sources sets Sources to exactly the given elements, compared by position.
+func (b ProjectedVolumeSourceBuilder[R]) Sources(v []VolumeProjectionAssertion) ProjectedVolumeSourceBuilder[R] {
+	b.get().Sources = Some(v)
+	return b
+}
+
+// DefaultMode sets the expected DefaultMode.
+func (b ProjectedVolumeSourceBuilder[R]) DefaultMode(v int32) ProjectedVolumeSourceBuilder[R] {
+	b.get().DefaultMode = SomePtr(v)
+	return b
+}
+
+// QuobyteVolumeSourceBuilder fluently fills in a QuobyteVolumeSourceAssertion that is part of the
+// assertion struct R returned by Build.
+type QuobyteVolumeSourceBuilder[R any] struct {
+	root *R
+	get  func() *QuobyteVolumeSourceAssertion
+}
+
+// Build returns the root assertion struct.
+func (b QuobyteVolumeSourceBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying QuobyteVolumeSourceAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b QuobyteVolumeSourceBuilder[R]) With(fn func(*QuobyteVolumeSourceAssertion)) QuobyteVolumeSourceBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// Registry sets the expected Registry.
+func (b QuobyteVolumeSourceBuilder[R]) Registry(v string) QuobyteVolumeSourceBuilder[R] {
+	b.get().Registry = Some(v)
+	return b
+}
+
+// Volume sets the expected Volume.
+func (b QuobyteVolumeSourceBuilder[R]) Volume(v string) QuobyteVolumeSourceBuilder[R] {
+	b.get().Volume = Some(v)
+	return b
+}
+
+// ReadOnly sets the expected ReadOnly.
+func (b QuobyteVolumeSourceBuilder[R]) ReadOnly(v bool) QuobyteVolumeSourceBuilder[R] {
+	b.get().ReadOnly = Some(v)
+	return b
+}
+
+// User sets the expected User.
+func (b QuobyteVolumeSourceBuilder[R]) User(v string) QuobyteVolumeSourceBuilder[R] {
+	b.get().User = Some(v)
+	return b
+}
+
+// Group sets the expected Group.
+func (b QuobyteVolumeSourceBuilder[R]) Group(v string) QuobyteVolumeSourceBuilder[R] {
+	b.get().Group = Some(v)
+	return b
+}
+
+// Tenant sets the expected Tenant.
+func (b QuobyteVolumeSourceBuilder[R]) Tenant(v string) QuobyteVolumeSourceBuilder[R] {
+	b.get().Tenant = Some(v)
+	return b
+}
+
+// RBDVolumeSourceBuilder fluently fills in a RBDVolumeSourceAssertion that is part of the
+// assertion struct R returned by Build.
+type RBDVolumeSourceBuilder[R any] struct {
+	root *R
+	get  func() *RBDVolumeSourceAssertion
+}
+
+// Build returns the root assertion struct.
+func (b RBDVolumeSourceBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying RBDVolumeSourceAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b RBDVolumeSourceBuilder[R]) With(fn func(*RBDVolumeSourceAssertion)) RBDVolumeSourceBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// CephMonitors sets the expected CephMonitors.
+func (b RBDVolumeSourceBuilder[R]) CephMonitors(v []string) RBDVolumeSourceBuilder[R] {
+	b.get().CephMonitors = Some(v)
+	return b
+}
+
+// RBDImage sets the expected RBDImage.
+func (b RBDVolumeSourceBuilder[R]) RBDImage(v string) RBDVolumeSourceBuilder[R] {
+	b.get().RBDImage = Some(v)
+	return b
+}
+
+// FSType sets the expected FSType.
+func (b RBDVolumeSourceBuilder[R]) FSType(v string) RBDVolumeSourceBuilder[R] {
+	b.get().FSType = Some(v)
+	return b
+}
+
+// RBDPool sets the expected RBDPool.
+func (b RBDVolumeSourceBuilder[R]) RBDPool(v string) RBDVolumeSourceBuilder[R] {
+	b.get().RBDPool = Some(v)
+	return b
+}
+
+// RadosUser sets the expected RadosUser.
+func (b RBDVolumeSourceBuilder[R]) RadosUser(v string) RBDVolumeSourceBuilder[R] {
+	b.get().RadosUser = Some(v)
+	return b
+}
+
+// Keyring sets the expected Keyring.
+func (b RBDVolumeSourceBuilder[R]) Keyring(v string) RBDVolumeSourceBuilder[R] {
+	b.get().Keyring = Some(v)
+	return b
+}
+
+// SecretRef descends into the SecretRef field.
+func (b RBDVolumeSourceBuilder[R]) SecretRef() CoreLocalObjectReferenceBuilder[R] {
+	get := b.get
+	return CoreLocalObjectReferenceBuilder[R]{
+		get: func() *CoreLocalObjectReferenceAssertion {
+			return &get().SecretRef
+		},
+		root: b.root,
+	}
+}
+
+// ReadOnly sets the expected ReadOnly.
+func (b RBDVolumeSourceBuilder[R]) ReadOnly(v bool) RBDVolumeSourceBuilder[R] {
+	b.get().ReadOnly = Some(v)
+	return b
+}
+
+// ResourceClaimBuilder fluently fills in a ResourceClaimAssertion that is part of the
+// assertion struct R returned by Build.
+type ResourceClaimBuilder[R any] struct {
+	root *R
+	get  func() *ResourceClaimAssertion
+}
+
+// Build returns the root assertion struct.
+func (b ResourceClaimBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying ResourceClaimAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b ResourceClaimBuilder[R]) With(fn func(*ResourceClaimAssertion)) ResourceClaimBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// Name sets the expected Name.
+func (b ResourceClaimBuilder[R]) Name(v string) ResourceClaimBuilder[R] {
+	b.get().Name = Some(v)
+	return b
+}
+
+// Request sets the expected Request.
+func (b ResourceClaimBuilder[R]) Request(v string) ResourceClaimBuilder[R] {
+	b.get().Request = Some(v)
+	return b
+}
+
+// ResourceFieldSelectorBuilder fluently fills in a ResourceFieldSelectorAssertion that is part of the
+// assertion struct R returned by Build.
+type ResourceFieldSelectorBuilder[R any] struct {
+	root *R
+	get  func() *ResourceFieldSelectorAssertion
+}
+
+// Build returns the root assertion struct.
+func (b ResourceFieldSelectorBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying ResourceFieldSelectorAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b ResourceFieldSelectorBuilder[R]) With(fn func(*ResourceFieldSelectorAssertion)) ResourceFieldSelectorBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// ContainerName sets the expected ContainerName.
+func (b ResourceFieldSelectorBuilder[R]) ContainerName(v string) ResourceFieldSelectorBuilder[R] {
+	b.get().ContainerName = Some(v)
+	return b
+}
+
+// Resource sets the expected Resource.
+func (b ResourceFieldSelectorBuilder[R]) Resource(v string) ResourceFieldSelectorBuilder[R] {
+	b.get().Resource = Some(v)
+	return b
+}
+
+// Divisor descends into the Divisor field.
+func (b ResourceFieldSelectorBuilder[R]) Divisor() QuantityBuilder[R] {
+	get := b.get
+	return QuantityBuilder[R]{
+		get: func() *QuantityAssertion {
+			return &get().Divisor
+		},
+		root: b.root,
+	}
+}
+
+// ResourceRequirementsBuilder fluently fills in a ResourceRequirementsAssertion that is part of the
+// assertion struct R returned by Build.
+type ResourceRequirementsBuilder[R any] struct {
+	root *R
+	get  func() *ResourceRequirementsAssertion
+}
+
+// Build returns the root assertion struct.
+func (b ResourceRequirementsBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying ResourceRequirementsAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b ResourceRequirementsBuilder[R]) With(fn func(*ResourceRequirementsAssertion)) ResourceRequirementsBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// Limits sets the expected Limits.
+func (b ResourceRequirementsBuilder[R]) Limits(v v11.ResourceList) ResourceRequirementsBuilder[R] {
+	b.get().Limits = Some(v)
+	return b
+}
+
+// Requests sets the expected Requests.
+func (b ResourceRequirementsBuilder[R]) Requests(v v11.ResourceList) ResourceRequirementsBuilder[R] {
+	b.get().Requests = Some(v)
+	return b
+}
+
+// Claims sets Claims to exactly the given elements, compared by position.
+func (b ResourceRequirementsBuilder[R]) Claims(v []ResourceClaimAssertion) ResourceRequirementsBuilder[R] {
+	b.get().Claims = Some(v)
+	return b
+}
+
+// Claim descends into the element of Claims with the given Name,
+// adding it if needed. Claims is compared with ByKey semantics.
+func (b ResourceRequirementsBuilder[R]) Claim(name string) ResourceClaimBuilder[R] {
+	get := b.get
+	keyedElement(&get().Claims, name)
+	return ResourceClaimBuilder[R]{
+		get: func() *ResourceClaimAssertion {
+			return keyedElement(&get().Claims, name)
+		},
+		root: b.root,
+	}
+}
+
+// SELinuxOptionsBuilder fluently fills in a SELinuxOptionsAssertion that is part of the
+// assertion struct R returned by Build.
+type SELinuxOptionsBuilder[R any] struct {
+	root *R
+	get  func() *SELinuxOptionsAssertion
+}
+
+// Build returns the root assertion struct.
+func (b SELinuxOptionsBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying SELinuxOptionsAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b SELinuxOptionsBuilder[R]) With(fn func(*SELinuxOptionsAssertion)) SELinuxOptionsBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// User sets the expected User.
+func (b SELinuxOptionsBuilder[R]) User(v string) SELinuxOptionsBuilder[R] {
+	b.get().User = Some(v)
+	return b
+}
+
+// Role sets the expected Role.
+func (b SELinuxOptionsBuilder[R]) Role(v string) SELinuxOptionsBuilder[R] {
+	b.get().Role = Some(v)
+	return b
+}
+
+// Type sets the expected Type.
+func (b SELinuxOptionsBuilder[R]) Type(v string) SELinuxOptionsBuilder[R] {
+	b.get().Type = Some(v)
+	return b
+}
+
+// Level sets the expected Level.
+func (b SELinuxOptionsBuilder[R]) Level(v string) SELinuxOptionsBuilder[R] {
+	b.get().Level = Some(v)
+	return b
+}
+
+// ScaleIOVolumeSourceBuilder fluently fills in a ScaleIOVolumeSourceAssertion that is part of the
+// assertion struct R returned by Build.
+type ScaleIOVolumeSourceBuilder[R any] struct {
+	root *R
+	get  func() *ScaleIOVolumeSourceAssertion
+}
+
+// Build returns the root assertion struct.
+func (b ScaleIOVolumeSourceBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying ScaleIOVolumeSourceAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b ScaleIOVolumeSourceBuilder[R]) With(fn func(*ScaleIOVolumeSourceAssertion)) ScaleIOVolumeSourceBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// Gateway sets the expected Gateway.
+func (b ScaleIOVolumeSourceBuilder[R]) Gateway(v string) ScaleIOVolumeSourceBuilder[R] {
+	b.get().Gateway = Some(v)
+	return b
+}
+
+// System sets the expected System.
+func (b ScaleIOVolumeSourceBuilder[R]) System(v string) ScaleIOVolumeSourceBuilder[R] {
+	b.get().System = Some(v)
+	return b
+}
+
+// SecretRef descends into the SecretRef field.
+func (b ScaleIOVolumeSourceBuilder[R]) SecretRef() CoreLocalObjectReferenceBuilder[R] {
+	get := b.get
+	return CoreLocalObjectReferenceBuilder[R]{
+		get: func() *CoreLocalObjectReferenceAssertion {
+			return &get().SecretRef
+		},
+		root: b.root,
+	}
+}
+
+// SSLEnabled sets the expected SSLEnabled.
+func (b ScaleIOVolumeSourceBuilder[R]) SSLEnabled(v bool) ScaleIOVolumeSourceBuilder[R] {
+	b.get().SSLEnabled = Some(v)
+	return b
+}
+
+// ProtectionDomain sets the expected ProtectionDomain.
+func (b ScaleIOVolumeSourceBuilder[R]) ProtectionDomain(v string) ScaleIOVolumeSourceBuilder[R] {
+	b.get().ProtectionDomain = Some(v)
+	return b
+}
+
+// StoragePool sets the expected StoragePool.
+func (b ScaleIOVolumeSourceBuilder[R]) StoragePool(v string) ScaleIOVolumeSourceBuilder[R] {
+	b.get().StoragePool = Some(v)
+	return b
+}
+
+// StorageMode sets the expected StorageMode.
+func (b ScaleIOVolumeSourceBuilder[R]) StorageMode(v string) ScaleIOVolumeSourceBuilder[R] {
+	b.get().StorageMode = Some(v)
+	return b
+}
+
+// VolumeName sets the expected VolumeName.
+func (b ScaleIOVolumeSourceBuilder[R]) VolumeName(v string) ScaleIOVolumeSourceBuilder[R] {
+	b.get().VolumeName = Some(v)
+	return b
+}
+
+// FSType sets the expected FSType.
+func (b ScaleIOVolumeSourceBuilder[R]) FSType(v string) ScaleIOVolumeSourceBuilder[R] {
+	b.get().FSType = Some(v)
+	return b
+}
+
+// ReadOnly sets the expected ReadOnly.
+func (b ScaleIOVolumeSourceBuilder[R]) ReadOnly(v bool) ScaleIOVolumeSourceBuilder[R] {
+	b.get().ReadOnly = Some(v)
+	return b
+}
+
+// SeccompProfileBuilder fluently fills in a SeccompProfileAssertion that is part of the
+// assertion struct R returned by Build.
+type SeccompProfileBuilder[R any] struct {
+	root *R
+	get  func() *SeccompProfileAssertion
+}
+
+// Build returns the root assertion struct.
+func (b SeccompProfileBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying SeccompProfileAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b SeccompProfileBuilder[R]) With(fn func(*SeccompProfileAssertion)) SeccompProfileBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// Type sets the expected Type.
+func (b SeccompProfileBuilder[R]) Type(v v11.SeccompProfileType) SeccompProfileBuilder[R] {
+	b.get().Type = Some(v)
+	return b
+}
+
+// LocalhostProfile sets the expected LocalhostProfile.
+func (b SeccompProfileBuilder[R]) LocalhostProfile(v string) SeccompProfileBuilder[R] {
+	b.get().LocalhostProfile = SomePtr(v)
+	return b
+}
+
+// SecretBuilder fluently fills in a SecretAssertion that is part of the
+// assertion struct R returned by Build.
+type SecretBuilder[R any] struct {
+	root *R
+	get  func() *SecretAssertion
+}
+
+// Build returns the root assertion struct.
+func (b SecretBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying SecretAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b SecretBuilder[R]) With(fn func(*SecretAssertion)) SecretBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// TypeMeta descends into the TypeMeta field.
+func (b SecretBuilder[R]) TypeMeta() TypeMetaBuilder[R] {
+	get := b.get
+	return TypeMetaBuilder[R]{
+		get: func() *TypeMetaAssertion {
+			return &get().TypeMeta
+		},
+		root: b.root,
+	}
+}
+
+// ObjectMeta descends into the ObjectMeta field.
+func (b SecretBuilder[R]) ObjectMeta() ObjectMetaBuilder[R] {
+	get := b.get
+	return ObjectMetaBuilder[R]{
+		get: func() *ObjectMetaAssertion {
+			return &get().ObjectMeta
+		},
+		root: b.root,
+	}
+}
+
+// Immutable sets the expected Immutable.
+func (b SecretBuilder[R]) Immutable(v bool) SecretBuilder[R] {
+	b.get().Immutable = SomePtr(v)
+	return b
+}
+
+// Data sets the expected Data.
+func (b SecretBuilder[R]) Data(v map[string][]byte) SecretBuilder[R] {
+	b.get().Data = Some(v)
+	return b
+}
+
+// StringData sets the expected StringData.
+func (b SecretBuilder[R]) StringData(v map[string]string) SecretBuilder[R] {
+	b.get().StringData = Some(v)
+	return b
+}
+
+// Type sets the expected Type.
+func (b SecretBuilder[R]) Type(v v11.SecretType) SecretBuilder[R] {
+	b.get().Type = Some(v)
+	return b
+}
+
+// SecretEnvSourceBuilder fluently fills in a SecretEnvSourceAssertion that is part of the
+// assertion struct R returned by Build.
+type SecretEnvSourceBuilder[R any] struct {
+	root *R
+	get  func() *SecretEnvSourceAssertion
+}
+
+// Build returns the root assertion struct.
+func (b SecretEnvSourceBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying SecretEnvSourceAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b SecretEnvSourceBuilder[R]) With(fn func(*SecretEnvSourceAssertion)) SecretEnvSourceBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// LocalObjectReference descends into the LocalObjectReference field.
+func (b SecretEnvSourceBuilder[R]) LocalObjectReference() CoreLocalObjectReferenceBuilder[R] {
+	get := b.get
+	return CoreLocalObjectReferenceBuilder[R]{
+		get: func() *CoreLocalObjectReferenceAssertion {
+			return &get().LocalObjectReference
+		},
+		root: b.root,
+	}
+}
+
+// Optional sets the expected Optional.
+func (b SecretEnvSourceBuilder[R]) Optional(v bool) SecretEnvSourceBuilder[R] {
+	b.get().Optional = SomePtr(v)
+	return b
+}
+
+// SecretKeySelectorBuilder fluently fills in a SecretKeySelectorAssertion that is part of the
+// assertion struct R returned by Build.
+type SecretKeySelectorBuilder[R any] struct {
+	root *R
+	get  func() *SecretKeySelectorAssertion
+}
+
+// Build returns the root assertion struct.
+func (b SecretKeySelectorBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying SecretKeySelectorAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b SecretKeySelectorBuilder[R]) With(fn func(*SecretKeySelectorAssertion)) SecretKeySelectorBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// LocalObjectReference descends into the LocalObjectReference field.
+func (b SecretKeySelectorBuilder[R]) LocalObjectReference() CoreLocalObjectReferenceBuilder[R] {
+	get := b.get
+	return CoreLocalObjectReferenceBuilder[R]{
+		get: func() *CoreLocalObjectReferenceAssertion {
+			return &get().LocalObjectReference
+		},
+		root: b.root,
+	}
+}
+
+// Key sets the expected Key.
+func (b SecretKeySelectorBuilder[R]) Key(v string) SecretKeySelectorBuilder[R] {
+	b.get().Key = Some(v)
+	return b
+}
+
+// Optional sets the expected Optional.
+func (b SecretKeySelectorBuilder[R]) Optional(v bool) SecretKeySelectorBuilder[R] {
+	b.get().Optional = SomePtr(v)
+	return b
+}
+
+// SecretProjectionBuilder fluently fills in a SecretProjectionAssertion that is part of the
+// assertion struct R returned by Build.
+type SecretProjectionBuilder[R any] struct {
+	root *R
+	get  func() *SecretProjectionAssertion
+}
+
+// Build returns the root assertion struct.
+func (b SecretProjectionBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying SecretProjectionAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b SecretProjectionBuilder[R]) With(fn func(*SecretProjectionAssertion)) SecretProjectionBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// LocalObjectReference descends into the LocalObjectReference field.
+func (b SecretProjectionBuilder[R]) LocalObjectReference() CoreLocalObjectReferenceBuilder[R] {
+	get := b.get
+	return CoreLocalObjectReferenceBuilder[R]{
+		get: func() *CoreLocalObjectReferenceAssertion {
+			return &get().LocalObjectReference
+		},
+		root: b.root,
+	}
+}
+
+// Items sets Items to exactly the given elements, compared by position.
+func (b SecretProjectionBuilder[R]) Items(v []KeyToPathAssertion) SecretProjectionBuilder[R] {
+	b.get().Items = Some(v)
+	return b
+}
+
+// Optional sets the expected Optional.
+func (b SecretProjectionBuilder[R]) Optional(v bool) SecretProjectionBuilder[R] {
+	b.get().Optional = SomePtr(v)
+	return b
+}
+
+// SecretVolumeSourceBuilder fluently fills in a SecretVolumeSourceAssertion that is part of the
+// assertion struct R returned by Build.
+type SecretVolumeSourceBuilder[R any] struct {
+	root *R
+	get  func() *SecretVolumeSourceAssertion
+}
+
+// Build returns the root assertion struct.
+func (b SecretVolumeSourceBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying SecretVolumeSourceAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b SecretVolumeSourceBuilder[R]) With(fn func(*SecretVolumeSourceAssertion)) SecretVolumeSourceBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// SecretName sets the expected SecretName.
+func (b SecretVolumeSourceBuilder[R]) SecretName(v string) SecretVolumeSourceBuilder[R] {
+	b.get().SecretName = Some(v)
+	return b
+}
+
+// Items sets Items to exactly the given elements, compared by position.
+func (b SecretVolumeSourceBuilder[R]) Items(v []KeyToPathAssertion) SecretVolumeSourceBuilder[R] {
+	b.get().Items = Some(v)
+	return b
+}
+
+// DefaultMode sets the expected DefaultMode.
+func (b SecretVolumeSourceBuilder[R]) DefaultMode(v int32) SecretVolumeSourceBuilder[R] {
+	b.get().DefaultMode = SomePtr(v)
+	return b
+}
+
+// Optional sets the expected Optional.
+func (b SecretVolumeSourceBuilder[R]) Optional(v bool) SecretVolumeSourceBuilder[R] {
+	b.get().Optional = SomePtr(v)
+	return b
+}
+
+// SecurityContextBuilder fluently fills in a SecurityContextAssertion that is part of the
+// assertion struct R returned by Build.
+type SecurityContextBuilder[R any] struct {
+	root *R
+	get  func() *SecurityContextAssertion
+}
+
+// Build returns the root assertion struct.
+func (b SecurityContextBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying SecurityContextAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b SecurityContextBuilder[R]) With(fn func(*SecurityContextAssertion)) SecurityContextBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// Capabilities descends into the Capabilities field.
+func (b SecurityContextBuilder[R]) Capabilities() CapabilitiesBuilder[R] {
+	get := b.get
+	return CapabilitiesBuilder[R]{
+		get: func() *CapabilitiesAssertion {
+			return &get().Capabilities
+		},
+		root: b.root,
+	}
+}
+
+// Privileged sets the expected Privileged.
+func (b SecurityContextBuilder[R]) Privileged(v bool) SecurityContextBuilder[R] {
+	b.get().Privileged = SomePtr(v)
+	return b
+}
+
+// SELinuxOptions descends into the SELinuxOptions field.
+func (b SecurityContextBuilder[R]) SELinuxOptions() SELinuxOptionsBuilder[R] {
+	get := b.get
+	return SELinuxOptionsBuilder[R]{
+		get: func() *SELinuxOptionsAssertion {
+			return &get().SELinuxOptions
+		},
+		root: b.root,
+	}
+}
+
+// WindowsOptions descends into the WindowsOptions field.
+func (b SecurityContextBuilder[R]) WindowsOptions() WindowsSecurityContextOptionsBuilder[R] {
+	get := b.get
+	return WindowsSecurityContextOptionsBuilder[R]{
+		get: func() *WindowsSecurityContextOptionsAssertion {
+			return &get().WindowsOptions
+		},
+		root: b.root,
+	}
+}
+
+// RunAsUser sets the expected RunAsUser.
+func (b SecurityContextBuilder[R]) RunAsUser(v int64) SecurityContextBuilder[R] {
+	b.get().RunAsUser = SomePtr(v)
+	return b
+}
+
+// RunAsGroup sets the expected RunAsGroup.
+func (b SecurityContextBuilder[R]) RunAsGroup(v int64) SecurityContextBuilder[R] {
+	b.get().RunAsGroup = SomePtr(v)
+	return b
+}
+
+// RunAsNonRoot sets the expected RunAsNonRoot.
+func (b SecurityContextBuilder[R]) RunAsNonRoot(v bool) SecurityContextBuilder[R] {
+	b.get().RunAsNonRoot = SomePtr(v)
+	return b
+}
+
+// ReadOnlyRootFilesystem sets the expected ReadOnlyRootFilesystem.
+func (b SecurityContextBuilder[R]) ReadOnlyRootFilesystem(v bool) SecurityContextBuilder[R] {
+	b.get().ReadOnlyRootFilesystem = SomePtr(v)
+	return b
+}
+
+// AllowPrivilegeEscalation sets the expected AllowPrivilegeEscalation.
+func (b SecurityContextBuilder[R]) AllowPrivilegeEscalation(v bool) SecurityContextBuilder[R] {
+	b.get().AllowPrivilegeEscalation = SomePtr(v)
+	return b
+}
+
+// ProcMount sets the expected ProcMount.
+func (b SecurityContextBuilder[R]) ProcMount(v v11.ProcMountType) SecurityContextBuilder[R] {
+	b.get().ProcMount = SomePtr(v)
+	return b
+}
+
+// SeccompProfile descends into the SeccompProfile field.
+func (b SecurityContextBuilder[R]) SeccompProfile() SeccompProfileBuilder[R] {
+	get := b.get
+	return SeccompProfileBuilder[R]{
+		get: func() *SeccompProfileAssertion {
+			return &get().SeccompProfile
+		},
+		root: b.root,
+	}
+}
+
+// AppArmorProfile descends into the AppArmorProfile field.
+func (b SecurityContextBuilder[R]) AppArmorProfile() AppArmorProfileBuilder[R] {
+	get := b.get
+	return AppArmorProfileBuilder[R]{
+		get: func() *AppArmorProfileAssertion {
+			return &get().AppArmorProfile
+		},
+		root: b.root,
+	}
+}
+
+// ServiceBuilder fluently fills in a ServiceAssertion that is part of the
+// assertion struct R returned by Build.
+type ServiceBuilder[R any] struct {
+	root *R
+	get  func() *ServiceAssertion
+}
+
+// Build returns the root assertion struct.
+func (b ServiceBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying ServiceAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b ServiceBuilder[R]) With(fn func(*ServiceAssertion)) ServiceBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// TypeMeta descends into the TypeMeta field.
+func (b ServiceBuilder[R]) TypeMeta() TypeMetaBuilder[R] {
+	get := b.get
+	return TypeMetaBuilder[R]{
+		get: func() *TypeMetaAssertion {
+			return &get().TypeMeta
+		},
+		root: b.root,
+	}
+}
+
+// ObjectMeta descends into the ObjectMeta field.
+func (b ServiceBuilder[R]) ObjectMeta() ObjectMetaBuilder[R] {
+	get := b.get
+	return ObjectMetaBuilder[R]{
+		get: func() *ObjectMetaAssertion {
+			return &get().ObjectMeta
+		},
+		root: b.root,
+	}
+}
+
+// Spec descends into the Spec field.
+func (b ServiceBuilder[R]) Spec() ServiceSpecBuilder[R] {
+	get := b.get
+	return ServiceSpecBuilder[R]{
+		get: func() *ServiceSpecAssertion {
+			return &get().Spec
+		},
+		root: b.root,
+	}
+}
+
+// Status sets the expected Status.
+func (b ServiceBuilder[R]) Status(v v11.ServiceStatus) ServiceBuilder[R] {
+	b.get().Status = Some(v)
+	return b
+}
+
+// ServiceAccountBuilder fluently fills in a ServiceAccountAssertion that is part of the
+// assertion struct R returned by Build.
+type ServiceAccountBuilder[R any] struct {
+	root *R
+	get  func() *ServiceAccountAssertion
+}
+
+// Build returns the root assertion struct.
+func (b ServiceAccountBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying ServiceAccountAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b ServiceAccountBuilder[R]) With(fn func(*ServiceAccountAssertion)) ServiceAccountBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// TypeMeta descends into the TypeMeta field.
+func (b ServiceAccountBuilder[R]) TypeMeta() TypeMetaBuilder[R] {
+	get := b.get
+	return TypeMetaBuilder[R]{
+		get: func() *TypeMetaAssertion {
+			return &get().TypeMeta
+		},
+		root: b.root,
+	}
+}
+
+// ObjectMeta descends into the ObjectMeta field.
+func (b ServiceAccountBuilder[R]) ObjectMeta() ObjectMetaBuilder[R] {
+	get := b.get
+	return ObjectMetaBuilder[R]{
+		get: func() *ObjectMetaAssertion {
+			return &get().ObjectMeta
+		},
+		root: b.root,
+	}
+}
+
+// Secrets sets Secrets to exactly the given elements, compared by position.
+func (b ServiceAccountBuilder[R]) Secrets(v []CoreObjectReferenceAssertion) ServiceAccountBuilder[R] {
+	b.get().Secrets = Some(v)
+	return b
+}
+
+// Secret descends into the element of Secrets with the given Name,
+// adding it if needed. Secrets is compared with ByKey semantics.
+func (b ServiceAccountBuilder[R]) Secret(name string) CoreObjectReferenceBuilder[R] {
+	get := b.get
+	keyedElement(&get().Secrets, name)
+	return CoreObjectReferenceBuilder[R]{
+		get: func() *CoreObjectReferenceAssertion {
+			return keyedElement(&get().Secrets, name)
+		},
+		root: b.root,
+	}
+}
+
+// ImagePullSecrets sets ImagePullSecrets to exactly the given elements, compared by position.
+func (b ServiceAccountBuilder[R]) ImagePullSecrets(v []CoreLocalObjectReferenceAssertion) ServiceAccountBuilder[R] {
+	b.get().ImagePullSecrets = Some(v)
+	return b
+}
+
+// ImagePullSecret descends into the element of ImagePullSecrets with the given Name,
+// adding it if needed. ImagePullSecrets is compared with ByKey semantics.
+func (b ServiceAccountBuilder[R]) ImagePullSecret(name string) CoreLocalObjectReferenceBuilder[R] {
+	get := b.get
+	keyedElement(&get().ImagePullSecrets, name)
+	return CoreLocalObjectReferenceBuilder[R]{
+		get: func() *CoreLocalObjectReferenceAssertion {
+			return keyedElement(&get().ImagePullSecrets, name)
+		},
+		root: b.root,
+	}
+}
+
+// AutomountServiceAccountToken sets the expected AutomountServiceAccountToken.
+func (b ServiceAccountBuilder[R]) AutomountServiceAccountToken(v bool) ServiceAccountBuilder[R] {
+	b.get().AutomountServiceAccountToken = SomePtr(v)
+	return b
+}
+
+// ServiceAccountTokenProjectionBuilder fluently fills in a ServiceAccountTokenProjectionAssertion that is part of the
+// assertion struct R returned by Build.
+type ServiceAccountTokenProjectionBuilder[R any] struct {
+	root *R
+	get  func() *ServiceAccountTokenProjectionAssertion
+}
+
+// Build returns the root assertion struct.
+func (b ServiceAccountTokenProjectionBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying ServiceAccountTokenProjectionAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b ServiceAccountTokenProjectionBuilder[R]) With(fn func(*ServiceAccountTokenProjectionAssertion)) ServiceAccountTokenProjectionBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// Audience sets the expected Audience.
+func (b ServiceAccountTokenProjectionBuilder[R]) Audience(v string) ServiceAccountTokenProjectionBuilder[R] {
+	b.get().Audience = Some(v)
+	return b
+}
+
+// ExpirationSeconds sets the expected ExpirationSeconds.
+func (b ServiceAccountTokenProjectionBuilder[R]) ExpirationSeconds(v int64) ServiceAccountTokenProjectionBuilder[R] {
+	b.get().ExpirationSeconds = SomePtr(v)
+	return b
+}
+
+// Path sets the expected Path.
+func (b ServiceAccountTokenProjectionBuilder[R]) Path(v string) ServiceAccountTokenProjectionBuilder[R] {
+	b.get().Path = Some(v)
+	return b
+}
+
+// ServicePortBuilder fluently fills in a ServicePortAssertion that is part of the
+// assertion struct R returned by Build.
+type ServicePortBuilder[R any] struct {
+	root *R
+	get  func() *ServicePortAssertion
+}
+
+// Build returns the root assertion struct.
+func (b ServicePortBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying ServicePortAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b ServicePortBuilder[R]) With(fn func(*ServicePortAssertion)) ServicePortBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// Name sets the expected Name.
+func (b ServicePortBuilder[R]) Name(v string) ServicePortBuilder[R] {
+	b.get().Name = Some(v)
+	return b
+}
+
+// Protocol sets the expected Protocol.
+func (b ServicePortBuilder[R]) Protocol(v v11.Protocol) ServicePortBuilder[R] {
+	b.get().Protocol = Some(v)
+	return b
+}
+
+// AppProtocol sets the expected AppProtocol.
+func (b ServicePortBuilder[R]) AppProtocol(v string) ServicePortBuilder[R] {
+	b.get().AppProtocol = SomePtr(v)
+	return b
+}
+
+// Port sets the expected Port.
+func (b ServicePortBuilder[R]) Port(v int32) ServicePortBuilder[R] {
+	b.get().Port = Some(v)
+	return b
+}
+
+// TargetPort descends into the TargetPort field.
+func (b ServicePortBuilder[R]) TargetPort() IntOrStringBuilder[R] {
+	get := b.get
+	return IntOrStringBuilder[R]{
+		get: func() *IntOrStringAssertion {
+			return &get().TargetPort
+		},
+		root: b.root,
+	}
+}
+
+// NodePort sets the expected NodePort.
+func (b ServicePortBuilder[R]) NodePort(v int32) ServicePortBuilder[R] {
+	b.get().NodePort = Some(v)
+	return b
+}
+
+// ServiceSpecBuilder fluently fills in a ServiceSpecAssertion that is part of the
+// assertion struct R returned by Build.
+type ServiceSpecBuilder[R any] struct {
+	root *R
+	get  func() *ServiceSpecAssertion
+}
+
+// Build returns the root assertion struct.
+func (b ServiceSpecBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying ServiceSpecAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b ServiceSpecBuilder[R]) With(fn func(*ServiceSpecAssertion)) ServiceSpecBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// Ports sets Ports to exactly the given elements, compared by position.
+func (b ServiceSpecBuilder[R]) Ports(v []ServicePortAssertion) ServiceSpecBuilder[R] {
+	b.get().Ports = Some(v)
+	return b
+}
+
+// Port descends into the element of Ports with the given Name,
+// adding it if needed. Ports is compared with ByKey semantics.
+func (b ServiceSpecBuilder[R]) Port(name string) ServicePortBuilder[R] {
+	get := b.get
+	keyedElement(&get().Ports, name)
+	return ServicePortBuilder[R]{
+		get: func() *ServicePortAssertion {
+			return keyedElement(&get().Ports, name)
+		},
+		root: b.root,
+	}
+}
+
+// Selector sets the expected Selector.
+func (b ServiceSpecBuilder[R]) Selector(v map[string]string) ServiceSpecBuilder[R] {
+	b.get().Selector = Some(v)
+	return b
+}
+
+// ClusterIP sets the expected ClusterIP.
+func (b ServiceSpecBuilder[R]) ClusterIP(v string) ServiceSpecBuilder[R] {
+	b.get().ClusterIP = Some(v)
+	return b
+}
+
+// ClusterIPs sets the expected ClusterIPs.
+func (b ServiceSpecBuilder[R]) ClusterIPs(v []string) ServiceSpecBuilder[R] {
+	b.get().ClusterIPs = Some(v)
+	return b
+}
+
+// Type sets the expected Type.
+func (b ServiceSpecBuilder[R]) Type(v v11.ServiceType) ServiceSpecBuilder[R] {
+	b.get().Type = Some(v)
+	return b
+}
+
+// ExternalIPs sets the expected ExternalIPs.
+func (b ServiceSpecBuilder[R]) ExternalIPs(v []string) ServiceSpecBuilder[R] {
+	b.get().ExternalIPs = Some(v)
+	return b
+}
+
+// SessionAffinity sets the expected SessionAffinity.
+func (b ServiceSpecBuilder[R]) SessionAffinity(v v11.ServiceAffinity) ServiceSpecBuilder[R] {
+	b.get().SessionAffinity = Some(v)
+	return b
+}
+
+// LoadBalancerIP sets the expected LoadBalancerIP.
+func (b ServiceSpecBuilder[R]) LoadBalancerIP(v string) ServiceSpecBuilder[R] {
+	b.get().LoadBalancerIP = Some(v)
+	return b
+}
+
+// LoadBalancerSourceRanges sets the expected LoadBalancerSourceRanges.
+func (b ServiceSpecBuilder[R]) LoadBalancerSourceRanges(v []string) ServiceSpecBuilder[R] {
+	b.get().LoadBalancerSourceRanges = Some(v)
+	return b
+}
+
+// ExternalName sets the expected ExternalName.
+func (b ServiceSpecBuilder[R]) ExternalName(v string) ServiceSpecBuilder[R] {
+	b.get().ExternalName = Some(v)
+	return b
+}
+
+// ExternalTrafficPolicy sets the expected ExternalTrafficPolicy.
+func (b ServiceSpecBuilder[R]) ExternalTrafficPolicy(v v11.ServiceExternalTrafficPolicy) ServiceSpecBuilder[R] {
+	b.get().ExternalTrafficPolicy = Some(v)
+	return b
+}
+
+// HealthCheckNodePort sets the expected HealthCheckNodePort.
+func (b ServiceSpecBuilder[R]) HealthCheckNodePort(v int32) ServiceSpecBuilder[R] {
+	b.get().HealthCheckNodePort = Some(v)
+	return b
+}
+
+// PublishNotReadyAddresses sets the expected PublishNotReadyAddresses.
+func (b ServiceSpecBuilder[R]) PublishNotReadyAddresses(v bool) ServiceSpecBuilder[R] {
+	b.get().PublishNotReadyAddresses = Some(v)
+	return b
+}
+
+// SessionAffinityConfig descends into the SessionAffinityConfig field.
+func (b ServiceSpecBuilder[R]) SessionAffinityConfig() SessionAffinityConfigBuilder[R] {
+	get := b.get
+	return SessionAffinityConfigBuilder[R]{
+		get: func() *SessionAffinityConfigAssertion {
+			return &get().SessionAffinityConfig
+		},
+		root: b.root,
+	}
+}
+
+// IPFamilies sets the expected IPFamilies.
+func (b ServiceSpecBuilder[R]) IPFamilies(v []v11.IPFamily) ServiceSpecBuilder[R] {
+	b.get().IPFamilies = Some(v)
+	return b
+}
+
+// IPFamilyPolicy sets the expected IPFamilyPolicy.
+func (b ServiceSpecBuilder[R]) IPFamilyPolicy(v v11.IPFamilyPolicy) ServiceSpecBuilder[R] {
+	b.get().IPFamilyPolicy = SomePtr(v)
+	return b
+}
+
+// AllocateLoadBalancerNodePorts sets the expected AllocateLoadBalancerNodePorts.
+func (b ServiceSpecBuilder[R]) AllocateLoadBalancerNodePorts(v bool) ServiceSpecBuilder[R] {
+	b.get().AllocateLoadBalancerNodePorts = SomePtr(v)
+	return b
+}
+
+// LoadBalancerClass sets the expected LoadBalancerClass.
+func (b ServiceSpecBuilder[R]) LoadBalancerClass(v string) ServiceSpecBuilder[R] {
+	b.get().LoadBalancerClass = SomePtr(v)
+	return b
+}
+
+// InternalTrafficPolicy sets the expected InternalTrafficPolicy.
+func (b ServiceSpecBuilder[R]) InternalTrafficPolicy(v v11.ServiceInternalTrafficPolicy) ServiceSpecBuilder[R] {
+	b.get().InternalTrafficPolicy = SomePtr(v)
+	return b
+}
+
+// TrafficDistribution sets the expected TrafficDistribution.
+func (b ServiceSpecBuilder[R]) TrafficDistribution(v string) ServiceSpecBuilder[R] {
+	b.get().TrafficDistribution = SomePtr(v)
+	return b
+}
+
+// SessionAffinityConfigBuilder fluently fills in a SessionAffinityConfigAssertion that is part of the
+// assertion struct R returned by Build.
+type SessionAffinityConfigBuilder[R any] struct {
+	root *R
+	get  func() *SessionAffinityConfigAssertion
+}
+
+// Build returns the root assertion struct.
+func (b SessionAffinityConfigBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying SessionAffinityConfigAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b SessionAffinityConfigBuilder[R]) With(fn func(*SessionAffinityConfigAssertion)) SessionAffinityConfigBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// ClientIP descends into the ClientIP field.
+func (b SessionAffinityConfigBuilder[R]) ClientIP() ClientIPConfigBuilder[R] {
+	get := b.get
+	return ClientIPConfigBuilder[R]{
+		get: func() *ClientIPConfigAssertion {
+			return &get().ClientIP
+		},
+		root: b.root,
+	}
+}
+
+// SleepActionBuilder fluently fills in a SleepActionAssertion that is part of the
+// assertion struct R returned by Build.
+type SleepActionBuilder[R any] struct {
+	root *R
+	get  func() *SleepActionAssertion
+}
+
+// Build returns the root assertion struct.
+func (b SleepActionBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying SleepActionAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b SleepActionBuilder[R]) With(fn func(*SleepActionAssertion)) SleepActionBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// Seconds sets the expected Seconds.
+func (b SleepActionBuilder[R]) Seconds(v int64) SleepActionBuilder[R] {
+	b.get().Seconds = Some(v)
+	return b
+}
+
+// StorageOSVolumeSourceBuilder fluently fills in a StorageOSVolumeSourceAssertion that is part of the
+// assertion struct R returned by Build.
+type StorageOSVolumeSourceBuilder[R any] struct {
+	root *R
+	get  func() *StorageOSVolumeSourceAssertion
+}
+
+// Build returns the root assertion struct.
+func (b StorageOSVolumeSourceBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying StorageOSVolumeSourceAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b StorageOSVolumeSourceBuilder[R]) With(fn func(*StorageOSVolumeSourceAssertion)) StorageOSVolumeSourceBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// VolumeName sets the expected VolumeName.
+func (b StorageOSVolumeSourceBuilder[R]) VolumeName(v string) StorageOSVolumeSourceBuilder[R] {
+	b.get().VolumeName = Some(v)
+	return b
+}
+
+// VolumeNamespace sets the expected VolumeNamespace.
+func (b StorageOSVolumeSourceBuilder[R]) VolumeNamespace(v string) StorageOSVolumeSourceBuilder[R] {
+	b.get().VolumeNamespace = Some(v)
+	return b
+}
+
+// FSType sets the expected FSType.
+func (b StorageOSVolumeSourceBuilder[R]) FSType(v string) StorageOSVolumeSourceBuilder[R] {
+	b.get().FSType = Some(v)
+	return b
+}
+
+// ReadOnly sets the expected ReadOnly.
+func (b StorageOSVolumeSourceBuilder[R]) ReadOnly(v bool) StorageOSVolumeSourceBuilder[R] {
+	b.get().ReadOnly = Some(v)
+	return b
+}
+
+// SecretRef descends into the SecretRef field.
+func (b StorageOSVolumeSourceBuilder[R]) SecretRef() CoreLocalObjectReferenceBuilder[R] {
+	get := b.get
+	return CoreLocalObjectReferenceBuilder[R]{
+		get: func() *CoreLocalObjectReferenceAssertion {
+			return &get().SecretRef
+		},
+		root: b.root,
+	}
+}
+
+// SysctlBuilder fluently fills in a SysctlAssertion that is part of the
+// assertion struct R returned by Build.
+type SysctlBuilder[R any] struct {
+	root *R
+	get  func() *SysctlAssertion
+}
+
+// Build returns the root assertion struct.
+func (b SysctlBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying SysctlAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b SysctlBuilder[R]) With(fn func(*SysctlAssertion)) SysctlBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// Name sets the expected Name.
+func (b SysctlBuilder[R]) Name(v string) SysctlBuilder[R] {
+	b.get().Name = Some(v)
+	return b
+}
+
+// Value sets the expected Value.
+func (b SysctlBuilder[R]) Value(v string) SysctlBuilder[R] {
+	b.get().Value = Some(v)
+	return b
+}
+
+// TCPSocketActionBuilder fluently fills in a TCPSocketActionAssertion that is part of the
+// assertion struct R returned by Build.
+type TCPSocketActionBuilder[R any] struct {
+	root *R
+	get  func() *TCPSocketActionAssertion
+}
+
+// Build returns the root assertion struct.
+func (b TCPSocketActionBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying TCPSocketActionAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b TCPSocketActionBuilder[R]) With(fn func(*TCPSocketActionAssertion)) TCPSocketActionBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// Port descends into the Port field.
+func (b TCPSocketActionBuilder[R]) Port() IntOrStringBuilder[R] {
+	get := b.get
+	return IntOrStringBuilder[R]{
+		get: func() *IntOrStringAssertion {
+			return &get().Port
+		},
+		root: b.root,
+	}
+}
+
+// Host sets the expected Host.
+func (b TCPSocketActionBuilder[R]) Host(v string) TCPSocketActionBuilder[R] {
+	b.get().Host = Some(v)
+	return b
+}
+
+// TolerationBuilder fluently fills in a TolerationAssertion that is part of the
+// assertion struct R returned by Build.
+type TolerationBuilder[R any] struct {
+	root *R
+	get  func() *TolerationAssertion
+}
+
+// Build returns the root assertion struct.
+func (b TolerationBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying TolerationAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b TolerationBuilder[R]) With(fn func(*TolerationAssertion)) TolerationBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// Key sets the expected Key.
+func (b TolerationBuilder[R]) Key(v string) TolerationBuilder[R] {
+	b.get().Key = Some(v)
+	return b
+}
+
+// Operator sets the expected Operator.
+func (b TolerationBuilder[R]) Operator(v v11.TolerationOperator) TolerationBuilder[R] {
+	b.get().Operator = Some(v)
+	return b
+}
+
+// Value sets the expected Value.
+func (b TolerationBuilder[R]) Value(v string) TolerationBuilder[R] {
+	b.get().Value = Some(v)
+	return b
+}
+
+// Effect sets the expected Effect.
+func (b TolerationBuilder[R]) Effect(v v11.TaintEffect) TolerationBuilder[R] {
+	b.get().Effect = Some(v)
+	return b
+}
+
+// TolerationSeconds sets the expected TolerationSeconds.
+func (b TolerationBuilder[R]) TolerationSeconds(v int64) TolerationBuilder[R] {
+	b.get().TolerationSeconds = SomePtr(v)
+	return b
+}
+
+// CoreTopologySpreadConstraintBuilder fluently fills in a CoreTopologySpreadConstraintAssertion that is part of the
+// assertion struct R returned by Build.
+type CoreTopologySpreadConstraintBuilder[R any] struct {
+	root *R
+	get  func() *CoreTopologySpreadConstraintAssertion
+}
+
+// Build returns the root assertion struct.
+func (b CoreTopologySpreadConstraintBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying CoreTopologySpreadConstraintAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b CoreTopologySpreadConstraintBuilder[R]) With(fn func(*CoreTopologySpreadConstraintAssertion)) CoreTopologySpreadConstraintBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// MaxSkew sets the expected MaxSkew.
+func (b CoreTopologySpreadConstraintBuilder[R]) MaxSkew(v int32) CoreTopologySpreadConstraintBuilder[R] {
+	b.get().MaxSkew = Some(v)
+	return b
+}
+
+// TopologyKey sets the expected TopologyKey.
+func (b CoreTopologySpreadConstraintBuilder[R]) TopologyKey(v string) CoreTopologySpreadConstraintBuilder[R] {
+	b.get().TopologyKey = Some(v)
+	return b
+}
+
+// WhenUnsatisfiable sets the expected WhenUnsatisfiable.
+func (b CoreTopologySpreadConstraintBuilder[R]) WhenUnsatisfiable(v v11.UnsatisfiableConstraintAction) CoreTopologySpreadConstraintBuilder[R] {
+	b.get().WhenUnsatisfiable = Some(v)
+	return b
+}
+
+// LabelSelector descends into the LabelSelector field.
+func (b CoreTopologySpreadConstraintBuilder[R]) LabelSelector() LabelSelectorBuilder[R] {
+	get := b.get
+	return LabelSelectorBuilder[R]{
+		get: func() *LabelSelectorAssertion {
+			return &get().LabelSelector
+		},
+		root: b.root,
+	}
+}
+
+// MinDomains sets the expected MinDomains.
+func (b CoreTopologySpreadConstraintBuilder[R]) MinDomains(v int32) CoreTopologySpreadConstraintBuilder[R] {
+	b.get().MinDomains = SomePtr(v)
+	return b
+}
+
+// NodeAffinityPolicy sets the expected NodeAffinityPolicy.
+func (b CoreTopologySpreadConstraintBuilder[R]) NodeAffinityPolicy(v v11.NodeInclusionPolicy) CoreTopologySpreadConstraintBuilder[R] {
+	b.get().NodeAffinityPolicy = SomePtr(v)
+	return b
+}
+
+// NodeTaintsPolicy sets the expected NodeTaintsPolicy.
+func (b CoreTopologySpreadConstraintBuilder[R]) NodeTaintsPolicy(v v11.NodeInclusionPolicy) CoreTopologySpreadConstraintBuilder[R] {
+	b.get().NodeTaintsPolicy = SomePtr(v)
+	return b
+}
+
+// MatchLabelKeys sets the expected MatchLabelKeys.
+func (b CoreTopologySpreadConstraintBuilder[R]) MatchLabelKeys(v []string) CoreTopologySpreadConstraintBuilder[R] {
+	b.get().MatchLabelKeys = Some(v)
+	return b
+}
+
+// TypedLocalObjectReferenceBuilder fluently fills in a TypedLocalObjectReferenceAssertion that is part of the
+// assertion struct R returned by Build.
+type TypedLocalObjectReferenceBuilder[R any] struct {
+	root *R
+	get  func() *TypedLocalObjectReferenceAssertion
+}
+
+// Build returns the root assertion struct.
+func (b TypedLocalObjectReferenceBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying TypedLocalObjectReferenceAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b TypedLocalObjectReferenceBuilder[R]) With(fn func(*TypedLocalObjectReferenceAssertion)) TypedLocalObjectReferenceBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// APIGroup sets the expected APIGroup.
+func (b TypedLocalObjectReferenceBuilder[R]) APIGroup(v string) TypedLocalObjectReferenceBuilder[R] {
+	b.get().APIGroup = SomePtr(v)
+	return b
+}
+
+// Kind sets the expected Kind.
+func (b TypedLocalObjectReferenceBuilder[R]) Kind(v string) TypedLocalObjectReferenceBuilder[R] {
+	b.get().Kind = Some(v)
+	return b
+}
+
+// Name sets the expected Name.
+func (b TypedLocalObjectReferenceBuilder[R]) Name(v string) TypedLocalObjectReferenceBuilder[R] {
+	b.get().Name = Some(v)
+	return b
+}
+
+// TypedObjectReferenceBuilder fluently fills in a TypedObjectReferenceAssertion that is part of the
+// assertion struct R returned by Build.
+type TypedObjectReferenceBuilder[R any] struct {
+	root *R
+	get  func() *TypedObjectReferenceAssertion
+}
+
+// Build returns the root assertion struct.
+func (b TypedObjectReferenceBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying TypedObjectReferenceAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b TypedObjectReferenceBuilder[R]) With(fn func(*TypedObjectReferenceAssertion)) TypedObjectReferenceBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// APIGroup sets the expected APIGroup.
+func (b TypedObjectReferenceBuilder[R]) APIGroup(v string) TypedObjectReferenceBuilder[R] {
+	b.get().APIGroup = SomePtr(v)
+	return b
+}
+
+// Kind sets the expected Kind.
+func (b TypedObjectReferenceBuilder[R]) Kind(v string) TypedObjectReferenceBuilder[R] {
+	b.get().Kind = Some(v)
+	return b
+}
+
+// Name sets the expected Name.
+func (b TypedObjectReferenceBuilder[R]) Name(v string) TypedObjectReferenceBuilder[R] {
+	b.get().Name = Some(v)
+	return b
+}
+
+// Namespace sets the expected Namespace.
+func (b TypedObjectReferenceBuilder[R]) Namespace(v string) TypedObjectReferenceBuilder[R] {
+	b.get().Namespace = SomePtr(v)
+	return b
+}
+
+// VolumeBuilder fluently fills in a VolumeAssertion that is part of the
+// assertion struct R returned by Build.
+type VolumeBuilder[R any] struct {
+	root *R
+	get  func() *VolumeAssertion
+}
+
+// Build returns the root assertion struct.
+func (b VolumeBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying VolumeAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b VolumeBuilder[R]) With(fn func(*VolumeAssertion)) VolumeBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// Name sets the expected Name.
+func (b VolumeBuilder[R]) Name(v string) VolumeBuilder[R] {
+	b.get().Name = Some(v)
+	return b
+}
+
+// VolumeSource descends into the VolumeSource field.
+func (b VolumeBuilder[R]) VolumeSource() VolumeSourceBuilder[R] {
+	get := b.get
+	return VolumeSourceBuilder[R]{
+		get: func() *VolumeSourceAssertion {
+			return &get().VolumeSource
+		},
+		root: b.root,
+	}
+}
+
+// VolumeDeviceBuilder fluently fills in a VolumeDeviceAssertion that is part of the
+// assertion struct R returned by Build.
+type VolumeDeviceBuilder[R any] struct {
+	root *R
+	get  func() *VolumeDeviceAssertion
+}
+
+// Build returns the root assertion struct.
+func (b VolumeDeviceBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying VolumeDeviceAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b VolumeDeviceBuilder[R]) With(fn func(*VolumeDeviceAssertion)) VolumeDeviceBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// Name sets the expected Name.
+func (b VolumeDeviceBuilder[R]) Name(v string) VolumeDeviceBuilder[R] {
+	b.get().Name = Some(v)
+	return b
+}
+
+// DevicePath sets the expected DevicePath.
+func (b VolumeDeviceBuilder[R]) DevicePath(v string) VolumeDeviceBuilder[R] {
+	b.get().DevicePath = Some(v)
+	return b
+}
+
+// VolumeMountBuilder fluently fills in a VolumeMountAssertion that is part of the
+// assertion struct R returned by Build.
+type VolumeMountBuilder[R any] struct {
+	root *R
+	get  func() *VolumeMountAssertion
+}
+
+// Build returns the root assertion struct.
+func (b VolumeMountBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying VolumeMountAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b VolumeMountBuilder[R]) With(fn func(*VolumeMountAssertion)) VolumeMountBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// Name sets the expected Name.
+func (b VolumeMountBuilder[R]) Name(v string) VolumeMountBuilder[R] {
+	b.get().Name = Some(v)
+	return b
+}
+
+// ReadOnly sets the expected ReadOnly.
+func (b VolumeMountBuilder[R]) ReadOnly(v bool) VolumeMountBuilder[R] {
+	b.get().ReadOnly = Some(v)
+	return b
+}
+
+// RecursiveReadOnly sets the expected RecursiveReadOnly.
+func (b VolumeMountBuilder[R]) RecursiveReadOnly(v v11.RecursiveReadOnlyMode) VolumeMountBuilder[R] {
+	b.get().RecursiveReadOnly = SomePtr(v)
+	return b
+}
+
+// MountPath sets the expected MountPath.
+func (b VolumeMountBuilder[R]) MountPath(v string) VolumeMountBuilder[R] {
+	b.get().MountPath = Some(v)
+	return b
+}
+
+// SubPath sets the expected SubPath.
+func (b VolumeMountBuilder[R]) SubPath(v string) VolumeMountBuilder[R] {
+	b.get().SubPath = Some(v)
+	return b
+}
+
+// MountPropagation sets the expected MountPropagation.
+func (b VolumeMountBuilder[R]) MountPropagation(v v11.MountPropagationMode) VolumeMountBuilder[R] {
+	b.get().MountPropagation = SomePtr(v)
+	return b
+}
+
+// SubPathExpr sets the expected SubPathExpr.
+func (b VolumeMountBuilder[R]) SubPathExpr(v string) VolumeMountBuilder[R] {
+	b.get().SubPathExpr = Some(v)
+	return b
+}
+
+// VolumeProjectionBuilder fluently fills in a VolumeProjectionAssertion that is part of the
+// assertion struct R returned by Build.
+type VolumeProjectionBuilder[R any] struct {
+	root *R
+	get  func() *VolumeProjectionAssertion
+}
+
+// Build returns the root assertion struct.
+func (b VolumeProjectionBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying VolumeProjectionAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b VolumeProjectionBuilder[R]) With(fn func(*VolumeProjectionAssertion)) VolumeProjectionBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// Secret descends into the Secret field.
+func (b VolumeProjectionBuilder[R]) Secret() SecretProjectionBuilder[R] {
+	get := b.get
+	return SecretProjectionBuilder[R]{
+		get: func() *SecretProjectionAssertion {
+			return &get().Secret
+		},
+		root: b.root,
+	}
+}
+
+// DownwardAPI descends into the DownwardAPI field.
+func (b VolumeProjectionBuilder[R]) DownwardAPI() DownwardAPIProjectionBuilder[R] {
+	get := b.get
+	return DownwardAPIProjectionBuilder[R]{
+		get: func() *DownwardAPIProjectionAssertion {
+			return &get().DownwardAPI
+		},
+		root: b.root,
+	}
+}
+
+// ConfigMap descends into the ConfigMap field.
+func (b VolumeProjectionBuilder[R]) ConfigMap() ConfigMapProjectionBuilder[R] {
+	get := b.get
+	return ConfigMapProjectionBuilder[R]{
+		get: func() *ConfigMapProjectionAssertion {
+			return &get().ConfigMap
+		},
+		root: b.root,
+	}
+}
+
+// ServiceAccountToken descends into the ServiceAccountToken field.
+func (b VolumeProjectionBuilder[R]) ServiceAccountToken() ServiceAccountTokenProjectionBuilder[R] {
+	get := b.get
+	return ServiceAccountTokenProjectionBuilder[R]{
+		get: func() *ServiceAccountTokenProjectionAssertion {
+			return &get().ServiceAccountToken
+		},
+		root: b.root,
+	}
+}
+
+// ClusterTrustBundle descends into the ClusterTrustBundle field.
+func (b VolumeProjectionBuilder[R]) ClusterTrustBundle() ClusterTrustBundleProjectionBuilder[R] {
+	get := b.get
+	return ClusterTrustBundleProjectionBuilder[R]{
+		get: func() *ClusterTrustBundleProjectionAssertion {
+			return &get().ClusterTrustBundle
+		},
+		root: b.root,
+	}
+}
+
+// PodCertificate descends into the PodCertificate field.
+func (b VolumeProjectionBuilder[R]) PodCertificate() PodCertificateProjectionBuilder[R] {
+	get := b.get
+	return PodCertificateProjectionBuilder[R]{
+		get: func() *PodCertificateProjectionAssertion {
+			return &get().PodCertificate
+		},
+		root: b.root,
+	}
+}
+
+// VolumeResourceRequirementsBuilder fluently fills in a VolumeResourceRequirementsAssertion that is part of the
+// assertion struct R returned by Build.
+type VolumeResourceRequirementsBuilder[R any] struct {
+	root *R
+	get  func() *VolumeResourceRequirementsAssertion
+}
+
+// Build returns the root assertion struct.
+func (b VolumeResourceRequirementsBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying VolumeResourceRequirementsAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b VolumeResourceRequirementsBuilder[R]) With(fn func(*VolumeResourceRequirementsAssertion)) VolumeResourceRequirementsBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// Limits sets the expected Limits.
+func (b VolumeResourceRequirementsBuilder[R]) Limits(v v11.ResourceList) VolumeResourceRequirementsBuilder[R] {
+	b.get().Limits = Some(v)
+	return b
+}
+
+// Requests sets the expected Requests.
+func (b VolumeResourceRequirementsBuilder[R]) Requests(v v11.ResourceList) VolumeResourceRequirementsBuilder[R] {
+	b.get().Requests = Some(v)
+	return b
+}
+
+// VolumeSourceBuilder fluently fills in a VolumeSourceAssertion that is part of the
+// assertion struct R returned by Build.
+type VolumeSourceBuilder[R any] struct {
+	root *R
+	get  func() *VolumeSourceAssertion
+}
+
+// Build returns the root assertion struct.
+func (b VolumeSourceBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying VolumeSourceAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b VolumeSourceBuilder[R]) With(fn func(*VolumeSourceAssertion)) VolumeSourceBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// HostPath descends into the HostPath field.
+func (b VolumeSourceBuilder[R]) HostPath() HostPathVolumeSourceBuilder[R] {
+	get := b.get
+	return HostPathVolumeSourceBuilder[R]{
+		get: func() *HostPathVolumeSourceAssertion {
+			return &get().HostPath
+		},
+		root: b.root,
+	}
+}
+
+// EmptyDir descends into the EmptyDir field.
+func (b VolumeSourceBuilder[R]) EmptyDir() EmptyDirVolumeSourceBuilder[R] {
+	get := b.get
+	return EmptyDirVolumeSourceBuilder[R]{
+		get: func() *EmptyDirVolumeSourceAssertion {
+			return &get().EmptyDir
+		},
+		root: b.root,
+	}
+}
+
+// GCEPersistentDisk descends into the GCEPersistentDisk field.
+func (b VolumeSourceBuilder[R]) GCEPersistentDisk() GCEPersistentDiskVolumeSourceBuilder[R] {
+	get := b.get
+	return GCEPersistentDiskVolumeSourceBuilder[R]{
+		get: func() *GCEPersistentDiskVolumeSourceAssertion {
+			return &get().GCEPersistentDisk
+		},
+		root: b.root,
+	}
+}
+
+// AWSElasticBlockStore descends into the AWSElasticBlockStore field.
+func (b VolumeSourceBuilder[R]) AWSElasticBlockStore() AWSElasticBlockStoreVolumeSourceBuilder[R] {
+	get := b.get
+	return AWSElasticBlockStoreVolumeSourceBuilder[R]{
+		get: func() *AWSElasticBlockStoreVolumeSourceAssertion {
+			return &get().AWSElasticBlockStore
+		},
+		root: b.root,
+	}
+}
+
+// GitRepo descends into the GitRepo field.
+func (b VolumeSourceBuilder[R]) GitRepo() GitRepoVolumeSourceBuilder[R] {
+	get := b.get
+	return GitRepoVolumeSourceBuilder[R]{
+		get: func() *GitRepoVolumeSourceAssertion {
+			return &get().GitRepo
+		},
+		root: b.root,
+	}
+}
+
+// Secret descends into the Secret field.
+func (b VolumeSourceBuilder[R]) Secret() SecretVolumeSourceBuilder[R] {
+	get := b.get
+	return SecretVolumeSourceBuilder[R]{
+		get: func() *SecretVolumeSourceAssertion {
+			return &get().Secret
+		},
+		root: b.root,
+	}
+}
+
+// NFS descends into the NFS field.
+func (b VolumeSourceBuilder[R]) NFS() NFSVolumeSourceBuilder[R] {
+	get := b.get
+	return NFSVolumeSourceBuilder[R]{
+		get: func() *NFSVolumeSourceAssertion {
+			return &get().NFS
+		},
+		root: b.root,
+	}
+}
+
+// ISCSI descends into the ISCSI field.
+func (b VolumeSourceBuilder[R]) ISCSI() ISCSIVolumeSourceBuilder[R] {
+	get := b.get
+	return ISCSIVolumeSourceBuilder[R]{
+		get: func() *ISCSIVolumeSourceAssertion {
+			return &get().ISCSI
+		},
+		root: b.root,
+	}
+}
+
+// Glusterfs descends into the Glusterfs field.
+func (b VolumeSourceBuilder[R]) Glusterfs() GlusterfsVolumeSourceBuilder[R] {
+	get := b.get
+	return GlusterfsVolumeSourceBuilder[R]{
+		get: func() *GlusterfsVolumeSourceAssertion {
+			return &get().Glusterfs
+		},
+		root: b.root,
+	}
+}
+
+// PersistentVolumeClaim descends into the PersistentVolumeClaim field.
+func (b VolumeSourceBuilder[R]) PersistentVolumeClaim() PersistentVolumeClaimVolumeSourceBuilder[R] {
+	get := b.get
+	return PersistentVolumeClaimVolumeSourceBuilder[R]{
+		get: func() *PersistentVolumeClaimVolumeSourceAssertion {
+			return &get().PersistentVolumeClaim
+		},
+		root: b.root,
+	}
+}
+
+// RBD descends into the RBD field.
+func (b VolumeSourceBuilder[R]) RBD() RBDVolumeSourceBuilder[R] {
+	get := b.get
+	return RBDVolumeSourceBuilder[R]{
+		get: func() *RBDVolumeSourceAssertion {
+			return &get().RBD
+		},
+		root: b.root,
+	}
+}
+
+// FlexVolume descends into the FlexVolume field.
+func (b VolumeSourceBuilder[R]) FlexVolume() FlexVolumeSourceBuilder[R] {
+	get := b.get
+	return FlexVolumeSourceBuilder[R]{
+		get: func() *FlexVolumeSourceAssertion {
+			return &get().FlexVolume
+		},
+		root: b.root,
+	}
+}
+
+// Cinder descends into the Cinder field.
+func (b VolumeSourceBuilder[R]) Cinder() CinderVolumeSourceBuilder[R] {
+	get := b.get
+	return CinderVolumeSourceBuilder[R]{
+		get: func() *CinderVolumeSourceAssertion {
+			return &get().Cinder
+		},
+		root: b.root,
+	}
+}
+
+// CephFS descends into the CephFS field.
+func (b VolumeSourceBuilder[R]) CephFS() CephFSVolumeSourceBuilder[R] {
+	get := b.get
+	return CephFSVolumeSourceBuilder[R]{
+		get: func() *CephFSVolumeSourceAssertion {
+			return &get().CephFS
+		},
+		root: b.root,
+	}
+}
+
+// Flocker descends into the Flocker field.
+func (b VolumeSourceBuilder[R]) Flocker() FlockerVolumeSourceBuilder[R] {
+	get := b.get
+	return FlockerVolumeSourceBuilder[R]{
+		get: func() *FlockerVolumeSourceAssertion {
+			return &get().Flocker
+		},
+		root: b.root,
+	}
+}
+
+// DownwardAPI descends into the DownwardAPI field.
+func (b VolumeSourceBuilder[R]) DownwardAPI() DownwardAPIVolumeSourceBuilder[R] {
+	get := b.get
+	return DownwardAPIVolumeSourceBuilder[R]{
+		get: func() *DownwardAPIVolumeSourceAssertion {
+			return &get().DownwardAPI
+		},
+		root: b.root,
+	}
+}
+
+// FC descends into the FC field.
+func (b VolumeSourceBuilder[R]) FC() FCVolumeSourceBuilder[R] {
+	get := b.get
+	return FCVolumeSourceBuilder[R]{
+		get: func() *FCVolumeSourceAssertion {
+			return &get().FC
+		},
+		root: b.root,
+	}
+}
+
+// AzureFile descends into the AzureFile field.
+func (b VolumeSourceBuilder[R]) AzureFile() AzureFileVolumeSourceBuilder[R] {
+	get := b.get
+	return AzureFileVolumeSourceBuilder[R]{
+		get: func() *AzureFileVolumeSourceAssertion {
+			return &get().AzureFile
+		},
+		root: b.root,
+	}
+}
+
+// ConfigMap descends into the ConfigMap field.
+func (b VolumeSourceBuilder[R]) ConfigMap() ConfigMapVolumeSourceBuilder[R] {
+	get := b.get
+	return ConfigMapVolumeSourceBuilder[R]{
+		get: func() *ConfigMapVolumeSourceAssertion {
+			return &get().ConfigMap
+		},
+		root: b.root,
+	}
+}
+
+// VsphereVolume descends into the VsphereVolume field.
+func (b VolumeSourceBuilder[R]) VsphereVolume() VsphereVirtualDiskVolumeSourceBuilder[R] {
+	get := b.get
+	return VsphereVirtualDiskVolumeSourceBuilder[R]{
+		get: func() *VsphereVirtualDiskVolumeSourceAssertion {
+			return &get().VsphereVolume
+		},
+		root: b.root,
+	}
+}
+
+// Quobyte descends into the Quobyte field.
+func (b VolumeSourceBuilder[R]) Quobyte() QuobyteVolumeSourceBuilder[R] {
+	get := b.get
+	return QuobyteVolumeSourceBuilder[R]{
+		get: func() *QuobyteVolumeSourceAssertion {
+			return &get().Quobyte
+		},
+		root: b.root,
+	}
+}
+
+// AzureDisk descends into the AzureDisk field.
+func (b VolumeSourceBuilder[R]) AzureDisk() AzureDiskVolumeSourceBuilder[R] {
+	get := b.get
+	return AzureDiskVolumeSourceBuilder[R]{
+		get: func() *AzureDiskVolumeSourceAssertion {
+			return &get().AzureDisk
+		},
+		root: b.root,
+	}
+}
+
+// PhotonPersistentDisk descends into the PhotonPersistentDisk field.
+func (b VolumeSourceBuilder[R]) PhotonPersistentDisk() PhotonPersistentDiskVolumeSourceBuilder[R] {
+	get := b.get
+	return PhotonPersistentDiskVolumeSourceBuilder[R]{
+		get: func() *PhotonPersistentDiskVolumeSourceAssertion {
+			return &get().PhotonPersistentDisk
+		},
+		root: b.root,
+	}
+}
+
+// Projected descends into the Projected field.
+func (b VolumeSourceBuilder[R]) Projected() ProjectedVolumeSourceBuilder[R] {
+	get := b.get
+	return ProjectedVolumeSourceBuilder[R]{
+		get: func() *ProjectedVolumeSourceAssertion {
+			return &get().Projected
+		},
+		root: b.root,
+	}
+}
+
+// PortworxVolume descends into the PortworxVolume field.
+func (b VolumeSourceBuilder[R]) PortworxVolume() PortworxVolumeSourceBuilder[R] {
+	get := b.get
+	return PortworxVolumeSourceBuilder[R]{
+		get: func() *PortworxVolumeSourceAssertion {
+			return &get().PortworxVolume
+		},
+		root: b.root,
+	}
+}
+
+// ScaleIO descends into the ScaleIO field.
+func (b VolumeSourceBuilder[R]) ScaleIO() ScaleIOVolumeSourceBuilder[R] {
+	get := b.get
+	return ScaleIOVolumeSourceBuilder[R]{
+		get: func() *ScaleIOVolumeSourceAssertion {
+			return &get().ScaleIO
+		},
+		root: b.root,
+	}
+}
+
+// StorageOS descends into the StorageOS field.
+func (b VolumeSourceBuilder[R]) StorageOS() StorageOSVolumeSourceBuilder[R] {
+	get := b.get
+	return StorageOSVolumeSourceBuilder[R]{
+		get: func() *StorageOSVolumeSourceAssertion {
+			return &get().StorageOS
+		},
+		root: b.root,
+	}
+}
+
+// CSI descends into the CSI field.
+func (b VolumeSourceBuilder[R]) CSI() CSIVolumeSourceBuilder[R] {
+	get := b.get
+	return CSIVolumeSourceBuilder[R]{
+		get: func() *CSIVolumeSourceAssertion {
+			return &get().CSI
+		},
+		root: b.root,
+	}
+}
+
+// Ephemeral descends into the Ephemeral field.
+func (b VolumeSourceBuilder[R]) Ephemeral() EphemeralVolumeSourceBuilder[R] {
+	get := b.get
+	return EphemeralVolumeSourceBuilder[R]{
+		get: func() *EphemeralVolumeSourceAssertion {
+			return &get().Ephemeral
+		},
+		root: b.root,
+	}
+}
+
+// Image descends into the Image field.
+func (b VolumeSourceBuilder[R]) Image() ImageVolumeSourceBuilder[R] {
+	get := b.get
+	return ImageVolumeSourceBuilder[R]{
+		get: func() *ImageVolumeSourceAssertion {
+			return &get().Image
+		},
+		root: b.root,
+	}
+}
+
+// VsphereVirtualDiskVolumeSourceBuilder fluently fills in a VsphereVirtualDiskVolumeSourceAssertion that is part of the
+// assertion struct R returned by Build.
+type VsphereVirtualDiskVolumeSourceBuilder[R any] struct {
+	root *R
+	get  func() *VsphereVirtualDiskVolumeSourceAssertion
+}
+
+// Build returns the root assertion struct.
+func (b VsphereVirtualDiskVolumeSourceBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying VsphereVirtualDiskVolumeSourceAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b VsphereVirtualDiskVolumeSourceBuilder[R]) With(fn func(*VsphereVirtualDiskVolumeSourceAssertion)) VsphereVirtualDiskVolumeSourceBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// VolumePath sets the expected VolumePath.
+func (b VsphereVirtualDiskVolumeSourceBuilder[R]) VolumePath(v string) VsphereVirtualDiskVolumeSourceBuilder[R] {
+	b.get().VolumePath = Some(v)
+	return b
+}
+
+// FSType sets the expected FSType.
+func (b VsphereVirtualDiskVolumeSourceBuilder[R]) FSType(v string) VsphereVirtualDiskVolumeSourceBuilder[R] {
+	b.get().FSType = Some(v)
+	return b
+}
+
+// StoragePolicyName sets the expected StoragePolicyName.
+func (b VsphereVirtualDiskVolumeSourceBuilder[R]) StoragePolicyName(v string) VsphereVirtualDiskVolumeSourceBuilder[R] {
+	b.get().StoragePolicyName = Some(v)
+	return b
+}
+
+// StoragePolicyID sets the expected StoragePolicyID.
+func (b VsphereVirtualDiskVolumeSourceBuilder[R]) StoragePolicyID(v string) VsphereVirtualDiskVolumeSourceBuilder[R] {
+	b.get().StoragePolicyID = Some(v)
+	return b
+}
+
+// WeightedPodAffinityTermBuilder fluently fills in a WeightedPodAffinityTermAssertion that is part of the
+// assertion struct R returned by Build.
+type WeightedPodAffinityTermBuilder[R any] struct {
+	root *R
+	get  func() *WeightedPodAffinityTermAssertion
+}
+
+// Build returns the root assertion struct.
+func (b WeightedPodAffinityTermBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying WeightedPodAffinityTermAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b WeightedPodAffinityTermBuilder[R]) With(fn func(*WeightedPodAffinityTermAssertion)) WeightedPodAffinityTermBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// Weight sets the expected Weight.
+func (b WeightedPodAffinityTermBuilder[R]) Weight(v int32) WeightedPodAffinityTermBuilder[R] {
+	b.get().Weight = Some(v)
+	return b
+}
+
+// PodAffinityTerm descends into the PodAffinityTerm field.
+func (b WeightedPodAffinityTermBuilder[R]) PodAffinityTerm() PodAffinityTermBuilder[R] {
+	get := b.get
+	return PodAffinityTermBuilder[R]{
+		get: func() *PodAffinityTermAssertion {
+			return &get().PodAffinityTerm
+		},
+		root: b.root,
+	}
+}
+
+// WindowsSecurityContextOptionsBuilder fluently fills in a WindowsSecurityContextOptionsAssertion that is part of the
+// assertion struct R returned by Build.
+type WindowsSecurityContextOptionsBuilder[R any] struct {
+	root *R
+	get  func() *WindowsSecurityContextOptionsAssertion
+}
+
+// Build returns the root assertion struct.
+func (b WindowsSecurityContextOptionsBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying WindowsSecurityContextOptionsAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b WindowsSecurityContextOptionsBuilder[R]) With(fn func(*WindowsSecurityContextOptionsAssertion)) WindowsSecurityContextOptionsBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// GMSACredentialSpecName sets the expected GMSACredentialSpecName.
+func (b WindowsSecurityContextOptionsBuilder[R]) GMSACredentialSpecName(v string) WindowsSecurityContextOptionsBuilder[R] {
+	b.get().GMSACredentialSpecName = SomePtr(v)
+	return b
+}
+
+// GMSACredentialSpec sets the expected GMSACredentialSpec.
+func (b WindowsSecurityContextOptionsBuilder[R]) GMSACredentialSpec(v string) WindowsSecurityContextOptionsBuilder[R] {
+	b.get().GMSACredentialSpec = SomePtr(v)
+	return b
+}
+
+// RunAsUserName sets the expected RunAsUserName.
+func (b WindowsSecurityContextOptionsBuilder[R]) RunAsUserName(v string) WindowsSecurityContextOptionsBuilder[R] {
+	b.get().RunAsUserName = SomePtr(v)
+	return b
+}
+
+// HostProcess sets the expected HostProcess.
+func (b WindowsSecurityContextOptionsBuilder[R]) HostProcess(v bool) WindowsSecurityContextOptionsBuilder[R] {
+	b.get().HostProcess = SomePtr(v)
+	return b
+}
+
+// WorkloadReferenceBuilder fluently fills in a WorkloadReferenceAssertion that is part of the
+// assertion struct R returned by Build.
+type WorkloadReferenceBuilder[R any] struct {
+	root *R
+	get  func() *WorkloadReferenceAssertion
+}
+
+// Build returns the root assertion struct.
+func (b WorkloadReferenceBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying WorkloadReferenceAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b WorkloadReferenceBuilder[R]) With(fn func(*WorkloadReferenceAssertion)) WorkloadReferenceBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// Name sets the expected Name.
+func (b WorkloadReferenceBuilder[R]) Name(v string) WorkloadReferenceBuilder[R] {
+	b.get().Name = Some(v)
+	return b
+}
+
+// PodGroup sets the expected PodGroup.
+func (b WorkloadReferenceBuilder[R]) PodGroup(v string) WorkloadReferenceBuilder[R] {
+	b.get().PodGroup = Some(v)
+	return b
+}
+
+// PodGroupReplicaKey sets the expected PodGroupReplicaKey.
+func (b WorkloadReferenceBuilder[R]) PodGroupReplicaKey(v string) WorkloadReferenceBuilder[R] {
+	b.get().PodGroupReplicaKey = Some(v)
+	return b
+}
+
+// HTTPIngressPathBuilder fluently fills in a HTTPIngressPathAssertion that is part of the
+// assertion struct R returned by Build.
+type HTTPIngressPathBuilder[R any] struct {
+	root *R
+	get  func() *HTTPIngressPathAssertion
+}
+
+// Build returns the root assertion struct.
+func (b HTTPIngressPathBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying HTTPIngressPathAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b HTTPIngressPathBuilder[R]) With(fn func(*HTTPIngressPathAssertion)) HTTPIngressPathBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// Path sets the expected Path.
+func (b HTTPIngressPathBuilder[R]) Path(v string) HTTPIngressPathBuilder[R] {
+	b.get().Path = Some(v)
+	return b
+}
+
+// PathType sets the expected PathType.
+func (b HTTPIngressPathBuilder[R]) PathType(v v14.PathType) HTTPIngressPathBuilder[R] {
+	b.get().PathType = SomePtr(v)
+	return b
+}
+
+// Backend descends into the Backend field.
+func (b HTTPIngressPathBuilder[R]) Backend() IngressBackendBuilder[R] {
+	get := b.get
+	return IngressBackendBuilder[R]{
+		get: func() *IngressBackendAssertion {
+			return &get().Backend
+		},
+		root: b.root,
+	}
+}
+
+// HTTPIngressRuleValueBuilder fluently fills in a HTTPIngressRuleValueAssertion that is part of the
+// assertion struct R returned by Build.
+type HTTPIngressRuleValueBuilder[R any] struct {
+	root *R
+	get  func() *HTTPIngressRuleValueAssertion
+}
+
+// Build returns the root assertion struct.
+func (b HTTPIngressRuleValueBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying HTTPIngressRuleValueAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b HTTPIngressRuleValueBuilder[R]) With(fn func(*HTTPIngressRuleValueAssertion)) HTTPIngressRuleValueBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// Paths sets Paths to exactly the given elements, compared by position.
+func (b HTTPIngressRuleValueBuilder[R]) Paths(v []HTTPIngressPathAssertion) HTTPIngressRuleValueBuilder[R] {
+	b.get().Paths = Some(v)
+	return b
+}
+
+// IngressBuilder fluently fills in a IngressAssertion that is part of the
+// assertion struct R returned by Build.
+type IngressBuilder[R any] struct {
+	root *R
+	get  func() *IngressAssertion
+}
+
+// Build returns the root assertion struct.
+func (b IngressBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying IngressAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b IngressBuilder[R]) With(fn func(*IngressAssertion)) IngressBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// TypeMeta descends into the TypeMeta field.
+func (b IngressBuilder[R]) TypeMeta() TypeMetaBuilder[R] {
+	get := b.get
+	return TypeMetaBuilder[R]{
+		get: func() *TypeMetaAssertion {
+			return &get().TypeMeta
+		},
+		root: b.root,
+	}
+}
+
+// ObjectMeta descends into the ObjectMeta field.
+func (b IngressBuilder[R]) ObjectMeta() ObjectMetaBuilder[R] {
+	get := b.get
+	return ObjectMetaBuilder[R]{
+		get: func() *ObjectMetaAssertion {
+			return &get().ObjectMeta
+		},
+		root: b.root,
+	}
+}
+
+// Spec descends into the Spec field.
+func (b IngressBuilder[R]) Spec() IngressSpecBuilder[R] {
+	get := b.get
+	return IngressSpecBuilder[R]{
+		get: func() *IngressSpecAssertion {
+			return &get().Spec
+		},
+		root: b.root,
+	}
+}
+
+// Status sets the expected Status.
+func (b IngressBuilder[R]) Status(v v14.IngressStatus) IngressBuilder[R] {
+	b.get().Status = Some(v)
+	return b
+}
+
+// IngressBackendBuilder fluently fills in a IngressBackendAssertion that is part of the
+// assertion struct R returned by Build.
+type IngressBackendBuilder[R any] struct {
+	root *R
+	get  func() *IngressBackendAssertion
+}
+
+// Build returns the root assertion struct.
+func (b IngressBackendBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying IngressBackendAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b IngressBackendBuilder[R]) With(fn func(*IngressBackendAssertion)) IngressBackendBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// Service descends into the Service field.
+func (b IngressBackendBuilder[R]) Service() IngressServiceBackendBuilder[R] {
+	get := b.get
+	return IngressServiceBackendBuilder[R]{
+		get: func() *IngressServiceBackendAssertion {
+			return &get().Service
+		},
+		root: b.root,
+	}
+}
+
+// Resource descends into the Resource field.
+func (b IngressBackendBuilder[R]) Resource() TypedLocalObjectReferenceBuilder[R] {
+	get := b.get
+	return TypedLocalObjectReferenceBuilder[R]{
+		get: func() *TypedLocalObjectReferenceAssertion {
+			return &get().Resource
+		},
+		root: b.root,
+	}
+}
+
+// IngressRuleBuilder fluently fills in a IngressRuleAssertion that is part of the
+// assertion struct R returned by Build.
+type IngressRuleBuilder[R any] struct {
+	root *R
+	get  func() *IngressRuleAssertion
+}
+
+// Build returns the root assertion struct.
+func (b IngressRuleBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying IngressRuleAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b IngressRuleBuilder[R]) With(fn func(*IngressRuleAssertion)) IngressRuleBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// Host sets the expected Host.
+func (b IngressRuleBuilder[R]) Host(v string) IngressRuleBuilder[R] {
+	b.get().Host = Some(v)
+	return b
+}
+
+// IngressRuleValue descends into the IngressRuleValue field.
+func (b IngressRuleBuilder[R]) IngressRuleValue() IngressRuleValueBuilder[R] {
+	get := b.get
+	return IngressRuleValueBuilder[R]{
+		get: func() *IngressRuleValueAssertion {
+			return &get().IngressRuleValue
+		},
+		root: b.root,
+	}
+}
+
+// IngressRuleValueBuilder fluently fills in a IngressRuleValueAssertion that is part of the
+// assertion struct R returned by Build.
+type IngressRuleValueBuilder[R any] struct {
+	root *R
+	get  func() *IngressRuleValueAssertion
+}
+
+// Build returns the root assertion struct.
+func (b IngressRuleValueBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying IngressRuleValueAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b IngressRuleValueBuilder[R]) With(fn func(*IngressRuleValueAssertion)) IngressRuleValueBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// HTTP descends into the HTTP field.
+func (b IngressRuleValueBuilder[R]) HTTP() HTTPIngressRuleValueBuilder[R] {
+	get := b.get
+	return HTTPIngressRuleValueBuilder[R]{
+		get: func() *HTTPIngressRuleValueAssertion {
+			return &get().HTTP
+		},
+		root: b.root,
+	}
+}
+
+// IngressServiceBackendBuilder fluently fills in a IngressServiceBackendAssertion that is part of the
+// assertion struct R returned by Build.
+type IngressServiceBackendBuilder[R any] struct {
+	root *R
+	get  func() *IngressServiceBackendAssertion
+}
+
+// Build returns the root assertion struct.
+func (b IngressServiceBackendBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying IngressServiceBackendAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b IngressServiceBackendBuilder[R]) With(fn func(*IngressServiceBackendAssertion)) IngressServiceBackendBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// Name sets the expected Name.
+func (b IngressServiceBackendBuilder[R]) Name(v string) IngressServiceBackendBuilder[R] {
+	b.get().Name = Some(v)
+	return b
+}
+
+// Port descends into the Port field.
+func (b IngressServiceBackendBuilder[R]) Port() ServiceBackendPortBuilder[R] {
+	get := b.get
+	return ServiceBackendPortBuilder[R]{
+		get: func() *ServiceBackendPortAssertion {
+			return &get().Port
+		},
+		root: b.root,
+	}
+}
+
+// IngressSpecBuilder fluently fills in a IngressSpecAssertion that is part of the
+// assertion struct R returned by Build.
+type IngressSpecBuilder[R any] struct {
+	root *R
+	get  func() *IngressSpecAssertion
+}
+
+// Build returns the root assertion struct.
+func (b IngressSpecBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying IngressSpecAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b IngressSpecBuilder[R]) With(fn func(*IngressSpecAssertion)) IngressSpecBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// IngressClassName sets the expected IngressClassName.
+func (b IngressSpecBuilder[R]) IngressClassName(v string) IngressSpecBuilder[R] {
+	b.get().IngressClassName = SomePtr(v)
+	return b
+}
+
+// DefaultBackend descends into the DefaultBackend field.
+func (b IngressSpecBuilder[R]) DefaultBackend() IngressBackendBuilder[R] {
+	get := b.get
+	return IngressBackendBuilder[R]{
+		get: func() *IngressBackendAssertion {
+			return &get().DefaultBackend
+		},
+		root: b.root,
+	}
+}
+
+// TLS sets TLS to exactly the given elements, compared by position.
+func (b IngressSpecBuilder[R]) TLS(v []IngressTLSAssertion) IngressSpecBuilder[R] {
+	b.get().TLS = Some(v)
+	return b
+}
+
+// Rules sets Rules to exactly the given elements, compared by position.
+func (b IngressSpecBuilder[R]) Rules(v []IngressRuleAssertion) IngressSpecBuilder[R] {
+	b.get().Rules = Some(v)
+	return b
+}
+
+// IngressTLSBuilder fluently fills in a IngressTLSAssertion that is part of the
+// assertion struct R returned by Build.
+type IngressTLSBuilder[R any] struct {
+	root *R
+	get  func() *IngressTLSAssertion
+}
+
+// Build returns the root assertion struct.
+func (b IngressTLSBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying IngressTLSAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b IngressTLSBuilder[R]) With(fn func(*IngressTLSAssertion)) IngressTLSBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// Hosts sets the expected Hosts.
+func (b IngressTLSBuilder[R]) Hosts(v []string) IngressTLSBuilder[R] {
+	b.get().Hosts = Some(v)
+	return b
+}
+
+// SecretName sets the expected SecretName.
+func (b IngressTLSBuilder[R]) SecretName(v string) IngressTLSBuilder[R] {
+	b.get().SecretName = Some(v)
+	return b
+}
+
+// ServiceBackendPortBuilder fluently fills in a ServiceBackendPortAssertion that is part of the
+// assertion struct R returned by Build.
+type ServiceBackendPortBuilder[R any] struct {
+	root *R
+	get  func() *ServiceBackendPortAssertion
+}
+
+// Build returns the root assertion struct.
+func (b ServiceBackendPortBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying ServiceBackendPortAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b ServiceBackendPortBuilder[R]) With(fn func(*ServiceBackendPortAssertion)) ServiceBackendPortBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// Name sets the expected Name.
+func (b ServiceBackendPortBuilder[R]) Name(v string) ServiceBackendPortBuilder[R] {
+	b.get().Name = Some(v)
+	return b
+}
+
+// Number sets the expected Number.
+func (b ServiceBackendPortBuilder[R]) Number(v int32) ServiceBackendPortBuilder[R] {
+	b.get().Number = Some(v)
+	return b
+}
+
+// PodDisruptionBudgetBuilder fluently fills in a PodDisruptionBudgetAssertion that is part of the
+// assertion struct R returned by Build.
+type PodDisruptionBudgetBuilder[R any] struct {
+	root *R
+	get  func() *PodDisruptionBudgetAssertion
+}
+
+// Build returns the root assertion struct.
+func (b PodDisruptionBudgetBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying PodDisruptionBudgetAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b PodDisruptionBudgetBuilder[R]) With(fn func(*PodDisruptionBudgetAssertion)) PodDisruptionBudgetBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// TypeMeta descends into the TypeMeta field.
+func (b PodDisruptionBudgetBuilder[R]) TypeMeta() TypeMetaBuilder[R] {
+	get := b.get
+	return TypeMetaBuilder[R]{
+		get: func() *TypeMetaAssertion {
+			return &get().TypeMeta
+		},
+		root: b.root,
+	}
+}
+
+// ObjectMeta descends into the ObjectMeta field.
+func (b PodDisruptionBudgetBuilder[R]) ObjectMeta() ObjectMetaBuilder[R] {
+	get := b.get
+	return ObjectMetaBuilder[R]{
+		get: func() *ObjectMetaAssertion {
+			return &get().ObjectMeta
+		},
+		root: b.root,
+	}
+}
+
+// Spec descends into the Spec field.
+func (b PodDisruptionBudgetBuilder[R]) Spec() PodDisruptionBudgetSpecBuilder[R] {
+	get := b.get
+	return PodDisruptionBudgetSpecBuilder[R]{
+		get: func() *PodDisruptionBudgetSpecAssertion {
+			return &get().Spec
+		},
+		root: b.root,
+	}
+}
+
+// Status sets the expected Status.
+func (b PodDisruptionBudgetBuilder[R]) Status(v v15.PodDisruptionBudgetStatus) PodDisruptionBudgetBuilder[R] {
+	b.get().Status = Some(v)
+	return b
+}
+
+// PodDisruptionBudgetSpecBuilder fluently fills in a PodDisruptionBudgetSpecAssertion that is part of the
+// assertion struct R returned by Build.
+type PodDisruptionBudgetSpecBuilder[R any] struct {
+	root *R
+	get  func() *PodDisruptionBudgetSpecAssertion
+}
+
+// Build returns the root assertion struct.
+func (b PodDisruptionBudgetSpecBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying PodDisruptionBudgetSpecAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b PodDisruptionBudgetSpecBuilder[R]) With(fn func(*PodDisruptionBudgetSpecAssertion)) PodDisruptionBudgetSpecBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// MinAvailable descends into the MinAvailable field.
+func (b PodDisruptionBudgetSpecBuilder[R]) MinAvailable() IntOrStringBuilder[R] {
+	get := b.get
+	return IntOrStringBuilder[R]{
+		get: func() *IntOrStringAssertion {
+			return &get().MinAvailable
+		},
+		root: b.root,
+	}
+}
+
+// Selector descends into the Selector field.
+func (b PodDisruptionBudgetSpecBuilder[R]) Selector() LabelSelectorBuilder[R] {
+	get := b.get
+	return LabelSelectorBuilder[R]{
+		get: func() *LabelSelectorAssertion {
+			return &get().Selector
+		},
+		root: b.root,
+	}
+}
+
+// MaxUnavailable descends into the MaxUnavailable field.
+func (b PodDisruptionBudgetSpecBuilder[R]) MaxUnavailable() IntOrStringBuilder[R] {
+	get := b.get
+	return IntOrStringBuilder[R]{
+		get: func() *IntOrStringAssertion {
+			return &get().MaxUnavailable
+		},
+		root: b.root,
+	}
+}
+
+// UnhealthyPodEvictionPolicy sets the expected UnhealthyPodEvictionPolicy.
+func (b PodDisruptionBudgetSpecBuilder[R]) UnhealthyPodEvictionPolicy(v v15.UnhealthyPodEvictionPolicyType) PodDisruptionBudgetSpecBuilder[R] {
+	b.get().UnhealthyPodEvictionPolicy = SomePtr(v)
+	return b
+}
+
+// PolicyRuleBuilder fluently fills in a PolicyRuleAssertion that is part of the
+// assertion struct R returned by Build.
+type PolicyRuleBuilder[R any] struct {
+	root *R
+	get  func() *PolicyRuleAssertion
+}
+
+// Build returns the root assertion struct.
+func (b PolicyRuleBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying PolicyRuleAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b PolicyRuleBuilder[R]) With(fn func(*PolicyRuleAssertion)) PolicyRuleBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// Verbs sets the expected Verbs.
+func (b PolicyRuleBuilder[R]) Verbs(v []string) PolicyRuleBuilder[R] {
+	b.get().Verbs = Some(v)
+	return b
+}
+
+// APIGroups sets the expected APIGroups.
+func (b PolicyRuleBuilder[R]) APIGroups(v []string) PolicyRuleBuilder[R] {
+	b.get().APIGroups = Some(v)
+	return b
+}
+
+// Resources sets the expected Resources.
+func (b PolicyRuleBuilder[R]) Resources(v []string) PolicyRuleBuilder[R] {
+	b.get().Resources = Some(v)
+	return b
+}
+
+// ResourceNames sets the expected ResourceNames.
+func (b PolicyRuleBuilder[R]) ResourceNames(v []string) PolicyRuleBuilder[R] {
+	b.get().ResourceNames = Some(v)
+	return b
+}
+
+// NonResourceURLs sets the expected NonResourceURLs.
+func (b PolicyRuleBuilder[R]) NonResourceURLs(v []string) PolicyRuleBuilder[R] {
+	b.get().NonResourceURLs = Some(v)
+	return b
+}
+
+// RoleBuilder fluently fills in a RoleAssertion that is part of the
+// assertion struct R returned by Build.
+type RoleBuilder[R any] struct {
+	root *R
+	get  func() *RoleAssertion
+}
+
+// Build returns the root assertion struct.
+func (b RoleBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying RoleAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b RoleBuilder[R]) With(fn func(*RoleAssertion)) RoleBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// TypeMeta descends into the TypeMeta field.
+func (b RoleBuilder[R]) TypeMeta() TypeMetaBuilder[R] {
+	get := b.get
+	return TypeMetaBuilder[R]{
+		get: func() *TypeMetaAssertion {
+			return &get().TypeMeta
+		},
+		root: b.root,
+	}
+}
+
+// ObjectMeta descends into the ObjectMeta field.
+func (b RoleBuilder[R]) ObjectMeta() ObjectMetaBuilder[R] {
+	get := b.get
+	return ObjectMetaBuilder[R]{
+		get: func() *ObjectMetaAssertion {
+			return &get().ObjectMeta
+		},
+		root: b.root,
+	}
+}
+
+// Rules sets Rules to exactly the given elements, compared by position.
+func (b RoleBuilder[R]) Rules(v []PolicyRuleAssertion) RoleBuilder[R] {
+	b.get().Rules = Some(v)
+	return b
+}
+
+// RoleBindingBuilder fluently fills in a RoleBindingAssertion that is part of the
+// assertion struct R returned by Build.
+type RoleBindingBuilder[R any] struct {
+	root *R
+	get  func() *RoleBindingAssertion
+}
+
+// Build returns the root assertion struct.
+func (b RoleBindingBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying RoleBindingAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b RoleBindingBuilder[R]) With(fn func(*RoleBindingAssertion)) RoleBindingBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// TypeMeta descends into the TypeMeta field.
+func (b RoleBindingBuilder[R]) TypeMeta() TypeMetaBuilder[R] {
+	get := b.get
+	return TypeMetaBuilder[R]{
+		get: func() *TypeMetaAssertion {
+			return &get().TypeMeta
+		},
+		root: b.root,
+	}
+}
+
+// ObjectMeta descends into the ObjectMeta field.
+func (b RoleBindingBuilder[R]) ObjectMeta() ObjectMetaBuilder[R] {
+	get := b.get
+	return ObjectMetaBuilder[R]{
+		get: func() *ObjectMetaAssertion {
+			return &get().ObjectMeta
+		},
+		root: b.root,
+	}
+}
+
+// Subjects sets Subjects to exactly the given elements, compared by position.
+func (b RoleBindingBuilder[R]) Subjects(v []SubjectAssertion) RoleBindingBuilder[R] {
+	b.get().Subjects = Some(v)
+	return b
+}
+
+// Subject descends into the element of Subjects with the given Name,
+// adding it if needed. Subjects is compared with ByKey semantics.
+func (b RoleBindingBuilder[R]) Subject(name string) SubjectBuilder[R] {
+	get := b.get
+	keyedElement(&get().Subjects, name)
+	return SubjectBuilder[R]{
+		get: func() *SubjectAssertion {
+			return keyedElement(&get().Subjects, name)
+		},
+		root: b.root,
+	}
+}
+
+// RoleRef descends into the RoleRef field.
+func (b RoleBindingBuilder[R]) RoleRef() RoleRefBuilder[R] {
+	get := b.get
+	return RoleRefBuilder[R]{
+		get: func() *RoleRefAssertion {
+			return &get().RoleRef
+		},
+		root: b.root,
+	}
+}
+
+// RoleRefBuilder fluently fills in a RoleRefAssertion that is part of the
+// assertion struct R returned by Build.
+type RoleRefBuilder[R any] struct {
+	root *R
+	get  func() *RoleRefAssertion
+}
+
+// Build returns the root assertion struct.
+func (b RoleRefBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying RoleRefAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b RoleRefBuilder[R]) With(fn func(*RoleRefAssertion)) RoleRefBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// APIGroup sets the expected APIGroup.
+func (b RoleRefBuilder[R]) APIGroup(v string) RoleRefBuilder[R] {
+	b.get().APIGroup = Some(v)
+	return b
+}
+
+// Kind sets the expected Kind.
+func (b RoleRefBuilder[R]) Kind(v string) RoleRefBuilder[R] {
+	b.get().Kind = Some(v)
+	return b
+}
+
+// Name sets the expected Name.
+func (b RoleRefBuilder[R]) Name(v string) RoleRefBuilder[R] {
+	b.get().Name = Some(v)
+	return b
+}
+
+// SubjectBuilder fluently fills in a SubjectAssertion that is part of the
+// assertion struct R returned by Build.
+type SubjectBuilder[R any] struct {
+	root *R
+	get  func() *SubjectAssertion
+}
+
+// Build returns the root assertion struct.
+func (b SubjectBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying SubjectAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b SubjectBuilder[R]) With(fn func(*SubjectAssertion)) SubjectBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// Kind sets the expected Kind.
+func (b SubjectBuilder[R]) Kind(v string) SubjectBuilder[R] {
+	b.get().Kind = Some(v)
+	return b
+}
+
+// APIGroup sets the expected APIGroup.
+func (b SubjectBuilder[R]) APIGroup(v string) SubjectBuilder[R] {
+	b.get().APIGroup = Some(v)
+	return b
+}
+
+// Name sets the expected Name.
+func (b SubjectBuilder[R]) Name(v string) SubjectBuilder[R] {
+	b.get().Name = Some(v)
+	return b
+}
+
+// Namespace sets the expected Namespace.
+func (b SubjectBuilder[R]) Namespace(v string) SubjectBuilder[R] {
+	b.get().Namespace = Some(v)
+	return b
+}
+
+// QuantityBuilder fluently fills in a QuantityAssertion that is part of the
+// assertion struct R returned by Build.
+type QuantityBuilder[R any] struct {
+	root *R
+	get  func() *QuantityAssertion
+}
+
+// Build returns the root assertion struct.
+func (b QuantityBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying QuantityAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b QuantityBuilder[R]) With(fn func(*QuantityAssertion)) QuantityBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// Format sets the expected Format.
+func (b QuantityBuilder[R]) Format(v resource.Format) QuantityBuilder[R] {
+	b.get().Format = Some(v)
+	return b
+}
+
+// FieldsV1Builder fluently fills in a FieldsV1Assertion that is part of the
+// assertion struct R returned by Build.
+type FieldsV1Builder[R any] struct {
+	root *R
+	get  func() *FieldsV1Assertion
+}
+
+// Build returns the root assertion struct.
+func (b FieldsV1Builder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying FieldsV1Assertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b FieldsV1Builder[R]) With(fn func(*FieldsV1Assertion)) FieldsV1Builder[R] {
+	fn(b.get())
+	return b
+}
+
+// Raw sets the expected Raw.
+func (b FieldsV1Builder[R]) Raw(v []byte) FieldsV1Builder[R] {
+	b.get().Raw = Some(v)
+	return b
+}
+
+// LabelSelectorBuilder fluently fills in a LabelSelectorAssertion that is part of the
+// assertion struct R returned by Build.
+type LabelSelectorBuilder[R any] struct {
+	root *R
+	get  func() *LabelSelectorAssertion
+}
+
+// Build returns the root assertion struct.
+func (b LabelSelectorBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying LabelSelectorAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b LabelSelectorBuilder[R]) With(fn func(*LabelSelectorAssertion)) LabelSelectorBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// MatchLabels sets the expected MatchLabels.
+func (b LabelSelectorBuilder[R]) MatchLabels(v map[string]string) LabelSelectorBuilder[R] {
+	b.get().MatchLabels = Some(v)
+	return b
+}
+
+// MatchExpressions sets MatchExpressions to exactly the given elements, compared by position.
+func (b LabelSelectorBuilder[R]) MatchExpressions(v []LabelSelectorRequirementAssertion) LabelSelectorBuilder[R] {
+	b.get().MatchExpressions = Some(v)
+	return b
+}
+
+// LabelSelectorRequirementBuilder fluently fills in a LabelSelectorRequirementAssertion that is part of the
+// assertion struct R returned by Build.
+type LabelSelectorRequirementBuilder[R any] struct {
+	root *R
+	get  func() *LabelSelectorRequirementAssertion
+}
+
+// Build returns the root assertion struct.
+func (b LabelSelectorRequirementBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying LabelSelectorRequirementAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b LabelSelectorRequirementBuilder[R]) With(fn func(*LabelSelectorRequirementAssertion)) LabelSelectorRequirementBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// Key sets the expected Key.
+func (b LabelSelectorRequirementBuilder[R]) Key(v string) LabelSelectorRequirementBuilder[R] {
+	b.get().Key = Some(v)
+	return b
+}
+
+// Operator sets the expected Operator.
+func (b LabelSelectorRequirementBuilder[R]) Operator(v v16.LabelSelectorOperator) LabelSelectorRequirementBuilder[R] {
+	b.get().Operator = Some(v)
+	return b
+}
+
+// Values sets the expected Values.
+func (b LabelSelectorRequirementBuilder[R]) Values(v []string) LabelSelectorRequirementBuilder[R] {
+	b.get().Values = Some(v)
+	return b
+}
+
+// ManagedFieldsEntryBuilder fluently fills in a ManagedFieldsEntryAssertion that is part of the
+// assertion struct R returned by Build.
+type ManagedFieldsEntryBuilder[R any] struct {
+	root *R
+	get  func() *ManagedFieldsEntryAssertion
+}
+
+// Build returns the root assertion struct.
+func (b ManagedFieldsEntryBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying ManagedFieldsEntryAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b ManagedFieldsEntryBuilder[R]) With(fn func(*ManagedFieldsEntryAssertion)) ManagedFieldsEntryBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// Manager sets the expected Manager.
+func (b ManagedFieldsEntryBuilder[R]) Manager(v string) ManagedFieldsEntryBuilder[R] {
+	b.get().Manager = Some(v)
+	return b
+}
+
+// Operation sets the expected Operation.
+func (b ManagedFieldsEntryBuilder[R]) Operation(v v16.ManagedFieldsOperationType) ManagedFieldsEntryBuilder[R] {
+	b.get().Operation = Some(v)
+	return b
+}
+
+// APIVersion sets the expected APIVersion.
+func (b ManagedFieldsEntryBuilder[R]) APIVersion(v string) ManagedFieldsEntryBuilder[R] {
+	b.get().APIVersion = Some(v)
+	return b
+}
+
+// Time descends into the Time field.
+func (b ManagedFieldsEntryBuilder[R]) Time() TimeBuilder[R] {
+	get := b.get
+	return TimeBuilder[R]{
+		get: func() *TimeAssertion {
+			return &get().Time
+		},
+		root: b.root,
+	}
+}
+
+// FieldsType sets the expected FieldsType.
+func (b ManagedFieldsEntryBuilder[R]) FieldsType(v string) ManagedFieldsEntryBuilder[R] {
+	b.get().FieldsType = Some(v)
+	return b
+}
+
+// FieldsV1 descends into the FieldsV1 field.
+func (b ManagedFieldsEntryBuilder[R]) FieldsV1() FieldsV1Builder[R] {
+	get := b.get
+	return FieldsV1Builder[R]{
+		get: func() *FieldsV1Assertion {
+			return &get().FieldsV1
+		},
+		root: b.root,
+	}
+}
+
+// Subresource sets the expected Subresource.
+func (b ManagedFieldsEntryBuilder[R]) Subresource(v string) ManagedFieldsEntryBuilder[R] {
+	b.get().Subresource = Some(v)
+	return b
+}
+
+// ObjectMetaBuilder fluently fills in a ObjectMetaAssertion that is part of the
+// assertion struct R returned by Build.
+type ObjectMetaBuilder[R any] struct {
+	root *R
+	get  func() *ObjectMetaAssertion
+}
+
+// Build returns the root assertion struct.
+func (b ObjectMetaBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying ObjectMetaAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b ObjectMetaBuilder[R]) With(fn func(*ObjectMetaAssertion)) ObjectMetaBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// Name sets the expected Name.
+func (b ObjectMetaBuilder[R]) Name(v string) ObjectMetaBuilder[R] {
+	b.get().Name = Some(v)
+	return b
+}
+
+// GenerateName sets the expected GenerateName.
+func (b ObjectMetaBuilder[R]) GenerateName(v string) ObjectMetaBuilder[R] {
+	b.get().GenerateName = Some(v)
+	return b
+}
+
+// Namespace sets the expected Namespace.
+func (b ObjectMetaBuilder[R]) Namespace(v string) ObjectMetaBuilder[R] {
+	b.get().Namespace = Some(v)
+	return b
+}
+
+// SelfLink sets the expected SelfLink.
+func (b ObjectMetaBuilder[R]) SelfLink(v string) ObjectMetaBuilder[R] {
+	b.get().SelfLink = Some(v)
+	return b
+}
+
+// UID sets the expected UID.
+func (b ObjectMetaBuilder[R]) UID(v types.UID) ObjectMetaBuilder[R] {
+	b.get().UID = Some(v)
+	return b
+}
+
+// ResourceVersion sets the expected ResourceVersion.
+func (b ObjectMetaBuilder[R]) ResourceVersion(v string) ObjectMetaBuilder[R] {
+	b.get().ResourceVersion = Some(v)
+	return b
+}
+
+// Generation sets the expected Generation.
+func (b ObjectMetaBuilder[R]) Generation(v int64) ObjectMetaBuilder[R] {
+	b.get().Generation = Some(v)
+	return b
+}
+
+// CreationTimestamp descends into the CreationTimestamp field.
+func (b ObjectMetaBuilder[R]) CreationTimestamp() TimeBuilder[R] {
+	get := b.get
+	return TimeBuilder[R]{
+		get: func() *TimeAssertion {
+			return &get().CreationTimestamp
+		},
+		root: b.root,
+	}
+}
+
+// DeletionTimestamp descends into the DeletionTimestamp field.
+func (b ObjectMetaBuilder[R]) DeletionTimestamp() TimeBuilder[R] {
+	get := b.get
+	return TimeBuilder[R]{
+		get: func() *TimeAssertion {
+			return &get().DeletionTimestamp
+		},
+		root: b.root,
+	}
+}
+
+// DeletionGracePeriodSeconds sets the expected DeletionGracePeriodSeconds.
+func (b ObjectMetaBuilder[R]) DeletionGracePeriodSeconds(v int64) ObjectMetaBuilder[R] {
+	b.get().DeletionGracePeriodSeconds = SomePtr(v)
+	return b
+}
+
+// Labels sets the expected Labels.
+func (b ObjectMetaBuilder[R]) Labels(v map[string]string) ObjectMetaBuilder[R] {
+	b.get().Labels = Some(v)
+	return b
+}
+
+// Annotations sets the expected Annotations.
+func (b ObjectMetaBuilder[R]) Annotations(v map[string]string) ObjectMetaBuilder[R] {
+	b.get().Annotations = Some(v)
+	return b
+}
+
+// OwnerReferences sets OwnerReferences to exactly the given elements, compared by position.
+func (b ObjectMetaBuilder[R]) OwnerReferences(v []OwnerReferenceAssertion) ObjectMetaBuilder[R] {
+	b.get().OwnerReferences = Some(v)
+	return b
+}
+
+// OwnerReference descends into the element of OwnerReferences with the given Name,
+// adding it if needed. OwnerReferences is compared with ByKey semantics.
+func (b ObjectMetaBuilder[R]) OwnerReference(name string) OwnerReferenceBuilder[R] {
+	get := b.get
+	keyedElement(&get().OwnerReferences, name)
+	return OwnerReferenceBuilder[R]{
+		get: func() *OwnerReferenceAssertion {
+			return keyedElement(&get().OwnerReferences, name)
+		},
+		root: b.root,
+	}
+}
+
+// Finalizers sets the expected Finalizers.
+func (b ObjectMetaBuilder[R]) Finalizers(v []string) ObjectMetaBuilder[R] {
+	b.get().Finalizers = Some(v)
+	return b
+}
+
+// ManagedFields sets ManagedFields to exactly the given elements, compared by position.
+func (b ObjectMetaBuilder[R]) ManagedFields(v []ManagedFieldsEntryAssertion) ObjectMetaBuilder[R] {
+	b.get().ManagedFields = Some(v)
+	return b
+}
+
+// OwnerReferenceBuilder fluently fills in a OwnerReferenceAssertion that is part of the
+// assertion struct R returned by Build.
+type OwnerReferenceBuilder[R any] struct {
+	root *R
+	get  func() *OwnerReferenceAssertion
+}
+
+// Build returns the root assertion struct.
+func (b OwnerReferenceBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying OwnerReferenceAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b OwnerReferenceBuilder[R]) With(fn func(*OwnerReferenceAssertion)) OwnerReferenceBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// APIVersion sets the expected APIVersion.
+func (b OwnerReferenceBuilder[R]) APIVersion(v string) OwnerReferenceBuilder[R] {
+	b.get().APIVersion = Some(v)
+	return b
+}
+
+// Kind sets the expected Kind.
+func (b OwnerReferenceBuilder[R]) Kind(v string) OwnerReferenceBuilder[R] {
+	b.get().Kind = Some(v)
+	return b
+}
+
+// Name sets the expected Name.
+func (b OwnerReferenceBuilder[R]) Name(v string) OwnerReferenceBuilder[R] {
+	b.get().Name = Some(v)
+	return b
+}
+
+// UID sets the expected UID.
+func (b OwnerReferenceBuilder[R]) UID(v types.UID) OwnerReferenceBuilder[R] {
+	b.get().UID = Some(v)
+	return b
+}
+
+// Controller sets the expected Controller.
+func (b OwnerReferenceBuilder[R]) Controller(v bool) OwnerReferenceBuilder[R] {
+	b.get().Controller = SomePtr(v)
+	return b
+}
+
+// BlockOwnerDeletion sets the expected BlockOwnerDeletion.
+func (b OwnerReferenceBuilder[R]) BlockOwnerDeletion(v bool) OwnerReferenceBuilder[R] {
+	b.get().BlockOwnerDeletion = SomePtr(v)
+	return b
+}
+
+// TimeBuilder fluently fills in a TimeAssertion that is part of the
+// assertion struct R returned by Build.
+type TimeBuilder[R any] struct {
+	root *R
+	get  func() *TimeAssertion
+}
+
+// Build returns the root assertion struct.
+func (b TimeBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying TimeAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b TimeBuilder[R]) With(fn func(*TimeAssertion)) TimeBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// Time sets the expected Time.
+func (b TimeBuilder[R]) Time(v time.Time) TimeBuilder[R] {
+	b.get().Time = Some(v)
+	return b
+}
+
+// TypeMetaBuilder fluently fills in a TypeMetaAssertion that is part of the
+// assertion struct R returned by Build.
+type TypeMetaBuilder[R any] struct {
+	root *R
+	get  func() *TypeMetaAssertion
+}
+
+// Build returns the root assertion struct.
+func (b TypeMetaBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying TypeMetaAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b TypeMetaBuilder[R]) With(fn func(*TypeMetaAssertion)) TypeMetaBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// Kind sets the expected Kind.
+func (b TypeMetaBuilder[R]) Kind(v string) TypeMetaBuilder[R] {
+	b.get().Kind = Some(v)
+	return b
+}
+
+// APIVersion sets the expected APIVersion.
+func (b TypeMetaBuilder[R]) APIVersion(v string) TypeMetaBuilder[R] {
+	b.get().APIVersion = Some(v)
+	return b
+}
+
+// IntOrStringBuilder fluently fills in a IntOrStringAssertion that is part of the
+// assertion struct R returned by Build.
+type IntOrStringBuilder[R any] struct {
+	root *R
+	get  func() *IntOrStringAssertion
+}
+
+// Build returns the root assertion struct.
+func (b IntOrStringBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying IntOrStringAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b IntOrStringBuilder[R]) With(fn func(*IntOrStringAssertion)) IntOrStringBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// Type sets the expected Type.
+func (b IntOrStringBuilder[R]) Type(v intstr.Type) IntOrStringBuilder[R] {
+	b.get().Type = Some(v)
+	return b
+}
+
+// IntVal sets the expected IntVal.
+func (b IntOrStringBuilder[R]) IntVal(v int32) IntOrStringBuilder[R] {
+	b.get().IntVal = Some(v)
+	return b
+}
+
+// StrVal sets the expected StrVal.
+func (b IntOrStringBuilder[R]) StrVal(v string) IntOrStringBuilder[R] {
+	b.get().StrVal = Some(v)
+	return b
+}
+
+// BackendObjectReferenceBuilder fluently fills in a BackendObjectReferenceAssertion that is part of the
+// assertion struct R returned by Build.
+type BackendObjectReferenceBuilder[R any] struct {
+	root *R
+	get  func() *BackendObjectReferenceAssertion
+}
+
+// Build returns the root assertion struct.
+func (b BackendObjectReferenceBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying BackendObjectReferenceAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b BackendObjectReferenceBuilder[R]) With(fn func(*BackendObjectReferenceAssertion)) BackendObjectReferenceBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// Group sets the expected Group.
+func (b BackendObjectReferenceBuilder[R]) Group(v v17.Group) BackendObjectReferenceBuilder[R] {
+	b.get().Group = SomePtr(v)
+	return b
+}
+
+// Kind sets the expected Kind.
+func (b BackendObjectReferenceBuilder[R]) Kind(v v17.Kind) BackendObjectReferenceBuilder[R] {
+	b.get().Kind = SomePtr(v)
+	return b
+}
+
+// Name sets the expected Name.
+func (b BackendObjectReferenceBuilder[R]) Name(v v17.ObjectName) BackendObjectReferenceBuilder[R] {
+	b.get().Name = Some(v)
+	return b
+}
+
+// Namespace sets the expected Namespace.
+func (b BackendObjectReferenceBuilder[R]) Namespace(v v17.Namespace) BackendObjectReferenceBuilder[R] {
+	b.get().Namespace = SomePtr(v)
+	return b
+}
+
+// Port sets the expected Port.
+func (b BackendObjectReferenceBuilder[R]) Port(v v17.PortNumber) BackendObjectReferenceBuilder[R] {
+	b.get().Port = SomePtr(v)
+	return b
+}
+
+// BackendRefBuilder fluently fills in a BackendRefAssertion that is part of the
+// assertion struct R returned by Build.
+type BackendRefBuilder[R any] struct {
+	root *R
+	get  func() *BackendRefAssertion
+}
+
+// Build returns the root assertion struct.
+func (b BackendRefBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying BackendRefAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b BackendRefBuilder[R]) With(fn func(*BackendRefAssertion)) BackendRefBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// BackendObjectReference descends into the BackendObjectReference field.
+func (b BackendRefBuilder[R]) BackendObjectReference() BackendObjectReferenceBuilder[R] {
+	get := b.get
+	return BackendObjectReferenceBuilder[R]{
+		get: func() *BackendObjectReferenceAssertion {
+			return &get().BackendObjectReference
+		},
+		root: b.root,
+	}
+}
+
+// Weight sets the expected Weight.
+func (b BackendRefBuilder[R]) Weight(v int32) BackendRefBuilder[R] {
+	b.get().Weight = SomePtr(v)
+	return b
+}
+
+// CommonRouteSpecBuilder fluently fills in a CommonRouteSpecAssertion that is part of the
+// assertion struct R returned by Build.
+type CommonRouteSpecBuilder[R any] struct {
+	root *R
+	get  func() *CommonRouteSpecAssertion
+}
+
+// Build returns the root assertion struct.
+func (b CommonRouteSpecBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying CommonRouteSpecAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b CommonRouteSpecBuilder[R]) With(fn func(*CommonRouteSpecAssertion)) CommonRouteSpecBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// ParentRefs sets ParentRefs to exactly the given elements, compared by position.
+func (b CommonRouteSpecBuilder[R]) ParentRefs(v []ApisParentReferenceAssertion) CommonRouteSpecBuilder[R] {
+	b.get().ParentRefs = Some(v)
+	return b
+}
+
+// ParentRef descends into the element of ParentRefs with the given Name,
+// adding it if needed. ParentRefs is compared with ByKey semantics.
+func (b CommonRouteSpecBuilder[R]) ParentRef(name string) ApisParentReferenceBuilder[R] {
+	get := b.get
+	keyedElement(&get().ParentRefs, name)
+	return ApisParentReferenceBuilder[R]{
+		get: func() *ApisParentReferenceAssertion {
+			return keyedElement(&get().ParentRefs, name)
+		},
+		root: b.root,
+	}
+}
+
+// UseDefaultGateways sets the expected UseDefaultGateways.
+func (b CommonRouteSpecBuilder[R]) UseDefaultGateways(v v17.GatewayDefaultScope) CommonRouteSpecBuilder[R] {
+	b.get().UseDefaultGateways = Some(v)
+	return b
+}
+
+// CookieConfigBuilder fluently fills in a CookieConfigAssertion that is part of the
+// assertion struct R returned by Build.
+type CookieConfigBuilder[R any] struct {
+	root *R
+	get  func() *CookieConfigAssertion
+}
+
+// Build returns the root assertion struct.
+func (b CookieConfigBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying CookieConfigAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b CookieConfigBuilder[R]) With(fn func(*CookieConfigAssertion)) CookieConfigBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// LifetimeType sets the expected LifetimeType.
+func (b CookieConfigBuilder[R]) LifetimeType(v v17.CookieLifetimeType) CookieConfigBuilder[R] {
+	b.get().LifetimeType = SomePtr(v)
+	return b
+}
+
+// ForwardBodyConfigBuilder fluently fills in a ForwardBodyConfigAssertion that is part of the
+// assertion struct R returned by Build.
+type ForwardBodyConfigBuilder[R any] struct {
+	root *R
+	get  func() *ForwardBodyConfigAssertion
+}
+
+// Build returns the root assertion struct.
+func (b ForwardBodyConfigBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying ForwardBodyConfigAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b ForwardBodyConfigBuilder[R]) With(fn func(*ForwardBodyConfigAssertion)) ForwardBodyConfigBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// MaxSize sets the expected MaxSize.
+func (b ForwardBodyConfigBuilder[R]) MaxSize(v uint16) ForwardBodyConfigBuilder[R] {
+	b.get().MaxSize = Some(v)
+	return b
+}
+
+// FractionBuilder fluently fills in a FractionAssertion that is part of the
+// assertion struct R returned by Build.
+type FractionBuilder[R any] struct {
+	root *R
+	get  func() *FractionAssertion
+}
+
+// Build returns the root assertion struct.
+func (b FractionBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying FractionAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b FractionBuilder[R]) With(fn func(*FractionAssertion)) FractionBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// Numerator sets the expected Numerator.
+func (b FractionBuilder[R]) Numerator(v int32) FractionBuilder[R] {
+	b.get().Numerator = Some(v)
+	return b
+}
+
+// Denominator sets the expected Denominator.
+func (b FractionBuilder[R]) Denominator(v int32) FractionBuilder[R] {
+	b.get().Denominator = SomePtr(v)
+	return b
+}
+
+// GRPCAuthConfigBuilder fluently fills in a GRPCAuthConfigAssertion that is part of the
+// assertion struct R returned by Build.
+type GRPCAuthConfigBuilder[R any] struct {
+	root *R
+	get  func() *GRPCAuthConfigAssertion
+}
+
+// Build returns the root assertion struct.
+func (b GRPCAuthConfigBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying GRPCAuthConfigAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b GRPCAuthConfigBuilder[R]) With(fn func(*GRPCAuthConfigAssertion)) GRPCAuthConfigBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// AllowedRequestHeaders sets the expected AllowedRequestHeaders.
+func (b GRPCAuthConfigBuilder[R]) AllowedRequestHeaders(v []string) GRPCAuthConfigBuilder[R] {
+	b.get().AllowedRequestHeaders = Some(v)
+	return b
+}
+
+// GRPCBackendRefBuilder fluently fills in a GRPCBackendRefAssertion that is part of the
+// assertion struct R returned by Build.
+type GRPCBackendRefBuilder[R any] struct {
+	root *R
+	get  func() *GRPCBackendRefAssertion
+}
+
+// Build returns the root assertion struct.
+func (b GRPCBackendRefBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying GRPCBackendRefAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b GRPCBackendRefBuilder[R]) With(fn func(*GRPCBackendRefAssertion)) GRPCBackendRefBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// BackendRef descends into the BackendRef field.
+func (b GRPCBackendRefBuilder[R]) BackendRef() BackendRefBuilder[R] {
+	get := b.get
+	return BackendRefBuilder[R]{
+		get: func() *BackendRefAssertion {
+			return &get().BackendRef
+		},
+		root: b.root,
+	}
+}
+
+// Filters sets Filters to exactly the given elements, compared by position.
+func (b GRPCBackendRefBuilder[R]) Filters(v []GRPCRouteFilterAssertion) GRPCBackendRefBuilder[R] {
+	b.get().Filters = Some(v)
+	return b
+}
+
+// GRPCHeaderMatchBuilder fluently fills in a GRPCHeaderMatchAssertion that is part of the
+// assertion struct R returned by Build.
+type GRPCHeaderMatchBuilder[R any] struct {
+	root *R
+	get  func() *GRPCHeaderMatchAssertion
+}
+
+// Build returns the root assertion struct.
+func (b GRPCHeaderMatchBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying GRPCHeaderMatchAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b GRPCHeaderMatchBuilder[R]) With(fn func(*GRPCHeaderMatchAssertion)) GRPCHeaderMatchBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// Type sets the expected Type.
+func (b GRPCHeaderMatchBuilder[R]) Type(v v17.GRPCHeaderMatchType) GRPCHeaderMatchBuilder[R] {
+	b.get().Type = SomePtr(v)
+	return b
+}
+
+// Name sets the expected Name.
+func (b GRPCHeaderMatchBuilder[R]) Name(v v17.GRPCHeaderName) GRPCHeaderMatchBuilder[R] {
+	b.get().Name = Some(v)
+	return b
+}
+
+// Value sets the expected Value.
+func (b GRPCHeaderMatchBuilder[R]) Value(v string) GRPCHeaderMatchBuilder[R] {
+	b.get().Value = Some(v)
+	return b
+}
+
+// GRPCMethodMatchBuilder fluently fills in a GRPCMethodMatchAssertion that is part of the
+// assertion struct R returned by Build.
+type GRPCMethodMatchBuilder[R any] struct {
+	root *R
+	get  func() *GRPCMethodMatchAssertion
+}
+
+// Build returns the root assertion struct.
+func (b GRPCMethodMatchBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying GRPCMethodMatchAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b GRPCMethodMatchBuilder[R]) With(fn func(*GRPCMethodMatchAssertion)) GRPCMethodMatchBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// Type sets the expected Type.
+func (b GRPCMethodMatchBuilder[R]) Type(v v17.GRPCMethodMatchType) GRPCMethodMatchBuilder[R] {
+	b.get().Type = SomePtr(v)
+	return b
+}
+
+// Service sets the expected Service.
+func (b GRPCMethodMatchBuilder[R]) Service(v string) GRPCMethodMatchBuilder[R] {
+	b.get().Service = SomePtr(v)
+	return b
+}
+
+// Method sets the expected Method.
+func (b GRPCMethodMatchBuilder[R]) Method(v string) GRPCMethodMatchBuilder[R] {
+	b.get().Method = SomePtr(v)
+	return b
+}
+
+// GRPCRouteBuilder fluently fills in a GRPCRouteAssertion that is part of the
+// assertion struct R returned by Build.
+type GRPCRouteBuilder[R any] struct {
+	root *R
+	get  func() *GRPCRouteAssertion
+}
+
+// Build returns the root assertion struct.
+func (b GRPCRouteBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying GRPCRouteAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b GRPCRouteBuilder[R]) With(fn func(*GRPCRouteAssertion)) GRPCRouteBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// TypeMeta descends into the TypeMeta field.
+func (b GRPCRouteBuilder[R]) TypeMeta() TypeMetaBuilder[R] {
+	get := b.get
+	return TypeMetaBuilder[R]{
+		get: func() *TypeMetaAssertion {
+			return &get().TypeMeta
+		},
+		root: b.root,
+	}
+}
+
+// ObjectMeta descends into the ObjectMeta field.
+func (b GRPCRouteBuilder[R]) ObjectMeta() ObjectMetaBuilder[R] {
+	get := b.get
+	return ObjectMetaBuilder[R]{
+		get: func() *ObjectMetaAssertion {
+			return &get().ObjectMeta
+		},
+		root: b.root,
+	}
+}
+
+// Spec descends into the Spec field.
+func (b GRPCRouteBuilder[R]) Spec() GRPCRouteSpecBuilder[R] {
+	get := b.get
+	return GRPCRouteSpecBuilder[R]{
+		get: func() *GRPCRouteSpecAssertion {
+			return &get().Spec
+		},
+		root: b.root,
+	}
+}
+
+// Status sets the expected Status.
+func (b GRPCRouteBuilder[R]) Status(v v17.GRPCRouteStatus) GRPCRouteBuilder[R] {
+	b.get().Status = Some(v)
+	return b
+}
+
+// GRPCRouteFilterBuilder fluently fills in a GRPCRouteFilterAssertion that is part of the
+// assertion struct R returned by Build.
+type GRPCRouteFilterBuilder[R any] struct {
+	root *R
+	get  func() *GRPCRouteFilterAssertion
+}
+
+// Build returns the root assertion struct.
+func (b GRPCRouteFilterBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying GRPCRouteFilterAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b GRPCRouteFilterBuilder[R]) With(fn func(*GRPCRouteFilterAssertion)) GRPCRouteFilterBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// Type sets the expected Type.
+func (b GRPCRouteFilterBuilder[R]) Type(v v17.GRPCRouteFilterType) GRPCRouteFilterBuilder[R] {
+	b.get().Type = Some(v)
+	return b
+}
+
+// RequestHeaderModifier descends into the RequestHeaderModifier field.
+func (b GRPCRouteFilterBuilder[R]) RequestHeaderModifier() HTTPHeaderFilterBuilder[R] {
+	get := b.get
+	return HTTPHeaderFilterBuilder[R]{
+		get: func() *HTTPHeaderFilterAssertion {
+			return &get().RequestHeaderModifier
+		},
+		root: b.root,
+	}
+}
+
+// ResponseHeaderModifier descends into the ResponseHeaderModifier field.
+func (b GRPCRouteFilterBuilder[R]) ResponseHeaderModifier() HTTPHeaderFilterBuilder[R] {
+	get := b.get
+	return HTTPHeaderFilterBuilder[R]{
+		get: func() *HTTPHeaderFilterAssertion {
+			return &get().ResponseHeaderModifier
+		},
+		root: b.root,
+	}
+}
+
+// RequestMirror descends into the RequestMirror field.
+func (b GRPCRouteFilterBuilder[R]) RequestMirror() HTTPRequestMirrorFilterBuilder[R] {
+	get := b.get
+	return HTTPRequestMirrorFilterBuilder[R]{
+		get: func() *HTTPRequestMirrorFilterAssertion {
+			return &get().RequestMirror
+		},
+		root: b.root,
+	}
+}
+
+// ExtensionRef descends into the ExtensionRef field.
+func (b GRPCRouteFilterBuilder[R]) ExtensionRef() ApisLocalObjectReferenceBuilder[R] {
+	get := b.get
+	return ApisLocalObjectReferenceBuilder[R]{
+		get: func() *ApisLocalObjectReferenceAssertion {
+			return &get().ExtensionRef
+		},
+		root: b.root,
+	}
+}
+
+// GRPCRouteMatchBuilder fluently fills in a GRPCRouteMatchAssertion that is part of the
+// assertion struct R returned by Build.
+type GRPCRouteMatchBuilder[R any] struct {
+	root *R
+	get  func() *GRPCRouteMatchAssertion
+}
+
+// Build returns the root assertion struct.
+func (b GRPCRouteMatchBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying GRPCRouteMatchAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b GRPCRouteMatchBuilder[R]) With(fn func(*GRPCRouteMatchAssertion)) GRPCRouteMatchBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// Method descends into the Method field.
+func (b GRPCRouteMatchBuilder[R]) Method() GRPCMethodMatchBuilder[R] {
+	get := b.get
+	return GRPCMethodMatchBuilder[R]{
+		get: func() *GRPCMethodMatchAssertion {
+			return &get().Method
+		},
+		root: b.root,
+	}
+}
+
+// Headers sets Headers to exactly the given elements, compared by position.
+func (b GRPCRouteMatchBuilder[R]) Headers(v []GRPCHeaderMatchAssertion) GRPCRouteMatchBuilder[R] {
+	b.get().Headers = Some(v)
+	return b
+}
+
+// Header descends into the element of Headers with the given Name,
+// adding it if needed. Headers is compared with ByKey semantics.
+func (b GRPCRouteMatchBuilder[R]) Header(name string) GRPCHeaderMatchBuilder[R] {
+	get := b.get
+	keyedElement(&get().Headers, name)
+	return GRPCHeaderMatchBuilder[R]{
+		get: func() *GRPCHeaderMatchAssertion {
+			return keyedElement(&get().Headers, name)
+		},
+		root: b.root,
+	}
+}
+
+// GRPCRouteRuleBuilder fluently fills in a GRPCRouteRuleAssertion that is part of the
+// assertion struct R returned by Build.
+type GRPCRouteRuleBuilder[R any] struct {
+	root *R
+	get  func() *GRPCRouteRuleAssertion
+}
+
+// Build returns the root assertion struct.
+func (b GRPCRouteRuleBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying GRPCRouteRuleAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b GRPCRouteRuleBuilder[R]) With(fn func(*GRPCRouteRuleAssertion)) GRPCRouteRuleBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// Name sets the expected Name.
+func (b GRPCRouteRuleBuilder[R]) Name(v v17.SectionName) GRPCRouteRuleBuilder[R] {
+	b.get().Name = SomePtr(v)
+	return b
+}
+
+// Matches sets Matches to exactly the given elements, compared by position.
+func (b GRPCRouteRuleBuilder[R]) Matches(v []GRPCRouteMatchAssertion) GRPCRouteRuleBuilder[R] {
+	b.get().Matches = Some(v)
+	return b
+}
+
+// Filters sets Filters to exactly the given elements, compared by position.
+func (b GRPCRouteRuleBuilder[R]) Filters(v []GRPCRouteFilterAssertion) GRPCRouteRuleBuilder[R] {
+	b.get().Filters = Some(v)
+	return b
+}
+
+// BackendRefs sets BackendRefs to exactly the given elements, compared by position.
+func (b GRPCRouteRuleBuilder[R]) BackendRefs(v []GRPCBackendRefAssertion) GRPCRouteRuleBuilder[R] {
+	b.get().BackendRefs = Some(v)
+	return b
+}
+
+// SessionPersistence descends into the SessionPersistence field.
+func (b GRPCRouteRuleBuilder[R]) SessionPersistence() SessionPersistenceBuilder[R] {
+	get := b.get
+	return SessionPersistenceBuilder[R]{
+		get: func() *SessionPersistenceAssertion {
+			return &get().SessionPersistence
+		},
+		root: b.root,
+	}
+}
+
+// GRPCRouteSpecBuilder fluently fills in a GRPCRouteSpecAssertion that is part of the
+// assertion struct R returned by Build.
+type GRPCRouteSpecBuilder[R any] struct {
+	root *R
+	get  func() *GRPCRouteSpecAssertion
+}
+
+// Build returns the root assertion struct.
+func (b GRPCRouteSpecBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying GRPCRouteSpecAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b GRPCRouteSpecBuilder[R]) With(fn func(*GRPCRouteSpecAssertion)) GRPCRouteSpecBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// CommonRouteSpec descends into the CommonRouteSpec field.
+func (b GRPCRouteSpecBuilder[R]) CommonRouteSpec() CommonRouteSpecBuilder[R] {
+	get := b.get
+	return CommonRouteSpecBuilder[R]{
+		get: func() *CommonRouteSpecAssertion {
+			return &get().CommonRouteSpec
+		},
+		root: b.root,
+	}
+}
+
+// Hostnames sets the expected Hostnames.
+func (b GRPCRouteSpecBuilder[R]) Hostnames(v []v17.Hostname) GRPCRouteSpecBuilder[R] {
+	b.get().Hostnames = Some(v)
+	return b
+}
+
+// Rules sets Rules to exactly the given elements, compared by position.
+func (b GRPCRouteSpecBuilder[R]) Rules(v []GRPCRouteRuleAssertion) GRPCRouteSpecBuilder[R] {
+	b.get().Rules = Some(v)
+	return b
+}
+
+// HTTPAuthConfigBuilder fluently fills in a HTTPAuthConfigAssertion that is part of the
+// assertion struct R returned by Build.
+type HTTPAuthConfigBuilder[R any] struct {
+	root *R
+	get  func() *HTTPAuthConfigAssertion
+}
+
+// Build returns the root assertion struct.
+func (b HTTPAuthConfigBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying HTTPAuthConfigAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b HTTPAuthConfigBuilder[R]) With(fn func(*HTTPAuthConfigAssertion)) HTTPAuthConfigBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// Path sets the expected Path.
+func (b HTTPAuthConfigBuilder[R]) Path(v string) HTTPAuthConfigBuilder[R] {
+	b.get().Path = Some(v)
+	return b
+}
+
+// AllowedRequestHeaders sets the expected AllowedRequestHeaders.
+func (b HTTPAuthConfigBuilder[R]) AllowedRequestHeaders(v []string) HTTPAuthConfigBuilder[R] {
+	b.get().AllowedRequestHeaders = Some(v)
+	return b
+}
+
+// AllowedResponseHeaders sets the expected AllowedResponseHeaders.
+func (b HTTPAuthConfigBuilder[R]) AllowedResponseHeaders(v []string) HTTPAuthConfigBuilder[R] {
+	b.get().AllowedResponseHeaders = Some(v)
+	return b
+}
+
+// HTTPBackendRefBuilder fluently fills in a HTTPBackendRefAssertion that is part of the
+// assertion struct R returned by Build.
+type HTTPBackendRefBuilder[R any] struct {
+	root *R
+	get  func() *HTTPBackendRefAssertion
+}
+
+// Build returns the root assertion struct.
+func (b HTTPBackendRefBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying HTTPBackendRefAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b HTTPBackendRefBuilder[R]) With(fn func(*HTTPBackendRefAssertion)) HTTPBackendRefBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// BackendRef descends into the BackendRef field.
+func (b HTTPBackendRefBuilder[R]) BackendRef() BackendRefBuilder[R] {
+	get := b.get
+	return BackendRefBuilder[R]{
+		get: func() *BackendRefAssertion {
+			return &get().BackendRef
+		},
+		root: b.root,
+	}
+}
+
+// Filters sets Filters to exactly the given elements, compared by position.
+func (b HTTPBackendRefBuilder[R]) Filters(v []HTTPRouteFilterAssertion) HTTPBackendRefBuilder[R] {
+	b.get().Filters = Some(v)
+	return b
+}
+
+// HTTPCORSFilterBuilder fluently fills in a HTTPCORSFilterAssertion that is part of the
+// assertion struct R returned by Build.
+type HTTPCORSFilterBuilder[R any] struct {
+	root *R
+	get  func() *HTTPCORSFilterAssertion
+}
+
+// Build returns the root assertion struct.
+func (b HTTPCORSFilterBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying HTTPCORSFilterAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b HTTPCORSFilterBuilder[R]) With(fn func(*HTTPCORSFilterAssertion)) HTTPCORSFilterBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// AllowOrigins sets the expected AllowOrigins.
+func (b HTTPCORSFilterBuilder[R]) AllowOrigins(v []v17.CORSOrigin) HTTPCORSFilterBuilder[R] {
+	b.get().AllowOrigins = Some(v)
+	return b
+}
+
+// AllowCredentials sets the expected AllowCredentials.
+func (b HTTPCORSFilterBuilder[R]) AllowCredentials(v bool) HTTPCORSFilterBuilder[R] {
+	b.get().AllowCredentials = SomePtr(v)
+	return b
+}
+
+// AllowMethods sets the expected AllowMethods.
+func (b HTTPCORSFilterBuilder[R]) AllowMethods(v []v17.HTTPMethodWithWildcard) HTTPCORSFilterBuilder[R] {
+	b.get().AllowMethods = Some(v)
+	return b
+}
+
+// AllowHeaders sets the expected AllowHeaders.
+func (b HTTPCORSFilterBuilder[R]) AllowHeaders(v []v17.HTTPHeaderName) HTTPCORSFilterBuilder[R] {
+	b.get().AllowHeaders = Some(v)
+	return b
+}
+
+// ExposeHeaders sets the expected ExposeHeaders.
+func (b HTTPCORSFilterBuilder[R]) ExposeHeaders(v []v17.HTTPHeaderName) HTTPCORSFilterBuilder[R] {
+	b.get().ExposeHeaders = Some(v)
+	return b
+}
+
+// MaxAge sets the expected MaxAge.
+func (b HTTPCORSFilterBuilder[R]) MaxAge(v int32) HTTPCORSFilterBuilder[R] {
+	b.get().MaxAge = Some(v)
+	return b
+}
+
+// HTTPExternalAuthFilterBuilder fluently fills in a HTTPExternalAuthFilterAssertion that is part of the
+// assertion struct R returned by Build.
+type HTTPExternalAuthFilterBuilder[R any] struct {
+	root *R
+	get  func() *HTTPExternalAuthFilterAssertion
+}
+
+// Build returns the root assertion struct.
+func (b HTTPExternalAuthFilterBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying HTTPExternalAuthFilterAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b HTTPExternalAuthFilterBuilder[R]) With(fn func(*HTTPExternalAuthFilterAssertion)) HTTPExternalAuthFilterBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// ExternalAuthProtocol sets the expected ExternalAuthProtocol.
+func (b HTTPExternalAuthFilterBuilder[R]) ExternalAuthProtocol(v v17.HTTPRouteExternalAuthProtocol) HTTPExternalAuthFilterBuilder[R] {
+	b.get().ExternalAuthProtocol = Some(v)
+	return b
+}
+
+// BackendRef descends into the BackendRef field.
+func (b HTTPExternalAuthFilterBuilder[R]) BackendRef() BackendObjectReferenceBuilder[R] {
+	get := b.get
+	return BackendObjectReferenceBuilder[R]{
+		get: func() *BackendObjectReferenceAssertion {
+			return &get().BackendRef
+		},
+		root: b.root,
+	}
+}
+
+// GRPCAuthConfig descends into the GRPCAuthConfig field.
+func (b HTTPExternalAuthFilterBuilder[R]) GRPCAuthConfig() GRPCAuthConfigBuilder[R] {
+	get := b.get
+	return GRPCAuthConfigBuilder[R]{
+		get: func() *GRPCAuthConfigAssertion {
+			return &get().GRPCAuthConfig
+		},
+		root: b.root,
+	}
+}
+
+// HTTPAuthConfig descends into the HTTPAuthConfig field.
+func (b HTTPExternalAuthFilterBuilder[R]) HTTPAuthConfig() HTTPAuthConfigBuilder[R] {
+	get := b.get
+	return HTTPAuthConfigBuilder[R]{
+		get: func() *HTTPAuthConfigAssertion {
+			return &get().HTTPAuthConfig
+		},
+		root: b.root,
+	}
+}
+
+// ForwardBody descends into the ForwardBody field.
+func (b HTTPExternalAuthFilterBuilder[R]) ForwardBody() ForwardBodyConfigBuilder[R] {
+	get := b.get
+	return ForwardBodyConfigBuilder[R]{
+		get: func() *ForwardBodyConfigAssertion {
+			return &get().ForwardBody
+		},
+		root: b.root,
+	}
+}
+
+// ApisHTTPHeaderBuilder fluently fills in a ApisHTTPHeaderAssertion that is part of the
+// assertion struct R returned by Build.
+type ApisHTTPHeaderBuilder[R any] struct {
+	root *R
+	get  func() *ApisHTTPHeaderAssertion
+}
+
+// Build returns the root assertion struct.
+func (b ApisHTTPHeaderBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying ApisHTTPHeaderAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b ApisHTTPHeaderBuilder[R]) With(fn func(*ApisHTTPHeaderAssertion)) ApisHTTPHeaderBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// Name sets the expected Name.
+func (b ApisHTTPHeaderBuilder[R]) Name(v v17.HTTPHeaderName) ApisHTTPHeaderBuilder[R] {
+	b.get().Name = Some(v)
+	return b
+}
+
+// Value sets the expected Value.
+func (b ApisHTTPHeaderBuilder[R]) Value(v string) ApisHTTPHeaderBuilder[R] {
+	b.get().Value = Some(v)
+	return b
+}
+
+// HTTPHeaderFilterBuilder fluently fills in a HTTPHeaderFilterAssertion that is part of the
+// assertion struct R returned by Build.
+type HTTPHeaderFilterBuilder[R any] struct {
+	root *R
+	get  func() *HTTPHeaderFilterAssertion
+}
+
+// Build returns the root assertion struct.
+func (b HTTPHeaderFilterBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying HTTPHeaderFilterAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b HTTPHeaderFilterBuilder[R]) With(fn func(*HTTPHeaderFilterAssertion)) HTTPHeaderFilterBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// Set sets Set to exactly the given elements, compared by position.
+func (b HTTPHeaderFilterBuilder[R]) Set(v []ApisHTTPHeaderAssertion) HTTPHeaderFilterBuilder[R] {
+	b.get().Set = Some(v)
+	return b
+}
+
+// HTTPHeader descends into the element of Set with the given Name,
+// adding it if needed. Set is compared with ByKey semantics.
+func (b HTTPHeaderFilterBuilder[R]) HTTPHeader(name string) ApisHTTPHeaderBuilder[R] {
+	get := b.get
+	keyedElement(&get().Set, name)
+	return ApisHTTPHeaderBuilder[R]{
+		get: func() *ApisHTTPHeaderAssertion {
+			return keyedElement(&get().Set, name)
+		},
+		root: b.root,
+	}
+}
+
+// Add sets Add to exactly the given elements, compared by position.
+func (b HTTPHeaderFilterBuilder[R]) Add(v []ApisHTTPHeaderAssertion) HTTPHeaderFilterBuilder[R] {
+	b.get().Add = Some(v)
+	return b
+}
+
+// Remove sets the expected Remove.
+func (b HTTPHeaderFilterBuilder[R]) Remove(v []string) HTTPHeaderFilterBuilder[R] {
+	b.get().Remove = Some(v)
+	return b
+}
+
+// HTTPHeaderMatchBuilder fluently fills in a HTTPHeaderMatchAssertion that is part of the
+// assertion struct R returned by Build.
+type HTTPHeaderMatchBuilder[R any] struct {
+	root *R
+	get  func() *HTTPHeaderMatchAssertion
+}
+
+// Build returns the root assertion struct.
+func (b HTTPHeaderMatchBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying HTTPHeaderMatchAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b HTTPHeaderMatchBuilder[R]) With(fn func(*HTTPHeaderMatchAssertion)) HTTPHeaderMatchBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// Type sets the expected Type.
+func (b HTTPHeaderMatchBuilder[R]) Type(v v17.HeaderMatchType) HTTPHeaderMatchBuilder[R] {
+	b.get().Type = SomePtr(v)
+	return b
+}
+
+// Name sets the expected Name.
+func (b HTTPHeaderMatchBuilder[R]) Name(v v17.HTTPHeaderName) HTTPHeaderMatchBuilder[R] {
+	b.get().Name = Some(v)
+	return b
+}
+
+// Value sets the expected Value.
+func (b HTTPHeaderMatchBuilder[R]) Value(v string) HTTPHeaderMatchBuilder[R] {
+	b.get().Value = Some(v)
+	return b
+}
+
+// HTTPPathMatchBuilder fluently fills in a HTTPPathMatchAssertion that is part of the
+// assertion struct R returned by Build.
+type HTTPPathMatchBuilder[R any] struct {
+	root *R
+	get  func() *HTTPPathMatchAssertion
+}
+
+// Build returns the root assertion struct.
+func (b HTTPPathMatchBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying HTTPPathMatchAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b HTTPPathMatchBuilder[R]) With(fn func(*HTTPPathMatchAssertion)) HTTPPathMatchBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// Type sets the expected Type.
+func (b HTTPPathMatchBuilder[R]) Type(v v17.PathMatchType) HTTPPathMatchBuilder[R] {
+	b.get().Type = SomePtr(v)
+	return b
+}
+
+// Value sets the expected Value.
+func (b HTTPPathMatchBuilder[R]) Value(v string) HTTPPathMatchBuilder[R] {
+	b.get().Value = SomePtr(v)
+	return b
+}
+
+// HTTPPathModifierBuilder fluently fills in a HTTPPathModifierAssertion that is part of the
+// assertion struct R returned by Build.
+type HTTPPathModifierBuilder[R any] struct {
+	root *R
+	get  func() *HTTPPathModifierAssertion
+}
+
+// Build returns the root assertion struct.
+func (b HTTPPathModifierBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying HTTPPathModifierAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b HTTPPathModifierBuilder[R]) With(fn func(*HTTPPathModifierAssertion)) HTTPPathModifierBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// Type sets the expected Type.
+func (b HTTPPathModifierBuilder[R]) Type(v v17.HTTPPathModifierType) HTTPPathModifierBuilder[R] {
+	b.get().Type = Some(v)
+	return b
+}
+
+// ReplaceFullPath sets the expected ReplaceFullPath.
+func (b HTTPPathModifierBuilder[R]) ReplaceFullPath(v string) HTTPPathModifierBuilder[R] {
+	b.get().ReplaceFullPath = SomePtr(v)
+	return b
+}
+
+// ReplacePrefixMatch sets the expected ReplacePrefixMatch.
+func (b HTTPPathModifierBuilder[R]) ReplacePrefixMatch(v string) HTTPPathModifierBuilder[R] {
+	b.get().ReplacePrefixMatch = SomePtr(v)
+	return b
+}
+
+// HTTPQueryParamMatchBuilder fluently fills in a HTTPQueryParamMatchAssertion that is part of the
+// assertion struct R returned by Build.
+type HTTPQueryParamMatchBuilder[R any] struct {
+	root *R
+	get  func() *HTTPQueryParamMatchAssertion
+}
+
+// Build returns the root assertion struct.
+func (b HTTPQueryParamMatchBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying HTTPQueryParamMatchAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b HTTPQueryParamMatchBuilder[R]) With(fn func(*HTTPQueryParamMatchAssertion)) HTTPQueryParamMatchBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// Type sets the expected Type.
+func (b HTTPQueryParamMatchBuilder[R]) Type(v v17.QueryParamMatchType) HTTPQueryParamMatchBuilder[R] {
+	b.get().Type = SomePtr(v)
+	return b
+}
+
+// Name sets the expected Name.
+func (b HTTPQueryParamMatchBuilder[R]) Name(v v17.HTTPHeaderName) HTTPQueryParamMatchBuilder[R] {
+	b.get().Name = Some(v)
+	return b
+}
+
+// Value sets the expected Value.
+func (b HTTPQueryParamMatchBuilder[R]) Value(v string) HTTPQueryParamMatchBuilder[R] {
+	b.get().Value = Some(v)
+	return b
+}
+
+// HTTPRequestMirrorFilterBuilder fluently fills in a HTTPRequestMirrorFilterAssertion that is part of the
+// assertion struct R returned by Build.
+type HTTPRequestMirrorFilterBuilder[R any] struct {
+	root *R
+	get  func() *HTTPRequestMirrorFilterAssertion
+}
+
+// Build returns the root assertion struct.
+func (b HTTPRequestMirrorFilterBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying HTTPRequestMirrorFilterAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b HTTPRequestMirrorFilterBuilder[R]) With(fn func(*HTTPRequestMirrorFilterAssertion)) HTTPRequestMirrorFilterBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// BackendRef descends into the BackendRef field.
+func (b HTTPRequestMirrorFilterBuilder[R]) BackendRef() BackendObjectReferenceBuilder[R] {
+	get := b.get
+	return BackendObjectReferenceBuilder[R]{
+		get: func() *BackendObjectReferenceAssertion {
+			return &get().BackendRef
+		},
+		root: b.root,
+	}
+}
+
+// Percent sets the expected Percent.
+func (b HTTPRequestMirrorFilterBuilder[R]) Percent(v int32) HTTPRequestMirrorFilterBuilder[R] {
+	b.get().Percent = SomePtr(v)
+	return b
+}
+
+// Fraction descends into the Fraction field.
+func (b HTTPRequestMirrorFilterBuilder[R]) Fraction() FractionBuilder[R] {
+	get := b.get
+	return FractionBuilder[R]{
+		get: func() *FractionAssertion {
+			return &get().Fraction
+		},
+		root: b.root,
+	}
+}
+
+// HTTPRequestRedirectFilterBuilder fluently fills in a HTTPRequestRedirectFilterAssertion that is part of the
+// assertion struct R returned by Build.
+type HTTPRequestRedirectFilterBuilder[R any] struct {
+	root *R
+	get  func() *HTTPRequestRedirectFilterAssertion
+}
+
+// Build returns the root assertion struct.
+func (b HTTPRequestRedirectFilterBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying HTTPRequestRedirectFilterAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b HTTPRequestRedirectFilterBuilder[R]) With(fn func(*HTTPRequestRedirectFilterAssertion)) HTTPRequestRedirectFilterBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// Scheme sets the expected Scheme.
+func (b HTTPRequestRedirectFilterBuilder[R]) Scheme(v string) HTTPRequestRedirectFilterBuilder[R] {
+	b.get().Scheme = SomePtr(v)
+	return b
+}
+
+// Hostname sets the expected Hostname.
+func (b HTTPRequestRedirectFilterBuilder[R]) Hostname(v v17.PreciseHostname) HTTPRequestRedirectFilterBuilder[R] {
+	b.get().Hostname = SomePtr(v)
+	return b
+}
+
+// Path descends into the Path field.
+func (b HTTPRequestRedirectFilterBuilder[R]) Path() HTTPPathModifierBuilder[R] {
+	get := b.get
+	return HTTPPathModifierBuilder[R]{
+		get: func() *HTTPPathModifierAssertion {
+			return &get().Path
+		},
+		root: b.root,
+	}
+}
+
+// Port sets the expected Port.
+func (b HTTPRequestRedirectFilterBuilder[R]) Port(v v17.PortNumber) HTTPRequestRedirectFilterBuilder[R] {
+	b.get().Port = SomePtr(v)
+	return b
+}
+
+// StatusCode sets the expected StatusCode.
+func (b HTTPRequestRedirectFilterBuilder[R]) StatusCode(v int) HTTPRequestRedirectFilterBuilder[R] {
+	b.get().StatusCode = SomePtr(v)
+	return b
+}
+
+// HTTPRouteBuilder fluently fills in a HTTPRouteAssertion that is part of the
+// assertion struct R returned by Build.
+type HTTPRouteBuilder[R any] struct {
+	root *R
+	get  func() *HTTPRouteAssertion
+}
+
+// Build returns the root assertion struct.
+func (b HTTPRouteBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying HTTPRouteAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b HTTPRouteBuilder[R]) With(fn func(*HTTPRouteAssertion)) HTTPRouteBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// TypeMeta descends into the TypeMeta field.
+func (b HTTPRouteBuilder[R]) TypeMeta() TypeMetaBuilder[R] {
+	get := b.get
+	return TypeMetaBuilder[R]{
+		get: func() *TypeMetaAssertion {
+			return &get().TypeMeta
+		},
+		root: b.root,
+	}
+}
+
+// ObjectMeta descends into the ObjectMeta field.
+func (b HTTPRouteBuilder[R]) ObjectMeta() ObjectMetaBuilder[R] {
+	get := b.get
+	return ObjectMetaBuilder[R]{
+		get: func() *ObjectMetaAssertion {
+			return &get().ObjectMeta
+		},
+		root: b.root,
+	}
+}
+
+// Spec descends into the Spec field.
+func (b HTTPRouteBuilder[R]) Spec() HTTPRouteSpecBuilder[R] {
+	get := b.get
+	return HTTPRouteSpecBuilder[R]{
+		get: func() *HTTPRouteSpecAssertion {
+			return &get().Spec
+		},
+		root: b.root,
+	}
+}
+
+// Status sets the expected Status.
+func (b HTTPRouteBuilder[R]) Status(v v17.HTTPRouteStatus) HTTPRouteBuilder[R] {
+	b.get().Status = Some(v)
+	return b
+}
+
+// HTTPRouteFilterBuilder fluently fills in a HTTPRouteFilterAssertion that is part of the
+// assertion struct R returned by Build.
+type HTTPRouteFilterBuilder[R any] struct {
+	root *R
+	get  func() *HTTPRouteFilterAssertion
+}
+
+// Build returns the root assertion struct.
+func (b HTTPRouteFilterBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying HTTPRouteFilterAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b HTTPRouteFilterBuilder[R]) With(fn func(*HTTPRouteFilterAssertion)) HTTPRouteFilterBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// Type sets the expected Type.
+func (b HTTPRouteFilterBuilder[R]) Type(v v17.HTTPRouteFilterType) HTTPRouteFilterBuilder[R] {
+	b.get().Type = Some(v)
+	return b
+}
+
+// RequestHeaderModifier descends into the RequestHeaderModifier field.
+func (b HTTPRouteFilterBuilder[R]) RequestHeaderModifier() HTTPHeaderFilterBuilder[R] {
+	get := b.get
+	return HTTPHeaderFilterBuilder[R]{
+		get: func() *HTTPHeaderFilterAssertion {
+			return &get().RequestHeaderModifier
+		},
+		root: b.root,
+	}
+}
+
+// ResponseHeaderModifier descends into the ResponseHeaderModifier field.
+func (b HTTPRouteFilterBuilder[R]) ResponseHeaderModifier() HTTPHeaderFilterBuilder[R] {
+	get := b.get
+	return HTTPHeaderFilterBuilder[R]{
+		get: func() *HTTPHeaderFilterAssertion {
+			return &get().ResponseHeaderModifier
+		},
+		root: b.root,
+	}
+}
+
+// RequestMirror descends into the RequestMirror field.
+func (b HTTPRouteFilterBuilder[R]) RequestMirror() HTTPRequestMirrorFilterBuilder[R] {
+	get := b.get
+	return HTTPRequestMirrorFilterBuilder[R]{
+		get: func() *HTTPRequestMirrorFilterAssertion {
+			return &get().RequestMirror
+		},
+		root: b.root,
+	}
+}
+
+// RequestRedirect descends into the RequestRedirect field.
+func (b HTTPRouteFilterBuilder[R]) RequestRedirect() HTTPRequestRedirectFilterBuilder[R] {
+	get := b.get
+	return HTTPRequestRedirectFilterBuilder[R]{
+		get: func() *HTTPRequestRedirectFilterAssertion {
+			return &get().RequestRedirect
+		},
+		root: b.root,
+	}
+}
+
+// URLRewrite descends into the URLRewrite field.
+func (b HTTPRouteFilterBuilder[R]) URLRewrite() HTTPURLRewriteFilterBuilder[R] {
+	get := b.get
+	return HTTPURLRewriteFilterBuilder[R]{
+		get: func() *HTTPURLRewriteFilterAssertion {
+			return &get().URLRewrite
+		},
+		root: b.root,
+	}
+}
+
+// CORS descends into the CORS field.
+func (b HTTPRouteFilterBuilder[R]) CORS() HTTPCORSFilterBuilder[R] {
+	get := b.get
+	return HTTPCORSFilterBuilder[R]{
+		get: func() *HTTPCORSFilterAssertion {
+			return &get().CORS
+		},
+		root: b.root,
+	}
+}
+
+// ExternalAuth descends into the ExternalAuth field.
+func (b HTTPRouteFilterBuilder[R]) ExternalAuth() HTTPExternalAuthFilterBuilder[R] {
+	get := b.get
+	return HTTPExternalAuthFilterBuilder[R]{
+		get: func() *HTTPExternalAuthFilterAssertion {
+			return &get().ExternalAuth
+		},
+		root: b.root,
+	}
+}
+
+// ExtensionRef descends into the ExtensionRef field.
+func (b HTTPRouteFilterBuilder[R]) ExtensionRef() ApisLocalObjectReferenceBuilder[R] {
+	get := b.get
+	return ApisLocalObjectReferenceBuilder[R]{
+		get: func() *ApisLocalObjectReferenceAssertion {
+			return &get().ExtensionRef
+		},
+		root: b.root,
+	}
+}
+
+// HTTPRouteMatchBuilder fluently fills in a HTTPRouteMatchAssertion that is part of the
+// assertion struct R returned by Build.
+type HTTPRouteMatchBuilder[R any] struct {
+	root *R
+	get  func() *HTTPRouteMatchAssertion
+}
+
+// Build returns the root assertion struct.
+func (b HTTPRouteMatchBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying HTTPRouteMatchAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b HTTPRouteMatchBuilder[R]) With(fn func(*HTTPRouteMatchAssertion)) HTTPRouteMatchBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// Path descends into the Path field.
+func (b HTTPRouteMatchBuilder[R]) Path() HTTPPathMatchBuilder[R] {
+	get := b.get
+	return HTTPPathMatchBuilder[R]{
+		get: func() *HTTPPathMatchAssertion {
+			return &get().Path
+		},
+		root: b.root,
+	}
+}
+
+// Headers sets Headers to exactly the given elements, compared by position.
+func (b HTTPRouteMatchBuilder[R]) Headers(v []HTTPHeaderMatchAssertion) HTTPRouteMatchBuilder[R] {
+	b.get().Headers = Some(v)
+	return b
+}
+
+// Header descends into the element of Headers with the given Name,
+// adding it if needed. Headers is compared with ByKey semantics.
+func (b HTTPRouteMatchBuilder[R]) Header(name string) HTTPHeaderMatchBuilder[R] {
+	get := b.get
+	keyedElement(&get().Headers, name)
+	return HTTPHeaderMatchBuilder[R]{
+		get: func() *HTTPHeaderMatchAssertion {
+			return keyedElement(&get().Headers, name)
+		},
+		root: b.root,
+	}
+}
+
+// QueryParams sets QueryParams to exactly the given elements, compared by position.
+func (b HTTPRouteMatchBuilder[R]) QueryParams(v []HTTPQueryParamMatchAssertion) HTTPRouteMatchBuilder[R] {
+	b.get().QueryParams = Some(v)
+	return b
+}
+
+// QueryParam descends into the element of QueryParams with the given Name,
+// adding it if needed. QueryParams is compared with ByKey semantics.
+func (b HTTPRouteMatchBuilder[R]) QueryParam(name string) HTTPQueryParamMatchBuilder[R] {
+	get := b.get
+	keyedElement(&get().QueryParams, name)
+	return HTTPQueryParamMatchBuilder[R]{
+		get: func() *HTTPQueryParamMatchAssertion {
+			return keyedElement(&get().QueryParams, name)
+		},
+		root: b.root,
+	}
+}
+
+// Method sets the expected Method.
+func (b HTTPRouteMatchBuilder[R]) Method(v v17.HTTPMethod) HTTPRouteMatchBuilder[R] {
+	b.get().Method = SomePtr(v)
+	return b
+}
+
+// HTTPRouteRetryBuilder fluently fills in a HTTPRouteRetryAssertion that is part of the
+// assertion struct R returned by Build.
+type HTTPRouteRetryBuilder[R any] struct {
+	root *R
+	get  func() *HTTPRouteRetryAssertion
+}
+
+// Build returns the root assertion struct.
+func (b HTTPRouteRetryBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying HTTPRouteRetryAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b HTTPRouteRetryBuilder[R]) With(fn func(*HTTPRouteRetryAssertion)) HTTPRouteRetryBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// Codes sets the expected Codes.
+func (b HTTPRouteRetryBuilder[R]) Codes(v []v17.HTTPRouteRetryStatusCode) HTTPRouteRetryBuilder[R] {
+	b.get().Codes = Some(v)
+	return b
+}
+
+// Attempts sets the expected Attempts.
+func (b HTTPRouteRetryBuilder[R]) Attempts(v int) HTTPRouteRetryBuilder[R] {
+	b.get().Attempts = SomePtr(v)
+	return b
+}
+
+// Backoff sets the expected Backoff.
+func (b HTTPRouteRetryBuilder[R]) Backoff(v v17.Duration) HTTPRouteRetryBuilder[R] {
+	b.get().Backoff = SomePtr(v)
+	return b
+}
+
+// HTTPRouteRuleBuilder fluently fills in a HTTPRouteRuleAssertion that is part of the
+// assertion struct R returned by Build.
+type HTTPRouteRuleBuilder[R any] struct {
+	root *R
+	get  func() *HTTPRouteRuleAssertion
+}
+
+// Build returns the root assertion struct.
+func (b HTTPRouteRuleBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying HTTPRouteRuleAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b HTTPRouteRuleBuilder[R]) With(fn func(*HTTPRouteRuleAssertion)) HTTPRouteRuleBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// Name sets the expected Name.
+func (b HTTPRouteRuleBuilder[R]) Name(v v17.SectionName) HTTPRouteRuleBuilder[R] {
+	b.get().Name = SomePtr(v)
+	return b
+}
+
+// Matches sets Matches to exactly the given elements, compared by position.
+func (b HTTPRouteRuleBuilder[R]) Matches(v []HTTPRouteMatchAssertion) HTTPRouteRuleBuilder[R] {
+	b.get().Matches = Some(v)
+	return b
+}
+
+// Filters sets Filters to exactly the given elements, compared by position.
+func (b HTTPRouteRuleBuilder[R]) Filters(v []HTTPRouteFilterAssertion) HTTPRouteRuleBuilder[R] {
+	b.get().Filters = Some(v)
+	return b
+}
+
+// BackendRefs sets BackendRefs to exactly the given elements, compared by position.
+func (b HTTPRouteRuleBuilder[R]) BackendRefs(v []HTTPBackendRefAssertion) HTTPRouteRuleBuilder[R] {
+	b.get().BackendRefs = Some(v)
+	return b
+}
+
+// Timeouts descends into the Timeouts field.
+func (b HTTPRouteRuleBuilder[R]) Timeouts() HTTPRouteTimeoutsBuilder[R] {
+	get := b.get
+	return HTTPRouteTimeoutsBuilder[R]{
+		get: func() *HTTPRouteTimeoutsAssertion {
+			return &get().Timeouts
+		},
+		root: b.root,
+	}
+}
+
+// Retry descends into the Retry field.
+func (b HTTPRouteRuleBuilder[R]) Retry() HTTPRouteRetryBuilder[R] {
+	get := b.get
+	return HTTPRouteRetryBuilder[R]{
+		get: func() *HTTPRouteRetryAssertion {
+			return &get().Retry
+		},
+		root: b.root,
+	}
+}
+
+// SessionPersistence descends into the SessionPersistence field.
+func (b HTTPRouteRuleBuilder[R]) SessionPersistence() SessionPersistenceBuilder[R] {
+	get := b.get
+	return SessionPersistenceBuilder[R]{
+		get: func() *SessionPersistenceAssertion {
+			return &get().SessionPersistence
+		},
+		root: b.root,
+	}
+}
+
+// HTTPRouteSpecBuilder fluently fills in a HTTPRouteSpecAssertion that is part of the
+// assertion struct R returned by Build.
+type HTTPRouteSpecBuilder[R any] struct {
+	root *R
+	get  func() *HTTPRouteSpecAssertion
+}
+
+// Build returns the root assertion struct.
+func (b HTTPRouteSpecBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying HTTPRouteSpecAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b HTTPRouteSpecBuilder[R]) With(fn func(*HTTPRouteSpecAssertion)) HTTPRouteSpecBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// CommonRouteSpec descends into the CommonRouteSpec field.
+func (b HTTPRouteSpecBuilder[R]) CommonRouteSpec() CommonRouteSpecBuilder[R] {
+	get := b.get
+	return CommonRouteSpecBuilder[R]{
+		get: func() *CommonRouteSpecAssertion {
+			return &get().CommonRouteSpec
+		},
+		root: b.root,
+	}
+}
+
+// Hostnames sets the expected Hostnames.
+func (b HTTPRouteSpecBuilder[R]) Hostnames(v []v17.Hostname) HTTPRouteSpecBuilder[R] {
+	b.get().Hostnames = Some(v)
+	return b
+}
+
+// Rules sets Rules to exactly the given elements, compared by position.
+func (b HTTPRouteSpecBuilder[R]) Rules(v []HTTPRouteRuleAssertion) HTTPRouteSpecBuilder[R] {
+	b.get().Rules = Some(v)
+	return b
+}
+
+// HTTPRouteTimeoutsBuilder fluently fills in a HTTPRouteTimeoutsAssertion that is part of the
+// assertion struct R returned by Build.
+type HTTPRouteTimeoutsBuilder[R any] struct {
+	root *R
+	get  func() *HTTPRouteTimeoutsAssertion
+}
+
+// Build returns the root assertion struct.
+func (b HTTPRouteTimeoutsBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying HTTPRouteTimeoutsAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b HTTPRouteTimeoutsBuilder[R]) With(fn func(*HTTPRouteTimeoutsAssertion)) HTTPRouteTimeoutsBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// Request sets the expected Request.
+func (b HTTPRouteTimeoutsBuilder[R]) Request(v v17.Duration) HTTPRouteTimeoutsBuilder[R] {
+	b.get().Request = SomePtr(v)
+	return b
+}
+
+// BackendRequest sets the expected BackendRequest.
+func (b HTTPRouteTimeoutsBuilder[R]) BackendRequest(v v17.Duration) HTTPRouteTimeoutsBuilder[R] {
+	b.get().BackendRequest = SomePtr(v)
+	return b
+}
+
+// HTTPURLRewriteFilterBuilder fluently fills in a HTTPURLRewriteFilterAssertion that is part of the
+// assertion struct R returned by Build.
+type HTTPURLRewriteFilterBuilder[R any] struct {
+	root *R
+	get  func() *HTTPURLRewriteFilterAssertion
+}
+
+// Build returns the root assertion struct.
+func (b HTTPURLRewriteFilterBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying HTTPURLRewriteFilterAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b HTTPURLRewriteFilterBuilder[R]) With(fn func(*HTTPURLRewriteFilterAssertion)) HTTPURLRewriteFilterBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// Hostname sets the expected Hostname.
+func (b HTTPURLRewriteFilterBuilder[R]) Hostname(v v17.PreciseHostname) HTTPURLRewriteFilterBuilder[R] {
+	b.get().Hostname = SomePtr(v)
+	return b
+}
+
+// Path descends into the Path field.
+func (b HTTPURLRewriteFilterBuilder[R]) Path() HTTPPathModifierBuilder[R] {
+	get := b.get
+	return HTTPPathModifierBuilder[R]{
+		get: func() *HTTPPathModifierAssertion {
+			return &get().Path
+		},
+		root: b.root,
+	}
+}
+
+// ApisLocalObjectReferenceBuilder fluently fills in a ApisLocalObjectReferenceAssertion that is part of the
+// assertion struct R returned by Build.
+type ApisLocalObjectReferenceBuilder[R any] struct {
+	root *R
+	get  func() *ApisLocalObjectReferenceAssertion
+}
+
+// Build returns the root assertion struct.
+func (b ApisLocalObjectReferenceBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying ApisLocalObjectReferenceAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b ApisLocalObjectReferenceBuilder[R]) With(fn func(*ApisLocalObjectReferenceAssertion)) ApisLocalObjectReferenceBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// Group sets the expected Group.
+func (b ApisLocalObjectReferenceBuilder[R]) Group(v v17.Group) ApisLocalObjectReferenceBuilder[R] {
+	b.get().Group = Some(v)
+	return b
+}
+
+// Kind sets the expected Kind.
+func (b ApisLocalObjectReferenceBuilder[R]) Kind(v v17.Kind) ApisLocalObjectReferenceBuilder[R] {
+	b.get().Kind = Some(v)
+	return b
+}
+
+// Name sets the expected Name.
+func (b ApisLocalObjectReferenceBuilder[R]) Name(v v17.ObjectName) ApisLocalObjectReferenceBuilder[R] {
+	b.get().Name = Some(v)
+	return b
+}
+
+// ApisParentReferenceBuilder fluently fills in a ApisParentReferenceAssertion that is part of the
+// assertion struct R returned by Build.
+type ApisParentReferenceBuilder[R any] struct {
+	root *R
+	get  func() *ApisParentReferenceAssertion
+}
+
+// Build returns the root assertion struct.
+func (b ApisParentReferenceBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying ApisParentReferenceAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b ApisParentReferenceBuilder[R]) With(fn func(*ApisParentReferenceAssertion)) ApisParentReferenceBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// Group sets the expected Group.
+func (b ApisParentReferenceBuilder[R]) Group(v v17.Group) ApisParentReferenceBuilder[R] {
+	b.get().Group = SomePtr(v)
+	return b
+}
+
+// Kind sets the expected Kind.
+func (b ApisParentReferenceBuilder[R]) Kind(v v17.Kind) ApisParentReferenceBuilder[R] {
+	b.get().Kind = SomePtr(v)
+	return b
+}
+
+// Namespace sets the expected Namespace.
+func (b ApisParentReferenceBuilder[R]) Namespace(v v17.Namespace) ApisParentReferenceBuilder[R] {
+	b.get().Namespace = SomePtr(v)
+	return b
+}
+
+// Name sets the expected Name.
+func (b ApisParentReferenceBuilder[R]) Name(v v17.ObjectName) ApisParentReferenceBuilder[R] {
+	b.get().Name = Some(v)
+	return b
+}
+
+// SectionName sets the expected SectionName.
+func (b ApisParentReferenceBuilder[R]) SectionName(v v17.SectionName) ApisParentReferenceBuilder[R] {
+	b.get().SectionName = SomePtr(v)
+	return b
+}
+
+// Port sets the expected Port.
+func (b ApisParentReferenceBuilder[R]) Port(v v17.PortNumber) ApisParentReferenceBuilder[R] {
+	b.get().Port = SomePtr(v)
+	return b
+}
+
+// SessionPersistenceBuilder fluently fills in a SessionPersistenceAssertion that is part of the
+// assertion struct R returned by Build.
+type SessionPersistenceBuilder[R any] struct {
+	root *R
+	get  func() *SessionPersistenceAssertion
+}
+
+// Build returns the root assertion struct.
+func (b SessionPersistenceBuilder[R]) Build() R {
+	return *b.root
+}
+
+// With applies fn to the underlying SessionPersistenceAssertion, for fields without
+// a dedicated builder method or to set a gomega matcher.
+func (b SessionPersistenceBuilder[R]) With(fn func(*SessionPersistenceAssertion)) SessionPersistenceBuilder[R] {
+	fn(b.get())
+	return b
+}
+
+// SessionName sets the expected SessionName.
+func (b SessionPersistenceBuilder[R]) SessionName(v string) SessionPersistenceBuilder[R] {
+	b.get().SessionName = SomePtr(v)
+	return b
+}
+
+// AbsoluteTimeout sets the expected AbsoluteTimeout.
+func (b SessionPersistenceBuilder[R]) AbsoluteTimeout(v v17.Duration) SessionPersistenceBuilder[R] {
+	b.get().AbsoluteTimeout = SomePtr(v)
+	return b
+}
+
+// IdleTimeout sets the expected IdleTimeout.
+func (b SessionPersistenceBuilder[R]) IdleTimeout(v v17.Duration) SessionPersistenceBuilder[R] {
+	b.get().IdleTimeout = SomePtr(v)
+	return b
+}
+
+// Type sets the expected Type.
+func (b SessionPersistenceBuilder[R]) Type(v v17.SessionPersistenceType) SessionPersistenceBuilder[R] {
+	b.get().Type = SomePtr(v)
+	return b
+}
+
+// CookieConfig descends into the CookieConfig field.
+func (b SessionPersistenceBuilder[R]) CookieConfig() CookieConfigBuilder[R] {
+	get := b.get
+	return CookieConfigBuilder[R]{
+		get: func() *CookieConfigAssertion {
+			return &get().CookieConfig
+		},
+		root: b.root,
+	}
+}
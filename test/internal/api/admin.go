@@ -0,0 +1,25 @@
+package api
+
+import (
+	"context"
+	"net/http"
+)
+
+// Org is an organization of the admin API.
+type Org struct {
+	ID            string `json:"id"`
+	Name          string `json:"name"`
+	PrimaryDomain string `json:"primaryDomain"`
+}
+
+// GetDefaultOrg returns the instance's default organization, in which users
+// register unless they pick another one.
+func (c *Client) GetDefaultOrg(ctx context.Context) (*Org, error) {
+	var resp struct {
+		Org *Org `json:"org"`
+	}
+	if err := c.call(ctx, http.MethodGet, "/admin/v1/orgs/default", nil, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Org, nil
+}
@@ -0,0 +1,203 @@
+// Package api is a small typed client for the ZITADEL APIs the acceptance and
+// load tests use to create fixtures: the management and admin APIs (v1) and
+// the user and organization services (v2). It calls their REST mappings,
+// which are served on the same routes as every other HTTP path, with access
+// tokens of the machine user it authenticates as, which the JWT profile token
+// source of zitadel/oidc obtains.
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/zitadel/oidc/pkg/client"
+	"github.com/zitadel/oidc/pkg/client/profile"
+	"github.com/zitadel/oidc/pkg/oidc"
+	"golang.org/x/oauth2"
+
+	httphelper "github.com/zitadel/zitadel-charts/test/internal/http"
+)
+
+// orgHeader selects the organization a v1 API call acts in.
+const orgHeader = "x-zitadel-orgid"
+
+// requestTimeout bounds a token exchange, which the token source performs
+// without a context.
+const requestTimeout = 30 * time.Second
+
+// Client calls the ZITADEL APIs of one instance as a machine user. It is
+// safe for concurrent use.
+type Client struct {
+	baseURL string
+	userID  string
+	orgID   string
+	tokens  oauth2.TokenSource
+}
+
+// New returns a client for the instance at apiBaseURL that authenticates
+// with the machine user's key, as written by ZITADEL to the machine key
+// secret. The key is exchanged for an access token with the JWT profile
+// grant on first use, and again shortly before the token expires.
+// extraScopes are requested in addition to the ones the ZITADEL APIs need.
+func New(apiBaseURL string, key []byte, extraScopes ...string) (*Client, error) {
+	tokens, err := NewTokenSource(nil, apiBaseURL, key, append([]string{
+		oidc.ScopeOpenID, oidc.ScopeProfile, oidc.ScopeEmail,
+		"urn:zitadel:iam:org:project:id:zitadel:aud",
+	}, extraScopes...)...)
+	if err != nil {
+		return nil, err
+	}
+	keyFile, err := client.ConfigFromKeyFileData(key)
+	if err != nil {
+		return nil, fmt.Errorf("reading machine key: %w", err)
+	}
+	return &Client{
+		baseURL: strings.TrimSuffix(apiBaseURL, "/"),
+		userID:  keyFile.UserID,
+		tokens:  oauth2.ReuseTokenSource(nil, tokens),
+	}, nil
+}
+
+// NewTokenSource returns a token source that signs a JWT profile assertion
+// with the machine user's key and exchanges it for an access token with
+// scopes at the token endpoint of apiBaseURL, every time it is asked for a
+// token. The exchange is sent with httpClient, which should have a timeout.
+// If it is nil, the transport of the http helpers is used.
+func NewTokenSource(httpClient *http.Client, apiBaseURL string, key []byte, scopes ...string) (oauth2.TokenSource, error) {
+	issuer := strings.TrimSuffix(apiBaseURL, "/")
+	if httpClient == nil {
+		httpClient = &http.Client{Transport: httphelper.Client().Transport, Timeout: requestTimeout}
+	}
+	tokens, err := profile.NewJWTProfileTokenSourceFromKeyFileData(issuer, key, scopes,
+		profile.WithHTTPClient(httpClient),
+		profile.WithStaticTokenEndpoint(issuer, issuer+"/oauth/v2/token"))
+	if err != nil {
+		return nil, fmt.Errorf("reading machine key: %w", err)
+	}
+	return tokens, nil
+}
+
+// InOrg returns a client whose management and admin API calls act in the
+// organization orgID instead of the machine user's own.
+func (c *Client) InOrg(orgID string) *Client {
+	clone := *c
+	clone.orgID = orgID
+	return &clone
+}
+
+// UserID returns the ID of the machine user the client authenticates as.
+func (c *Client) UserID() string {
+	return c.userID
+}
+
+// Token returns a valid access token of the machine user. Errors wrap
+// ErrToken.
+func (c *Client) Token(ctx context.Context) (string, error) {
+	if err := ctx.Err(); err != nil {
+		return "", err
+	}
+	token, err := c.tokens.Token()
+	if err != nil {
+		return "", fmt.Errorf("%w: %w", ErrToken, err)
+	}
+	return token.AccessToken, nil
+}
+
+// ErrToken is wrapped by the errors of calls that failed because no access
+// token could be obtained, before the API was called.
+var ErrToken = errors.New("getting access token")
+
+// Error is returned for a response with a status other than 200.
+type Error struct {
+	Method     string
+	Path       string
+	StatusCode int
+	Body       string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("%s %s: status %d: %s", e.Method, e.Path, e.StatusCode, e.Body)
+}
+
+// Temporary reports whether a call that failed with err may succeed when it
+// is repeated: no access token could be obtained, no response was received,
+// or the API answered with a server error such as 503 Unavailable. A create
+// that failed without a response may still have taken effect.
+func Temporary(err error) bool {
+	var apiErr *Error
+	if errors.As(err, &apiErr) {
+		return apiErr.StatusCode >= http.StatusInternalServerError
+	}
+	var urlErr *url.Error
+	return errors.Is(err, ErrToken) || errors.As(err, &urlErr)
+}
+
+// IsAlreadyExists reports whether a create failed with err because the
+// resource already exists.
+func IsAlreadyExists(err error) bool {
+	var apiErr *Error
+	return errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusConflict
+}
+
+// call sends in as JSON, unless it is nil, and decodes the response into
+// out, unless it is nil.
+func (c *Client) call(ctx context.Context, method, path string, in, out any) error {
+	token, err := c.Token(ctx)
+	if err != nil {
+		return err
+	}
+
+	var body io.Reader
+	if in != nil {
+		data, err := json.Marshal(in)
+		if err != nil {
+			return err
+		}
+		body = bytes.NewReader(data)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, c.baseURL+path, body)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("Content-Type", "application/json")
+	if c.orgID != "" {
+		req.Header.Set(orgHeader, c.orgID)
+	}
+
+	resp, err := httphelper.Client().Do(req)
+	if err != nil {
+		return fmt.Errorf("%s %s: %w", method, path, err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("%s %s: reading response: %w", method, path, err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return &Error{Method: method, Path: path, StatusCode: resp.StatusCode, Body: string(data)}
+	}
+	if out == nil {
+		return nil
+	}
+	if err := json.Unmarshal(data, out); err != nil {
+		return fmt.Errorf("%s %s: decoding response: %w", method, path, err)
+	}
+	return nil
+}
+
+// nameQuery returns a search request body with a single query of kind that
+// matches field exactly, e.g. {"queries": [{"nameQuery": {"name": ...}}]}.
+func nameQuery(kind, field, value string) map[string]any {
+	return map[string]any{"queries": []map[string]any{{
+		kind: map[string]any{field: value, "method": "TEXT_QUERY_METHOD_EQUALS"},
+	}}}
+}
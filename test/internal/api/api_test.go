@@ -0,0 +1,99 @@
+package api
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/oauth2"
+)
+
+// TestErrorClassification verifies which failed calls Temporary and
+// IsAlreadyExists report.
+func TestErrorClassification(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/conflict":
+			w.WriteHeader(http.StatusConflict)
+		case "/unavailable":
+			w.WriteHeader(http.StatusServiceUnavailable)
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+	defer server.Close()
+
+	client := &Client{baseURL: server.URL, tokens: oauth2.StaticTokenSource(&oauth2.Token{AccessToken: "token"})}
+	call := func(path string) error {
+		return client.call(context.Background(), http.MethodPost, path, map[string]any{}, nil)
+	}
+
+	conflict := call("/conflict")
+	require.True(t, IsAlreadyExists(conflict))
+	require.False(t, Temporary(conflict))
+
+	require.True(t, Temporary(call("/unavailable")))
+	require.False(t, Temporary(call("/invalid")))
+
+	closed := &Client{baseURL: "http://127.0.0.1:1", tokens: client.tokens}
+	require.True(t, Temporary(closed.call(context.Background(), http.MethodGet, "/", nil, nil)),
+		"a call without a response should be temporary")
+
+	_, err := (&Client{tokens: failingTokenSource{}}).Token(context.Background())
+	require.ErrorIs(t, err, ErrToken)
+	require.True(t, Temporary(err))
+}
+
+// TestNewExchangesKeyForCachedToken verifies that the client takes the user
+// ID from the machine key and exchanges the key for a token with the JWT
+// profile grant once, reusing the token while it is valid.
+func TestNewExchangesKeyForCachedToken(t *testing.T) {
+	var exchanges atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/oauth/v2/token", r.URL.Path)
+		require.NoError(t, r.ParseForm())
+		require.Equal(t, "urn:ietf:params:oauth:grant-type:jwt-bearer", r.PostForm.Get("grant_type"))
+		require.Contains(t, strings.Fields(r.PostForm.Get("scope")), "urn:zitadel:iam:org:project:id:123:aud")
+		require.NotEmpty(t, r.PostForm.Get("assertion"))
+		exchanges.Add(1)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"access_token":"token","token_type":"Bearer","expires_in":3600}`))
+	}))
+	defer server.Close()
+
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	key, err := json.Marshal(map[string]string{
+		"type":   "serviceaccount",
+		"keyId":  "key",
+		"userId": "machine",
+		"key":    string(pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(rsaKey)})),
+	})
+	require.NoError(t, err)
+
+	client, err := New(server.URL, key, "urn:zitadel:iam:org:project:id:123:aud")
+	require.NoError(t, err)
+	require.Equal(t, "machine", client.UserID())
+	for range 2 {
+		token, err := client.Token(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, "token", token)
+	}
+	require.EqualValues(t, 1, exchanges.Load())
+}
+
+type failingTokenSource struct{}
+
+func (failingTokenSource) Token() (*oauth2.Token, error) {
+	return nil, errors.New("token endpoint unavailable")
+}
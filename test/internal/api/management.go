@@ -0,0 +1,182 @@
+package api
+
+import (
+	"context"
+	"net/http"
+)
+
+// Project is a project of the management API.
+type Project struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// AddProject creates a project in the client's organization and returns its
+// ID.
+func (c *Client) AddProject(ctx context.Context, name string) (string, error) {
+	var resp struct {
+		ID string `json:"id"`
+	}
+	err := c.call(ctx, http.MethodPost, "/management/v1/projects", map[string]any{"name": name}, &resp)
+	return resp.ID, err
+}
+
+// GetProjectByID returns the project with the given ID.
+func (c *Client) GetProjectByID(ctx context.Context, id string) (*Project, error) {
+	var resp struct {
+		Project *Project `json:"project"`
+	}
+	if err := c.call(ctx, http.MethodGet, "/management/v1/projects/"+id, nil, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Project, nil
+}
+
+// ListProjects returns the projects of the client's organization named name.
+func (c *Client) ListProjects(ctx context.Context, name string) ([]Project, error) {
+	var resp struct {
+		Result []Project `json:"result"`
+	}
+	err := c.call(ctx, http.MethodPost, "/management/v1/projects/_search", nameQuery("nameQuery", "name", name), &resp)
+	return resp.Result, err
+}
+
+// ImportHumanUserRequest describes a human user created with its password.
+type ImportHumanUserRequest struct {
+	UserName               string       `json:"userName"`
+	Profile                HumanProfile `json:"profile"`
+	Email                  HumanEmail   `json:"email"`
+	Password               string       `json:"password,omitempty"`
+	PasswordChangeRequired bool         `json:"passwordChangeRequired"`
+}
+
+// HumanProfile is the profile of a human user in the management API.
+type HumanProfile struct {
+	FirstName string `json:"firstName"`
+	LastName  string `json:"lastName"`
+}
+
+// HumanEmail is the email address of a human user in the management API.
+type HumanEmail struct {
+	Email           string `json:"email"`
+	IsEmailVerified bool   `json:"isEmailVerified"`
+}
+
+// ImportHumanUser creates a human user in the client's organization and
+// returns its ID.
+func (c *Client) ImportHumanUser(ctx context.Context, req *ImportHumanUserRequest) (string, error) {
+	var resp struct {
+		UserID string `json:"userId"`
+	}
+	err := c.call(ctx, http.MethodPost, "/management/v1/users/human/_import", req, &resp)
+	return resp.UserID, err
+}
+
+// AddOIDCAppRequest describes an OIDC application. The enum fields take the
+// names of the API's enum values, e.g. "OIDC_APP_TYPE_USER_AGENT".
+type AddOIDCAppRequest struct {
+	Name                     string   `json:"name"`
+	RedirectURIs             []string `json:"redirectUris"`
+	ResponseTypes            []string `json:"responseTypes"`
+	GrantTypes               []string `json:"grantTypes"`
+	AppType                  string   `json:"appType"`
+	AuthMethodType           string   `json:"authMethodType"`
+	AccessTokenType          string   `json:"accessTokenType,omitempty"`
+	IDTokenUserinfoAssertion bool     `json:"idTokenUserinfoAssertion"`
+	DevMode                  bool     `json:"devMode"`
+}
+
+// AppCredentials identifies a newly created application. The client ID and
+// secret are only set for the application types that use them.
+type AppCredentials struct {
+	AppID        string `json:"appId"`
+	ClientID     string `json:"clientId"`
+	ClientSecret string `json:"clientSecret"`
+}
+
+// AddOIDCApp creates an OIDC application in the project.
+func (c *Client) AddOIDCApp(ctx context.Context, projectID string, req *AddOIDCAppRequest) (*AppCredentials, error) {
+	return c.addApp(ctx, projectID, "oidc", req)
+}
+
+// AddAPIApp creates an API application in the project that authenticates
+// with authMethodType, e.g. "API_AUTH_METHOD_TYPE_BASIC".
+func (c *Client) AddAPIApp(ctx context.Context, projectID, name, authMethodType string) (*AppCredentials, error) {
+	return c.addApp(ctx, projectID, "api", map[string]any{
+		"name":           name,
+		"authMethodType": authMethodType,
+	})
+}
+
+// AddSAMLApp creates a SAML application in the project from the service
+// provider's metadata.
+func (c *Client) AddSAMLApp(ctx context.Context, projectID, name string, metadata []byte) (*AppCredentials, error) {
+	// The metadata is a bytes field, which the JSON mapping encodes as
+	// base64, as does json.Marshal for a []byte.
+	return c.addApp(ctx, projectID, "saml", map[string]any{
+		"name":        name,
+		"metadataXml": metadata,
+	})
+}
+
+// App is an application of a project. The client ID is set for OIDC and API
+// applications.
+type App struct {
+	ID         string     `json:"id"`
+	Name       string     `json:"name"`
+	OIDCConfig *appConfig `json:"oidcConfig"`
+	APIConfig  *appConfig `json:"apiConfig"`
+}
+
+type appConfig struct {
+	ClientID string `json:"clientId"`
+}
+
+// ClientID returns the client ID of an OIDC or API application.
+func (a *App) ClientID() string {
+	switch {
+	case a.OIDCConfig != nil:
+		return a.OIDCConfig.ClientID
+	case a.APIConfig != nil:
+		return a.APIConfig.ClientID
+	}
+	return ""
+}
+
+// ListApps returns the applications of the project named name.
+func (c *Client) ListApps(ctx context.Context, projectID, name string) ([]App, error) {
+	var resp struct {
+		Result []App `json:"result"`
+	}
+	err := c.call(ctx, http.MethodPost, "/management/v1/projects/"+projectID+"/apps/_search",
+		nameQuery("nameQuery", "name", name), &resp)
+	return resp.Result, err
+}
+
+// RegenerateAPIClientSecret replaces the client secret of an API application
+// and returns the new one.
+func (c *Client) RegenerateAPIClientSecret(ctx context.Context, projectID, appID string) (string, error) {
+	var resp struct {
+		ClientSecret string `json:"clientSecret"`
+	}
+	err := c.call(ctx, http.MethodPost,
+		"/management/v1/projects/"+projectID+"/apps/"+appID+"/api_config/_generate_client_secret", map[string]any{}, &resp)
+	return resp.ClientSecret, err
+}
+
+func (c *Client) addApp(ctx context.Context, projectID, kind string, req any) (*AppCredentials, error) {
+	resp := new(AppCredentials)
+	if err := c.call(ctx, http.MethodPost, "/management/v1/projects/"+projectID+"/apps/"+kind, req, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// GetSupportedLanguages returns the languages the instance supports.
+func (c *Client) GetSupportedLanguages(ctx context.Context) ([]string, error) {
+	var resp struct {
+		Languages []string `json:"languages"`
+	}
+	err := c.call(ctx, http.MethodGet, "/management/v1/languages", nil, &resp)
+	return resp.Languages, err
+}
@@ -0,0 +1,35 @@
+package api
+
+import (
+	"context"
+	"net/http"
+)
+
+// AddOrganization creates an organization through the organization service
+// (v2) with the given users as its owners and returns its ID.
+func (c *Client) AddOrganization(ctx context.Context, name string, ownerIDs ...string) (string, error) {
+	admins := make([]map[string]any, 0, len(ownerIDs))
+	for _, id := range ownerIDs {
+		admins = append(admins, map[string]any{"userId": id, "roles": []string{"ORG_OWNER"}})
+	}
+	var resp struct {
+		OrganizationID string `json:"organizationId"`
+	}
+	err := c.call(ctx, http.MethodPost, "/v2/organizations", map[string]any{"name": name, "admins": admins}, &resp)
+	return resp.OrganizationID, err
+}
+
+// Organization is an organization of the organization service (v2).
+type Organization struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// ListOrganizations returns the organizations named name.
+func (c *Client) ListOrganizations(ctx context.Context, name string) ([]Organization, error) {
+	var resp struct {
+		Result []Organization `json:"result"`
+	}
+	err := c.call(ctx, http.MethodPost, "/v2/organizations/_search", nameQuery("nameQuery", "name", name), &resp)
+	return resp.Result, err
+}
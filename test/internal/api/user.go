@@ -0,0 +1,69 @@
+package api
+
+import (
+	"context"
+	"net/http"
+)
+
+// AddHumanUserRequest describes a human user created through the user
+// service (v2) with a verified email and a password.
+type AddHumanUserRequest struct {
+	OrganizationID string
+	Username       string
+	GivenName      string
+	FamilyName     string
+	Email          string
+	Password       string
+}
+
+// User is a user of the user service (v2).
+type User struct {
+	UserID             string   `json:"userId"`
+	Username           string   `json:"username"`
+	State              string   `json:"state"`
+	PreferredLoginName string   `json:"preferredLoginName"`
+	LoginNames         []string `json:"loginNames"`
+}
+
+// AddHumanUser creates a human user and returns its ID.
+func (c *Client) AddHumanUser(ctx context.Context, req *AddHumanUserRequest) (string, error) {
+	body := map[string]any{
+		"username": req.Username,
+		"profile":  map[string]any{"givenName": req.GivenName, "familyName": req.FamilyName},
+		"email":    map[string]any{"email": req.Email, "isVerified": true},
+		"password": map[string]any{"password": req.Password, "changeRequired": false},
+	}
+	if req.OrganizationID != "" {
+		body["organization"] = map[string]any{"orgId": req.OrganizationID}
+	}
+	var resp struct {
+		UserID string `json:"userId"`
+	}
+	err := c.call(ctx, http.MethodPost, "/v2/users/human", body, &resp)
+	return resp.UserID, err
+}
+
+// GetUserByID returns the user with the given ID.
+func (c *Client) GetUserByID(ctx context.Context, id string) (*User, error) {
+	var resp struct {
+		User *User `json:"user"`
+	}
+	if err := c.call(ctx, http.MethodGet, "/v2/users/"+id, nil, &resp); err != nil {
+		return nil, err
+	}
+	return resp.User, nil
+}
+
+// ListUsers returns the users named username.
+func (c *Client) ListUsers(ctx context.Context, username string) ([]User, error) {
+	var resp struct {
+		Result []User `json:"result"`
+	}
+	err := c.call(ctx, http.MethodPost, "/v2/users", nameQuery("userNameQuery", "userName", username), &resp)
+	return resp.Result, err
+}
+
+// DeleteUser deletes the user with the given ID.
+func (c *Client) DeleteUser(ctx context.Context, id string) error {
+	return c.call(ctx, http.MethodDelete, "/v2/users/"+id, nil, nil)
+}
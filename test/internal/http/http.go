@@ -14,6 +14,12 @@ var insecureClient = &http.Client{
 	},
 }
 
+// Client returns the HTTP client used by Get and Post, which skips TLS
+// certificate verification, for libraries that make their own requests.
+func Client() *http.Client {
+	return insecureClient
+}
+
 // Get performs an HTTP GET request that skips TLS certificate verification.
 // Returns the status code, response body, and any error encountered.
 func Get(ctx context.Context, url string, headers map[string]string) (int, []byte, error) {
@@ -26,10 +32,13 @@ func Post(ctx context.Context, url string, headers map[string]string, body io.Re
 	return doRequest(ctx, http.MethodPost, url, headers, body)
 }
 
-func doRequest(ctx context.Context, method, url string, headers map[string]string, body io.Reader) (int, []byte, error) {
+// Do performs an HTTP request with the given method that skips TLS
+// certificate verification. Returns the response, whose body has already
+// been read and closed, the response body, and any error encountered.
+func Do(ctx context.Context, method, url string, headers map[string]string, body io.Reader) (*http.Response, []byte, error) {
 	req, err := http.NewRequestWithContext(ctx, method, url, body)
 	if err != nil {
-		return 0, nil, fmt.Errorf("creating request failed: %w", err)
+		return nil, nil, fmt.Errorf("creating request failed: %w", err)
 	}
 
 	for k, v := range headers {
@@ -38,14 +47,22 @@ func doRequest(ctx context.Context, method, url string, headers map[string]strin
 
 	resp, err := insecureClient.Do(req)
 	if err != nil {
-		return 0, nil, fmt.Errorf("request failed: %w", err)
+		return nil, nil, fmt.Errorf("request failed: %w", err)
 	}
 	defer func() { _ = resp.Body.Close() }()
 
 	respBody, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return resp.StatusCode, nil, fmt.Errorf("reading response failed: %w", err)
+		return resp, nil, fmt.Errorf("reading response failed: %w", err)
 	}
 
-	return resp.StatusCode, respBody, nil
+	return resp, respBody, nil
+}
+
+func doRequest(ctx context.Context, method, url string, headers map[string]string, body io.Reader) (int, []byte, error) {
+	resp, respBody, err := Do(ctx, method, url, headers, body)
+	if resp == nil {
+		return 0, nil, err
+	}
+	return resp.StatusCode, respBody, err
 }
@@ -9,6 +9,16 @@
 // The Gateway API provider is enabled and Traefik automatically creates a
 // GatewayClass and Gateway. The dynamically mapped host ports are available
 // via Cluster.HTTPSPort and Cluster.HTTPPort.
+//
+// By default every test binary starts and terminates its own container. When
+// iterating on a single test, set ZITADEL_TEST_CLUSTER=persistent to keep one
+// container running across runs, or ZITADEL_TEST_KUBECONFIG to run against a
+// cluster managed elsewhere. See Start.
+//
+// Start runs a single server node unless WithAgents adds agent nodes, whose
+// labels and taints let tests exercise scheduling across zones.
+// ZITADEL_TEST_IMAGES side-loads the images the suites deploy, for runners
+// without registry access.
 package testcluster
 
 import (
@@ -17,10 +27,12 @@ import (
 	"fmt"
 	"os"
 	"os/exec"
+	"strings"
 
 	"github.com/docker/go-connections/nat"
 	"github.com/testcontainers/testcontainers-go"
 	"github.com/testcontainers/testcontainers-go/modules/k3s"
+	"github.com/testcontainers/testcontainers-go/network"
 )
 
 //go:embed *.yaml
@@ -29,6 +41,27 @@ var manifests embed.FS
 // k3sImage is the K3s container image used by all test suites.
 const k3sImage = "rancher/k3s:v1.34.1-k3s1"
 
+const (
+	// ClusterEnvVar selects the cluster lifecycle: "ephemeral" (the default)
+	// or "persistent".
+	ClusterEnvVar = "ZITADEL_TEST_CLUSTER"
+	// KubeconfigEnvVar points at the kubeconfig of an existing cluster to use
+	// instead of a K3s container. It takes precedence over ClusterEnvVar.
+	KubeconfigEnvVar = "ZITADEL_TEST_KUBECONFIG"
+	// HTTPSPortEnvVar and HTTPPortEnvVar set Cluster.HTTPSPort and
+	// Cluster.HTTPPort for a cluster given by KubeconfigEnvVar. They default
+	// to the Traefik NodePorts.
+	HTTPSPortEnvVar = "ZITADEL_TEST_HTTPS_PORT"
+	HTTPPortEnvVar  = "ZITADEL_TEST_HTTP_PORT"
+)
+
+// persistentContainerName is the name of the container that all test
+// binaries share in persistent mode.
+const persistentContainerName = "zitadel-charts-k3s"
+
+// containerLabel marks containers started by this package.
+const containerLabel = "dev.zitadel.charts.testcluster"
+
 // Cluster holds a running K3s container and the temporary kubeconfig file
 // that points to it.
 type Cluster struct {
@@ -38,9 +71,17 @@ type Cluster struct {
 	// HTTPPort is the dynamically mapped host port for the Traefik HTTP
 	// NodePort (30080). This is also the port used by the Gateway API
 	// listener ("web" entrypoint).
-	HTTPPort  string
+	HTTPPort       string
 	container      *k3s.K3sContainer
+	agents         []testcontainers.Container
+	network        *testcontainers.DockerNetwork
 	kubeconfigPath string
+	// persistent clusters outlive the test binary: Cleanup leaves them
+	// running.
+	persistent bool
+	// ownsKubeconfig is set when kubeconfigPath is a temporary file written
+	// by Start rather than the user's file.
+	ownsKubeconfig bool
 }
 
 // Start creates a K3s cluster with Traefik enabled, extracts its kubeconfig
@@ -50,74 +91,223 @@ type Cluster struct {
 // provider is enabled and Traefik creates a GatewayClass ("traefik") and
 // Gateway ("traefik-gateway") automatically. Call Cleanup when the cluster
 // is no longer needed.
-func Start(ctx context.Context) (*Cluster, error) {
+//
+// With ZITADEL_TEST_CLUSTER=persistent, Start attaches to the labelled
+// container named zitadel-charts-k3s, creating it on first use, and Cleanup
+// leaves it running. The testcontainers reaper is disabled for the process,
+// as it would otherwise remove the container when the test binary exits. Test
+// namespaces left behind by earlier runs are deleted once they can no longer
+// belong to a running test; every test still gets its own namespace, so runs
+// never see each other's objects. Remove the container with
+// `docker rm -f zitadel-charts-k3s` to start from scratch.
+//
+// With ZITADEL_TEST_IMAGES set, Start side-loads the images the suites
+// deploy into every node, so tests run without registry access. See
+// ImagesEnvVar.
+//
+// With ZITADEL_TEST_KUBECONFIG set, Start uses that cluster as is. It must
+// already provide what the suite needs, such as Traefik and the Gateway API
+// CRDs.
+//
+// Options add agent nodes and set node labels and taints. They require an
+// ephemeral cluster, since a persistent or attached cluster keeps the
+// topology it was created with.
+func Start(ctx context.Context, options ...Option) (*Cluster, error) {
+	cfg := &config{}
+	for _, opt := range options {
+		opt(cfg)
+	}
+	for i := range cfg.agents {
+		if cfg.agents[i].Name == "" {
+			cfg.agents[i].Name = fmt.Sprintf("agent-%d", i+1)
+		}
+	}
+	custom := len(cfg.agents) > 0 || len(cfg.server.flags()) > 0
+
+	if path := os.Getenv(KubeconfigEnvVar); path != "" {
+		if custom {
+			return nil, fmt.Errorf("%s: cannot change the nodes of an existing cluster", KubeconfigEnvVar)
+		}
+		return attach(path)
+	}
+
+	var persistent bool
+	switch mode := os.Getenv(ClusterEnvVar); mode {
+	case "", "ephemeral":
+	case "persistent":
+		persistent = true
+	default:
+		return nil, fmt.Errorf("%s: unknown mode %q (want \"ephemeral\" or \"persistent\")", ClusterEnvVar, mode)
+	}
+	if persistent && custom {
+		return nil, fmt.Errorf("%s=persistent: cannot change the nodes of a shared cluster", ClusterEnvVar)
+	}
+
 	traefikPath, err := writeEmbeddedFile("traefik-config.yaml")
 	if err != nil {
 		return nil, err
 	}
 	defer func() { _ = os.Remove(traefikPath) }()
 
-	container, err := k3s.Run(ctx, k3sImage,
-		testcontainers.WithCmd("server", "--tls-san=localhost"),
+	cmd := append([]string{"server", "--tls-san=localhost"}, cfg.server.flags()...)
+	opts := []testcontainers.ContainerCustomizer{
 		testcontainers.WithExposedPorts("30080/tcp", "30443/tcp"),
+		testcontainers.WithLabels(map[string]string{containerLabel: "true"}),
 		k3s.WithManifest(traefikPath),
-	)
+	}
+	if persistent {
+		if err := os.Setenv("TESTCONTAINERS_RYUK_DISABLED", "true"); err != nil {
+			return nil, err
+		}
+		opts = append(opts, testcontainers.WithReuseByName(persistentContainerName))
+	}
+
+	var token string
+	var nw *testcontainers.DockerNetwork
+	if len(cfg.agents) > 0 {
+		if token, err = newToken(); err != nil {
+			return nil, err
+		}
+		if nw, err = network.New(ctx); err != nil {
+			return nil, fmt.Errorf("creating node network: %w", err)
+		}
+		cmd = append(cmd, "--token="+token)
+		opts = append(opts, network.WithNetwork([]string{serverAlias}, nw))
+	}
+	opts = append(opts, testcontainers.WithCmd(cmd...))
+
+	var agents []testcontainers.Container
+	container, err := k3s.Run(ctx, k3sImage, opts...)
+	// terminate discards the containers when Start fails, unless they are
+	// shared.
+	terminate := func() {
+		for _, agent := range agents {
+			_ = agent.Terminate(context.Background())
+		}
+		if container != nil && !persistent {
+			_ = container.Terminate(context.Background())
+		}
+		if nw != nil {
+			_ = nw.Remove(context.Background())
+		}
+	}
 	if err != nil {
+		terminate()
 		return nil, err
 	}
 
 	kubeconfig, err := container.GetKubeConfig(ctx)
 	if err != nil {
-		_ = container.Terminate(context.Background())
+		terminate()
 		return nil, err
 	}
 
 	kubeconfigFile, err := os.CreateTemp("", "k3s-kubeconfig-*.yaml")
 	if err != nil {
-		_ = container.Terminate(context.Background())
+		terminate()
 		return nil, err
 	}
 
 	if _, err := kubeconfigFile.Write(kubeconfig); err != nil {
 		_ = kubeconfigFile.Close()
 		_ = os.Remove(kubeconfigFile.Name())
-		_ = container.Terminate(context.Background())
+		terminate()
 		return nil, err
 	}
 	if err := kubeconfigFile.Close(); err != nil {
 		_ = os.Remove(kubeconfigFile.Name())
-		_ = container.Terminate(context.Background())
+		terminate()
 		return nil, err
 	}
 
 	if err := os.Setenv("KUBECONFIG", kubeconfigFile.Name()); err != nil {
 		_ = os.Remove(kubeconfigFile.Name())
-		_ = container.Terminate(context.Background())
+		terminate()
 		return nil, err
 	}
 
 	httpsMapped, err := container.MappedPort(ctx, nat.Port("30443/tcp"))
 	if err != nil {
 		_ = os.Remove(kubeconfigFile.Name())
-		_ = container.Terminate(context.Background())
+		terminate()
 		return nil, err
 	}
 
 	httpMapped, err := container.MappedPort(ctx, nat.Port("30080/tcp"))
 	if err != nil {
 		_ = os.Remove(kubeconfigFile.Name())
-		_ = container.Terminate(context.Background())
+		terminate()
 		return nil, err
 	}
 
+	for _, node := range cfg.agents {
+		agent, err := startAgent(ctx, node, token, nw)
+		if err != nil {
+			_ = os.Remove(kubeconfigFile.Name())
+			terminate()
+			return nil, err
+		}
+		agents = append(agents, agent)
+	}
+	if len(agents) > 0 {
+		if err := waitForNodes(ctx, kubeconfigFile.Name(), 1+len(agents)); err != nil {
+			_ = os.Remove(kubeconfigFile.Name())
+			terminate()
+			return nil, err
+		}
+	}
+
+	if source := os.Getenv(ImagesEnvVar); source != "" {
+		nodes := append([]testcontainers.Container{container}, agents...)
+		if err := preloadImages(ctx, source, nodes); err != nil {
+			_ = os.Remove(kubeconfigFile.Name())
+			terminate()
+			return nil, fmt.Errorf("%s: %w", ImagesEnvVar, err)
+		}
+	}
+
+	if persistent {
+		if err := deleteStaleNamespaces(ctx, kubeconfigFile.Name()); err != nil {
+			_ = os.Remove(kubeconfigFile.Name())
+			return nil, err
+		}
+	}
+
 	return &Cluster{
 		HTTPSPort:      httpsMapped.Port(),
 		HTTPPort:       httpMapped.Port(),
 		container:      container,
+		agents:         agents,
+		network:        nw,
 		kubeconfigPath: kubeconfigFile.Name(),
+		persistent:     persistent,
+		ownsKubeconfig: true,
+	}, nil
+}
+
+// attach uses the existing cluster described by the kubeconfig at path.
+func attach(path string) (*Cluster, error) {
+	if _, err := os.Stat(path); err != nil {
+		return nil, fmt.Errorf("%s: %w", KubeconfigEnvVar, err)
+	}
+	if err := os.Setenv("KUBECONFIG", path); err != nil {
+		return nil, err
+	}
+	return &Cluster{
+		HTTPSPort:      envOr(HTTPSPortEnvVar, "30443"),
+		HTTPPort:       envOr(HTTPPortEnvVar, "30080"),
+		kubeconfigPath: path,
+		persistent:     true,
 	}, nil
 }
 
+func envOr(key, fallback string) string {
+	if v := strings.TrimSpace(os.Getenv(key)); v != "" {
+		return v
+	}
+	return fallback
+}
+
 // ApplyGatewayCRDs registers the Gateway API CRDs with the cluster's API
 // server using kubectl apply. The K3s bundled traefik-crd chart already
 // installs these CRDs, so this call is idempotent. It is kept for test
@@ -155,12 +345,23 @@ func (c *Cluster) ApplyServiceMonitorCRD(ctx context.Context) error {
 	return nil
 }
 
-// Cleanup terminates the K3s container and removes the temporary kubeconfig
+// Cleanup terminates the K3s containers and removes the temporary kubeconfig
 // file. Errors are silently ignored since this is typically called in a defer
-// and the container will be reaped by Ryuk regardless.
+// and the container will be reaped by Ryuk regardless. Persistent and
+// attached clusters are left running.
 func (c *Cluster) Cleanup() {
-	_ = c.container.Terminate(context.Background())
-	_ = os.Remove(c.kubeconfigPath)
+	for _, agent := range c.agents {
+		_ = agent.Terminate(context.Background())
+	}
+	if c.container != nil && !c.persistent {
+		_ = c.container.Terminate(context.Background())
+	}
+	if c.network != nil {
+		_ = c.network.Remove(context.Background())
+	}
+	if c.ownsKubeconfig {
+		_ = os.Remove(c.kubeconfigPath)
+	}
 	// Only unset KUBECONFIG if it still points to this cluster's kubeconfig.
 	if os.Getenv("KUBECONFIG") == c.kubeconfigPath {
 		_ = os.Unsetenv("KUBECONFIG")
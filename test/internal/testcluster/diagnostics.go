@@ -0,0 +1,262 @@
+package testcluster
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gruntwork-io/terratest/modules/k8s"
+	"github.com/gruntwork-io/terratest/modules/logger"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// DiagnosticsDirEnvVar sets the directory CollectDiagnostics writes to. It
+// defaults to .diagnostics in the test's working directory, which CI
+// archives when a run fails.
+const DiagnosticsDirEnvVar = "ZITADEL_TEST_DIAGNOSTICS_DIR"
+
+// diagnosticsTimeout bounds the time spent collecting diagnostics, the
+// kubectl and helm commands included.
+const diagnosticsTimeout = 2 * time.Minute
+
+// diagnosticsKinds are the resources dumped by kubectl get and describe.
+// Secrets are left out, since the directory is archived as a CI artifact.
+const diagnosticsKinds = "pods,deployments,replicasets,statefulsets,jobs,services,ingresses," +
+	"configmaps,serviceaccounts,roles,rolebindings,poddisruptionbudgets,horizontalpodautoscalers"
+
+// zitadelConfigKey is the ConfigMap key holding the rendered ZITADEL config.
+const zitadelConfigKey = "zitadel-config-yaml"
+
+// CollectDiagnostics writes what is needed to debug a failed test from the
+// namespace of k into a directory named after the test, and returns it:
+//
+//   - pods/<pod>/<container>.log, plus .previous.log for restarted containers
+//   - events.txt, oldest first
+//   - resources.yaml and describe.txt for the namespace's resources
+//   - helm/<release>/history.txt
+//   - config/<configmap>.yaml with each rendered ZITADEL config
+//
+// Collection is best effort: what cannot be collected is listed in
+// errors.txt instead of failing the test again.
+func CollectDiagnostics(t *testing.T, k *k8s.KubectlOptions) string {
+	t.Helper()
+	ctx, cancel := context.WithTimeout(context.Background(), diagnosticsTimeout)
+	defer cancel()
+
+	root := envOr(DiagnosticsDirEnvVar, ".diagnostics")
+	b := &bundle{dir: filepath.Join(root, t.Name())}
+	if err := os.RemoveAll(b.dir); err != nil {
+		t.Logf("failed to clear diagnostics directory %s: %v", b.dir, err)
+	}
+
+	// Keep the client's output out of the test log.
+	quiet := *k
+	quiet.Logger = logger.Discard
+
+	if client, err := k8s.GetKubernetesClientFromOptionsE(t, &quiet); err != nil {
+		b.fail("creating k8s client", err)
+	} else {
+		b.podLogs(ctx, client, k.Namespace)
+		b.events(ctx, client, k.Namespace)
+		b.zitadelConfigs(ctx, client, k.Namespace)
+	}
+	b.kubectl(ctx, k, "resources.yaml", "get", diagnosticsKinds, "-o", "yaml")
+	b.kubectl(ctx, k, "describe.txt", "describe", diagnosticsKinds)
+	b.helmReleases(ctx, k)
+
+	if len(b.errs) > 0 {
+		b.write("errors.txt", []byte(strings.Join(b.errs, "\n")+"\n"))
+	}
+	return b.dir
+}
+
+// bundle is a diagnostics directory being written.
+type bundle struct {
+	dir  string
+	errs []string
+}
+
+func (b *bundle) fail(what string, err error) {
+	b.errs = append(b.errs, fmt.Sprintf("%s: %v", what, err))
+}
+
+func (b *bundle) write(name string, data []byte) {
+	path := filepath.Join(b.dir, name)
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		b.fail("creating "+filepath.Dir(name), err)
+		return
+	}
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		b.fail("writing "+name, err)
+	}
+}
+
+// podLogs writes the logs of every container, init containers included. The
+// previous instance is only kept by the kubelet after a restart.
+func (b *bundle) podLogs(ctx context.Context, client kubernetes.Interface, namespace string) {
+	pods, err := client.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		b.fail("listing pods", err)
+		return
+	}
+	for _, pod := range pods.Items {
+		restarts := map[string]int32{}
+		for _, s := range append(pod.Status.InitContainerStatuses, pod.Status.ContainerStatuses...) {
+			restarts[s.Name] = s.RestartCount
+		}
+		for _, c := range append(pod.Spec.InitContainers, pod.Spec.Containers...) {
+			b.log(ctx, client, pod, c.Name, false)
+			if restarts[c.Name] > 0 {
+				b.log(ctx, client, pod, c.Name, true)
+			}
+		}
+	}
+}
+
+func (b *bundle) log(ctx context.Context, client kubernetes.Interface, pod corev1.Pod, container string, previous bool) {
+	name := filepath.Join("pods", pod.Name, container+".log")
+	if previous {
+		name = filepath.Join("pods", pod.Name, container+".previous.log")
+	}
+	data, err := client.CoreV1().Pods(pod.Namespace).GetLogs(pod.Name, &corev1.PodLogOptions{
+		Container: container,
+		Previous:  previous,
+	}).DoRaw(ctx)
+	if err != nil {
+		b.fail("fetching "+name, err)
+		return
+	}
+	b.write(name, data)
+}
+
+// events writes the namespace's events in the order they last occurred.
+func (b *bundle) events(ctx context.Context, client kubernetes.Interface, namespace string) {
+	events, err := client.CoreV1().Events(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		b.fail("listing events", err)
+		return
+	}
+	when := func(e corev1.Event) time.Time {
+		switch {
+		case !e.LastTimestamp.IsZero():
+			return e.LastTimestamp.Time
+		case !e.EventTime.IsZero():
+			return e.EventTime.Time
+		}
+		return e.CreationTimestamp.Time
+	}
+	items := events.Items
+	sort.SliceStable(items, func(i, j int) bool { return when(items[i]).Before(when(items[j])) })
+
+	var sb strings.Builder
+	for _, e := range items {
+		fmt.Fprintf(&sb, "%s  %-7s  %-24s  %s/%s (x%d): %s\n",
+			when(e).UTC().Format(time.RFC3339), e.Type, e.Reason,
+			e.InvolvedObject.Kind, e.InvolvedObject.Name, max(e.Count, 1), strings.TrimSpace(e.Message))
+	}
+	b.write("events.txt", []byte(sb.String()))
+}
+
+// zitadelConfigs writes the rendered ZITADEL configuration of every release.
+func (b *bundle) zitadelConfigs(ctx context.Context, client kubernetes.Interface, namespace string) {
+	configMaps, err := client.CoreV1().ConfigMaps(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		b.fail("listing configmaps", err)
+		return
+	}
+	for _, cm := range configMaps.Items {
+		if config, ok := cm.Data[zitadelConfigKey]; ok {
+			b.write(filepath.Join("config", cm.Name+".yaml"), []byte(config))
+		}
+	}
+}
+
+func (b *bundle) kubectl(ctx context.Context, k *k8s.KubectlOptions, name string, args ...string) {
+	out, err := run(ctx, "kubectl", kubectlArgs(k, args...)...)
+	if err != nil {
+		b.fail("kubectl "+strings.Join(args, " "), err)
+	}
+	if out != "" {
+		b.write(name, []byte(out+"\n"))
+	}
+}
+
+// helmReleases writes the history of every Helm release in the namespace,
+// failed and pending ones included. Manifests and values are left out for the
+// same reason as Secrets: they hold the masterkey and database passwords.
+func (b *bundle) helmReleases(ctx context.Context, k *k8s.KubectlOptions) {
+	out, err := run(ctx, "helm", helmArgs(k, "list", "--all", "--short")...)
+	if err != nil {
+		b.fail("helm list", err)
+		return
+	}
+	for _, release := range strings.Fields(out) {
+		args := []string{"history", release}
+		out, err := run(ctx, "helm", helmArgs(k, args...)...)
+		if err != nil {
+			b.fail("helm "+strings.Join(args, " "), err)
+			continue
+		}
+		b.write(filepath.Join("helm", release, "history.txt"), []byte(out+"\n"))
+	}
+}
+
+// kubectlArgs prefixes args with the cluster and namespace selected by k.
+func kubectlArgs(k *k8s.KubectlOptions, args ...string) []string {
+	var prefix []string
+	if k.ConfigPath != "" {
+		prefix = append(prefix, "--kubeconfig", k.ConfigPath)
+	}
+	if k.ContextName != "" {
+		prefix = append(prefix, "--context", k.ContextName)
+	}
+	if k.Namespace != "" {
+		prefix = append(prefix, "--namespace", k.Namespace)
+	}
+	return append(prefix, args...)
+}
+
+// helmArgs prefixes args with the cluster and namespace selected by k. Helm
+// names the context flag differently from kubectl.
+func helmArgs(k *k8s.KubectlOptions, args ...string) []string {
+	var prefix []string
+	if k.ConfigPath != "" {
+		prefix = append(prefix, "--kubeconfig", k.ConfigPath)
+	}
+	if k.ContextName != "" {
+		prefix = append(prefix, "--kube-context", k.ContextName)
+	}
+	if k.Namespace != "" {
+		prefix = append(prefix, "--namespace", k.Namespace)
+	}
+	return append(prefix, args...)
+}
+
+// run runs name with args until it exits or ctx is done, and returns its
+// trimmed stdout. Its stderr is included in the error.
+func run(ctx context.Context, name string, args ...string) (string, error) {
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, name, args...)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	err := cmd.Run()
+	if err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			err = errors.Join(err, ctxErr)
+		}
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			err = fmt.Errorf("%w\n%s", err, msg)
+		}
+	}
+	return strings.TrimSpace(stdout.String()), err
+}
@@ -0,0 +1,137 @@
+package testcluster
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gruntwork-io/terratest/modules/k8s"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestBundleCollectsLogsEventsAndConfig(t *testing.T) {
+	const ns = "diag"
+	now := time.Now()
+	client := fake.NewClientset(
+		&corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: "zitadel-0", Namespace: ns},
+			Spec: corev1.PodSpec{
+				InitContainers: []corev1.Container{{Name: "chown"}},
+				Containers:     []corev1.Container{{Name: "zitadel"}},
+			},
+			Status: corev1.PodStatus{
+				ContainerStatuses: []corev1.ContainerStatus{{Name: "zitadel", RestartCount: 2}},
+			},
+		},
+		&corev1.Event{
+			ObjectMeta:     metav1.ObjectMeta{Name: "late", Namespace: ns},
+			InvolvedObject: corev1.ObjectReference{Kind: "Pod", Name: "zitadel-0"},
+			Reason:         "BackOff",
+			LastTimestamp:  metav1.NewTime(now),
+		},
+		&corev1.Event{
+			ObjectMeta:     metav1.ObjectMeta{Name: "early", Namespace: ns},
+			InvolvedObject: corev1.ObjectReference{Kind: "Pod", Name: "zitadel-0"},
+			Reason:         "Scheduled",
+			LastTimestamp:  metav1.NewTime(now.Add(-time.Minute)),
+		},
+		&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "zitadel-config-yaml", Namespace: ns},
+			Data:       map[string]string{zitadelConfigKey: "ExternalDomain: example.com"},
+		},
+	)
+
+	b := &bundle{dir: t.TempDir()}
+	ctx := context.Background()
+	b.podLogs(ctx, client, ns)
+	b.events(ctx, client, ns)
+	b.zitadelConfigs(ctx, client, ns)
+	require.Empty(t, b.errs)
+
+	for _, name := range []string{"chown.log", "zitadel.log", "zitadel.previous.log"} {
+		require.FileExists(t, filepath.Join(b.dir, "pods", "zitadel-0", name))
+	}
+	require.NoFileExists(t, filepath.Join(b.dir, "pods", "zitadel-0", "chown.previous.log"))
+
+	events, err := os.ReadFile(filepath.Join(b.dir, "events.txt"))
+	require.NoError(t, err)
+	require.Regexp(t, `(?s)Scheduled.*BackOff`, string(events))
+
+	config, err := os.ReadFile(filepath.Join(b.dir, "config", "zitadel-config-yaml.yaml"))
+	require.NoError(t, err)
+	require.Equal(t, "ExternalDomain: example.com", string(config))
+}
+
+func TestDiagnosticsKindsLeaveOutSecrets(t *testing.T) {
+	require.NotContains(t, strings.Split(diagnosticsKinds, ","), "secrets")
+}
+
+// TestHelmReleasesLeaveOutSecrets runs the collector against a fake helm
+// whose release carries a masterkey, a database password and a Secret, and
+// checks that none of them end up in the bundle.
+func TestHelmReleasesLeaveOutSecrets(t *testing.T) {
+	secrets := []string{"x123456789012345678901234567891y", "db-admin-password", "c2VjcmV0LXZhbHVl"}
+	bin := t.TempDir()
+	script := `#!/bin/sh
+for arg in "$@"; do
+  case "$arg" in
+    list) echo zitadel; exit 0 ;;
+    history) echo "REVISION  STATUS  DESCRIPTION"; echo "1         failed  Upgrade failed"; exit 0 ;;
+    values) printf 'zitadel:\n  masterkey: %s\n  secretConfig:\n    Database:\n      Postgres:\n        Admin:\n          Password: %s\n' "$MASTERKEY" "$PASSWORD"; exit 0 ;;
+    manifest|hooks|all) printf 'apiVersion: v1\nkind: Secret\nmetadata:\n  name: zitadel-masterkey\ndata:\n  masterkey: %s\n' "$SECRET"; exit 0 ;;
+  esac
+done
+exit 1
+`
+	require.NoError(t, os.WriteFile(filepath.Join(bin, "helm"), []byte(script), 0o755))
+	t.Setenv("PATH", bin+string(os.PathListSeparator)+os.Getenv("PATH"))
+	t.Setenv("MASTERKEY", secrets[0])
+	t.Setenv("PASSWORD", secrets[1])
+	t.Setenv("SECRET", secrets[2])
+
+	b := &bundle{dir: t.TempDir()}
+	b.helmReleases(context.Background(), k8s.NewKubectlOptions("", "", "diag"))
+	require.Empty(t, b.errs)
+	require.FileExists(t, filepath.Join(b.dir, "helm", "zitadel", "history.txt"))
+
+	err := filepath.WalkDir(b.dir, func(path string, d os.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		for _, secret := range secrets {
+			require.NotContains(t, string(data), secret, "secret value written to %s", path)
+		}
+		return nil
+	})
+	require.NoError(t, err)
+}
+
+func TestCommandArgsSelectClusterAndNamespace(t *testing.T) {
+	k := k8s.NewKubectlOptions("k3s", "/tmp/kubeconfig", "diag")
+	require.Equal(t,
+		[]string{"--kubeconfig", "/tmp/kubeconfig", "--context", "k3s", "--namespace", "diag", "get", "pods"},
+		kubectlArgs(k, "get", "pods"))
+	require.Equal(t,
+		[]string{"--kubeconfig", "/tmp/kubeconfig", "--kube-context", "k3s", "--namespace", "diag", "list"},
+		helmArgs(k, "list"))
+	require.Equal(t, []string{"list"}, helmArgs(&k8s.KubectlOptions{}, "list"))
+}
+
+func TestRunStopsWhenContextIsDone(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	started := time.Now()
+	_, err := run(ctx, "sleep", "10")
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.Less(t, time.Since(started), 5*time.Second)
+}
@@ -0,0 +1,256 @@
+package testcluster
+
+import (
+	"archive/tar"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/containerd/errdefs"
+	"github.com/distribution/reference"
+	"github.com/testcontainers/testcontainers-go"
+	tcexec "github.com/testcontainers/testcontainers-go/exec"
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/zitadel/zitadel-charts/test/render"
+)
+
+// ImagesEnvVar makes Start side-load the images the suites deploy into every
+// node before tests start, so that K3s never pulls them. Set it to "docker"
+// to save them from the host Docker daemon, or to the path of a tarball
+// written by `docker save` or holding an OCI image layout. It is ignored for
+// a cluster given by KubeconfigEnvVar.
+const ImagesEnvVar = "ZITADEL_TEST_IMAGES"
+
+// importPath is where image archives are copied to inside a node.
+const importPath = "/tmp/zitadel-charts-images.tar"
+
+// ChartImages returns the normalized references of every image the chart
+// renders with its defaults on the K3s version the cluster runs, e.g.
+// "ghcr.io/zitadel/zitadel:v4.13.0" and "docker.io/alpine/k8s:1.34.1".
+func ChartImages() ([]string, error) {
+	m, err := render.ChartE(
+		render.WithSetValues(map[string]string{"zitadel.masterkey": strings.Repeat("x", 32)}),
+		render.WithKubeVersion(kubeVersion()),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("rendering the chart: %w", err)
+	}
+
+	seen := map[string]bool{}
+	for _, obj := range m.Objects {
+		var spec *corev1.PodSpec
+		switch o := obj.(type) {
+		case *appsv1.Deployment:
+			spec = &o.Spec.Template.Spec
+		case *appsv1.StatefulSet:
+			spec = &o.Spec.Template.Spec
+		case *appsv1.ReplicaSet:
+			spec = &o.Spec.Template.Spec
+		case *batchv1.Job:
+			spec = &o.Spec.Template.Spec
+		case *corev1.Pod:
+			spec = &o.Spec
+		default:
+			continue
+		}
+		for _, c := range append(spec.InitContainers, spec.Containers...) {
+			ref, err := normalize(c.Image)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", c.Name, err)
+			}
+			seen[ref] = true
+		}
+	}
+	return sortedSet(seen), nil
+}
+
+// kubeVersion returns the Kubernetes version of k3sImage, e.g. "v1.34.1".
+func kubeVersion() string {
+	_, tag, _ := strings.Cut(k3sImage, ":")
+	version, _, _ := strings.Cut(tag, "-")
+	return version
+}
+
+// preloadImages imports the chart's images and that of InstallPostgres from
+// source, as described by ImagesEnvVar, into the containerd of every node.
+func preloadImages(ctx context.Context, source string, nodes []testcontainers.Container) error {
+	images, err := ChartImages()
+	if err != nil {
+		return err
+	}
+	postgres, err := postgresImage()
+	if err != nil {
+		return err
+	}
+	if postgres, err = normalize(postgres); err != nil {
+		return err
+	}
+	images = append(images, postgres)
+
+	archive := source
+	if source == "docker" {
+		if archive, err = saveImages(ctx, images); err != nil {
+			return err
+		}
+		defer func() { _ = os.Remove(archive) }()
+	} else if err := checkArchive(archive, images); err != nil {
+		return err
+	}
+
+	for _, node := range nodes {
+		if err := node.CopyFileToContainer(ctx, archive, importPath, 0o644); err != nil {
+			return fmt.Errorf("copying images to node: %w", err)
+		}
+		code, out, err := node.Exec(ctx, []string{"ctr", "-n=k8s.io", "images", "import", "--all-platforms", importPath}, tcexec.Multiplexed())
+		if err != nil {
+			return fmt.Errorf("importing images: %w", err)
+		}
+		if code != 0 {
+			output, _ := io.ReadAll(out)
+			return fmt.Errorf("importing images: ctr exited with %d\n%s", code, output)
+		}
+	}
+	return nil
+}
+
+// saveImages writes images from the host Docker daemon to a temporary
+// archive. It fails with the list of images the daemon does not have.
+func saveImages(ctx context.Context, images []string) (string, error) {
+	provider, err := testcontainers.NewDockerProvider()
+	if err != nil {
+		return "", err
+	}
+	defer func() { _ = provider.Close() }()
+
+	var missing []string
+	for _, image := range images {
+		if _, err := provider.Client().ImageInspect(ctx, image); errdefs.IsNotFound(err) {
+			missing = append(missing, image)
+		} else if err != nil {
+			return "", fmt.Errorf("inspecting %s: %w", image, err)
+		}
+	}
+	if len(missing) > 0 {
+		return "", missingError("the Docker daemon", missing)
+	}
+
+	f, err := os.CreateTemp("", "zitadel-charts-images-*.tar")
+	if err != nil {
+		return "", err
+	}
+	_ = f.Close()
+	if err := provider.SaveImages(ctx, f.Name(), images...); err != nil {
+		_ = os.Remove(f.Name())
+		return "", err
+	}
+	return f.Name(), nil
+}
+
+// checkArchive fails with the list of images that the archive at path does
+// not contain.
+func checkArchive(path string, images []string) error {
+	contained, err := archiveImages(path)
+	if err != nil {
+		return fmt.Errorf("%s: %w", ImagesEnvVar, err)
+	}
+	var missing []string
+	for _, image := range images {
+		if !contained[image] {
+			missing = append(missing, image)
+		}
+	}
+	if len(missing) > 0 {
+		return missingError(path, missing)
+	}
+	return nil
+}
+
+func missingError(source string, missing []string) error {
+	return fmt.Errorf("%s is missing %d of the images the tests deploy:\n  %s", source, len(missing), strings.Join(missing, "\n  "))
+}
+
+// archiveImages returns the normalized references of the images in a
+// `docker save` archive (manifest.json) or an OCI image layout (index.json).
+func archiveImages(path string) (map[string]bool, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = f.Close() }()
+
+	images := map[string]bool{}
+	add := func(ref string) {
+		if normalized, err := normalize(ref); err == nil {
+			images[normalized] = true
+		}
+	}
+	var found bool
+	r := tar.NewReader(f)
+	for {
+		hdr, err := r.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		switch strings.TrimPrefix(hdr.Name, "./") {
+		case "manifest.json":
+			var manifest []struct{ RepoTags []string }
+			if err := json.NewDecoder(r).Decode(&manifest); err != nil {
+				return nil, fmt.Errorf("manifest.json: %w", err)
+			}
+			for _, m := range manifest {
+				for _, tag := range m.RepoTags {
+					add(tag)
+				}
+			}
+			found = true
+		case "index.json":
+			var index struct {
+				Manifests []struct {
+					Annotations map[string]string `json:"annotations"`
+				} `json:"manifests"`
+			}
+			if err := json.NewDecoder(r).Decode(&index); err != nil {
+				return nil, fmt.Errorf("index.json: %w", err)
+			}
+			for _, m := range index.Manifests {
+				add(m.Annotations["io.containerd.image.name"])
+				add(m.Annotations["org.opencontainers.image.ref.name"])
+			}
+			found = true
+		}
+	}
+	if !found {
+		return nil, errors.New("neither a docker save archive nor an OCI image layout")
+	}
+	return images, nil
+}
+
+// normalize returns the fully qualified form of an image reference, as
+// containerd names it, e.g. "docker.io/library/postgres:latest".
+func normalize(ref string) (string, error) {
+	named, err := reference.ParseNormalizedNamed(ref)
+	if err != nil {
+		return "", fmt.Errorf("invalid image reference %q: %w", ref, err)
+	}
+	return reference.TagNameOnly(named).String(), nil
+}
+
+func sortedSet(set map[string]bool) []string {
+	keys := make([]string, 0, len(set))
+	for k := range set {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,71 @@
+package testcluster
+
+import (
+	"archive/tar"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"helm.sh/helm/v3/pkg/chart/loader"
+
+	"github.com/zitadel/zitadel-charts/test/render"
+)
+
+func TestChartImagesFollowAppVersionAndCluster(t *testing.T) {
+	chrt, err := loader.Load(render.ChartPath())
+	require.NoError(t, err)
+
+	images, err := ChartImages()
+	require.NoError(t, err)
+	require.Contains(t, images, "ghcr.io/zitadel/zitadel:"+chrt.Metadata.AppVersion)
+	require.Contains(t, images, "ghcr.io/zitadel/zitadel-login:"+chrt.Metadata.AppVersion)
+	require.Contains(t, images, "docker.io/alpine/k8s:"+kubeVersion()[1:])
+	for _, image := range images {
+		normalized, err := normalize(image)
+		require.NoError(t, err)
+		require.Equal(t, normalized, image, "ChartImages must return normalized references")
+	}
+}
+
+func TestCheckArchiveListsMissingImages(t *testing.T) {
+	images := []string{"docker.io/library/postgres:17", "ghcr.io/zitadel/zitadel:v4.13.0"}
+
+	dockerSave := writeTar(t, "manifest.json", []map[string]any{
+		{"RepoTags": []string{"postgres:17", "ghcr.io/zitadel/zitadel:v4.13.0"}},
+	})
+	require.NoError(t, checkArchive(dockerSave, images))
+
+	ociLayout := writeTar(t, "index.json", map[string]any{
+		"manifests": []map[string]any{
+			{"annotations": map[string]string{"io.containerd.image.name": "docker.io/library/postgres:17"}},
+		},
+	})
+	err := checkArchive(ociLayout, images)
+	require.ErrorContains(t, err, "missing 1 of the images")
+	require.ErrorContains(t, err, "ghcr.io/zitadel/zitadel:v4.13.0")
+	require.NotContains(t, err.Error(), "postgres")
+
+	require.ErrorContains(t, checkArchive(writeTar(t, "layer.tar", "x"), images), "neither")
+}
+
+// writeTar writes a tarball holding a single JSON file and returns its path.
+func writeTar(t *testing.T, name string, content any) string {
+	t.Helper()
+
+	data, err := json.Marshal(content)
+	require.NoError(t, err)
+
+	path := filepath.Join(t.TempDir(), "images.tar")
+	f, err := os.Create(path)
+	require.NoError(t, err)
+	defer func() { require.NoError(t, f.Close()) }()
+
+	w := tar.NewWriter(f)
+	require.NoError(t, w.WriteHeader(&tar.Header{Name: name, Mode: 0o644, Size: int64(len(data))}))
+	_, err = w.Write(data)
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+	return path
+}
@@ -2,6 +2,7 @@ package testcluster
 
 import (
 	"context"
+	"fmt"
 	"os"
 	"strings"
 	"testing"
@@ -9,31 +10,75 @@ import (
 
 	"github.com/gruntwork-io/terratest/modules/k8s"
 	"github.com/gruntwork-io/terratest/modules/random"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/clientcmd"
 )
 
+// namespaceLabel marks the namespaces created by WithNamespace, so that a
+// persistent cluster can find the ones earlier runs left behind.
+const namespaceLabel = "dev.zitadel.charts.testcluster/namespace"
+
+// namespaceTimeout bounds how long a test may use its namespace.
+const namespaceTimeout = 30 * time.Minute
+
 // WithNamespace creates a unique namespace for a test, runs the provided
 // function, and cleans up the namespace afterwards. The namespace name is
 // generated using the current Unix nanosecond timestamp to ensure uniqueness
 // across concurrent test runs.
 //
 // A 30-minute context timeout is provided to the callback. If the test fails,
-// the namespace is preserved for debugging purposes.
+// the namespace is preserved for debugging purposes and its diagnostics are
+// written by CollectDiagnostics.
 func WithNamespace(t *testing.T, fn func(ctx context.Context, k *k8s.KubectlOptions)) {
 	t.Helper()
-	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Minute)
+	ctx, cancel := context.WithTimeout(context.Background(), namespaceTimeout)
 	defer cancel()
 
 	namespace := "zitadel-test-" + strings.ToLower(random.UniqueId())
 	k := k8s.NewKubectlOptions("", os.Getenv("KUBECONFIG"), namespace)
 
-	k8s.CreateNamespace(t, k, namespace)
+	k8s.CreateNamespaceWithMetadata(t, k, metav1.ObjectMeta{
+		Name:   namespace,
+		Labels: map[string]string{namespaceLabel: "true"},
+	})
 	defer func() {
 		if !t.Failed() {
 			k8s.DeleteNamespace(t, k, namespace)
 		} else {
-			t.Logf("Test failed, keeping namespace %s for debugging", namespace)
+			dir := CollectDiagnostics(t, k)
+			t.Logf("Test failed, keeping namespace %s for debugging; diagnostics written to %s", namespace, dir)
 		}
 	}()
 
 	fn(ctx, k)
 }
+
+// deleteStaleNamespaces deletes the test namespaces that are older than
+// namespaceTimeout. No test can still be using them, so this is safe while
+// other test binaries share the cluster.
+func deleteStaleNamespaces(ctx context.Context, kubeconfigPath string) error {
+	config, err := clientcmd.BuildConfigFromFlags("", kubeconfigPath)
+	if err != nil {
+		return err
+	}
+	client, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		return err
+	}
+
+	namespaces, err := client.CoreV1().Namespaces().List(ctx, metav1.ListOptions{LabelSelector: namespaceLabel})
+	if err != nil {
+		return fmt.Errorf("listing test namespaces: %w", err)
+	}
+	cutoff := time.Now().Add(-namespaceTimeout)
+	for _, ns := range namespaces.Items {
+		if ns.DeletionTimestamp != nil || ns.CreationTimestamp.After(cutoff) {
+			continue
+		}
+		if err := client.CoreV1().Namespaces().Delete(ctx, ns.Name, metav1.DeleteOptions{}); err != nil {
+			return fmt.Errorf("deleting stale namespace %s: %w", ns.Name, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,151 @@
+package testcluster
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/docker/docker/api/types/container"
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/network"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+// serverAlias is the network alias agents use to reach the K3s server.
+const serverAlias = "k3s-server"
+
+// Node configures a K3s node.
+type Node struct {
+	// Name is the Kubernetes node name. Agents default to "agent-<n>",
+	// counting from 1; the server keeps its container hostname.
+	Name string
+	// Labels are registered on the node, e.g.
+	// {"topology.kubernetes.io/zone": "zone-a"}.
+	Labels map[string]string
+	// Taints are registered on the node in kubectl syntax, e.g.
+	// "dedicated=zitadel:NoSchedule".
+	Taints []string
+}
+
+// flags returns the K3s command-line flags that register the node's name,
+// labels and taints.
+func (n Node) flags() []string {
+	var flags []string
+	if n.Name != "" {
+		flags = append(flags, "--node-name="+n.Name)
+	}
+	keys := make([]string, 0, len(n.Labels))
+	for k := range n.Labels {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		flags = append(flags, "--node-label="+k+"="+n.Labels[k])
+	}
+	for _, taint := range n.Taints {
+		flags = append(flags, "--node-taint="+taint)
+	}
+	return flags
+}
+
+// Option configures the cluster created by Start.
+type Option func(*config)
+
+type config struct {
+	server Node
+	agents []Node
+}
+
+// WithServer sets the name, labels and taints of the K3s server node.
+func WithServer(node Node) Option {
+	return func(c *config) {
+		c.server = node
+	}
+}
+
+// WithAgents adds K3s agent nodes. Each agent runs in its own container and
+// joins the server over a shared Docker network. Start returns once every
+// node is Ready. Agents are only supported for ephemeral clusters.
+func WithAgents(nodes ...Node) Option {
+	return func(c *config) {
+		c.agents = append(c.agents, nodes...)
+	}
+}
+
+// newToken returns a random cluster join token.
+func newToken() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// startAgent runs a K3s agent container that joins the server on nw.
+func startAgent(ctx context.Context, node Node, token string, nw *testcontainers.DockerNetwork) (testcontainers.Container, error) {
+	ctr, err := testcontainers.Run(ctx, k3sImage,
+		testcontainers.WithCmd(append([]string{"agent"}, node.flags()...)...),
+		testcontainers.WithEnv(map[string]string{
+			"K3S_URL":   "https://" + serverAlias + ":6443",
+			"K3S_TOKEN": token,
+		}),
+		// The same host configuration the k3s module uses for the server.
+		testcontainers.WithHostConfigModifier(func(hc *container.HostConfig) {
+			hc.Privileged = true
+			hc.CgroupnsMode = "host"
+			hc.Tmpfs = map[string]string{
+				"/run":     "",
+				"/var/run": "",
+			}
+		}),
+		testcontainers.WithLabels(map[string]string{containerLabel: "true"}),
+		network.WithNetwork([]string{node.Name}, nw),
+	)
+	if err != nil {
+		if ctr != nil {
+			_ = ctr.Terminate(context.Background())
+		}
+		return nil, fmt.Errorf("starting agent %s: %w", node.Name, err)
+	}
+	return ctr, nil
+}
+
+// waitForNodes waits until want nodes have registered and are Ready.
+func waitForNodes(ctx context.Context, kubeconfigPath string, want int) error {
+	config, err := clientcmd.BuildConfigFromFlags("", kubeconfigPath)
+	if err != nil {
+		return err
+	}
+	client, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		return err
+	}
+
+	var ready int
+	err = wait.PollUntilContextCancel(ctx, 2*time.Second, true, func(ctx context.Context) (bool, error) {
+		nodes, err := client.CoreV1().Nodes().List(ctx, metav1.ListOptions{})
+		if err != nil {
+			return false, nil
+		}
+		ready = 0
+		for _, node := range nodes.Items {
+			for _, cond := range node.Status.Conditions {
+				if cond.Type == corev1.NodeReady && cond.Status == corev1.ConditionTrue {
+					ready++
+				}
+			}
+		}
+		return ready >= want, nil
+	})
+	if err != nil {
+		return fmt.Errorf("waiting for %d Ready nodes (%d ready): %w", want, ready, err)
+	}
+	return nil
+}
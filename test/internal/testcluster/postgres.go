@@ -0,0 +1,164 @@
+package testcluster
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gruntwork-io/terratest/modules/k8s"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/ptr"
+)
+
+// postgresTimeout bounds how long InstallPostgres waits for the database.
+const postgresTimeout = 5 * time.Minute
+
+// tlsMountPath is where the TLS secret is mounted in the PostgreSQL pod.
+const tlsMountPath = "/tls"
+
+// PostgresOption configures InstallPostgres.
+type PostgresOption func(*postgresConfig)
+
+type postgresConfig struct {
+	tlsSecretName string
+	password      string
+	database      string
+}
+
+// WithPostgresTLS enables TLS with the certificate in the given secret, as
+// created by CreateTLSSecret. The server requests client certificates signed
+// by its ca.crt but does not require them.
+func WithPostgresTLS(secretName string) PostgresOption {
+	return func(c *postgresConfig) {
+		c.tlsSecretName = secretName
+	}
+}
+
+// WithPostgresDatabase sets the database created on first start, in addition
+// to "postgres".
+func WithPostgresDatabase(database string) PostgresOption {
+	return func(c *postgresConfig) {
+		c.database = database
+	}
+}
+
+// WithPostgresPassword sets the password of the postgres superuser and makes
+// the server require passwords. Without it, every connection is trusted.
+func WithPostgresPassword(password string) PostgresOption {
+	return func(c *postgresConfig) {
+		c.password = password
+	}
+}
+
+// InstallPostgres deploys a single PostgreSQL instance into the namespace
+// from KubectlOptions and waits until it accepts connections. It is reachable
+// at db-postgresql:5432 with the superuser "postgres". Data lives in an
+// emptyDir, so it is lost with the pod. The manifests are embedded and the
+// image is side-loaded with the chart's (see ImagesEnvVar), so no Helm
+// repository or registry access is needed.
+func InstallPostgres(t *testing.T, k *k8s.KubectlOptions, opts ...PostgresOption) {
+	t.Helper()
+
+	cfg := &postgresConfig{}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
+	service, statefulSet, err := postgresManifests()
+	require.NoError(t, err, "failed to decode the PostgreSQL manifests")
+	configurePostgres(&statefulSet.Spec.Template.Spec, cfg)
+
+	clientset, err := k8s.GetKubernetesClientFromOptionsE(t, k)
+	require.NoError(t, err, "failed to create k8s client")
+	ctx, cancel := context.WithTimeout(context.Background(), postgresTimeout)
+	defer cancel()
+
+	_, err = clientset.CoreV1().Services(k.Namespace).Create(ctx, service, metav1.CreateOptions{})
+	require.NoError(t, err, "failed to create the PostgreSQL service")
+	_, err = clientset.AppsV1().StatefulSets(k.Namespace).Create(ctx, statefulSet, metav1.CreateOptions{})
+	require.NoError(t, err, "failed to create the PostgreSQL statefulset")
+
+	var last *appsv1.StatefulSet
+	err = wait.PollUntilContextCancel(ctx, 2*time.Second, true, func(ctx context.Context) (bool, error) {
+		last, err = clientset.AppsV1().StatefulSets(k.Namespace).Get(ctx, statefulSet.Name, metav1.GetOptions{})
+		if err != nil {
+			return false, nil
+		}
+		return last.Status.ReadyReplicas == 1, nil
+	})
+	require.NoError(t, err, "PostgreSQL did not become ready within %s; last status: %+v", postgresTimeout, last)
+}
+
+// configurePostgres applies the options to the PostgreSQL pod.
+func configurePostgres(spec *corev1.PodSpec, cfg *postgresConfig) {
+	c := &spec.Containers[0]
+	if cfg.password != "" {
+		c.Env = append(c.Env, corev1.EnvVar{Name: "POSTGRES_PASSWORD", Value: cfg.password})
+	} else {
+		c.Env = append(c.Env, corev1.EnvVar{Name: "POSTGRES_HOST_AUTH_METHOD", Value: "trust"})
+	}
+	if cfg.database != "" {
+		c.Env = append(c.Env, corev1.EnvVar{Name: "POSTGRES_DB", Value: cfg.database})
+	}
+	if cfg.tlsSecretName != "" {
+		c.Args = append(c.Args,
+			"-c", "ssl=on",
+			"-c", "ssl_cert_file="+tlsMountPath+"/tls.crt",
+			"-c", "ssl_key_file="+tlsMountPath+"/tls.key",
+			"-c", "ssl_ca_file="+tlsMountPath+"/ca.crt",
+		)
+		c.VolumeMounts = append(c.VolumeMounts, corev1.VolumeMount{Name: "tls", MountPath: tlsMountPath, ReadOnly: true})
+		spec.Volumes = append(spec.Volumes, corev1.Volume{
+			Name: "tls",
+			VolumeSource: corev1.VolumeSource{Secret: &corev1.SecretVolumeSource{
+				SecretName:  cfg.tlsSecretName,
+				DefaultMode: ptr.To[int32](0o640),
+			}},
+		})
+	}
+}
+
+// postgresManifests decodes the embedded PostgreSQL Service and StatefulSet.
+func postgresManifests() (*corev1.Service, *appsv1.StatefulSet, error) {
+	data, err := manifests.ReadFile("postgres.yaml")
+	if err != nil {
+		return nil, nil, err
+	}
+	var service *corev1.Service
+	var statefulSet *appsv1.StatefulSet
+	decoder := scheme.Codecs.UniversalDeserializer()
+	for _, doc := range strings.Split(string(data), "\n---\n") {
+		obj, _, err := decoder.Decode([]byte(doc), nil, nil)
+		if err != nil {
+			return nil, nil, err
+		}
+		switch o := obj.(type) {
+		case *corev1.Service:
+			service = o
+		case *appsv1.StatefulSet:
+			statefulSet = o
+		default:
+			return nil, nil, fmt.Errorf("unexpected %T in postgres.yaml", obj)
+		}
+	}
+	if service == nil || statefulSet == nil {
+		return nil, nil, fmt.Errorf("postgres.yaml must hold a Service and a StatefulSet")
+	}
+	return service, statefulSet, nil
+}
+
+// postgresImage returns the image of the embedded PostgreSQL StatefulSet.
+func postgresImage() (string, error) {
+	_, statefulSet, err := postgresManifests()
+	if err != nil {
+		return "", err
+	}
+	return statefulSet.Spec.Template.Spec.Containers[0].Image, nil
+}
@@ -0,0 +1,30 @@
+package testcluster
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestConfigurePostgres(t *testing.T) {
+	_, statefulSet, err := postgresManifests()
+	require.NoError(t, err)
+	spec := statefulSet.Spec.Template.Spec
+	require.Equal(t, "db-postgresql", statefulSet.Spec.ServiceName)
+
+	trusted := spec.DeepCopy()
+	configurePostgres(trusted, &postgresConfig{})
+	require.Contains(t, trusted.Containers[0].Env, corev1.EnvVar{Name: "POSTGRES_HOST_AUTH_METHOD", Value: "trust"})
+	require.Len(t, trusted.Volumes, len(spec.Volumes))
+
+	secured := spec.DeepCopy()
+	configurePostgres(secured, &postgresConfig{tlsSecretName: "postgres-cert", password: "abc", database: "zitadel"})
+	c := secured.Containers[0]
+	require.Contains(t, c.Env, corev1.EnvVar{Name: "POSTGRES_PASSWORD", Value: "abc"})
+	require.Contains(t, c.Env, corev1.EnvVar{Name: "POSTGRES_DB", Value: "zitadel"})
+	require.NotContains(t, c.Env, corev1.EnvVar{Name: "POSTGRES_HOST_AUTH_METHOD", Value: "trust"})
+	require.Contains(t, c.Args, "ssl=on")
+	require.Contains(t, c.Args, "ssl_key_file=/tls/tls.key")
+	require.Equal(t, "postgres-cert", secured.Volumes[len(secured.Volumes)-1].Secret.SecretName)
+}
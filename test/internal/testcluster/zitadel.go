@@ -0,0 +1,53 @@
+package testcluster
+
+// Masterkey is the masterkey the suites install ZITADEL with unless a test
+// brings its own secret.
+const Masterkey = "x123456789012345678901234567891y"
+
+// PostgresHost is the service InstallPostgres makes the database reachable
+// at.
+const PostgresHost = "db-postgresql"
+
+// PostgresConfig returns the Database.Postgres section of the ZITADEL config
+// for the database started by InstallPostgres, connecting as user and
+// adminUser with sslMode. Passwords belong in the chart's secretConfig and
+// are left out.
+func PostgresConfig(user, adminUser, sslMode string) map[string]any {
+	return map[string]any{
+		"Host":            PostgresHost,
+		"Port":            5432,
+		"Database":        "zitadel",
+		"MaxOpenConns":    20,
+		"MaxIdleConns":    10,
+		"MaxConnLifetime": "30m",
+		"MaxConnIdleTime": "5m",
+		"User": map[string]any{
+			"Username": user,
+			"SSL":      map[string]any{"Mode": sslMode},
+		},
+		"Admin": map[string]any{
+			"Username": adminUser,
+			"SSL":      map[string]any{"Mode": sslMode},
+		},
+	}
+}
+
+// MachineUserConfig returns the FirstInstance section of the ZITADEL config
+// that creates a machine user with a JSON key. The setup job writes the key
+// to a secret named after username, under the key <username>.json.
+func MachineUserConfig(name, username string) map[string]any {
+	return map[string]any{
+		"Org": map[string]any{
+			"Machine": map[string]any{
+				"Machine": map[string]any{
+					"Username": username,
+					"Name":     name,
+				},
+				"MachineKey": map[string]any{
+					"ExpirationDate": "2029-01-01T00:00:00Z",
+					"Type":           1,
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,120 @@
+package load
+
+import (
+	"math"
+	"time"
+)
+
+const (
+	// histogramMin is the upper bound of the first bucket.
+	histogramMin = time.Millisecond
+	// bucketsPerDoubling sets the resolution: each bucket is 2^(1/4), about
+	// 19%, wider than the one before.
+	bucketsPerDoubling = 4
+	// histogramBuckets covers latencies up to about 65 seconds; slower
+	// requests land in the last bucket.
+	histogramBuckets = 16*bucketsPerDoubling + 1
+)
+
+// Histogram counts latencies in exponentially growing buckets, so its size
+// does not grow with the duration of a soak run. Percentiles are reported as
+// the upper bound of the bucket they fall into, which overestimates them by
+// at most one bucket width. A Histogram is not safe for concurrent use.
+type Histogram struct {
+	counts [histogramBuckets]int64
+	total  int64
+	sum    time.Duration
+	max    time.Duration
+}
+
+// Record adds one latency.
+func (h *Histogram) Record(d time.Duration) {
+	h.counts[bucketOf(d)]++
+	h.total++
+	h.sum += d
+	h.max = max(h.max, d)
+}
+
+// Merge adds the latencies recorded by other.
+func (h *Histogram) Merge(other *Histogram) {
+	for i, c := range other.counts {
+		h.counts[i] += c
+	}
+	h.total += other.total
+	h.sum += other.sum
+	h.max = max(h.max, other.max)
+}
+
+// Count returns the number of recorded latencies.
+func (h *Histogram) Count() int64 {
+	return h.total
+}
+
+// Mean returns the average recorded latency.
+func (h *Histogram) Mean() time.Duration {
+	if h.total == 0 {
+		return 0
+	}
+	return h.sum / time.Duration(h.total)
+}
+
+// Max returns the highest recorded latency.
+func (h *Histogram) Max() time.Duration {
+	return h.max
+}
+
+// Percentile returns the latency below which the fraction p, between 0 and
+// 1, of the recorded latencies fall. It never exceeds Max.
+func (h *Histogram) Percentile(p float64) time.Duration {
+	if h.total == 0 {
+		return 0
+	}
+	rank := int64(math.Ceil(p * float64(h.total)))
+	var seen int64
+	for i, c := range h.counts {
+		seen += c
+		if seen >= rank && c > 0 {
+			if i == histogramBuckets-1 {
+				// The last bucket has no upper bound.
+				return h.max
+			}
+			return min(bucketBound(i), h.max)
+		}
+	}
+	return h.max
+}
+
+// Bucket is a histogram bucket in a Report: Count latencies were above the
+// previous bucket's bound and at most LessOrEqualMs milliseconds.
+type Bucket struct {
+	LessOrEqualMs float64 `json:"leMs"`
+	Count         int64   `json:"count"`
+}
+
+// Buckets returns the non-empty buckets in ascending order. The last bucket
+// also holds every latency above its bound.
+func (h *Histogram) Buckets() []Bucket {
+	var buckets []Bucket
+	for i, c := range h.counts {
+		if c > 0 {
+			buckets = append(buckets, Bucket{LessOrEqualMs: milliseconds(bucketBound(i)), Count: c})
+		}
+	}
+	return buckets
+}
+
+func bucketOf(d time.Duration) int {
+	if d <= histogramMin {
+		return 0
+	}
+	i := int(math.Ceil(bucketsPerDoubling * math.Log2(float64(d)/float64(histogramMin))))
+	return min(i, histogramBuckets-1)
+}
+
+func bucketBound(i int) time.Duration {
+	return time.Duration(float64(histogramMin) * math.Exp2(float64(i)/bucketsPerDoubling))
+}
+
+func milliseconds(d time.Duration) float64 {
+	return float64(d) / float64(time.Millisecond)
+}
@@ -0,0 +1,50 @@
+package load
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHistogramPercentiles(t *testing.T) {
+	var h Histogram
+	for i := 1; i <= 100; i++ {
+		h.Record(time.Duration(i) * time.Millisecond)
+	}
+
+	require.Equal(t, int64(100), h.Count())
+	assert.Equal(t, 50500*time.Microsecond, h.Mean())
+	assert.Equal(t, 100*time.Millisecond, h.Max())
+	// Percentiles are bucket bounds, at most one bucket (19%) above the
+	// exact value and never above the maximum.
+	for _, c := range []struct {
+		p     float64
+		exact time.Duration
+	}{{0.5, 50 * time.Millisecond}, {0.9, 90 * time.Millisecond}, {0.99, 99 * time.Millisecond}} {
+		got := h.Percentile(c.p)
+		assert.GreaterOrEqual(t, got, c.exact, "p%v", 100*c.p)
+		assert.LessOrEqual(t, got, min(c.exact*119/100, h.Max()), "p%v", 100*c.p)
+	}
+
+	var total int64
+	for _, b := range h.Buckets() {
+		total += b.Count
+	}
+	assert.Equal(t, h.Count(), total, "the buckets should hold every latency")
+}
+
+func TestHistogramMergeAndOverflow(t *testing.T) {
+	var a, b Histogram
+	a.Record(500 * time.Microsecond)
+	b.Record(10 * time.Minute)
+	a.Merge(&b)
+
+	require.Equal(t, int64(2), a.Count())
+	assert.Equal(t, 10*time.Minute, a.Max())
+	assert.Equal(t, 10*time.Minute, a.Percentile(1), "latencies beyond the last bucket should report the maximum")
+	buckets := a.Buckets()
+	require.Len(t, buckets, 2)
+	assert.Equal(t, 1.0, buckets[0].LessOrEqualMs)
+}
@@ -0,0 +1,324 @@
+// Package load drives a weighted mix of requests against a deployed ZITADEL
+// release for a fixed duration and reports their latencies and errors, so
+// that replicaCount, resources and zitadel.autoscaling can be sized from
+// measurements instead of guesswork.
+//
+// Run starts a fixed number of workers. Each worker repeatedly picks a
+// scenario by its weight, runs it and records the outcome, until the
+// duration has passed. The Report holds per-scenario latency histograms
+// and percentiles and is written as JSON. Check compares it with Thresholds.
+//
+// The scenarios in scenarios.go cover token issuance, token introspection
+// and login page fetches. The tests in this package deploy the chart to the
+// K3s cluster from testcluster and run them. They only run if
+// ZITADEL_TEST_LOAD is set (see main_test.go).
+package load
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// maxSampleErrors is how many distinct error messages a scenario report
+// keeps.
+const maxSampleErrors = 10
+
+// Scenario is one kind of request. Do performs a single request and returns
+// an error if it failed or returned an unexpected response.
+type Scenario struct {
+	Name string
+	Do   func(ctx context.Context) error
+}
+
+// Weighted is a scenario and its share of the requests, relative to the
+// other scenarios of a mix.
+type Weighted struct {
+	Scenario
+	Weight int
+}
+
+// Config describes a run.
+type Config struct {
+	// Duration is how long requests are started for.
+	Duration time.Duration
+	// Concurrency is the number of workers, each of which has at most one
+	// request in flight.
+	Concurrency int
+	// Mix is the scenarios to run and their weights.
+	Mix []Weighted
+}
+
+// ParseMix parses a mix such as "token=5,introspection=3,login=2" into the
+// weights of the named scenarios.
+func ParseMix(s string) (map[string]int, error) {
+	weights := map[string]int{}
+	for _, part := range strings.Split(s, ",") {
+		name, weight, ok := strings.Cut(strings.TrimSpace(part), "=")
+		if !ok {
+			return nil, fmt.Errorf("mix entry %q is not of the form name=weight", part)
+		}
+		w, err := strconv.Atoi(weight)
+		if err != nil || w < 0 {
+			return nil, fmt.Errorf("mix entry %q: weight must be a non-negative integer", part)
+		}
+		weights[name] = w
+	}
+	return weights, nil
+}
+
+// Run runs cfg until its duration has passed or ctx is done, waits for the
+// requests in flight and reports their outcome. Requests still running when
+// ctx is done count as errors.
+func Run(ctx context.Context, cfg Config) (*Report, error) {
+	if cfg.Duration <= 0 {
+		return nil, errors.New("the duration must be positive")
+	}
+	if cfg.Concurrency <= 0 {
+		return nil, errors.New("the concurrency must be positive")
+	}
+	var totalWeight int
+	for _, w := range cfg.Mix {
+		if w.Weight < 0 {
+			return nil, fmt.Errorf("scenario %s has a negative weight", w.Name)
+		}
+		totalWeight += w.Weight
+	}
+	if totalWeight == 0 {
+		return nil, errors.New("the mix has no scenario with a positive weight")
+	}
+
+	runCtx, cancel := context.WithTimeout(ctx, cfg.Duration)
+	defer cancel()
+
+	started := time.Now()
+	results := make([]map[string]*scenarioStats, cfg.Concurrency)
+	var wg sync.WaitGroup
+	for i := range results {
+		stats := map[string]*scenarioStats{}
+		results[i] = stats
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for runCtx.Err() == nil {
+				s := pick(cfg.Mix, totalWeight)
+				begin := time.Now()
+				// The request itself uses ctx, so that it is not cut short
+				// when the duration ends.
+				err := s.Do(ctx)
+				if stats[s.Name] == nil {
+					stats[s.Name] = newScenarioStats()
+				}
+				stats[s.Name].record(time.Since(begin), err)
+			}
+		}()
+	}
+	wg.Wait()
+	elapsed := time.Since(started)
+
+	merged := map[string]*scenarioStats{}
+	for _, stats := range results {
+		for name, s := range stats {
+			if merged[name] == nil {
+				merged[name] = newScenarioStats()
+			}
+			merged[name].merge(s)
+		}
+	}
+	return newReport(cfg, elapsed, merged), nil
+}
+
+// pick chooses a scenario at random, proportionally to the weights.
+func pick(mix []Weighted, totalWeight int) Scenario {
+	n := rand.IntN(totalWeight)
+	for _, w := range mix {
+		if n < w.Weight {
+			return w.Scenario
+		}
+		n -= w.Weight
+	}
+	panic("unreachable: the weights add up to totalWeight")
+}
+
+// scenarioStats collects the outcomes of one worker's requests of a
+// scenario.
+type scenarioStats struct {
+	latencies Histogram
+	errors    int64
+	samples   map[string]int64
+}
+
+func newScenarioStats() *scenarioStats {
+	return &scenarioStats{samples: map[string]int64{}}
+}
+
+func (s *scenarioStats) record(d time.Duration, err error) {
+	s.latencies.Record(d)
+	if err == nil {
+		return
+	}
+	s.errors++
+	msg := err.Error()
+	if _, ok := s.samples[msg]; ok || len(s.samples) < maxSampleErrors {
+		s.samples[msg]++
+	}
+}
+
+func (s *scenarioStats) merge(other *scenarioStats) {
+	s.latencies.Merge(&other.latencies)
+	s.errors += other.errors
+	for msg, n := range other.samples {
+		if _, ok := s.samples[msg]; ok || len(s.samples) < maxSampleErrors {
+			s.samples[msg] += n
+		}
+	}
+}
+
+// Report is the outcome of a run.
+type Report struct {
+	Duration    string `json:"duration"`
+	Concurrency int    `json:"concurrency"`
+	// Mix maps the scenarios to their weights.
+	Mix map[string]int `json:"mix"`
+	// Total summarizes all requests.
+	Total ScenarioReport `json:"total"`
+	// Scenarios summarizes the requests of each scenario.
+	Scenarios map[string]ScenarioReport `json:"scenarios"`
+	// Violations lists the thresholds the run crossed, as set by Check.
+	Violations []string `json:"violations,omitempty"`
+}
+
+// ScenarioReport summarizes the requests of a scenario.
+type ScenarioReport struct {
+	Requests int64 `json:"requests"`
+	Errors   int64 `json:"errors"`
+	// ErrorRate is the fraction of requests that failed.
+	ErrorRate float64 `json:"errorRate"`
+	// Throughput is the number of requests per second.
+	Throughput float64 `json:"throughput"`
+	Latency    Latency `json:"latency"`
+	// ErrorSamples maps up to ten distinct error messages to how often they
+	// occurred.
+	ErrorSamples map[string]int64 `json:"errorSamples,omitempty"`
+}
+
+// Latency holds the percentiles of a scenario's latencies in milliseconds
+// and the histogram they were taken from. Failed requests are included.
+type Latency struct {
+	Mean      float64  `json:"meanMs"`
+	P50       float64  `json:"p50Ms"`
+	P90       float64  `json:"p90Ms"`
+	P99       float64  `json:"p99Ms"`
+	Max       float64  `json:"maxMs"`
+	Histogram []Bucket `json:"histogram"`
+}
+
+func newReport(cfg Config, elapsed time.Duration, stats map[string]*scenarioStats) *Report {
+	report := &Report{
+		Duration:    elapsed.Round(time.Millisecond).String(),
+		Concurrency: cfg.Concurrency,
+		Mix:         map[string]int{},
+		Scenarios:   map[string]ScenarioReport{},
+	}
+	for _, w := range cfg.Mix {
+		report.Mix[w.Name] = w.Weight
+	}
+
+	total := newScenarioStats()
+	for name, s := range stats {
+		report.Scenarios[name] = s.report(elapsed)
+		total.merge(s)
+	}
+	report.Total = total.report(elapsed)
+	return report
+}
+
+func (s *scenarioStats) report(elapsed time.Duration) ScenarioReport {
+	h := &s.latencies
+	r := ScenarioReport{
+		Requests: h.Count(),
+		Errors:   s.errors,
+		Latency: Latency{
+			Mean:      milliseconds(h.Mean()),
+			P50:       milliseconds(h.Percentile(0.50)),
+			P90:       milliseconds(h.Percentile(0.90)),
+			P99:       milliseconds(h.Percentile(0.99)),
+			Max:       milliseconds(h.Max()),
+			Histogram: h.Buckets(),
+		},
+	}
+	if r.Requests > 0 {
+		r.ErrorRate = float64(r.Errors) / float64(r.Requests)
+		r.Throughput = float64(r.Requests) / elapsed.Seconds()
+	}
+	if len(s.samples) > 0 {
+		r.ErrorSamples = s.samples
+	}
+	return r
+}
+
+// Thresholds are the limits a run must stay within.
+type Thresholds struct {
+	// MaxErrorRate is the highest acceptable fraction of failed requests
+	// of each scenario.
+	MaxErrorRate float64
+	// MaxP99 is the highest acceptable 99th percentile latency of each
+	// scenario.
+	MaxP99 time.Duration
+}
+
+// Check records in the report which thresholds each scenario crossed and
+// returns an error listing them, or nil if the run stayed within all of
+// them. A scenario of the mix that never ran counts as a violation.
+func (r *Report) Check(th Thresholds) error {
+	r.Violations = nil
+	names := make([]string, 0, len(r.Mix))
+	for name, weight := range r.Mix {
+		if weight > 0 {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		s, ok := r.Scenarios[name]
+		if !ok || s.Requests == 0 {
+			r.Violations = append(r.Violations, fmt.Sprintf("%s: no requests completed", name))
+			continue
+		}
+		if s.ErrorRate > th.MaxErrorRate {
+			r.Violations = append(r.Violations, fmt.Sprintf("%s: error rate %.2f%% exceeds %.2f%%",
+				name, 100*s.ErrorRate, 100*th.MaxErrorRate))
+		}
+		if th.MaxP99 > 0 && s.Latency.P99 > milliseconds(th.MaxP99) {
+			r.Violations = append(r.Violations, fmt.Sprintf("%s: p99 latency %.0fms exceeds %s",
+				name, s.Latency.P99, th.MaxP99))
+		}
+	}
+	if len(r.Violations) > 0 {
+		return fmt.Errorf("the run crossed %d thresholds:\n%s", len(r.Violations), strings.Join(r.Violations, "\n"))
+	}
+	return nil
+}
+
+// WriteFile writes the report as indented JSON to path, creating its
+// directory if needed.
+func (r *Report) WriteFile(path string) error {
+	data, err := json.MarshalIndent(r, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	return os.WriteFile(path, append(data, '\n'), 0o644)
+}
@@ -0,0 +1,253 @@
+package load_test
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"os"
+	"path/filepath"
+	"runtime"
+	"slices"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/gruntwork-io/terratest/modules/helm"
+	"github.com/gruntwork-io/terratest/modules/k8s"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/ptr"
+
+	"github.com/zitadel/zitadel-charts/test/internal/api"
+	"github.com/zitadel/zitadel-charts/test/internal/testcluster"
+	"github.com/zitadel/zitadel-charts/test/load"
+	"github.com/zitadel/zitadel-charts/test/values"
+)
+
+// The settings of TestLoad are read from these environment variables.
+const (
+	// durationEnvVar is how long requests are sent, e.g. "10m" for a soak
+	// run.
+	durationEnvVar = "ZITADEL_TEST_LOAD_DURATION"
+	// concurrencyEnvVar is the number of concurrent workers.
+	concurrencyEnvVar = "ZITADEL_TEST_LOAD_CONCURRENCY"
+	// mixEnvVar weighs the scenarios, e.g. "token=2,introspection=6,login=2".
+	mixEnvVar = "ZITADEL_TEST_LOAD_MIX"
+	// maxErrorRateEnvVar is the highest acceptable fraction of failed
+	// requests per scenario, e.g. "0.01".
+	maxErrorRateEnvVar = "ZITADEL_TEST_LOAD_MAX_ERROR_RATE"
+	// maxP99EnvVar is the highest acceptable p99 latency per scenario.
+	maxP99EnvVar = "ZITADEL_TEST_LOAD_MAX_P99"
+	// valuesEnvVar points at a values file applied on top of the test's
+	// values, to try out replicaCount, resources or zitadel.autoscaling.
+	valuesEnvVar = "ZITADEL_TEST_LOAD_VALUES"
+	// reportEnvVar is where the JSON report is written.
+	reportEnvVar = "ZITADEL_TEST_LOAD_REPORT"
+)
+
+const (
+	zitadelRelease  = "zitadel-load"
+	machineUsername = "zitadel-load-sa"
+)
+
+// settings configure TestLoad.
+type settings struct {
+	duration    time.Duration
+	concurrency int
+	mix         map[string]int
+	thresholds  load.Thresholds
+	valuesFile  string
+	reportPath  string
+}
+
+// loadSettings reads the settings from the environment, falling back to a
+// two-minute run. Installing the chart comes on top of that, so the test
+// needs the longer timeout `make test-load` passes (-timeout 90m), not go
+// test's default ten minutes.
+func loadSettings(t *testing.T) settings {
+	t.Helper()
+
+	s := settings{
+		duration:    2 * time.Minute,
+		concurrency: 16,
+		thresholds:  load.Thresholds{MaxErrorRate: 0.01, MaxP99: 2 * time.Second},
+		valuesFile:  os.Getenv(valuesEnvVar),
+		reportPath:  filepath.Join(".load-reports", "load.json"),
+	}
+	var err error
+	if v := os.Getenv(durationEnvVar); v != "" {
+		s.duration, err = time.ParseDuration(v)
+		require.NoError(t, err, "%s", durationEnvVar)
+	}
+	if v := os.Getenv(concurrencyEnvVar); v != "" {
+		s.concurrency, err = strconv.Atoi(v)
+		require.NoError(t, err, "%s", concurrencyEnvVar)
+	}
+	mix := "token=2,introspection=6,login=2"
+	if v := os.Getenv(mixEnvVar); v != "" {
+		mix = v
+	}
+	s.mix, err = load.ParseMix(mix)
+	require.NoError(t, err, "%s", mixEnvVar)
+	if v := os.Getenv(maxErrorRateEnvVar); v != "" {
+		s.thresholds.MaxErrorRate, err = strconv.ParseFloat(v, 64)
+		require.NoError(t, err, "%s", maxErrorRateEnvVar)
+	}
+	if v := os.Getenv(maxP99EnvVar); v != "" {
+		s.thresholds.MaxP99, err = time.ParseDuration(v)
+		require.NoError(t, err, "%s", maxP99EnvVar)
+	}
+	if v := os.Getenv(reportEnvVar); v != "" {
+		s.reportPath = v
+	}
+	return s
+}
+
+// TestLoad deploys the chart with PostgreSQL and a machine user, drives the
+// configured mix of token issuance, introspection and login page requests
+// against it, and fails if a scenario's error rate or p99 latency crosses
+// its threshold. The JSON report is written either way, so that runs with
+// different values can be compared.
+//
+// The introspection scenario introspects a single token of the machine user
+// on behalf of an API application created for the run.
+func TestLoad(t *testing.T) {
+	requireLoad(t)
+	s := loadSettings(t)
+	domain := "load.127.0.0.1.sslip.io"
+	apiBaseURL := fmt.Sprintf("https://%s:%s", domain, httpsPort)
+
+	testcluster.WithNamespace(t, func(ctx context.Context, k *k8s.KubectlOptions) {
+		testcluster.InstallPostgres(t, k)
+		installZitadel(t, k, domain, s.valuesFile)
+
+		key := k8s.GetSecret(t, k, machineUsername).Data[machineUsername+".json"]
+		require.NotNil(t, key, "the machine key secret %s has no key", machineUsername)
+		mix := scenarios(ctx, t, apiBaseURL, key, s.mix)
+
+		// The run gets its own context, since a soak run may outlast the
+		// namespace's.
+		report, err := load.Run(context.Background(), load.Config{
+			Duration:    s.duration,
+			Concurrency: s.concurrency,
+			Mix:         mix,
+		})
+		require.NoError(t, err)
+		checkErr := report.Check(s.thresholds)
+		require.NoError(t, report.WriteFile(s.reportPath))
+
+		t.Logf("%d requests in %s, %.1f/s, report written to %s",
+			report.Total.Requests, report.Duration, report.Total.Throughput, s.reportPath)
+		for _, name := range slices.Sorted(maps.Keys(report.Scenarios)) {
+			r := report.Scenarios[name]
+			t.Logf("%s: %d requests, %.2f%% errors, p50 %.0fms, p99 %.0fms, max %.0fms",
+				name, r.Requests, 100*r.ErrorRate, r.Latency.P50, r.Latency.P99, r.Latency.Max)
+		}
+		require.NoError(t, checkErr)
+	})
+}
+
+// scenarios builds the scenarios of the mix and waits until each of them
+// succeeds once, so that the run does not measure the instance starting up.
+func scenarios(ctx context.Context, t *testing.T, apiBaseURL string, key []byte, weights map[string]int) []load.Weighted {
+	t.Helper()
+
+	var mix []load.Weighted
+	for _, name := range slices.Sorted(maps.Keys(weights)) {
+		if weights[name] == 0 {
+			continue
+		}
+		var scenario load.Scenario
+		switch name {
+		case load.ScenarioToken:
+			var err error
+			scenario, err = load.TokenIssuance(apiBaseURL, key)
+			require.NoError(t, err)
+		case load.ScenarioIntrospection:
+			scenario = introspection(ctx, t, apiBaseURL, key)
+		case load.ScenarioLogin:
+			scenario = load.LoginPage(apiBaseURL)
+		default:
+			t.Fatalf("%s: unknown scenario %q (want %q, %q or %q)", mixEnvVar, name,
+				load.ScenarioToken, load.ScenarioIntrospection, load.ScenarioLogin)
+		}
+
+		require.EventuallyWithT(t, func(collect *assert.CollectT) {
+			assert.NoError(collect, scenario.Do(ctx))
+		}, 2*time.Minute, time.Second, "scenario %s did not succeed for two minutes", name)
+		mix = append(mix, load.Weighted{Scenario: scenario, Weight: weights[name]})
+	}
+	return mix
+}
+
+// introspection creates a project with an API application and returns the
+// introspection scenario for a machine user token whose audience includes
+// the project.
+func introspection(ctx context.Context, t *testing.T, apiBaseURL string, key []byte) load.Scenario {
+	t.Helper()
+
+	client, err := api.New(apiBaseURL, key)
+	require.NoError(t, err)
+
+	var projectID string
+	var app *api.AppCredentials
+	require.EventuallyWithT(t, func(collect *assert.CollectT) {
+		var err error
+		if projectID == "" {
+			projectID, err = client.AddProject(ctx, "load")
+			if !assert.NoError(collect, err) {
+				return
+			}
+		}
+		app, err = client.AddAPIApp(ctx, projectID, "load", "API_AUTH_METHOD_TYPE_BASIC")
+		assert.NoError(collect, err)
+	}, 2*time.Minute, time.Second, "creating the API app failed for two minutes")
+
+	audience, err := api.New(apiBaseURL, key, fmt.Sprintf("urn:zitadel:iam:org:project:id:%s:aud", projectID))
+	require.NoError(t, err)
+	token, err := audience.Token(ctx)
+	require.NoError(t, err)
+	return load.Introspection(apiBaseURL, app.ClientID, app.ClientSecret, token)
+}
+
+// installZitadel installs the chart from the working tree with an ingress
+// for domain and a machine user whose key is written to a secret, layering
+// valuesFile, if set, on top.
+func installZitadel(t *testing.T, k *k8s.KubectlOptions, domain, valuesFile string) {
+	t.Helper()
+
+	_, filename, _, _ := runtime.Caller(0)
+	chartPath := filepath.Join(filepath.Dir(filename), "..", "..", "charts", "zitadel")
+
+	port, err := strconv.Atoi(httpsPort)
+	require.NoError(t, err)
+	vals := &values.Values{
+		Ingress: &values.Ingress{Enabled: ptr.To(true)},
+		Zitadel: &values.Zitadel{
+			Masterkey: ptr.To(testcluster.Masterkey),
+			ConfigmapConfig: map[string]any{
+				"ExternalDomain": domain,
+				"ExternalPort":   port,
+				"TLS":            map[string]any{"Enabled": false},
+				"Database": map[string]any{
+					"Postgres": testcluster.PostgresConfig("postgres", "postgres", "disable"),
+				},
+				"FirstInstance": testcluster.MachineUserConfig("Load", machineUsername),
+			},
+		},
+		Login: &values.Login{
+			Ingress: &values.LoginIngress{Enabled: ptr.To(true)},
+		},
+	}
+
+	valuesFiles := []string{vals.WriteFile(t)}
+	if valuesFile != "" {
+		valuesFiles = append(valuesFiles, valuesFile)
+	}
+	options := &helm.Options{
+		KubectlOptions: k,
+		ValuesFiles:    valuesFiles,
+		ExtraArgs:      map[string][]string{"install": {"--wait", "--timeout", "10m"}},
+	}
+	helm.Install(t, options, chartPath, zitadelRelease)
+}
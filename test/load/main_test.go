@@ -0,0 +1,57 @@
+package load_test
+
+import (
+	"context"
+	"log"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/zitadel/zitadel-charts/test/internal/testcluster"
+)
+
+const k3sStartupTimeout = 5 * time.Minute
+
+// loadEnvVar enables the load tests. They deploy ZITADEL and keep it busy
+// for minutes, so `go test ./...` only runs the package's unit tests unless
+// it is set.
+const loadEnvVar = "ZITADEL_TEST_LOAD"
+
+// httpsPort holds the dynamically mapped host port for the Traefik HTTPS
+// NodePort (30443). It is empty unless loadEnvVar is set.
+var httpsPort string
+
+func TestMain(m *testing.M) {
+	os.Exit(run(m))
+}
+
+// run starts a K3s cluster if the load tests are enabled and then executes
+// the test suite. It returns the exit code from m.Run.
+func run(m *testing.M) int {
+	if os.Getenv(loadEnvVar) == "" {
+		return m.Run()
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), k3sStartupTimeout)
+	defer cancel()
+
+	cluster, err := testcluster.Start(ctx)
+	if err != nil {
+		log.Printf("failed to start K3s cluster: %v", err)
+		return 1
+	}
+	defer cluster.Cleanup()
+
+	httpsPort = cluster.HTTPSPort
+
+	return m.Run()
+}
+
+// requireLoad skips the test unless loadEnvVar is set.
+func requireLoad(t *testing.T) {
+	t.Helper()
+
+	if httpsPort == "" {
+		t.Skipf("%s is not set", loadEnvVar)
+	}
+}
@@ -0,0 +1,75 @@
+package load
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseMix(t *testing.T) {
+	mix, err := ParseMix("token=5, introspection=3,login=0")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int{"token": 5, "introspection": 3, "login": 0}, mix)
+
+	for _, invalid := range []string{"token", "token=x", "token=-1"} {
+		_, err := ParseMix(invalid)
+		assert.Error(t, err, invalid)
+	}
+}
+
+func TestRunReportsAndChecksThresholds(t *testing.T) {
+	var failing atomic.Int64
+	mix := []Weighted{
+		{Scenario: Scenario{Name: "fast", Do: func(context.Context) error {
+			time.Sleep(time.Microsecond)
+			return nil
+		}}, Weight: 3},
+		{Scenario: Scenario{Name: "flaky", Do: func(context.Context) error {
+			time.Sleep(5 * time.Millisecond)
+			if failing.Add(1)%2 == 0 {
+				return errors.New("boom")
+			}
+			return nil
+		}}, Weight: 1},
+		{Scenario: Scenario{Name: "disabled", Do: func(context.Context) error { return nil }}, Weight: 0},
+	}
+
+	report, err := Run(context.Background(), Config{Duration: 200 * time.Millisecond, Concurrency: 4, Mix: mix})
+	require.NoError(t, err)
+
+	require.Contains(t, report.Scenarios, "fast")
+	require.Contains(t, report.Scenarios, "flaky")
+	assert.NotContains(t, report.Scenarios, "disabled")
+	flaky := report.Scenarios["flaky"]
+	assert.InDelta(t, 0.5, flaky.ErrorRate, 0.1)
+	assert.Equal(t, map[string]int64{"boom": flaky.Errors}, flaky.ErrorSamples)
+	assert.GreaterOrEqual(t, flaky.Latency.P50, 5.0)
+	assert.Equal(t, report.Scenarios["fast"].Requests+flaky.Requests, report.Total.Requests)
+
+	require.NoError(t, report.Check(Thresholds{MaxErrorRate: 1}))
+	err = report.Check(Thresholds{MaxErrorRate: 0.01, MaxP99: time.Nanosecond})
+	require.Error(t, err)
+	assert.Len(t, report.Violations, 3, "flaky crosses both thresholds, fast the latency one: %v", report.Violations)
+
+	path := filepath.Join(t.TempDir(), "reports", "load.json")
+	require.NoError(t, report.WriteFile(path))
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	var decoded Report
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	assert.Equal(t, report.Violations, decoded.Violations)
+	assert.Equal(t, report.Total.Requests, decoded.Total.Requests)
+}
+
+func TestRunRejectsEmptyMix(t *testing.T) {
+	_, err := Run(context.Background(), Config{Duration: time.Second, Concurrency: 1})
+	require.Error(t, err)
+}
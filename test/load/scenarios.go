@@ -0,0 +1,146 @@
+package load
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/zitadel/oidc/pkg/oidc"
+
+	"github.com/zitadel/zitadel-charts/test/internal/api"
+)
+
+// requestTimeout bounds a single request, so that a hanging connection
+// shows up as an error instead of stalling a worker.
+const requestTimeout = 30 * time.Second
+
+// client skips TLS certificate verification, like test/internal/http,
+// and keeps enough idle connections for every worker to reuse its own.
+var client = &http.Client{
+	Transport: &http.Transport{
+		TLSClientConfig:     &tls.Config{InsecureSkipVerify: true},
+		MaxIdleConns:        256,
+		MaxIdleConnsPerHost: 256,
+	},
+	Timeout: requestTimeout,
+}
+
+// Scenario names, as used in a mix.
+const (
+	ScenarioToken         = "token"
+	ScenarioIntrospection = "introspection"
+	ScenarioLogin         = "login"
+)
+
+// TokenIssuance returns a scenario that signs a JWT profile assertion with
+// the machine user's key and exchanges it for an access token, which is the
+// token endpoint's most expensive path: it verifies the signature against the
+// stored public key and writes a token. The exchange is bounded by
+// requestTimeout rather than by the context.
+func TokenIssuance(apiBaseURL string, key []byte) (Scenario, error) {
+	tokens, err := api.NewTokenSource(client, apiBaseURL, key, oidc.ScopeOpenID)
+	if err != nil {
+		return Scenario{}, err
+	}
+	return Scenario{
+		Name: ScenarioToken,
+		Do: func(ctx context.Context) error {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			token, err := tokens.Token()
+			if err != nil {
+				return err
+			}
+			if token.AccessToken == "" {
+				return fmt.Errorf("token response without access token")
+			}
+			return nil
+		},
+	}, nil
+}
+
+// Introspection returns a scenario in which an API application, identified
+// by clientID and clientSecret, introspects token with basic auth, as a
+// resource server does for every request it receives. The token has to be
+// reported active.
+func Introspection(apiBaseURL, clientID, clientSecret, token string) Scenario {
+	// The credentials are form-encoded before they are joined (RFC 6749,
+	// section 2.3.1).
+	credentials := url.QueryEscape(clientID) + ":" + url.QueryEscape(clientSecret)
+	authorization := "Basic " + base64.StdEncoding.EncodeToString([]byte(credentials))
+	return Scenario{
+		Name: ScenarioIntrospection,
+		Do: func(ctx context.Context) error {
+			body, err := post(ctx, apiBaseURL+"/oauth/v2/introspect",
+				map[string]string{"Authorization": authorization}, url.Values{"token": {token}})
+			if err != nil {
+				return err
+			}
+			var resp struct {
+				Active bool `json:"active"`
+			}
+			if err := json.Unmarshal(body, &resp); err != nil {
+				return fmt.Errorf("decoding introspection response: %w", err)
+			}
+			if !resp.Active {
+				return fmt.Errorf("the token was reported inactive")
+			}
+			return nil
+		},
+	}
+}
+
+// LoginPage returns a scenario that fetches the login name page of the Login
+// UI, which the login deployment renders on the server and which calls the
+// ZITADEL API for the instance's branding and settings.
+func LoginPage(apiBaseURL string) Scenario {
+	return Scenario{
+		Name: ScenarioLogin,
+		Do: func(ctx context.Context) error {
+			_, err := do(ctx, http.MethodGet, apiBaseURL+"/ui/v2/login/loginname", nil, nil)
+			return err
+		},
+	}
+}
+
+// post sends form to endpoint and returns the response body.
+func post(ctx context.Context, endpoint string, headers map[string]string, form url.Values) ([]byte, error) {
+	h := map[string]string{"Content-Type": "application/x-www-form-urlencoded"}
+	for k, v := range headers {
+		h[k] = v
+	}
+	return do(ctx, http.MethodPost, endpoint, h, strings.NewReader(form.Encode()))
+}
+
+// do performs a request and returns the response body. A status other than
+// 200 is an error.
+func do(ctx context.Context, method, endpoint string, headers map[string]string, body io.Reader) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, method, endpoint, body)
+	if err != nil {
+		return nil, err
+	}
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("reading response: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s %s: status %d", method, req.URL.Path, resp.StatusCode)
+	}
+	return data, nil
+}
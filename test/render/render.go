@@ -0,0 +1,352 @@
+// Package render renders the ZITADEL Helm chart in-process with the Helm SDK
+// and decodes the resulting manifests into typed Kubernetes objects. It is the
+// offline counterpart to a live `helm upgrade --install`: no cluster, Docker
+// daemon or helm binary is required, so structural assertions on rendered
+// specs run in seconds.
+//
+// Objects are decoded with a scheme that knows the client-go built-in types,
+// the Gateway API v1 types and the Prometheus Operator monitoring v1 types.
+// Anything else (for example manifests injected via extraManifests) is kept
+// as *unstructured.Unstructured.
+package render
+
+import (
+	"fmt"
+	"path/filepath"
+	goruntime "runtime"
+	"sort"
+	"strings"
+	"testing"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	"github.com/stretchr/testify/require"
+	"helm.sh/helm/v3/pkg/chart/loader"
+	"helm.sh/helm/v3/pkg/chartutil"
+	"helm.sh/helm/v3/pkg/engine"
+	"helm.sh/helm/v3/pkg/releaseutil"
+	"helm.sh/helm/v3/pkg/strvals"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/yaml"
+	"k8s.io/client-go/kubernetes/scheme"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+// defaultKubeVersion is the Kubernetes version reported to the chart via
+// .Capabilities.KubeVersion. It matches the K3s image used by testcluster so
+// that rendered output is identical to what a live install would produce.
+const defaultKubeVersion = "v1.34.1"
+
+// Scheme knows every type the chart can render: the client-go built-ins,
+// Gateway API v1 and Prometheus Operator monitoring v1.
+var Scheme = runtime.NewScheme()
+
+var decoder runtime.Decoder
+
+func init() {
+	utilruntime.Must(scheme.AddToScheme(Scheme))
+	utilruntime.Must(gatewayv1.Install(Scheme))
+	utilruntime.Must(monitoringv1.AddToScheme(Scheme))
+	decoder = serializer.NewCodecFactory(Scheme).UniversalDeserializer()
+}
+
+// Option configures a chart render.
+type Option func(*config)
+
+type config struct {
+	chartPath   string
+	releaseName string
+	namespace   string
+	kubeVersion string
+	valuesFiles []string
+	setValues   map[string]string
+	jsonValues  map[string]string
+	validate    bool
+}
+
+// WithChartPath renders the chart at the given path instead of charts/zitadel.
+func WithChartPath(path string) Option {
+	return func(c *config) {
+		c.chartPath = path
+	}
+}
+
+// WithRelease sets the release name exposed to templates as .Release.Name.
+func WithRelease(name string) Option {
+	return func(c *config) {
+		c.releaseName = name
+	}
+}
+
+// WithNamespace sets the namespace exposed to templates as .Release.Namespace.
+func WithNamespace(namespace string) Option {
+	return func(c *config) {
+		c.namespace = namespace
+	}
+}
+
+// WithKubeVersion overrides the Kubernetes version reported via .Capabilities.
+func WithKubeVersion(version string) Option {
+	return func(c *config) {
+		c.kubeVersion = version
+	}
+}
+
+// WithValuesFiles merges the given values files, in order, before any --set
+// style values are applied. This mirrors `helm template -f`.
+func WithValuesFiles(paths ...string) Option {
+	return func(c *config) {
+		c.valuesFiles = append(c.valuesFiles, paths...)
+	}
+}
+
+// WithSetValues applies Helm --set style values. Keys use the same path syntax
+// as terratest's helm.Options.SetValues (e.g. "gateway.httpRoute.parentRefs[0].name").
+func WithSetValues(values map[string]string) Option {
+	return func(c *config) {
+		if c.setValues == nil {
+			c.setValues = make(map[string]string, len(values))
+		}
+		for k, v := range values {
+			c.setValues[k] = v
+		}
+	}
+}
+
+// WithSetJSONValues applies Helm --set-json style values. They are applied
+// after WithSetValues, matching the precedence of `helm template`.
+func WithSetJSONValues(values map[string]string) Option {
+	return func(c *config) {
+		if c.jsonValues == nil {
+			c.jsonValues = make(map[string]string, len(values))
+		}
+		for k, v := range values {
+			c.jsonValues[k] = v
+		}
+	}
+}
+
+// WithSchemaValidation validates the merged values against values.schema.json
+// the way `helm template` does. The schema bundles the Kubernetes definitions
+// it references, so validation works offline. It is off by default so that
+// tests can render values the schema rejects.
+func WithSchemaValidation() Option {
+	return func(c *config) {
+		c.validate = true
+	}
+}
+
+// Manifest is the decoded output of a chart render. Objects are ordered the
+// way Helm would install them: hooks first, by weight, then regular
+// resources in Helm's install order.
+type Manifest struct {
+	Objects []runtime.Object
+}
+
+// ChartPath returns the absolute path to the ZITADEL Helm chart.
+func ChartPath() string {
+	_, filename, _, _ := goruntime.Caller(0)
+	return filepath.Join(filepath.Dir(filename), "..", "..", "charts", "zitadel")
+}
+
+// Chart renders the chart and decodes it into typed objects, failing the test
+// on error.
+func Chart(t *testing.T, opts ...Option) *Manifest {
+	t.Helper()
+	m, err := ChartE(opts...)
+	require.NoError(t, err, "failed to render chart")
+	return m
+}
+
+// ChartE renders the chart and decodes it into typed objects.
+func ChartE(opts ...Option) (*Manifest, error) {
+	docs, err := RenderE(opts...)
+	if err != nil {
+		return nil, err
+	}
+	m := &Manifest{}
+	for _, doc := range docs {
+		obj, err := Decode([]byte(doc))
+		if err != nil {
+			return nil, err
+		}
+		if obj != nil {
+			m.Objects = append(m.Objects, obj)
+		}
+	}
+	return m, nil
+}
+
+// RenderE renders the chart and returns one YAML document per Kubernetes
+// object, hooks included, in Helm's install order.
+func RenderE(opts ...Option) ([]string, error) {
+	cfg := &config{
+		chartPath:   ChartPath(),
+		releaseName: "zitadel",
+		namespace:   "default",
+		kubeVersion: defaultKubeVersion,
+	}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
+	chrt, err := loader.Load(cfg.chartPath)
+	if err != nil {
+		return nil, fmt.Errorf("loading chart %s: %w", cfg.chartPath, err)
+	}
+
+	vals := map[string]any{}
+	for _, path := range cfg.valuesFiles {
+		fileVals, err := chartutil.ReadValuesFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("reading values file %s: %w", path, err)
+		}
+		vals = chartutil.MergeTables(fileVals.AsMap(), vals)
+	}
+	for _, k := range sortedKeys(cfg.setValues) {
+		if err := strvals.ParseInto(k+"="+cfg.setValues[k], vals); err != nil {
+			return nil, fmt.Errorf("parsing --set %s: %w", k, err)
+		}
+	}
+	for _, k := range sortedKeys(cfg.jsonValues) {
+		if err := strvals.ParseJSON(k+"="+cfg.jsonValues[k], vals); err != nil {
+			return nil, fmt.Errorf("parsing --set-json %s: %w", k, err)
+		}
+	}
+
+	if err := chartutil.ProcessDependenciesWithMerge(chrt, vals); err != nil {
+		return nil, fmt.Errorf("processing chart dependencies: %w", err)
+	}
+
+	kubeVersion, err := chartutil.ParseKubeVersion(cfg.kubeVersion)
+	if err != nil {
+		return nil, err
+	}
+	caps := chartutil.DefaultCapabilities.Copy()
+	caps.KubeVersion = *kubeVersion
+	if chrt.Metadata.KubeVersion != "" && !chartutil.IsCompatibleRange(chrt.Metadata.KubeVersion, caps.KubeVersion.String()) {
+		return nil, fmt.Errorf("chart requires kubeVersion %s which is incompatible with %s", chrt.Metadata.KubeVersion, caps.KubeVersion.String())
+	}
+
+	renderVals, err := chartutil.ToRenderValuesWithSchemaValidation(chrt, vals, chartutil.ReleaseOptions{
+		Name:      cfg.releaseName,
+		Namespace: cfg.namespace,
+		Revision:  1,
+		IsInstall: true,
+	}, caps, !cfg.validate)
+	if err != nil {
+		return nil, fmt.Errorf("building render values: %w", err)
+	}
+
+	files, err := engine.Render(chrt, renderVals)
+	if err != nil {
+		return nil, fmt.Errorf("rendering templates: %w", err)
+	}
+	for name := range files {
+		if strings.HasSuffix(name, "NOTES.txt") {
+			delete(files, name)
+		}
+	}
+
+	hooks, manifests, err := releaseutil.SortManifests(files, nil, releaseutil.InstallOrder)
+	if err != nil {
+		return nil, fmt.Errorf("sorting manifests: %w", err)
+	}
+
+	var docs []string
+	for _, hook := range hooks {
+		docs = append(docs, hook.Manifest)
+	}
+	for _, manifest := range manifests {
+		docs = append(docs, manifest.Content)
+	}
+	return docs, nil
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// Decode converts a single YAML document into a typed object. Kinds unknown
+// to Scheme are returned as *unstructured.Unstructured. A document that is
+// empty or holds only comments yields a nil object and no error.
+func Decode(doc []byte) (runtime.Object, error) {
+	u := &unstructured.Unstructured{}
+	if err := yaml.Unmarshal(doc, &u.Object); err != nil {
+		return nil, fmt.Errorf("decoding manifest: %w\n%s", err, doc)
+	}
+	if len(u.Object) == 0 {
+		return nil, nil
+	}
+	obj, _, err := decoder.Decode(doc, nil, nil)
+	if err == nil {
+		return obj, nil
+	}
+	if !runtime.IsNotRegisteredError(err) {
+		return nil, fmt.Errorf("decoding manifest: %w\n%s", err, doc)
+	}
+	return u, nil
+}
+
+// Find returns the first object of type T with the given name.
+func Find[T runtime.Object](m *Manifest, name string) (T, bool) {
+	for _, obj := range m.Objects {
+		typed, ok := obj.(T)
+		if !ok {
+			continue
+		}
+		if objectName(obj) == name {
+			return typed, true
+		}
+	}
+	var zero T
+	return zero, false
+}
+
+// Get returns the object of type T with the given name, failing the test if
+// the chart did not render it.
+func Get[T runtime.Object](t *testing.T, m *Manifest, name string) T {
+	t.Helper()
+	obj, ok := Find[T](m, name)
+	require.True(t, ok, "rendered chart has no %T named %q", *new(T), name)
+	return obj
+}
+
+// Names lists "<Kind>/<name>" for every rendered object. It is intended for
+// failure messages.
+func (m *Manifest) Names() []string {
+	names := make([]string, 0, len(m.Objects))
+	for _, obj := range m.Objects {
+		names = append(names, fmt.Sprintf("%s/%s", Kind(obj), objectName(obj)))
+	}
+	return names
+}
+
+func objectName(obj runtime.Object) string {
+	accessor, err := meta.Accessor(obj)
+	if err != nil {
+		return ""
+	}
+	return accessor.GetName()
+}
+
+// Kind returns the Kubernetes kind of a decoded object, falling back to the
+// scheme when TypeMeta has been cleared.
+func Kind(obj runtime.Object) string {
+	if kind := obj.GetObjectKind().GroupVersionKind().Kind; kind != "" {
+		return kind
+	}
+	gvks, _, err := Scheme.ObjectKinds(obj)
+	if err != nil || len(gvks) == 0 {
+		return fmt.Sprintf("%T", obj)
+	}
+	return gvks[0].Kind
+}
@@ -0,0 +1,84 @@
+package render_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/zitadel/zitadel-charts/test/assert"
+	"github.com/zitadel/zitadel-charts/test/render"
+)
+
+// TestChartDecodesTypedObjects renders the chart with the minimum required
+// values and verifies that the output is decoded into typed client-go and
+// Gateway API objects on which the generated assertions run unchanged.
+func TestChartDecodesTypedObjects(t *testing.T) {
+	t.Parallel()
+
+	m := render.Chart(t,
+		render.WithRelease("zitadel-render"),
+		render.WithNamespace("render-test"),
+		render.WithSetValues(map[string]string{
+			"zitadel.masterkey":                    "x123456789012345678901234567891y",
+			"gateway.httpRoute.enabled":            "true",
+			"gateway.httpRoute.parentRefs[0].name": "my-gateway",
+		}),
+	)
+
+	deployment := render.Get[*appsv1.Deployment](t, m, "zitadel-render")
+	assert.AssertPartial(t, deployment, assert.DeploymentAssertion{
+		Spec: assert.DeploymentSpecAssertion{
+			Selector: assert.LabelSelectorAssertion{
+				MatchLabels: assert.Some(map[string]string{
+					"app.kubernetes.io/name":      "zitadel",
+					"app.kubernetes.io/component": "start",
+				}),
+			},
+		},
+	})
+
+	route := render.Get[*gatewayv1.HTTPRoute](t, m, "zitadel-render")
+	require.Equal(t, gatewayv1.ObjectName("my-gateway"), route.Spec.ParentRefs[0].Name)
+
+	_, ok := render.Find[*corev1.Secret](m, "zitadel-render-masterkey")
+	require.True(t, ok, "expected masterkey secret in %v", m.Names())
+}
+
+// TestChartRejectsInvalidValues verifies that template failures surface as
+// errors instead of producing a partial manifest.
+func TestChartRejectsInvalidValues(t *testing.T) {
+	t.Parallel()
+
+	_, err := render.ChartE()
+	require.ErrorContains(t, err, "masterkey")
+}
+
+// TestSchemaValidationChecksKubernetesDefinitions verifies that values are
+// validated against the Kubernetes definitions bundled into the schema, so
+// that WithSchemaValidation needs no network access.
+func TestSchemaValidationChecksKubernetesDefinitions(t *testing.T) {
+	t.Parallel()
+
+	masterkey := map[string]string{"zitadel.masterkey": "x123456789012345678901234567891y"}
+	_, err := render.ChartE(
+		render.WithSchemaValidation(),
+		render.WithSetValues(masterkey),
+		render.WithSetJSONValues(map[string]string{
+			"extraContainers": `[{"name": "sidecar", "image": "busybox"}]`,
+			"resources":       `{"limits": {"memory": "512Mi", "cpu": 1}}`,
+		}),
+	)
+	require.NoError(t, err)
+
+	_, err = render.ChartE(
+		render.WithSchemaValidation(),
+		render.WithSetValues(masterkey),
+		render.WithSetJSONValues(map[string]string{
+			"extraContainers": `[{"image": "busybox"}]`,
+		}),
+	)
+	require.ErrorContains(t, err, "at '/extraContainers/0': missing property 'name'")
+}
@@ -29,12 +29,10 @@ func TestSchemaInSync(t *testing.T) {
 	require.NoError(t, err)
 
 	generatedFile := filepath.Join(t.TempDir(), "values.schema.json")
-	cmd := exec.Command("helm", "schema",
-		"-f", valuesFile,
-		"-o", generatedFile,
-		"--draft", "2020",
-		"--use-helm-docs",
-		"--k8s-schema-version", "v1.30.0")
+	cmd := exec.Command("go", "run", "./internal/gen/schemagen",
+		"-values", valuesFile,
+		"-out", generatedFile)
+	cmd.Dir = filepath.Join(filepath.Dir(file), "..")
 	output, err := cmd.CombinedOutput()
 	require.NoError(t, err, string(output))
 
@@ -49,8 +47,8 @@ func TestSchemaInSync(t *testing.T) {
 // This prevents generic "type": "object" or "type": "array" definitions that
 // lack structure. Primitive types and arrays of primitives are allowed, as
 // are map[string]string types identified by their description annotation.
-// Complex types must either define nested properties or reference external
-// Kubernetes schemas via $ref. Some paths like configmapConfig are ignored
+// Complex types must either define nested properties or reference a
+// Kubernetes definition via $ref. Some paths like configmapConfig are ignored
 // as they are intentionally free-form.
 func TestSchemaFullyTyped(t *testing.T) {
 	t.Parallel()
@@ -66,15 +64,9 @@ func TestSchemaFullyTyped(t *testing.T) {
 	require.NoError(t, json.Unmarshal(data, &schema))
 
 	ignored := map[string]bool{
-		"/zitadel/configmapConfig":                   true,
-		"/zitadel/secretConfig":                      true,
-		"/extraManifests":                            true,
-		"/metrics/serviceMonitor/tlsConfig":              true,
-		"/metrics/serviceMonitor/relabellings":           true,
-		"/metrics/serviceMonitor/metricRelabellings":     true,
-		"/login/metrics/serviceMonitor/tlsConfig":        true,
-		"/login/metrics/serviceMonitor/relabellings":     true,
-		"/login/metrics/serviceMonitor/metricRelabellings": true,
+		"/zitadel/configmapConfig": true,
+		"/zitadel/secretConfig":    true,
+		"/extraManifests":          true,
 	}
 
 	properties := schema["properties"].(map[string]any)
@@ -169,3 +161,47 @@ func TestSchemaFullyTyped(t *testing.T) {
 		})
 	}
 }
+
+// TestSchemaRefsResolve ensures every $ref points to a definition bundled in
+// the schema's $defs, so that validating values needs no network access.
+func TestSchemaRefsResolve(t *testing.T) {
+	t.Parallel()
+
+	_, file, _, ok := runtime.Caller(0)
+	require.True(t, ok, "runtime.Caller(0) failed; cannot determine test file path")
+
+	schemaFile := filepath.Join(filepath.Dir(file), "..", "charts", "zitadel", "values.schema.json")
+	data, err := os.ReadFile(schemaFile)
+	require.NoError(t, err)
+
+	var schema map[string]any
+	require.NoError(t, json.Unmarshal(data, &schema))
+	defs, _ := schema["$defs"].(map[string]any)
+
+	var refs []string
+	var walk func(v any)
+	walk = func(v any) {
+		switch v := v.(type) {
+		case map[string]any:
+			if ref, ok := v["$ref"].(string); ok {
+				refs = append(refs, ref)
+			}
+			for _, child := range v {
+				walk(child)
+			}
+		case []any:
+			for _, child := range v {
+				walk(child)
+			}
+		}
+	}
+	walk(schema)
+	require.NotEmpty(t, refs)
+
+	for _, ref := range refs {
+		name, ok := strings.CutPrefix(ref, "#/$defs/")
+		if assert.True(t, ok, "$ref %s is not a bundled definition", ref) {
+			assert.Contains(t, defs, name, "$ref %s does not resolve", ref)
+		}
+	}
+}
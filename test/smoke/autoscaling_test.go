@@ -5,10 +5,12 @@ import (
 
 	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
 
 	"github.com/zitadel/zitadel-charts/test/assert"
 	setup "github.com/zitadel/zitadel-charts/test/smoke/support"
 	"github.com/zitadel/zitadel-charts/test/support"
+	"github.com/zitadel/zitadel-charts/test/values"
 )
 
 //goland:noinspection DuplicatedCode
@@ -17,22 +19,31 @@ func TestAutoscalingMatrix(t *testing.T) {
 
 	testCases := []struct {
 		name              string
-		setValues         map[string]string
+		values            *values.Values
 		zitadel           *assert.HorizontalPodAutoscalerAssertion
 		login             *assert.HorizontalPodAutoscalerAssertion
 		zitadelDeployment *assert.DeploymentAssertion
 		loginDeployment   *assert.DeploymentAssertion
+		// apiDefaults marks cases that assert on fields the API server
+		// fills in, which are absent from the rendered manifest.
+		apiDefaults bool
 	}{
 		{
 			name: "both-enabled-cpu-only",
-			setValues: map[string]string{
-				"zitadel.enabled":               "true",
-				"zitadel.autoscaling.enabled":   "true",
-				"zitadel.autoscaling.targetCPU": "60",
-
-				"login.enabled":               "true",
-				"login.autoscaling.enabled":   "true",
-				"login.autoscaling.targetCPU": "55",
+			values: &values.Values{
+				Login: &values.Login{
+					Autoscaling: &values.LoginAutoscaling{
+						Enabled:   ptr.To(true),
+						TargetCPU: ptr.To(55),
+					},
+					Enabled: ptr.To(true),
+				},
+				Zitadel: &values.Zitadel{
+					Autoscaling: &values.ZitadelAutoscaling{
+						Enabled:   ptr.To(true),
+						TargetCPU: ptr.To(60),
+					},
+				},
 			},
 			zitadel: &assert.HorizontalPodAutoscalerAssertion{
 				Spec: assert.HorizontalPodAutoscalerSpecAssertion{
@@ -81,14 +92,20 @@ func TestAutoscalingMatrix(t *testing.T) {
 		},
 		{
 			name: "zitadel-enabled-mem-only-login-disabled",
-			setValues: map[string]string{
-				"zitadel.enabled":                  "true",
-				"zitadel.autoscaling.enabled":      "true",
-				"zitadel.autoscaling.targetMemory": "70",
-
-				"login.enabled":             "true",
-				"login.autoscaling.enabled": "false",
-				"login.replicaCount":        "2",
+			values: &values.Values{
+				Login: &values.Login{
+					Autoscaling: &values.LoginAutoscaling{
+						Enabled: ptr.To(false),
+					},
+					Enabled:      ptr.To(true),
+					ReplicaCount: ptr.To(2),
+				},
+				Zitadel: &values.Zitadel{
+					Autoscaling: &values.ZitadelAutoscaling{
+						Enabled:      ptr.To(true),
+						TargetMemory: ptr.To(70),
+					},
+				},
 			},
 			zitadel: &assert.HorizontalPodAutoscalerAssertion{
 				Spec: assert.HorizontalPodAutoscalerSpecAssertion{
@@ -119,19 +136,38 @@ func TestAutoscalingMatrix(t *testing.T) {
 			},
 		},
 		{
-			name: "both-enabled-with-annotations-and-behavior",
-			setValues: map[string]string{
-				"zitadel.enabled":                                                   "true",
-				"zitadel.autoscaling.enabled":                                       "true",
-				"zitadel.autoscaling.targetCPU":                                     "60",
-				"zitadel.autoscaling.annotations.team":                              "platform",
-				"zitadel.autoscaling.behavior.scaleDown.stabilizationWindowSeconds": "300",
-
-				"login.enabled":                       "true",
-				"login.autoscaling.enabled":           "true",
-				"login.autoscaling.targetCPU":         "50",
-				"login.autoscaling.annotations.owner": "iam",
-				"login.autoscaling.behavior.scaleDown.stabilizationWindowSeconds": "300",
+			name:        "both-enabled-with-annotations-and-behavior",
+			apiDefaults: true,
+			values: &values.Values{
+				Login: &values.Login{
+					Autoscaling: &values.LoginAutoscaling{
+						Annotations: map[string]string{
+							"owner": "iam",
+						},
+						Behavior: &autoscalingv2.HorizontalPodAutoscalerBehavior{
+							ScaleDown: &autoscalingv2.HPAScalingRules{
+								StabilizationWindowSeconds: ptr.To(int32(300)),
+							},
+						},
+						Enabled:   ptr.To(true),
+						TargetCPU: ptr.To(50),
+					},
+					Enabled: ptr.To(true),
+				},
+				Zitadel: &values.Zitadel{
+					Autoscaling: &values.ZitadelAutoscaling{
+						Annotations: map[string]string{
+							"team": "platform",
+						},
+						Behavior: &autoscalingv2.HorizontalPodAutoscalerBehavior{
+							ScaleDown: &autoscalingv2.HPAScalingRules{
+								StabilizationWindowSeconds: ptr.To(int32(300)),
+							},
+						},
+						Enabled:   ptr.To(true),
+						TargetCPU: ptr.To(60),
+					},
+				},
 			},
 			zitadel: &assert.HorizontalPodAutoscalerAssertion{
 				Spec: assert.HorizontalPodAutoscalerSpecAssertion{
@@ -224,14 +260,20 @@ func TestAutoscalingMatrix(t *testing.T) {
 		},
 		{
 			name: "both-disabled-replicas-set",
-			setValues: map[string]string{
-				"zitadel.enabled":             "true",
-				"zitadel.autoscaling.enabled": "false",
-				"replicaCount":                "2",
-
-				"login.enabled":             "true",
-				"login.autoscaling.enabled": "false",
-				"login.replicaCount":        "2",
+			values: &values.Values{
+				Login: &values.Login{
+					Autoscaling: &values.LoginAutoscaling{
+						Enabled: ptr.To(false),
+					},
+					Enabled:      ptr.To(true),
+					ReplicaCount: ptr.To(2),
+				},
+				ReplicaCount: ptr.To(2),
+				Zitadel: &values.Zitadel{
+					Autoscaling: &values.ZitadelAutoscaling{
+						Enabled: ptr.To(false),
+					},
+				},
 			},
 			zitadelDeployment: &assert.DeploymentAssertion{
 				Spec: assert.DeploymentSpecAssertion{
@@ -250,8 +292,11 @@ func TestAutoscalingMatrix(t *testing.T) {
 		tc := tc
 		t.Run(tc.name, func(t *testing.T) {
 			t.Parallel()
+			if tc.apiDefaults {
+				support.SkipIfRendered(t, "expects the scaleUp behavior defaulted by the API server")
+			}
 			support.WithNamespace(t, func(env *support.Env) {
-				releaseName := setup.InstallZitadel(t, env, tc.name, tc.setValues)
+				releaseName := setup.InstallZitadel(t, env, tc.name, tc.values)
 
 				if tc.zitadel != nil {
 					env.AssertPartial(t, releaseName, *tc.zitadel)
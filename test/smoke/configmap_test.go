@@ -3,24 +3,29 @@ package smoke_test_test
 import (
 	"testing"
 
+	"k8s.io/utils/ptr"
+
 	"github.com/zitadel/zitadel-charts/test/assert"
 	setup "github.com/zitadel/zitadel-charts/test/smoke/support"
 	"github.com/zitadel/zitadel-charts/test/support"
+	"github.com/zitadel/zitadel-charts/test/values"
 )
 
 func TestConfigMapMatrix(t *testing.T) {
 	t.Parallel()
 
 	testCases := []struct {
-		name      string
-		setValues map[string]string
-		zitadel   *assert.ConfigMapAssertion
-		login     *assert.ConfigMapAssertion
+		name    string
+		values  *values.Values
+		zitadel *assert.ConfigMapAssertion
+		login   *assert.ConfigMapAssertion
 	}{
 		{
 			name: "both-enabled-default",
-			setValues: map[string]string{
-				"login.enabled": "true",
+			values: &values.Values{
+				Login: &values.Login{
+					Enabled: ptr.To(true),
+				},
 			},
 			zitadel: &assert.ConfigMapAssertion{
 				ObjectMeta: assert.ObjectMetaAssertion{
@@ -43,10 +48,20 @@ func TestConfigMapMatrix(t *testing.T) {
 		},
 		{
 			name: "both-enabled-with-annotations",
-			setValues: map[string]string{
-				"configMap.annotations.owner":      "platform-team",
-				"login.enabled":                    "true",
-				"login.configMap.annotations.team": "frontend",
+			values: &values.Values{
+				ConfigMap: &values.ConfigMap{
+					Annotations: map[string]string{
+						"owner": "platform-team",
+					},
+				},
+				Login: &values.Login{
+					ConfigMap: &values.LoginConfigMap{
+						Annotations: map[string]string{
+							"team": "frontend",
+						},
+					},
+					Enabled: ptr.To(true),
+				},
 			},
 			zitadel: &assert.ConfigMapAssertion{
 				ObjectMeta: assert.ObjectMetaAssertion{
@@ -71,9 +86,15 @@ func TestConfigMapMatrix(t *testing.T) {
 		},
 		{
 			name: "zitadel-only-login-disabled",
-			setValues: map[string]string{
-				"configMap.annotations.config-version": "v2",
-				"login.enabled":                        "false",
+			values: &values.Values{
+				ConfigMap: &values.ConfigMap{
+					Annotations: map[string]string{
+						"config-version": "v2",
+					},
+				},
+				Login: &values.Login{
+					Enabled: ptr.To(false),
+				},
 			},
 			zitadel: &assert.ConfigMapAssertion{
 				ObjectMeta: assert.ObjectMetaAssertion{
@@ -93,7 +114,7 @@ func TestConfigMapMatrix(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			t.Parallel()
 			support.WithNamespace(t, func(env *support.Env) {
-				releaseName := setup.InstallZitadel(t, env, tc.name, tc.setValues)
+				releaseName := setup.InstallZitadel(t, env, tc.name, tc.values)
 
 				if tc.zitadel != nil {
 					env.AssertPartial(t, releaseName+"-config-yaml", *tc.zitadel)
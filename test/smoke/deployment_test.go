@@ -6,10 +6,12 @@ import (
 	"github.com/onsi/gomega"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/utils/ptr"
 
 	"github.com/zitadel/zitadel-charts/test/assert"
 	setup "github.com/zitadel/zitadel-charts/test/smoke/support"
 	"github.com/zitadel/zitadel-charts/test/support"
+	"github.com/zitadel/zitadel-charts/test/values"
 )
 
 //goland:noinspection ALL
@@ -17,16 +19,20 @@ func TestDeploymentMatrix(t *testing.T) {
 	t.Parallel()
 
 	testCases := []struct {
-		name      string
-		setValues map[string]string
-		zitadel   *assert.DeploymentAssertion
-		login     *assert.DeploymentAssertion
+		name    string
+		values  *values.Values
+		zitadel *assert.DeploymentAssertion
+		login   *assert.DeploymentAssertion
 	}{
 		{
 			name: "defaults",
-			setValues: map[string]string{
-				"login.enabled":         "true",
-				"login.ingress.enabled": "true",
+			values: &values.Values{
+				Login: &values.Login{
+					Enabled: ptr.To(true),
+					Ingress: &values.LoginIngress{
+						Enabled: ptr.To(true),
+					},
+				},
 			},
 			zitadel: &assert.DeploymentAssertion{
 				ObjectMeta: assert.ObjectMetaAssertion{
@@ -63,6 +69,20 @@ func TestDeploymentMatrix(t *testing.T) {
 							Containers: assert.Some([]assert.ContainerAssertion{
 								{
 									Name: assert.Some("zitadel"),
+									Env: assert.ByKey(
+										assert.EnvVarAssertion{
+											Name:  assert.Some("ZITADEL_DATABASE_POSTGRES_AWAITINITIALCONN"),
+											Value: assert.Some("5m"),
+										},
+										assert.EnvVarAssertion{
+											Name: assert.Some("ZITADEL_MASTERKEY"),
+											ValueFrom: assert.EnvVarSourceAssertion{
+												SecretKeyRef: assert.SecretKeySelectorAssertion{
+													Key: assert.Some("masterkey"),
+												},
+											},
+										},
+									),
 									SecurityContext: assert.SecurityContextAssertion{
 										RunAsNonRoot:           assert.SomePtr(true),
 										RunAsUser:              assert.SomePtr(int64(1000)),
@@ -139,12 +159,24 @@ func TestDeploymentMatrix(t *testing.T) {
 		},
 		{
 			name: "with-wait4x-resources",
-			setValues: map[string]string{
-				"login.enabled":                          "true",
-				"tools.wait4x.resources.requests.cpu":    "50m",
-				"tools.wait4x.resources.requests.memory": "32Mi",
-				"tools.wait4x.resources.limits.cpu":      "100m",
-				"tools.wait4x.resources.limits.memory":   "64Mi",
+			values: &values.Values{
+				Login: &values.Login{
+					Enabled: ptr.To(true),
+				},
+				Tools: &values.Tools{
+					Wait4x: &values.ToolsWait4x{
+						Resources: &corev1.ResourceRequirements{
+							Limits: map[corev1.ResourceName]resource.Quantity{
+								"cpu":    resource.MustParse("100m"),
+								"memory": resource.MustParse("64Mi"),
+							},
+							Requests: map[corev1.ResourceName]resource.Quantity{
+								"cpu":    resource.MustParse("50m"),
+								"memory": resource.MustParse("32Mi"),
+							},
+						},
+					},
+				},
 			},
 			login: &assert.DeploymentAssertion{
 				Spec: assert.DeploymentSpecAssertion{
@@ -172,9 +204,13 @@ func TestDeploymentMatrix(t *testing.T) {
 		},
 		{
 			name: "login-metrics-annotations",
-			setValues: map[string]string{
-				"login.enabled":         "true",
-				"login.metrics.enabled": "true",
+			values: &values.Values{
+				Login: &values.Login{
+					Enabled: ptr.To(true),
+					Metrics: &values.LoginMetrics{
+						Enabled: ptr.To(true),
+					},
+				},
 			},
 			login: &assert.DeploymentAssertion{
 				Spec: assert.DeploymentSpecAssertion{
@@ -192,8 +228,10 @@ func TestDeploymentMatrix(t *testing.T) {
 		},
 		{
 			name: "login-metrics-disabled-no-annotations",
-			setValues: map[string]string{
-				"login.enabled": "true",
+			values: &values.Values{
+				Login: &values.Login{
+					Enabled: ptr.To(true),
+				},
 			},
 			login: &assert.DeploymentAssertion{
 				Spec: assert.DeploymentSpecAssertion{
@@ -209,32 +247,127 @@ func TestDeploymentMatrix(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "probe-host-header",
+			values: &values.Values{
+				Zitadel: &values.Zitadel{
+					ConfigmapConfig: map[string]any{
+						"ExternalDomain": "probe.example.com",
+					},
+				},
+			},
+			zitadel: assert.Ptr(assert.Deployment().
+				Container("zitadel").
+				LivenessProbe().ProbeHandler().HTTPGet().
+				HTTPHeader("Host").Value("probe.example.com").
+				Build()),
+		},
+		{
+			name: "optional-blocks-disabled",
+			values: &values.Values{
+				StartupProbe: &values.StartupProbe{
+					Enabled: ptr.To(false),
+				},
+			},
+			zitadel: &assert.DeploymentAssertion{
+				Spec: assert.DeploymentSpecAssertion{
+					Template: assert.PodTemplateSpecAssertion{
+						Spec: assert.PodSpecAssertion{
+							Volumes: assert.NotContains(assert.VolumeAssertion{Name: assert.Some("tls")}),
+							Containers: assert.ByKey(assert.ContainerAssertion{
+								Name:         assert.Some("zitadel"),
+								StartupProbe: assert.Unset[assert.CoreProbeAssertion](),
+								VolumeMounts: assert.NotContains(assert.VolumeMountAssertion{Name: assert.Some("tls")}),
+								Env: assert.NotContains(
+									assert.EnvVarAssertion{Name: assert.Some("ZITADEL_TLS_CERTPATH")},
+									assert.EnvVarAssertion{Name: assert.Some("ZITADEL_TLS_KEYPATH")},
+								),
+							}),
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "optional-blocks-enabled",
+			values: &values.Values{
+				StartupProbe: &values.StartupProbe{
+					Enabled: ptr.To(true),
+				},
+				Zitadel: &values.Zitadel{
+					SelfSignedCert: &values.ZitadelSelfSignedCert{
+						Enabled: ptr.To(true),
+					},
+				},
+			},
+			zitadel: &assert.DeploymentAssertion{
+				Spec: assert.DeploymentSpecAssertion{
+					Template: assert.PodTemplateSpecAssertion{
+						Spec: assert.PodSpecAssertion{
+							Volumes: assert.ContainsElements(assert.VolumeAssertion{Name: assert.Some("tls")}),
+							Containers: assert.ByKey(assert.ContainerAssertion{
+								Name: assert.Some("zitadel"),
+								StartupProbe: assert.CoreProbeAssertion{
+									PeriodSeconds: assert.Some(int32(1)),
+								},
+								VolumeMounts: assert.ContainsElements(assert.VolumeMountAssertion{Name: assert.Some("tls")}),
+							}),
+						},
+					},
+				},
+			},
+		},
 		{
 			name: "component-overrides",
-			setValues: map[string]string{
-				"login.enabled":         "true",
-				"login.ingress.enabled": "true",
-
-				"zitadel.podSecurityContext.runAsNonRoot":          "true",
-				"zitadel.podSecurityContext.runAsUser":             "2000",
-				"zitadel.podSecurityContext.fsGroup":               "2000",
-				"zitadel.podSecurityContext.seccompProfile.type":   "RuntimeDefault",
-				"zitadel.securityContext.runAsNonRoot":             "true",
-				"zitadel.securityContext.runAsUser":                "2000",
-				"zitadel.securityContext.readOnlyRootFilesystem":   "true",
-				"zitadel.securityContext.privileged":               "false",
-				"zitadel.securityContext.allowPrivilegeEscalation": "false",
-				"zitadel.securityContext.capabilities.drop[0]":     "ALL",
-				"login.podSecurityContext.runAsNonRoot":            "true",
-				"login.podSecurityContext.runAsUser":               "3000",
-				"login.podSecurityContext.fsGroup":                 "3000",
-				"login.podSecurityContext.seccompProfile.type":     "RuntimeDefault",
-				"login.securityContext.runAsNonRoot":               "true",
-				"login.securityContext.runAsUser":                  "3000",
-				"login.securityContext.readOnlyRootFilesystem":     "true",
-				"login.securityContext.privileged":                 "false",
-				"login.securityContext.allowPrivilegeEscalation":   "false",
-				"login.securityContext.capabilities.drop[0]":       "NET_RAW",
+			values: &values.Values{
+				Login: &values.Login{
+					Enabled: ptr.To(true),
+					Ingress: &values.LoginIngress{
+						Enabled: ptr.To(true),
+					},
+					PodSecurityContext: &corev1.PodSecurityContext{
+						RunAsUser:    ptr.To(int64(3000)),
+						RunAsNonRoot: ptr.To(true),
+						FSGroup:      ptr.To(int64(3000)),
+						SeccompProfile: &corev1.SeccompProfile{
+							Type: "RuntimeDefault",
+						},
+					},
+					SecurityContext: &corev1.SecurityContext{
+						Capabilities: &corev1.Capabilities{
+							Drop: []corev1.Capability{
+								"NET_RAW",
+							},
+						},
+						Privileged:               ptr.To(false),
+						RunAsUser:                ptr.To(int64(3000)),
+						RunAsNonRoot:             ptr.To(true),
+						ReadOnlyRootFilesystem:   ptr.To(true),
+						AllowPrivilegeEscalation: ptr.To(false),
+					},
+				},
+				Zitadel: &values.Zitadel{
+					PodSecurityContext: &corev1.PodSecurityContext{
+						RunAsUser:    ptr.To(int64(2000)),
+						RunAsNonRoot: ptr.To(true),
+						FSGroup:      ptr.To(int64(2000)),
+						SeccompProfile: &corev1.SeccompProfile{
+							Type: "RuntimeDefault",
+						},
+					},
+					SecurityContext: &corev1.SecurityContext{
+						Capabilities: &corev1.Capabilities{
+							Drop: []corev1.Capability{
+								"ALL",
+							},
+						},
+						Privileged:               ptr.To(false),
+						RunAsUser:                ptr.To(int64(2000)),
+						RunAsNonRoot:             ptr.To(true),
+						ReadOnlyRootFilesystem:   ptr.To(true),
+						AllowPrivilegeEscalation: ptr.To(false),
+					},
+				},
 			},
 			zitadel: &assert.DeploymentAssertion{
 				Spec: assert.DeploymentSpecAssertion{
@@ -321,13 +454,13 @@ func TestDeploymentMatrix(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			t.Parallel()
 			support.WithNamespace(t, func(env *support.Env) {
-				releaseName := setup.InstallZitadel(t, env, tc.name, tc.setValues)
+				releaseName := setup.InstallZitadel(t, env, tc.name, tc.values)
 
 				if tc.zitadel != nil {
-					env.AssertPartial(t, releaseName, *tc.zitadel)
+					env.AssertPartialAll(t, releaseName, *tc.zitadel)
 				}
 				if tc.login != nil {
-					env.AssertPartial(t, releaseName+"-login", *tc.login)
+					env.AssertPartialAll(t, releaseName+"-login", *tc.login)
 				}
 			})
 		})
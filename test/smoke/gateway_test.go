@@ -3,14 +3,16 @@ package smoke_test_test
 import (
 	"testing"
 
-	"github.com/gruntwork-io/terratest/modules/helm"
 	"github.com/onsi/gomega"
 	"github.com/stretchr/testify/require"
+	"k8s.io/utils/ptr"
 	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
 
 	"github.com/zitadel/zitadel-charts/test/assert"
+	"github.com/zitadel/zitadel-charts/test/render"
 	setup "github.com/zitadel/zitadel-charts/test/smoke/support"
 	"github.com/zitadel/zitadel-charts/test/support"
+	"github.com/zitadel/zitadel-charts/test/values"
 )
 
 //goland:noinspection ALL
@@ -18,21 +20,43 @@ func TestGatewayHTTPRouteMatrix(t *testing.T) {
 	t.Parallel()
 
 	testCases := []struct {
-		name      string
-		setValues map[string]string
-		zitadel   *assert.HTTPRouteAssertion
-		login     *assert.HTTPRouteAssertion
+		name    string
+		values  *values.Values
+		zitadel *assert.HTTPRouteAssertion
+		login   *assert.HTTPRouteAssertion
 	}{
 		{
 			name: "labels",
-			setValues: map[string]string{
-				"gateway.httpRoute.enabled":                   "true",
-				"gateway.httpRoute.parentRefs[0].name":        "my-gateway",
-				"gateway.httpRoute.hostnames[0]":              "zitadel.example.local",
-				"login.enabled":                               "true",
-				"login.gateway.httpRoute.enabled":              "true",
-				"login.gateway.httpRoute.parentRefs[0].name":  "my-gateway",
-				"login.gateway.httpRoute.hostnames[0]":        "zitadel.example.local",
+			values: &values.Values{
+				Gateway: &values.Gateway{
+					HTTPRoute: &values.GatewayHTTPRoute{
+						Enabled: ptr.To(true),
+						Hostnames: []string{
+							"zitadel.example.local",
+						},
+						ParentRefs: []values.GatewayHTTPRouteParentRef{
+							{
+								Name: ptr.To("my-gateway"),
+							},
+						},
+					},
+				},
+				Login: &values.Login{
+					Enabled: ptr.To(true),
+					Gateway: &values.LoginGateway{
+						HTTPRoute: &values.LoginGatewayHTTPRoute{
+							Enabled: ptr.To(true),
+							Hostnames: []string{
+								"zitadel.example.local",
+							},
+							ParentRefs: []values.LoginGatewayHTTPRouteParentRef{
+								{
+									Name: ptr.To("my-gateway"),
+								},
+							},
+						},
+					},
+				},
 			},
 			zitadel: &assert.HTTPRouteAssertion{
 				ObjectMeta: assert.ObjectMetaAssertion{
@@ -56,10 +80,20 @@ func TestGatewayHTTPRouteMatrix(t *testing.T) {
 		},
 		{
 			name: "custom-labels",
-			setValues: map[string]string{
-				"gateway.httpRoute.enabled":             "true",
-				"gateway.httpRoute.parentRefs[0].name":  "my-gw",
-				"gateway.httpRoute.labels.custom-label": "custom-value",
+			values: &values.Values{
+				Gateway: &values.Gateway{
+					HTTPRoute: &values.GatewayHTTPRoute{
+						Enabled: ptr.To(true),
+						Labels: map[string]string{
+							"custom-label": "custom-value",
+						},
+						ParentRefs: []values.GatewayHTTPRouteParentRef{
+							{
+								Name: ptr.To("my-gw"),
+							},
+						},
+					},
+				},
 			},
 			zitadel: &assert.HTTPRouteAssertion{
 				ObjectMeta: assert.ObjectMetaAssertion{
@@ -72,11 +106,21 @@ func TestGatewayHTTPRouteMatrix(t *testing.T) {
 		},
 		{
 			name: "custom-hosts",
-			setValues: map[string]string{
-				"gateway.httpRoute.enabled":            "true",
-				"gateway.httpRoute.parentRefs[0].name": "my-gw",
-				"gateway.httpRoute.hostnames[0]":       "custom.example.com",
-				"gateway.httpRoute.hostnames[1]":       "other.example.com",
+			values: &values.Values{
+				Gateway: &values.Gateway{
+					HTTPRoute: &values.GatewayHTTPRoute{
+						Enabled: ptr.To(true),
+						Hostnames: []string{
+							"custom.example.com",
+							"other.example.com",
+						},
+						ParentRefs: []values.GatewayHTTPRouteParentRef{
+							{
+								Name: ptr.To("my-gw"),
+							},
+						},
+					},
+				},
 			},
 			zitadel: &assert.HTTPRouteAssertion{
 				Spec: assert.HTTPRouteSpecAssertion{
@@ -89,11 +133,21 @@ func TestGatewayHTTPRouteMatrix(t *testing.T) {
 		},
 		{
 			name: "custom-annotations",
-			setValues: map[string]string{
-				"gateway.httpRoute.enabled":                 "true",
-				"gateway.httpRoute.parentRefs[0].name":      "my-gw",
-				"gateway.httpRoute.annotations.example-foo": "bar",
-				"gateway.httpRoute.annotations.example-baz": "qux",
+			values: &values.Values{
+				Gateway: &values.Gateway{
+					HTTPRoute: &values.GatewayHTTPRoute{
+						Annotations: map[string]string{
+							"example-baz": "qux",
+							"example-foo": "bar",
+						},
+						Enabled: ptr.To(true),
+						ParentRefs: []values.GatewayHTTPRouteParentRef{
+							{
+								Name: ptr.To("my-gw"),
+							},
+						},
+					},
+				},
 			},
 			zitadel: &assert.HTTPRouteAssertion{
 				ObjectMeta: assert.ObjectMetaAssertion{
@@ -106,9 +160,17 @@ func TestGatewayHTTPRouteMatrix(t *testing.T) {
 		},
 		{
 			name: "no-custom-annotations",
-			setValues: map[string]string{
-				"gateway.httpRoute.enabled":            "true",
-				"gateway.httpRoute.parentRefs[0].name": "my-gw",
+			values: &values.Values{
+				Gateway: &values.Gateway{
+					HTTPRoute: &values.GatewayHTTPRoute{
+						Enabled: ptr.To(true),
+						ParentRefs: []values.GatewayHTTPRouteParentRef{
+							{
+								Name: ptr.To("my-gw"),
+							},
+						},
+					},
+				},
 			},
 			zitadel: &assert.HTTPRouteAssertion{
 				ObjectMeta: assert.ObjectMetaAssertion{
@@ -121,12 +183,30 @@ func TestGatewayHTTPRouteMatrix(t *testing.T) {
 		},
 		{
 			name: "default-path",
-			setValues: map[string]string{
-				"gateway.httpRoute.enabled":                   "true",
-				"gateway.httpRoute.parentRefs[0].name":        "my-gw",
-				"login.enabled":                               "true",
-				"login.gateway.httpRoute.enabled":              "true",
-				"login.gateway.httpRoute.parentRefs[0].name":  "my-gw",
+			values: &values.Values{
+				Gateway: &values.Gateway{
+					HTTPRoute: &values.GatewayHTTPRoute{
+						Enabled: ptr.To(true),
+						ParentRefs: []values.GatewayHTTPRouteParentRef{
+							{
+								Name: ptr.To("my-gw"),
+							},
+						},
+					},
+				},
+				Login: &values.Login{
+					Enabled: ptr.To(true),
+					Gateway: &values.LoginGateway{
+						HTTPRoute: &values.LoginGatewayHTTPRoute{
+							Enabled: ptr.To(true),
+							ParentRefs: []values.LoginGatewayHTTPRouteParentRef{
+								{
+									Name: ptr.To("my-gw"),
+								},
+							},
+						},
+					},
+				},
 			},
 			zitadel: &assert.HTTPRouteAssertion{
 				Spec: assert.HTTPRouteSpecAssertion{
@@ -163,12 +243,20 @@ func TestGatewayHTTPRouteMatrix(t *testing.T) {
 		},
 		{
 			name: "parent-refs",
-			setValues: map[string]string{
-				"gateway.httpRoute.enabled":                   "true",
-				"gateway.httpRoute.parentRefs[0].name":        "my-gateway",
-				"gateway.httpRoute.parentRefs[0].namespace":   "gateway-ns",
-				"gateway.httpRoute.parentRefs[0].sectionName": "https",
-				"gateway.httpRoute.parentRefs[0].port":        "443",
+			values: &values.Values{
+				Gateway: &values.Gateway{
+					HTTPRoute: &values.GatewayHTTPRoute{
+						Enabled: ptr.To(true),
+						ParentRefs: []values.GatewayHTTPRouteParentRef{
+							{
+								Name:        ptr.To("my-gateway"),
+								Namespace:   ptr.To("gateway-ns"),
+								Port:        ptr.To(443),
+								SectionName: ptr.To("https"),
+							},
+						},
+					},
+				},
 			},
 			zitadel: &assert.HTTPRouteAssertion{
 				Spec: assert.HTTPRouteSpecAssertion{
@@ -187,12 +275,30 @@ func TestGatewayHTTPRouteMatrix(t *testing.T) {
 		},
 		{
 			name: "filters",
-			setValues: map[string]string{
-				"gateway.httpRoute.enabled":                                       "true",
-				"gateway.httpRoute.parentRefs[0].name":                            "my-gw",
-				"gateway.httpRoute.filters[0].type":                               "RequestHeaderModifier",
-				"gateway.httpRoute.filters[0].requestHeaderModifier.set[0].name":  "X-Custom",
-				"gateway.httpRoute.filters[0].requestHeaderModifier.set[0].value": "test",
+			values: &values.Values{
+				Gateway: &values.Gateway{
+					HTTPRoute: &values.GatewayHTTPRoute{
+						Enabled: ptr.To(true),
+						Filters: []gatewayv1.HTTPRouteFilter{
+							{
+								Type: "RequestHeaderModifier",
+								RequestHeaderModifier: &gatewayv1.HTTPHeaderFilter{
+									Set: []gatewayv1.HTTPHeader{
+										{
+											Name:  "X-Custom",
+											Value: "test",
+										},
+									},
+								},
+							},
+						},
+						ParentRefs: []values.GatewayHTTPRouteParentRef{
+							{
+								Name: ptr.To("my-gw"),
+							},
+						},
+					},
+				},
 			},
 			zitadel: &assert.HTTPRouteAssertion{
 				Spec: assert.HTTPRouteSpecAssertion{
@@ -218,11 +324,21 @@ func TestGatewayHTTPRouteMatrix(t *testing.T) {
 		},
 		{
 			name: "timeouts",
-			setValues: map[string]string{
-				"gateway.httpRoute.enabled":                 "true",
-				"gateway.httpRoute.parentRefs[0].name":      "my-gw",
-				"gateway.httpRoute.timeouts.request":        "30s",
-				"gateway.httpRoute.timeouts.backendRequest": "20s",
+			values: &values.Values{
+				Gateway: &values.Gateway{
+					HTTPRoute: &values.GatewayHTTPRoute{
+						Enabled: ptr.To(true),
+						ParentRefs: []values.GatewayHTTPRouteParentRef{
+							{
+								Name: ptr.To("my-gw"),
+							},
+						},
+						Timeouts: map[string]string{
+							"backendRequest": "20s",
+							"request":        "30s",
+						},
+					},
+				},
 			},
 			zitadel: &assert.HTTPRouteAssertion{
 				Spec: assert.HTTPRouteSpecAssertion{
@@ -244,7 +360,7 @@ func TestGatewayHTTPRouteMatrix(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			t.Parallel()
 			support.WithNamespace(t, func(env *support.Env) {
-				releaseName := setup.InstallZitadel(t, env, tc.name, tc.setValues)
+				releaseName := setup.InstallZitadel(t, env, tc.name, tc.values)
 
 				if tc.zitadel != nil {
 					env.AssertPartial(t, releaseName, *tc.zitadel)
@@ -262,16 +378,26 @@ func TestGatewayGRPCRouteMatrix(t *testing.T) {
 	t.Parallel()
 
 	testCases := []struct {
-		name      string
-		setValues map[string]string
-		zitadel   *assert.GRPCRouteAssertion
+		name    string
+		values  *values.Values
+		zitadel *assert.GRPCRouteAssertion
 	}{
 		{
 			name: "labels",
-			setValues: map[string]string{
-				"gateway.grpcRoute.enabled":            "true",
-				"gateway.grpcRoute.parentRefs[0].name": "my-gateway",
-				"gateway.grpcRoute.hostnames[0]":       "zitadel.example.local",
+			values: &values.Values{
+				Gateway: &values.Gateway{
+					GRPCRoute: &values.GatewayGRPCRoute{
+						Enabled: ptr.To(true),
+						Hostnames: []string{
+							"zitadel.example.local",
+						},
+						ParentRefs: []values.GatewayGRPCRouteParentRef{
+							{
+								Name: ptr.To("my-gateway"),
+							},
+						},
+					},
+				},
 			},
 			zitadel: &assert.GRPCRouteAssertion{
 				ObjectMeta: assert.ObjectMetaAssertion{
@@ -285,12 +411,28 @@ func TestGatewayGRPCRouteMatrix(t *testing.T) {
 		},
 		{
 			name: "matches",
-			setValues: map[string]string{
-				"gateway.grpcRoute.enabled":                   "true",
-				"gateway.grpcRoute.parentRefs[0].name":        "my-gw",
-				"gateway.grpcRoute.matches[0].headers[0].name":  "content-type",
-				"gateway.grpcRoute.matches[0].headers[0].type":  "RegularExpression",
-				"gateway.grpcRoute.matches[0].headers[0].value": "application/grpc.*",
+			values: &values.Values{
+				Gateway: &values.Gateway{
+					GRPCRoute: &values.GatewayGRPCRoute{
+						Enabled: ptr.To(true),
+						Matches: []values.GatewayGRPCRouteMatch{
+							{
+								Headers: []values.GatewayGRPCRouteMatchHeader{
+									{
+										Name:  ptr.To("content-type"),
+										Type:  ptr.To("RegularExpression"),
+										Value: ptr.To("application/grpc.*"),
+									},
+								},
+							},
+						},
+						ParentRefs: []values.GatewayGRPCRouteParentRef{
+							{
+								Name: ptr.To("my-gw"),
+							},
+						},
+					},
+				},
 			},
 			zitadel: &assert.GRPCRouteAssertion{
 				Spec: assert.GRPCRouteSpecAssertion{
@@ -313,12 +455,30 @@ func TestGatewayGRPCRouteMatrix(t *testing.T) {
 		},
 		{
 			name: "filters",
-			setValues: map[string]string{
-				"gateway.grpcRoute.enabled":                                       "true",
-				"gateway.grpcRoute.parentRefs[0].name":                            "my-gw",
-				"gateway.grpcRoute.filters[0].type":                               "RequestHeaderModifier",
-				"gateway.grpcRoute.filters[0].requestHeaderModifier.set[0].name":  "X-Custom",
-				"gateway.grpcRoute.filters[0].requestHeaderModifier.set[0].value": "grpc-test",
+			values: &values.Values{
+				Gateway: &values.Gateway{
+					GRPCRoute: &values.GatewayGRPCRoute{
+						Enabled: ptr.To(true),
+						Filters: []gatewayv1.GRPCRouteFilter{
+							{
+								Type: "RequestHeaderModifier",
+								RequestHeaderModifier: &gatewayv1.HTTPHeaderFilter{
+									Set: []gatewayv1.HTTPHeader{
+										{
+											Name:  "X-Custom",
+											Value: "grpc-test",
+										},
+									},
+								},
+							},
+						},
+						ParentRefs: []values.GatewayGRPCRouteParentRef{
+							{
+								Name: ptr.To("my-gw"),
+							},
+						},
+					},
+				},
 			},
 			zitadel: &assert.GRPCRouteAssertion{
 				Spec: assert.GRPCRouteSpecAssertion{
@@ -349,7 +509,7 @@ func TestGatewayGRPCRouteMatrix(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			t.Parallel()
 			support.WithNamespace(t, func(env *support.Env) {
-				releaseName := setup.InstallZitadel(t, env, tc.name, tc.setValues)
+				releaseName := setup.InstallZitadel(t, env, tc.name, tc.values)
 
 				if tc.zitadel != nil {
 					env.AssertPartial(t, releaseName+"-grpc", *tc.zitadel)
@@ -378,40 +538,38 @@ func TestGatewayHTTPRouteEmptyPathsFails(t *testing.T) {
 	chartPath := setup.ChartPath(t)
 
 	t.Run("zitadel-empty-paths", func(t *testing.T) {
-		options := &helm.Options{
-			SetValues: map[string]string{
+		_, err := render.ChartE(
+			render.WithChartPath(chartPath),
+			render.WithRelease("gateway-empty-paths"),
+			render.WithSetValues(map[string]string{
 				"zitadel.configmapConfig.ExternalDomain": "zitadel.example.local",
 				"zitadel.masterkey":                      "01234567890123456789012345678901",
-				"gateway.httpRoute.enabled":               "true",
-				"gateway.httpRoute.parentRefs[0].name":    "my-gw",
-			},
-			SetJsonValues: map[string]string{
+				"gateway.httpRoute.enabled":              "true",
+				"gateway.httpRoute.parentRefs[0].name":   "my-gw",
+			}),
+			render.WithSetJSONValues(map[string]string{
 				"gateway.httpRoute.paths": "[]",
-			},
-		}
-
-		_, err := helm.RenderTemplateE(t, options, chartPath, "gateway-empty-paths",
-			[]string{"templates/httproute_zitadel.yaml"})
+			}),
+		)
 		require.Error(t, err)
 		require.Contains(t, err.Error(), "gateway.httpRoute.paths must not be empty")
 	})
 
 	t.Run("login-empty-paths", func(t *testing.T) {
-		options := &helm.Options{
-			SetValues: map[string]string{
-				"zitadel.configmapConfig.ExternalDomain":    "zitadel.example.local",
+		_, err := render.ChartE(
+			render.WithChartPath(chartPath),
+			render.WithRelease("gateway-empty-paths"),
+			render.WithSetValues(map[string]string{
+				"zitadel.configmapConfig.ExternalDomain":     "zitadel.example.local",
 				"zitadel.masterkey":                          "01234567890123456789012345678901",
 				"login.enabled":                              "true",
-				"login.gateway.httpRoute.enabled":             "true",
+				"login.gateway.httpRoute.enabled":            "true",
 				"login.gateway.httpRoute.parentRefs[0].name": "my-gw",
-			},
-			SetJsonValues: map[string]string{
+			}),
+			render.WithSetJSONValues(map[string]string{
 				"login.gateway.httpRoute.paths": "[]",
-			},
-		}
-
-		_, err := helm.RenderTemplateE(t, options, chartPath, "gateway-empty-paths",
-			[]string{"templates/httproute_login.yaml"})
+			}),
+		)
 		require.Error(t, err)
 		require.Contains(t, err.Error(), "login.gateway.httpRoute.paths must not be empty")
 	})
@@ -4,9 +4,12 @@ import (
 	"testing"
 
 	"github.com/onsi/gomega"
+	"k8s.io/utils/ptr"
+
 	"github.com/zitadel/zitadel-charts/test/assert"
 	setup "github.com/zitadel/zitadel-charts/test/smoke/support"
 	"github.com/zitadel/zitadel-charts/test/support"
+	"github.com/zitadel/zitadel-charts/test/values"
 )
 
 //goland:noinspection DuplicatedCode
@@ -14,22 +17,44 @@ func TestIngressMatrix(t *testing.T) {
 	t.Parallel()
 
 	testCases := []struct {
-		name      string
-		setValues map[string]string
-		zitadel   *assert.IngressAssertion
-		login     *assert.IngressAssertion
+		name    string
+		values  *values.Values
+		zitadel *assert.IngressAssertion
+		login   *assert.IngressAssertion
 	}{
 		{
 			name: "labels",
-			setValues: map[string]string{
-				"login.enabled":                            "true",
-				"login.ingress.enabled":                    "true",
-				"ingress.hosts[0].host":                    "zitadel.example.local",
-				"ingress.hosts[0].paths[0].path":           "/",
-				"ingress.hosts[0].paths[0].pathType":       "Prefix",
-				"login.ingress.hosts[0].host":              "login.example.local",
-				"login.ingress.hosts[0].paths[0].path":     "/",
-				"login.ingress.hosts[0].paths[0].pathType": "Prefix",
+			values: &values.Values{
+				Ingress: &values.Ingress{
+					Hosts: []values.IngressHost{
+						{
+							Host: ptr.To("zitadel.example.local"),
+							Paths: []values.IngressHostPath{
+								{
+									Path:     ptr.To("/"),
+									PathType: ptr.To("Prefix"),
+								},
+							},
+						},
+					},
+				},
+				Login: &values.Login{
+					Enabled: ptr.To(true),
+					Ingress: &values.LoginIngress{
+						Enabled: ptr.To(true),
+						Hosts: []values.LoginIngressHost{
+							{
+								Host: ptr.To("login.example.local"),
+								Paths: []values.LoginIngressHostPath{
+									{
+										Path:     ptr.To("/"),
+										PathType: ptr.To("Prefix"),
+									},
+								},
+							},
+						},
+					},
+				},
 			},
 			zitadel: &assert.IngressAssertion{
 				ObjectMeta: assert.ObjectMetaAssertion{
@@ -57,11 +82,21 @@ func TestIngressMatrix(t *testing.T) {
 		},
 		{
 			name: "nginx-controller-injects-backend-protocol",
-			setValues: map[string]string{
-				"ingress.controller":                 "nginx",
-				"ingress.hosts[0].host":              "zitadel.example.local",
-				"ingress.hosts[0].paths[0].path":     "/",
-				"ingress.hosts[0].paths[0].pathType": "Prefix",
+			values: &values.Values{
+				Ingress: &values.Ingress{
+					Controller: ptr.To("nginx"),
+					Hosts: []values.IngressHost{
+						{
+							Host: ptr.To("zitadel.example.local"),
+							Paths: []values.IngressHostPath{
+								{
+									Path:     ptr.To("/"),
+									PathType: ptr.To("Prefix"),
+								},
+							},
+						},
+					},
+				},
 			},
 			zitadel: &assert.IngressAssertion{
 				ObjectMeta: assert.ObjectMetaAssertion{
@@ -73,11 +108,21 @@ func TestIngressMatrix(t *testing.T) {
 		},
 		{
 			name: "generic-controller-omits-backend-protocol",
-			setValues: map[string]string{
-				"ingress.controller":                 "generic",
-				"ingress.hosts[0].host":              "zitadel.example.local",
-				"ingress.hosts[0].paths[0].path":     "/",
-				"ingress.hosts[0].paths[0].pathType": "Prefix",
+			values: &values.Values{
+				Ingress: &values.Ingress{
+					Controller: ptr.To("generic"),
+					Hosts: []values.IngressHost{
+						{
+							Host: ptr.To("zitadel.example.local"),
+							Paths: []values.IngressHostPath{
+								{
+									Path:     ptr.To("/"),
+									PathType: ptr.To("Prefix"),
+								},
+							},
+						},
+					},
+				},
 			},
 			zitadel: &assert.IngressAssertion{
 				ObjectMeta: assert.ObjectMetaAssertion{
@@ -94,7 +139,7 @@ func TestIngressMatrix(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			t.Parallel()
 			support.WithNamespace(t, func(env *support.Env) {
-				releaseName := setup.InstallZitadel(t, env, tc.name, tc.setValues)
+				releaseName := setup.InstallZitadel(t, env, tc.name, tc.values)
 
 				if tc.zitadel != nil {
 					env.AssertPartial(t, releaseName, *tc.zitadel)
@@ -4,7 +4,12 @@
 //
 // A K3s cluster is started automatically via testcontainers before any test
 // runs. The cluster is torn down when the suite completes. No external cluster
-// or manual setup is required.
+// or manual setup is required. Set ZITADEL_TEST_CLUSTER=persistent to keep the
+// cluster between runs instead (see testcluster.Start).
+//
+// Setting ZITADEL_TEST_MODE=rendered runs the same cases against the chart
+// rendered in-process instead, without starting a cluster. Cases that depend
+// on objects created at runtime are skipped in that mode.
 package smoke_test_test
 
 import (
@@ -15,6 +20,7 @@ import (
 	"time"
 
 	"github.com/zitadel/zitadel-charts/test/internal/testcluster"
+	"github.com/zitadel/zitadel-charts/test/support"
 )
 
 // k3sStartupTimeout is the maximum time allowed for the K3s container to start
@@ -28,8 +34,18 @@ func TestMain(m *testing.M) {
 
 // run starts a K3s cluster, sets up the KUBECONFIG environment variable,
 // executes the test suite, and tears everything down. It returns the exit
-// code from m.Run for use with os.Exit.
+// code from m.Run for use with os.Exit. In rendered mode the suite runs
+// without a cluster.
 func run(m *testing.M) int {
+	mode, err := support.CurrentModeE()
+	if err != nil {
+		log.Print(err)
+		return 1
+	}
+	if mode == support.ModeRendered {
+		return m.Run()
+	}
+
 	ctx, cancel := context.WithTimeout(context.Background(), k3sStartupTimeout)
 	defer cancel()
 
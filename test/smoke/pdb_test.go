@@ -4,10 +4,12 @@ import (
 	"testing"
 
 	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
 
 	"github.com/zitadel/zitadel-charts/test/assert"
 	setup "github.com/zitadel/zitadel-charts/test/smoke/support"
 	"github.com/zitadel/zitadel-charts/test/support"
+	"github.com/zitadel/zitadel-charts/test/values"
 )
 
 //goland:noinspection DuplicatedCode
@@ -15,20 +17,25 @@ func TestPodDisruptionBudgetMatrix(t *testing.T) {
 	t.Parallel()
 
 	testCases := []struct {
-		name      string
-		setValues map[string]string
-		zitadel   *assert.PodDisruptionBudgetAssertion
-		login     *assert.PodDisruptionBudgetAssertion
+		name    string
+		values  *values.Values
+		zitadel *assert.PodDisruptionBudgetAssertion
+		login   *assert.PodDisruptionBudgetAssertion
 	}{
 		{
 			name: "both-enabled-minAvailable-int",
-			setValues: map[string]string{
-				"pdb.enabled":      "true",
-				"pdb.minAvailable": "2",
-
-				"login.enabled":          "true",
-				"login.pdb.enabled":      "true",
-				"login.pdb.minAvailable": "1",
+			values: &values.Values{
+				Login: &values.Login{
+					Enabled: ptr.To(true),
+					PDB: &values.LoginPDB{
+						Enabled:      ptr.To(true),
+						MinAvailable: ptr.To(intstr.FromInt32(1)),
+					},
+				},
+				PDB: &values.PDB{
+					Enabled:      ptr.To(true),
+					MinAvailable: ptr.To(intstr.FromInt32(2)),
+				},
 			},
 			zitadel: &assert.PodDisruptionBudgetAssertion{
 				Spec: assert.PodDisruptionBudgetSpecAssertion{
@@ -49,13 +56,18 @@ func TestPodDisruptionBudgetMatrix(t *testing.T) {
 		},
 		{
 			name: "both-enabled-minAvailable-percentage",
-			setValues: map[string]string{
-				"pdb.enabled":      "true",
-				"pdb.minAvailable": "50%",
-
-				"login.enabled":          "true",
-				"login.pdb.enabled":      "true",
-				"login.pdb.minAvailable": "75%",
+			values: &values.Values{
+				Login: &values.Login{
+					Enabled: ptr.To(true),
+					PDB: &values.LoginPDB{
+						Enabled:      ptr.To(true),
+						MinAvailable: ptr.To(intstr.FromString("75%")),
+					},
+				},
+				PDB: &values.PDB{
+					Enabled:      ptr.To(true),
+					MinAvailable: ptr.To(intstr.FromString("50%")),
+				},
 			},
 			zitadel: &assert.PodDisruptionBudgetAssertion{
 				Spec: assert.PodDisruptionBudgetSpecAssertion{
@@ -76,13 +88,18 @@ func TestPodDisruptionBudgetMatrix(t *testing.T) {
 		},
 		{
 			name: "both-enabled-maxUnavailable-int",
-			setValues: map[string]string{
-				"pdb.enabled":        "true",
-				"pdb.maxUnavailable": "1",
-
-				"login.enabled":            "true",
-				"login.pdb.enabled":        "true",
-				"login.pdb.maxUnavailable": "2",
+			values: &values.Values{
+				Login: &values.Login{
+					Enabled: ptr.To(true),
+					PDB: &values.LoginPDB{
+						Enabled:        ptr.To(true),
+						MaxUnavailable: ptr.To(intstr.FromInt32(2)),
+					},
+				},
+				PDB: &values.PDB{
+					Enabled:        ptr.To(true),
+					MaxUnavailable: ptr.To(intstr.FromInt32(1)),
+				},
 			},
 			zitadel: &assert.PodDisruptionBudgetAssertion{
 				Spec: assert.PodDisruptionBudgetSpecAssertion{
@@ -103,13 +120,18 @@ func TestPodDisruptionBudgetMatrix(t *testing.T) {
 		},
 		{
 			name: "both-enabled-maxUnavailable-percentage",
-			setValues: map[string]string{
-				"pdb.enabled":        "true",
-				"pdb.maxUnavailable": "25%",
-
-				"login.enabled":            "true",
-				"login.pdb.enabled":        "true",
-				"login.pdb.maxUnavailable": "33%",
+			values: &values.Values{
+				Login: &values.Login{
+					Enabled: ptr.To(true),
+					PDB: &values.LoginPDB{
+						Enabled:        ptr.To(true),
+						MaxUnavailable: ptr.To(intstr.FromString("33%")),
+					},
+				},
+				PDB: &values.PDB{
+					Enabled:        ptr.To(true),
+					MaxUnavailable: ptr.To(intstr.FromString("25%")),
+				},
 			},
 			zitadel: &assert.PodDisruptionBudgetAssertion{
 				Spec: assert.PodDisruptionBudgetSpecAssertion{
@@ -130,12 +152,17 @@ func TestPodDisruptionBudgetMatrix(t *testing.T) {
 		},
 		{
 			name: "zitadel-enabled-login-disabled",
-			setValues: map[string]string{
-				"pdb.enabled":      "true",
-				"pdb.minAvailable": "1",
-
-				"login.enabled":     "true",
-				"login.pdb.enabled": "false",
+			values: &values.Values{
+				Login: &values.Login{
+					Enabled: ptr.To(true),
+					PDB: &values.LoginPDB{
+						Enabled: ptr.To(false),
+					},
+				},
+				PDB: &values.PDB{
+					Enabled:      ptr.To(true),
+					MinAvailable: ptr.To(intstr.FromInt32(1)),
+				},
 			},
 			zitadel: &assert.PodDisruptionBudgetAssertion{
 				Spec: assert.PodDisruptionBudgetSpecAssertion{
@@ -148,16 +175,25 @@ func TestPodDisruptionBudgetMatrix(t *testing.T) {
 		},
 		{
 			name: "both-enabled-with-annotations",
-			setValues: map[string]string{
-				"pdb.enabled":           "true",
-				"pdb.minAvailable":      "1",
-				"pdb.annotations.team":  "platform",
-				"pdb.annotations.owner": "sre",
-
-				"login.enabled":              "true",
-				"login.pdb.enabled":          "true",
-				"login.pdb.minAvailable":     "1",
-				"login.pdb.annotations.team": "frontend",
+			values: &values.Values{
+				Login: &values.Login{
+					Enabled: ptr.To(true),
+					PDB: &values.LoginPDB{
+						Annotations: map[string]string{
+							"team": "frontend",
+						},
+						Enabled:      ptr.To(true),
+						MinAvailable: ptr.To(intstr.FromInt32(1)),
+					},
+				},
+				PDB: &values.PDB{
+					Annotations: map[string]string{
+						"owner": "sre",
+						"team":  "platform",
+					},
+					Enabled:      ptr.To(true),
+					MinAvailable: ptr.To(intstr.FromInt32(1)),
+				},
 			},
 			zitadel: &assert.PodDisruptionBudgetAssertion{
 				ObjectMeta: assert.ObjectMetaAssertion{
@@ -189,11 +225,16 @@ func TestPodDisruptionBudgetMatrix(t *testing.T) {
 		},
 		{
 			name: "both-disabled",
-			setValues: map[string]string{
-				"pdb.enabled": "false",
-
-				"login.enabled":     "true",
-				"login.pdb.enabled": "false",
+			values: &values.Values{
+				Login: &values.Login{
+					Enabled: ptr.To(true),
+					PDB: &values.LoginPDB{
+						Enabled: ptr.To(false),
+					},
+				},
+				PDB: &values.PDB{
+					Enabled: ptr.To(false),
+				},
 			},
 		},
 	}
@@ -203,7 +244,7 @@ func TestPodDisruptionBudgetMatrix(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			t.Parallel()
 			support.WithNamespace(t, func(env *support.Env) {
-				releaseName := setup.InstallZitadel(t, env, tc.name, tc.setValues)
+				releaseName := setup.InstallZitadel(t, env, tc.name, tc.values)
 
 				if tc.zitadel != nil {
 					env.AssertPartial(t, releaseName, *tc.zitadel)
@@ -4,37 +4,62 @@ import (
 	"testing"
 
 	"github.com/onsi/gomega"
+	"k8s.io/utils/ptr"
 
 	"github.com/zitadel/zitadel-charts/test/assert"
 	setup "github.com/zitadel/zitadel-charts/test/smoke/support"
 	"github.com/zitadel/zitadel-charts/test/support"
+	"github.com/zitadel/zitadel-charts/test/values"
 )
 
 func TestSecretsMatrix(t *testing.T) {
 	t.Parallel()
+	support.SkipIfRendered(t, "machine key, PAT and login client secrets are written by the setup job")
 
 	testCases := []struct {
-		name             string
-		setValues        map[string]string
-		masterkey        *assert.SecretAssertion
-		machineKey       *assert.SecretAssertion
-		machineKeyName   string
-		machinePat       *assert.SecretAssertion
-		machinePatName   string
-		loginClient      *assert.SecretAssertion
-		loginClientName  string
+		name            string
+		values          *values.Values
+		masterkey       *assert.SecretAssertion
+		machineKey      *assert.SecretAssertion
+		machineKeyName  string
+		machinePat      *assert.SecretAssertion
+		machinePatName  string
+		loginClient     *assert.SecretAssertion
+		loginClientName string
 	}{
 		{
 			name: "default-all-enabled",
-			setValues: map[string]string{
-				"zitadel.configmapConfig.FirstInstance.Org.Machine.Machine.Username":          "iam-admin",
-				"zitadel.configmapConfig.FirstInstance.Org.Machine.Machine.Name":              "Admin Machine",
-				"zitadel.configmapConfig.FirstInstance.Org.Machine.MachineKey.ExpirationDate": "2029-01-01T00:00:00Z",
-				"zitadel.configmapConfig.FirstInstance.Org.Machine.MachineKey.Type":           "1",
-				"zitadel.configmapConfig.FirstInstance.Org.Machine.Pat.ExpirationDate":        "2029-01-01T00:00:00Z",
-				"zitadel.configmapConfig.FirstInstance.Org.LoginClient.Machine.Username":      "login-client",
-				"zitadel.configmapConfig.FirstInstance.Org.LoginClient.Machine.Name":          "Login Client",
-				"zitadel.configmapConfig.FirstInstance.Org.LoginClient.Pat.ExpirationDate":    "2029-01-01T00:00:00Z",
+			values: &values.Values{
+				Zitadel: &values.Zitadel{
+					ConfigmapConfig: map[string]any{
+						"FirstInstance": map[string]any{
+							"Org": map[string]any{
+								"LoginClient": map[string]any{
+									"Machine": map[string]any{
+										"Name":     "Login Client",
+										"Username": "login-client",
+									},
+									"Pat": map[string]any{
+										"ExpirationDate": "2029-01-01T00:00:00Z",
+									},
+								},
+								"Machine": map[string]any{
+									"Machine": map[string]any{
+										"Name":     "Admin Machine",
+										"Username": "iam-admin",
+									},
+									"MachineKey": map[string]any{
+										"ExpirationDate": "2029-01-01T00:00:00Z",
+										"Type":           1,
+									},
+									"Pat": map[string]any{
+										"ExpirationDate": "2029-01-01T00:00:00Z",
+									},
+								},
+							},
+						},
+					},
+				},
 			},
 			masterkey: &assert.SecretAssertion{
 				Data: assert.Matching[map[string][]byte](
@@ -62,14 +87,34 @@ func TestSecretsMatrix(t *testing.T) {
 		},
 		{
 			name: "machine-only-no-pat",
-			setValues: map[string]string{
-				"zitadel.configmapConfig.FirstInstance.Org.Machine.Machine.Username":          "my-machine",
-				"zitadel.configmapConfig.FirstInstance.Org.Machine.Machine.Name":              "My Machine",
-				"zitadel.configmapConfig.FirstInstance.Org.Machine.MachineKey.ExpirationDate": "2029-01-01T00:00:00Z",
-				"zitadel.configmapConfig.FirstInstance.Org.Machine.MachineKey.Type":           "1",
-				"zitadel.configmapConfig.FirstInstance.Org.LoginClient.Machine.Username":      "login-client",
-				"zitadel.configmapConfig.FirstInstance.Org.LoginClient.Machine.Name":          "Login Client",
-				"zitadel.configmapConfig.FirstInstance.Org.LoginClient.Pat.ExpirationDate":    "2029-01-01T00:00:00Z",
+			values: &values.Values{
+				Zitadel: &values.Zitadel{
+					ConfigmapConfig: map[string]any{
+						"FirstInstance": map[string]any{
+							"Org": map[string]any{
+								"LoginClient": map[string]any{
+									"Machine": map[string]any{
+										"Name":     "Login Client",
+										"Username": "login-client",
+									},
+									"Pat": map[string]any{
+										"ExpirationDate": "2029-01-01T00:00:00Z",
+									},
+								},
+								"Machine": map[string]any{
+									"Machine": map[string]any{
+										"Name":     "My Machine",
+										"Username": "my-machine",
+									},
+									"MachineKey": map[string]any{
+										"ExpirationDate": "2029-01-01T00:00:00Z",
+										"Type":           1,
+									},
+								},
+							},
+						},
+					},
+				},
 			},
 			masterkey: &assert.SecretAssertion{
 				Data: assert.Matching[map[string][]byte](
@@ -91,10 +136,24 @@ func TestSecretsMatrix(t *testing.T) {
 		},
 		{
 			name: "login-client-only",
-			setValues: map[string]string{
-				"zitadel.configmapConfig.FirstInstance.Org.LoginClient.Machine.Username":   "login-client",
-				"zitadel.configmapConfig.FirstInstance.Org.LoginClient.Machine.Name":       "Login Client",
-				"zitadel.configmapConfig.FirstInstance.Org.LoginClient.Pat.ExpirationDate": "2029-01-01T00:00:00Z",
+			values: &values.Values{
+				Zitadel: &values.Zitadel{
+					ConfigmapConfig: map[string]any{
+						"FirstInstance": map[string]any{
+							"Org": map[string]any{
+								"LoginClient": map[string]any{
+									"Machine": map[string]any{
+										"Name":     "Login Client",
+										"Username": "login-client",
+									},
+									"Pat": map[string]any{
+										"ExpirationDate": "2029-01-01T00:00:00Z",
+									},
+								},
+							},
+						},
+					},
+				},
 			},
 			masterkey: &assert.SecretAssertion{
 				Data: assert.Matching[map[string][]byte](
@@ -110,16 +169,40 @@ func TestSecretsMatrix(t *testing.T) {
 		},
 		{
 			name: "custom-names-with-prefix",
-			setValues: map[string]string{
-				"zitadel.configmapConfig.FirstInstance.Org.Machine.Machine.Username":          "custom-admin",
-				"zitadel.configmapConfig.FirstInstance.Org.Machine.Machine.Name":              "Custom Admin",
-				"zitadel.configmapConfig.FirstInstance.Org.Machine.MachineKey.ExpirationDate": "2029-01-01T00:00:00Z",
-				"zitadel.configmapConfig.FirstInstance.Org.Machine.MachineKey.Type":           "1",
-				"zitadel.configmapConfig.FirstInstance.Org.Machine.Pat.ExpirationDate":        "2029-01-01T00:00:00Z",
-				"zitadel.configmapConfig.FirstInstance.Org.LoginClient.Machine.Username":      "login-client",
-				"zitadel.configmapConfig.FirstInstance.Org.LoginClient.Machine.Name":          "Login Client",
-				"zitadel.configmapConfig.FirstInstance.Org.LoginClient.Pat.ExpirationDate":    "2029-01-01T00:00:00Z",
-				"login.loginClientSecretPrefix":                                               "myapp-",
+			values: &values.Values{
+				Login: &values.Login{
+					LoginClientSecretPrefix: ptr.To("myapp-"),
+				},
+				Zitadel: &values.Zitadel{
+					ConfigmapConfig: map[string]any{
+						"FirstInstance": map[string]any{
+							"Org": map[string]any{
+								"LoginClient": map[string]any{
+									"Machine": map[string]any{
+										"Name":     "Login Client",
+										"Username": "login-client",
+									},
+									"Pat": map[string]any{
+										"ExpirationDate": "2029-01-01T00:00:00Z",
+									},
+								},
+								"Machine": map[string]any{
+									"Machine": map[string]any{
+										"Name":     "Custom Admin",
+										"Username": "custom-admin",
+									},
+									"MachineKey": map[string]any{
+										"ExpirationDate": "2029-01-01T00:00:00Z",
+										"Type":           1,
+									},
+									"Pat": map[string]any{
+										"ExpirationDate": "2029-01-01T00:00:00Z",
+									},
+								},
+							},
+						},
+					},
+				},
 			},
 			masterkey: &assert.SecretAssertion{
 				Data: assert.Matching[map[string][]byte](
@@ -146,8 +229,7 @@ func TestSecretsMatrix(t *testing.T) {
 			},
 		},
 		{
-			name:      "minimal-no-setup",
-			setValues: map[string]string{},
+			name: "minimal-no-setup",
 			masterkey: &assert.SecretAssertion{
 				Data: assert.Matching[map[string][]byte](
 					gomega.HaveKeyWithValue("masterkey", gomega.Not(gomega.BeEmpty())),
@@ -161,7 +243,7 @@ func TestSecretsMatrix(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			t.Parallel()
 			support.WithNamespace(t, func(env *support.Env) {
-				releaseName := setup.InstallZitadel(t, env, tc.name, tc.setValues)
+				releaseName := setup.InstallZitadel(t, env, tc.name, tc.values)
 
 				if tc.masterkey != nil {
 					env.AssertPartial(t, releaseName+"-masterkey", *tc.masterkey)
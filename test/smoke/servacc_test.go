@@ -3,9 +3,12 @@ package smoke_test_test
 import (
 	"testing"
 
+	"k8s.io/utils/ptr"
+
 	"github.com/zitadel/zitadel-charts/test/assert"
 	setup "github.com/zitadel/zitadel-charts/test/smoke/support"
 	"github.com/zitadel/zitadel-charts/test/support"
+	"github.com/zitadel/zitadel-charts/test/values"
 )
 
 //goland:noinspection DuplicatedCode
@@ -13,17 +16,23 @@ func TestServiceAccountMatrix(t *testing.T) {
 	t.Parallel()
 
 	testCases := []struct {
-		name      string
-		setValues map[string]string
-		zitadel   *assert.ServiceAccountAssertion
-		login     *assert.ServiceAccountAssertion
+		name    string
+		values  *values.Values
+		zitadel *assert.ServiceAccountAssertion
+		login   *assert.ServiceAccountAssertion
 	}{
 		{
 			name: "both-enabled-default",
-			setValues: map[string]string{
-				"serviceAccount.create":       "true",
-				"login.enabled":               "true",
-				"login.serviceAccount.create": "true",
+			values: &values.Values{
+				Login: &values.Login{
+					Enabled: ptr.To(true),
+					ServiceAccount: &values.LoginServiceAccount{
+						Create: ptr.To(true),
+					},
+				},
+				ServiceAccount: &values.ServiceAccount{
+					Create: ptr.To(true),
+				},
 			},
 			zitadel: &assert.ServiceAccountAssertion{
 				ObjectMeta: assert.ObjectMetaAssertion{
@@ -46,14 +55,24 @@ func TestServiceAccountMatrix(t *testing.T) {
 		},
 		{
 			name: "both-enabled-with-annotations",
-			setValues: map[string]string{
-				"serviceAccount.create": "true",
-				"serviceAccount.annotations.eks\\.amazonaws\\.com/role-arn":       "arn:aws:iam::123456789012:role/zitadel-role",
-				"serviceAccount.annotations.owner":                                "platform-team",
-				"login.enabled":                                                   "true",
-				"login.serviceAccount.create":                                     "true",
-				"login.serviceAccount.annotations.eks\\.amazonaws\\.com/role-arn": "arn:aws:iam::123456789012:role/login-role",
-				"login.serviceAccount.annotations.team":                           "frontend",
+			values: &values.Values{
+				Login: &values.Login{
+					Enabled: ptr.To(true),
+					ServiceAccount: &values.LoginServiceAccount{
+						Annotations: map[string]string{
+							"eks.amazonaws.com/role-arn": "arn:aws:iam::123456789012:role/login-role",
+							"team":                       "frontend",
+						},
+						Create: ptr.To(true),
+					},
+				},
+				ServiceAccount: &values.ServiceAccount{
+					Annotations: map[string]string{
+						"eks.amazonaws.com/role-arn": "arn:aws:iam::123456789012:role/zitadel-role",
+						"owner":                      "platform-team",
+					},
+					Create: ptr.To(true),
+				},
 			},
 			zitadel: &assert.ServiceAccountAssertion{
 				ObjectMeta: assert.ObjectMetaAssertion{
@@ -80,11 +99,19 @@ func TestServiceAccountMatrix(t *testing.T) {
 		},
 		{
 			name: "zitadel-enabled-login-disabled",
-			setValues: map[string]string{
-				"serviceAccount.create":                        "true",
-				"serviceAccount.annotations.workload-identity": "enabled",
-				"login.enabled":                                "true",
-				"login.serviceAccount.create":                  "false",
+			values: &values.Values{
+				Login: &values.Login{
+					Enabled: ptr.To(true),
+					ServiceAccount: &values.LoginServiceAccount{
+						Create: ptr.To(false),
+					},
+				},
+				ServiceAccount: &values.ServiceAccount{
+					Annotations: map[string]string{
+						"workload-identity": "enabled",
+					},
+					Create: ptr.To(true),
+				},
 			},
 			zitadel: &assert.ServiceAccountAssertion{
 				ObjectMeta: assert.ObjectMetaAssertion{
@@ -99,18 +126,30 @@ func TestServiceAccountMatrix(t *testing.T) {
 		},
 		{
 			name: "both-disabled",
-			setValues: map[string]string{
-				"serviceAccount.create":       "false",
-				"login.enabled":               "true",
-				"login.serviceAccount.create": "false",
+			values: &values.Values{
+				Login: &values.Login{
+					Enabled: ptr.To(true),
+					ServiceAccount: &values.LoginServiceAccount{
+						Create: ptr.To(false),
+					},
+				},
+				ServiceAccount: &values.ServiceAccount{
+					Create: ptr.To(false),
+				},
 			},
 		},
 		{
 			name: "zitadel-only-with-gcp-workload-identity",
-			setValues: map[string]string{
-				"serviceAccount.create": "true",
-				"serviceAccount.annotations.iam\\.gke\\.io/gcp-service-account": "zitadel@project.iam.gserviceaccount.com",
-				"login.enabled": "false",
+			values: &values.Values{
+				Login: &values.Login{
+					Enabled: ptr.To(false),
+				},
+				ServiceAccount: &values.ServiceAccount{
+					Annotations: map[string]string{
+						"iam.gke.io/gcp-service-account": "zitadel@project.iam.gserviceaccount.com",
+					},
+					Create: ptr.To(true),
+				},
 			},
 			zitadel: &assert.ServiceAccountAssertion{
 				ObjectMeta: assert.ObjectMetaAssertion{
@@ -130,7 +169,7 @@ func TestServiceAccountMatrix(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			t.Parallel()
 			support.WithNamespace(t, func(env *support.Env) {
-				releaseName := setup.InstallZitadel(t, env, tc.name, tc.setValues)
+				releaseName := setup.InstallZitadel(t, env, tc.name, tc.values)
 
 				if tc.zitadel != nil {
 					env.AssertPartial(t, releaseName, *tc.zitadel)
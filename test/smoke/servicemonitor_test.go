@@ -3,13 +3,14 @@ package smoke_test_test
 import (
 	"testing"
 
-	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
-
 	"github.com/onsi/gomega"
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	"k8s.io/utils/ptr"
 
 	"github.com/zitadel/zitadel-charts/test/assert"
 	setup "github.com/zitadel/zitadel-charts/test/smoke/support"
 	"github.com/zitadel/zitadel-charts/test/support"
+	"github.com/zitadel/zitadel-charts/test/values"
 )
 
 //goland:noinspection ALL
@@ -29,16 +30,20 @@ func TestServiceMonitorMatrix(t *testing.T) {
 	t.Parallel()
 
 	testCases := []struct {
-		name      string
-		setValues map[string]string
-		zitadel   *assert.ServiceMonitorAssertion
-		login     *assert.ServiceMonitorAssertion
+		name    string
+		values  *values.Values
+		zitadel *assert.ServiceMonitorAssertion
+		login   *assert.ServiceMonitorAssertion
 	}{
 		{
 			name: "zitadel-only",
-			setValues: map[string]string{
-				"metrics.enabled":                "true",
-				"metrics.serviceMonitor.enabled": "true",
+			values: &values.Values{
+				Metrics: &values.Metrics{
+					Enabled: ptr.To(true),
+					ServiceMonitor: &values.MetricsServiceMonitor{
+						Enabled: ptr.To(true),
+					},
+				},
 			},
 			zitadel: &assert.ServiceMonitorAssertion{
 				ObjectMeta: assert.ObjectMetaAssertion{
@@ -70,10 +75,16 @@ func TestServiceMonitorMatrix(t *testing.T) {
 		},
 		{
 			name: "login-only",
-			setValues: map[string]string{
-				"login.enabled":                        "true",
-				"login.metrics.enabled":                "true",
-				"login.metrics.serviceMonitor.enabled": "true",
+			values: &values.Values{
+				Login: &values.Login{
+					Enabled: ptr.To(true),
+					Metrics: &values.LoginMetrics{
+						Enabled: ptr.To(true),
+						ServiceMonitor: &values.LoginMetricsServiceMonitor{
+							Enabled: ptr.To(true),
+						},
+					},
+				},
 			},
 			login: &assert.ServiceMonitorAssertion{
 				ObjectMeta: assert.ObjectMetaAssertion{
@@ -107,12 +118,22 @@ func TestServiceMonitorMatrix(t *testing.T) {
 		},
 		{
 			name: "both-enabled",
-			setValues: map[string]string{
-				"metrics.enabled":                      "true",
-				"metrics.serviceMonitor.enabled":       "true",
-				"login.enabled":                        "true",
-				"login.metrics.enabled":                "true",
-				"login.metrics.serviceMonitor.enabled": "true",
+			values: &values.Values{
+				Login: &values.Login{
+					Enabled: ptr.To(true),
+					Metrics: &values.LoginMetrics{
+						Enabled: ptr.To(true),
+						ServiceMonitor: &values.LoginMetricsServiceMonitor{
+							Enabled: ptr.To(true),
+						},
+					},
+				},
+				Metrics: &values.Metrics{
+					Enabled: ptr.To(true),
+					ServiceMonitor: &values.MetricsServiceMonitor{
+						Enabled: ptr.To(true),
+					},
+				},
 			},
 			zitadel: &assert.ServiceMonitorAssertion{
 				ObjectMeta: assert.ObjectMetaAssertion{
@@ -149,15 +170,28 @@ func TestServiceMonitorMatrix(t *testing.T) {
 		},
 		{
 			name: "additional-labels",
-			setValues: map[string]string{
-				"metrics.enabled":                              "true",
-				"metrics.serviceMonitor.enabled":               "true",
-				"metrics.serviceMonitor.additionalLabels.team": "platform",
-
-				"login.enabled":                                      "true",
-				"login.metrics.enabled":                               "true",
-				"login.metrics.serviceMonitor.enabled":                "true",
-				"login.metrics.serviceMonitor.additionalLabels.team":  "frontend",
+			values: &values.Values{
+				Login: &values.Login{
+					Enabled: ptr.To(true),
+					Metrics: &values.LoginMetrics{
+						Enabled: ptr.To(true),
+						ServiceMonitor: &values.LoginMetricsServiceMonitor{
+							AdditionalLabels: map[string]string{
+								"team": "frontend",
+							},
+							Enabled: ptr.To(true),
+						},
+					},
+				},
+				Metrics: &values.Metrics{
+					Enabled: ptr.To(true),
+					ServiceMonitor: &values.MetricsServiceMonitor{
+						AdditionalLabels: map[string]string{
+							"team": "platform",
+						},
+						Enabled: ptr.To(true),
+					},
+				},
 			},
 			zitadel: &assert.ServiceMonitorAssertion{
 				ObjectMeta: assert.ObjectMetaAssertion{
@@ -178,14 +212,20 @@ func TestServiceMonitorMatrix(t *testing.T) {
 		},
 		{
 			name: "scrape-interval-and-timeout",
-			setValues: map[string]string{
-				"login.enabled":                                  "true",
-				"login.metrics.enabled":                          "true",
-				"login.metrics.serviceMonitor.enabled":           "true",
-				"login.metrics.serviceMonitor.scrapeInterval":    "15s",
-				"login.metrics.serviceMonitor.scrapeTimeout":     "10s",
-				"login.metrics.serviceMonitor.honorLabels":       "true",
-				"login.metrics.serviceMonitor.honorTimestamps":   "false",
+			values: &values.Values{
+				Login: &values.Login{
+					Enabled: ptr.To(true),
+					Metrics: &values.LoginMetrics{
+						Enabled: ptr.To(true),
+						ServiceMonitor: &values.LoginMetricsServiceMonitor{
+							Enabled:         ptr.To(true),
+							HonorLabels:     ptr.To(true),
+							HonorTimestamps: ptr.To(false),
+							ScrapeInterval:  ptr.To("15s"),
+							ScrapeTimeout:   ptr.To("10s"),
+						},
+					},
+				},
 			},
 			login: &assert.ServiceMonitorAssertion{
 				Spec: assert.ServiceMonitorSpecAssertion{
@@ -209,7 +249,7 @@ func TestServiceMonitorMatrix(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			t.Parallel()
 			support.WithNamespace(t, func(env *support.Env) {
-				releaseName := setup.InstallZitadel(t, env, tc.name, tc.setValues)
+				releaseName := setup.InstallZitadel(t, env, tc.name, tc.values)
 
 				if tc.zitadel != nil {
 					env.AssertPartial(t, releaseName, *tc.zitadel)
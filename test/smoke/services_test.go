@@ -6,10 +6,12 @@ import (
 	"github.com/onsi/gomega"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
 
 	"github.com/zitadel/zitadel-charts/test/assert"
 	setup "github.com/zitadel/zitadel-charts/test/smoke/support"
 	"github.com/zitadel/zitadel-charts/test/support"
+	"github.com/zitadel/zitadel-charts/test/values"
 )
 
 //goland:noinspection DuplicatedCode
@@ -17,15 +19,17 @@ func TestServiceMatrix(t *testing.T) {
 	t.Parallel()
 
 	testCases := []struct {
-		name      string
-		setValues map[string]string
-		zitadel   *assert.ServiceAssertion
-		login     *assert.ServiceAssertion
+		name    string
+		values  *values.Values
+		zitadel *assert.ServiceAssertion
+		login   *assert.ServiceAssertion
 	}{
 		{
 			name: "both-enabled-default-clusterip",
-			setValues: map[string]string{
-				"login.enabled": "true",
+			values: &values.Values{
+				Login: &values.Login{
+					Enabled: ptr.To(true),
+				},
 			},
 			zitadel: &assert.ServiceAssertion{
 				ObjectMeta: assert.ObjectMetaAssertion{
@@ -75,10 +79,16 @@ func TestServiceMatrix(t *testing.T) {
 		},
 		{
 			name: "both-enabled-custom-ports",
-			setValues: map[string]string{
-				"service.port":       "9090",
-				"login.enabled":      "true",
-				"login.service.port": "9091",
+			values: &values.Values{
+				Login: &values.Login{
+					Enabled: ptr.To(true),
+					Service: &values.LoginService{
+						Port: ptr.To(9091),
+					},
+				},
+				Service: &values.Service{
+					Port: ptr.To(9090),
+				},
 			},
 			zitadel: &assert.ServiceAssertion{
 				Spec: assert.ServiceSpecAssertion{
@@ -113,11 +123,21 @@ func TestServiceMatrix(t *testing.T) {
 		},
 		{
 			name: "both-enabled-with-annotations",
-			setValues: map[string]string{
-				"service.annotations.cloud\\.google\\.com/load-balancer-type": "Internal",
-				"service.annotations.owner":                                   "platform-team",
-				"login.enabled":                                               "true",
-				"login.service.annotations.service\\.beta\\.kubernetes\\.io/aws-load-balancer-internal": "yes",
+			values: &values.Values{
+				Login: &values.Login{
+					Enabled: ptr.To(true),
+					Service: &values.LoginService{
+						Annotations: map[string]string{
+							"service.beta.kubernetes.io/aws-load-balancer-internal": "yes",
+						},
+					},
+				},
+				Service: &values.Service{
+					Annotations: map[string]string{
+						"cloud.google.com/load-balancer-type": "Internal",
+						"owner":                               "platform-team",
+					},
+				},
 			},
 			zitadel: &assert.ServiceAssertion{
 				Spec: assert.ServiceSpecAssertion{
@@ -163,10 +183,14 @@ func TestServiceMatrix(t *testing.T) {
 		},
 		{
 			name: "zitadel-only-login-disabled",
-			setValues: map[string]string{
-				"service.type":  "ClusterIP",
-				"service.port":  "8888",
-				"login.enabled": "false",
+			values: &values.Values{
+				Login: &values.Login{
+					Enabled: ptr.To(false),
+				},
+				Service: &values.Service{
+					Port: ptr.To(8888),
+					Type: ptr.To("ClusterIP"),
+				},
 			},
 			zitadel: &assert.ServiceAssertion{
 				Spec: assert.ServiceSpecAssertion{
@@ -191,7 +215,7 @@ func TestServiceMatrix(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			t.Parallel()
 			support.WithNamespace(t, func(env *support.Env) {
-				releaseName := setup.InstallZitadel(t, env, tc.name, tc.setValues)
+				releaseName := setup.InstallZitadel(t, env, tc.name, tc.values)
 
 				if tc.zitadel != nil {
 					env.AssertPartial(t, releaseName, *tc.zitadel)
@@ -4,16 +4,13 @@ import (
 	"fmt"
 	"path/filepath"
 	"runtime"
-	"strings"
 	"testing"
 
-	"github.com/gruntwork-io/terratest/modules/helm"
-	"github.com/gruntwork-io/terratest/modules/k8s"
 	"github.com/stretchr/testify/require"
-	corev1 "k8s.io/api/core/v1"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 
 	testsupport "github.com/zitadel/zitadel-charts/test/support"
+	"github.com/zitadel/zitadel-charts/test/values"
 )
 
 // ChartPath returns the absolute path to the Zitadel Helm chart.
@@ -29,127 +26,86 @@ func ChartPath(t *testing.T) string {
 }
 
 // InstallZitadel installs the Zitadel chart with PostgreSQL and standard
-// configuration. It handles: WithPostgres, commonSetValues, MakeRelease,
-// mergedSetValues, helm.Options, helm.UpgradeE, and log dumping on failure.
-// Returns the generated release name.
-func InstallZitadel(t *testing.T, env *testsupport.Env, testName string, setValues map[string]string) string {
+// configuration. It handles: WithPostgres, the common values, MakeRelease,
+// and the Env's Backend. Failures are diagnosed by testcluster.WithNamespace.
+// The caller's values are applied on top of the common values. Returns the
+// generated release name.
+//
+// In ModeRendered PostgreSQL is not installed and the chart is only rendered,
+// since nothing ever connects to the database.
+func InstallZitadel(t *testing.T, env *testsupport.Env, testName string, vals *values.Values) string {
 	t.Helper()
 
 	chartPath := ChartPath(t)
+	live := env.Backend.Mode() == testsupport.ModeLive
 
-	env.Logger.Logf(t, "namespace %q created; installing PostgreSQL…", env.Namespace)
-	WithPostgres(t, env)
+	if live {
+		env.Logger.Logf(t, "namespace %q created; installing PostgreSQL…", env.Namespace)
+		WithPostgres(t, env)
+	}
 
-	uniqueDomain := fmt.Sprintf("%s.test.local", env.Namespace)
-	commonSetValues := map[string]string{
-		"zitadel.masterkey":                      "x123456789012345678901234567891y",
-		"zitadel.configmapConfig.ExternalDomain": uniqueDomain,
-		"zitadel.configmapConfig.ExternalPort":   "443",
-		"zitadel.configmapConfig.TLS.Enabled":    "false",
-		"ingress.enabled":                        "true",
-		"login.ingress.enabled":                  "true",
+	config := map[string]any{
+		"ExternalDomain": fmt.Sprintf("%s.test.local", env.Namespace),
+		"ExternalPort":   443,
+		"TLS":            map[string]any{"Enabled": false},
 	}
 	// Hardcoded discrete Database.Postgres.* fields put the chart in legacy
 	// "configmap mode". Skip them if the caller is exercising DSN mode (either
 	// via an env var ZITADEL_DATABASE_POSTGRES_DSN or by enabling the bundled
 	// postgresql subchart) so the chart's DSN code paths are actually tested.
-	if !setValuesUseDSNMode(setValues) {
-		commonSetValues["zitadel.configmapConfig.Database.Postgres.Host"] = "db-postgresql"
-		commonSetValues["zitadel.configmapConfig.Database.Postgres.Port"] = "5432"
-		commonSetValues["zitadel.configmapConfig.Database.Postgres.Database"] = "zitadel"
-		commonSetValues["zitadel.configmapConfig.Database.Postgres.MaxOpenConns"] = "20"
-		commonSetValues["zitadel.configmapConfig.Database.Postgres.MaxIdleConns"] = "10"
-		commonSetValues["zitadel.configmapConfig.Database.Postgres.MaxConnLifetime"] = "30m"
-		commonSetValues["zitadel.configmapConfig.Database.Postgres.MaxConnIdleTime"] = "5m"
-		commonSetValues["zitadel.configmapConfig.Database.Postgres.User.Username"] = "postgres"
-		commonSetValues["zitadel.configmapConfig.Database.Postgres.User.SSL.Mode"] = "disable"
-		commonSetValues["zitadel.configmapConfig.Database.Postgres.Admin.Username"] = "postgres"
-		commonSetValues["zitadel.configmapConfig.Database.Postgres.Admin.SSL.Mode"] = "disable"
-	}
-
-	releaseName := env.MakeRelease("zitadel-test", testName)
-
-	mergedSetValues := make(map[string]string)
-	for key, value := range commonSetValues {
-		mergedSetValues[key] = value
-	}
-	for key, value := range setValues {
-		mergedSetValues[key] = value
+	if !usesDSNMode(vals) {
+		config["Database"] = map[string]any{
+			"Postgres": map[string]any{
+				"Host":            "db-postgresql",
+				"Port":            5432,
+				"Database":        "zitadel",
+				"MaxOpenConns":    20,
+				"MaxIdleConns":    10,
+				"MaxConnLifetime": "30m",
+				"MaxConnIdleTime": "5m",
+				"User": map[string]any{
+					"Username": "postgres",
+					"SSL":      map[string]any{"Mode": "disable"},
+				},
+				"Admin": map[string]any{
+					"Username": "postgres",
+					"SSL":      map[string]any{"Mode": "disable"},
+				},
+			},
+		}
 	}
-
-	helmOptions := &helm.Options{
-		KubectlOptions: env.Kube,
-		SetValues:      mergedSetValues,
-		ExtraArgs: map[string][]string{
-			"upgrade": {"--install", "--wait", "--timeout", "30m"},
+	commonValues := &values.Values{
+		Zitadel: &values.Zitadel{
+			Masterkey:       ptr.To("x123456789012345678901234567891y"),
+			ConfigmapConfig: config,
+		},
+		Ingress: &values.Ingress{Enabled: ptr.To(true)},
+		Login: &values.Login{
+			Ingress: &values.LoginIngress{Enabled: ptr.To(true)},
 		},
 	}
 
-	if err := helm.UpgradeE(t, helmOptions, chartPath, releaseName); err != nil {
-		dumpSetupAndInitJobLogs(t, env, releaseName)
-		require.NoError(t, err)
-	}
+	releaseName := env.MakeRelease("zitadel-test", testName)
+
+	require.NoError(t, env.Backend.InstallChart(t, chartPath, releaseName, commonValues, vals))
 
 	return releaseName
 }
 
-// setValuesUseDSNMode returns true if the caller's setValues opt into DSN mode,
-// either by setting an env entry whose name is ZITADEL_DATABASE_POSTGRES_DSN
-// or by enabling the bundled postgresql subchart. Helm --set encodes list
-// entries as e.g. "env[0].name=ZITADEL_DATABASE_POSTGRES_DSN" so we have to
-// scan the keys for that pattern.
-func setValuesUseDSNMode(setValues map[string]string) bool {
-	if setValues["postgresql.enabled"] == "true" {
+// usesDSNMode returns true if the caller's values opt into DSN mode, either
+// by setting an env entry whose name is ZITADEL_DATABASE_POSTGRES_DSN or by
+// enabling the bundled postgresql subchart.
+func usesDSNMode(vals *values.Values) bool {
+	if vals == nil {
+		return false
+	}
+	if vals.Postgresql != nil && ptr.Deref(vals.Postgresql.Enabled, false) {
 		return true
 	}
-	for key, value := range setValues {
-		if strings.HasPrefix(key, "env[") && strings.HasSuffix(key, "].name") &&
-			value == "ZITADEL_DATABASE_POSTGRES_DSN" {
+	for _, env := range vals.Env {
+		if env.Name == "ZITADEL_DATABASE_POSTGRES_DSN" {
 			return true
 		}
 	}
 	return false
 }
-
-func dumpSetupAndInitJobLogs(t *testing.T, env *testsupport.Env, releaseName string) {
-	namespace := env.Kube.Namespace
-	jobNames := []string{fmt.Sprintf("%s-setup", releaseName), fmt.Sprintf("%s-init", releaseName)}
-
-	for _, jobName := range jobNames {
-		labelSelector := fmt.Sprintf("job-name=%s", jobName)
-		pods := listPods(t, env, labelSelector)
-
-		for _, pod := range pods {
-			for _, container := range pod.Spec.Containers {
-				logOutput, _ := k8s.RunKubectlAndGetOutputE(
-					t,
-					env.Kube,
-					"logs",
-					pod.Name, "-n", namespace, "-c", container.Name, "--tail=500",
-				)
-				env.Logger.Logf(t, "---- logs: pod=%s container=%s ----\n%s\n---- end logs ----", pod.Name, container.Name, logOutput)
-			}
-			for _, initContainer := range pod.Spec.InitContainers {
-				logOutput, _ := k8s.RunKubectlAndGetOutputE(
-					t,
-					env.Kube,
-					"logs",
-					pod.Name, "-n", namespace, "-c", initContainer.Name, "--tail=500",
-				)
-				env.Logger.Logf(t, "---- logs: pod=%s initContainer=%s ----\n%s\n---- end logs ----", pod.Name, initContainer.Name, logOutput)
-			}
-		}
-	}
-}
-
-func listPods(t *testing.T, env *testsupport.Env, labelSelector string) []corev1.Pod {
-	podList, err := env.Client.CoreV1().Pods(env.Kube.Namespace).List(
-		env.Ctx,
-		metav1.ListOptions{LabelSelector: labelSelector},
-	)
-	if err != nil {
-		env.Logger.Logf(t, "warn: list pods selector=%q: %v", labelSelector, err)
-		return nil
-	}
-	return podList.Items
-}
@@ -0,0 +1,230 @@
+// Package snapshot compares rendered chart manifests against committed golden
+// YAML files. Every named Case is rendered in-process via the render package,
+// normalised so that volatile fields (checksum annotations, generated
+// certificates, random secrets) do not cause spurious diffs, and compared
+// with testdata/<name>.golden.yaml.
+//
+// After an intentional template change, regenerate the golden files with:
+//
+//	go test ./test/snapshot/ -update
+package snapshot
+
+import (
+	"encoding/base64"
+	"fmt"
+	"os"
+	"path/filepath"
+	goruntime "runtime"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/pmezard/go-difflib/difflib"
+	"github.com/stretchr/testify/require"
+	"sigs.k8s.io/yaml"
+
+	"github.com/zitadel/zitadel-charts/test/render"
+)
+
+// Placeholders substituted for volatile values.
+const (
+	checksumPlaceholder    = "<checksum>"
+	certificatePlaceholder = "<generated certificate>"
+	randomPlaceholder      = "<random>"
+)
+
+// Case is a named set of values the chart is rendered with.
+type Case struct {
+	// Name identifies the case and names its golden file.
+	Name string
+	// ValuesFiles are merged in order, like `helm template -f`.
+	ValuesFiles []string
+	// SetValues are applied after ValuesFiles, like `helm template --set`.
+	SetValues map[string]string
+}
+
+// repoRoot returns the absolute path to the repository root.
+func repoRoot() string {
+	_, filename, _, _ := goruntime.Caller(0)
+	return filepath.Join(filepath.Dir(filename), "..", "..")
+}
+
+// ExampleCases returns one case per ZITADEL values file under examples/,
+// named after its example directory. Values files for other charts, such as
+// postgres-values.yaml or traefik-values.yaml, are not included.
+func ExampleCases() ([]Case, error) {
+	var files []string
+	for _, pattern := range []string{"zitadel-values.yaml", "quickstart-values.yaml"} {
+		matches, err := filepath.Glob(filepath.Join(repoRoot(), "examples", "*", pattern))
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, matches...)
+	}
+	sort.Strings(files)
+
+	cases := make([]Case, 0, len(files))
+	for _, file := range files {
+		cases = append(cases, Case{
+			Name:        "example-" + filepath.Base(filepath.Dir(file)),
+			ValuesFiles: []string{file},
+		})
+	}
+	return cases, nil
+}
+
+// RenderE renders the case and returns the normalised manifests as a single
+// multi-document YAML string. Objects are sorted by kind and name so that the
+// output does not depend on template file order.
+func RenderE(c Case) (string, error) {
+	docs, err := render.RenderE(
+		render.WithRelease("zitadel"),
+		render.WithNamespace("zitadel"),
+		render.WithValuesFiles(c.ValuesFiles...),
+		render.WithSetValues(c.SetValues),
+	)
+	if err != nil {
+		return "", fmt.Errorf("rendering %s: %w", c.Name, err)
+	}
+
+	type object struct {
+		sortKey string
+		content []byte
+	}
+	objects := make([]object, 0, len(docs))
+	for _, doc := range docs {
+		obj := map[string]any{}
+		if err := yaml.Unmarshal([]byte(doc), &obj); err != nil {
+			return "", fmt.Errorf("decoding manifest of %s: %w\n%s", c.Name, err, doc)
+		}
+		if len(obj) == 0 {
+			continue
+		}
+		Normalize(obj)
+		content, err := yaml.Marshal(obj)
+		if err != nil {
+			return "", err
+		}
+		metadata, _ := obj["metadata"].(map[string]any)
+		objects = append(objects, object{
+			sortKey: fmt.Sprintf("%v/%v/%v", obj["kind"], metadata["namespace"], metadata["name"]),
+			content: content,
+		})
+	}
+	sort.SliceStable(objects, func(i, j int) bool {
+		return objects[i].sortKey < objects[j].sortKey
+	})
+
+	var b strings.Builder
+	for _, obj := range objects {
+		b.WriteString("---\n")
+		b.Write(obj.content)
+	}
+	return b.String(), nil
+}
+
+// Normalize replaces volatile values in a decoded manifest with stable
+// placeholders:
+//
+//   - values of checksum/* annotations, anywhere in the object
+//   - Secret data holding PEM material, as produced by genSelfSignedCert
+//   - Secret data generated with randAlphaNum, as by the postgresql subchart
+//     when no password is configured
+func Normalize(obj map[string]any) {
+	normalizeChecksums(obj)
+	if obj["kind"] != "Secret" {
+		return
+	}
+	for _, field := range []string{"data", "stringData"} {
+		data, ok := obj[field].(map[string]any)
+		if !ok {
+			continue
+		}
+		for key, value := range data {
+			s, ok := value.(string)
+			if !ok {
+				continue
+			}
+			plain := s
+			if field == "data" {
+				decoded, err := base64.StdEncoding.DecodeString(s)
+				if err != nil {
+					continue
+				}
+				plain = string(decoded)
+			}
+			switch {
+			case strings.Contains(plain, "-----BEGIN "):
+				data[key] = certificatePlaceholder
+			case isRandomPassword(obj, key):
+				data[key] = randomPlaceholder
+			}
+		}
+	}
+}
+
+// normalizeChecksums walks the object and replaces the value of every
+// annotation whose key starts with "checksum/".
+func normalizeChecksums(node any) {
+	switch n := node.(type) {
+	case map[string]any:
+		for key, value := range n {
+			if annotations, ok := value.(map[string]any); ok && key == "annotations" {
+				for name := range annotations {
+					if strings.HasPrefix(name, "checksum/") {
+						annotations[name] = checksumPlaceholder
+					}
+				}
+			}
+			normalizeChecksums(value)
+		}
+	case []any:
+		for _, value := range n {
+			normalizeChecksums(value)
+		}
+	}
+}
+
+// isRandomPassword reports whether the Secret key holds a password the
+// postgresql subchart generates when none is configured.
+func isRandomPassword(secret map[string]any, key string) bool {
+	metadata, _ := secret["metadata"].(map[string]any)
+	labels, _ := metadata["labels"].(map[string]any)
+	if labels["app.kubernetes.io/name"] != "postgresql" {
+		return false
+	}
+	return key == "postgres-password" || key == "password" || key == "replication-password"
+}
+
+// Assert renders the case and compares it with its golden file in
+// testdata. With update set, the golden file is rewritten instead.
+func Assert(t *testing.T, c Case, update bool) {
+	t.Helper()
+
+	actual, err := RenderE(c)
+	require.NoError(t, err)
+
+	golden := filepath.Join("testdata", c.Name+".golden.yaml")
+	if update {
+		require.NoError(t, os.MkdirAll(filepath.Dir(golden), 0o755))
+		require.NoError(t, os.WriteFile(golden, []byte(actual), 0o644))
+		return
+	}
+
+	expected, err := os.ReadFile(golden)
+	require.NoError(t, err, "missing golden file; run: go test ./test/snapshot/ -update")
+	if string(expected) == actual {
+		return
+	}
+
+	diff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(string(expected)),
+		B:        difflib.SplitLines(actual),
+		FromFile: golden,
+		ToFile:   "rendered",
+		Context:  3,
+	})
+	require.NoError(t, err)
+	t.Fatalf("rendered manifests of %s differ from %s; if the change is intended, run: go test ./test/snapshot/ -update\n\n%s",
+		c.Name, golden, diff)
+}
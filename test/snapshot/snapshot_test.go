@@ -0,0 +1,63 @@
+package snapshot_test
+
+import (
+	"flag"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel-charts/test/snapshot"
+)
+
+var update = flag.Bool("update", false, "rewrite golden files in testdata instead of comparing against them")
+
+// extraCases are named value sets beyond the examples. Add one when a
+// combination of values is worth pinning that no example covers.
+var extraCases = []snapshot.Case{
+	{
+		Name: "self-signed-cert",
+		SetValues: map[string]string{
+			"zitadel.masterkey":              "x123456789012345678901234567891y",
+			"zitadel.selfSignedCert.enabled": "true",
+		},
+	},
+}
+
+// TestSnapshots renders the chart for every example values file and each
+// extra case and compares the result with the committed golden YAML.
+func TestSnapshots(t *testing.T) {
+	t.Parallel()
+
+	cases, err := snapshot.ExampleCases()
+	require.NoError(t, err)
+	require.NotEmpty(t, cases, "no example values files found")
+	cases = append(cases, extraCases...)
+
+	for _, c := range cases {
+		t.Run(c.Name, func(t *testing.T) {
+			t.Parallel()
+			snapshot.Assert(t, c, *update)
+		})
+	}
+}
+
+// TestRenderIsStable verifies that normalisation removes every volatile
+// value, so that two renders of the same case are identical.
+func TestRenderIsStable(t *testing.T) {
+	t.Parallel()
+
+	cases, err := snapshot.ExampleCases()
+	require.NoError(t, err)
+	cases = append(cases, extraCases...)
+
+	for _, c := range cases {
+		t.Run(c.Name, func(t *testing.T) {
+			t.Parallel()
+			first, err := snapshot.RenderE(c)
+			require.NoError(t, err)
+			second, err := snapshot.RenderE(c)
+			require.NoError(t, err)
+			require.Equal(t, first, second, "render of %s is not deterministic; add a normaliser for the differing field", c.Name)
+		})
+	}
+}
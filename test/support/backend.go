@@ -0,0 +1,212 @@
+package support
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/gruntwork-io/terratest/modules/helm"
+	"github.com/gruntwork-io/terratest/modules/k8s"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/dynamic"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/kubernetes"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/kubernetes/scheme"
+
+	"github.com/zitadel/zitadel-charts/test/render"
+	"github.com/zitadel/zitadel-charts/test/values"
+)
+
+// Mode selects what an Env is backed by.
+type Mode string
+
+const (
+	// ModeLive installs releases into the K3s cluster started by TestMain and
+	// reads resources back through the API server.
+	ModeLive Mode = "live"
+	// ModeRendered renders releases in-process with the Helm SDK and serves
+	// the decoded objects from an in-memory object store. No cluster is
+	// started, so only rendered specs can be asserted on.
+	ModeRendered Mode = "rendered"
+)
+
+// ModeEnvVar is the environment variable that selects the Mode for a test
+// run. It defaults to ModeLive when unset.
+const ModeEnvVar = "ZITADEL_TEST_MODE"
+
+// CurrentModeE returns the Mode selected via ModeEnvVar, or an error naming
+// the valid modes if it holds any other value.
+func CurrentModeE() (Mode, error) {
+	switch mode := Mode(os.Getenv(ModeEnvVar)); mode {
+	case "", ModeLive:
+		return ModeLive, nil
+	case ModeRendered:
+		return ModeRendered, nil
+	default:
+		return "", fmt.Errorf("%s: unknown mode %q (want %q or %q)", ModeEnvVar, mode, ModeLive, ModeRendered)
+	}
+}
+
+// CurrentMode returns the Mode selected via ModeEnvVar, failing the test if
+// it is invalid.
+func CurrentMode(t *testing.T) Mode {
+	t.Helper()
+	mode, err := CurrentModeE()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return mode
+}
+
+// SkipIfRendered skips the test when running in ModeRendered. Use it for
+// tests that depend on objects only a live install produces, such as
+// secrets written by the setup job.
+func SkipIfRendered(t *testing.T, reason string) {
+	t.Helper()
+	if CurrentMode(t) == ModeRendered {
+		t.Skipf("skipping in %s mode: %s", ModeRendered, reason)
+	}
+}
+
+// Backend is the object store behind an Env. The generated Get* methods and
+// AssertPartial/AssertNone read through Client and DynamicClient, so the same
+// test tables run unchanged against either implementation.
+type Backend interface {
+	// Mode reports which implementation this is.
+	Mode() Mode
+	// Client returns the typed client used for built-in Kubernetes kinds.
+	Client() kubernetes.Interface
+	// DynamicClient returns the client used for CRD-backed kinds such as
+	// Gateway API routes and ServiceMonitors.
+	DynamicClient() dynamic.Interface
+	// InstallChart installs the chart at chartPath as releaseName and makes
+	// the resulting objects readable. Each of vals is passed as a values
+	// file, in order, so later ones override earlier ones.
+	InstallChart(t *testing.T, chartPath, releaseName string, vals ...*values.Values) error
+}
+
+// liveBackend reads from the K3s cluster and installs releases with the helm
+// binary.
+type liveBackend struct {
+	kube    *k8s.KubectlOptions
+	client  kubernetes.Interface
+	dynamic dynamic.Interface
+}
+
+func (b *liveBackend) Mode() Mode                       { return ModeLive }
+func (b *liveBackend) Client() kubernetes.Interface     { return b.client }
+func (b *liveBackend) DynamicClient() dynamic.Interface { return b.dynamic }
+
+func (b *liveBackend) InstallChart(t *testing.T, chartPath, releaseName string, vals ...*values.Values) error {
+	t.Helper()
+	helmOptions := &helm.Options{
+		KubectlOptions: b.kube,
+		ValuesFiles:    valuesFiles(t, vals),
+		ExtraArgs: map[string][]string{
+			"upgrade": {"--install", "--wait", "--timeout", "30m"},
+		},
+	}
+	return helm.UpgradeE(t, helmOptions, chartPath, releaseName)
+}
+
+// renderedBackend renders releases with the Helm SDK and stores the decoded
+// objects in fake clientsets. Hooks are stored alongside regular resources,
+// matching the output of `helm template`.
+type renderedBackend struct {
+	namespace string
+	client    *kubefake.Clientset
+	dynamic   *dynamicfake.FakeDynamicClient
+}
+
+// newRenderedBackend returns an empty rendered backend. The dynamic client
+// stores everything as unstructured objects and is told the list kinds of the
+// CRD-backed resources, which it would otherwise guess from their plurals.
+func newRenderedBackend(namespace string) *renderedBackend {
+	return &renderedBackend{
+		namespace: namespace,
+		client:    kubefake.NewClientset(),
+		dynamic:   dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), crdListKinds),
+	}
+}
+
+func (b *renderedBackend) Mode() Mode                       { return ModeRendered }
+func (b *renderedBackend) Client() kubernetes.Interface     { return b.client }
+func (b *renderedBackend) DynamicClient() dynamic.Interface { return b.dynamic }
+
+func (b *renderedBackend) InstallChart(t *testing.T, chartPath, releaseName string, vals ...*values.Values) error {
+	t.Helper()
+	manifest, err := render.ChartE(
+		render.WithChartPath(chartPath),
+		render.WithRelease(releaseName),
+		render.WithNamespace(b.namespace),
+		render.WithValuesFiles(valuesFiles(t, vals)...),
+	)
+	if err != nil {
+		return err
+	}
+	for _, obj := range manifest.Objects {
+		if err := b.add(obj); err != nil {
+			return fmt.Errorf("storing rendered %s: %w", render.Kind(obj), err)
+		}
+	}
+	return nil
+}
+
+// valuesFiles writes each non-nil layer of values to a file.
+func valuesFiles(t *testing.T, vals []*values.Values) []string {
+	t.Helper()
+	files := make([]string, 0, len(vals))
+	for _, v := range vals {
+		if v != nil {
+			files = append(files, v.WriteFile(t))
+		}
+	}
+	return files
+}
+
+// add stores obj in the typed clientset when client-go knows its kind, and in
+// the dynamic client otherwise. Namespaced objects rendered without a
+// namespace are placed in the backend's namespace, as the API server would.
+func (b *renderedBackend) add(obj runtime.Object) error {
+	obj = obj.DeepCopyObject()
+	gvk := obj.GetObjectKind().GroupVersionKind()
+
+	accessor, err := meta.Accessor(obj)
+	if err != nil {
+		return err
+	}
+	if accessor.GetNamespace() == "" && !clusterScopedKinds[gvk.Kind] {
+		accessor.SetNamespace(b.namespace)
+	}
+
+	if scheme.Scheme.Recognizes(gvk) {
+		return b.client.Tracker().Add(obj)
+	}
+	if _, ok := obj.(*unstructured.Unstructured); !ok {
+		content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+		if err != nil {
+			return err
+		}
+		obj = &unstructured.Unstructured{Object: content}
+	}
+	return b.dynamic.Tracker().Add(obj)
+}
+
+// clusterScopedKinds lists the built-in kinds that have no namespace. The
+// chart itself renders none of them, but extraManifests may.
+var clusterScopedKinds = map[string]bool{
+	"ClusterRole":                    true,
+	"ClusterRoleBinding":             true,
+	"CustomResourceDefinition":       true,
+	"GatewayClass":                   true,
+	"IngressClass":                   true,
+	"MutatingWebhookConfiguration":   true,
+	"Namespace":                      true,
+	"PersistentVolume":               true,
+	"PriorityClass":                  true,
+	"StorageClass":                   true,
+	"ValidatingWebhookConfiguration": true,
+}
@@ -0,0 +1,22 @@
+package support
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestCurrentModeERejectsUnknownModes checks that a mistyped ModeEnvVar is
+// reported as an error naming the valid modes.
+func TestCurrentModeERejectsUnknownModes(t *testing.T) {
+	for value, want := range map[string]Mode{"": ModeLive, "live": ModeLive, "rendered": ModeRendered} {
+		t.Setenv(ModeEnvVar, value)
+		mode, err := CurrentModeE()
+		require.NoError(t, err, "%s=%q", ModeEnvVar, value)
+		require.Equal(t, want, mode, "%s=%q", ModeEnvVar, value)
+	}
+
+	t.Setenv(ModeEnvVar, "renderd")
+	_, err := CurrentModeE()
+	require.ErrorContains(t, err, `unknown mode "renderd" (want "live" or "rendered")`)
+}
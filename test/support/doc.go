@@ -1,5 +1,5 @@
-// Package support provides test helpers for fetching and asserting
-// Kubernetes resources in integration and smoke tests.
+// Package support provides test helpers for fetching, listing, waiting for and
+// asserting Kubernetes resources in integration and smoke tests.
 //
-//go:generate go run ../../internal/gen/supportgen -out zz_generated.go -package support
+//go:generate go run ../../internal/gen/supportgen -crds crds.txt -out zz_generated.go -package support
 package support
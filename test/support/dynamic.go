@@ -0,0 +1,63 @@
+package support
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/dynamic"
+)
+
+// getDynamic fetches the named object of a CRD-backed resource and converts
+// it to T.
+func getDynamic[T any](ctx context.Context, client dynamic.ResourceInterface, name string) (*T, error) {
+	obj, err := client.Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	return fromUnstructured[T](obj)
+}
+
+// listDynamic lists the objects of a CRD-backed resource and converts them
+// to T.
+func listDynamic[T any](ctx context.Context, client dynamic.ResourceInterface, opts metav1.ListOptions) ([]T, error) {
+	list, err := client.List(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	items := make([]T, 0, len(list.Items))
+	for i := range list.Items {
+		item, err := fromUnstructured[T](&list.Items[i])
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, *item)
+	}
+	return items, nil
+}
+
+// waitForDynamic is waitFor for CRD-backed resources: it watches the
+// unstructured objects and hands ready their typed form.
+func waitForDynamic[T any](t *testing.T, env *Env, kind, name string, client dynamic.ResourceInterface, ready func(*T) bool, timeout time.Duration) *T {
+	t.Helper()
+	obj := waitFor(t, env, kind, name, client, func(u *unstructured.Unstructured) bool {
+		typed, err := fromUnstructured[T](u)
+		return err == nil && (ready == nil || ready(typed))
+	}, timeout)
+	typed, err := fromUnstructured[T](obj)
+	if err != nil {
+		t.Fatalf("failed to convert %s %q: %v", kind, name, err)
+	}
+	return typed
+}
+
+func fromUnstructured[T any](obj *unstructured.Unstructured) (*T, error) {
+	var typed T
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, &typed); err != nil {
+		return nil, err
+	}
+	return &typed, nil
+}
@@ -12,11 +12,15 @@ import (
 // Env represents a per-test environment created by WithNamespace. It provides
 // namespace-scoped kubectl options, a Kubernetes client, a timeout-scoped
 // context, and a logger for consistent test output across test execution.
+//
+// Client and DynamicClient are the Backend's clients. In ModeRendered they
+// are backed by an in-memory object store and Kube points at no cluster.
 type Env struct {
 	Ctx           context.Context
 	Namespace     string
 	Kube          *k8s.KubectlOptions
-	Client        *kubernetes.Clientset
+	Client        kubernetes.Interface
 	DynamicClient dynamic.Interface
+	Backend       Backend
 	Logger        *logger.Logger
 }
@@ -0,0 +1,165 @@
+package support
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/tools/cache"
+	watchtools "k8s.io/client-go/tools/watch"
+
+	"github.com/zitadel/zitadel-charts/test/assert"
+)
+
+// pollInterval is how often the Eventually assertions re-fetch a resource.
+var pollInterval = time.Second
+
+// ListOption narrows the objects returned by the generated List methods.
+type ListOption func(*metav1.ListOptions)
+
+// WithLabelSelector restricts a list to objects matching a label selector,
+// e.g. "app.kubernetes.io/component=start".
+func WithLabelSelector(selector string) ListOption {
+	return func(o *metav1.ListOptions) {
+		o.LabelSelector = selector
+	}
+}
+
+// WithFieldSelector restricts a list to objects matching a field selector,
+// e.g. "status.phase=Running".
+func WithFieldSelector(selector string) ListOption {
+	return func(o *metav1.ListOptions) {
+		o.FieldSelector = selector
+	}
+}
+
+func listOptions(opts []ListOption) metav1.ListOptions {
+	var o metav1.ListOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return o
+}
+
+// listWatcher is the List and Watch half of a typed client-go resource
+// interface, e.g. appsv1.DeploymentInterface.
+type listWatcher[L runtime.Object] interface {
+	List(ctx context.Context, opts metav1.ListOptions) (L, error)
+	Watch(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error)
+}
+
+// waitFor runs an informer on the single object called name and returns it
+// as soon as ready reports true for it. A nil ready waits for the object to
+// exist. The test fails after timeout, reporting the last state observed.
+func waitFor[T runtime.Object, L runtime.Object](t *testing.T, env *Env, kind, name string, client listWatcher[L], ready func(T) bool, timeout time.Duration) T {
+	t.Helper()
+
+	byName := fields.OneTermEqualSelector("metadata.name", name).String()
+	lw := &cache.ListWatch{
+		ListWithContextFunc: func(ctx context.Context, opts metav1.ListOptions) (runtime.Object, error) {
+			opts.FieldSelector = byName
+			return client.List(ctx, opts)
+		},
+		WatchFuncWithContext: func(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error) {
+			opts.FieldSelector = byName
+			return client.Watch(ctx, opts)
+		},
+	}
+
+	ctx, cancel := context.WithTimeout(env.Ctx, timeout)
+	defer cancel()
+
+	// The typed and dynamic clients always share a backend, so env.Client
+	// tells whether watch-list semantics are supported for either.
+	var last T
+	var seen bool
+	_, err := watchtools.UntilWithSync(ctx, cache.ToListWatcherWithWatchListSemantics(lw, env.Client), nil, nil,
+		func(event watch.Event) (bool, error) {
+			obj, ok := event.Object.(T)
+			if !ok {
+				return false, nil
+			}
+			// Not every backend honours the field selector.
+			if accessor, err := meta.Accessor(obj); err != nil || accessor.GetName() != name {
+				return false, nil
+			}
+			if event.Type == watch.Deleted {
+				seen = false
+				return false, nil
+			}
+			last, seen = obj, true
+			return ready == nil || ready(obj), nil
+		},
+	)
+	if err != nil {
+		if !wait.Interrupted(err) {
+			require.NoError(t, err, "failed to watch %s %s", kind, name)
+		}
+		if !seen {
+			t.Fatalf("timed out after %s waiting for %s %q: it does not exist", timeout, kind, name)
+		}
+		t.Fatalf("timed out after %s waiting for %s %q to become ready; last state:\n%+v", timeout, kind, name, last)
+	}
+	return last
+}
+
+// AssertPartialEventually is like AssertPartial but re-fetches the resource
+// until the assertion holds, for resources that controllers are still
+// reconciling. After timeout it fails with every mismatching field of the
+// last version it fetched.
+func (env *Env) AssertPartialEventually(t *testing.T, name string, assertion assert.Assertable, timeout time.Duration) {
+	t.Helper()
+
+	var last any
+	var lastErr error
+	err := wait.PollUntilContextTimeout(env.Ctx, pollInterval, timeout, true, func(context.Context) (bool, error) {
+		last, lastErr = env.fetchE(t, name, assertion)
+		if lastErr != nil {
+			return false, nil
+		}
+		return len(assert.Mismatches(last, assertion)) == 0, nil
+	})
+	if err == nil {
+		return
+	}
+	require.NoError(t, lastErr, "failed to fetch %q within %s", name, timeout)
+	assert.AssertPartialAll(t, last, assertion, "assertion did not hold within %s", timeout)
+}
+
+// Eventually calls check until it succeeds, for conditions that are not the
+// state of a single resource, such as an endpoint answering through the
+// ingress. After timeout it fails with the last error check returned.
+func (env *Env) Eventually(t *testing.T, check func(ctx context.Context) error, timeout time.Duration, msgAndArgs ...any) {
+	t.Helper()
+
+	var lastErr error
+	err := wait.PollUntilContextTimeout(env.Ctx, pollInterval, timeout, true, func(ctx context.Context) (bool, error) {
+		lastErr = check(ctx)
+		return lastErr == nil, nil
+	})
+	if err != nil {
+		require.NoError(t, lastErr, msgAndArgs...)
+		require.NoError(t, err, msgAndArgs...)
+	}
+}
+
+// AssertNoneEventually is like AssertNone but waits up to timeout for the
+// resource to be deleted.
+func (env *Env) AssertNoneEventually(t *testing.T, name string, assertion assert.Assertable, timeout time.Duration) {
+	t.Helper()
+
+	var lastErr error
+	err := wait.PollUntilContextTimeout(env.Ctx, pollInterval, timeout, true, func(context.Context) (bool, error) {
+		_, lastErr = env.fetchE(t, name, assertion)
+		return apierrors.IsNotFound(lastErr), nil
+	})
+	require.NoError(t, err, "%q still exists after %s (last error: %v)", name, timeout, lastErr)
+}
@@ -0,0 +1,206 @@
+package support
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gruntwork-io/terratest/modules/k8s"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	k8stesting "k8s.io/client-go/testing"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/zitadel/zitadel-charts/test/assert"
+)
+
+// newTestEnv returns an Env backed by empty fake clients.
+func newTestEnv(t *testing.T) *Env {
+	t.Helper()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	t.Cleanup(cancel)
+	backend := newRenderedBackend("wait-test")
+	return &Env{
+		Ctx:           ctx,
+		Namespace:     "wait-test",
+		Kube:          k8s.NewKubectlOptions("", "", "wait-test"),
+		Client:        backend.Client(),
+		DynamicClient: backend.DynamicClient(),
+		Backend:       backend,
+	}
+}
+
+func deployment(name string, labels map[string]string) *appsv1.Deployment {
+	return &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "wait-test", Labels: labels}}
+}
+
+// updateWhenWatched applies update to the named Deployment as soon as a watch
+// on Deployments is open, as a controller reconciling it would. The watch is
+// registered with the tracker before the update, so the event cannot be
+// missed and no delay is needed.
+func updateWhenWatched(t *testing.T, env *Env, name string, update func(*appsv1.Deployment)) {
+	t.Helper()
+	client := env.Backend.(*renderedBackend).client
+	watching := make(chan struct{})
+	var once sync.Once
+	client.PrependWatchReactor("deployments", func(action k8stesting.Action) (bool, watch.Interface, error) {
+		w, err := client.Tracker().Watch(action.GetResource(), action.GetNamespace())
+		once.Do(func() { close(watching) })
+		return true, w, err
+	})
+	updateAfter(env, watching, name, update)
+}
+
+// updateWhenFetched applies update to the named Deployment once it has been
+// fetched, so a poll sees the old version first and the update on a later
+// attempt.
+func updateWhenFetched(t *testing.T, env *Env, name string, update func(*appsv1.Deployment)) {
+	t.Helper()
+	client := env.Backend.(*renderedBackend).client
+	fetched := make(chan struct{})
+	var once sync.Once
+	client.PrependReactor("get", "deployments", func(k8stesting.Action) (bool, runtime.Object, error) {
+		once.Do(func() { close(fetched) })
+		return false, nil, nil
+	})
+	updateAfter(env, fetched, name, update)
+}
+
+// updateAfter applies update to the named Deployment once signal is closed.
+func updateAfter(env *Env, signal <-chan struct{}, name string, update func(*appsv1.Deployment)) {
+	go func() {
+		select {
+		case <-signal:
+		case <-env.Ctx.Done():
+			return
+		}
+		d, err := env.Client.AppsV1().Deployments(env.Namespace).Get(env.Ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return
+		}
+		update(d)
+		_, _ = env.Client.AppsV1().Deployments(env.Namespace).Update(env.Ctx, d, metav1.UpdateOptions{})
+	}()
+}
+
+// TestListFiltersByLabel checks that List helpers apply WithLabelSelector.
+func TestListFiltersByLabel(t *testing.T) {
+	t.Parallel()
+	env := newTestEnv(t)
+	for _, d := range []*appsv1.Deployment{
+		deployment("zitadel", map[string]string{"app.kubernetes.io/component": "start"}),
+		deployment("zitadel-login", map[string]string{"app.kubernetes.io/component": "login"}),
+	} {
+		_, err := env.Client.AppsV1().Deployments(env.Namespace).Create(env.Ctx, d, metav1.CreateOptions{})
+		require.NoError(t, err)
+	}
+
+	require.Len(t, env.ListDeployments(t), 2)
+	items := env.ListDeployments(t, WithLabelSelector("app.kubernetes.io/component=login"))
+	require.Len(t, items, 1)
+	require.Equal(t, "zitadel-login", items[0].Name)
+}
+
+// TestWaitForObservesUpdates checks that WaitFor returns once an update makes
+// the object ready.
+func TestWaitForObservesUpdates(t *testing.T) {
+	t.Parallel()
+	env := newTestEnv(t)
+	_, err := env.Client.AppsV1().Deployments(env.Namespace).Create(env.Ctx, deployment("zitadel", nil), metav1.CreateOptions{})
+	require.NoError(t, err)
+
+	updateWhenWatched(t, env, "zitadel", func(d *appsv1.Deployment) { d.Status.ReadyReplicas = 1 })
+
+	d := env.WaitForDeployment(t, "zitadel", func(d *appsv1.Deployment) bool {
+		return d.Status.ReadyReplicas == 1
+	}, 10*time.Second)
+	require.Equal(t, int32(1), d.Status.ReadyReplicas)
+}
+
+// TestAssertPartialEventuallyRetries checks that AssertPartialEventually passes
+// once an update makes the object match.
+func TestAssertPartialEventuallyRetries(t *testing.T) {
+	t.Parallel()
+	env := newTestEnv(t)
+	_, err := env.Client.AppsV1().Deployments(env.Namespace).Create(env.Ctx, deployment("zitadel", nil), metav1.CreateOptions{})
+	require.NoError(t, err)
+
+	updateWhenFetched(t, env, "zitadel", func(d *appsv1.Deployment) { d.Labels = map[string]string{"reconciled": "true"} })
+
+	env.AssertPartialEventually(t, "zitadel", assert.DeploymentAssertion{
+		ObjectMeta: assert.ObjectMetaAssertion{
+			Labels: assert.Some(map[string]string{"reconciled": "true"}),
+		},
+	}, 10*time.Second)
+}
+
+// TestEventuallyRetriesUntilCheckPasses checks that Eventually calls a
+// failing check again until it passes.
+func TestEventuallyRetriesUntilCheckPasses(t *testing.T) {
+	t.Parallel()
+	env := newTestEnv(t)
+
+	calls := 0
+	env.Eventually(t, func(context.Context) error {
+		if calls++; calls < 2 {
+			return errors.New("not yet")
+		}
+		return nil
+	}, 10*time.Second)
+	require.Equal(t, 2, calls)
+}
+
+// TestCRDHelpersUseDynamicClient checks that the CRD helpers read through the
+// dynamic client.
+func TestCRDHelpersUseDynamicClient(t *testing.T) {
+	t.Parallel()
+	env := newTestEnv(t)
+	route := &gatewayv1.HTTPRoute{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "gateway.networking.k8s.io/v1", Kind: "HTTPRoute"},
+		ObjectMeta: metav1.ObjectMeta{Name: "zitadel", Namespace: env.Namespace},
+	}
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(route)
+	require.NoError(t, err)
+	_, err = env.DynamicClient.Resource(gvrHTTPRoutes).Namespace(env.Namespace).
+		Create(env.Ctx, &unstructured.Unstructured{Object: content}, metav1.CreateOptions{})
+	require.NoError(t, err)
+
+	require.Len(t, env.ListHTTPRoutes(t), 1)
+	require.Equal(t, "zitadel", env.WaitForHTTPRoute(t, "zitadel", nil, 10*time.Second).Name)
+	env.AssertPartial(t, "zitadel", assert.HTTPRouteAssertion{
+		ObjectMeta: assert.ObjectMetaAssertion{Name: assert.Some("zitadel")},
+	})
+	env.AssertNone(t, "zitadel", assert.GatewayAssertion{})
+}
+
+// TestRenderedBackendListsEveryCRD lists every CRD-backed resource through the
+// rendered backend's dynamic client, which panics for a resource whose list
+// kind it cannot resolve.
+func TestRenderedBackendListsEveryCRD(t *testing.T) {
+	t.Parallel()
+	env := newTestEnv(t)
+	for gvr, listKind := range crdListKinds {
+		list, err := env.DynamicClient.Resource(gvr).Namespace(env.Namespace).List(env.Ctx, metav1.ListOptions{})
+		require.NoError(t, err, "listing %s", gvr.Resource)
+		require.Equal(t, listKind, list.GetKind(), "listing %s", gvr.Resource)
+	}
+
+	gateway := &gatewayv1.Gateway{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "gateway.networking.k8s.io/v1", Kind: "Gateway"},
+		ObjectMeta: metav1.ObjectMeta{Name: "zitadel", Namespace: env.Namespace},
+	}
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(gateway)
+	require.NoError(t, err)
+	_, err = env.DynamicClient.Resource(gvrGateways).Namespace(env.Namespace).
+		Create(env.Ctx, &unstructured.Unstructured{Object: content}, metav1.CreateOptions{})
+	require.NoError(t, err)
+
+	require.Len(t, env.ListGateways(t), 1)
+	require.Equal(t, "zitadel", env.WaitForGateway(t, "zitadel", nil, 10*time.Second).Name)
+}
@@ -5,6 +5,7 @@ import (
 	"regexp"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/gruntwork-io/terratest/modules/k8s"
 	"github.com/gruntwork-io/terratest/modules/logger"
@@ -20,30 +21,69 @@ import (
 // a Kubernetes client and logger, and passes the resulting Env to the callback.
 // The namespace is cleaned up when the test finishes unless the test failed,
 // in which case it is preserved for debugging.
+//
+// In ModeRendered no cluster is contacted: the Env is backed by an in-memory
+// object store that InstallChart fills with the rendered release.
 func WithNamespace(t *testing.T, fn func(*Env)) {
 	t.Helper()
 
+	if CurrentMode(t) == ModeRendered {
+		withRenderedNamespace(t, fn)
+		return
+	}
+
 	testcluster.WithNamespace(t, func(ctx context.Context, k *k8s.KubectlOptions) {
-		client, err := k8s.GetKubernetesClientFromOptionsE(t, k)
-		require.NoError(t, err)
-
-		config, err := clientcmd.BuildConfigFromFlags("", k.ConfigPath)
-		require.NoError(t, err)
-		dynClient, err := dynamic.NewForConfig(config)
-		require.NoError(t, err)
-
-		env := &Env{
-			Ctx:           ctx,
-			Namespace:     k.Namespace,
-			Kube:          k,
-			Client:        client,
-			DynamicClient: dynClient,
-			Logger:        logger.New(logger.Terratest),
-		}
-		fn(env)
+		fn(NewEnv(ctx, t, k))
 	})
 }
 
+// NewEnv returns an Env for the existing namespace of k in a live cluster,
+// for suites that create their namespaces with testcluster.WithNamespace.
+func NewEnv(ctx context.Context, t *testing.T, k *k8s.KubectlOptions) *Env {
+	t.Helper()
+
+	client, err := k8s.GetKubernetesClientFromOptionsE(t, k)
+	require.NoError(t, err)
+
+	config, err := clientcmd.BuildConfigFromFlags("", k.ConfigPath)
+	require.NoError(t, err)
+	dynClient, err := dynamic.NewForConfig(config)
+	require.NoError(t, err)
+
+	return &Env{
+		Ctx:           ctx,
+		Namespace:     k.Namespace,
+		Kube:          k,
+		Client:        client,
+		DynamicClient: dynClient,
+		Backend:       &liveBackend{kube: k, client: client, dynamic: dynClient},
+		Logger:        logger.New(logger.Terratest),
+	}
+}
+
+// withRenderedNamespace is the ModeRendered counterpart of
+// testcluster.WithNamespace. The namespace only exists as a name passed to
+// the chart and used to scope the fake clients.
+func withRenderedNamespace(t *testing.T, fn func(*Env)) {
+	t.Helper()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	namespace := "zitadel-test-" + strings.ToLower(random.UniqueId())
+	backend := newRenderedBackend(namespace)
+
+	env := &Env{
+		Ctx:           ctx,
+		Namespace:     namespace,
+		Kube:          k8s.NewKubectlOptions("", "", namespace),
+		Client:        backend.Client(),
+		DynamicClient: backend.DynamicClient(),
+		Backend:       backend,
+		Logger:        logger.New(logger.Terratest),
+	}
+	fn(env)
+}
+
 var helmNameRegex = regexp.MustCompile(`[^a-z0-9\-]`)
 
 // MakeRelease generates a Helm-compatible release name by combining the base
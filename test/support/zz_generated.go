@@ -3,6 +3,7 @@
 package support
 
 import (
+	v119 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
 	require "github.com/stretchr/testify/require"
 	assert "github.com/zitadel/zitadel-charts/test/assert"
 	v1 "k8s.io/api/admissionregistration/v1"
@@ -57,7 +58,10 @@ import (
 	v1beta116 "k8s.io/api/storagemigration/v1beta1"
 	errors "k8s.io/apimachinery/pkg/api/errors"
 	v11 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	v118 "sigs.k8s.io/gateway-api/apis/v1"
 	"testing"
+	"time"
 )
 
 // GetMutatingWebhookConfiguration fetches a MutatingWebhookConfiguration by name, failing the test on error.
@@ -74,6 +78,32 @@ func (env *Env) GetMutatingWebhookConfigurationE(t *testing.T, name string) (*v1
 	return env.Client.AdmissionregistrationV1().MutatingWebhookConfigurations().Get(env.Ctx, name, v11.GetOptions{})
 }
 
+// ListMutatingWebhookConfigurations lists the MutatingWebhookConfigurations matching opts, failing the test on error.
+func (env *Env) ListMutatingWebhookConfigurations(t *testing.T, opts ...ListOption) []v1.MutatingWebhookConfiguration {
+	t.Helper()
+	items, err := env.ListMutatingWebhookConfigurationsE(t, opts...)
+	require.NoError(t, err, "failed to list MutatingWebhookConfigurations")
+	return items
+}
+
+// ListMutatingWebhookConfigurationsE lists the MutatingWebhookConfigurations matching opts, returning the error.
+func (env *Env) ListMutatingWebhookConfigurationsE(t *testing.T, opts ...ListOption) ([]v1.MutatingWebhookConfiguration, error) {
+	t.Helper()
+	list, err := env.Client.AdmissionregistrationV1().MutatingWebhookConfigurations().List(env.Ctx, listOptions(opts))
+	if err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+// WaitForMutatingWebhookConfiguration watches the MutatingWebhookConfiguration called name until ready reports true
+// for it and returns it. A nil ready waits for it to exist. The test fails
+// after timeout.
+func (env *Env) WaitForMutatingWebhookConfiguration(t *testing.T, name string, ready func(*v1.MutatingWebhookConfiguration) bool, timeout time.Duration) *v1.MutatingWebhookConfiguration {
+	t.Helper()
+	return waitFor(t, env, "MutatingWebhookConfiguration", name, env.Client.AdmissionregistrationV1().MutatingWebhookConfigurations(), ready, timeout)
+}
+
 // GetValidatingAdmissionPolicy fetches a ValidatingAdmissionPolicy by name, failing the test on error.
 func (env *Env) GetValidatingAdmissionPolicy(t *testing.T, name string) *v1.ValidatingAdmissionPolicy {
 	t.Helper()
@@ -88,6 +118,32 @@ func (env *Env) GetValidatingAdmissionPolicyE(t *testing.T, name string) (*v1.Va
 	return env.Client.AdmissionregistrationV1().ValidatingAdmissionPolicies().Get(env.Ctx, name, v11.GetOptions{})
 }
 
+// ListValidatingAdmissionPolicies lists the ValidatingAdmissionPolicies matching opts, failing the test on error.
+func (env *Env) ListValidatingAdmissionPolicies(t *testing.T, opts ...ListOption) []v1.ValidatingAdmissionPolicy {
+	t.Helper()
+	items, err := env.ListValidatingAdmissionPoliciesE(t, opts...)
+	require.NoError(t, err, "failed to list ValidatingAdmissionPolicies")
+	return items
+}
+
+// ListValidatingAdmissionPoliciesE lists the ValidatingAdmissionPolicies matching opts, returning the error.
+func (env *Env) ListValidatingAdmissionPoliciesE(t *testing.T, opts ...ListOption) ([]v1.ValidatingAdmissionPolicy, error) {
+	t.Helper()
+	list, err := env.Client.AdmissionregistrationV1().ValidatingAdmissionPolicies().List(env.Ctx, listOptions(opts))
+	if err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+// WaitForValidatingAdmissionPolicy watches the ValidatingAdmissionPolicy called name until ready reports true
+// for it and returns it. A nil ready waits for it to exist. The test fails
+// after timeout.
+func (env *Env) WaitForValidatingAdmissionPolicy(t *testing.T, name string, ready func(*v1.ValidatingAdmissionPolicy) bool, timeout time.Duration) *v1.ValidatingAdmissionPolicy {
+	t.Helper()
+	return waitFor(t, env, "ValidatingAdmissionPolicy", name, env.Client.AdmissionregistrationV1().ValidatingAdmissionPolicies(), ready, timeout)
+}
+
 // GetValidatingAdmissionPolicyBinding fetches a ValidatingAdmissionPolicyBinding by name, failing the test on error.
 func (env *Env) GetValidatingAdmissionPolicyBinding(t *testing.T, name string) *v1.ValidatingAdmissionPolicyBinding {
 	t.Helper()
@@ -102,6 +158,32 @@ func (env *Env) GetValidatingAdmissionPolicyBindingE(t *testing.T, name string)
 	return env.Client.AdmissionregistrationV1().ValidatingAdmissionPolicyBindings().Get(env.Ctx, name, v11.GetOptions{})
 }
 
+// ListValidatingAdmissionPolicyBindings lists the ValidatingAdmissionPolicyBindings matching opts, failing the test on error.
+func (env *Env) ListValidatingAdmissionPolicyBindings(t *testing.T, opts ...ListOption) []v1.ValidatingAdmissionPolicyBinding {
+	t.Helper()
+	items, err := env.ListValidatingAdmissionPolicyBindingsE(t, opts...)
+	require.NoError(t, err, "failed to list ValidatingAdmissionPolicyBindings")
+	return items
+}
+
+// ListValidatingAdmissionPolicyBindingsE lists the ValidatingAdmissionPolicyBindings matching opts, returning the error.
+func (env *Env) ListValidatingAdmissionPolicyBindingsE(t *testing.T, opts ...ListOption) ([]v1.ValidatingAdmissionPolicyBinding, error) {
+	t.Helper()
+	list, err := env.Client.AdmissionregistrationV1().ValidatingAdmissionPolicyBindings().List(env.Ctx, listOptions(opts))
+	if err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+// WaitForValidatingAdmissionPolicyBinding watches the ValidatingAdmissionPolicyBinding called name until ready reports true
+// for it and returns it. A nil ready waits for it to exist. The test fails
+// after timeout.
+func (env *Env) WaitForValidatingAdmissionPolicyBinding(t *testing.T, name string, ready func(*v1.ValidatingAdmissionPolicyBinding) bool, timeout time.Duration) *v1.ValidatingAdmissionPolicyBinding {
+	t.Helper()
+	return waitFor(t, env, "ValidatingAdmissionPolicyBinding", name, env.Client.AdmissionregistrationV1().ValidatingAdmissionPolicyBindings(), ready, timeout)
+}
+
 // GetValidatingWebhookConfiguration fetches a ValidatingWebhookConfiguration by name, failing the test on error.
 func (env *Env) GetValidatingWebhookConfiguration(t *testing.T, name string) *v1.ValidatingWebhookConfiguration {
 	t.Helper()
@@ -116,6 +198,32 @@ func (env *Env) GetValidatingWebhookConfigurationE(t *testing.T, name string) (*
 	return env.Client.AdmissionregistrationV1().ValidatingWebhookConfigurations().Get(env.Ctx, name, v11.GetOptions{})
 }
 
+// ListValidatingWebhookConfigurations lists the ValidatingWebhookConfigurations matching opts, failing the test on error.
+func (env *Env) ListValidatingWebhookConfigurations(t *testing.T, opts ...ListOption) []v1.ValidatingWebhookConfiguration {
+	t.Helper()
+	items, err := env.ListValidatingWebhookConfigurationsE(t, opts...)
+	require.NoError(t, err, "failed to list ValidatingWebhookConfigurations")
+	return items
+}
+
+// ListValidatingWebhookConfigurationsE lists the ValidatingWebhookConfigurations matching opts, returning the error.
+func (env *Env) ListValidatingWebhookConfigurationsE(t *testing.T, opts ...ListOption) ([]v1.ValidatingWebhookConfiguration, error) {
+	t.Helper()
+	list, err := env.Client.AdmissionregistrationV1().ValidatingWebhookConfigurations().List(env.Ctx, listOptions(opts))
+	if err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+// WaitForValidatingWebhookConfiguration watches the ValidatingWebhookConfiguration called name until ready reports true
+// for it and returns it. A nil ready waits for it to exist. The test fails
+// after timeout.
+func (env *Env) WaitForValidatingWebhookConfiguration(t *testing.T, name string, ready func(*v1.ValidatingWebhookConfiguration) bool, timeout time.Duration) *v1.ValidatingWebhookConfiguration {
+	t.Helper()
+	return waitFor(t, env, "ValidatingWebhookConfiguration", name, env.Client.AdmissionregistrationV1().ValidatingWebhookConfigurations(), ready, timeout)
+}
+
 // GetAdmissionregistrationV1alpha1MutatingAdmissionPolicy fetches a AdmissionregistrationV1alpha1MutatingAdmissionPolicy by name, failing the test on error.
 func (env *Env) GetAdmissionregistrationV1alpha1MutatingAdmissionPolicy(t *testing.T, name string) *v1alpha1.MutatingAdmissionPolicy {
 	t.Helper()
@@ -130,6 +238,32 @@ func (env *Env) GetAdmissionregistrationV1alpha1MutatingAdmissionPolicyE(t *test
 	return env.Client.AdmissionregistrationV1alpha1().MutatingAdmissionPolicies().Get(env.Ctx, name, v11.GetOptions{})
 }
 
+// ListAdmissionregistrationV1alpha1MutatingAdmissionPolicies lists the AdmissionregistrationV1alpha1MutatingAdmissionPolicies matching opts, failing the test on error.
+func (env *Env) ListAdmissionregistrationV1alpha1MutatingAdmissionPolicies(t *testing.T, opts ...ListOption) []v1alpha1.MutatingAdmissionPolicy {
+	t.Helper()
+	items, err := env.ListAdmissionregistrationV1alpha1MutatingAdmissionPoliciesE(t, opts...)
+	require.NoError(t, err, "failed to list AdmissionregistrationV1alpha1MutatingAdmissionPolicies")
+	return items
+}
+
+// ListAdmissionregistrationV1alpha1MutatingAdmissionPoliciesE lists the AdmissionregistrationV1alpha1MutatingAdmissionPolicies matching opts, returning the error.
+func (env *Env) ListAdmissionregistrationV1alpha1MutatingAdmissionPoliciesE(t *testing.T, opts ...ListOption) ([]v1alpha1.MutatingAdmissionPolicy, error) {
+	t.Helper()
+	list, err := env.Client.AdmissionregistrationV1alpha1().MutatingAdmissionPolicies().List(env.Ctx, listOptions(opts))
+	if err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+// WaitForAdmissionregistrationV1alpha1MutatingAdmissionPolicy watches the AdmissionregistrationV1alpha1MutatingAdmissionPolicy called name until ready reports true
+// for it and returns it. A nil ready waits for it to exist. The test fails
+// after timeout.
+func (env *Env) WaitForAdmissionregistrationV1alpha1MutatingAdmissionPolicy(t *testing.T, name string, ready func(*v1alpha1.MutatingAdmissionPolicy) bool, timeout time.Duration) *v1alpha1.MutatingAdmissionPolicy {
+	t.Helper()
+	return waitFor(t, env, "MutatingAdmissionPolicy", name, env.Client.AdmissionregistrationV1alpha1().MutatingAdmissionPolicies(), ready, timeout)
+}
+
 // GetAdmissionregistrationV1alpha1MutatingAdmissionPolicyBinding fetches a AdmissionregistrationV1alpha1MutatingAdmissionPolicyBinding by name, failing the test on error.
 func (env *Env) GetAdmissionregistrationV1alpha1MutatingAdmissionPolicyBinding(t *testing.T, name string) *v1alpha1.MutatingAdmissionPolicyBinding {
 	t.Helper()
@@ -144,6 +278,32 @@ func (env *Env) GetAdmissionregistrationV1alpha1MutatingAdmissionPolicyBindingE(
 	return env.Client.AdmissionregistrationV1alpha1().MutatingAdmissionPolicyBindings().Get(env.Ctx, name, v11.GetOptions{})
 }
 
+// ListAdmissionregistrationV1alpha1MutatingAdmissionPolicyBindings lists the AdmissionregistrationV1alpha1MutatingAdmissionPolicyBindings matching opts, failing the test on error.
+func (env *Env) ListAdmissionregistrationV1alpha1MutatingAdmissionPolicyBindings(t *testing.T, opts ...ListOption) []v1alpha1.MutatingAdmissionPolicyBinding {
+	t.Helper()
+	items, err := env.ListAdmissionregistrationV1alpha1MutatingAdmissionPolicyBindingsE(t, opts...)
+	require.NoError(t, err, "failed to list AdmissionregistrationV1alpha1MutatingAdmissionPolicyBindings")
+	return items
+}
+
+// ListAdmissionregistrationV1alpha1MutatingAdmissionPolicyBindingsE lists the AdmissionregistrationV1alpha1MutatingAdmissionPolicyBindings matching opts, returning the error.
+func (env *Env) ListAdmissionregistrationV1alpha1MutatingAdmissionPolicyBindingsE(t *testing.T, opts ...ListOption) ([]v1alpha1.MutatingAdmissionPolicyBinding, error) {
+	t.Helper()
+	list, err := env.Client.AdmissionregistrationV1alpha1().MutatingAdmissionPolicyBindings().List(env.Ctx, listOptions(opts))
+	if err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+// WaitForAdmissionregistrationV1alpha1MutatingAdmissionPolicyBinding watches the AdmissionregistrationV1alpha1MutatingAdmissionPolicyBinding called name until ready reports true
+// for it and returns it. A nil ready waits for it to exist. The test fails
+// after timeout.
+func (env *Env) WaitForAdmissionregistrationV1alpha1MutatingAdmissionPolicyBinding(t *testing.T, name string, ready func(*v1alpha1.MutatingAdmissionPolicyBinding) bool, timeout time.Duration) *v1alpha1.MutatingAdmissionPolicyBinding {
+	t.Helper()
+	return waitFor(t, env, "MutatingAdmissionPolicyBinding", name, env.Client.AdmissionregistrationV1alpha1().MutatingAdmissionPolicyBindings(), ready, timeout)
+}
+
 // GetAdmissionregistrationV1alpha1ValidatingAdmissionPolicy fetches a AdmissionregistrationV1alpha1ValidatingAdmissionPolicy by name, failing the test on error.
 func (env *Env) GetAdmissionregistrationV1alpha1ValidatingAdmissionPolicy(t *testing.T, name string) *v1alpha1.ValidatingAdmissionPolicy {
 	t.Helper()
@@ -158,6 +318,32 @@ func (env *Env) GetAdmissionregistrationV1alpha1ValidatingAdmissionPolicyE(t *te
 	return env.Client.AdmissionregistrationV1alpha1().ValidatingAdmissionPolicies().Get(env.Ctx, name, v11.GetOptions{})
 }
 
+// ListAdmissionregistrationV1alpha1ValidatingAdmissionPolicies lists the AdmissionregistrationV1alpha1ValidatingAdmissionPolicies matching opts, failing the test on error.
+func (env *Env) ListAdmissionregistrationV1alpha1ValidatingAdmissionPolicies(t *testing.T, opts ...ListOption) []v1alpha1.ValidatingAdmissionPolicy {
+	t.Helper()
+	items, err := env.ListAdmissionregistrationV1alpha1ValidatingAdmissionPoliciesE(t, opts...)
+	require.NoError(t, err, "failed to list AdmissionregistrationV1alpha1ValidatingAdmissionPolicies")
+	return items
+}
+
+// ListAdmissionregistrationV1alpha1ValidatingAdmissionPoliciesE lists the AdmissionregistrationV1alpha1ValidatingAdmissionPolicies matching opts, returning the error.
+func (env *Env) ListAdmissionregistrationV1alpha1ValidatingAdmissionPoliciesE(t *testing.T, opts ...ListOption) ([]v1alpha1.ValidatingAdmissionPolicy, error) {
+	t.Helper()
+	list, err := env.Client.AdmissionregistrationV1alpha1().ValidatingAdmissionPolicies().List(env.Ctx, listOptions(opts))
+	if err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+// WaitForAdmissionregistrationV1alpha1ValidatingAdmissionPolicy watches the AdmissionregistrationV1alpha1ValidatingAdmissionPolicy called name until ready reports true
+// for it and returns it. A nil ready waits for it to exist. The test fails
+// after timeout.
+func (env *Env) WaitForAdmissionregistrationV1alpha1ValidatingAdmissionPolicy(t *testing.T, name string, ready func(*v1alpha1.ValidatingAdmissionPolicy) bool, timeout time.Duration) *v1alpha1.ValidatingAdmissionPolicy {
+	t.Helper()
+	return waitFor(t, env, "ValidatingAdmissionPolicy", name, env.Client.AdmissionregistrationV1alpha1().ValidatingAdmissionPolicies(), ready, timeout)
+}
+
 // GetAdmissionregistrationV1alpha1ValidatingAdmissionPolicyBinding fetches a AdmissionregistrationV1alpha1ValidatingAdmissionPolicyBinding by name, failing the test on error.
 func (env *Env) GetAdmissionregistrationV1alpha1ValidatingAdmissionPolicyBinding(t *testing.T, name string) *v1alpha1.ValidatingAdmissionPolicyBinding {
 	t.Helper()
@@ -172,6 +358,32 @@ func (env *Env) GetAdmissionregistrationV1alpha1ValidatingAdmissionPolicyBinding
 	return env.Client.AdmissionregistrationV1alpha1().ValidatingAdmissionPolicyBindings().Get(env.Ctx, name, v11.GetOptions{})
 }
 
+// ListAdmissionregistrationV1alpha1ValidatingAdmissionPolicyBindings lists the AdmissionregistrationV1alpha1ValidatingAdmissionPolicyBindings matching opts, failing the test on error.
+func (env *Env) ListAdmissionregistrationV1alpha1ValidatingAdmissionPolicyBindings(t *testing.T, opts ...ListOption) []v1alpha1.ValidatingAdmissionPolicyBinding {
+	t.Helper()
+	items, err := env.ListAdmissionregistrationV1alpha1ValidatingAdmissionPolicyBindingsE(t, opts...)
+	require.NoError(t, err, "failed to list AdmissionregistrationV1alpha1ValidatingAdmissionPolicyBindings")
+	return items
+}
+
+// ListAdmissionregistrationV1alpha1ValidatingAdmissionPolicyBindingsE lists the AdmissionregistrationV1alpha1ValidatingAdmissionPolicyBindings matching opts, returning the error.
+func (env *Env) ListAdmissionregistrationV1alpha1ValidatingAdmissionPolicyBindingsE(t *testing.T, opts ...ListOption) ([]v1alpha1.ValidatingAdmissionPolicyBinding, error) {
+	t.Helper()
+	list, err := env.Client.AdmissionregistrationV1alpha1().ValidatingAdmissionPolicyBindings().List(env.Ctx, listOptions(opts))
+	if err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+// WaitForAdmissionregistrationV1alpha1ValidatingAdmissionPolicyBinding watches the AdmissionregistrationV1alpha1ValidatingAdmissionPolicyBinding called name until ready reports true
+// for it and returns it. A nil ready waits for it to exist. The test fails
+// after timeout.
+func (env *Env) WaitForAdmissionregistrationV1alpha1ValidatingAdmissionPolicyBinding(t *testing.T, name string, ready func(*v1alpha1.ValidatingAdmissionPolicyBinding) bool, timeout time.Duration) *v1alpha1.ValidatingAdmissionPolicyBinding {
+	t.Helper()
+	return waitFor(t, env, "ValidatingAdmissionPolicyBinding", name, env.Client.AdmissionregistrationV1alpha1().ValidatingAdmissionPolicyBindings(), ready, timeout)
+}
+
 // GetAdmissionregistrationV1beta1MutatingWebhookConfiguration fetches a AdmissionregistrationV1beta1MutatingWebhookConfiguration by name, failing the test on error.
 func (env *Env) GetAdmissionregistrationV1beta1MutatingWebhookConfiguration(t *testing.T, name string) *v1beta1.MutatingWebhookConfiguration {
 	t.Helper()
@@ -186,6 +398,32 @@ func (env *Env) GetAdmissionregistrationV1beta1MutatingWebhookConfigurationE(t *
 	return env.Client.AdmissionregistrationV1beta1().MutatingWebhookConfigurations().Get(env.Ctx, name, v11.GetOptions{})
 }
 
+// ListAdmissionregistrationV1beta1MutatingWebhookConfigurations lists the AdmissionregistrationV1beta1MutatingWebhookConfigurations matching opts, failing the test on error.
+func (env *Env) ListAdmissionregistrationV1beta1MutatingWebhookConfigurations(t *testing.T, opts ...ListOption) []v1beta1.MutatingWebhookConfiguration {
+	t.Helper()
+	items, err := env.ListAdmissionregistrationV1beta1MutatingWebhookConfigurationsE(t, opts...)
+	require.NoError(t, err, "failed to list AdmissionregistrationV1beta1MutatingWebhookConfigurations")
+	return items
+}
+
+// ListAdmissionregistrationV1beta1MutatingWebhookConfigurationsE lists the AdmissionregistrationV1beta1MutatingWebhookConfigurations matching opts, returning the error.
+func (env *Env) ListAdmissionregistrationV1beta1MutatingWebhookConfigurationsE(t *testing.T, opts ...ListOption) ([]v1beta1.MutatingWebhookConfiguration, error) {
+	t.Helper()
+	list, err := env.Client.AdmissionregistrationV1beta1().MutatingWebhookConfigurations().List(env.Ctx, listOptions(opts))
+	if err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+// WaitForAdmissionregistrationV1beta1MutatingWebhookConfiguration watches the AdmissionregistrationV1beta1MutatingWebhookConfiguration called name until ready reports true
+// for it and returns it. A nil ready waits for it to exist. The test fails
+// after timeout.
+func (env *Env) WaitForAdmissionregistrationV1beta1MutatingWebhookConfiguration(t *testing.T, name string, ready func(*v1beta1.MutatingWebhookConfiguration) bool, timeout time.Duration) *v1beta1.MutatingWebhookConfiguration {
+	t.Helper()
+	return waitFor(t, env, "MutatingWebhookConfiguration", name, env.Client.AdmissionregistrationV1beta1().MutatingWebhookConfigurations(), ready, timeout)
+}
+
 // GetAdmissionregistrationV1beta1ValidatingAdmissionPolicy fetches a AdmissionregistrationV1beta1ValidatingAdmissionPolicy by name, failing the test on error.
 func (env *Env) GetAdmissionregistrationV1beta1ValidatingAdmissionPolicy(t *testing.T, name string) *v1beta1.ValidatingAdmissionPolicy {
 	t.Helper()
@@ -200,6 +438,32 @@ func (env *Env) GetAdmissionregistrationV1beta1ValidatingAdmissionPolicyE(t *tes
 	return env.Client.AdmissionregistrationV1beta1().ValidatingAdmissionPolicies().Get(env.Ctx, name, v11.GetOptions{})
 }
 
+// ListAdmissionregistrationV1beta1ValidatingAdmissionPolicies lists the AdmissionregistrationV1beta1ValidatingAdmissionPolicies matching opts, failing the test on error.
+func (env *Env) ListAdmissionregistrationV1beta1ValidatingAdmissionPolicies(t *testing.T, opts ...ListOption) []v1beta1.ValidatingAdmissionPolicy {
+	t.Helper()
+	items, err := env.ListAdmissionregistrationV1beta1ValidatingAdmissionPoliciesE(t, opts...)
+	require.NoError(t, err, "failed to list AdmissionregistrationV1beta1ValidatingAdmissionPolicies")
+	return items
+}
+
+// ListAdmissionregistrationV1beta1ValidatingAdmissionPoliciesE lists the AdmissionregistrationV1beta1ValidatingAdmissionPolicies matching opts, returning the error.
+func (env *Env) ListAdmissionregistrationV1beta1ValidatingAdmissionPoliciesE(t *testing.T, opts ...ListOption) ([]v1beta1.ValidatingAdmissionPolicy, error) {
+	t.Helper()
+	list, err := env.Client.AdmissionregistrationV1beta1().ValidatingAdmissionPolicies().List(env.Ctx, listOptions(opts))
+	if err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+// WaitForAdmissionregistrationV1beta1ValidatingAdmissionPolicy watches the AdmissionregistrationV1beta1ValidatingAdmissionPolicy called name until ready reports true
+// for it and returns it. A nil ready waits for it to exist. The test fails
+// after timeout.
+func (env *Env) WaitForAdmissionregistrationV1beta1ValidatingAdmissionPolicy(t *testing.T, name string, ready func(*v1beta1.ValidatingAdmissionPolicy) bool, timeout time.Duration) *v1beta1.ValidatingAdmissionPolicy {
+	t.Helper()
+	return waitFor(t, env, "ValidatingAdmissionPolicy", name, env.Client.AdmissionregistrationV1beta1().ValidatingAdmissionPolicies(), ready, timeout)
+}
+
 // GetAdmissionregistrationV1beta1ValidatingAdmissionPolicyBinding fetches a AdmissionregistrationV1beta1ValidatingAdmissionPolicyBinding by name, failing the test on error.
 func (env *Env) GetAdmissionregistrationV1beta1ValidatingAdmissionPolicyBinding(t *testing.T, name string) *v1beta1.ValidatingAdmissionPolicyBinding {
 	t.Helper()
@@ -214,6 +478,32 @@ func (env *Env) GetAdmissionregistrationV1beta1ValidatingAdmissionPolicyBindingE
 	return env.Client.AdmissionregistrationV1beta1().ValidatingAdmissionPolicyBindings().Get(env.Ctx, name, v11.GetOptions{})
 }
 
+// ListAdmissionregistrationV1beta1ValidatingAdmissionPolicyBindings lists the AdmissionregistrationV1beta1ValidatingAdmissionPolicyBindings matching opts, failing the test on error.
+func (env *Env) ListAdmissionregistrationV1beta1ValidatingAdmissionPolicyBindings(t *testing.T, opts ...ListOption) []v1beta1.ValidatingAdmissionPolicyBinding {
+	t.Helper()
+	items, err := env.ListAdmissionregistrationV1beta1ValidatingAdmissionPolicyBindingsE(t, opts...)
+	require.NoError(t, err, "failed to list AdmissionregistrationV1beta1ValidatingAdmissionPolicyBindings")
+	return items
+}
+
+// ListAdmissionregistrationV1beta1ValidatingAdmissionPolicyBindingsE lists the AdmissionregistrationV1beta1ValidatingAdmissionPolicyBindings matching opts, returning the error.
+func (env *Env) ListAdmissionregistrationV1beta1ValidatingAdmissionPolicyBindingsE(t *testing.T, opts ...ListOption) ([]v1beta1.ValidatingAdmissionPolicyBinding, error) {
+	t.Helper()
+	list, err := env.Client.AdmissionregistrationV1beta1().ValidatingAdmissionPolicyBindings().List(env.Ctx, listOptions(opts))
+	if err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+// WaitForAdmissionregistrationV1beta1ValidatingAdmissionPolicyBinding watches the AdmissionregistrationV1beta1ValidatingAdmissionPolicyBinding called name until ready reports true
+// for it and returns it. A nil ready waits for it to exist. The test fails
+// after timeout.
+func (env *Env) WaitForAdmissionregistrationV1beta1ValidatingAdmissionPolicyBinding(t *testing.T, name string, ready func(*v1beta1.ValidatingAdmissionPolicyBinding) bool, timeout time.Duration) *v1beta1.ValidatingAdmissionPolicyBinding {
+	t.Helper()
+	return waitFor(t, env, "ValidatingAdmissionPolicyBinding", name, env.Client.AdmissionregistrationV1beta1().ValidatingAdmissionPolicyBindings(), ready, timeout)
+}
+
 // GetAdmissionregistrationV1beta1ValidatingWebhookConfiguration fetches a AdmissionregistrationV1beta1ValidatingWebhookConfiguration by name, failing the test on error.
 func (env *Env) GetAdmissionregistrationV1beta1ValidatingWebhookConfiguration(t *testing.T, name string) *v1beta1.ValidatingWebhookConfiguration {
 	t.Helper()
@@ -228,6 +518,32 @@ func (env *Env) GetAdmissionregistrationV1beta1ValidatingWebhookConfigurationE(t
 	return env.Client.AdmissionregistrationV1beta1().ValidatingWebhookConfigurations().Get(env.Ctx, name, v11.GetOptions{})
 }
 
+// ListAdmissionregistrationV1beta1ValidatingWebhookConfigurations lists the AdmissionregistrationV1beta1ValidatingWebhookConfigurations matching opts, failing the test on error.
+func (env *Env) ListAdmissionregistrationV1beta1ValidatingWebhookConfigurations(t *testing.T, opts ...ListOption) []v1beta1.ValidatingWebhookConfiguration {
+	t.Helper()
+	items, err := env.ListAdmissionregistrationV1beta1ValidatingWebhookConfigurationsE(t, opts...)
+	require.NoError(t, err, "failed to list AdmissionregistrationV1beta1ValidatingWebhookConfigurations")
+	return items
+}
+
+// ListAdmissionregistrationV1beta1ValidatingWebhookConfigurationsE lists the AdmissionregistrationV1beta1ValidatingWebhookConfigurations matching opts, returning the error.
+func (env *Env) ListAdmissionregistrationV1beta1ValidatingWebhookConfigurationsE(t *testing.T, opts ...ListOption) ([]v1beta1.ValidatingWebhookConfiguration, error) {
+	t.Helper()
+	list, err := env.Client.AdmissionregistrationV1beta1().ValidatingWebhookConfigurations().List(env.Ctx, listOptions(opts))
+	if err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+// WaitForAdmissionregistrationV1beta1ValidatingWebhookConfiguration watches the AdmissionregistrationV1beta1ValidatingWebhookConfiguration called name until ready reports true
+// for it and returns it. A nil ready waits for it to exist. The test fails
+// after timeout.
+func (env *Env) WaitForAdmissionregistrationV1beta1ValidatingWebhookConfiguration(t *testing.T, name string, ready func(*v1beta1.ValidatingWebhookConfiguration) bool, timeout time.Duration) *v1beta1.ValidatingWebhookConfiguration {
+	t.Helper()
+	return waitFor(t, env, "ValidatingWebhookConfiguration", name, env.Client.AdmissionregistrationV1beta1().ValidatingWebhookConfigurations(), ready, timeout)
+}
+
 // GetMutatingAdmissionPolicy fetches a MutatingAdmissionPolicy by name, failing the test on error.
 func (env *Env) GetMutatingAdmissionPolicy(t *testing.T, name string) *v1beta1.MutatingAdmissionPolicy {
 	t.Helper()
@@ -242,6 +558,32 @@ func (env *Env) GetMutatingAdmissionPolicyE(t *testing.T, name string) (*v1beta1
 	return env.Client.AdmissionregistrationV1beta1().MutatingAdmissionPolicies().Get(env.Ctx, name, v11.GetOptions{})
 }
 
+// ListMutatingAdmissionPolicies lists the MutatingAdmissionPolicies matching opts, failing the test on error.
+func (env *Env) ListMutatingAdmissionPolicies(t *testing.T, opts ...ListOption) []v1beta1.MutatingAdmissionPolicy {
+	t.Helper()
+	items, err := env.ListMutatingAdmissionPoliciesE(t, opts...)
+	require.NoError(t, err, "failed to list MutatingAdmissionPolicies")
+	return items
+}
+
+// ListMutatingAdmissionPoliciesE lists the MutatingAdmissionPolicies matching opts, returning the error.
+func (env *Env) ListMutatingAdmissionPoliciesE(t *testing.T, opts ...ListOption) ([]v1beta1.MutatingAdmissionPolicy, error) {
+	t.Helper()
+	list, err := env.Client.AdmissionregistrationV1beta1().MutatingAdmissionPolicies().List(env.Ctx, listOptions(opts))
+	if err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+// WaitForMutatingAdmissionPolicy watches the MutatingAdmissionPolicy called name until ready reports true
+// for it and returns it. A nil ready waits for it to exist. The test fails
+// after timeout.
+func (env *Env) WaitForMutatingAdmissionPolicy(t *testing.T, name string, ready func(*v1beta1.MutatingAdmissionPolicy) bool, timeout time.Duration) *v1beta1.MutatingAdmissionPolicy {
+	t.Helper()
+	return waitFor(t, env, "MutatingAdmissionPolicy", name, env.Client.AdmissionregistrationV1beta1().MutatingAdmissionPolicies(), ready, timeout)
+}
+
 // GetMutatingAdmissionPolicyBinding fetches a MutatingAdmissionPolicyBinding by name, failing the test on error.
 func (env *Env) GetMutatingAdmissionPolicyBinding(t *testing.T, name string) *v1beta1.MutatingAdmissionPolicyBinding {
 	t.Helper()
@@ -256,6 +598,32 @@ func (env *Env) GetMutatingAdmissionPolicyBindingE(t *testing.T, name string) (*
 	return env.Client.AdmissionregistrationV1beta1().MutatingAdmissionPolicyBindings().Get(env.Ctx, name, v11.GetOptions{})
 }
 
+// ListMutatingAdmissionPolicyBindings lists the MutatingAdmissionPolicyBindings matching opts, failing the test on error.
+func (env *Env) ListMutatingAdmissionPolicyBindings(t *testing.T, opts ...ListOption) []v1beta1.MutatingAdmissionPolicyBinding {
+	t.Helper()
+	items, err := env.ListMutatingAdmissionPolicyBindingsE(t, opts...)
+	require.NoError(t, err, "failed to list MutatingAdmissionPolicyBindings")
+	return items
+}
+
+// ListMutatingAdmissionPolicyBindingsE lists the MutatingAdmissionPolicyBindings matching opts, returning the error.
+func (env *Env) ListMutatingAdmissionPolicyBindingsE(t *testing.T, opts ...ListOption) ([]v1beta1.MutatingAdmissionPolicyBinding, error) {
+	t.Helper()
+	list, err := env.Client.AdmissionregistrationV1beta1().MutatingAdmissionPolicyBindings().List(env.Ctx, listOptions(opts))
+	if err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+// WaitForMutatingAdmissionPolicyBinding watches the MutatingAdmissionPolicyBinding called name until ready reports true
+// for it and returns it. A nil ready waits for it to exist. The test fails
+// after timeout.
+func (env *Env) WaitForMutatingAdmissionPolicyBinding(t *testing.T, name string, ready func(*v1beta1.MutatingAdmissionPolicyBinding) bool, timeout time.Duration) *v1beta1.MutatingAdmissionPolicyBinding {
+	t.Helper()
+	return waitFor(t, env, "MutatingAdmissionPolicyBinding", name, env.Client.AdmissionregistrationV1beta1().MutatingAdmissionPolicyBindings(), ready, timeout)
+}
+
 // GetControllerRevision fetches a ControllerRevision by name, failing the test on error.
 func (env *Env) GetControllerRevision(t *testing.T, name string) *v12.ControllerRevision {
 	t.Helper()
@@ -270,6 +638,32 @@ func (env *Env) GetControllerRevisionE(t *testing.T, name string) (*v12.Controll
 	return env.Client.AppsV1().ControllerRevisions(env.Kube.Namespace).Get(env.Ctx, name, v11.GetOptions{})
 }
 
+// ListControllerRevisions lists the ControllerRevisions matching opts, failing the test on error.
+func (env *Env) ListControllerRevisions(t *testing.T, opts ...ListOption) []v12.ControllerRevision {
+	t.Helper()
+	items, err := env.ListControllerRevisionsE(t, opts...)
+	require.NoError(t, err, "failed to list ControllerRevisions")
+	return items
+}
+
+// ListControllerRevisionsE lists the ControllerRevisions matching opts, returning the error.
+func (env *Env) ListControllerRevisionsE(t *testing.T, opts ...ListOption) ([]v12.ControllerRevision, error) {
+	t.Helper()
+	list, err := env.Client.AppsV1().ControllerRevisions(env.Kube.Namespace).List(env.Ctx, listOptions(opts))
+	if err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+// WaitForControllerRevision watches the ControllerRevision called name until ready reports true
+// for it and returns it. A nil ready waits for it to exist. The test fails
+// after timeout.
+func (env *Env) WaitForControllerRevision(t *testing.T, name string, ready func(*v12.ControllerRevision) bool, timeout time.Duration) *v12.ControllerRevision {
+	t.Helper()
+	return waitFor(t, env, "ControllerRevision", name, env.Client.AppsV1().ControllerRevisions(env.Kube.Namespace), ready, timeout)
+}
+
 // GetDaemonSet fetches a DaemonSet by name, failing the test on error.
 func (env *Env) GetDaemonSet(t *testing.T, name string) *v12.DaemonSet {
 	t.Helper()
@@ -284,6 +678,32 @@ func (env *Env) GetDaemonSetE(t *testing.T, name string) (*v12.DaemonSet, error)
 	return env.Client.AppsV1().DaemonSets(env.Kube.Namespace).Get(env.Ctx, name, v11.GetOptions{})
 }
 
+// ListDaemonSets lists the DaemonSets matching opts, failing the test on error.
+func (env *Env) ListDaemonSets(t *testing.T, opts ...ListOption) []v12.DaemonSet {
+	t.Helper()
+	items, err := env.ListDaemonSetsE(t, opts...)
+	require.NoError(t, err, "failed to list DaemonSets")
+	return items
+}
+
+// ListDaemonSetsE lists the DaemonSets matching opts, returning the error.
+func (env *Env) ListDaemonSetsE(t *testing.T, opts ...ListOption) ([]v12.DaemonSet, error) {
+	t.Helper()
+	list, err := env.Client.AppsV1().DaemonSets(env.Kube.Namespace).List(env.Ctx, listOptions(opts))
+	if err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+// WaitForDaemonSet watches the DaemonSet called name until ready reports true
+// for it and returns it. A nil ready waits for it to exist. The test fails
+// after timeout.
+func (env *Env) WaitForDaemonSet(t *testing.T, name string, ready func(*v12.DaemonSet) bool, timeout time.Duration) *v12.DaemonSet {
+	t.Helper()
+	return waitFor(t, env, "DaemonSet", name, env.Client.AppsV1().DaemonSets(env.Kube.Namespace), ready, timeout)
+}
+
 // GetDeployment fetches a Deployment by name, failing the test on error.
 func (env *Env) GetDeployment(t *testing.T, name string) *v12.Deployment {
 	t.Helper()
@@ -298,6 +718,32 @@ func (env *Env) GetDeploymentE(t *testing.T, name string) (*v12.Deployment, erro
 	return env.Client.AppsV1().Deployments(env.Kube.Namespace).Get(env.Ctx, name, v11.GetOptions{})
 }
 
+// ListDeployments lists the Deployments matching opts, failing the test on error.
+func (env *Env) ListDeployments(t *testing.T, opts ...ListOption) []v12.Deployment {
+	t.Helper()
+	items, err := env.ListDeploymentsE(t, opts...)
+	require.NoError(t, err, "failed to list Deployments")
+	return items
+}
+
+// ListDeploymentsE lists the Deployments matching opts, returning the error.
+func (env *Env) ListDeploymentsE(t *testing.T, opts ...ListOption) ([]v12.Deployment, error) {
+	t.Helper()
+	list, err := env.Client.AppsV1().Deployments(env.Kube.Namespace).List(env.Ctx, listOptions(opts))
+	if err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+// WaitForDeployment watches the Deployment called name until ready reports true
+// for it and returns it. A nil ready waits for it to exist. The test fails
+// after timeout.
+func (env *Env) WaitForDeployment(t *testing.T, name string, ready func(*v12.Deployment) bool, timeout time.Duration) *v12.Deployment {
+	t.Helper()
+	return waitFor(t, env, "Deployment", name, env.Client.AppsV1().Deployments(env.Kube.Namespace), ready, timeout)
+}
+
 // GetReplicaSet fetches a ReplicaSet by name, failing the test on error.
 func (env *Env) GetReplicaSet(t *testing.T, name string) *v12.ReplicaSet {
 	t.Helper()
@@ -312,6 +758,32 @@ func (env *Env) GetReplicaSetE(t *testing.T, name string) (*v12.ReplicaSet, erro
 	return env.Client.AppsV1().ReplicaSets(env.Kube.Namespace).Get(env.Ctx, name, v11.GetOptions{})
 }
 
+// ListReplicaSets lists the ReplicaSets matching opts, failing the test on error.
+func (env *Env) ListReplicaSets(t *testing.T, opts ...ListOption) []v12.ReplicaSet {
+	t.Helper()
+	items, err := env.ListReplicaSetsE(t, opts...)
+	require.NoError(t, err, "failed to list ReplicaSets")
+	return items
+}
+
+// ListReplicaSetsE lists the ReplicaSets matching opts, returning the error.
+func (env *Env) ListReplicaSetsE(t *testing.T, opts ...ListOption) ([]v12.ReplicaSet, error) {
+	t.Helper()
+	list, err := env.Client.AppsV1().ReplicaSets(env.Kube.Namespace).List(env.Ctx, listOptions(opts))
+	if err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+// WaitForReplicaSet watches the ReplicaSet called name until ready reports true
+// for it and returns it. A nil ready waits for it to exist. The test fails
+// after timeout.
+func (env *Env) WaitForReplicaSet(t *testing.T, name string, ready func(*v12.ReplicaSet) bool, timeout time.Duration) *v12.ReplicaSet {
+	t.Helper()
+	return waitFor(t, env, "ReplicaSet", name, env.Client.AppsV1().ReplicaSets(env.Kube.Namespace), ready, timeout)
+}
+
 // GetStatefulSet fetches a StatefulSet by name, failing the test on error.
 func (env *Env) GetStatefulSet(t *testing.T, name string) *v12.StatefulSet {
 	t.Helper()
@@ -326,6 +798,32 @@ func (env *Env) GetStatefulSetE(t *testing.T, name string) (*v12.StatefulSet, er
 	return env.Client.AppsV1().StatefulSets(env.Kube.Namespace).Get(env.Ctx, name, v11.GetOptions{})
 }
 
+// ListStatefulSets lists the StatefulSets matching opts, failing the test on error.
+func (env *Env) ListStatefulSets(t *testing.T, opts ...ListOption) []v12.StatefulSet {
+	t.Helper()
+	items, err := env.ListStatefulSetsE(t, opts...)
+	require.NoError(t, err, "failed to list StatefulSets")
+	return items
+}
+
+// ListStatefulSetsE lists the StatefulSets matching opts, returning the error.
+func (env *Env) ListStatefulSetsE(t *testing.T, opts ...ListOption) ([]v12.StatefulSet, error) {
+	t.Helper()
+	list, err := env.Client.AppsV1().StatefulSets(env.Kube.Namespace).List(env.Ctx, listOptions(opts))
+	if err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+// WaitForStatefulSet watches the StatefulSet called name until ready reports true
+// for it and returns it. A nil ready waits for it to exist. The test fails
+// after timeout.
+func (env *Env) WaitForStatefulSet(t *testing.T, name string, ready func(*v12.StatefulSet) bool, timeout time.Duration) *v12.StatefulSet {
+	t.Helper()
+	return waitFor(t, env, "StatefulSet", name, env.Client.AppsV1().StatefulSets(env.Kube.Namespace), ready, timeout)
+}
+
 // GetAppsV1beta1ControllerRevision fetches a AppsV1beta1ControllerRevision by name, failing the test on error.
 func (env *Env) GetAppsV1beta1ControllerRevision(t *testing.T, name string) *v1beta11.ControllerRevision {
 	t.Helper()
@@ -340,6 +838,32 @@ func (env *Env) GetAppsV1beta1ControllerRevisionE(t *testing.T, name string) (*v
 	return env.Client.AppsV1beta1().ControllerRevisions(env.Kube.Namespace).Get(env.Ctx, name, v11.GetOptions{})
 }
 
+// ListAppsV1beta1ControllerRevisions lists the AppsV1beta1ControllerRevisions matching opts, failing the test on error.
+func (env *Env) ListAppsV1beta1ControllerRevisions(t *testing.T, opts ...ListOption) []v1beta11.ControllerRevision {
+	t.Helper()
+	items, err := env.ListAppsV1beta1ControllerRevisionsE(t, opts...)
+	require.NoError(t, err, "failed to list AppsV1beta1ControllerRevisions")
+	return items
+}
+
+// ListAppsV1beta1ControllerRevisionsE lists the AppsV1beta1ControllerRevisions matching opts, returning the error.
+func (env *Env) ListAppsV1beta1ControllerRevisionsE(t *testing.T, opts ...ListOption) ([]v1beta11.ControllerRevision, error) {
+	t.Helper()
+	list, err := env.Client.AppsV1beta1().ControllerRevisions(env.Kube.Namespace).List(env.Ctx, listOptions(opts))
+	if err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+// WaitForAppsV1beta1ControllerRevision watches the AppsV1beta1ControllerRevision called name until ready reports true
+// for it and returns it. A nil ready waits for it to exist. The test fails
+// after timeout.
+func (env *Env) WaitForAppsV1beta1ControllerRevision(t *testing.T, name string, ready func(*v1beta11.ControllerRevision) bool, timeout time.Duration) *v1beta11.ControllerRevision {
+	t.Helper()
+	return waitFor(t, env, "ControllerRevision", name, env.Client.AppsV1beta1().ControllerRevisions(env.Kube.Namespace), ready, timeout)
+}
+
 // GetAppsV1beta1Deployment fetches a AppsV1beta1Deployment by name, failing the test on error.
 func (env *Env) GetAppsV1beta1Deployment(t *testing.T, name string) *v1beta11.Deployment {
 	t.Helper()
@@ -354,6 +878,32 @@ func (env *Env) GetAppsV1beta1DeploymentE(t *testing.T, name string) (*v1beta11.
 	return env.Client.AppsV1beta1().Deployments(env.Kube.Namespace).Get(env.Ctx, name, v11.GetOptions{})
 }
 
+// ListAppsV1beta1Deployments lists the AppsV1beta1Deployments matching opts, failing the test on error.
+func (env *Env) ListAppsV1beta1Deployments(t *testing.T, opts ...ListOption) []v1beta11.Deployment {
+	t.Helper()
+	items, err := env.ListAppsV1beta1DeploymentsE(t, opts...)
+	require.NoError(t, err, "failed to list AppsV1beta1Deployments")
+	return items
+}
+
+// ListAppsV1beta1DeploymentsE lists the AppsV1beta1Deployments matching opts, returning the error.
+func (env *Env) ListAppsV1beta1DeploymentsE(t *testing.T, opts ...ListOption) ([]v1beta11.Deployment, error) {
+	t.Helper()
+	list, err := env.Client.AppsV1beta1().Deployments(env.Kube.Namespace).List(env.Ctx, listOptions(opts))
+	if err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+// WaitForAppsV1beta1Deployment watches the AppsV1beta1Deployment called name until ready reports true
+// for it and returns it. A nil ready waits for it to exist. The test fails
+// after timeout.
+func (env *Env) WaitForAppsV1beta1Deployment(t *testing.T, name string, ready func(*v1beta11.Deployment) bool, timeout time.Duration) *v1beta11.Deployment {
+	t.Helper()
+	return waitFor(t, env, "Deployment", name, env.Client.AppsV1beta1().Deployments(env.Kube.Namespace), ready, timeout)
+}
+
 // GetAppsV1beta1StatefulSet fetches a AppsV1beta1StatefulSet by name, failing the test on error.
 func (env *Env) GetAppsV1beta1StatefulSet(t *testing.T, name string) *v1beta11.StatefulSet {
 	t.Helper()
@@ -368,6 +918,32 @@ func (env *Env) GetAppsV1beta1StatefulSetE(t *testing.T, name string) (*v1beta11
 	return env.Client.AppsV1beta1().StatefulSets(env.Kube.Namespace).Get(env.Ctx, name, v11.GetOptions{})
 }
 
+// ListAppsV1beta1StatefulSets lists the AppsV1beta1StatefulSets matching opts, failing the test on error.
+func (env *Env) ListAppsV1beta1StatefulSets(t *testing.T, opts ...ListOption) []v1beta11.StatefulSet {
+	t.Helper()
+	items, err := env.ListAppsV1beta1StatefulSetsE(t, opts...)
+	require.NoError(t, err, "failed to list AppsV1beta1StatefulSets")
+	return items
+}
+
+// ListAppsV1beta1StatefulSetsE lists the AppsV1beta1StatefulSets matching opts, returning the error.
+func (env *Env) ListAppsV1beta1StatefulSetsE(t *testing.T, opts ...ListOption) ([]v1beta11.StatefulSet, error) {
+	t.Helper()
+	list, err := env.Client.AppsV1beta1().StatefulSets(env.Kube.Namespace).List(env.Ctx, listOptions(opts))
+	if err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+// WaitForAppsV1beta1StatefulSet watches the AppsV1beta1StatefulSet called name until ready reports true
+// for it and returns it. A nil ready waits for it to exist. The test fails
+// after timeout.
+func (env *Env) WaitForAppsV1beta1StatefulSet(t *testing.T, name string, ready func(*v1beta11.StatefulSet) bool, timeout time.Duration) *v1beta11.StatefulSet {
+	t.Helper()
+	return waitFor(t, env, "StatefulSet", name, env.Client.AppsV1beta1().StatefulSets(env.Kube.Namespace), ready, timeout)
+}
+
 // GetAppsV1beta2ControllerRevision fetches a AppsV1beta2ControllerRevision by name, failing the test on error.
 func (env *Env) GetAppsV1beta2ControllerRevision(t *testing.T, name string) *v1beta2.ControllerRevision {
 	t.Helper()
@@ -382,6 +958,32 @@ func (env *Env) GetAppsV1beta2ControllerRevisionE(t *testing.T, name string) (*v
 	return env.Client.AppsV1beta2().ControllerRevisions(env.Kube.Namespace).Get(env.Ctx, name, v11.GetOptions{})
 }
 
+// ListAppsV1beta2ControllerRevisions lists the AppsV1beta2ControllerRevisions matching opts, failing the test on error.
+func (env *Env) ListAppsV1beta2ControllerRevisions(t *testing.T, opts ...ListOption) []v1beta2.ControllerRevision {
+	t.Helper()
+	items, err := env.ListAppsV1beta2ControllerRevisionsE(t, opts...)
+	require.NoError(t, err, "failed to list AppsV1beta2ControllerRevisions")
+	return items
+}
+
+// ListAppsV1beta2ControllerRevisionsE lists the AppsV1beta2ControllerRevisions matching opts, returning the error.
+func (env *Env) ListAppsV1beta2ControllerRevisionsE(t *testing.T, opts ...ListOption) ([]v1beta2.ControllerRevision, error) {
+	t.Helper()
+	list, err := env.Client.AppsV1beta2().ControllerRevisions(env.Kube.Namespace).List(env.Ctx, listOptions(opts))
+	if err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+// WaitForAppsV1beta2ControllerRevision watches the AppsV1beta2ControllerRevision called name until ready reports true
+// for it and returns it. A nil ready waits for it to exist. The test fails
+// after timeout.
+func (env *Env) WaitForAppsV1beta2ControllerRevision(t *testing.T, name string, ready func(*v1beta2.ControllerRevision) bool, timeout time.Duration) *v1beta2.ControllerRevision {
+	t.Helper()
+	return waitFor(t, env, "ControllerRevision", name, env.Client.AppsV1beta2().ControllerRevisions(env.Kube.Namespace), ready, timeout)
+}
+
 // GetAppsV1beta2DaemonSet fetches a AppsV1beta2DaemonSet by name, failing the test on error.
 func (env *Env) GetAppsV1beta2DaemonSet(t *testing.T, name string) *v1beta2.DaemonSet {
 	t.Helper()
@@ -396,6 +998,32 @@ func (env *Env) GetAppsV1beta2DaemonSetE(t *testing.T, name string) (*v1beta2.Da
 	return env.Client.AppsV1beta2().DaemonSets(env.Kube.Namespace).Get(env.Ctx, name, v11.GetOptions{})
 }
 
+// ListAppsV1beta2DaemonSets lists the AppsV1beta2DaemonSets matching opts, failing the test on error.
+func (env *Env) ListAppsV1beta2DaemonSets(t *testing.T, opts ...ListOption) []v1beta2.DaemonSet {
+	t.Helper()
+	items, err := env.ListAppsV1beta2DaemonSetsE(t, opts...)
+	require.NoError(t, err, "failed to list AppsV1beta2DaemonSets")
+	return items
+}
+
+// ListAppsV1beta2DaemonSetsE lists the AppsV1beta2DaemonSets matching opts, returning the error.
+func (env *Env) ListAppsV1beta2DaemonSetsE(t *testing.T, opts ...ListOption) ([]v1beta2.DaemonSet, error) {
+	t.Helper()
+	list, err := env.Client.AppsV1beta2().DaemonSets(env.Kube.Namespace).List(env.Ctx, listOptions(opts))
+	if err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+// WaitForAppsV1beta2DaemonSet watches the AppsV1beta2DaemonSet called name until ready reports true
+// for it and returns it. A nil ready waits for it to exist. The test fails
+// after timeout.
+func (env *Env) WaitForAppsV1beta2DaemonSet(t *testing.T, name string, ready func(*v1beta2.DaemonSet) bool, timeout time.Duration) *v1beta2.DaemonSet {
+	t.Helper()
+	return waitFor(t, env, "DaemonSet", name, env.Client.AppsV1beta2().DaemonSets(env.Kube.Namespace), ready, timeout)
+}
+
 // GetAppsV1beta2Deployment fetches a AppsV1beta2Deployment by name, failing the test on error.
 func (env *Env) GetAppsV1beta2Deployment(t *testing.T, name string) *v1beta2.Deployment {
 	t.Helper()
@@ -410,6 +1038,32 @@ func (env *Env) GetAppsV1beta2DeploymentE(t *testing.T, name string) (*v1beta2.D
 	return env.Client.AppsV1beta2().Deployments(env.Kube.Namespace).Get(env.Ctx, name, v11.GetOptions{})
 }
 
+// ListAppsV1beta2Deployments lists the AppsV1beta2Deployments matching opts, failing the test on error.
+func (env *Env) ListAppsV1beta2Deployments(t *testing.T, opts ...ListOption) []v1beta2.Deployment {
+	t.Helper()
+	items, err := env.ListAppsV1beta2DeploymentsE(t, opts...)
+	require.NoError(t, err, "failed to list AppsV1beta2Deployments")
+	return items
+}
+
+// ListAppsV1beta2DeploymentsE lists the AppsV1beta2Deployments matching opts, returning the error.
+func (env *Env) ListAppsV1beta2DeploymentsE(t *testing.T, opts ...ListOption) ([]v1beta2.Deployment, error) {
+	t.Helper()
+	list, err := env.Client.AppsV1beta2().Deployments(env.Kube.Namespace).List(env.Ctx, listOptions(opts))
+	if err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+// WaitForAppsV1beta2Deployment watches the AppsV1beta2Deployment called name until ready reports true
+// for it and returns it. A nil ready waits for it to exist. The test fails
+// after timeout.
+func (env *Env) WaitForAppsV1beta2Deployment(t *testing.T, name string, ready func(*v1beta2.Deployment) bool, timeout time.Duration) *v1beta2.Deployment {
+	t.Helper()
+	return waitFor(t, env, "Deployment", name, env.Client.AppsV1beta2().Deployments(env.Kube.Namespace), ready, timeout)
+}
+
 // GetAppsV1beta2ReplicaSet fetches a AppsV1beta2ReplicaSet by name, failing the test on error.
 func (env *Env) GetAppsV1beta2ReplicaSet(t *testing.T, name string) *v1beta2.ReplicaSet {
 	t.Helper()
@@ -424,20 +1078,72 @@ func (env *Env) GetAppsV1beta2ReplicaSetE(t *testing.T, name string) (*v1beta2.R
 	return env.Client.AppsV1beta2().ReplicaSets(env.Kube.Namespace).Get(env.Ctx, name, v11.GetOptions{})
 }
 
-// GetAppsV1beta2StatefulSet fetches a AppsV1beta2StatefulSet by name, failing the test on error.
-func (env *Env) GetAppsV1beta2StatefulSet(t *testing.T, name string) *v1beta2.StatefulSet {
+// ListAppsV1beta2ReplicaSets lists the AppsV1beta2ReplicaSets matching opts, failing the test on error.
+func (env *Env) ListAppsV1beta2ReplicaSets(t *testing.T, opts ...ListOption) []v1beta2.ReplicaSet {
 	t.Helper()
-	obj, err := env.Client.AppsV1beta2().StatefulSets(env.Kube.Namespace).Get(env.Ctx, name, v11.GetOptions{})
-	require.NoError(t, err, "failed to get AppsV1beta2StatefulSet %s", name)
-	return obj
+	items, err := env.ListAppsV1beta2ReplicaSetsE(t, opts...)
+	require.NoError(t, err, "failed to list AppsV1beta2ReplicaSets")
+	return items
 }
 
-// GetAppsV1beta2StatefulSetE fetches a AppsV1beta2StatefulSet by name, returning the error for non-existence checks.
+// ListAppsV1beta2ReplicaSetsE lists the AppsV1beta2ReplicaSets matching opts, returning the error.
+func (env *Env) ListAppsV1beta2ReplicaSetsE(t *testing.T, opts ...ListOption) ([]v1beta2.ReplicaSet, error) {
+	t.Helper()
+	list, err := env.Client.AppsV1beta2().ReplicaSets(env.Kube.Namespace).List(env.Ctx, listOptions(opts))
+	if err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+// WaitForAppsV1beta2ReplicaSet watches the AppsV1beta2ReplicaSet called name until ready reports true
+// for it and returns it. A nil ready waits for it to exist. The test fails
+// after timeout.
+func (env *Env) WaitForAppsV1beta2ReplicaSet(t *testing.T, name string, ready func(*v1beta2.ReplicaSet) bool, timeout time.Duration) *v1beta2.ReplicaSet {
+	t.Helper()
+	return waitFor(t, env, "ReplicaSet", name, env.Client.AppsV1beta2().ReplicaSets(env.Kube.Namespace), ready, timeout)
+}
+
+// GetAppsV1beta2StatefulSet fetches a AppsV1beta2StatefulSet by name, failing the test on error.
+func (env *Env) GetAppsV1beta2StatefulSet(t *testing.T, name string) *v1beta2.StatefulSet {
+	t.Helper()
+	obj, err := env.Client.AppsV1beta2().StatefulSets(env.Kube.Namespace).Get(env.Ctx, name, v11.GetOptions{})
+	require.NoError(t, err, "failed to get AppsV1beta2StatefulSet %s", name)
+	return obj
+}
+
+// GetAppsV1beta2StatefulSetE fetches a AppsV1beta2StatefulSet by name, returning the error for non-existence checks.
 func (env *Env) GetAppsV1beta2StatefulSetE(t *testing.T, name string) (*v1beta2.StatefulSet, error) {
 	t.Helper()
 	return env.Client.AppsV1beta2().StatefulSets(env.Kube.Namespace).Get(env.Ctx, name, v11.GetOptions{})
 }
 
+// ListAppsV1beta2StatefulSets lists the AppsV1beta2StatefulSets matching opts, failing the test on error.
+func (env *Env) ListAppsV1beta2StatefulSets(t *testing.T, opts ...ListOption) []v1beta2.StatefulSet {
+	t.Helper()
+	items, err := env.ListAppsV1beta2StatefulSetsE(t, opts...)
+	require.NoError(t, err, "failed to list AppsV1beta2StatefulSets")
+	return items
+}
+
+// ListAppsV1beta2StatefulSetsE lists the AppsV1beta2StatefulSets matching opts, returning the error.
+func (env *Env) ListAppsV1beta2StatefulSetsE(t *testing.T, opts ...ListOption) ([]v1beta2.StatefulSet, error) {
+	t.Helper()
+	list, err := env.Client.AppsV1beta2().StatefulSets(env.Kube.Namespace).List(env.Ctx, listOptions(opts))
+	if err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+// WaitForAppsV1beta2StatefulSet watches the AppsV1beta2StatefulSet called name until ready reports true
+// for it and returns it. A nil ready waits for it to exist. The test fails
+// after timeout.
+func (env *Env) WaitForAppsV1beta2StatefulSet(t *testing.T, name string, ready func(*v1beta2.StatefulSet) bool, timeout time.Duration) *v1beta2.StatefulSet {
+	t.Helper()
+	return waitFor(t, env, "StatefulSet", name, env.Client.AppsV1beta2().StatefulSets(env.Kube.Namespace), ready, timeout)
+}
+
 // GetAutoscalingV1HorizontalPodAutoscaler fetches a AutoscalingV1HorizontalPodAutoscaler by name, failing the test on error.
 func (env *Env) GetAutoscalingV1HorizontalPodAutoscaler(t *testing.T, name string) *v13.HorizontalPodAutoscaler {
 	t.Helper()
@@ -452,6 +1158,32 @@ func (env *Env) GetAutoscalingV1HorizontalPodAutoscalerE(t *testing.T, name stri
 	return env.Client.AutoscalingV1().HorizontalPodAutoscalers(env.Kube.Namespace).Get(env.Ctx, name, v11.GetOptions{})
 }
 
+// ListAutoscalingV1HorizontalPodAutoscalers lists the AutoscalingV1HorizontalPodAutoscalers matching opts, failing the test on error.
+func (env *Env) ListAutoscalingV1HorizontalPodAutoscalers(t *testing.T, opts ...ListOption) []v13.HorizontalPodAutoscaler {
+	t.Helper()
+	items, err := env.ListAutoscalingV1HorizontalPodAutoscalersE(t, opts...)
+	require.NoError(t, err, "failed to list AutoscalingV1HorizontalPodAutoscalers")
+	return items
+}
+
+// ListAutoscalingV1HorizontalPodAutoscalersE lists the AutoscalingV1HorizontalPodAutoscalers matching opts, returning the error.
+func (env *Env) ListAutoscalingV1HorizontalPodAutoscalersE(t *testing.T, opts ...ListOption) ([]v13.HorizontalPodAutoscaler, error) {
+	t.Helper()
+	list, err := env.Client.AutoscalingV1().HorizontalPodAutoscalers(env.Kube.Namespace).List(env.Ctx, listOptions(opts))
+	if err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+// WaitForAutoscalingV1HorizontalPodAutoscaler watches the AutoscalingV1HorizontalPodAutoscaler called name until ready reports true
+// for it and returns it. A nil ready waits for it to exist. The test fails
+// after timeout.
+func (env *Env) WaitForAutoscalingV1HorizontalPodAutoscaler(t *testing.T, name string, ready func(*v13.HorizontalPodAutoscaler) bool, timeout time.Duration) *v13.HorizontalPodAutoscaler {
+	t.Helper()
+	return waitFor(t, env, "HorizontalPodAutoscaler", name, env.Client.AutoscalingV1().HorizontalPodAutoscalers(env.Kube.Namespace), ready, timeout)
+}
+
 // GetHorizontalPodAutoscaler fetches a HorizontalPodAutoscaler by name, failing the test on error.
 func (env *Env) GetHorizontalPodAutoscaler(t *testing.T, name string) *v2.HorizontalPodAutoscaler {
 	t.Helper()
@@ -466,6 +1198,32 @@ func (env *Env) GetHorizontalPodAutoscalerE(t *testing.T, name string) (*v2.Hori
 	return env.Client.AutoscalingV2().HorizontalPodAutoscalers(env.Kube.Namespace).Get(env.Ctx, name, v11.GetOptions{})
 }
 
+// ListHorizontalPodAutoscalers lists the HorizontalPodAutoscalers matching opts, failing the test on error.
+func (env *Env) ListHorizontalPodAutoscalers(t *testing.T, opts ...ListOption) []v2.HorizontalPodAutoscaler {
+	t.Helper()
+	items, err := env.ListHorizontalPodAutoscalersE(t, opts...)
+	require.NoError(t, err, "failed to list HorizontalPodAutoscalers")
+	return items
+}
+
+// ListHorizontalPodAutoscalersE lists the HorizontalPodAutoscalers matching opts, returning the error.
+func (env *Env) ListHorizontalPodAutoscalersE(t *testing.T, opts ...ListOption) ([]v2.HorizontalPodAutoscaler, error) {
+	t.Helper()
+	list, err := env.Client.AutoscalingV2().HorizontalPodAutoscalers(env.Kube.Namespace).List(env.Ctx, listOptions(opts))
+	if err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+// WaitForHorizontalPodAutoscaler watches the HorizontalPodAutoscaler called name until ready reports true
+// for it and returns it. A nil ready waits for it to exist. The test fails
+// after timeout.
+func (env *Env) WaitForHorizontalPodAutoscaler(t *testing.T, name string, ready func(*v2.HorizontalPodAutoscaler) bool, timeout time.Duration) *v2.HorizontalPodAutoscaler {
+	t.Helper()
+	return waitFor(t, env, "HorizontalPodAutoscaler", name, env.Client.AutoscalingV2().HorizontalPodAutoscalers(env.Kube.Namespace), ready, timeout)
+}
+
 // GetAutoscalingV2beta1HorizontalPodAutoscaler fetches a AutoscalingV2beta1HorizontalPodAutoscaler by name, failing the test on error.
 func (env *Env) GetAutoscalingV2beta1HorizontalPodAutoscaler(t *testing.T, name string) *v2beta1.HorizontalPodAutoscaler {
 	t.Helper()
@@ -480,6 +1238,32 @@ func (env *Env) GetAutoscalingV2beta1HorizontalPodAutoscalerE(t *testing.T, name
 	return env.Client.AutoscalingV2beta1().HorizontalPodAutoscalers(env.Kube.Namespace).Get(env.Ctx, name, v11.GetOptions{})
 }
 
+// ListAutoscalingV2beta1HorizontalPodAutoscalers lists the AutoscalingV2beta1HorizontalPodAutoscalers matching opts, failing the test on error.
+func (env *Env) ListAutoscalingV2beta1HorizontalPodAutoscalers(t *testing.T, opts ...ListOption) []v2beta1.HorizontalPodAutoscaler {
+	t.Helper()
+	items, err := env.ListAutoscalingV2beta1HorizontalPodAutoscalersE(t, opts...)
+	require.NoError(t, err, "failed to list AutoscalingV2beta1HorizontalPodAutoscalers")
+	return items
+}
+
+// ListAutoscalingV2beta1HorizontalPodAutoscalersE lists the AutoscalingV2beta1HorizontalPodAutoscalers matching opts, returning the error.
+func (env *Env) ListAutoscalingV2beta1HorizontalPodAutoscalersE(t *testing.T, opts ...ListOption) ([]v2beta1.HorizontalPodAutoscaler, error) {
+	t.Helper()
+	list, err := env.Client.AutoscalingV2beta1().HorizontalPodAutoscalers(env.Kube.Namespace).List(env.Ctx, listOptions(opts))
+	if err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+// WaitForAutoscalingV2beta1HorizontalPodAutoscaler watches the AutoscalingV2beta1HorizontalPodAutoscaler called name until ready reports true
+// for it and returns it. A nil ready waits for it to exist. The test fails
+// after timeout.
+func (env *Env) WaitForAutoscalingV2beta1HorizontalPodAutoscaler(t *testing.T, name string, ready func(*v2beta1.HorizontalPodAutoscaler) bool, timeout time.Duration) *v2beta1.HorizontalPodAutoscaler {
+	t.Helper()
+	return waitFor(t, env, "HorizontalPodAutoscaler", name, env.Client.AutoscalingV2beta1().HorizontalPodAutoscalers(env.Kube.Namespace), ready, timeout)
+}
+
 // GetAutoscalingV2beta2HorizontalPodAutoscaler fetches a AutoscalingV2beta2HorizontalPodAutoscaler by name, failing the test on error.
 func (env *Env) GetAutoscalingV2beta2HorizontalPodAutoscaler(t *testing.T, name string) *v2beta2.HorizontalPodAutoscaler {
 	t.Helper()
@@ -494,6 +1278,32 @@ func (env *Env) GetAutoscalingV2beta2HorizontalPodAutoscalerE(t *testing.T, name
 	return env.Client.AutoscalingV2beta2().HorizontalPodAutoscalers(env.Kube.Namespace).Get(env.Ctx, name, v11.GetOptions{})
 }
 
+// ListAutoscalingV2beta2HorizontalPodAutoscalers lists the AutoscalingV2beta2HorizontalPodAutoscalers matching opts, failing the test on error.
+func (env *Env) ListAutoscalingV2beta2HorizontalPodAutoscalers(t *testing.T, opts ...ListOption) []v2beta2.HorizontalPodAutoscaler {
+	t.Helper()
+	items, err := env.ListAutoscalingV2beta2HorizontalPodAutoscalersE(t, opts...)
+	require.NoError(t, err, "failed to list AutoscalingV2beta2HorizontalPodAutoscalers")
+	return items
+}
+
+// ListAutoscalingV2beta2HorizontalPodAutoscalersE lists the AutoscalingV2beta2HorizontalPodAutoscalers matching opts, returning the error.
+func (env *Env) ListAutoscalingV2beta2HorizontalPodAutoscalersE(t *testing.T, opts ...ListOption) ([]v2beta2.HorizontalPodAutoscaler, error) {
+	t.Helper()
+	list, err := env.Client.AutoscalingV2beta2().HorizontalPodAutoscalers(env.Kube.Namespace).List(env.Ctx, listOptions(opts))
+	if err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+// WaitForAutoscalingV2beta2HorizontalPodAutoscaler watches the AutoscalingV2beta2HorizontalPodAutoscaler called name until ready reports true
+// for it and returns it. A nil ready waits for it to exist. The test fails
+// after timeout.
+func (env *Env) WaitForAutoscalingV2beta2HorizontalPodAutoscaler(t *testing.T, name string, ready func(*v2beta2.HorizontalPodAutoscaler) bool, timeout time.Duration) *v2beta2.HorizontalPodAutoscaler {
+	t.Helper()
+	return waitFor(t, env, "HorizontalPodAutoscaler", name, env.Client.AutoscalingV2beta2().HorizontalPodAutoscalers(env.Kube.Namespace), ready, timeout)
+}
+
 // GetCronJob fetches a CronJob by name, failing the test on error.
 func (env *Env) GetCronJob(t *testing.T, name string) *v14.CronJob {
 	t.Helper()
@@ -508,6 +1318,32 @@ func (env *Env) GetCronJobE(t *testing.T, name string) (*v14.CronJob, error) {
 	return env.Client.BatchV1().CronJobs(env.Kube.Namespace).Get(env.Ctx, name, v11.GetOptions{})
 }
 
+// ListCronJobs lists the CronJobs matching opts, failing the test on error.
+func (env *Env) ListCronJobs(t *testing.T, opts ...ListOption) []v14.CronJob {
+	t.Helper()
+	items, err := env.ListCronJobsE(t, opts...)
+	require.NoError(t, err, "failed to list CronJobs")
+	return items
+}
+
+// ListCronJobsE lists the CronJobs matching opts, returning the error.
+func (env *Env) ListCronJobsE(t *testing.T, opts ...ListOption) ([]v14.CronJob, error) {
+	t.Helper()
+	list, err := env.Client.BatchV1().CronJobs(env.Kube.Namespace).List(env.Ctx, listOptions(opts))
+	if err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+// WaitForCronJob watches the CronJob called name until ready reports true
+// for it and returns it. A nil ready waits for it to exist. The test fails
+// after timeout.
+func (env *Env) WaitForCronJob(t *testing.T, name string, ready func(*v14.CronJob) bool, timeout time.Duration) *v14.CronJob {
+	t.Helper()
+	return waitFor(t, env, "CronJob", name, env.Client.BatchV1().CronJobs(env.Kube.Namespace), ready, timeout)
+}
+
 // GetJob fetches a Job by name, failing the test on error.
 func (env *Env) GetJob(t *testing.T, name string) *v14.Job {
 	t.Helper()
@@ -522,6 +1358,32 @@ func (env *Env) GetJobE(t *testing.T, name string) (*v14.Job, error) {
 	return env.Client.BatchV1().Jobs(env.Kube.Namespace).Get(env.Ctx, name, v11.GetOptions{})
 }
 
+// ListJobs lists the Jobs matching opts, failing the test on error.
+func (env *Env) ListJobs(t *testing.T, opts ...ListOption) []v14.Job {
+	t.Helper()
+	items, err := env.ListJobsE(t, opts...)
+	require.NoError(t, err, "failed to list Jobs")
+	return items
+}
+
+// ListJobsE lists the Jobs matching opts, returning the error.
+func (env *Env) ListJobsE(t *testing.T, opts ...ListOption) ([]v14.Job, error) {
+	t.Helper()
+	list, err := env.Client.BatchV1().Jobs(env.Kube.Namespace).List(env.Ctx, listOptions(opts))
+	if err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+// WaitForJob watches the Job called name until ready reports true
+// for it and returns it. A nil ready waits for it to exist. The test fails
+// after timeout.
+func (env *Env) WaitForJob(t *testing.T, name string, ready func(*v14.Job) bool, timeout time.Duration) *v14.Job {
+	t.Helper()
+	return waitFor(t, env, "Job", name, env.Client.BatchV1().Jobs(env.Kube.Namespace), ready, timeout)
+}
+
 // GetBatchV1beta1CronJob fetches a BatchV1beta1CronJob by name, failing the test on error.
 func (env *Env) GetBatchV1beta1CronJob(t *testing.T, name string) *v1beta12.CronJob {
 	t.Helper()
@@ -536,6 +1398,32 @@ func (env *Env) GetBatchV1beta1CronJobE(t *testing.T, name string) (*v1beta12.Cr
 	return env.Client.BatchV1beta1().CronJobs(env.Kube.Namespace).Get(env.Ctx, name, v11.GetOptions{})
 }
 
+// ListBatchV1beta1CronJobs lists the BatchV1beta1CronJobs matching opts, failing the test on error.
+func (env *Env) ListBatchV1beta1CronJobs(t *testing.T, opts ...ListOption) []v1beta12.CronJob {
+	t.Helper()
+	items, err := env.ListBatchV1beta1CronJobsE(t, opts...)
+	require.NoError(t, err, "failed to list BatchV1beta1CronJobs")
+	return items
+}
+
+// ListBatchV1beta1CronJobsE lists the BatchV1beta1CronJobs matching opts, returning the error.
+func (env *Env) ListBatchV1beta1CronJobsE(t *testing.T, opts ...ListOption) ([]v1beta12.CronJob, error) {
+	t.Helper()
+	list, err := env.Client.BatchV1beta1().CronJobs(env.Kube.Namespace).List(env.Ctx, listOptions(opts))
+	if err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+// WaitForBatchV1beta1CronJob watches the BatchV1beta1CronJob called name until ready reports true
+// for it and returns it. A nil ready waits for it to exist. The test fails
+// after timeout.
+func (env *Env) WaitForBatchV1beta1CronJob(t *testing.T, name string, ready func(*v1beta12.CronJob) bool, timeout time.Duration) *v1beta12.CronJob {
+	t.Helper()
+	return waitFor(t, env, "CronJob", name, env.Client.BatchV1beta1().CronJobs(env.Kube.Namespace), ready, timeout)
+}
+
 // GetCertificateSigningRequest fetches a CertificateSigningRequest by name, failing the test on error.
 func (env *Env) GetCertificateSigningRequest(t *testing.T, name string) *v15.CertificateSigningRequest {
 	t.Helper()
@@ -550,6 +1438,32 @@ func (env *Env) GetCertificateSigningRequestE(t *testing.T, name string) (*v15.C
 	return env.Client.CertificatesV1().CertificateSigningRequests().Get(env.Ctx, name, v11.GetOptions{})
 }
 
+// ListCertificateSigningRequests lists the CertificateSigningRequests matching opts, failing the test on error.
+func (env *Env) ListCertificateSigningRequests(t *testing.T, opts ...ListOption) []v15.CertificateSigningRequest {
+	t.Helper()
+	items, err := env.ListCertificateSigningRequestsE(t, opts...)
+	require.NoError(t, err, "failed to list CertificateSigningRequests")
+	return items
+}
+
+// ListCertificateSigningRequestsE lists the CertificateSigningRequests matching opts, returning the error.
+func (env *Env) ListCertificateSigningRequestsE(t *testing.T, opts ...ListOption) ([]v15.CertificateSigningRequest, error) {
+	t.Helper()
+	list, err := env.Client.CertificatesV1().CertificateSigningRequests().List(env.Ctx, listOptions(opts))
+	if err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+// WaitForCertificateSigningRequest watches the CertificateSigningRequest called name until ready reports true
+// for it and returns it. A nil ready waits for it to exist. The test fails
+// after timeout.
+func (env *Env) WaitForCertificateSigningRequest(t *testing.T, name string, ready func(*v15.CertificateSigningRequest) bool, timeout time.Duration) *v15.CertificateSigningRequest {
+	t.Helper()
+	return waitFor(t, env, "CertificateSigningRequest", name, env.Client.CertificatesV1().CertificateSigningRequests(), ready, timeout)
+}
+
 // GetCertificatesV1alpha1ClusterTrustBundle fetches a CertificatesV1alpha1ClusterTrustBundle by name, failing the test on error.
 func (env *Env) GetCertificatesV1alpha1ClusterTrustBundle(t *testing.T, name string) *v1alpha11.ClusterTrustBundle {
 	t.Helper()
@@ -564,6 +1478,32 @@ func (env *Env) GetCertificatesV1alpha1ClusterTrustBundleE(t *testing.T, name st
 	return env.Client.CertificatesV1alpha1().ClusterTrustBundles().Get(env.Ctx, name, v11.GetOptions{})
 }
 
+// ListCertificatesV1alpha1ClusterTrustBundles lists the CertificatesV1alpha1ClusterTrustBundles matching opts, failing the test on error.
+func (env *Env) ListCertificatesV1alpha1ClusterTrustBundles(t *testing.T, opts ...ListOption) []v1alpha11.ClusterTrustBundle {
+	t.Helper()
+	items, err := env.ListCertificatesV1alpha1ClusterTrustBundlesE(t, opts...)
+	require.NoError(t, err, "failed to list CertificatesV1alpha1ClusterTrustBundles")
+	return items
+}
+
+// ListCertificatesV1alpha1ClusterTrustBundlesE lists the CertificatesV1alpha1ClusterTrustBundles matching opts, returning the error.
+func (env *Env) ListCertificatesV1alpha1ClusterTrustBundlesE(t *testing.T, opts ...ListOption) ([]v1alpha11.ClusterTrustBundle, error) {
+	t.Helper()
+	list, err := env.Client.CertificatesV1alpha1().ClusterTrustBundles().List(env.Ctx, listOptions(opts))
+	if err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+// WaitForCertificatesV1alpha1ClusterTrustBundle watches the CertificatesV1alpha1ClusterTrustBundle called name until ready reports true
+// for it and returns it. A nil ready waits for it to exist. The test fails
+// after timeout.
+func (env *Env) WaitForCertificatesV1alpha1ClusterTrustBundle(t *testing.T, name string, ready func(*v1alpha11.ClusterTrustBundle) bool, timeout time.Duration) *v1alpha11.ClusterTrustBundle {
+	t.Helper()
+	return waitFor(t, env, "ClusterTrustBundle", name, env.Client.CertificatesV1alpha1().ClusterTrustBundles(), ready, timeout)
+}
+
 // GetCertificatesV1beta1CertificateSigningRequest fetches a CertificatesV1beta1CertificateSigningRequest by name, failing the test on error.
 func (env *Env) GetCertificatesV1beta1CertificateSigningRequest(t *testing.T, name string) *v1beta13.CertificateSigningRequest {
 	t.Helper()
@@ -578,6 +1518,32 @@ func (env *Env) GetCertificatesV1beta1CertificateSigningRequestE(t *testing.T, n
 	return env.Client.CertificatesV1beta1().CertificateSigningRequests().Get(env.Ctx, name, v11.GetOptions{})
 }
 
+// ListCertificatesV1beta1CertificateSigningRequests lists the CertificatesV1beta1CertificateSigningRequests matching opts, failing the test on error.
+func (env *Env) ListCertificatesV1beta1CertificateSigningRequests(t *testing.T, opts ...ListOption) []v1beta13.CertificateSigningRequest {
+	t.Helper()
+	items, err := env.ListCertificatesV1beta1CertificateSigningRequestsE(t, opts...)
+	require.NoError(t, err, "failed to list CertificatesV1beta1CertificateSigningRequests")
+	return items
+}
+
+// ListCertificatesV1beta1CertificateSigningRequestsE lists the CertificatesV1beta1CertificateSigningRequests matching opts, returning the error.
+func (env *Env) ListCertificatesV1beta1CertificateSigningRequestsE(t *testing.T, opts ...ListOption) ([]v1beta13.CertificateSigningRequest, error) {
+	t.Helper()
+	list, err := env.Client.CertificatesV1beta1().CertificateSigningRequests().List(env.Ctx, listOptions(opts))
+	if err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+// WaitForCertificatesV1beta1CertificateSigningRequest watches the CertificatesV1beta1CertificateSigningRequest called name until ready reports true
+// for it and returns it. A nil ready waits for it to exist. The test fails
+// after timeout.
+func (env *Env) WaitForCertificatesV1beta1CertificateSigningRequest(t *testing.T, name string, ready func(*v1beta13.CertificateSigningRequest) bool, timeout time.Duration) *v1beta13.CertificateSigningRequest {
+	t.Helper()
+	return waitFor(t, env, "CertificateSigningRequest", name, env.Client.CertificatesV1beta1().CertificateSigningRequests(), ready, timeout)
+}
+
 // GetClusterTrustBundle fetches a ClusterTrustBundle by name, failing the test on error.
 func (env *Env) GetClusterTrustBundle(t *testing.T, name string) *v1beta13.ClusterTrustBundle {
 	t.Helper()
@@ -592,6 +1558,32 @@ func (env *Env) GetClusterTrustBundleE(t *testing.T, name string) (*v1beta13.Clu
 	return env.Client.CertificatesV1beta1().ClusterTrustBundles().Get(env.Ctx, name, v11.GetOptions{})
 }
 
+// ListClusterTrustBundles lists the ClusterTrustBundles matching opts, failing the test on error.
+func (env *Env) ListClusterTrustBundles(t *testing.T, opts ...ListOption) []v1beta13.ClusterTrustBundle {
+	t.Helper()
+	items, err := env.ListClusterTrustBundlesE(t, opts...)
+	require.NoError(t, err, "failed to list ClusterTrustBundles")
+	return items
+}
+
+// ListClusterTrustBundlesE lists the ClusterTrustBundles matching opts, returning the error.
+func (env *Env) ListClusterTrustBundlesE(t *testing.T, opts ...ListOption) ([]v1beta13.ClusterTrustBundle, error) {
+	t.Helper()
+	list, err := env.Client.CertificatesV1beta1().ClusterTrustBundles().List(env.Ctx, listOptions(opts))
+	if err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+// WaitForClusterTrustBundle watches the ClusterTrustBundle called name until ready reports true
+// for it and returns it. A nil ready waits for it to exist. The test fails
+// after timeout.
+func (env *Env) WaitForClusterTrustBundle(t *testing.T, name string, ready func(*v1beta13.ClusterTrustBundle) bool, timeout time.Duration) *v1beta13.ClusterTrustBundle {
+	t.Helper()
+	return waitFor(t, env, "ClusterTrustBundle", name, env.Client.CertificatesV1beta1().ClusterTrustBundles(), ready, timeout)
+}
+
 // GetPodCertificateRequest fetches a PodCertificateRequest by name, failing the test on error.
 func (env *Env) GetPodCertificateRequest(t *testing.T, name string) *v1beta13.PodCertificateRequest {
 	t.Helper()
@@ -606,6 +1598,32 @@ func (env *Env) GetPodCertificateRequestE(t *testing.T, name string) (*v1beta13.
 	return env.Client.CertificatesV1beta1().PodCertificateRequests(env.Kube.Namespace).Get(env.Ctx, name, v11.GetOptions{})
 }
 
+// ListPodCertificateRequests lists the PodCertificateRequests matching opts, failing the test on error.
+func (env *Env) ListPodCertificateRequests(t *testing.T, opts ...ListOption) []v1beta13.PodCertificateRequest {
+	t.Helper()
+	items, err := env.ListPodCertificateRequestsE(t, opts...)
+	require.NoError(t, err, "failed to list PodCertificateRequests")
+	return items
+}
+
+// ListPodCertificateRequestsE lists the PodCertificateRequests matching opts, returning the error.
+func (env *Env) ListPodCertificateRequestsE(t *testing.T, opts ...ListOption) ([]v1beta13.PodCertificateRequest, error) {
+	t.Helper()
+	list, err := env.Client.CertificatesV1beta1().PodCertificateRequests(env.Kube.Namespace).List(env.Ctx, listOptions(opts))
+	if err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+// WaitForPodCertificateRequest watches the PodCertificateRequest called name until ready reports true
+// for it and returns it. A nil ready waits for it to exist. The test fails
+// after timeout.
+func (env *Env) WaitForPodCertificateRequest(t *testing.T, name string, ready func(*v1beta13.PodCertificateRequest) bool, timeout time.Duration) *v1beta13.PodCertificateRequest {
+	t.Helper()
+	return waitFor(t, env, "PodCertificateRequest", name, env.Client.CertificatesV1beta1().PodCertificateRequests(env.Kube.Namespace), ready, timeout)
+}
+
 // GetLease fetches a Lease by name, failing the test on error.
 func (env *Env) GetLease(t *testing.T, name string) *v16.Lease {
 	t.Helper()
@@ -620,6 +1638,32 @@ func (env *Env) GetLeaseE(t *testing.T, name string) (*v16.Lease, error) {
 	return env.Client.CoordinationV1().Leases(env.Kube.Namespace).Get(env.Ctx, name, v11.GetOptions{})
 }
 
+// ListLeases lists the Leases matching opts, failing the test on error.
+func (env *Env) ListLeases(t *testing.T, opts ...ListOption) []v16.Lease {
+	t.Helper()
+	items, err := env.ListLeasesE(t, opts...)
+	require.NoError(t, err, "failed to list Leases")
+	return items
+}
+
+// ListLeasesE lists the Leases matching opts, returning the error.
+func (env *Env) ListLeasesE(t *testing.T, opts ...ListOption) ([]v16.Lease, error) {
+	t.Helper()
+	list, err := env.Client.CoordinationV1().Leases(env.Kube.Namespace).List(env.Ctx, listOptions(opts))
+	if err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+// WaitForLease watches the Lease called name until ready reports true
+// for it and returns it. A nil ready waits for it to exist. The test fails
+// after timeout.
+func (env *Env) WaitForLease(t *testing.T, name string, ready func(*v16.Lease) bool, timeout time.Duration) *v16.Lease {
+	t.Helper()
+	return waitFor(t, env, "Lease", name, env.Client.CoordinationV1().Leases(env.Kube.Namespace), ready, timeout)
+}
+
 // GetCoordinationV1alpha2LeaseCandidate fetches a CoordinationV1alpha2LeaseCandidate by name, failing the test on error.
 func (env *Env) GetCoordinationV1alpha2LeaseCandidate(t *testing.T, name string) *v1alpha2.LeaseCandidate {
 	t.Helper()
@@ -634,6 +1678,32 @@ func (env *Env) GetCoordinationV1alpha2LeaseCandidateE(t *testing.T, name string
 	return env.Client.CoordinationV1alpha2().LeaseCandidates(env.Kube.Namespace).Get(env.Ctx, name, v11.GetOptions{})
 }
 
+// ListCoordinationV1alpha2LeaseCandidates lists the CoordinationV1alpha2LeaseCandidates matching opts, failing the test on error.
+func (env *Env) ListCoordinationV1alpha2LeaseCandidates(t *testing.T, opts ...ListOption) []v1alpha2.LeaseCandidate {
+	t.Helper()
+	items, err := env.ListCoordinationV1alpha2LeaseCandidatesE(t, opts...)
+	require.NoError(t, err, "failed to list CoordinationV1alpha2LeaseCandidates")
+	return items
+}
+
+// ListCoordinationV1alpha2LeaseCandidatesE lists the CoordinationV1alpha2LeaseCandidates matching opts, returning the error.
+func (env *Env) ListCoordinationV1alpha2LeaseCandidatesE(t *testing.T, opts ...ListOption) ([]v1alpha2.LeaseCandidate, error) {
+	t.Helper()
+	list, err := env.Client.CoordinationV1alpha2().LeaseCandidates(env.Kube.Namespace).List(env.Ctx, listOptions(opts))
+	if err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+// WaitForCoordinationV1alpha2LeaseCandidate watches the CoordinationV1alpha2LeaseCandidate called name until ready reports true
+// for it and returns it. A nil ready waits for it to exist. The test fails
+// after timeout.
+func (env *Env) WaitForCoordinationV1alpha2LeaseCandidate(t *testing.T, name string, ready func(*v1alpha2.LeaseCandidate) bool, timeout time.Duration) *v1alpha2.LeaseCandidate {
+	t.Helper()
+	return waitFor(t, env, "LeaseCandidate", name, env.Client.CoordinationV1alpha2().LeaseCandidates(env.Kube.Namespace), ready, timeout)
+}
+
 // GetCoordinationV1beta1Lease fetches a CoordinationV1beta1Lease by name, failing the test on error.
 func (env *Env) GetCoordinationV1beta1Lease(t *testing.T, name string) *v1beta14.Lease {
 	t.Helper()
@@ -648,6 +1718,32 @@ func (env *Env) GetCoordinationV1beta1LeaseE(t *testing.T, name string) (*v1beta
 	return env.Client.CoordinationV1beta1().Leases(env.Kube.Namespace).Get(env.Ctx, name, v11.GetOptions{})
 }
 
+// ListCoordinationV1beta1Leases lists the CoordinationV1beta1Leases matching opts, failing the test on error.
+func (env *Env) ListCoordinationV1beta1Leases(t *testing.T, opts ...ListOption) []v1beta14.Lease {
+	t.Helper()
+	items, err := env.ListCoordinationV1beta1LeasesE(t, opts...)
+	require.NoError(t, err, "failed to list CoordinationV1beta1Leases")
+	return items
+}
+
+// ListCoordinationV1beta1LeasesE lists the CoordinationV1beta1Leases matching opts, returning the error.
+func (env *Env) ListCoordinationV1beta1LeasesE(t *testing.T, opts ...ListOption) ([]v1beta14.Lease, error) {
+	t.Helper()
+	list, err := env.Client.CoordinationV1beta1().Leases(env.Kube.Namespace).List(env.Ctx, listOptions(opts))
+	if err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+// WaitForCoordinationV1beta1Lease watches the CoordinationV1beta1Lease called name until ready reports true
+// for it and returns it. A nil ready waits for it to exist. The test fails
+// after timeout.
+func (env *Env) WaitForCoordinationV1beta1Lease(t *testing.T, name string, ready func(*v1beta14.Lease) bool, timeout time.Duration) *v1beta14.Lease {
+	t.Helper()
+	return waitFor(t, env, "Lease", name, env.Client.CoordinationV1beta1().Leases(env.Kube.Namespace), ready, timeout)
+}
+
 // GetLeaseCandidate fetches a LeaseCandidate by name, failing the test on error.
 func (env *Env) GetLeaseCandidate(t *testing.T, name string) *v1beta14.LeaseCandidate {
 	t.Helper()
@@ -662,6 +1758,32 @@ func (env *Env) GetLeaseCandidateE(t *testing.T, name string) (*v1beta14.LeaseCa
 	return env.Client.CoordinationV1beta1().LeaseCandidates(env.Kube.Namespace).Get(env.Ctx, name, v11.GetOptions{})
 }
 
+// ListLeaseCandidates lists the LeaseCandidates matching opts, failing the test on error.
+func (env *Env) ListLeaseCandidates(t *testing.T, opts ...ListOption) []v1beta14.LeaseCandidate {
+	t.Helper()
+	items, err := env.ListLeaseCandidatesE(t, opts...)
+	require.NoError(t, err, "failed to list LeaseCandidates")
+	return items
+}
+
+// ListLeaseCandidatesE lists the LeaseCandidates matching opts, returning the error.
+func (env *Env) ListLeaseCandidatesE(t *testing.T, opts ...ListOption) ([]v1beta14.LeaseCandidate, error) {
+	t.Helper()
+	list, err := env.Client.CoordinationV1beta1().LeaseCandidates(env.Kube.Namespace).List(env.Ctx, listOptions(opts))
+	if err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+// WaitForLeaseCandidate watches the LeaseCandidate called name until ready reports true
+// for it and returns it. A nil ready waits for it to exist. The test fails
+// after timeout.
+func (env *Env) WaitForLeaseCandidate(t *testing.T, name string, ready func(*v1beta14.LeaseCandidate) bool, timeout time.Duration) *v1beta14.LeaseCandidate {
+	t.Helper()
+	return waitFor(t, env, "LeaseCandidate", name, env.Client.CoordinationV1beta1().LeaseCandidates(env.Kube.Namespace), ready, timeout)
+}
+
 // GetComponentStatus fetches a ComponentStatus by name, failing the test on error.
 func (env *Env) GetComponentStatus(t *testing.T, name string) *v17.ComponentStatus {
 	t.Helper()
@@ -676,6 +1798,32 @@ func (env *Env) GetComponentStatusE(t *testing.T, name string) (*v17.ComponentSt
 	return env.Client.CoreV1().ComponentStatuses().Get(env.Ctx, name, v11.GetOptions{})
 }
 
+// ListComponentStatuses lists the ComponentStatuses matching opts, failing the test on error.
+func (env *Env) ListComponentStatuses(t *testing.T, opts ...ListOption) []v17.ComponentStatus {
+	t.Helper()
+	items, err := env.ListComponentStatusesE(t, opts...)
+	require.NoError(t, err, "failed to list ComponentStatuses")
+	return items
+}
+
+// ListComponentStatusesE lists the ComponentStatuses matching opts, returning the error.
+func (env *Env) ListComponentStatusesE(t *testing.T, opts ...ListOption) ([]v17.ComponentStatus, error) {
+	t.Helper()
+	list, err := env.Client.CoreV1().ComponentStatuses().List(env.Ctx, listOptions(opts))
+	if err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+// WaitForComponentStatus watches the ComponentStatus called name until ready reports true
+// for it and returns it. A nil ready waits for it to exist. The test fails
+// after timeout.
+func (env *Env) WaitForComponentStatus(t *testing.T, name string, ready func(*v17.ComponentStatus) bool, timeout time.Duration) *v17.ComponentStatus {
+	t.Helper()
+	return waitFor(t, env, "ComponentStatus", name, env.Client.CoreV1().ComponentStatuses(), ready, timeout)
+}
+
 // GetConfigMap fetches a ConfigMap by name, failing the test on error.
 func (env *Env) GetConfigMap(t *testing.T, name string) *v17.ConfigMap {
 	t.Helper()
@@ -690,6 +1838,32 @@ func (env *Env) GetConfigMapE(t *testing.T, name string) (*v17.ConfigMap, error)
 	return env.Client.CoreV1().ConfigMaps(env.Kube.Namespace).Get(env.Ctx, name, v11.GetOptions{})
 }
 
+// ListConfigMaps lists the ConfigMaps matching opts, failing the test on error.
+func (env *Env) ListConfigMaps(t *testing.T, opts ...ListOption) []v17.ConfigMap {
+	t.Helper()
+	items, err := env.ListConfigMapsE(t, opts...)
+	require.NoError(t, err, "failed to list ConfigMaps")
+	return items
+}
+
+// ListConfigMapsE lists the ConfigMaps matching opts, returning the error.
+func (env *Env) ListConfigMapsE(t *testing.T, opts ...ListOption) ([]v17.ConfigMap, error) {
+	t.Helper()
+	list, err := env.Client.CoreV1().ConfigMaps(env.Kube.Namespace).List(env.Ctx, listOptions(opts))
+	if err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+// WaitForConfigMap watches the ConfigMap called name until ready reports true
+// for it and returns it. A nil ready waits for it to exist. The test fails
+// after timeout.
+func (env *Env) WaitForConfigMap(t *testing.T, name string, ready func(*v17.ConfigMap) bool, timeout time.Duration) *v17.ConfigMap {
+	t.Helper()
+	return waitFor(t, env, "ConfigMap", name, env.Client.CoreV1().ConfigMaps(env.Kube.Namespace), ready, timeout)
+}
+
 // GetEndpoints fetches a Endpoints by name, failing the test on error.
 func (env *Env) GetEndpoints(t *testing.T, name string) *v17.Endpoints {
 	t.Helper()
@@ -704,6 +1878,32 @@ func (env *Env) GetEndpointsE(t *testing.T, name string) (*v17.Endpoints, error)
 	return env.Client.CoreV1().Endpoints(env.Kube.Namespace).Get(env.Ctx, name, v11.GetOptions{})
 }
 
+// ListEndpoints lists the Endpoints matching opts, failing the test on error.
+func (env *Env) ListEndpoints(t *testing.T, opts ...ListOption) []v17.Endpoints {
+	t.Helper()
+	items, err := env.ListEndpointsE(t, opts...)
+	require.NoError(t, err, "failed to list Endpoints")
+	return items
+}
+
+// ListEndpointsE lists the Endpoints matching opts, returning the error.
+func (env *Env) ListEndpointsE(t *testing.T, opts ...ListOption) ([]v17.Endpoints, error) {
+	t.Helper()
+	list, err := env.Client.CoreV1().Endpoints(env.Kube.Namespace).List(env.Ctx, listOptions(opts))
+	if err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+// WaitForEndpoints watches the Endpoints called name until ready reports true
+// for it and returns it. A nil ready waits for it to exist. The test fails
+// after timeout.
+func (env *Env) WaitForEndpoints(t *testing.T, name string, ready func(*v17.Endpoints) bool, timeout time.Duration) *v17.Endpoints {
+	t.Helper()
+	return waitFor(t, env, "Endpoints", name, env.Client.CoreV1().Endpoints(env.Kube.Namespace), ready, timeout)
+}
+
 // GetEvent fetches a Event by name, failing the test on error.
 func (env *Env) GetEvent(t *testing.T, name string) *v17.Event {
 	t.Helper()
@@ -718,6 +1918,32 @@ func (env *Env) GetEventE(t *testing.T, name string) (*v17.Event, error) {
 	return env.Client.CoreV1().Events(env.Kube.Namespace).Get(env.Ctx, name, v11.GetOptions{})
 }
 
+// ListEvents lists the Events matching opts, failing the test on error.
+func (env *Env) ListEvents(t *testing.T, opts ...ListOption) []v17.Event {
+	t.Helper()
+	items, err := env.ListEventsE(t, opts...)
+	require.NoError(t, err, "failed to list Events")
+	return items
+}
+
+// ListEventsE lists the Events matching opts, returning the error.
+func (env *Env) ListEventsE(t *testing.T, opts ...ListOption) ([]v17.Event, error) {
+	t.Helper()
+	list, err := env.Client.CoreV1().Events(env.Kube.Namespace).List(env.Ctx, listOptions(opts))
+	if err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+// WaitForEvent watches the Event called name until ready reports true
+// for it and returns it. A nil ready waits for it to exist. The test fails
+// after timeout.
+func (env *Env) WaitForEvent(t *testing.T, name string, ready func(*v17.Event) bool, timeout time.Duration) *v17.Event {
+	t.Helper()
+	return waitFor(t, env, "Event", name, env.Client.CoreV1().Events(env.Kube.Namespace), ready, timeout)
+}
+
 // GetLimitRange fetches a LimitRange by name, failing the test on error.
 func (env *Env) GetLimitRange(t *testing.T, name string) *v17.LimitRange {
 	t.Helper()
@@ -732,6 +1958,32 @@ func (env *Env) GetLimitRangeE(t *testing.T, name string) (*v17.LimitRange, erro
 	return env.Client.CoreV1().LimitRanges(env.Kube.Namespace).Get(env.Ctx, name, v11.GetOptions{})
 }
 
+// ListLimitRanges lists the LimitRanges matching opts, failing the test on error.
+func (env *Env) ListLimitRanges(t *testing.T, opts ...ListOption) []v17.LimitRange {
+	t.Helper()
+	items, err := env.ListLimitRangesE(t, opts...)
+	require.NoError(t, err, "failed to list LimitRanges")
+	return items
+}
+
+// ListLimitRangesE lists the LimitRanges matching opts, returning the error.
+func (env *Env) ListLimitRangesE(t *testing.T, opts ...ListOption) ([]v17.LimitRange, error) {
+	t.Helper()
+	list, err := env.Client.CoreV1().LimitRanges(env.Kube.Namespace).List(env.Ctx, listOptions(opts))
+	if err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+// WaitForLimitRange watches the LimitRange called name until ready reports true
+// for it and returns it. A nil ready waits for it to exist. The test fails
+// after timeout.
+func (env *Env) WaitForLimitRange(t *testing.T, name string, ready func(*v17.LimitRange) bool, timeout time.Duration) *v17.LimitRange {
+	t.Helper()
+	return waitFor(t, env, "LimitRange", name, env.Client.CoreV1().LimitRanges(env.Kube.Namespace), ready, timeout)
+}
+
 // GetNamespace fetches a Namespace by name, failing the test on error.
 func (env *Env) GetNamespace(t *testing.T, name string) *v17.Namespace {
 	t.Helper()
@@ -746,6 +1998,32 @@ func (env *Env) GetNamespaceE(t *testing.T, name string) (*v17.Namespace, error)
 	return env.Client.CoreV1().Namespaces().Get(env.Ctx, name, v11.GetOptions{})
 }
 
+// ListNamespaces lists the Namespaces matching opts, failing the test on error.
+func (env *Env) ListNamespaces(t *testing.T, opts ...ListOption) []v17.Namespace {
+	t.Helper()
+	items, err := env.ListNamespacesE(t, opts...)
+	require.NoError(t, err, "failed to list Namespaces")
+	return items
+}
+
+// ListNamespacesE lists the Namespaces matching opts, returning the error.
+func (env *Env) ListNamespacesE(t *testing.T, opts ...ListOption) ([]v17.Namespace, error) {
+	t.Helper()
+	list, err := env.Client.CoreV1().Namespaces().List(env.Ctx, listOptions(opts))
+	if err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+// WaitForNamespace watches the Namespace called name until ready reports true
+// for it and returns it. A nil ready waits for it to exist. The test fails
+// after timeout.
+func (env *Env) WaitForNamespace(t *testing.T, name string, ready func(*v17.Namespace) bool, timeout time.Duration) *v17.Namespace {
+	t.Helper()
+	return waitFor(t, env, "Namespace", name, env.Client.CoreV1().Namespaces(), ready, timeout)
+}
+
 // GetNode fetches a Node by name, failing the test on error.
 func (env *Env) GetNode(t *testing.T, name string) *v17.Node {
 	t.Helper()
@@ -760,6 +2038,32 @@ func (env *Env) GetNodeE(t *testing.T, name string) (*v17.Node, error) {
 	return env.Client.CoreV1().Nodes().Get(env.Ctx, name, v11.GetOptions{})
 }
 
+// ListNodes lists the Nodes matching opts, failing the test on error.
+func (env *Env) ListNodes(t *testing.T, opts ...ListOption) []v17.Node {
+	t.Helper()
+	items, err := env.ListNodesE(t, opts...)
+	require.NoError(t, err, "failed to list Nodes")
+	return items
+}
+
+// ListNodesE lists the Nodes matching opts, returning the error.
+func (env *Env) ListNodesE(t *testing.T, opts ...ListOption) ([]v17.Node, error) {
+	t.Helper()
+	list, err := env.Client.CoreV1().Nodes().List(env.Ctx, listOptions(opts))
+	if err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+// WaitForNode watches the Node called name until ready reports true
+// for it and returns it. A nil ready waits for it to exist. The test fails
+// after timeout.
+func (env *Env) WaitForNode(t *testing.T, name string, ready func(*v17.Node) bool, timeout time.Duration) *v17.Node {
+	t.Helper()
+	return waitFor(t, env, "Node", name, env.Client.CoreV1().Nodes(), ready, timeout)
+}
+
 // GetPersistentVolume fetches a PersistentVolume by name, failing the test on error.
 func (env *Env) GetPersistentVolume(t *testing.T, name string) *v17.PersistentVolume {
 	t.Helper()
@@ -774,6 +2078,32 @@ func (env *Env) GetPersistentVolumeE(t *testing.T, name string) (*v17.Persistent
 	return env.Client.CoreV1().PersistentVolumes().Get(env.Ctx, name, v11.GetOptions{})
 }
 
+// ListPersistentVolumes lists the PersistentVolumes matching opts, failing the test on error.
+func (env *Env) ListPersistentVolumes(t *testing.T, opts ...ListOption) []v17.PersistentVolume {
+	t.Helper()
+	items, err := env.ListPersistentVolumesE(t, opts...)
+	require.NoError(t, err, "failed to list PersistentVolumes")
+	return items
+}
+
+// ListPersistentVolumesE lists the PersistentVolumes matching opts, returning the error.
+func (env *Env) ListPersistentVolumesE(t *testing.T, opts ...ListOption) ([]v17.PersistentVolume, error) {
+	t.Helper()
+	list, err := env.Client.CoreV1().PersistentVolumes().List(env.Ctx, listOptions(opts))
+	if err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+// WaitForPersistentVolume watches the PersistentVolume called name until ready reports true
+// for it and returns it. A nil ready waits for it to exist. The test fails
+// after timeout.
+func (env *Env) WaitForPersistentVolume(t *testing.T, name string, ready func(*v17.PersistentVolume) bool, timeout time.Duration) *v17.PersistentVolume {
+	t.Helper()
+	return waitFor(t, env, "PersistentVolume", name, env.Client.CoreV1().PersistentVolumes(), ready, timeout)
+}
+
 // GetPersistentVolumeClaim fetches a PersistentVolumeClaim by name, failing the test on error.
 func (env *Env) GetPersistentVolumeClaim(t *testing.T, name string) *v17.PersistentVolumeClaim {
 	t.Helper()
@@ -788,6 +2118,32 @@ func (env *Env) GetPersistentVolumeClaimE(t *testing.T, name string) (*v17.Persi
 	return env.Client.CoreV1().PersistentVolumeClaims(env.Kube.Namespace).Get(env.Ctx, name, v11.GetOptions{})
 }
 
+// ListPersistentVolumeClaims lists the PersistentVolumeClaims matching opts, failing the test on error.
+func (env *Env) ListPersistentVolumeClaims(t *testing.T, opts ...ListOption) []v17.PersistentVolumeClaim {
+	t.Helper()
+	items, err := env.ListPersistentVolumeClaimsE(t, opts...)
+	require.NoError(t, err, "failed to list PersistentVolumeClaims")
+	return items
+}
+
+// ListPersistentVolumeClaimsE lists the PersistentVolumeClaims matching opts, returning the error.
+func (env *Env) ListPersistentVolumeClaimsE(t *testing.T, opts ...ListOption) ([]v17.PersistentVolumeClaim, error) {
+	t.Helper()
+	list, err := env.Client.CoreV1().PersistentVolumeClaims(env.Kube.Namespace).List(env.Ctx, listOptions(opts))
+	if err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+// WaitForPersistentVolumeClaim watches the PersistentVolumeClaim called name until ready reports true
+// for it and returns it. A nil ready waits for it to exist. The test fails
+// after timeout.
+func (env *Env) WaitForPersistentVolumeClaim(t *testing.T, name string, ready func(*v17.PersistentVolumeClaim) bool, timeout time.Duration) *v17.PersistentVolumeClaim {
+	t.Helper()
+	return waitFor(t, env, "PersistentVolumeClaim", name, env.Client.CoreV1().PersistentVolumeClaims(env.Kube.Namespace), ready, timeout)
+}
+
 // GetPod fetches a Pod by name, failing the test on error.
 func (env *Env) GetPod(t *testing.T, name string) *v17.Pod {
 	t.Helper()
@@ -802,6 +2158,32 @@ func (env *Env) GetPodE(t *testing.T, name string) (*v17.Pod, error) {
 	return env.Client.CoreV1().Pods(env.Kube.Namespace).Get(env.Ctx, name, v11.GetOptions{})
 }
 
+// ListPods lists the Pods matching opts, failing the test on error.
+func (env *Env) ListPods(t *testing.T, opts ...ListOption) []v17.Pod {
+	t.Helper()
+	items, err := env.ListPodsE(t, opts...)
+	require.NoError(t, err, "failed to list Pods")
+	return items
+}
+
+// ListPodsE lists the Pods matching opts, returning the error.
+func (env *Env) ListPodsE(t *testing.T, opts ...ListOption) ([]v17.Pod, error) {
+	t.Helper()
+	list, err := env.Client.CoreV1().Pods(env.Kube.Namespace).List(env.Ctx, listOptions(opts))
+	if err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+// WaitForPod watches the Pod called name until ready reports true
+// for it and returns it. A nil ready waits for it to exist. The test fails
+// after timeout.
+func (env *Env) WaitForPod(t *testing.T, name string, ready func(*v17.Pod) bool, timeout time.Duration) *v17.Pod {
+	t.Helper()
+	return waitFor(t, env, "Pod", name, env.Client.CoreV1().Pods(env.Kube.Namespace), ready, timeout)
+}
+
 // GetPodTemplate fetches a PodTemplate by name, failing the test on error.
 func (env *Env) GetPodTemplate(t *testing.T, name string) *v17.PodTemplate {
 	t.Helper()
@@ -816,6 +2198,32 @@ func (env *Env) GetPodTemplateE(t *testing.T, name string) (*v17.PodTemplate, er
 	return env.Client.CoreV1().PodTemplates(env.Kube.Namespace).Get(env.Ctx, name, v11.GetOptions{})
 }
 
+// ListPodTemplates lists the PodTemplates matching opts, failing the test on error.
+func (env *Env) ListPodTemplates(t *testing.T, opts ...ListOption) []v17.PodTemplate {
+	t.Helper()
+	items, err := env.ListPodTemplatesE(t, opts...)
+	require.NoError(t, err, "failed to list PodTemplates")
+	return items
+}
+
+// ListPodTemplatesE lists the PodTemplates matching opts, returning the error.
+func (env *Env) ListPodTemplatesE(t *testing.T, opts ...ListOption) ([]v17.PodTemplate, error) {
+	t.Helper()
+	list, err := env.Client.CoreV1().PodTemplates(env.Kube.Namespace).List(env.Ctx, listOptions(opts))
+	if err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+// WaitForPodTemplate watches the PodTemplate called name until ready reports true
+// for it and returns it. A nil ready waits for it to exist. The test fails
+// after timeout.
+func (env *Env) WaitForPodTemplate(t *testing.T, name string, ready func(*v17.PodTemplate) bool, timeout time.Duration) *v17.PodTemplate {
+	t.Helper()
+	return waitFor(t, env, "PodTemplate", name, env.Client.CoreV1().PodTemplates(env.Kube.Namespace), ready, timeout)
+}
+
 // GetReplicationController fetches a ReplicationController by name, failing the test on error.
 func (env *Env) GetReplicationController(t *testing.T, name string) *v17.ReplicationController {
 	t.Helper()
@@ -830,6 +2238,32 @@ func (env *Env) GetReplicationControllerE(t *testing.T, name string) (*v17.Repli
 	return env.Client.CoreV1().ReplicationControllers(env.Kube.Namespace).Get(env.Ctx, name, v11.GetOptions{})
 }
 
+// ListReplicationControllers lists the ReplicationControllers matching opts, failing the test on error.
+func (env *Env) ListReplicationControllers(t *testing.T, opts ...ListOption) []v17.ReplicationController {
+	t.Helper()
+	items, err := env.ListReplicationControllersE(t, opts...)
+	require.NoError(t, err, "failed to list ReplicationControllers")
+	return items
+}
+
+// ListReplicationControllersE lists the ReplicationControllers matching opts, returning the error.
+func (env *Env) ListReplicationControllersE(t *testing.T, opts ...ListOption) ([]v17.ReplicationController, error) {
+	t.Helper()
+	list, err := env.Client.CoreV1().ReplicationControllers(env.Kube.Namespace).List(env.Ctx, listOptions(opts))
+	if err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+// WaitForReplicationController watches the ReplicationController called name until ready reports true
+// for it and returns it. A nil ready waits for it to exist. The test fails
+// after timeout.
+func (env *Env) WaitForReplicationController(t *testing.T, name string, ready func(*v17.ReplicationController) bool, timeout time.Duration) *v17.ReplicationController {
+	t.Helper()
+	return waitFor(t, env, "ReplicationController", name, env.Client.CoreV1().ReplicationControllers(env.Kube.Namespace), ready, timeout)
+}
+
 // GetResourceQuota fetches a ResourceQuota by name, failing the test on error.
 func (env *Env) GetResourceQuota(t *testing.T, name string) *v17.ResourceQuota {
 	t.Helper()
@@ -844,6 +2278,32 @@ func (env *Env) GetResourceQuotaE(t *testing.T, name string) (*v17.ResourceQuota
 	return env.Client.CoreV1().ResourceQuotas(env.Kube.Namespace).Get(env.Ctx, name, v11.GetOptions{})
 }
 
+// ListResourceQuotas lists the ResourceQuotas matching opts, failing the test on error.
+func (env *Env) ListResourceQuotas(t *testing.T, opts ...ListOption) []v17.ResourceQuota {
+	t.Helper()
+	items, err := env.ListResourceQuotasE(t, opts...)
+	require.NoError(t, err, "failed to list ResourceQuotas")
+	return items
+}
+
+// ListResourceQuotasE lists the ResourceQuotas matching opts, returning the error.
+func (env *Env) ListResourceQuotasE(t *testing.T, opts ...ListOption) ([]v17.ResourceQuota, error) {
+	t.Helper()
+	list, err := env.Client.CoreV1().ResourceQuotas(env.Kube.Namespace).List(env.Ctx, listOptions(opts))
+	if err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+// WaitForResourceQuota watches the ResourceQuota called name until ready reports true
+// for it and returns it. A nil ready waits for it to exist. The test fails
+// after timeout.
+func (env *Env) WaitForResourceQuota(t *testing.T, name string, ready func(*v17.ResourceQuota) bool, timeout time.Duration) *v17.ResourceQuota {
+	t.Helper()
+	return waitFor(t, env, "ResourceQuota", name, env.Client.CoreV1().ResourceQuotas(env.Kube.Namespace), ready, timeout)
+}
+
 // GetSecret fetches a Secret by name, failing the test on error.
 func (env *Env) GetSecret(t *testing.T, name string) *v17.Secret {
 	t.Helper()
@@ -858,6 +2318,32 @@ func (env *Env) GetSecretE(t *testing.T, name string) (*v17.Secret, error) {
 	return env.Client.CoreV1().Secrets(env.Kube.Namespace).Get(env.Ctx, name, v11.GetOptions{})
 }
 
+// ListSecrets lists the Secrets matching opts, failing the test on error.
+func (env *Env) ListSecrets(t *testing.T, opts ...ListOption) []v17.Secret {
+	t.Helper()
+	items, err := env.ListSecretsE(t, opts...)
+	require.NoError(t, err, "failed to list Secrets")
+	return items
+}
+
+// ListSecretsE lists the Secrets matching opts, returning the error.
+func (env *Env) ListSecretsE(t *testing.T, opts ...ListOption) ([]v17.Secret, error) {
+	t.Helper()
+	list, err := env.Client.CoreV1().Secrets(env.Kube.Namespace).List(env.Ctx, listOptions(opts))
+	if err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+// WaitForSecret watches the Secret called name until ready reports true
+// for it and returns it. A nil ready waits for it to exist. The test fails
+// after timeout.
+func (env *Env) WaitForSecret(t *testing.T, name string, ready func(*v17.Secret) bool, timeout time.Duration) *v17.Secret {
+	t.Helper()
+	return waitFor(t, env, "Secret", name, env.Client.CoreV1().Secrets(env.Kube.Namespace), ready, timeout)
+}
+
 // GetService fetches a Service by name, failing the test on error.
 func (env *Env) GetService(t *testing.T, name string) *v17.Service {
 	t.Helper()
@@ -872,6 +2358,32 @@ func (env *Env) GetServiceE(t *testing.T, name string) (*v17.Service, error) {
 	return env.Client.CoreV1().Services(env.Kube.Namespace).Get(env.Ctx, name, v11.GetOptions{})
 }
 
+// ListServices lists the Services matching opts, failing the test on error.
+func (env *Env) ListServices(t *testing.T, opts ...ListOption) []v17.Service {
+	t.Helper()
+	items, err := env.ListServicesE(t, opts...)
+	require.NoError(t, err, "failed to list Services")
+	return items
+}
+
+// ListServicesE lists the Services matching opts, returning the error.
+func (env *Env) ListServicesE(t *testing.T, opts ...ListOption) ([]v17.Service, error) {
+	t.Helper()
+	list, err := env.Client.CoreV1().Services(env.Kube.Namespace).List(env.Ctx, listOptions(opts))
+	if err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+// WaitForService watches the Service called name until ready reports true
+// for it and returns it. A nil ready waits for it to exist. The test fails
+// after timeout.
+func (env *Env) WaitForService(t *testing.T, name string, ready func(*v17.Service) bool, timeout time.Duration) *v17.Service {
+	t.Helper()
+	return waitFor(t, env, "Service", name, env.Client.CoreV1().Services(env.Kube.Namespace), ready, timeout)
+}
+
 // GetServiceAccount fetches a ServiceAccount by name, failing the test on error.
 func (env *Env) GetServiceAccount(t *testing.T, name string) *v17.ServiceAccount {
 	t.Helper()
@@ -886,6 +2398,32 @@ func (env *Env) GetServiceAccountE(t *testing.T, name string) (*v17.ServiceAccou
 	return env.Client.CoreV1().ServiceAccounts(env.Kube.Namespace).Get(env.Ctx, name, v11.GetOptions{})
 }
 
+// ListServiceAccounts lists the ServiceAccounts matching opts, failing the test on error.
+func (env *Env) ListServiceAccounts(t *testing.T, opts ...ListOption) []v17.ServiceAccount {
+	t.Helper()
+	items, err := env.ListServiceAccountsE(t, opts...)
+	require.NoError(t, err, "failed to list ServiceAccounts")
+	return items
+}
+
+// ListServiceAccountsE lists the ServiceAccounts matching opts, returning the error.
+func (env *Env) ListServiceAccountsE(t *testing.T, opts ...ListOption) ([]v17.ServiceAccount, error) {
+	t.Helper()
+	list, err := env.Client.CoreV1().ServiceAccounts(env.Kube.Namespace).List(env.Ctx, listOptions(opts))
+	if err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+// WaitForServiceAccount watches the ServiceAccount called name until ready reports true
+// for it and returns it. A nil ready waits for it to exist. The test fails
+// after timeout.
+func (env *Env) WaitForServiceAccount(t *testing.T, name string, ready func(*v17.ServiceAccount) bool, timeout time.Duration) *v17.ServiceAccount {
+	t.Helper()
+	return waitFor(t, env, "ServiceAccount", name, env.Client.CoreV1().ServiceAccounts(env.Kube.Namespace), ready, timeout)
+}
+
 // GetEndpointSlice fetches a EndpointSlice by name, failing the test on error.
 func (env *Env) GetEndpointSlice(t *testing.T, name string) *v18.EndpointSlice {
 	t.Helper()
@@ -900,6 +2438,32 @@ func (env *Env) GetEndpointSliceE(t *testing.T, name string) (*v18.EndpointSlice
 	return env.Client.DiscoveryV1().EndpointSlices(env.Kube.Namespace).Get(env.Ctx, name, v11.GetOptions{})
 }
 
+// ListEndpointSlices lists the EndpointSlices matching opts, failing the test on error.
+func (env *Env) ListEndpointSlices(t *testing.T, opts ...ListOption) []v18.EndpointSlice {
+	t.Helper()
+	items, err := env.ListEndpointSlicesE(t, opts...)
+	require.NoError(t, err, "failed to list EndpointSlices")
+	return items
+}
+
+// ListEndpointSlicesE lists the EndpointSlices matching opts, returning the error.
+func (env *Env) ListEndpointSlicesE(t *testing.T, opts ...ListOption) ([]v18.EndpointSlice, error) {
+	t.Helper()
+	list, err := env.Client.DiscoveryV1().EndpointSlices(env.Kube.Namespace).List(env.Ctx, listOptions(opts))
+	if err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+// WaitForEndpointSlice watches the EndpointSlice called name until ready reports true
+// for it and returns it. A nil ready waits for it to exist. The test fails
+// after timeout.
+func (env *Env) WaitForEndpointSlice(t *testing.T, name string, ready func(*v18.EndpointSlice) bool, timeout time.Duration) *v18.EndpointSlice {
+	t.Helper()
+	return waitFor(t, env, "EndpointSlice", name, env.Client.DiscoveryV1().EndpointSlices(env.Kube.Namespace), ready, timeout)
+}
+
 // GetDiscoveryV1beta1EndpointSlice fetches a DiscoveryV1beta1EndpointSlice by name, failing the test on error.
 func (env *Env) GetDiscoveryV1beta1EndpointSlice(t *testing.T, name string) *v1beta15.EndpointSlice {
 	t.Helper()
@@ -914,6 +2478,32 @@ func (env *Env) GetDiscoveryV1beta1EndpointSliceE(t *testing.T, name string) (*v
 	return env.Client.DiscoveryV1beta1().EndpointSlices(env.Kube.Namespace).Get(env.Ctx, name, v11.GetOptions{})
 }
 
+// ListDiscoveryV1beta1EndpointSlices lists the DiscoveryV1beta1EndpointSlices matching opts, failing the test on error.
+func (env *Env) ListDiscoveryV1beta1EndpointSlices(t *testing.T, opts ...ListOption) []v1beta15.EndpointSlice {
+	t.Helper()
+	items, err := env.ListDiscoveryV1beta1EndpointSlicesE(t, opts...)
+	require.NoError(t, err, "failed to list DiscoveryV1beta1EndpointSlices")
+	return items
+}
+
+// ListDiscoveryV1beta1EndpointSlicesE lists the DiscoveryV1beta1EndpointSlices matching opts, returning the error.
+func (env *Env) ListDiscoveryV1beta1EndpointSlicesE(t *testing.T, opts ...ListOption) ([]v1beta15.EndpointSlice, error) {
+	t.Helper()
+	list, err := env.Client.DiscoveryV1beta1().EndpointSlices(env.Kube.Namespace).List(env.Ctx, listOptions(opts))
+	if err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+// WaitForDiscoveryV1beta1EndpointSlice watches the DiscoveryV1beta1EndpointSlice called name until ready reports true
+// for it and returns it. A nil ready waits for it to exist. The test fails
+// after timeout.
+func (env *Env) WaitForDiscoveryV1beta1EndpointSlice(t *testing.T, name string, ready func(*v1beta15.EndpointSlice) bool, timeout time.Duration) *v1beta15.EndpointSlice {
+	t.Helper()
+	return waitFor(t, env, "EndpointSlice", name, env.Client.DiscoveryV1beta1().EndpointSlices(env.Kube.Namespace), ready, timeout)
+}
+
 // GetEventsV1Event fetches a EventsV1Event by name, failing the test on error.
 func (env *Env) GetEventsV1Event(t *testing.T, name string) *v19.Event {
 	t.Helper()
@@ -928,6 +2518,32 @@ func (env *Env) GetEventsV1EventE(t *testing.T, name string) (*v19.Event, error)
 	return env.Client.EventsV1().Events(env.Kube.Namespace).Get(env.Ctx, name, v11.GetOptions{})
 }
 
+// ListEventsV1Events lists the EventsV1Events matching opts, failing the test on error.
+func (env *Env) ListEventsV1Events(t *testing.T, opts ...ListOption) []v19.Event {
+	t.Helper()
+	items, err := env.ListEventsV1EventsE(t, opts...)
+	require.NoError(t, err, "failed to list EventsV1Events")
+	return items
+}
+
+// ListEventsV1EventsE lists the EventsV1Events matching opts, returning the error.
+func (env *Env) ListEventsV1EventsE(t *testing.T, opts ...ListOption) ([]v19.Event, error) {
+	t.Helper()
+	list, err := env.Client.EventsV1().Events(env.Kube.Namespace).List(env.Ctx, listOptions(opts))
+	if err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+// WaitForEventsV1Event watches the EventsV1Event called name until ready reports true
+// for it and returns it. A nil ready waits for it to exist. The test fails
+// after timeout.
+func (env *Env) WaitForEventsV1Event(t *testing.T, name string, ready func(*v19.Event) bool, timeout time.Duration) *v19.Event {
+	t.Helper()
+	return waitFor(t, env, "Event", name, env.Client.EventsV1().Events(env.Kube.Namespace), ready, timeout)
+}
+
 // GetEventsV1beta1Event fetches a EventsV1beta1Event by name, failing the test on error.
 func (env *Env) GetEventsV1beta1Event(t *testing.T, name string) *v1beta16.Event {
 	t.Helper()
@@ -942,6 +2558,32 @@ func (env *Env) GetEventsV1beta1EventE(t *testing.T, name string) (*v1beta16.Eve
 	return env.Client.EventsV1beta1().Events(env.Kube.Namespace).Get(env.Ctx, name, v11.GetOptions{})
 }
 
+// ListEventsV1beta1Events lists the EventsV1beta1Events matching opts, failing the test on error.
+func (env *Env) ListEventsV1beta1Events(t *testing.T, opts ...ListOption) []v1beta16.Event {
+	t.Helper()
+	items, err := env.ListEventsV1beta1EventsE(t, opts...)
+	require.NoError(t, err, "failed to list EventsV1beta1Events")
+	return items
+}
+
+// ListEventsV1beta1EventsE lists the EventsV1beta1Events matching opts, returning the error.
+func (env *Env) ListEventsV1beta1EventsE(t *testing.T, opts ...ListOption) ([]v1beta16.Event, error) {
+	t.Helper()
+	list, err := env.Client.EventsV1beta1().Events(env.Kube.Namespace).List(env.Ctx, listOptions(opts))
+	if err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+// WaitForEventsV1beta1Event watches the EventsV1beta1Event called name until ready reports true
+// for it and returns it. A nil ready waits for it to exist. The test fails
+// after timeout.
+func (env *Env) WaitForEventsV1beta1Event(t *testing.T, name string, ready func(*v1beta16.Event) bool, timeout time.Duration) *v1beta16.Event {
+	t.Helper()
+	return waitFor(t, env, "Event", name, env.Client.EventsV1beta1().Events(env.Kube.Namespace), ready, timeout)
+}
+
 // GetExtensionsV1beta1DaemonSet fetches a ExtensionsV1beta1DaemonSet by name, failing the test on error.
 func (env *Env) GetExtensionsV1beta1DaemonSet(t *testing.T, name string) *v1beta17.DaemonSet {
 	t.Helper()
@@ -956,6 +2598,32 @@ func (env *Env) GetExtensionsV1beta1DaemonSetE(t *testing.T, name string) (*v1be
 	return env.Client.ExtensionsV1beta1().DaemonSets(env.Kube.Namespace).Get(env.Ctx, name, v11.GetOptions{})
 }
 
+// ListExtensionsV1beta1DaemonSets lists the ExtensionsV1beta1DaemonSets matching opts, failing the test on error.
+func (env *Env) ListExtensionsV1beta1DaemonSets(t *testing.T, opts ...ListOption) []v1beta17.DaemonSet {
+	t.Helper()
+	items, err := env.ListExtensionsV1beta1DaemonSetsE(t, opts...)
+	require.NoError(t, err, "failed to list ExtensionsV1beta1DaemonSets")
+	return items
+}
+
+// ListExtensionsV1beta1DaemonSetsE lists the ExtensionsV1beta1DaemonSets matching opts, returning the error.
+func (env *Env) ListExtensionsV1beta1DaemonSetsE(t *testing.T, opts ...ListOption) ([]v1beta17.DaemonSet, error) {
+	t.Helper()
+	list, err := env.Client.ExtensionsV1beta1().DaemonSets(env.Kube.Namespace).List(env.Ctx, listOptions(opts))
+	if err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+// WaitForExtensionsV1beta1DaemonSet watches the ExtensionsV1beta1DaemonSet called name until ready reports true
+// for it and returns it. A nil ready waits for it to exist. The test fails
+// after timeout.
+func (env *Env) WaitForExtensionsV1beta1DaemonSet(t *testing.T, name string, ready func(*v1beta17.DaemonSet) bool, timeout time.Duration) *v1beta17.DaemonSet {
+	t.Helper()
+	return waitFor(t, env, "DaemonSet", name, env.Client.ExtensionsV1beta1().DaemonSets(env.Kube.Namespace), ready, timeout)
+}
+
 // GetExtensionsV1beta1Deployment fetches a ExtensionsV1beta1Deployment by name, failing the test on error.
 func (env *Env) GetExtensionsV1beta1Deployment(t *testing.T, name string) *v1beta17.Deployment {
 	t.Helper()
@@ -970,6 +2638,32 @@ func (env *Env) GetExtensionsV1beta1DeploymentE(t *testing.T, name string) (*v1b
 	return env.Client.ExtensionsV1beta1().Deployments(env.Kube.Namespace).Get(env.Ctx, name, v11.GetOptions{})
 }
 
+// ListExtensionsV1beta1Deployments lists the ExtensionsV1beta1Deployments matching opts, failing the test on error.
+func (env *Env) ListExtensionsV1beta1Deployments(t *testing.T, opts ...ListOption) []v1beta17.Deployment {
+	t.Helper()
+	items, err := env.ListExtensionsV1beta1DeploymentsE(t, opts...)
+	require.NoError(t, err, "failed to list ExtensionsV1beta1Deployments")
+	return items
+}
+
+// ListExtensionsV1beta1DeploymentsE lists the ExtensionsV1beta1Deployments matching opts, returning the error.
+func (env *Env) ListExtensionsV1beta1DeploymentsE(t *testing.T, opts ...ListOption) ([]v1beta17.Deployment, error) {
+	t.Helper()
+	list, err := env.Client.ExtensionsV1beta1().Deployments(env.Kube.Namespace).List(env.Ctx, listOptions(opts))
+	if err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+// WaitForExtensionsV1beta1Deployment watches the ExtensionsV1beta1Deployment called name until ready reports true
+// for it and returns it. A nil ready waits for it to exist. The test fails
+// after timeout.
+func (env *Env) WaitForExtensionsV1beta1Deployment(t *testing.T, name string, ready func(*v1beta17.Deployment) bool, timeout time.Duration) *v1beta17.Deployment {
+	t.Helper()
+	return waitFor(t, env, "Deployment", name, env.Client.ExtensionsV1beta1().Deployments(env.Kube.Namespace), ready, timeout)
+}
+
 // GetExtensionsV1beta1Ingress fetches a ExtensionsV1beta1Ingress by name, failing the test on error.
 func (env *Env) GetExtensionsV1beta1Ingress(t *testing.T, name string) *v1beta17.Ingress {
 	t.Helper()
@@ -984,6 +2678,32 @@ func (env *Env) GetExtensionsV1beta1IngressE(t *testing.T, name string) (*v1beta
 	return env.Client.ExtensionsV1beta1().Ingresses(env.Kube.Namespace).Get(env.Ctx, name, v11.GetOptions{})
 }
 
+// ListExtensionsV1beta1Ingresses lists the ExtensionsV1beta1Ingresses matching opts, failing the test on error.
+func (env *Env) ListExtensionsV1beta1Ingresses(t *testing.T, opts ...ListOption) []v1beta17.Ingress {
+	t.Helper()
+	items, err := env.ListExtensionsV1beta1IngressesE(t, opts...)
+	require.NoError(t, err, "failed to list ExtensionsV1beta1Ingresses")
+	return items
+}
+
+// ListExtensionsV1beta1IngressesE lists the ExtensionsV1beta1Ingresses matching opts, returning the error.
+func (env *Env) ListExtensionsV1beta1IngressesE(t *testing.T, opts ...ListOption) ([]v1beta17.Ingress, error) {
+	t.Helper()
+	list, err := env.Client.ExtensionsV1beta1().Ingresses(env.Kube.Namespace).List(env.Ctx, listOptions(opts))
+	if err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+// WaitForExtensionsV1beta1Ingress watches the ExtensionsV1beta1Ingress called name until ready reports true
+// for it and returns it. A nil ready waits for it to exist. The test fails
+// after timeout.
+func (env *Env) WaitForExtensionsV1beta1Ingress(t *testing.T, name string, ready func(*v1beta17.Ingress) bool, timeout time.Duration) *v1beta17.Ingress {
+	t.Helper()
+	return waitFor(t, env, "Ingress", name, env.Client.ExtensionsV1beta1().Ingresses(env.Kube.Namespace), ready, timeout)
+}
+
 // GetExtensionsV1beta1NetworkPolicy fetches a ExtensionsV1beta1NetworkPolicy by name, failing the test on error.
 func (env *Env) GetExtensionsV1beta1NetworkPolicy(t *testing.T, name string) *v1beta17.NetworkPolicy {
 	t.Helper()
@@ -998,6 +2718,32 @@ func (env *Env) GetExtensionsV1beta1NetworkPolicyE(t *testing.T, name string) (*
 	return env.Client.ExtensionsV1beta1().NetworkPolicies(env.Kube.Namespace).Get(env.Ctx, name, v11.GetOptions{})
 }
 
+// ListExtensionsV1beta1NetworkPolicies lists the ExtensionsV1beta1NetworkPolicies matching opts, failing the test on error.
+func (env *Env) ListExtensionsV1beta1NetworkPolicies(t *testing.T, opts ...ListOption) []v1beta17.NetworkPolicy {
+	t.Helper()
+	items, err := env.ListExtensionsV1beta1NetworkPoliciesE(t, opts...)
+	require.NoError(t, err, "failed to list ExtensionsV1beta1NetworkPolicies")
+	return items
+}
+
+// ListExtensionsV1beta1NetworkPoliciesE lists the ExtensionsV1beta1NetworkPolicies matching opts, returning the error.
+func (env *Env) ListExtensionsV1beta1NetworkPoliciesE(t *testing.T, opts ...ListOption) ([]v1beta17.NetworkPolicy, error) {
+	t.Helper()
+	list, err := env.Client.ExtensionsV1beta1().NetworkPolicies(env.Kube.Namespace).List(env.Ctx, listOptions(opts))
+	if err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+// WaitForExtensionsV1beta1NetworkPolicy watches the ExtensionsV1beta1NetworkPolicy called name until ready reports true
+// for it and returns it. A nil ready waits for it to exist. The test fails
+// after timeout.
+func (env *Env) WaitForExtensionsV1beta1NetworkPolicy(t *testing.T, name string, ready func(*v1beta17.NetworkPolicy) bool, timeout time.Duration) *v1beta17.NetworkPolicy {
+	t.Helper()
+	return waitFor(t, env, "NetworkPolicy", name, env.Client.ExtensionsV1beta1().NetworkPolicies(env.Kube.Namespace), ready, timeout)
+}
+
 // GetExtensionsV1beta1ReplicaSet fetches a ExtensionsV1beta1ReplicaSet by name, failing the test on error.
 func (env *Env) GetExtensionsV1beta1ReplicaSet(t *testing.T, name string) *v1beta17.ReplicaSet {
 	t.Helper()
@@ -1012,6 +2758,32 @@ func (env *Env) GetExtensionsV1beta1ReplicaSetE(t *testing.T, name string) (*v1b
 	return env.Client.ExtensionsV1beta1().ReplicaSets(env.Kube.Namespace).Get(env.Ctx, name, v11.GetOptions{})
 }
 
+// ListExtensionsV1beta1ReplicaSets lists the ExtensionsV1beta1ReplicaSets matching opts, failing the test on error.
+func (env *Env) ListExtensionsV1beta1ReplicaSets(t *testing.T, opts ...ListOption) []v1beta17.ReplicaSet {
+	t.Helper()
+	items, err := env.ListExtensionsV1beta1ReplicaSetsE(t, opts...)
+	require.NoError(t, err, "failed to list ExtensionsV1beta1ReplicaSets")
+	return items
+}
+
+// ListExtensionsV1beta1ReplicaSetsE lists the ExtensionsV1beta1ReplicaSets matching opts, returning the error.
+func (env *Env) ListExtensionsV1beta1ReplicaSetsE(t *testing.T, opts ...ListOption) ([]v1beta17.ReplicaSet, error) {
+	t.Helper()
+	list, err := env.Client.ExtensionsV1beta1().ReplicaSets(env.Kube.Namespace).List(env.Ctx, listOptions(opts))
+	if err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+// WaitForExtensionsV1beta1ReplicaSet watches the ExtensionsV1beta1ReplicaSet called name until ready reports true
+// for it and returns it. A nil ready waits for it to exist. The test fails
+// after timeout.
+func (env *Env) WaitForExtensionsV1beta1ReplicaSet(t *testing.T, name string, ready func(*v1beta17.ReplicaSet) bool, timeout time.Duration) *v1beta17.ReplicaSet {
+	t.Helper()
+	return waitFor(t, env, "ReplicaSet", name, env.Client.ExtensionsV1beta1().ReplicaSets(env.Kube.Namespace), ready, timeout)
+}
+
 // GetFlowSchema fetches a FlowSchema by name, failing the test on error.
 func (env *Env) GetFlowSchema(t *testing.T, name string) *v110.FlowSchema {
 	t.Helper()
@@ -1026,6 +2798,32 @@ func (env *Env) GetFlowSchemaE(t *testing.T, name string) (*v110.FlowSchema, err
 	return env.Client.FlowcontrolV1().FlowSchemas().Get(env.Ctx, name, v11.GetOptions{})
 }
 
+// ListFlowSchemas lists the FlowSchemas matching opts, failing the test on error.
+func (env *Env) ListFlowSchemas(t *testing.T, opts ...ListOption) []v110.FlowSchema {
+	t.Helper()
+	items, err := env.ListFlowSchemasE(t, opts...)
+	require.NoError(t, err, "failed to list FlowSchemas")
+	return items
+}
+
+// ListFlowSchemasE lists the FlowSchemas matching opts, returning the error.
+func (env *Env) ListFlowSchemasE(t *testing.T, opts ...ListOption) ([]v110.FlowSchema, error) {
+	t.Helper()
+	list, err := env.Client.FlowcontrolV1().FlowSchemas().List(env.Ctx, listOptions(opts))
+	if err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+// WaitForFlowSchema watches the FlowSchema called name until ready reports true
+// for it and returns it. A nil ready waits for it to exist. The test fails
+// after timeout.
+func (env *Env) WaitForFlowSchema(t *testing.T, name string, ready func(*v110.FlowSchema) bool, timeout time.Duration) *v110.FlowSchema {
+	t.Helper()
+	return waitFor(t, env, "FlowSchema", name, env.Client.FlowcontrolV1().FlowSchemas(), ready, timeout)
+}
+
 // GetPriorityLevelConfiguration fetches a PriorityLevelConfiguration by name, failing the test on error.
 func (env *Env) GetPriorityLevelConfiguration(t *testing.T, name string) *v110.PriorityLevelConfiguration {
 	t.Helper()
@@ -1040,6 +2838,32 @@ func (env *Env) GetPriorityLevelConfigurationE(t *testing.T, name string) (*v110
 	return env.Client.FlowcontrolV1().PriorityLevelConfigurations().Get(env.Ctx, name, v11.GetOptions{})
 }
 
+// ListPriorityLevelConfigurations lists the PriorityLevelConfigurations matching opts, failing the test on error.
+func (env *Env) ListPriorityLevelConfigurations(t *testing.T, opts ...ListOption) []v110.PriorityLevelConfiguration {
+	t.Helper()
+	items, err := env.ListPriorityLevelConfigurationsE(t, opts...)
+	require.NoError(t, err, "failed to list PriorityLevelConfigurations")
+	return items
+}
+
+// ListPriorityLevelConfigurationsE lists the PriorityLevelConfigurations matching opts, returning the error.
+func (env *Env) ListPriorityLevelConfigurationsE(t *testing.T, opts ...ListOption) ([]v110.PriorityLevelConfiguration, error) {
+	t.Helper()
+	list, err := env.Client.FlowcontrolV1().PriorityLevelConfigurations().List(env.Ctx, listOptions(opts))
+	if err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+// WaitForPriorityLevelConfiguration watches the PriorityLevelConfiguration called name until ready reports true
+// for it and returns it. A nil ready waits for it to exist. The test fails
+// after timeout.
+func (env *Env) WaitForPriorityLevelConfiguration(t *testing.T, name string, ready func(*v110.PriorityLevelConfiguration) bool, timeout time.Duration) *v110.PriorityLevelConfiguration {
+	t.Helper()
+	return waitFor(t, env, "PriorityLevelConfiguration", name, env.Client.FlowcontrolV1().PriorityLevelConfigurations(), ready, timeout)
+}
+
 // GetFlowcontrolV1beta1FlowSchema fetches a FlowcontrolV1beta1FlowSchema by name, failing the test on error.
 func (env *Env) GetFlowcontrolV1beta1FlowSchema(t *testing.T, name string) *v1beta18.FlowSchema {
 	t.Helper()
@@ -1054,6 +2878,32 @@ func (env *Env) GetFlowcontrolV1beta1FlowSchemaE(t *testing.T, name string) (*v1
 	return env.Client.FlowcontrolV1beta1().FlowSchemas().Get(env.Ctx, name, v11.GetOptions{})
 }
 
+// ListFlowcontrolV1beta1FlowSchemas lists the FlowcontrolV1beta1FlowSchemas matching opts, failing the test on error.
+func (env *Env) ListFlowcontrolV1beta1FlowSchemas(t *testing.T, opts ...ListOption) []v1beta18.FlowSchema {
+	t.Helper()
+	items, err := env.ListFlowcontrolV1beta1FlowSchemasE(t, opts...)
+	require.NoError(t, err, "failed to list FlowcontrolV1beta1FlowSchemas")
+	return items
+}
+
+// ListFlowcontrolV1beta1FlowSchemasE lists the FlowcontrolV1beta1FlowSchemas matching opts, returning the error.
+func (env *Env) ListFlowcontrolV1beta1FlowSchemasE(t *testing.T, opts ...ListOption) ([]v1beta18.FlowSchema, error) {
+	t.Helper()
+	list, err := env.Client.FlowcontrolV1beta1().FlowSchemas().List(env.Ctx, listOptions(opts))
+	if err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+// WaitForFlowcontrolV1beta1FlowSchema watches the FlowcontrolV1beta1FlowSchema called name until ready reports true
+// for it and returns it. A nil ready waits for it to exist. The test fails
+// after timeout.
+func (env *Env) WaitForFlowcontrolV1beta1FlowSchema(t *testing.T, name string, ready func(*v1beta18.FlowSchema) bool, timeout time.Duration) *v1beta18.FlowSchema {
+	t.Helper()
+	return waitFor(t, env, "FlowSchema", name, env.Client.FlowcontrolV1beta1().FlowSchemas(), ready, timeout)
+}
+
 // GetFlowcontrolV1beta1PriorityLevelConfiguration fetches a FlowcontrolV1beta1PriorityLevelConfiguration by name, failing the test on error.
 func (env *Env) GetFlowcontrolV1beta1PriorityLevelConfiguration(t *testing.T, name string) *v1beta18.PriorityLevelConfiguration {
 	t.Helper()
@@ -1068,6 +2918,32 @@ func (env *Env) GetFlowcontrolV1beta1PriorityLevelConfigurationE(t *testing.T, n
 	return env.Client.FlowcontrolV1beta1().PriorityLevelConfigurations().Get(env.Ctx, name, v11.GetOptions{})
 }
 
+// ListFlowcontrolV1beta1PriorityLevelConfigurations lists the FlowcontrolV1beta1PriorityLevelConfigurations matching opts, failing the test on error.
+func (env *Env) ListFlowcontrolV1beta1PriorityLevelConfigurations(t *testing.T, opts ...ListOption) []v1beta18.PriorityLevelConfiguration {
+	t.Helper()
+	items, err := env.ListFlowcontrolV1beta1PriorityLevelConfigurationsE(t, opts...)
+	require.NoError(t, err, "failed to list FlowcontrolV1beta1PriorityLevelConfigurations")
+	return items
+}
+
+// ListFlowcontrolV1beta1PriorityLevelConfigurationsE lists the FlowcontrolV1beta1PriorityLevelConfigurations matching opts, returning the error.
+func (env *Env) ListFlowcontrolV1beta1PriorityLevelConfigurationsE(t *testing.T, opts ...ListOption) ([]v1beta18.PriorityLevelConfiguration, error) {
+	t.Helper()
+	list, err := env.Client.FlowcontrolV1beta1().PriorityLevelConfigurations().List(env.Ctx, listOptions(opts))
+	if err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+// WaitForFlowcontrolV1beta1PriorityLevelConfiguration watches the FlowcontrolV1beta1PriorityLevelConfiguration called name until ready reports true
+// for it and returns it. A nil ready waits for it to exist. The test fails
+// after timeout.
+func (env *Env) WaitForFlowcontrolV1beta1PriorityLevelConfiguration(t *testing.T, name string, ready func(*v1beta18.PriorityLevelConfiguration) bool, timeout time.Duration) *v1beta18.PriorityLevelConfiguration {
+	t.Helper()
+	return waitFor(t, env, "PriorityLevelConfiguration", name, env.Client.FlowcontrolV1beta1().PriorityLevelConfigurations(), ready, timeout)
+}
+
 // GetFlowcontrolV1beta2FlowSchema fetches a FlowcontrolV1beta2FlowSchema by name, failing the test on error.
 func (env *Env) GetFlowcontrolV1beta2FlowSchema(t *testing.T, name string) *v1beta21.FlowSchema {
 	t.Helper()
@@ -1082,6 +2958,32 @@ func (env *Env) GetFlowcontrolV1beta2FlowSchemaE(t *testing.T, name string) (*v1
 	return env.Client.FlowcontrolV1beta2().FlowSchemas().Get(env.Ctx, name, v11.GetOptions{})
 }
 
+// ListFlowcontrolV1beta2FlowSchemas lists the FlowcontrolV1beta2FlowSchemas matching opts, failing the test on error.
+func (env *Env) ListFlowcontrolV1beta2FlowSchemas(t *testing.T, opts ...ListOption) []v1beta21.FlowSchema {
+	t.Helper()
+	items, err := env.ListFlowcontrolV1beta2FlowSchemasE(t, opts...)
+	require.NoError(t, err, "failed to list FlowcontrolV1beta2FlowSchemas")
+	return items
+}
+
+// ListFlowcontrolV1beta2FlowSchemasE lists the FlowcontrolV1beta2FlowSchemas matching opts, returning the error.
+func (env *Env) ListFlowcontrolV1beta2FlowSchemasE(t *testing.T, opts ...ListOption) ([]v1beta21.FlowSchema, error) {
+	t.Helper()
+	list, err := env.Client.FlowcontrolV1beta2().FlowSchemas().List(env.Ctx, listOptions(opts))
+	if err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+// WaitForFlowcontrolV1beta2FlowSchema watches the FlowcontrolV1beta2FlowSchema called name until ready reports true
+// for it and returns it. A nil ready waits for it to exist. The test fails
+// after timeout.
+func (env *Env) WaitForFlowcontrolV1beta2FlowSchema(t *testing.T, name string, ready func(*v1beta21.FlowSchema) bool, timeout time.Duration) *v1beta21.FlowSchema {
+	t.Helper()
+	return waitFor(t, env, "FlowSchema", name, env.Client.FlowcontrolV1beta2().FlowSchemas(), ready, timeout)
+}
+
 // GetFlowcontrolV1beta2PriorityLevelConfiguration fetches a FlowcontrolV1beta2PriorityLevelConfiguration by name, failing the test on error.
 func (env *Env) GetFlowcontrolV1beta2PriorityLevelConfiguration(t *testing.T, name string) *v1beta21.PriorityLevelConfiguration {
 	t.Helper()
@@ -1096,6 +2998,32 @@ func (env *Env) GetFlowcontrolV1beta2PriorityLevelConfigurationE(t *testing.T, n
 	return env.Client.FlowcontrolV1beta2().PriorityLevelConfigurations().Get(env.Ctx, name, v11.GetOptions{})
 }
 
+// ListFlowcontrolV1beta2PriorityLevelConfigurations lists the FlowcontrolV1beta2PriorityLevelConfigurations matching opts, failing the test on error.
+func (env *Env) ListFlowcontrolV1beta2PriorityLevelConfigurations(t *testing.T, opts ...ListOption) []v1beta21.PriorityLevelConfiguration {
+	t.Helper()
+	items, err := env.ListFlowcontrolV1beta2PriorityLevelConfigurationsE(t, opts...)
+	require.NoError(t, err, "failed to list FlowcontrolV1beta2PriorityLevelConfigurations")
+	return items
+}
+
+// ListFlowcontrolV1beta2PriorityLevelConfigurationsE lists the FlowcontrolV1beta2PriorityLevelConfigurations matching opts, returning the error.
+func (env *Env) ListFlowcontrolV1beta2PriorityLevelConfigurationsE(t *testing.T, opts ...ListOption) ([]v1beta21.PriorityLevelConfiguration, error) {
+	t.Helper()
+	list, err := env.Client.FlowcontrolV1beta2().PriorityLevelConfigurations().List(env.Ctx, listOptions(opts))
+	if err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+// WaitForFlowcontrolV1beta2PriorityLevelConfiguration watches the FlowcontrolV1beta2PriorityLevelConfiguration called name until ready reports true
+// for it and returns it. A nil ready waits for it to exist. The test fails
+// after timeout.
+func (env *Env) WaitForFlowcontrolV1beta2PriorityLevelConfiguration(t *testing.T, name string, ready func(*v1beta21.PriorityLevelConfiguration) bool, timeout time.Duration) *v1beta21.PriorityLevelConfiguration {
+	t.Helper()
+	return waitFor(t, env, "PriorityLevelConfiguration", name, env.Client.FlowcontrolV1beta2().PriorityLevelConfigurations(), ready, timeout)
+}
+
 // GetFlowcontrolV1beta3FlowSchema fetches a FlowcontrolV1beta3FlowSchema by name, failing the test on error.
 func (env *Env) GetFlowcontrolV1beta3FlowSchema(t *testing.T, name string) *v1beta3.FlowSchema {
 	t.Helper()
@@ -1110,6 +3038,32 @@ func (env *Env) GetFlowcontrolV1beta3FlowSchemaE(t *testing.T, name string) (*v1
 	return env.Client.FlowcontrolV1beta3().FlowSchemas().Get(env.Ctx, name, v11.GetOptions{})
 }
 
+// ListFlowcontrolV1beta3FlowSchemas lists the FlowcontrolV1beta3FlowSchemas matching opts, failing the test on error.
+func (env *Env) ListFlowcontrolV1beta3FlowSchemas(t *testing.T, opts ...ListOption) []v1beta3.FlowSchema {
+	t.Helper()
+	items, err := env.ListFlowcontrolV1beta3FlowSchemasE(t, opts...)
+	require.NoError(t, err, "failed to list FlowcontrolV1beta3FlowSchemas")
+	return items
+}
+
+// ListFlowcontrolV1beta3FlowSchemasE lists the FlowcontrolV1beta3FlowSchemas matching opts, returning the error.
+func (env *Env) ListFlowcontrolV1beta3FlowSchemasE(t *testing.T, opts ...ListOption) ([]v1beta3.FlowSchema, error) {
+	t.Helper()
+	list, err := env.Client.FlowcontrolV1beta3().FlowSchemas().List(env.Ctx, listOptions(opts))
+	if err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+// WaitForFlowcontrolV1beta3FlowSchema watches the FlowcontrolV1beta3FlowSchema called name until ready reports true
+// for it and returns it. A nil ready waits for it to exist. The test fails
+// after timeout.
+func (env *Env) WaitForFlowcontrolV1beta3FlowSchema(t *testing.T, name string, ready func(*v1beta3.FlowSchema) bool, timeout time.Duration) *v1beta3.FlowSchema {
+	t.Helper()
+	return waitFor(t, env, "FlowSchema", name, env.Client.FlowcontrolV1beta3().FlowSchemas(), ready, timeout)
+}
+
 // GetFlowcontrolV1beta3PriorityLevelConfiguration fetches a FlowcontrolV1beta3PriorityLevelConfiguration by name, failing the test on error.
 func (env *Env) GetFlowcontrolV1beta3PriorityLevelConfiguration(t *testing.T, name string) *v1beta3.PriorityLevelConfiguration {
 	t.Helper()
@@ -1124,20 +3078,72 @@ func (env *Env) GetFlowcontrolV1beta3PriorityLevelConfigurationE(t *testing.T, n
 	return env.Client.FlowcontrolV1beta3().PriorityLevelConfigurations().Get(env.Ctx, name, v11.GetOptions{})
 }
 
-// GetStorageVersion fetches a StorageVersion by name, failing the test on error.
-func (env *Env) GetStorageVersion(t *testing.T, name string) *v1alpha12.StorageVersion {
+// ListFlowcontrolV1beta3PriorityLevelConfigurations lists the FlowcontrolV1beta3PriorityLevelConfigurations matching opts, failing the test on error.
+func (env *Env) ListFlowcontrolV1beta3PriorityLevelConfigurations(t *testing.T, opts ...ListOption) []v1beta3.PriorityLevelConfiguration {
 	t.Helper()
-	obj, err := env.Client.InternalV1alpha1().StorageVersions().Get(env.Ctx, name, v11.GetOptions{})
-	require.NoError(t, err, "failed to get StorageVersion %s", name)
-	return obj
+	items, err := env.ListFlowcontrolV1beta3PriorityLevelConfigurationsE(t, opts...)
+	require.NoError(t, err, "failed to list FlowcontrolV1beta3PriorityLevelConfigurations")
+	return items
 }
 
-// GetStorageVersionE fetches a StorageVersion by name, returning the error for non-existence checks.
+// ListFlowcontrolV1beta3PriorityLevelConfigurationsE lists the FlowcontrolV1beta3PriorityLevelConfigurations matching opts, returning the error.
+func (env *Env) ListFlowcontrolV1beta3PriorityLevelConfigurationsE(t *testing.T, opts ...ListOption) ([]v1beta3.PriorityLevelConfiguration, error) {
+	t.Helper()
+	list, err := env.Client.FlowcontrolV1beta3().PriorityLevelConfigurations().List(env.Ctx, listOptions(opts))
+	if err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+// WaitForFlowcontrolV1beta3PriorityLevelConfiguration watches the FlowcontrolV1beta3PriorityLevelConfiguration called name until ready reports true
+// for it and returns it. A nil ready waits for it to exist. The test fails
+// after timeout.
+func (env *Env) WaitForFlowcontrolV1beta3PriorityLevelConfiguration(t *testing.T, name string, ready func(*v1beta3.PriorityLevelConfiguration) bool, timeout time.Duration) *v1beta3.PriorityLevelConfiguration {
+	t.Helper()
+	return waitFor(t, env, "PriorityLevelConfiguration", name, env.Client.FlowcontrolV1beta3().PriorityLevelConfigurations(), ready, timeout)
+}
+
+// GetStorageVersion fetches a StorageVersion by name, failing the test on error.
+func (env *Env) GetStorageVersion(t *testing.T, name string) *v1alpha12.StorageVersion {
+	t.Helper()
+	obj, err := env.Client.InternalV1alpha1().StorageVersions().Get(env.Ctx, name, v11.GetOptions{})
+	require.NoError(t, err, "failed to get StorageVersion %s", name)
+	return obj
+}
+
+// GetStorageVersionE fetches a StorageVersion by name, returning the error for non-existence checks.
 func (env *Env) GetStorageVersionE(t *testing.T, name string) (*v1alpha12.StorageVersion, error) {
 	t.Helper()
 	return env.Client.InternalV1alpha1().StorageVersions().Get(env.Ctx, name, v11.GetOptions{})
 }
 
+// ListStorageVersions lists the StorageVersions matching opts, failing the test on error.
+func (env *Env) ListStorageVersions(t *testing.T, opts ...ListOption) []v1alpha12.StorageVersion {
+	t.Helper()
+	items, err := env.ListStorageVersionsE(t, opts...)
+	require.NoError(t, err, "failed to list StorageVersions")
+	return items
+}
+
+// ListStorageVersionsE lists the StorageVersions matching opts, returning the error.
+func (env *Env) ListStorageVersionsE(t *testing.T, opts ...ListOption) ([]v1alpha12.StorageVersion, error) {
+	t.Helper()
+	list, err := env.Client.InternalV1alpha1().StorageVersions().List(env.Ctx, listOptions(opts))
+	if err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+// WaitForStorageVersion watches the StorageVersion called name until ready reports true
+// for it and returns it. A nil ready waits for it to exist. The test fails
+// after timeout.
+func (env *Env) WaitForStorageVersion(t *testing.T, name string, ready func(*v1alpha12.StorageVersion) bool, timeout time.Duration) *v1alpha12.StorageVersion {
+	t.Helper()
+	return waitFor(t, env, "StorageVersion", name, env.Client.InternalV1alpha1().StorageVersions(), ready, timeout)
+}
+
 // GetIPAddress fetches a IPAddress by name, failing the test on error.
 func (env *Env) GetIPAddress(t *testing.T, name string) *v111.IPAddress {
 	t.Helper()
@@ -1152,6 +3158,32 @@ func (env *Env) GetIPAddressE(t *testing.T, name string) (*v111.IPAddress, error
 	return env.Client.NetworkingV1().IPAddresses().Get(env.Ctx, name, v11.GetOptions{})
 }
 
+// ListIPAddresses lists the IPAddresses matching opts, failing the test on error.
+func (env *Env) ListIPAddresses(t *testing.T, opts ...ListOption) []v111.IPAddress {
+	t.Helper()
+	items, err := env.ListIPAddressesE(t, opts...)
+	require.NoError(t, err, "failed to list IPAddresses")
+	return items
+}
+
+// ListIPAddressesE lists the IPAddresses matching opts, returning the error.
+func (env *Env) ListIPAddressesE(t *testing.T, opts ...ListOption) ([]v111.IPAddress, error) {
+	t.Helper()
+	list, err := env.Client.NetworkingV1().IPAddresses().List(env.Ctx, listOptions(opts))
+	if err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+// WaitForIPAddress watches the IPAddress called name until ready reports true
+// for it and returns it. A nil ready waits for it to exist. The test fails
+// after timeout.
+func (env *Env) WaitForIPAddress(t *testing.T, name string, ready func(*v111.IPAddress) bool, timeout time.Duration) *v111.IPAddress {
+	t.Helper()
+	return waitFor(t, env, "IPAddress", name, env.Client.NetworkingV1().IPAddresses(), ready, timeout)
+}
+
 // GetIngress fetches a Ingress by name, failing the test on error.
 func (env *Env) GetIngress(t *testing.T, name string) *v111.Ingress {
 	t.Helper()
@@ -1166,6 +3198,32 @@ func (env *Env) GetIngressE(t *testing.T, name string) (*v111.Ingress, error) {
 	return env.Client.NetworkingV1().Ingresses(env.Kube.Namespace).Get(env.Ctx, name, v11.GetOptions{})
 }
 
+// ListIngresses lists the Ingresses matching opts, failing the test on error.
+func (env *Env) ListIngresses(t *testing.T, opts ...ListOption) []v111.Ingress {
+	t.Helper()
+	items, err := env.ListIngressesE(t, opts...)
+	require.NoError(t, err, "failed to list Ingresses")
+	return items
+}
+
+// ListIngressesE lists the Ingresses matching opts, returning the error.
+func (env *Env) ListIngressesE(t *testing.T, opts ...ListOption) ([]v111.Ingress, error) {
+	t.Helper()
+	list, err := env.Client.NetworkingV1().Ingresses(env.Kube.Namespace).List(env.Ctx, listOptions(opts))
+	if err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+// WaitForIngress watches the Ingress called name until ready reports true
+// for it and returns it. A nil ready waits for it to exist. The test fails
+// after timeout.
+func (env *Env) WaitForIngress(t *testing.T, name string, ready func(*v111.Ingress) bool, timeout time.Duration) *v111.Ingress {
+	t.Helper()
+	return waitFor(t, env, "Ingress", name, env.Client.NetworkingV1().Ingresses(env.Kube.Namespace), ready, timeout)
+}
+
 // GetIngressClass fetches a IngressClass by name, failing the test on error.
 func (env *Env) GetIngressClass(t *testing.T, name string) *v111.IngressClass {
 	t.Helper()
@@ -1180,6 +3238,32 @@ func (env *Env) GetIngressClassE(t *testing.T, name string) (*v111.IngressClass,
 	return env.Client.NetworkingV1().IngressClasses().Get(env.Ctx, name, v11.GetOptions{})
 }
 
+// ListIngressClasses lists the IngressClasses matching opts, failing the test on error.
+func (env *Env) ListIngressClasses(t *testing.T, opts ...ListOption) []v111.IngressClass {
+	t.Helper()
+	items, err := env.ListIngressClassesE(t, opts...)
+	require.NoError(t, err, "failed to list IngressClasses")
+	return items
+}
+
+// ListIngressClassesE lists the IngressClasses matching opts, returning the error.
+func (env *Env) ListIngressClassesE(t *testing.T, opts ...ListOption) ([]v111.IngressClass, error) {
+	t.Helper()
+	list, err := env.Client.NetworkingV1().IngressClasses().List(env.Ctx, listOptions(opts))
+	if err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+// WaitForIngressClass watches the IngressClass called name until ready reports true
+// for it and returns it. A nil ready waits for it to exist. The test fails
+// after timeout.
+func (env *Env) WaitForIngressClass(t *testing.T, name string, ready func(*v111.IngressClass) bool, timeout time.Duration) *v111.IngressClass {
+	t.Helper()
+	return waitFor(t, env, "IngressClass", name, env.Client.NetworkingV1().IngressClasses(), ready, timeout)
+}
+
 // GetNetworkPolicy fetches a NetworkPolicy by name, failing the test on error.
 func (env *Env) GetNetworkPolicy(t *testing.T, name string) *v111.NetworkPolicy {
 	t.Helper()
@@ -1194,6 +3278,32 @@ func (env *Env) GetNetworkPolicyE(t *testing.T, name string) (*v111.NetworkPolic
 	return env.Client.NetworkingV1().NetworkPolicies(env.Kube.Namespace).Get(env.Ctx, name, v11.GetOptions{})
 }
 
+// ListNetworkPolicies lists the NetworkPolicies matching opts, failing the test on error.
+func (env *Env) ListNetworkPolicies(t *testing.T, opts ...ListOption) []v111.NetworkPolicy {
+	t.Helper()
+	items, err := env.ListNetworkPoliciesE(t, opts...)
+	require.NoError(t, err, "failed to list NetworkPolicies")
+	return items
+}
+
+// ListNetworkPoliciesE lists the NetworkPolicies matching opts, returning the error.
+func (env *Env) ListNetworkPoliciesE(t *testing.T, opts ...ListOption) ([]v111.NetworkPolicy, error) {
+	t.Helper()
+	list, err := env.Client.NetworkingV1().NetworkPolicies(env.Kube.Namespace).List(env.Ctx, listOptions(opts))
+	if err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+// WaitForNetworkPolicy watches the NetworkPolicy called name until ready reports true
+// for it and returns it. A nil ready waits for it to exist. The test fails
+// after timeout.
+func (env *Env) WaitForNetworkPolicy(t *testing.T, name string, ready func(*v111.NetworkPolicy) bool, timeout time.Duration) *v111.NetworkPolicy {
+	t.Helper()
+	return waitFor(t, env, "NetworkPolicy", name, env.Client.NetworkingV1().NetworkPolicies(env.Kube.Namespace), ready, timeout)
+}
+
 // GetServiceCIDR fetches a ServiceCIDR by name, failing the test on error.
 func (env *Env) GetServiceCIDR(t *testing.T, name string) *v111.ServiceCIDR {
 	t.Helper()
@@ -1208,6 +3318,32 @@ func (env *Env) GetServiceCIDRE(t *testing.T, name string) (*v111.ServiceCIDR, e
 	return env.Client.NetworkingV1().ServiceCIDRs().Get(env.Ctx, name, v11.GetOptions{})
 }
 
+// ListServiceCIDRs lists the ServiceCIDRs matching opts, failing the test on error.
+func (env *Env) ListServiceCIDRs(t *testing.T, opts ...ListOption) []v111.ServiceCIDR {
+	t.Helper()
+	items, err := env.ListServiceCIDRsE(t, opts...)
+	require.NoError(t, err, "failed to list ServiceCIDRs")
+	return items
+}
+
+// ListServiceCIDRsE lists the ServiceCIDRs matching opts, returning the error.
+func (env *Env) ListServiceCIDRsE(t *testing.T, opts ...ListOption) ([]v111.ServiceCIDR, error) {
+	t.Helper()
+	list, err := env.Client.NetworkingV1().ServiceCIDRs().List(env.Ctx, listOptions(opts))
+	if err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+// WaitForServiceCIDR watches the ServiceCIDR called name until ready reports true
+// for it and returns it. A nil ready waits for it to exist. The test fails
+// after timeout.
+func (env *Env) WaitForServiceCIDR(t *testing.T, name string, ready func(*v111.ServiceCIDR) bool, timeout time.Duration) *v111.ServiceCIDR {
+	t.Helper()
+	return waitFor(t, env, "ServiceCIDR", name, env.Client.NetworkingV1().ServiceCIDRs(), ready, timeout)
+}
+
 // GetNetworkingV1beta1IPAddress fetches a NetworkingV1beta1IPAddress by name, failing the test on error.
 func (env *Env) GetNetworkingV1beta1IPAddress(t *testing.T, name string) *v1beta19.IPAddress {
 	t.Helper()
@@ -1222,6 +3358,32 @@ func (env *Env) GetNetworkingV1beta1IPAddressE(t *testing.T, name string) (*v1be
 	return env.Client.NetworkingV1beta1().IPAddresses().Get(env.Ctx, name, v11.GetOptions{})
 }
 
+// ListNetworkingV1beta1IPAddresses lists the NetworkingV1beta1IPAddresses matching opts, failing the test on error.
+func (env *Env) ListNetworkingV1beta1IPAddresses(t *testing.T, opts ...ListOption) []v1beta19.IPAddress {
+	t.Helper()
+	items, err := env.ListNetworkingV1beta1IPAddressesE(t, opts...)
+	require.NoError(t, err, "failed to list NetworkingV1beta1IPAddresses")
+	return items
+}
+
+// ListNetworkingV1beta1IPAddressesE lists the NetworkingV1beta1IPAddresses matching opts, returning the error.
+func (env *Env) ListNetworkingV1beta1IPAddressesE(t *testing.T, opts ...ListOption) ([]v1beta19.IPAddress, error) {
+	t.Helper()
+	list, err := env.Client.NetworkingV1beta1().IPAddresses().List(env.Ctx, listOptions(opts))
+	if err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+// WaitForNetworkingV1beta1IPAddress watches the NetworkingV1beta1IPAddress called name until ready reports true
+// for it and returns it. A nil ready waits for it to exist. The test fails
+// after timeout.
+func (env *Env) WaitForNetworkingV1beta1IPAddress(t *testing.T, name string, ready func(*v1beta19.IPAddress) bool, timeout time.Duration) *v1beta19.IPAddress {
+	t.Helper()
+	return waitFor(t, env, "IPAddress", name, env.Client.NetworkingV1beta1().IPAddresses(), ready, timeout)
+}
+
 // GetNetworkingV1beta1Ingress fetches a NetworkingV1beta1Ingress by name, failing the test on error.
 func (env *Env) GetNetworkingV1beta1Ingress(t *testing.T, name string) *v1beta19.Ingress {
 	t.Helper()
@@ -1236,6 +3398,32 @@ func (env *Env) GetNetworkingV1beta1IngressE(t *testing.T, name string) (*v1beta
 	return env.Client.NetworkingV1beta1().Ingresses(env.Kube.Namespace).Get(env.Ctx, name, v11.GetOptions{})
 }
 
+// ListNetworkingV1beta1Ingresses lists the NetworkingV1beta1Ingresses matching opts, failing the test on error.
+func (env *Env) ListNetworkingV1beta1Ingresses(t *testing.T, opts ...ListOption) []v1beta19.Ingress {
+	t.Helper()
+	items, err := env.ListNetworkingV1beta1IngressesE(t, opts...)
+	require.NoError(t, err, "failed to list NetworkingV1beta1Ingresses")
+	return items
+}
+
+// ListNetworkingV1beta1IngressesE lists the NetworkingV1beta1Ingresses matching opts, returning the error.
+func (env *Env) ListNetworkingV1beta1IngressesE(t *testing.T, opts ...ListOption) ([]v1beta19.Ingress, error) {
+	t.Helper()
+	list, err := env.Client.NetworkingV1beta1().Ingresses(env.Kube.Namespace).List(env.Ctx, listOptions(opts))
+	if err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+// WaitForNetworkingV1beta1Ingress watches the NetworkingV1beta1Ingress called name until ready reports true
+// for it and returns it. A nil ready waits for it to exist. The test fails
+// after timeout.
+func (env *Env) WaitForNetworkingV1beta1Ingress(t *testing.T, name string, ready func(*v1beta19.Ingress) bool, timeout time.Duration) *v1beta19.Ingress {
+	t.Helper()
+	return waitFor(t, env, "Ingress", name, env.Client.NetworkingV1beta1().Ingresses(env.Kube.Namespace), ready, timeout)
+}
+
 // GetNetworkingV1beta1IngressClass fetches a NetworkingV1beta1IngressClass by name, failing the test on error.
 func (env *Env) GetNetworkingV1beta1IngressClass(t *testing.T, name string) *v1beta19.IngressClass {
 	t.Helper()
@@ -1250,6 +3438,32 @@ func (env *Env) GetNetworkingV1beta1IngressClassE(t *testing.T, name string) (*v
 	return env.Client.NetworkingV1beta1().IngressClasses().Get(env.Ctx, name, v11.GetOptions{})
 }
 
+// ListNetworkingV1beta1IngressClasses lists the NetworkingV1beta1IngressClasses matching opts, failing the test on error.
+func (env *Env) ListNetworkingV1beta1IngressClasses(t *testing.T, opts ...ListOption) []v1beta19.IngressClass {
+	t.Helper()
+	items, err := env.ListNetworkingV1beta1IngressClassesE(t, opts...)
+	require.NoError(t, err, "failed to list NetworkingV1beta1IngressClasses")
+	return items
+}
+
+// ListNetworkingV1beta1IngressClassesE lists the NetworkingV1beta1IngressClasses matching opts, returning the error.
+func (env *Env) ListNetworkingV1beta1IngressClassesE(t *testing.T, opts ...ListOption) ([]v1beta19.IngressClass, error) {
+	t.Helper()
+	list, err := env.Client.NetworkingV1beta1().IngressClasses().List(env.Ctx, listOptions(opts))
+	if err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+// WaitForNetworkingV1beta1IngressClass watches the NetworkingV1beta1IngressClass called name until ready reports true
+// for it and returns it. A nil ready waits for it to exist. The test fails
+// after timeout.
+func (env *Env) WaitForNetworkingV1beta1IngressClass(t *testing.T, name string, ready func(*v1beta19.IngressClass) bool, timeout time.Duration) *v1beta19.IngressClass {
+	t.Helper()
+	return waitFor(t, env, "IngressClass", name, env.Client.NetworkingV1beta1().IngressClasses(), ready, timeout)
+}
+
 // GetNetworkingV1beta1ServiceCIDR fetches a NetworkingV1beta1ServiceCIDR by name, failing the test on error.
 func (env *Env) GetNetworkingV1beta1ServiceCIDR(t *testing.T, name string) *v1beta19.ServiceCIDR {
 	t.Helper()
@@ -1264,6 +3478,32 @@ func (env *Env) GetNetworkingV1beta1ServiceCIDRE(t *testing.T, name string) (*v1
 	return env.Client.NetworkingV1beta1().ServiceCIDRs().Get(env.Ctx, name, v11.GetOptions{})
 }
 
+// ListNetworkingV1beta1ServiceCIDRs lists the NetworkingV1beta1ServiceCIDRs matching opts, failing the test on error.
+func (env *Env) ListNetworkingV1beta1ServiceCIDRs(t *testing.T, opts ...ListOption) []v1beta19.ServiceCIDR {
+	t.Helper()
+	items, err := env.ListNetworkingV1beta1ServiceCIDRsE(t, opts...)
+	require.NoError(t, err, "failed to list NetworkingV1beta1ServiceCIDRs")
+	return items
+}
+
+// ListNetworkingV1beta1ServiceCIDRsE lists the NetworkingV1beta1ServiceCIDRs matching opts, returning the error.
+func (env *Env) ListNetworkingV1beta1ServiceCIDRsE(t *testing.T, opts ...ListOption) ([]v1beta19.ServiceCIDR, error) {
+	t.Helper()
+	list, err := env.Client.NetworkingV1beta1().ServiceCIDRs().List(env.Ctx, listOptions(opts))
+	if err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+// WaitForNetworkingV1beta1ServiceCIDR watches the NetworkingV1beta1ServiceCIDR called name until ready reports true
+// for it and returns it. A nil ready waits for it to exist. The test fails
+// after timeout.
+func (env *Env) WaitForNetworkingV1beta1ServiceCIDR(t *testing.T, name string, ready func(*v1beta19.ServiceCIDR) bool, timeout time.Duration) *v1beta19.ServiceCIDR {
+	t.Helper()
+	return waitFor(t, env, "ServiceCIDR", name, env.Client.NetworkingV1beta1().ServiceCIDRs(), ready, timeout)
+}
+
 // GetRuntimeClass fetches a RuntimeClass by name, failing the test on error.
 func (env *Env) GetRuntimeClass(t *testing.T, name string) *v112.RuntimeClass {
 	t.Helper()
@@ -1278,6 +3518,32 @@ func (env *Env) GetRuntimeClassE(t *testing.T, name string) (*v112.RuntimeClass,
 	return env.Client.NodeV1().RuntimeClasses().Get(env.Ctx, name, v11.GetOptions{})
 }
 
+// ListRuntimeClasses lists the RuntimeClasses matching opts, failing the test on error.
+func (env *Env) ListRuntimeClasses(t *testing.T, opts ...ListOption) []v112.RuntimeClass {
+	t.Helper()
+	items, err := env.ListRuntimeClassesE(t, opts...)
+	require.NoError(t, err, "failed to list RuntimeClasses")
+	return items
+}
+
+// ListRuntimeClassesE lists the RuntimeClasses matching opts, returning the error.
+func (env *Env) ListRuntimeClassesE(t *testing.T, opts ...ListOption) ([]v112.RuntimeClass, error) {
+	t.Helper()
+	list, err := env.Client.NodeV1().RuntimeClasses().List(env.Ctx, listOptions(opts))
+	if err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+// WaitForRuntimeClass watches the RuntimeClass called name until ready reports true
+// for it and returns it. A nil ready waits for it to exist. The test fails
+// after timeout.
+func (env *Env) WaitForRuntimeClass(t *testing.T, name string, ready func(*v112.RuntimeClass) bool, timeout time.Duration) *v112.RuntimeClass {
+	t.Helper()
+	return waitFor(t, env, "RuntimeClass", name, env.Client.NodeV1().RuntimeClasses(), ready, timeout)
+}
+
 // GetNodeV1alpha1RuntimeClass fetches a NodeV1alpha1RuntimeClass by name, failing the test on error.
 func (env *Env) GetNodeV1alpha1RuntimeClass(t *testing.T, name string) *v1alpha13.RuntimeClass {
 	t.Helper()
@@ -1292,6 +3558,32 @@ func (env *Env) GetNodeV1alpha1RuntimeClassE(t *testing.T, name string) (*v1alph
 	return env.Client.NodeV1alpha1().RuntimeClasses().Get(env.Ctx, name, v11.GetOptions{})
 }
 
+// ListNodeV1alpha1RuntimeClasses lists the NodeV1alpha1RuntimeClasses matching opts, failing the test on error.
+func (env *Env) ListNodeV1alpha1RuntimeClasses(t *testing.T, opts ...ListOption) []v1alpha13.RuntimeClass {
+	t.Helper()
+	items, err := env.ListNodeV1alpha1RuntimeClassesE(t, opts...)
+	require.NoError(t, err, "failed to list NodeV1alpha1RuntimeClasses")
+	return items
+}
+
+// ListNodeV1alpha1RuntimeClassesE lists the NodeV1alpha1RuntimeClasses matching opts, returning the error.
+func (env *Env) ListNodeV1alpha1RuntimeClassesE(t *testing.T, opts ...ListOption) ([]v1alpha13.RuntimeClass, error) {
+	t.Helper()
+	list, err := env.Client.NodeV1alpha1().RuntimeClasses().List(env.Ctx, listOptions(opts))
+	if err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+// WaitForNodeV1alpha1RuntimeClass watches the NodeV1alpha1RuntimeClass called name until ready reports true
+// for it and returns it. A nil ready waits for it to exist. The test fails
+// after timeout.
+func (env *Env) WaitForNodeV1alpha1RuntimeClass(t *testing.T, name string, ready func(*v1alpha13.RuntimeClass) bool, timeout time.Duration) *v1alpha13.RuntimeClass {
+	t.Helper()
+	return waitFor(t, env, "RuntimeClass", name, env.Client.NodeV1alpha1().RuntimeClasses(), ready, timeout)
+}
+
 // GetNodeV1beta1RuntimeClass fetches a NodeV1beta1RuntimeClass by name, failing the test on error.
 func (env *Env) GetNodeV1beta1RuntimeClass(t *testing.T, name string) *v1beta110.RuntimeClass {
 	t.Helper()
@@ -1306,6 +3598,32 @@ func (env *Env) GetNodeV1beta1RuntimeClassE(t *testing.T, name string) (*v1beta1
 	return env.Client.NodeV1beta1().RuntimeClasses().Get(env.Ctx, name, v11.GetOptions{})
 }
 
+// ListNodeV1beta1RuntimeClasses lists the NodeV1beta1RuntimeClasses matching opts, failing the test on error.
+func (env *Env) ListNodeV1beta1RuntimeClasses(t *testing.T, opts ...ListOption) []v1beta110.RuntimeClass {
+	t.Helper()
+	items, err := env.ListNodeV1beta1RuntimeClassesE(t, opts...)
+	require.NoError(t, err, "failed to list NodeV1beta1RuntimeClasses")
+	return items
+}
+
+// ListNodeV1beta1RuntimeClassesE lists the NodeV1beta1RuntimeClasses matching opts, returning the error.
+func (env *Env) ListNodeV1beta1RuntimeClassesE(t *testing.T, opts ...ListOption) ([]v1beta110.RuntimeClass, error) {
+	t.Helper()
+	list, err := env.Client.NodeV1beta1().RuntimeClasses().List(env.Ctx, listOptions(opts))
+	if err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+// WaitForNodeV1beta1RuntimeClass watches the NodeV1beta1RuntimeClass called name until ready reports true
+// for it and returns it. A nil ready waits for it to exist. The test fails
+// after timeout.
+func (env *Env) WaitForNodeV1beta1RuntimeClass(t *testing.T, name string, ready func(*v1beta110.RuntimeClass) bool, timeout time.Duration) *v1beta110.RuntimeClass {
+	t.Helper()
+	return waitFor(t, env, "RuntimeClass", name, env.Client.NodeV1beta1().RuntimeClasses(), ready, timeout)
+}
+
 // GetPodDisruptionBudget fetches a PodDisruptionBudget by name, failing the test on error.
 func (env *Env) GetPodDisruptionBudget(t *testing.T, name string) *v113.PodDisruptionBudget {
 	t.Helper()
@@ -1320,6 +3638,32 @@ func (env *Env) GetPodDisruptionBudgetE(t *testing.T, name string) (*v113.PodDis
 	return env.Client.PolicyV1().PodDisruptionBudgets(env.Kube.Namespace).Get(env.Ctx, name, v11.GetOptions{})
 }
 
+// ListPodDisruptionBudgets lists the PodDisruptionBudgets matching opts, failing the test on error.
+func (env *Env) ListPodDisruptionBudgets(t *testing.T, opts ...ListOption) []v113.PodDisruptionBudget {
+	t.Helper()
+	items, err := env.ListPodDisruptionBudgetsE(t, opts...)
+	require.NoError(t, err, "failed to list PodDisruptionBudgets")
+	return items
+}
+
+// ListPodDisruptionBudgetsE lists the PodDisruptionBudgets matching opts, returning the error.
+func (env *Env) ListPodDisruptionBudgetsE(t *testing.T, opts ...ListOption) ([]v113.PodDisruptionBudget, error) {
+	t.Helper()
+	list, err := env.Client.PolicyV1().PodDisruptionBudgets(env.Kube.Namespace).List(env.Ctx, listOptions(opts))
+	if err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+// WaitForPodDisruptionBudget watches the PodDisruptionBudget called name until ready reports true
+// for it and returns it. A nil ready waits for it to exist. The test fails
+// after timeout.
+func (env *Env) WaitForPodDisruptionBudget(t *testing.T, name string, ready func(*v113.PodDisruptionBudget) bool, timeout time.Duration) *v113.PodDisruptionBudget {
+	t.Helper()
+	return waitFor(t, env, "PodDisruptionBudget", name, env.Client.PolicyV1().PodDisruptionBudgets(env.Kube.Namespace), ready, timeout)
+}
+
 // GetPolicyV1beta1PodDisruptionBudget fetches a PolicyV1beta1PodDisruptionBudget by name, failing the test on error.
 func (env *Env) GetPolicyV1beta1PodDisruptionBudget(t *testing.T, name string) *v1beta111.PodDisruptionBudget {
 	t.Helper()
@@ -1334,6 +3678,32 @@ func (env *Env) GetPolicyV1beta1PodDisruptionBudgetE(t *testing.T, name string)
 	return env.Client.PolicyV1beta1().PodDisruptionBudgets(env.Kube.Namespace).Get(env.Ctx, name, v11.GetOptions{})
 }
 
+// ListPolicyV1beta1PodDisruptionBudgets lists the PolicyV1beta1PodDisruptionBudgets matching opts, failing the test on error.
+func (env *Env) ListPolicyV1beta1PodDisruptionBudgets(t *testing.T, opts ...ListOption) []v1beta111.PodDisruptionBudget {
+	t.Helper()
+	items, err := env.ListPolicyV1beta1PodDisruptionBudgetsE(t, opts...)
+	require.NoError(t, err, "failed to list PolicyV1beta1PodDisruptionBudgets")
+	return items
+}
+
+// ListPolicyV1beta1PodDisruptionBudgetsE lists the PolicyV1beta1PodDisruptionBudgets matching opts, returning the error.
+func (env *Env) ListPolicyV1beta1PodDisruptionBudgetsE(t *testing.T, opts ...ListOption) ([]v1beta111.PodDisruptionBudget, error) {
+	t.Helper()
+	list, err := env.Client.PolicyV1beta1().PodDisruptionBudgets(env.Kube.Namespace).List(env.Ctx, listOptions(opts))
+	if err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+// WaitForPolicyV1beta1PodDisruptionBudget watches the PolicyV1beta1PodDisruptionBudget called name until ready reports true
+// for it and returns it. A nil ready waits for it to exist. The test fails
+// after timeout.
+func (env *Env) WaitForPolicyV1beta1PodDisruptionBudget(t *testing.T, name string, ready func(*v1beta111.PodDisruptionBudget) bool, timeout time.Duration) *v1beta111.PodDisruptionBudget {
+	t.Helper()
+	return waitFor(t, env, "PodDisruptionBudget", name, env.Client.PolicyV1beta1().PodDisruptionBudgets(env.Kube.Namespace), ready, timeout)
+}
+
 // GetClusterRole fetches a ClusterRole by name, failing the test on error.
 func (env *Env) GetClusterRole(t *testing.T, name string) *v114.ClusterRole {
 	t.Helper()
@@ -1348,6 +3718,32 @@ func (env *Env) GetClusterRoleE(t *testing.T, name string) (*v114.ClusterRole, e
 	return env.Client.RbacV1().ClusterRoles().Get(env.Ctx, name, v11.GetOptions{})
 }
 
+// ListClusterRoles lists the ClusterRoles matching opts, failing the test on error.
+func (env *Env) ListClusterRoles(t *testing.T, opts ...ListOption) []v114.ClusterRole {
+	t.Helper()
+	items, err := env.ListClusterRolesE(t, opts...)
+	require.NoError(t, err, "failed to list ClusterRoles")
+	return items
+}
+
+// ListClusterRolesE lists the ClusterRoles matching opts, returning the error.
+func (env *Env) ListClusterRolesE(t *testing.T, opts ...ListOption) ([]v114.ClusterRole, error) {
+	t.Helper()
+	list, err := env.Client.RbacV1().ClusterRoles().List(env.Ctx, listOptions(opts))
+	if err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+// WaitForClusterRole watches the ClusterRole called name until ready reports true
+// for it and returns it. A nil ready waits for it to exist. The test fails
+// after timeout.
+func (env *Env) WaitForClusterRole(t *testing.T, name string, ready func(*v114.ClusterRole) bool, timeout time.Duration) *v114.ClusterRole {
+	t.Helper()
+	return waitFor(t, env, "ClusterRole", name, env.Client.RbacV1().ClusterRoles(), ready, timeout)
+}
+
 // GetClusterRoleBinding fetches a ClusterRoleBinding by name, failing the test on error.
 func (env *Env) GetClusterRoleBinding(t *testing.T, name string) *v114.ClusterRoleBinding {
 	t.Helper()
@@ -1362,6 +3758,32 @@ func (env *Env) GetClusterRoleBindingE(t *testing.T, name string) (*v114.Cluster
 	return env.Client.RbacV1().ClusterRoleBindings().Get(env.Ctx, name, v11.GetOptions{})
 }
 
+// ListClusterRoleBindings lists the ClusterRoleBindings matching opts, failing the test on error.
+func (env *Env) ListClusterRoleBindings(t *testing.T, opts ...ListOption) []v114.ClusterRoleBinding {
+	t.Helper()
+	items, err := env.ListClusterRoleBindingsE(t, opts...)
+	require.NoError(t, err, "failed to list ClusterRoleBindings")
+	return items
+}
+
+// ListClusterRoleBindingsE lists the ClusterRoleBindings matching opts, returning the error.
+func (env *Env) ListClusterRoleBindingsE(t *testing.T, opts ...ListOption) ([]v114.ClusterRoleBinding, error) {
+	t.Helper()
+	list, err := env.Client.RbacV1().ClusterRoleBindings().List(env.Ctx, listOptions(opts))
+	if err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+// WaitForClusterRoleBinding watches the ClusterRoleBinding called name until ready reports true
+// for it and returns it. A nil ready waits for it to exist. The test fails
+// after timeout.
+func (env *Env) WaitForClusterRoleBinding(t *testing.T, name string, ready func(*v114.ClusterRoleBinding) bool, timeout time.Duration) *v114.ClusterRoleBinding {
+	t.Helper()
+	return waitFor(t, env, "ClusterRoleBinding", name, env.Client.RbacV1().ClusterRoleBindings(), ready, timeout)
+}
+
 // GetRole fetches a Role by name, failing the test on error.
 func (env *Env) GetRole(t *testing.T, name string) *v114.Role {
 	t.Helper()
@@ -1376,6 +3798,32 @@ func (env *Env) GetRoleE(t *testing.T, name string) (*v114.Role, error) {
 	return env.Client.RbacV1().Roles(env.Kube.Namespace).Get(env.Ctx, name, v11.GetOptions{})
 }
 
+// ListRoles lists the Roles matching opts, failing the test on error.
+func (env *Env) ListRoles(t *testing.T, opts ...ListOption) []v114.Role {
+	t.Helper()
+	items, err := env.ListRolesE(t, opts...)
+	require.NoError(t, err, "failed to list Roles")
+	return items
+}
+
+// ListRolesE lists the Roles matching opts, returning the error.
+func (env *Env) ListRolesE(t *testing.T, opts ...ListOption) ([]v114.Role, error) {
+	t.Helper()
+	list, err := env.Client.RbacV1().Roles(env.Kube.Namespace).List(env.Ctx, listOptions(opts))
+	if err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+// WaitForRole watches the Role called name until ready reports true
+// for it and returns it. A nil ready waits for it to exist. The test fails
+// after timeout.
+func (env *Env) WaitForRole(t *testing.T, name string, ready func(*v114.Role) bool, timeout time.Duration) *v114.Role {
+	t.Helper()
+	return waitFor(t, env, "Role", name, env.Client.RbacV1().Roles(env.Kube.Namespace), ready, timeout)
+}
+
 // GetRoleBinding fetches a RoleBinding by name, failing the test on error.
 func (env *Env) GetRoleBinding(t *testing.T, name string) *v114.RoleBinding {
 	t.Helper()
@@ -1390,6 +3838,32 @@ func (env *Env) GetRoleBindingE(t *testing.T, name string) (*v114.RoleBinding, e
 	return env.Client.RbacV1().RoleBindings(env.Kube.Namespace).Get(env.Ctx, name, v11.GetOptions{})
 }
 
+// ListRoleBindings lists the RoleBindings matching opts, failing the test on error.
+func (env *Env) ListRoleBindings(t *testing.T, opts ...ListOption) []v114.RoleBinding {
+	t.Helper()
+	items, err := env.ListRoleBindingsE(t, opts...)
+	require.NoError(t, err, "failed to list RoleBindings")
+	return items
+}
+
+// ListRoleBindingsE lists the RoleBindings matching opts, returning the error.
+func (env *Env) ListRoleBindingsE(t *testing.T, opts ...ListOption) ([]v114.RoleBinding, error) {
+	t.Helper()
+	list, err := env.Client.RbacV1().RoleBindings(env.Kube.Namespace).List(env.Ctx, listOptions(opts))
+	if err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+// WaitForRoleBinding watches the RoleBinding called name until ready reports true
+// for it and returns it. A nil ready waits for it to exist. The test fails
+// after timeout.
+func (env *Env) WaitForRoleBinding(t *testing.T, name string, ready func(*v114.RoleBinding) bool, timeout time.Duration) *v114.RoleBinding {
+	t.Helper()
+	return waitFor(t, env, "RoleBinding", name, env.Client.RbacV1().RoleBindings(env.Kube.Namespace), ready, timeout)
+}
+
 // GetRbacV1alpha1ClusterRole fetches a RbacV1alpha1ClusterRole by name, failing the test on error.
 func (env *Env) GetRbacV1alpha1ClusterRole(t *testing.T, name string) *v1alpha14.ClusterRole {
 	t.Helper()
@@ -1404,6 +3878,32 @@ func (env *Env) GetRbacV1alpha1ClusterRoleE(t *testing.T, name string) (*v1alpha
 	return env.Client.RbacV1alpha1().ClusterRoles().Get(env.Ctx, name, v11.GetOptions{})
 }
 
+// ListRbacV1alpha1ClusterRoles lists the RbacV1alpha1ClusterRoles matching opts, failing the test on error.
+func (env *Env) ListRbacV1alpha1ClusterRoles(t *testing.T, opts ...ListOption) []v1alpha14.ClusterRole {
+	t.Helper()
+	items, err := env.ListRbacV1alpha1ClusterRolesE(t, opts...)
+	require.NoError(t, err, "failed to list RbacV1alpha1ClusterRoles")
+	return items
+}
+
+// ListRbacV1alpha1ClusterRolesE lists the RbacV1alpha1ClusterRoles matching opts, returning the error.
+func (env *Env) ListRbacV1alpha1ClusterRolesE(t *testing.T, opts ...ListOption) ([]v1alpha14.ClusterRole, error) {
+	t.Helper()
+	list, err := env.Client.RbacV1alpha1().ClusterRoles().List(env.Ctx, listOptions(opts))
+	if err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+// WaitForRbacV1alpha1ClusterRole watches the RbacV1alpha1ClusterRole called name until ready reports true
+// for it and returns it. A nil ready waits for it to exist. The test fails
+// after timeout.
+func (env *Env) WaitForRbacV1alpha1ClusterRole(t *testing.T, name string, ready func(*v1alpha14.ClusterRole) bool, timeout time.Duration) *v1alpha14.ClusterRole {
+	t.Helper()
+	return waitFor(t, env, "ClusterRole", name, env.Client.RbacV1alpha1().ClusterRoles(), ready, timeout)
+}
+
 // GetRbacV1alpha1ClusterRoleBinding fetches a RbacV1alpha1ClusterRoleBinding by name, failing the test on error.
 func (env *Env) GetRbacV1alpha1ClusterRoleBinding(t *testing.T, name string) *v1alpha14.ClusterRoleBinding {
 	t.Helper()
@@ -1418,6 +3918,32 @@ func (env *Env) GetRbacV1alpha1ClusterRoleBindingE(t *testing.T, name string) (*
 	return env.Client.RbacV1alpha1().ClusterRoleBindings().Get(env.Ctx, name, v11.GetOptions{})
 }
 
+// ListRbacV1alpha1ClusterRoleBindings lists the RbacV1alpha1ClusterRoleBindings matching opts, failing the test on error.
+func (env *Env) ListRbacV1alpha1ClusterRoleBindings(t *testing.T, opts ...ListOption) []v1alpha14.ClusterRoleBinding {
+	t.Helper()
+	items, err := env.ListRbacV1alpha1ClusterRoleBindingsE(t, opts...)
+	require.NoError(t, err, "failed to list RbacV1alpha1ClusterRoleBindings")
+	return items
+}
+
+// ListRbacV1alpha1ClusterRoleBindingsE lists the RbacV1alpha1ClusterRoleBindings matching opts, returning the error.
+func (env *Env) ListRbacV1alpha1ClusterRoleBindingsE(t *testing.T, opts ...ListOption) ([]v1alpha14.ClusterRoleBinding, error) {
+	t.Helper()
+	list, err := env.Client.RbacV1alpha1().ClusterRoleBindings().List(env.Ctx, listOptions(opts))
+	if err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+// WaitForRbacV1alpha1ClusterRoleBinding watches the RbacV1alpha1ClusterRoleBinding called name until ready reports true
+// for it and returns it. A nil ready waits for it to exist. The test fails
+// after timeout.
+func (env *Env) WaitForRbacV1alpha1ClusterRoleBinding(t *testing.T, name string, ready func(*v1alpha14.ClusterRoleBinding) bool, timeout time.Duration) *v1alpha14.ClusterRoleBinding {
+	t.Helper()
+	return waitFor(t, env, "ClusterRoleBinding", name, env.Client.RbacV1alpha1().ClusterRoleBindings(), ready, timeout)
+}
+
 // GetRbacV1alpha1Role fetches a RbacV1alpha1Role by name, failing the test on error.
 func (env *Env) GetRbacV1alpha1Role(t *testing.T, name string) *v1alpha14.Role {
 	t.Helper()
@@ -1432,6 +3958,32 @@ func (env *Env) GetRbacV1alpha1RoleE(t *testing.T, name string) (*v1alpha14.Role
 	return env.Client.RbacV1alpha1().Roles(env.Kube.Namespace).Get(env.Ctx, name, v11.GetOptions{})
 }
 
+// ListRbacV1alpha1Roles lists the RbacV1alpha1Roles matching opts, failing the test on error.
+func (env *Env) ListRbacV1alpha1Roles(t *testing.T, opts ...ListOption) []v1alpha14.Role {
+	t.Helper()
+	items, err := env.ListRbacV1alpha1RolesE(t, opts...)
+	require.NoError(t, err, "failed to list RbacV1alpha1Roles")
+	return items
+}
+
+// ListRbacV1alpha1RolesE lists the RbacV1alpha1Roles matching opts, returning the error.
+func (env *Env) ListRbacV1alpha1RolesE(t *testing.T, opts ...ListOption) ([]v1alpha14.Role, error) {
+	t.Helper()
+	list, err := env.Client.RbacV1alpha1().Roles(env.Kube.Namespace).List(env.Ctx, listOptions(opts))
+	if err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+// WaitForRbacV1alpha1Role watches the RbacV1alpha1Role called name until ready reports true
+// for it and returns it. A nil ready waits for it to exist. The test fails
+// after timeout.
+func (env *Env) WaitForRbacV1alpha1Role(t *testing.T, name string, ready func(*v1alpha14.Role) bool, timeout time.Duration) *v1alpha14.Role {
+	t.Helper()
+	return waitFor(t, env, "Role", name, env.Client.RbacV1alpha1().Roles(env.Kube.Namespace), ready, timeout)
+}
+
 // GetRbacV1alpha1RoleBinding fetches a RbacV1alpha1RoleBinding by name, failing the test on error.
 func (env *Env) GetRbacV1alpha1RoleBinding(t *testing.T, name string) *v1alpha14.RoleBinding {
 	t.Helper()
@@ -1446,6 +3998,32 @@ func (env *Env) GetRbacV1alpha1RoleBindingE(t *testing.T, name string) (*v1alpha
 	return env.Client.RbacV1alpha1().RoleBindings(env.Kube.Namespace).Get(env.Ctx, name, v11.GetOptions{})
 }
 
+// ListRbacV1alpha1RoleBindings lists the RbacV1alpha1RoleBindings matching opts, failing the test on error.
+func (env *Env) ListRbacV1alpha1RoleBindings(t *testing.T, opts ...ListOption) []v1alpha14.RoleBinding {
+	t.Helper()
+	items, err := env.ListRbacV1alpha1RoleBindingsE(t, opts...)
+	require.NoError(t, err, "failed to list RbacV1alpha1RoleBindings")
+	return items
+}
+
+// ListRbacV1alpha1RoleBindingsE lists the RbacV1alpha1RoleBindings matching opts, returning the error.
+func (env *Env) ListRbacV1alpha1RoleBindingsE(t *testing.T, opts ...ListOption) ([]v1alpha14.RoleBinding, error) {
+	t.Helper()
+	list, err := env.Client.RbacV1alpha1().RoleBindings(env.Kube.Namespace).List(env.Ctx, listOptions(opts))
+	if err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+// WaitForRbacV1alpha1RoleBinding watches the RbacV1alpha1RoleBinding called name until ready reports true
+// for it and returns it. A nil ready waits for it to exist. The test fails
+// after timeout.
+func (env *Env) WaitForRbacV1alpha1RoleBinding(t *testing.T, name string, ready func(*v1alpha14.RoleBinding) bool, timeout time.Duration) *v1alpha14.RoleBinding {
+	t.Helper()
+	return waitFor(t, env, "RoleBinding", name, env.Client.RbacV1alpha1().RoleBindings(env.Kube.Namespace), ready, timeout)
+}
+
 // GetRbacV1beta1ClusterRole fetches a RbacV1beta1ClusterRole by name, failing the test on error.
 func (env *Env) GetRbacV1beta1ClusterRole(t *testing.T, name string) *v1beta112.ClusterRole {
 	t.Helper()
@@ -1460,6 +4038,32 @@ func (env *Env) GetRbacV1beta1ClusterRoleE(t *testing.T, name string) (*v1beta11
 	return env.Client.RbacV1beta1().ClusterRoles().Get(env.Ctx, name, v11.GetOptions{})
 }
 
+// ListRbacV1beta1ClusterRoles lists the RbacV1beta1ClusterRoles matching opts, failing the test on error.
+func (env *Env) ListRbacV1beta1ClusterRoles(t *testing.T, opts ...ListOption) []v1beta112.ClusterRole {
+	t.Helper()
+	items, err := env.ListRbacV1beta1ClusterRolesE(t, opts...)
+	require.NoError(t, err, "failed to list RbacV1beta1ClusterRoles")
+	return items
+}
+
+// ListRbacV1beta1ClusterRolesE lists the RbacV1beta1ClusterRoles matching opts, returning the error.
+func (env *Env) ListRbacV1beta1ClusterRolesE(t *testing.T, opts ...ListOption) ([]v1beta112.ClusterRole, error) {
+	t.Helper()
+	list, err := env.Client.RbacV1beta1().ClusterRoles().List(env.Ctx, listOptions(opts))
+	if err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+// WaitForRbacV1beta1ClusterRole watches the RbacV1beta1ClusterRole called name until ready reports true
+// for it and returns it. A nil ready waits for it to exist. The test fails
+// after timeout.
+func (env *Env) WaitForRbacV1beta1ClusterRole(t *testing.T, name string, ready func(*v1beta112.ClusterRole) bool, timeout time.Duration) *v1beta112.ClusterRole {
+	t.Helper()
+	return waitFor(t, env, "ClusterRole", name, env.Client.RbacV1beta1().ClusterRoles(), ready, timeout)
+}
+
 // GetRbacV1beta1ClusterRoleBinding fetches a RbacV1beta1ClusterRoleBinding by name, failing the test on error.
 func (env *Env) GetRbacV1beta1ClusterRoleBinding(t *testing.T, name string) *v1beta112.ClusterRoleBinding {
 	t.Helper()
@@ -1474,6 +4078,32 @@ func (env *Env) GetRbacV1beta1ClusterRoleBindingE(t *testing.T, name string) (*v
 	return env.Client.RbacV1beta1().ClusterRoleBindings().Get(env.Ctx, name, v11.GetOptions{})
 }
 
+// ListRbacV1beta1ClusterRoleBindings lists the RbacV1beta1ClusterRoleBindings matching opts, failing the test on error.
+func (env *Env) ListRbacV1beta1ClusterRoleBindings(t *testing.T, opts ...ListOption) []v1beta112.ClusterRoleBinding {
+	t.Helper()
+	items, err := env.ListRbacV1beta1ClusterRoleBindingsE(t, opts...)
+	require.NoError(t, err, "failed to list RbacV1beta1ClusterRoleBindings")
+	return items
+}
+
+// ListRbacV1beta1ClusterRoleBindingsE lists the RbacV1beta1ClusterRoleBindings matching opts, returning the error.
+func (env *Env) ListRbacV1beta1ClusterRoleBindingsE(t *testing.T, opts ...ListOption) ([]v1beta112.ClusterRoleBinding, error) {
+	t.Helper()
+	list, err := env.Client.RbacV1beta1().ClusterRoleBindings().List(env.Ctx, listOptions(opts))
+	if err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+// WaitForRbacV1beta1ClusterRoleBinding watches the RbacV1beta1ClusterRoleBinding called name until ready reports true
+// for it and returns it. A nil ready waits for it to exist. The test fails
+// after timeout.
+func (env *Env) WaitForRbacV1beta1ClusterRoleBinding(t *testing.T, name string, ready func(*v1beta112.ClusterRoleBinding) bool, timeout time.Duration) *v1beta112.ClusterRoleBinding {
+	t.Helper()
+	return waitFor(t, env, "ClusterRoleBinding", name, env.Client.RbacV1beta1().ClusterRoleBindings(), ready, timeout)
+}
+
 // GetRbacV1beta1Role fetches a RbacV1beta1Role by name, failing the test on error.
 func (env *Env) GetRbacV1beta1Role(t *testing.T, name string) *v1beta112.Role {
 	t.Helper()
@@ -1488,6 +4118,32 @@ func (env *Env) GetRbacV1beta1RoleE(t *testing.T, name string) (*v1beta112.Role,
 	return env.Client.RbacV1beta1().Roles(env.Kube.Namespace).Get(env.Ctx, name, v11.GetOptions{})
 }
 
+// ListRbacV1beta1Roles lists the RbacV1beta1Roles matching opts, failing the test on error.
+func (env *Env) ListRbacV1beta1Roles(t *testing.T, opts ...ListOption) []v1beta112.Role {
+	t.Helper()
+	items, err := env.ListRbacV1beta1RolesE(t, opts...)
+	require.NoError(t, err, "failed to list RbacV1beta1Roles")
+	return items
+}
+
+// ListRbacV1beta1RolesE lists the RbacV1beta1Roles matching opts, returning the error.
+func (env *Env) ListRbacV1beta1RolesE(t *testing.T, opts ...ListOption) ([]v1beta112.Role, error) {
+	t.Helper()
+	list, err := env.Client.RbacV1beta1().Roles(env.Kube.Namespace).List(env.Ctx, listOptions(opts))
+	if err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+// WaitForRbacV1beta1Role watches the RbacV1beta1Role called name until ready reports true
+// for it and returns it. A nil ready waits for it to exist. The test fails
+// after timeout.
+func (env *Env) WaitForRbacV1beta1Role(t *testing.T, name string, ready func(*v1beta112.Role) bool, timeout time.Duration) *v1beta112.Role {
+	t.Helper()
+	return waitFor(t, env, "Role", name, env.Client.RbacV1beta1().Roles(env.Kube.Namespace), ready, timeout)
+}
+
 // GetRbacV1beta1RoleBinding fetches a RbacV1beta1RoleBinding by name, failing the test on error.
 func (env *Env) GetRbacV1beta1RoleBinding(t *testing.T, name string) *v1beta112.RoleBinding {
 	t.Helper()
@@ -1502,6 +4158,32 @@ func (env *Env) GetRbacV1beta1RoleBindingE(t *testing.T, name string) (*v1beta11
 	return env.Client.RbacV1beta1().RoleBindings(env.Kube.Namespace).Get(env.Ctx, name, v11.GetOptions{})
 }
 
+// ListRbacV1beta1RoleBindings lists the RbacV1beta1RoleBindings matching opts, failing the test on error.
+func (env *Env) ListRbacV1beta1RoleBindings(t *testing.T, opts ...ListOption) []v1beta112.RoleBinding {
+	t.Helper()
+	items, err := env.ListRbacV1beta1RoleBindingsE(t, opts...)
+	require.NoError(t, err, "failed to list RbacV1beta1RoleBindings")
+	return items
+}
+
+// ListRbacV1beta1RoleBindingsE lists the RbacV1beta1RoleBindings matching opts, returning the error.
+func (env *Env) ListRbacV1beta1RoleBindingsE(t *testing.T, opts ...ListOption) ([]v1beta112.RoleBinding, error) {
+	t.Helper()
+	list, err := env.Client.RbacV1beta1().RoleBindings(env.Kube.Namespace).List(env.Ctx, listOptions(opts))
+	if err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+// WaitForRbacV1beta1RoleBinding watches the RbacV1beta1RoleBinding called name until ready reports true
+// for it and returns it. A nil ready waits for it to exist. The test fails
+// after timeout.
+func (env *Env) WaitForRbacV1beta1RoleBinding(t *testing.T, name string, ready func(*v1beta112.RoleBinding) bool, timeout time.Duration) *v1beta112.RoleBinding {
+	t.Helper()
+	return waitFor(t, env, "RoleBinding", name, env.Client.RbacV1beta1().RoleBindings(env.Kube.Namespace), ready, timeout)
+}
+
 // GetDeviceClass fetches a DeviceClass by name, failing the test on error.
 func (env *Env) GetDeviceClass(t *testing.T, name string) *v115.DeviceClass {
 	t.Helper()
@@ -1516,6 +4198,32 @@ func (env *Env) GetDeviceClassE(t *testing.T, name string) (*v115.DeviceClass, e
 	return env.Client.ResourceV1().DeviceClasses().Get(env.Ctx, name, v11.GetOptions{})
 }
 
+// ListDeviceClasses lists the DeviceClasses matching opts, failing the test on error.
+func (env *Env) ListDeviceClasses(t *testing.T, opts ...ListOption) []v115.DeviceClass {
+	t.Helper()
+	items, err := env.ListDeviceClassesE(t, opts...)
+	require.NoError(t, err, "failed to list DeviceClasses")
+	return items
+}
+
+// ListDeviceClassesE lists the DeviceClasses matching opts, returning the error.
+func (env *Env) ListDeviceClassesE(t *testing.T, opts ...ListOption) ([]v115.DeviceClass, error) {
+	t.Helper()
+	list, err := env.Client.ResourceV1().DeviceClasses().List(env.Ctx, listOptions(opts))
+	if err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+// WaitForDeviceClass watches the DeviceClass called name until ready reports true
+// for it and returns it. A nil ready waits for it to exist. The test fails
+// after timeout.
+func (env *Env) WaitForDeviceClass(t *testing.T, name string, ready func(*v115.DeviceClass) bool, timeout time.Duration) *v115.DeviceClass {
+	t.Helper()
+	return waitFor(t, env, "DeviceClass", name, env.Client.ResourceV1().DeviceClasses(), ready, timeout)
+}
+
 // GetResourceClaim fetches a ResourceClaim by name, failing the test on error.
 func (env *Env) GetResourceClaim(t *testing.T, name string) *v115.ResourceClaim {
 	t.Helper()
@@ -1530,6 +4238,32 @@ func (env *Env) GetResourceClaimE(t *testing.T, name string) (*v115.ResourceClai
 	return env.Client.ResourceV1().ResourceClaims(env.Kube.Namespace).Get(env.Ctx, name, v11.GetOptions{})
 }
 
+// ListResourceClaims lists the ResourceClaims matching opts, failing the test on error.
+func (env *Env) ListResourceClaims(t *testing.T, opts ...ListOption) []v115.ResourceClaim {
+	t.Helper()
+	items, err := env.ListResourceClaimsE(t, opts...)
+	require.NoError(t, err, "failed to list ResourceClaims")
+	return items
+}
+
+// ListResourceClaimsE lists the ResourceClaims matching opts, returning the error.
+func (env *Env) ListResourceClaimsE(t *testing.T, opts ...ListOption) ([]v115.ResourceClaim, error) {
+	t.Helper()
+	list, err := env.Client.ResourceV1().ResourceClaims(env.Kube.Namespace).List(env.Ctx, listOptions(opts))
+	if err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+// WaitForResourceClaim watches the ResourceClaim called name until ready reports true
+// for it and returns it. A nil ready waits for it to exist. The test fails
+// after timeout.
+func (env *Env) WaitForResourceClaim(t *testing.T, name string, ready func(*v115.ResourceClaim) bool, timeout time.Duration) *v115.ResourceClaim {
+	t.Helper()
+	return waitFor(t, env, "ResourceClaim", name, env.Client.ResourceV1().ResourceClaims(env.Kube.Namespace), ready, timeout)
+}
+
 // GetResourceClaimTemplate fetches a ResourceClaimTemplate by name, failing the test on error.
 func (env *Env) GetResourceClaimTemplate(t *testing.T, name string) *v115.ResourceClaimTemplate {
 	t.Helper()
@@ -1544,6 +4278,32 @@ func (env *Env) GetResourceClaimTemplateE(t *testing.T, name string) (*v115.Reso
 	return env.Client.ResourceV1().ResourceClaimTemplates(env.Kube.Namespace).Get(env.Ctx, name, v11.GetOptions{})
 }
 
+// ListResourceClaimTemplates lists the ResourceClaimTemplates matching opts, failing the test on error.
+func (env *Env) ListResourceClaimTemplates(t *testing.T, opts ...ListOption) []v115.ResourceClaimTemplate {
+	t.Helper()
+	items, err := env.ListResourceClaimTemplatesE(t, opts...)
+	require.NoError(t, err, "failed to list ResourceClaimTemplates")
+	return items
+}
+
+// ListResourceClaimTemplatesE lists the ResourceClaimTemplates matching opts, returning the error.
+func (env *Env) ListResourceClaimTemplatesE(t *testing.T, opts ...ListOption) ([]v115.ResourceClaimTemplate, error) {
+	t.Helper()
+	list, err := env.Client.ResourceV1().ResourceClaimTemplates(env.Kube.Namespace).List(env.Ctx, listOptions(opts))
+	if err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+// WaitForResourceClaimTemplate watches the ResourceClaimTemplate called name until ready reports true
+// for it and returns it. A nil ready waits for it to exist. The test fails
+// after timeout.
+func (env *Env) WaitForResourceClaimTemplate(t *testing.T, name string, ready func(*v115.ResourceClaimTemplate) bool, timeout time.Duration) *v115.ResourceClaimTemplate {
+	t.Helper()
+	return waitFor(t, env, "ResourceClaimTemplate", name, env.Client.ResourceV1().ResourceClaimTemplates(env.Kube.Namespace), ready, timeout)
+}
+
 // GetResourceSlice fetches a ResourceSlice by name, failing the test on error.
 func (env *Env) GetResourceSlice(t *testing.T, name string) *v115.ResourceSlice {
 	t.Helper()
@@ -1558,6 +4318,32 @@ func (env *Env) GetResourceSliceE(t *testing.T, name string) (*v115.ResourceSlic
 	return env.Client.ResourceV1().ResourceSlices().Get(env.Ctx, name, v11.GetOptions{})
 }
 
+// ListResourceSlices lists the ResourceSlices matching opts, failing the test on error.
+func (env *Env) ListResourceSlices(t *testing.T, opts ...ListOption) []v115.ResourceSlice {
+	t.Helper()
+	items, err := env.ListResourceSlicesE(t, opts...)
+	require.NoError(t, err, "failed to list ResourceSlices")
+	return items
+}
+
+// ListResourceSlicesE lists the ResourceSlices matching opts, returning the error.
+func (env *Env) ListResourceSlicesE(t *testing.T, opts ...ListOption) ([]v115.ResourceSlice, error) {
+	t.Helper()
+	list, err := env.Client.ResourceV1().ResourceSlices().List(env.Ctx, listOptions(opts))
+	if err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+// WaitForResourceSlice watches the ResourceSlice called name until ready reports true
+// for it and returns it. A nil ready waits for it to exist. The test fails
+// after timeout.
+func (env *Env) WaitForResourceSlice(t *testing.T, name string, ready func(*v115.ResourceSlice) bool, timeout time.Duration) *v115.ResourceSlice {
+	t.Helper()
+	return waitFor(t, env, "ResourceSlice", name, env.Client.ResourceV1().ResourceSlices(), ready, timeout)
+}
+
 // GetDeviceTaintRule fetches a DeviceTaintRule by name, failing the test on error.
 func (env *Env) GetDeviceTaintRule(t *testing.T, name string) *v1alpha3.DeviceTaintRule {
 	t.Helper()
@@ -1572,6 +4358,32 @@ func (env *Env) GetDeviceTaintRuleE(t *testing.T, name string) (*v1alpha3.Device
 	return env.Client.ResourceV1alpha3().DeviceTaintRules().Get(env.Ctx, name, v11.GetOptions{})
 }
 
+// ListDeviceTaintRules lists the DeviceTaintRules matching opts, failing the test on error.
+func (env *Env) ListDeviceTaintRules(t *testing.T, opts ...ListOption) []v1alpha3.DeviceTaintRule {
+	t.Helper()
+	items, err := env.ListDeviceTaintRulesE(t, opts...)
+	require.NoError(t, err, "failed to list DeviceTaintRules")
+	return items
+}
+
+// ListDeviceTaintRulesE lists the DeviceTaintRules matching opts, returning the error.
+func (env *Env) ListDeviceTaintRulesE(t *testing.T, opts ...ListOption) ([]v1alpha3.DeviceTaintRule, error) {
+	t.Helper()
+	list, err := env.Client.ResourceV1alpha3().DeviceTaintRules().List(env.Ctx, listOptions(opts))
+	if err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+// WaitForDeviceTaintRule watches the DeviceTaintRule called name until ready reports true
+// for it and returns it. A nil ready waits for it to exist. The test fails
+// after timeout.
+func (env *Env) WaitForDeviceTaintRule(t *testing.T, name string, ready func(*v1alpha3.DeviceTaintRule) bool, timeout time.Duration) *v1alpha3.DeviceTaintRule {
+	t.Helper()
+	return waitFor(t, env, "DeviceTaintRule", name, env.Client.ResourceV1alpha3().DeviceTaintRules(), ready, timeout)
+}
+
 // GetResourceV1beta1DeviceClass fetches a ResourceV1beta1DeviceClass by name, failing the test on error.
 func (env *Env) GetResourceV1beta1DeviceClass(t *testing.T, name string) *v1beta113.DeviceClass {
 	t.Helper()
@@ -1586,6 +4398,32 @@ func (env *Env) GetResourceV1beta1DeviceClassE(t *testing.T, name string) (*v1be
 	return env.Client.ResourceV1beta1().DeviceClasses().Get(env.Ctx, name, v11.GetOptions{})
 }
 
+// ListResourceV1beta1DeviceClasses lists the ResourceV1beta1DeviceClasses matching opts, failing the test on error.
+func (env *Env) ListResourceV1beta1DeviceClasses(t *testing.T, opts ...ListOption) []v1beta113.DeviceClass {
+	t.Helper()
+	items, err := env.ListResourceV1beta1DeviceClassesE(t, opts...)
+	require.NoError(t, err, "failed to list ResourceV1beta1DeviceClasses")
+	return items
+}
+
+// ListResourceV1beta1DeviceClassesE lists the ResourceV1beta1DeviceClasses matching opts, returning the error.
+func (env *Env) ListResourceV1beta1DeviceClassesE(t *testing.T, opts ...ListOption) ([]v1beta113.DeviceClass, error) {
+	t.Helper()
+	list, err := env.Client.ResourceV1beta1().DeviceClasses().List(env.Ctx, listOptions(opts))
+	if err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+// WaitForResourceV1beta1DeviceClass watches the ResourceV1beta1DeviceClass called name until ready reports true
+// for it and returns it. A nil ready waits for it to exist. The test fails
+// after timeout.
+func (env *Env) WaitForResourceV1beta1DeviceClass(t *testing.T, name string, ready func(*v1beta113.DeviceClass) bool, timeout time.Duration) *v1beta113.DeviceClass {
+	t.Helper()
+	return waitFor(t, env, "DeviceClass", name, env.Client.ResourceV1beta1().DeviceClasses(), ready, timeout)
+}
+
 // GetResourceV1beta1ResourceClaim fetches a ResourceV1beta1ResourceClaim by name, failing the test on error.
 func (env *Env) GetResourceV1beta1ResourceClaim(t *testing.T, name string) *v1beta113.ResourceClaim {
 	t.Helper()
@@ -1600,6 +4438,32 @@ func (env *Env) GetResourceV1beta1ResourceClaimE(t *testing.T, name string) (*v1
 	return env.Client.ResourceV1beta1().ResourceClaims(env.Kube.Namespace).Get(env.Ctx, name, v11.GetOptions{})
 }
 
+// ListResourceV1beta1ResourceClaims lists the ResourceV1beta1ResourceClaims matching opts, failing the test on error.
+func (env *Env) ListResourceV1beta1ResourceClaims(t *testing.T, opts ...ListOption) []v1beta113.ResourceClaim {
+	t.Helper()
+	items, err := env.ListResourceV1beta1ResourceClaimsE(t, opts...)
+	require.NoError(t, err, "failed to list ResourceV1beta1ResourceClaims")
+	return items
+}
+
+// ListResourceV1beta1ResourceClaimsE lists the ResourceV1beta1ResourceClaims matching opts, returning the error.
+func (env *Env) ListResourceV1beta1ResourceClaimsE(t *testing.T, opts ...ListOption) ([]v1beta113.ResourceClaim, error) {
+	t.Helper()
+	list, err := env.Client.ResourceV1beta1().ResourceClaims(env.Kube.Namespace).List(env.Ctx, listOptions(opts))
+	if err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+// WaitForResourceV1beta1ResourceClaim watches the ResourceV1beta1ResourceClaim called name until ready reports true
+// for it and returns it. A nil ready waits for it to exist. The test fails
+// after timeout.
+func (env *Env) WaitForResourceV1beta1ResourceClaim(t *testing.T, name string, ready func(*v1beta113.ResourceClaim) bool, timeout time.Duration) *v1beta113.ResourceClaim {
+	t.Helper()
+	return waitFor(t, env, "ResourceClaim", name, env.Client.ResourceV1beta1().ResourceClaims(env.Kube.Namespace), ready, timeout)
+}
+
 // GetResourceV1beta1ResourceClaimTemplate fetches a ResourceV1beta1ResourceClaimTemplate by name, failing the test on error.
 func (env *Env) GetResourceV1beta1ResourceClaimTemplate(t *testing.T, name string) *v1beta113.ResourceClaimTemplate {
 	t.Helper()
@@ -1614,6 +4478,32 @@ func (env *Env) GetResourceV1beta1ResourceClaimTemplateE(t *testing.T, name stri
 	return env.Client.ResourceV1beta1().ResourceClaimTemplates(env.Kube.Namespace).Get(env.Ctx, name, v11.GetOptions{})
 }
 
+// ListResourceV1beta1ResourceClaimTemplates lists the ResourceV1beta1ResourceClaimTemplates matching opts, failing the test on error.
+func (env *Env) ListResourceV1beta1ResourceClaimTemplates(t *testing.T, opts ...ListOption) []v1beta113.ResourceClaimTemplate {
+	t.Helper()
+	items, err := env.ListResourceV1beta1ResourceClaimTemplatesE(t, opts...)
+	require.NoError(t, err, "failed to list ResourceV1beta1ResourceClaimTemplates")
+	return items
+}
+
+// ListResourceV1beta1ResourceClaimTemplatesE lists the ResourceV1beta1ResourceClaimTemplates matching opts, returning the error.
+func (env *Env) ListResourceV1beta1ResourceClaimTemplatesE(t *testing.T, opts ...ListOption) ([]v1beta113.ResourceClaimTemplate, error) {
+	t.Helper()
+	list, err := env.Client.ResourceV1beta1().ResourceClaimTemplates(env.Kube.Namespace).List(env.Ctx, listOptions(opts))
+	if err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+// WaitForResourceV1beta1ResourceClaimTemplate watches the ResourceV1beta1ResourceClaimTemplate called name until ready reports true
+// for it and returns it. A nil ready waits for it to exist. The test fails
+// after timeout.
+func (env *Env) WaitForResourceV1beta1ResourceClaimTemplate(t *testing.T, name string, ready func(*v1beta113.ResourceClaimTemplate) bool, timeout time.Duration) *v1beta113.ResourceClaimTemplate {
+	t.Helper()
+	return waitFor(t, env, "ResourceClaimTemplate", name, env.Client.ResourceV1beta1().ResourceClaimTemplates(env.Kube.Namespace), ready, timeout)
+}
+
 // GetResourceV1beta1ResourceSlice fetches a ResourceV1beta1ResourceSlice by name, failing the test on error.
 func (env *Env) GetResourceV1beta1ResourceSlice(t *testing.T, name string) *v1beta113.ResourceSlice {
 	t.Helper()
@@ -1628,6 +4518,32 @@ func (env *Env) GetResourceV1beta1ResourceSliceE(t *testing.T, name string) (*v1
 	return env.Client.ResourceV1beta1().ResourceSlices().Get(env.Ctx, name, v11.GetOptions{})
 }
 
+// ListResourceV1beta1ResourceSlices lists the ResourceV1beta1ResourceSlices matching opts, failing the test on error.
+func (env *Env) ListResourceV1beta1ResourceSlices(t *testing.T, opts ...ListOption) []v1beta113.ResourceSlice {
+	t.Helper()
+	items, err := env.ListResourceV1beta1ResourceSlicesE(t, opts...)
+	require.NoError(t, err, "failed to list ResourceV1beta1ResourceSlices")
+	return items
+}
+
+// ListResourceV1beta1ResourceSlicesE lists the ResourceV1beta1ResourceSlices matching opts, returning the error.
+func (env *Env) ListResourceV1beta1ResourceSlicesE(t *testing.T, opts ...ListOption) ([]v1beta113.ResourceSlice, error) {
+	t.Helper()
+	list, err := env.Client.ResourceV1beta1().ResourceSlices().List(env.Ctx, listOptions(opts))
+	if err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+// WaitForResourceV1beta1ResourceSlice watches the ResourceV1beta1ResourceSlice called name until ready reports true
+// for it and returns it. A nil ready waits for it to exist. The test fails
+// after timeout.
+func (env *Env) WaitForResourceV1beta1ResourceSlice(t *testing.T, name string, ready func(*v1beta113.ResourceSlice) bool, timeout time.Duration) *v1beta113.ResourceSlice {
+	t.Helper()
+	return waitFor(t, env, "ResourceSlice", name, env.Client.ResourceV1beta1().ResourceSlices(), ready, timeout)
+}
+
 // GetResourceV1beta2DeviceClass fetches a ResourceV1beta2DeviceClass by name, failing the test on error.
 func (env *Env) GetResourceV1beta2DeviceClass(t *testing.T, name string) *v1beta22.DeviceClass {
 	t.Helper()
@@ -1642,6 +4558,32 @@ func (env *Env) GetResourceV1beta2DeviceClassE(t *testing.T, name string) (*v1be
 	return env.Client.ResourceV1beta2().DeviceClasses().Get(env.Ctx, name, v11.GetOptions{})
 }
 
+// ListResourceV1beta2DeviceClasses lists the ResourceV1beta2DeviceClasses matching opts, failing the test on error.
+func (env *Env) ListResourceV1beta2DeviceClasses(t *testing.T, opts ...ListOption) []v1beta22.DeviceClass {
+	t.Helper()
+	items, err := env.ListResourceV1beta2DeviceClassesE(t, opts...)
+	require.NoError(t, err, "failed to list ResourceV1beta2DeviceClasses")
+	return items
+}
+
+// ListResourceV1beta2DeviceClassesE lists the ResourceV1beta2DeviceClasses matching opts, returning the error.
+func (env *Env) ListResourceV1beta2DeviceClassesE(t *testing.T, opts ...ListOption) ([]v1beta22.DeviceClass, error) {
+	t.Helper()
+	list, err := env.Client.ResourceV1beta2().DeviceClasses().List(env.Ctx, listOptions(opts))
+	if err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+// WaitForResourceV1beta2DeviceClass watches the ResourceV1beta2DeviceClass called name until ready reports true
+// for it and returns it. A nil ready waits for it to exist. The test fails
+// after timeout.
+func (env *Env) WaitForResourceV1beta2DeviceClass(t *testing.T, name string, ready func(*v1beta22.DeviceClass) bool, timeout time.Duration) *v1beta22.DeviceClass {
+	t.Helper()
+	return waitFor(t, env, "DeviceClass", name, env.Client.ResourceV1beta2().DeviceClasses(), ready, timeout)
+}
+
 // GetResourceV1beta2ResourceClaim fetches a ResourceV1beta2ResourceClaim by name, failing the test on error.
 func (env *Env) GetResourceV1beta2ResourceClaim(t *testing.T, name string) *v1beta22.ResourceClaim {
 	t.Helper()
@@ -1656,6 +4598,32 @@ func (env *Env) GetResourceV1beta2ResourceClaimE(t *testing.T, name string) (*v1
 	return env.Client.ResourceV1beta2().ResourceClaims(env.Kube.Namespace).Get(env.Ctx, name, v11.GetOptions{})
 }
 
+// ListResourceV1beta2ResourceClaims lists the ResourceV1beta2ResourceClaims matching opts, failing the test on error.
+func (env *Env) ListResourceV1beta2ResourceClaims(t *testing.T, opts ...ListOption) []v1beta22.ResourceClaim {
+	t.Helper()
+	items, err := env.ListResourceV1beta2ResourceClaimsE(t, opts...)
+	require.NoError(t, err, "failed to list ResourceV1beta2ResourceClaims")
+	return items
+}
+
+// ListResourceV1beta2ResourceClaimsE lists the ResourceV1beta2ResourceClaims matching opts, returning the error.
+func (env *Env) ListResourceV1beta2ResourceClaimsE(t *testing.T, opts ...ListOption) ([]v1beta22.ResourceClaim, error) {
+	t.Helper()
+	list, err := env.Client.ResourceV1beta2().ResourceClaims(env.Kube.Namespace).List(env.Ctx, listOptions(opts))
+	if err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+// WaitForResourceV1beta2ResourceClaim watches the ResourceV1beta2ResourceClaim called name until ready reports true
+// for it and returns it. A nil ready waits for it to exist. The test fails
+// after timeout.
+func (env *Env) WaitForResourceV1beta2ResourceClaim(t *testing.T, name string, ready func(*v1beta22.ResourceClaim) bool, timeout time.Duration) *v1beta22.ResourceClaim {
+	t.Helper()
+	return waitFor(t, env, "ResourceClaim", name, env.Client.ResourceV1beta2().ResourceClaims(env.Kube.Namespace), ready, timeout)
+}
+
 // GetResourceV1beta2ResourceClaimTemplate fetches a ResourceV1beta2ResourceClaimTemplate by name, failing the test on error.
 func (env *Env) GetResourceV1beta2ResourceClaimTemplate(t *testing.T, name string) *v1beta22.ResourceClaimTemplate {
 	t.Helper()
@@ -1670,6 +4638,32 @@ func (env *Env) GetResourceV1beta2ResourceClaimTemplateE(t *testing.T, name stri
 	return env.Client.ResourceV1beta2().ResourceClaimTemplates(env.Kube.Namespace).Get(env.Ctx, name, v11.GetOptions{})
 }
 
+// ListResourceV1beta2ResourceClaimTemplates lists the ResourceV1beta2ResourceClaimTemplates matching opts, failing the test on error.
+func (env *Env) ListResourceV1beta2ResourceClaimTemplates(t *testing.T, opts ...ListOption) []v1beta22.ResourceClaimTemplate {
+	t.Helper()
+	items, err := env.ListResourceV1beta2ResourceClaimTemplatesE(t, opts...)
+	require.NoError(t, err, "failed to list ResourceV1beta2ResourceClaimTemplates")
+	return items
+}
+
+// ListResourceV1beta2ResourceClaimTemplatesE lists the ResourceV1beta2ResourceClaimTemplates matching opts, returning the error.
+func (env *Env) ListResourceV1beta2ResourceClaimTemplatesE(t *testing.T, opts ...ListOption) ([]v1beta22.ResourceClaimTemplate, error) {
+	t.Helper()
+	list, err := env.Client.ResourceV1beta2().ResourceClaimTemplates(env.Kube.Namespace).List(env.Ctx, listOptions(opts))
+	if err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+// WaitForResourceV1beta2ResourceClaimTemplate watches the ResourceV1beta2ResourceClaimTemplate called name until ready reports true
+// for it and returns it. A nil ready waits for it to exist. The test fails
+// after timeout.
+func (env *Env) WaitForResourceV1beta2ResourceClaimTemplate(t *testing.T, name string, ready func(*v1beta22.ResourceClaimTemplate) bool, timeout time.Duration) *v1beta22.ResourceClaimTemplate {
+	t.Helper()
+	return waitFor(t, env, "ResourceClaimTemplate", name, env.Client.ResourceV1beta2().ResourceClaimTemplates(env.Kube.Namespace), ready, timeout)
+}
+
 // GetResourceV1beta2ResourceSlice fetches a ResourceV1beta2ResourceSlice by name, failing the test on error.
 func (env *Env) GetResourceV1beta2ResourceSlice(t *testing.T, name string) *v1beta22.ResourceSlice {
 	t.Helper()
@@ -1684,6 +4678,32 @@ func (env *Env) GetResourceV1beta2ResourceSliceE(t *testing.T, name string) (*v1
 	return env.Client.ResourceV1beta2().ResourceSlices().Get(env.Ctx, name, v11.GetOptions{})
 }
 
+// ListResourceV1beta2ResourceSlices lists the ResourceV1beta2ResourceSlices matching opts, failing the test on error.
+func (env *Env) ListResourceV1beta2ResourceSlices(t *testing.T, opts ...ListOption) []v1beta22.ResourceSlice {
+	t.Helper()
+	items, err := env.ListResourceV1beta2ResourceSlicesE(t, opts...)
+	require.NoError(t, err, "failed to list ResourceV1beta2ResourceSlices")
+	return items
+}
+
+// ListResourceV1beta2ResourceSlicesE lists the ResourceV1beta2ResourceSlices matching opts, returning the error.
+func (env *Env) ListResourceV1beta2ResourceSlicesE(t *testing.T, opts ...ListOption) ([]v1beta22.ResourceSlice, error) {
+	t.Helper()
+	list, err := env.Client.ResourceV1beta2().ResourceSlices().List(env.Ctx, listOptions(opts))
+	if err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+// WaitForResourceV1beta2ResourceSlice watches the ResourceV1beta2ResourceSlice called name until ready reports true
+// for it and returns it. A nil ready waits for it to exist. The test fails
+// after timeout.
+func (env *Env) WaitForResourceV1beta2ResourceSlice(t *testing.T, name string, ready func(*v1beta22.ResourceSlice) bool, timeout time.Duration) *v1beta22.ResourceSlice {
+	t.Helper()
+	return waitFor(t, env, "ResourceSlice", name, env.Client.ResourceV1beta2().ResourceSlices(), ready, timeout)
+}
+
 // GetPriorityClass fetches a PriorityClass by name, failing the test on error.
 func (env *Env) GetPriorityClass(t *testing.T, name string) *v116.PriorityClass {
 	t.Helper()
@@ -1698,6 +4718,32 @@ func (env *Env) GetPriorityClassE(t *testing.T, name string) (*v116.PriorityClas
 	return env.Client.SchedulingV1().PriorityClasses().Get(env.Ctx, name, v11.GetOptions{})
 }
 
+// ListPriorityClasses lists the PriorityClasses matching opts, failing the test on error.
+func (env *Env) ListPriorityClasses(t *testing.T, opts ...ListOption) []v116.PriorityClass {
+	t.Helper()
+	items, err := env.ListPriorityClassesE(t, opts...)
+	require.NoError(t, err, "failed to list PriorityClasses")
+	return items
+}
+
+// ListPriorityClassesE lists the PriorityClasses matching opts, returning the error.
+func (env *Env) ListPriorityClassesE(t *testing.T, opts ...ListOption) ([]v116.PriorityClass, error) {
+	t.Helper()
+	list, err := env.Client.SchedulingV1().PriorityClasses().List(env.Ctx, listOptions(opts))
+	if err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+// WaitForPriorityClass watches the PriorityClass called name until ready reports true
+// for it and returns it. A nil ready waits for it to exist. The test fails
+// after timeout.
+func (env *Env) WaitForPriorityClass(t *testing.T, name string, ready func(*v116.PriorityClass) bool, timeout time.Duration) *v116.PriorityClass {
+	t.Helper()
+	return waitFor(t, env, "PriorityClass", name, env.Client.SchedulingV1().PriorityClasses(), ready, timeout)
+}
+
 // GetSchedulingV1alpha1PriorityClass fetches a SchedulingV1alpha1PriorityClass by name, failing the test on error.
 func (env *Env) GetSchedulingV1alpha1PriorityClass(t *testing.T, name string) *v1alpha15.PriorityClass {
 	t.Helper()
@@ -1712,6 +4758,32 @@ func (env *Env) GetSchedulingV1alpha1PriorityClassE(t *testing.T, name string) (
 	return env.Client.SchedulingV1alpha1().PriorityClasses().Get(env.Ctx, name, v11.GetOptions{})
 }
 
+// ListSchedulingV1alpha1PriorityClasses lists the SchedulingV1alpha1PriorityClasses matching opts, failing the test on error.
+func (env *Env) ListSchedulingV1alpha1PriorityClasses(t *testing.T, opts ...ListOption) []v1alpha15.PriorityClass {
+	t.Helper()
+	items, err := env.ListSchedulingV1alpha1PriorityClassesE(t, opts...)
+	require.NoError(t, err, "failed to list SchedulingV1alpha1PriorityClasses")
+	return items
+}
+
+// ListSchedulingV1alpha1PriorityClassesE lists the SchedulingV1alpha1PriorityClasses matching opts, returning the error.
+func (env *Env) ListSchedulingV1alpha1PriorityClassesE(t *testing.T, opts ...ListOption) ([]v1alpha15.PriorityClass, error) {
+	t.Helper()
+	list, err := env.Client.SchedulingV1alpha1().PriorityClasses().List(env.Ctx, listOptions(opts))
+	if err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+// WaitForSchedulingV1alpha1PriorityClass watches the SchedulingV1alpha1PriorityClass called name until ready reports true
+// for it and returns it. A nil ready waits for it to exist. The test fails
+// after timeout.
+func (env *Env) WaitForSchedulingV1alpha1PriorityClass(t *testing.T, name string, ready func(*v1alpha15.PriorityClass) bool, timeout time.Duration) *v1alpha15.PriorityClass {
+	t.Helper()
+	return waitFor(t, env, "PriorityClass", name, env.Client.SchedulingV1alpha1().PriorityClasses(), ready, timeout)
+}
+
 // GetWorkload fetches a Workload by name, failing the test on error.
 func (env *Env) GetWorkload(t *testing.T, name string) *v1alpha15.Workload {
 	t.Helper()
@@ -1726,6 +4798,32 @@ func (env *Env) GetWorkloadE(t *testing.T, name string) (*v1alpha15.Workload, er
 	return env.Client.SchedulingV1alpha1().Workloads(env.Kube.Namespace).Get(env.Ctx, name, v11.GetOptions{})
 }
 
+// ListWorkloads lists the Workloads matching opts, failing the test on error.
+func (env *Env) ListWorkloads(t *testing.T, opts ...ListOption) []v1alpha15.Workload {
+	t.Helper()
+	items, err := env.ListWorkloadsE(t, opts...)
+	require.NoError(t, err, "failed to list Workloads")
+	return items
+}
+
+// ListWorkloadsE lists the Workloads matching opts, returning the error.
+func (env *Env) ListWorkloadsE(t *testing.T, opts ...ListOption) ([]v1alpha15.Workload, error) {
+	t.Helper()
+	list, err := env.Client.SchedulingV1alpha1().Workloads(env.Kube.Namespace).List(env.Ctx, listOptions(opts))
+	if err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+// WaitForWorkload watches the Workload called name until ready reports true
+// for it and returns it. A nil ready waits for it to exist. The test fails
+// after timeout.
+func (env *Env) WaitForWorkload(t *testing.T, name string, ready func(*v1alpha15.Workload) bool, timeout time.Duration) *v1alpha15.Workload {
+	t.Helper()
+	return waitFor(t, env, "Workload", name, env.Client.SchedulingV1alpha1().Workloads(env.Kube.Namespace), ready, timeout)
+}
+
 // GetSchedulingV1beta1PriorityClass fetches a SchedulingV1beta1PriorityClass by name, failing the test on error.
 func (env *Env) GetSchedulingV1beta1PriorityClass(t *testing.T, name string) *v1beta114.PriorityClass {
 	t.Helper()
@@ -1740,6 +4838,32 @@ func (env *Env) GetSchedulingV1beta1PriorityClassE(t *testing.T, name string) (*
 	return env.Client.SchedulingV1beta1().PriorityClasses().Get(env.Ctx, name, v11.GetOptions{})
 }
 
+// ListSchedulingV1beta1PriorityClasses lists the SchedulingV1beta1PriorityClasses matching opts, failing the test on error.
+func (env *Env) ListSchedulingV1beta1PriorityClasses(t *testing.T, opts ...ListOption) []v1beta114.PriorityClass {
+	t.Helper()
+	items, err := env.ListSchedulingV1beta1PriorityClassesE(t, opts...)
+	require.NoError(t, err, "failed to list SchedulingV1beta1PriorityClasses")
+	return items
+}
+
+// ListSchedulingV1beta1PriorityClassesE lists the SchedulingV1beta1PriorityClasses matching opts, returning the error.
+func (env *Env) ListSchedulingV1beta1PriorityClassesE(t *testing.T, opts ...ListOption) ([]v1beta114.PriorityClass, error) {
+	t.Helper()
+	list, err := env.Client.SchedulingV1beta1().PriorityClasses().List(env.Ctx, listOptions(opts))
+	if err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+// WaitForSchedulingV1beta1PriorityClass watches the SchedulingV1beta1PriorityClass called name until ready reports true
+// for it and returns it. A nil ready waits for it to exist. The test fails
+// after timeout.
+func (env *Env) WaitForSchedulingV1beta1PriorityClass(t *testing.T, name string, ready func(*v1beta114.PriorityClass) bool, timeout time.Duration) *v1beta114.PriorityClass {
+	t.Helper()
+	return waitFor(t, env, "PriorityClass", name, env.Client.SchedulingV1beta1().PriorityClasses(), ready, timeout)
+}
+
 // GetCSIDriver fetches a CSIDriver by name, failing the test on error.
 func (env *Env) GetCSIDriver(t *testing.T, name string) *v117.CSIDriver {
 	t.Helper()
@@ -1754,6 +4878,32 @@ func (env *Env) GetCSIDriverE(t *testing.T, name string) (*v117.CSIDriver, error
 	return env.Client.StorageV1().CSIDrivers().Get(env.Ctx, name, v11.GetOptions{})
 }
 
+// ListCSIDrivers lists the CSIDrivers matching opts, failing the test on error.
+func (env *Env) ListCSIDrivers(t *testing.T, opts ...ListOption) []v117.CSIDriver {
+	t.Helper()
+	items, err := env.ListCSIDriversE(t, opts...)
+	require.NoError(t, err, "failed to list CSIDrivers")
+	return items
+}
+
+// ListCSIDriversE lists the CSIDrivers matching opts, returning the error.
+func (env *Env) ListCSIDriversE(t *testing.T, opts ...ListOption) ([]v117.CSIDriver, error) {
+	t.Helper()
+	list, err := env.Client.StorageV1().CSIDrivers().List(env.Ctx, listOptions(opts))
+	if err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+// WaitForCSIDriver watches the CSIDriver called name until ready reports true
+// for it and returns it. A nil ready waits for it to exist. The test fails
+// after timeout.
+func (env *Env) WaitForCSIDriver(t *testing.T, name string, ready func(*v117.CSIDriver) bool, timeout time.Duration) *v117.CSIDriver {
+	t.Helper()
+	return waitFor(t, env, "CSIDriver", name, env.Client.StorageV1().CSIDrivers(), ready, timeout)
+}
+
 // GetCSINode fetches a CSINode by name, failing the test on error.
 func (env *Env) GetCSINode(t *testing.T, name string) *v117.CSINode {
 	t.Helper()
@@ -1768,6 +4918,32 @@ func (env *Env) GetCSINodeE(t *testing.T, name string) (*v117.CSINode, error) {
 	return env.Client.StorageV1().CSINodes().Get(env.Ctx, name, v11.GetOptions{})
 }
 
+// ListCSINodes lists the CSINodes matching opts, failing the test on error.
+func (env *Env) ListCSINodes(t *testing.T, opts ...ListOption) []v117.CSINode {
+	t.Helper()
+	items, err := env.ListCSINodesE(t, opts...)
+	require.NoError(t, err, "failed to list CSINodes")
+	return items
+}
+
+// ListCSINodesE lists the CSINodes matching opts, returning the error.
+func (env *Env) ListCSINodesE(t *testing.T, opts ...ListOption) ([]v117.CSINode, error) {
+	t.Helper()
+	list, err := env.Client.StorageV1().CSINodes().List(env.Ctx, listOptions(opts))
+	if err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+// WaitForCSINode watches the CSINode called name until ready reports true
+// for it and returns it. A nil ready waits for it to exist. The test fails
+// after timeout.
+func (env *Env) WaitForCSINode(t *testing.T, name string, ready func(*v117.CSINode) bool, timeout time.Duration) *v117.CSINode {
+	t.Helper()
+	return waitFor(t, env, "CSINode", name, env.Client.StorageV1().CSINodes(), ready, timeout)
+}
+
 // GetCSIStorageCapacity fetches a CSIStorageCapacity by name, failing the test on error.
 func (env *Env) GetCSIStorageCapacity(t *testing.T, name string) *v117.CSIStorageCapacity {
 	t.Helper()
@@ -1782,6 +4958,32 @@ func (env *Env) GetCSIStorageCapacityE(t *testing.T, name string) (*v117.CSIStor
 	return env.Client.StorageV1().CSIStorageCapacities(env.Kube.Namespace).Get(env.Ctx, name, v11.GetOptions{})
 }
 
+// ListCSIStorageCapacities lists the CSIStorageCapacities matching opts, failing the test on error.
+func (env *Env) ListCSIStorageCapacities(t *testing.T, opts ...ListOption) []v117.CSIStorageCapacity {
+	t.Helper()
+	items, err := env.ListCSIStorageCapacitiesE(t, opts...)
+	require.NoError(t, err, "failed to list CSIStorageCapacities")
+	return items
+}
+
+// ListCSIStorageCapacitiesE lists the CSIStorageCapacities matching opts, returning the error.
+func (env *Env) ListCSIStorageCapacitiesE(t *testing.T, opts ...ListOption) ([]v117.CSIStorageCapacity, error) {
+	t.Helper()
+	list, err := env.Client.StorageV1().CSIStorageCapacities(env.Kube.Namespace).List(env.Ctx, listOptions(opts))
+	if err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+// WaitForCSIStorageCapacity watches the CSIStorageCapacity called name until ready reports true
+// for it and returns it. A nil ready waits for it to exist. The test fails
+// after timeout.
+func (env *Env) WaitForCSIStorageCapacity(t *testing.T, name string, ready func(*v117.CSIStorageCapacity) bool, timeout time.Duration) *v117.CSIStorageCapacity {
+	t.Helper()
+	return waitFor(t, env, "CSIStorageCapacity", name, env.Client.StorageV1().CSIStorageCapacities(env.Kube.Namespace), ready, timeout)
+}
+
 // GetStorageClass fetches a StorageClass by name, failing the test on error.
 func (env *Env) GetStorageClass(t *testing.T, name string) *v117.StorageClass {
 	t.Helper()
@@ -1796,6 +4998,32 @@ func (env *Env) GetStorageClassE(t *testing.T, name string) (*v117.StorageClass,
 	return env.Client.StorageV1().StorageClasses().Get(env.Ctx, name, v11.GetOptions{})
 }
 
+// ListStorageClasses lists the StorageClasses matching opts, failing the test on error.
+func (env *Env) ListStorageClasses(t *testing.T, opts ...ListOption) []v117.StorageClass {
+	t.Helper()
+	items, err := env.ListStorageClassesE(t, opts...)
+	require.NoError(t, err, "failed to list StorageClasses")
+	return items
+}
+
+// ListStorageClassesE lists the StorageClasses matching opts, returning the error.
+func (env *Env) ListStorageClassesE(t *testing.T, opts ...ListOption) ([]v117.StorageClass, error) {
+	t.Helper()
+	list, err := env.Client.StorageV1().StorageClasses().List(env.Ctx, listOptions(opts))
+	if err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+// WaitForStorageClass watches the StorageClass called name until ready reports true
+// for it and returns it. A nil ready waits for it to exist. The test fails
+// after timeout.
+func (env *Env) WaitForStorageClass(t *testing.T, name string, ready func(*v117.StorageClass) bool, timeout time.Duration) *v117.StorageClass {
+	t.Helper()
+	return waitFor(t, env, "StorageClass", name, env.Client.StorageV1().StorageClasses(), ready, timeout)
+}
+
 // GetVolumeAttachment fetches a VolumeAttachment by name, failing the test on error.
 func (env *Env) GetVolumeAttachment(t *testing.T, name string) *v117.VolumeAttachment {
 	t.Helper()
@@ -1810,6 +5038,32 @@ func (env *Env) GetVolumeAttachmentE(t *testing.T, name string) (*v117.VolumeAtt
 	return env.Client.StorageV1().VolumeAttachments().Get(env.Ctx, name, v11.GetOptions{})
 }
 
+// ListVolumeAttachments lists the VolumeAttachments matching opts, failing the test on error.
+func (env *Env) ListVolumeAttachments(t *testing.T, opts ...ListOption) []v117.VolumeAttachment {
+	t.Helper()
+	items, err := env.ListVolumeAttachmentsE(t, opts...)
+	require.NoError(t, err, "failed to list VolumeAttachments")
+	return items
+}
+
+// ListVolumeAttachmentsE lists the VolumeAttachments matching opts, returning the error.
+func (env *Env) ListVolumeAttachmentsE(t *testing.T, opts ...ListOption) ([]v117.VolumeAttachment, error) {
+	t.Helper()
+	list, err := env.Client.StorageV1().VolumeAttachments().List(env.Ctx, listOptions(opts))
+	if err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+// WaitForVolumeAttachment watches the VolumeAttachment called name until ready reports true
+// for it and returns it. A nil ready waits for it to exist. The test fails
+// after timeout.
+func (env *Env) WaitForVolumeAttachment(t *testing.T, name string, ready func(*v117.VolumeAttachment) bool, timeout time.Duration) *v117.VolumeAttachment {
+	t.Helper()
+	return waitFor(t, env, "VolumeAttachment", name, env.Client.StorageV1().VolumeAttachments(), ready, timeout)
+}
+
 // GetVolumeAttributesClass fetches a VolumeAttributesClass by name, failing the test on error.
 func (env *Env) GetVolumeAttributesClass(t *testing.T, name string) *v117.VolumeAttributesClass {
 	t.Helper()
@@ -1824,20 +5078,72 @@ func (env *Env) GetVolumeAttributesClassE(t *testing.T, name string) (*v117.Volu
 	return env.Client.StorageV1().VolumeAttributesClasses().Get(env.Ctx, name, v11.GetOptions{})
 }
 
-// GetStorageV1alpha1CSIStorageCapacity fetches a StorageV1alpha1CSIStorageCapacity by name, failing the test on error.
-func (env *Env) GetStorageV1alpha1CSIStorageCapacity(t *testing.T, name string) *v1alpha16.CSIStorageCapacity {
+// ListVolumeAttributesClasses lists the VolumeAttributesClasses matching opts, failing the test on error.
+func (env *Env) ListVolumeAttributesClasses(t *testing.T, opts ...ListOption) []v117.VolumeAttributesClass {
 	t.Helper()
-	obj, err := env.Client.StorageV1alpha1().CSIStorageCapacities(env.Kube.Namespace).Get(env.Ctx, name, v11.GetOptions{})
-	require.NoError(t, err, "failed to get StorageV1alpha1CSIStorageCapacity %s", name)
-	return obj
+	items, err := env.ListVolumeAttributesClassesE(t, opts...)
+	require.NoError(t, err, "failed to list VolumeAttributesClasses")
+	return items
 }
 
-// GetStorageV1alpha1CSIStorageCapacityE fetches a StorageV1alpha1CSIStorageCapacity by name, returning the error for non-existence checks.
+// ListVolumeAttributesClassesE lists the VolumeAttributesClasses matching opts, returning the error.
+func (env *Env) ListVolumeAttributesClassesE(t *testing.T, opts ...ListOption) ([]v117.VolumeAttributesClass, error) {
+	t.Helper()
+	list, err := env.Client.StorageV1().VolumeAttributesClasses().List(env.Ctx, listOptions(opts))
+	if err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+// WaitForVolumeAttributesClass watches the VolumeAttributesClass called name until ready reports true
+// for it and returns it. A nil ready waits for it to exist. The test fails
+// after timeout.
+func (env *Env) WaitForVolumeAttributesClass(t *testing.T, name string, ready func(*v117.VolumeAttributesClass) bool, timeout time.Duration) *v117.VolumeAttributesClass {
+	t.Helper()
+	return waitFor(t, env, "VolumeAttributesClass", name, env.Client.StorageV1().VolumeAttributesClasses(), ready, timeout)
+}
+
+// GetStorageV1alpha1CSIStorageCapacity fetches a StorageV1alpha1CSIStorageCapacity by name, failing the test on error.
+func (env *Env) GetStorageV1alpha1CSIStorageCapacity(t *testing.T, name string) *v1alpha16.CSIStorageCapacity {
+	t.Helper()
+	obj, err := env.Client.StorageV1alpha1().CSIStorageCapacities(env.Kube.Namespace).Get(env.Ctx, name, v11.GetOptions{})
+	require.NoError(t, err, "failed to get StorageV1alpha1CSIStorageCapacity %s", name)
+	return obj
+}
+
+// GetStorageV1alpha1CSIStorageCapacityE fetches a StorageV1alpha1CSIStorageCapacity by name, returning the error for non-existence checks.
 func (env *Env) GetStorageV1alpha1CSIStorageCapacityE(t *testing.T, name string) (*v1alpha16.CSIStorageCapacity, error) {
 	t.Helper()
 	return env.Client.StorageV1alpha1().CSIStorageCapacities(env.Kube.Namespace).Get(env.Ctx, name, v11.GetOptions{})
 }
 
+// ListStorageV1alpha1CSIStorageCapacities lists the StorageV1alpha1CSIStorageCapacities matching opts, failing the test on error.
+func (env *Env) ListStorageV1alpha1CSIStorageCapacities(t *testing.T, opts ...ListOption) []v1alpha16.CSIStorageCapacity {
+	t.Helper()
+	items, err := env.ListStorageV1alpha1CSIStorageCapacitiesE(t, opts...)
+	require.NoError(t, err, "failed to list StorageV1alpha1CSIStorageCapacities")
+	return items
+}
+
+// ListStorageV1alpha1CSIStorageCapacitiesE lists the StorageV1alpha1CSIStorageCapacities matching opts, returning the error.
+func (env *Env) ListStorageV1alpha1CSIStorageCapacitiesE(t *testing.T, opts ...ListOption) ([]v1alpha16.CSIStorageCapacity, error) {
+	t.Helper()
+	list, err := env.Client.StorageV1alpha1().CSIStorageCapacities(env.Kube.Namespace).List(env.Ctx, listOptions(opts))
+	if err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+// WaitForStorageV1alpha1CSIStorageCapacity watches the StorageV1alpha1CSIStorageCapacity called name until ready reports true
+// for it and returns it. A nil ready waits for it to exist. The test fails
+// after timeout.
+func (env *Env) WaitForStorageV1alpha1CSIStorageCapacity(t *testing.T, name string, ready func(*v1alpha16.CSIStorageCapacity) bool, timeout time.Duration) *v1alpha16.CSIStorageCapacity {
+	t.Helper()
+	return waitFor(t, env, "CSIStorageCapacity", name, env.Client.StorageV1alpha1().CSIStorageCapacities(env.Kube.Namespace), ready, timeout)
+}
+
 // GetStorageV1alpha1VolumeAttachment fetches a StorageV1alpha1VolumeAttachment by name, failing the test on error.
 func (env *Env) GetStorageV1alpha1VolumeAttachment(t *testing.T, name string) *v1alpha16.VolumeAttachment {
 	t.Helper()
@@ -1852,6 +5158,32 @@ func (env *Env) GetStorageV1alpha1VolumeAttachmentE(t *testing.T, name string) (
 	return env.Client.StorageV1alpha1().VolumeAttachments().Get(env.Ctx, name, v11.GetOptions{})
 }
 
+// ListStorageV1alpha1VolumeAttachments lists the StorageV1alpha1VolumeAttachments matching opts, failing the test on error.
+func (env *Env) ListStorageV1alpha1VolumeAttachments(t *testing.T, opts ...ListOption) []v1alpha16.VolumeAttachment {
+	t.Helper()
+	items, err := env.ListStorageV1alpha1VolumeAttachmentsE(t, opts...)
+	require.NoError(t, err, "failed to list StorageV1alpha1VolumeAttachments")
+	return items
+}
+
+// ListStorageV1alpha1VolumeAttachmentsE lists the StorageV1alpha1VolumeAttachments matching opts, returning the error.
+func (env *Env) ListStorageV1alpha1VolumeAttachmentsE(t *testing.T, opts ...ListOption) ([]v1alpha16.VolumeAttachment, error) {
+	t.Helper()
+	list, err := env.Client.StorageV1alpha1().VolumeAttachments().List(env.Ctx, listOptions(opts))
+	if err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+// WaitForStorageV1alpha1VolumeAttachment watches the StorageV1alpha1VolumeAttachment called name until ready reports true
+// for it and returns it. A nil ready waits for it to exist. The test fails
+// after timeout.
+func (env *Env) WaitForStorageV1alpha1VolumeAttachment(t *testing.T, name string, ready func(*v1alpha16.VolumeAttachment) bool, timeout time.Duration) *v1alpha16.VolumeAttachment {
+	t.Helper()
+	return waitFor(t, env, "VolumeAttachment", name, env.Client.StorageV1alpha1().VolumeAttachments(), ready, timeout)
+}
+
 // GetStorageV1alpha1VolumeAttributesClass fetches a StorageV1alpha1VolumeAttributesClass by name, failing the test on error.
 func (env *Env) GetStorageV1alpha1VolumeAttributesClass(t *testing.T, name string) *v1alpha16.VolumeAttributesClass {
 	t.Helper()
@@ -1866,6 +5198,32 @@ func (env *Env) GetStorageV1alpha1VolumeAttributesClassE(t *testing.T, name stri
 	return env.Client.StorageV1alpha1().VolumeAttributesClasses().Get(env.Ctx, name, v11.GetOptions{})
 }
 
+// ListStorageV1alpha1VolumeAttributesClasses lists the StorageV1alpha1VolumeAttributesClasses matching opts, failing the test on error.
+func (env *Env) ListStorageV1alpha1VolumeAttributesClasses(t *testing.T, opts ...ListOption) []v1alpha16.VolumeAttributesClass {
+	t.Helper()
+	items, err := env.ListStorageV1alpha1VolumeAttributesClassesE(t, opts...)
+	require.NoError(t, err, "failed to list StorageV1alpha1VolumeAttributesClasses")
+	return items
+}
+
+// ListStorageV1alpha1VolumeAttributesClassesE lists the StorageV1alpha1VolumeAttributesClasses matching opts, returning the error.
+func (env *Env) ListStorageV1alpha1VolumeAttributesClassesE(t *testing.T, opts ...ListOption) ([]v1alpha16.VolumeAttributesClass, error) {
+	t.Helper()
+	list, err := env.Client.StorageV1alpha1().VolumeAttributesClasses().List(env.Ctx, listOptions(opts))
+	if err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+// WaitForStorageV1alpha1VolumeAttributesClass watches the StorageV1alpha1VolumeAttributesClass called name until ready reports true
+// for it and returns it. A nil ready waits for it to exist. The test fails
+// after timeout.
+func (env *Env) WaitForStorageV1alpha1VolumeAttributesClass(t *testing.T, name string, ready func(*v1alpha16.VolumeAttributesClass) bool, timeout time.Duration) *v1alpha16.VolumeAttributesClass {
+	t.Helper()
+	return waitFor(t, env, "VolumeAttributesClass", name, env.Client.StorageV1alpha1().VolumeAttributesClasses(), ready, timeout)
+}
+
 // GetStorageV1beta1CSIDriver fetches a StorageV1beta1CSIDriver by name, failing the test on error.
 func (env *Env) GetStorageV1beta1CSIDriver(t *testing.T, name string) *v1beta115.CSIDriver {
 	t.Helper()
@@ -1880,6 +5238,32 @@ func (env *Env) GetStorageV1beta1CSIDriverE(t *testing.T, name string) (*v1beta1
 	return env.Client.StorageV1beta1().CSIDrivers().Get(env.Ctx, name, v11.GetOptions{})
 }
 
+// ListStorageV1beta1CSIDrivers lists the StorageV1beta1CSIDrivers matching opts, failing the test on error.
+func (env *Env) ListStorageV1beta1CSIDrivers(t *testing.T, opts ...ListOption) []v1beta115.CSIDriver {
+	t.Helper()
+	items, err := env.ListStorageV1beta1CSIDriversE(t, opts...)
+	require.NoError(t, err, "failed to list StorageV1beta1CSIDrivers")
+	return items
+}
+
+// ListStorageV1beta1CSIDriversE lists the StorageV1beta1CSIDrivers matching opts, returning the error.
+func (env *Env) ListStorageV1beta1CSIDriversE(t *testing.T, opts ...ListOption) ([]v1beta115.CSIDriver, error) {
+	t.Helper()
+	list, err := env.Client.StorageV1beta1().CSIDrivers().List(env.Ctx, listOptions(opts))
+	if err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+// WaitForStorageV1beta1CSIDriver watches the StorageV1beta1CSIDriver called name until ready reports true
+// for it and returns it. A nil ready waits for it to exist. The test fails
+// after timeout.
+func (env *Env) WaitForStorageV1beta1CSIDriver(t *testing.T, name string, ready func(*v1beta115.CSIDriver) bool, timeout time.Duration) *v1beta115.CSIDriver {
+	t.Helper()
+	return waitFor(t, env, "CSIDriver", name, env.Client.StorageV1beta1().CSIDrivers(), ready, timeout)
+}
+
 // GetStorageV1beta1CSINode fetches a StorageV1beta1CSINode by name, failing the test on error.
 func (env *Env) GetStorageV1beta1CSINode(t *testing.T, name string) *v1beta115.CSINode {
 	t.Helper()
@@ -1894,6 +5278,32 @@ func (env *Env) GetStorageV1beta1CSINodeE(t *testing.T, name string) (*v1beta115
 	return env.Client.StorageV1beta1().CSINodes().Get(env.Ctx, name, v11.GetOptions{})
 }
 
+// ListStorageV1beta1CSINodes lists the StorageV1beta1CSINodes matching opts, failing the test on error.
+func (env *Env) ListStorageV1beta1CSINodes(t *testing.T, opts ...ListOption) []v1beta115.CSINode {
+	t.Helper()
+	items, err := env.ListStorageV1beta1CSINodesE(t, opts...)
+	require.NoError(t, err, "failed to list StorageV1beta1CSINodes")
+	return items
+}
+
+// ListStorageV1beta1CSINodesE lists the StorageV1beta1CSINodes matching opts, returning the error.
+func (env *Env) ListStorageV1beta1CSINodesE(t *testing.T, opts ...ListOption) ([]v1beta115.CSINode, error) {
+	t.Helper()
+	list, err := env.Client.StorageV1beta1().CSINodes().List(env.Ctx, listOptions(opts))
+	if err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+// WaitForStorageV1beta1CSINode watches the StorageV1beta1CSINode called name until ready reports true
+// for it and returns it. A nil ready waits for it to exist. The test fails
+// after timeout.
+func (env *Env) WaitForStorageV1beta1CSINode(t *testing.T, name string, ready func(*v1beta115.CSINode) bool, timeout time.Duration) *v1beta115.CSINode {
+	t.Helper()
+	return waitFor(t, env, "CSINode", name, env.Client.StorageV1beta1().CSINodes(), ready, timeout)
+}
+
 // GetStorageV1beta1CSIStorageCapacity fetches a StorageV1beta1CSIStorageCapacity by name, failing the test on error.
 func (env *Env) GetStorageV1beta1CSIStorageCapacity(t *testing.T, name string) *v1beta115.CSIStorageCapacity {
 	t.Helper()
@@ -1908,6 +5318,32 @@ func (env *Env) GetStorageV1beta1CSIStorageCapacityE(t *testing.T, name string)
 	return env.Client.StorageV1beta1().CSIStorageCapacities(env.Kube.Namespace).Get(env.Ctx, name, v11.GetOptions{})
 }
 
+// ListStorageV1beta1CSIStorageCapacities lists the StorageV1beta1CSIStorageCapacities matching opts, failing the test on error.
+func (env *Env) ListStorageV1beta1CSIStorageCapacities(t *testing.T, opts ...ListOption) []v1beta115.CSIStorageCapacity {
+	t.Helper()
+	items, err := env.ListStorageV1beta1CSIStorageCapacitiesE(t, opts...)
+	require.NoError(t, err, "failed to list StorageV1beta1CSIStorageCapacities")
+	return items
+}
+
+// ListStorageV1beta1CSIStorageCapacitiesE lists the StorageV1beta1CSIStorageCapacities matching opts, returning the error.
+func (env *Env) ListStorageV1beta1CSIStorageCapacitiesE(t *testing.T, opts ...ListOption) ([]v1beta115.CSIStorageCapacity, error) {
+	t.Helper()
+	list, err := env.Client.StorageV1beta1().CSIStorageCapacities(env.Kube.Namespace).List(env.Ctx, listOptions(opts))
+	if err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+// WaitForStorageV1beta1CSIStorageCapacity watches the StorageV1beta1CSIStorageCapacity called name until ready reports true
+// for it and returns it. A nil ready waits for it to exist. The test fails
+// after timeout.
+func (env *Env) WaitForStorageV1beta1CSIStorageCapacity(t *testing.T, name string, ready func(*v1beta115.CSIStorageCapacity) bool, timeout time.Duration) *v1beta115.CSIStorageCapacity {
+	t.Helper()
+	return waitFor(t, env, "CSIStorageCapacity", name, env.Client.StorageV1beta1().CSIStorageCapacities(env.Kube.Namespace), ready, timeout)
+}
+
 // GetStorageV1beta1StorageClass fetches a StorageV1beta1StorageClass by name, failing the test on error.
 func (env *Env) GetStorageV1beta1StorageClass(t *testing.T, name string) *v1beta115.StorageClass {
 	t.Helper()
@@ -1922,6 +5358,32 @@ func (env *Env) GetStorageV1beta1StorageClassE(t *testing.T, name string) (*v1be
 	return env.Client.StorageV1beta1().StorageClasses().Get(env.Ctx, name, v11.GetOptions{})
 }
 
+// ListStorageV1beta1StorageClasses lists the StorageV1beta1StorageClasses matching opts, failing the test on error.
+func (env *Env) ListStorageV1beta1StorageClasses(t *testing.T, opts ...ListOption) []v1beta115.StorageClass {
+	t.Helper()
+	items, err := env.ListStorageV1beta1StorageClassesE(t, opts...)
+	require.NoError(t, err, "failed to list StorageV1beta1StorageClasses")
+	return items
+}
+
+// ListStorageV1beta1StorageClassesE lists the StorageV1beta1StorageClasses matching opts, returning the error.
+func (env *Env) ListStorageV1beta1StorageClassesE(t *testing.T, opts ...ListOption) ([]v1beta115.StorageClass, error) {
+	t.Helper()
+	list, err := env.Client.StorageV1beta1().StorageClasses().List(env.Ctx, listOptions(opts))
+	if err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+// WaitForStorageV1beta1StorageClass watches the StorageV1beta1StorageClass called name until ready reports true
+// for it and returns it. A nil ready waits for it to exist. The test fails
+// after timeout.
+func (env *Env) WaitForStorageV1beta1StorageClass(t *testing.T, name string, ready func(*v1beta115.StorageClass) bool, timeout time.Duration) *v1beta115.StorageClass {
+	t.Helper()
+	return waitFor(t, env, "StorageClass", name, env.Client.StorageV1beta1().StorageClasses(), ready, timeout)
+}
+
 // GetStorageV1beta1VolumeAttachment fetches a StorageV1beta1VolumeAttachment by name, failing the test on error.
 func (env *Env) GetStorageV1beta1VolumeAttachment(t *testing.T, name string) *v1beta115.VolumeAttachment {
 	t.Helper()
@@ -1936,6 +5398,32 @@ func (env *Env) GetStorageV1beta1VolumeAttachmentE(t *testing.T, name string) (*
 	return env.Client.StorageV1beta1().VolumeAttachments().Get(env.Ctx, name, v11.GetOptions{})
 }
 
+// ListStorageV1beta1VolumeAttachments lists the StorageV1beta1VolumeAttachments matching opts, failing the test on error.
+func (env *Env) ListStorageV1beta1VolumeAttachments(t *testing.T, opts ...ListOption) []v1beta115.VolumeAttachment {
+	t.Helper()
+	items, err := env.ListStorageV1beta1VolumeAttachmentsE(t, opts...)
+	require.NoError(t, err, "failed to list StorageV1beta1VolumeAttachments")
+	return items
+}
+
+// ListStorageV1beta1VolumeAttachmentsE lists the StorageV1beta1VolumeAttachments matching opts, returning the error.
+func (env *Env) ListStorageV1beta1VolumeAttachmentsE(t *testing.T, opts ...ListOption) ([]v1beta115.VolumeAttachment, error) {
+	t.Helper()
+	list, err := env.Client.StorageV1beta1().VolumeAttachments().List(env.Ctx, listOptions(opts))
+	if err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+// WaitForStorageV1beta1VolumeAttachment watches the StorageV1beta1VolumeAttachment called name until ready reports true
+// for it and returns it. A nil ready waits for it to exist. The test fails
+// after timeout.
+func (env *Env) WaitForStorageV1beta1VolumeAttachment(t *testing.T, name string, ready func(*v1beta115.VolumeAttachment) bool, timeout time.Duration) *v1beta115.VolumeAttachment {
+	t.Helper()
+	return waitFor(t, env, "VolumeAttachment", name, env.Client.StorageV1beta1().VolumeAttachments(), ready, timeout)
+}
+
 // GetStorageV1beta1VolumeAttributesClass fetches a StorageV1beta1VolumeAttributesClass by name, failing the test on error.
 func (env *Env) GetStorageV1beta1VolumeAttributesClass(t *testing.T, name string) *v1beta115.VolumeAttributesClass {
 	t.Helper()
@@ -1950,6 +5438,32 @@ func (env *Env) GetStorageV1beta1VolumeAttributesClassE(t *testing.T, name strin
 	return env.Client.StorageV1beta1().VolumeAttributesClasses().Get(env.Ctx, name, v11.GetOptions{})
 }
 
+// ListStorageV1beta1VolumeAttributesClasses lists the StorageV1beta1VolumeAttributesClasses matching opts, failing the test on error.
+func (env *Env) ListStorageV1beta1VolumeAttributesClasses(t *testing.T, opts ...ListOption) []v1beta115.VolumeAttributesClass {
+	t.Helper()
+	items, err := env.ListStorageV1beta1VolumeAttributesClassesE(t, opts...)
+	require.NoError(t, err, "failed to list StorageV1beta1VolumeAttributesClasses")
+	return items
+}
+
+// ListStorageV1beta1VolumeAttributesClassesE lists the StorageV1beta1VolumeAttributesClasses matching opts, returning the error.
+func (env *Env) ListStorageV1beta1VolumeAttributesClassesE(t *testing.T, opts ...ListOption) ([]v1beta115.VolumeAttributesClass, error) {
+	t.Helper()
+	list, err := env.Client.StorageV1beta1().VolumeAttributesClasses().List(env.Ctx, listOptions(opts))
+	if err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+// WaitForStorageV1beta1VolumeAttributesClass watches the StorageV1beta1VolumeAttributesClass called name until ready reports true
+// for it and returns it. A nil ready waits for it to exist. The test fails
+// after timeout.
+func (env *Env) WaitForStorageV1beta1VolumeAttributesClass(t *testing.T, name string, ready func(*v1beta115.VolumeAttributesClass) bool, timeout time.Duration) *v1beta115.VolumeAttributesClass {
+	t.Helper()
+	return waitFor(t, env, "VolumeAttributesClass", name, env.Client.StorageV1beta1().VolumeAttributesClasses(), ready, timeout)
+}
+
 // GetStorageVersionMigration fetches a StorageVersionMigration by name, failing the test on error.
 func (env *Env) GetStorageVersionMigration(t *testing.T, name string) *v1beta116.StorageVersionMigration {
 	t.Helper()
@@ -1964,173 +5478,606 @@ func (env *Env) GetStorageVersionMigrationE(t *testing.T, name string) (*v1beta1
 	return env.Client.StoragemigrationV1beta1().StorageVersionMigrations().Get(env.Ctx, name, v11.GetOptions{})
 }
 
-// AssertPartial fetches the K8s resource implied by the assertion type and
-// performs a partial assertion. The resource type is inferred from the
-// concrete assertion struct via a type switch.
-func (env *Env) AssertPartial(t *testing.T, name string, assertion assert.Assertable) {
+// ListStorageVersionMigrations lists the StorageVersionMigrations matching opts, failing the test on error.
+func (env *Env) ListStorageVersionMigrations(t *testing.T, opts ...ListOption) []v1beta116.StorageVersionMigration {
 	t.Helper()
-	switch a := assertion.(type) {
-	case assert.ControllerRevisionAssertion:
-		assert.AssertPartial(t, env.GetControllerRevision(t, name), a, name)
-	case *assert.ControllerRevisionAssertion:
-		assert.AssertPartial(t, env.GetControllerRevision(t, name), *a, name)
-	case assert.DaemonSetAssertion:
-		assert.AssertPartial(t, env.GetDaemonSet(t, name), a, name)
-	case *assert.DaemonSetAssertion:
-		assert.AssertPartial(t, env.GetDaemonSet(t, name), *a, name)
-	case assert.DeploymentAssertion:
-		assert.AssertPartial(t, env.GetDeployment(t, name), a, name)
-	case *assert.DeploymentAssertion:
-		assert.AssertPartial(t, env.GetDeployment(t, name), *a, name)
-	case assert.ReplicaSetAssertion:
-		assert.AssertPartial(t, env.GetReplicaSet(t, name), a, name)
-	case *assert.ReplicaSetAssertion:
-		assert.AssertPartial(t, env.GetReplicaSet(t, name), *a, name)
-	case assert.StatefulSetAssertion:
-		assert.AssertPartial(t, env.GetStatefulSet(t, name), a, name)
-	case *assert.StatefulSetAssertion:
-		assert.AssertPartial(t, env.GetStatefulSet(t, name), *a, name)
-	case assert.HorizontalPodAutoscalerAssertion:
-		assert.AssertPartial(t, env.GetHorizontalPodAutoscaler(t, name), a, name)
-	case *assert.HorizontalPodAutoscalerAssertion:
-		assert.AssertPartial(t, env.GetHorizontalPodAutoscaler(t, name), *a, name)
-	case assert.CronJobAssertion:
-		assert.AssertPartial(t, env.GetCronJob(t, name), a, name)
-	case *assert.CronJobAssertion:
-		assert.AssertPartial(t, env.GetCronJob(t, name), *a, name)
-	case assert.JobAssertion:
-		assert.AssertPartial(t, env.GetJob(t, name), a, name)
-	case *assert.JobAssertion:
-		assert.AssertPartial(t, env.GetJob(t, name), *a, name)
-	case assert.ConfigMapAssertion:
-		assert.AssertPartial(t, env.GetConfigMap(t, name), a, name)
-	case *assert.ConfigMapAssertion:
-		assert.AssertPartial(t, env.GetConfigMap(t, name), *a, name)
-	case assert.EndpointsAssertion:
-		assert.AssertPartial(t, env.GetEndpoints(t, name), a, name)
-	case *assert.EndpointsAssertion:
-		assert.AssertPartial(t, env.GetEndpoints(t, name), *a, name)
-	case assert.EventAssertion:
-		assert.AssertPartial(t, env.GetEvent(t, name), a, name)
-	case *assert.EventAssertion:
-		assert.AssertPartial(t, env.GetEvent(t, name), *a, name)
-	case assert.LimitRangeAssertion:
-		assert.AssertPartial(t, env.GetLimitRange(t, name), a, name)
-	case *assert.LimitRangeAssertion:
-		assert.AssertPartial(t, env.GetLimitRange(t, name), *a, name)
-	case assert.NamespaceAssertion:
-		assert.AssertPartial(t, env.GetNamespace(t, name), a, name)
-	case *assert.NamespaceAssertion:
-		assert.AssertPartial(t, env.GetNamespace(t, name), *a, name)
-	case assert.NodeAssertion:
-		assert.AssertPartial(t, env.GetNode(t, name), a, name)
-	case *assert.NodeAssertion:
-		assert.AssertPartial(t, env.GetNode(t, name), *a, name)
-	case assert.PersistentVolumeAssertion:
-		assert.AssertPartial(t, env.GetPersistentVolume(t, name), a, name)
-	case *assert.PersistentVolumeAssertion:
-		assert.AssertPartial(t, env.GetPersistentVolume(t, name), *a, name)
-	case assert.PersistentVolumeClaimAssertion:
-		assert.AssertPartial(t, env.GetPersistentVolumeClaim(t, name), a, name)
-	case *assert.PersistentVolumeClaimAssertion:
-		assert.AssertPartial(t, env.GetPersistentVolumeClaim(t, name), *a, name)
-	case assert.PodAssertion:
-		assert.AssertPartial(t, env.GetPod(t, name), a, name)
-	case *assert.PodAssertion:
-		assert.AssertPartial(t, env.GetPod(t, name), *a, name)
-	case assert.PodTemplateAssertion:
-		assert.AssertPartial(t, env.GetPodTemplate(t, name), a, name)
-	case *assert.PodTemplateAssertion:
-		assert.AssertPartial(t, env.GetPodTemplate(t, name), *a, name)
-	case assert.ReplicationControllerAssertion:
-		assert.AssertPartial(t, env.GetReplicationController(t, name), a, name)
-	case *assert.ReplicationControllerAssertion:
-		assert.AssertPartial(t, env.GetReplicationController(t, name), *a, name)
-	case assert.ResourceQuotaAssertion:
-		assert.AssertPartial(t, env.GetResourceQuota(t, name), a, name)
-	case *assert.ResourceQuotaAssertion:
-		assert.AssertPartial(t, env.GetResourceQuota(t, name), *a, name)
-	case assert.SecretAssertion:
-		assert.AssertPartial(t, env.GetSecret(t, name), a, name)
-	case *assert.SecretAssertion:
-		assert.AssertPartial(t, env.GetSecret(t, name), *a, name)
-	case assert.ServiceAssertion:
-		assert.AssertPartial(t, env.GetService(t, name), a, name)
-	case *assert.ServiceAssertion:
-		assert.AssertPartial(t, env.GetService(t, name), *a, name)
-	case assert.ServiceAccountAssertion:
-		assert.AssertPartial(t, env.GetServiceAccount(t, name), a, name)
-	case *assert.ServiceAccountAssertion:
-		assert.AssertPartial(t, env.GetServiceAccount(t, name), *a, name)
-	case assert.IPAddressAssertion:
-		assert.AssertPartial(t, env.GetIPAddress(t, name), a, name)
-	case *assert.IPAddressAssertion:
-		assert.AssertPartial(t, env.GetIPAddress(t, name), *a, name)
-	case assert.IngressAssertion:
-		assert.AssertPartial(t, env.GetIngress(t, name), a, name)
-	case *assert.IngressAssertion:
-		assert.AssertPartial(t, env.GetIngress(t, name), *a, name)
-	case assert.IngressClassAssertion:
-		assert.AssertPartial(t, env.GetIngressClass(t, name), a, name)
-	case *assert.IngressClassAssertion:
-		assert.AssertPartial(t, env.GetIngressClass(t, name), *a, name)
-	case assert.NetworkPolicyAssertion:
-		assert.AssertPartial(t, env.GetNetworkPolicy(t, name), a, name)
-	case *assert.NetworkPolicyAssertion:
-		assert.AssertPartial(t, env.GetNetworkPolicy(t, name), *a, name)
-	case assert.ServiceCIDRAssertion:
-		assert.AssertPartial(t, env.GetServiceCIDR(t, name), a, name)
-	case *assert.ServiceCIDRAssertion:
-		assert.AssertPartial(t, env.GetServiceCIDR(t, name), *a, name)
-	case assert.PodDisruptionBudgetAssertion:
-		assert.AssertPartial(t, env.GetPodDisruptionBudget(t, name), a, name)
-	case *assert.PodDisruptionBudgetAssertion:
-		assert.AssertPartial(t, env.GetPodDisruptionBudget(t, name), *a, name)
-	case assert.ClusterRoleAssertion:
-		assert.AssertPartial(t, env.GetClusterRole(t, name), a, name)
-	case *assert.ClusterRoleAssertion:
-		assert.AssertPartial(t, env.GetClusterRole(t, name), *a, name)
-	case assert.ClusterRoleBindingAssertion:
-		assert.AssertPartial(t, env.GetClusterRoleBinding(t, name), a, name)
-	case *assert.ClusterRoleBindingAssertion:
-		assert.AssertPartial(t, env.GetClusterRoleBinding(t, name), *a, name)
-	case assert.RoleAssertion:
-		assert.AssertPartial(t, env.GetRole(t, name), a, name)
-	case *assert.RoleAssertion:
-		assert.AssertPartial(t, env.GetRole(t, name), *a, name)
-	case assert.RoleBindingAssertion:
-		assert.AssertPartial(t, env.GetRoleBinding(t, name), a, name)
-	case *assert.RoleBindingAssertion:
-		assert.AssertPartial(t, env.GetRoleBinding(t, name), *a, name)
-	case assert.CSIDriverAssertion:
-		assert.AssertPartial(t, env.GetCSIDriver(t, name), a, name)
-	case *assert.CSIDriverAssertion:
-		assert.AssertPartial(t, env.GetCSIDriver(t, name), *a, name)
-	case assert.CSINodeAssertion:
-		assert.AssertPartial(t, env.GetCSINode(t, name), a, name)
-	case *assert.CSINodeAssertion:
-		assert.AssertPartial(t, env.GetCSINode(t, name), *a, name)
-	case assert.CSIStorageCapacityAssertion:
-		assert.AssertPartial(t, env.GetCSIStorageCapacity(t, name), a, name)
-	case *assert.CSIStorageCapacityAssertion:
-		assert.AssertPartial(t, env.GetCSIStorageCapacity(t, name), *a, name)
-	case assert.StorageClassAssertion:
-		assert.AssertPartial(t, env.GetStorageClass(t, name), a, name)
-	case *assert.StorageClassAssertion:
-		assert.AssertPartial(t, env.GetStorageClass(t, name), *a, name)
-	case assert.VolumeAttachmentAssertion:
-		assert.AssertPartial(t, env.GetVolumeAttachment(t, name), a, name)
-	case *assert.VolumeAttachmentAssertion:
-		assert.AssertPartial(t, env.GetVolumeAttachment(t, name), *a, name)
-	case assert.VolumeAttributesClassAssertion:
-		assert.AssertPartial(t, env.GetVolumeAttributesClass(t, name), a, name)
-	case *assert.VolumeAttributesClassAssertion:
-		assert.AssertPartial(t, env.GetVolumeAttributesClass(t, name), *a, name)
+	items, err := env.ListStorageVersionMigrationsE(t, opts...)
+	require.NoError(t, err, "failed to list StorageVersionMigrations")
+	return items
+}
+
+// ListStorageVersionMigrationsE lists the StorageVersionMigrations matching opts, returning the error.
+func (env *Env) ListStorageVersionMigrationsE(t *testing.T, opts ...ListOption) ([]v1beta116.StorageVersionMigration, error) {
+	t.Helper()
+	list, err := env.Client.StoragemigrationV1beta1().StorageVersionMigrations().List(env.Ctx, listOptions(opts))
+	if err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+// WaitForStorageVersionMigration watches the StorageVersionMigration called name until ready reports true
+// for it and returns it. A nil ready waits for it to exist. The test fails
+// after timeout.
+func (env *Env) WaitForStorageVersionMigration(t *testing.T, name string, ready func(*v1beta116.StorageVersionMigration) bool, timeout time.Duration) *v1beta116.StorageVersionMigration {
+	t.Helper()
+	return waitFor(t, env, "StorageVersionMigration", name, env.Client.StoragemigrationV1beta1().StorageVersionMigrations(), ready, timeout)
+}
+
+var gvrBackendTLSPolicies = schema.GroupVersionResource{
+	Group:    "gateway.networking.k8s.io",
+	Resource: "backendtlspolicies",
+	Version:  "v1",
+}
+
+// GetBackendTLSPolicy fetches a BackendTLSPolicy by name, failing the test on error.
+func (env *Env) GetBackendTLSPolicy(t *testing.T, name string) *v118.BackendTLSPolicy {
+	t.Helper()
+	obj, err := env.GetBackendTLSPolicyE(t, name)
+	require.NoError(t, err, "failed to get BackendTLSPolicy %s", name)
+	return obj
+}
+
+// GetBackendTLSPolicyE fetches a BackendTLSPolicy by name, returning the error for non-existence checks.
+func (env *Env) GetBackendTLSPolicyE(t *testing.T, name string) (*v118.BackendTLSPolicy, error) {
+	t.Helper()
+	return getDynamic[v118.BackendTLSPolicy](env.Ctx, env.DynamicClient.Resource(gvrBackendTLSPolicies).Namespace(env.Kube.Namespace), name)
+}
+
+// ListBackendTLSPolicies lists the BackendTLSPolicies matching opts, failing the test on error.
+func (env *Env) ListBackendTLSPolicies(t *testing.T, opts ...ListOption) []v118.BackendTLSPolicy {
+	t.Helper()
+	items, err := env.ListBackendTLSPoliciesE(t, opts...)
+	require.NoError(t, err, "failed to list BackendTLSPolicies")
+	return items
+}
+
+// ListBackendTLSPoliciesE lists the BackendTLSPolicies matching opts, returning the error.
+func (env *Env) ListBackendTLSPoliciesE(t *testing.T, opts ...ListOption) ([]v118.BackendTLSPolicy, error) {
+	t.Helper()
+	return listDynamic[v118.BackendTLSPolicy](env.Ctx, env.DynamicClient.Resource(gvrBackendTLSPolicies).Namespace(env.Kube.Namespace), listOptions(opts))
+}
+
+// WaitForBackendTLSPolicy watches the BackendTLSPolicy called name until ready reports true
+// for it and returns it. A nil ready waits for it to exist. The test fails
+// after timeout.
+func (env *Env) WaitForBackendTLSPolicy(t *testing.T, name string, ready func(*v118.BackendTLSPolicy) bool, timeout time.Duration) *v118.BackendTLSPolicy {
+	t.Helper()
+	return waitForDynamic(t, env, "BackendTLSPolicy", name, env.DynamicClient.Resource(gvrBackendTLSPolicies).Namespace(env.Kube.Namespace), ready, timeout)
+}
+
+var gvrGateways = schema.GroupVersionResource{
+	Group:    "gateway.networking.k8s.io",
+	Resource: "gateways",
+	Version:  "v1",
+}
+
+// GetGateway fetches a Gateway by name, failing the test on error.
+func (env *Env) GetGateway(t *testing.T, name string) *v118.Gateway {
+	t.Helper()
+	obj, err := env.GetGatewayE(t, name)
+	require.NoError(t, err, "failed to get Gateway %s", name)
+	return obj
+}
+
+// GetGatewayE fetches a Gateway by name, returning the error for non-existence checks.
+func (env *Env) GetGatewayE(t *testing.T, name string) (*v118.Gateway, error) {
+	t.Helper()
+	return getDynamic[v118.Gateway](env.Ctx, env.DynamicClient.Resource(gvrGateways).Namespace(env.Kube.Namespace), name)
+}
+
+// ListGateways lists the Gateways matching opts, failing the test on error.
+func (env *Env) ListGateways(t *testing.T, opts ...ListOption) []v118.Gateway {
+	t.Helper()
+	items, err := env.ListGatewaysE(t, opts...)
+	require.NoError(t, err, "failed to list Gateways")
+	return items
+}
+
+// ListGatewaysE lists the Gateways matching opts, returning the error.
+func (env *Env) ListGatewaysE(t *testing.T, opts ...ListOption) ([]v118.Gateway, error) {
+	t.Helper()
+	return listDynamic[v118.Gateway](env.Ctx, env.DynamicClient.Resource(gvrGateways).Namespace(env.Kube.Namespace), listOptions(opts))
+}
+
+// WaitForGateway watches the Gateway called name until ready reports true
+// for it and returns it. A nil ready waits for it to exist. The test fails
+// after timeout.
+func (env *Env) WaitForGateway(t *testing.T, name string, ready func(*v118.Gateway) bool, timeout time.Duration) *v118.Gateway {
+	t.Helper()
+	return waitForDynamic(t, env, "Gateway", name, env.DynamicClient.Resource(gvrGateways).Namespace(env.Kube.Namespace), ready, timeout)
+}
+
+var gvrGRPCRoutes = schema.GroupVersionResource{
+	Group:    "gateway.networking.k8s.io",
+	Resource: "grpcroutes",
+	Version:  "v1",
+}
+
+// GetGRPCRoute fetches a GRPCRoute by name, failing the test on error.
+func (env *Env) GetGRPCRoute(t *testing.T, name string) *v118.GRPCRoute {
+	t.Helper()
+	obj, err := env.GetGRPCRouteE(t, name)
+	require.NoError(t, err, "failed to get GRPCRoute %s", name)
+	return obj
+}
+
+// GetGRPCRouteE fetches a GRPCRoute by name, returning the error for non-existence checks.
+func (env *Env) GetGRPCRouteE(t *testing.T, name string) (*v118.GRPCRoute, error) {
+	t.Helper()
+	return getDynamic[v118.GRPCRoute](env.Ctx, env.DynamicClient.Resource(gvrGRPCRoutes).Namespace(env.Kube.Namespace), name)
+}
+
+// ListGRPCRoutes lists the GRPCRoutes matching opts, failing the test on error.
+func (env *Env) ListGRPCRoutes(t *testing.T, opts ...ListOption) []v118.GRPCRoute {
+	t.Helper()
+	items, err := env.ListGRPCRoutesE(t, opts...)
+	require.NoError(t, err, "failed to list GRPCRoutes")
+	return items
+}
+
+// ListGRPCRoutesE lists the GRPCRoutes matching opts, returning the error.
+func (env *Env) ListGRPCRoutesE(t *testing.T, opts ...ListOption) ([]v118.GRPCRoute, error) {
+	t.Helper()
+	return listDynamic[v118.GRPCRoute](env.Ctx, env.DynamicClient.Resource(gvrGRPCRoutes).Namespace(env.Kube.Namespace), listOptions(opts))
+}
+
+// WaitForGRPCRoute watches the GRPCRoute called name until ready reports true
+// for it and returns it. A nil ready waits for it to exist. The test fails
+// after timeout.
+func (env *Env) WaitForGRPCRoute(t *testing.T, name string, ready func(*v118.GRPCRoute) bool, timeout time.Duration) *v118.GRPCRoute {
+	t.Helper()
+	return waitForDynamic(t, env, "GRPCRoute", name, env.DynamicClient.Resource(gvrGRPCRoutes).Namespace(env.Kube.Namespace), ready, timeout)
+}
+
+var gvrHTTPRoutes = schema.GroupVersionResource{
+	Group:    "gateway.networking.k8s.io",
+	Resource: "httproutes",
+	Version:  "v1",
+}
+
+// GetHTTPRoute fetches a HTTPRoute by name, failing the test on error.
+func (env *Env) GetHTTPRoute(t *testing.T, name string) *v118.HTTPRoute {
+	t.Helper()
+	obj, err := env.GetHTTPRouteE(t, name)
+	require.NoError(t, err, "failed to get HTTPRoute %s", name)
+	return obj
+}
+
+// GetHTTPRouteE fetches a HTTPRoute by name, returning the error for non-existence checks.
+func (env *Env) GetHTTPRouteE(t *testing.T, name string) (*v118.HTTPRoute, error) {
+	t.Helper()
+	return getDynamic[v118.HTTPRoute](env.Ctx, env.DynamicClient.Resource(gvrHTTPRoutes).Namespace(env.Kube.Namespace), name)
+}
+
+// ListHTTPRoutes lists the HTTPRoutes matching opts, failing the test on error.
+func (env *Env) ListHTTPRoutes(t *testing.T, opts ...ListOption) []v118.HTTPRoute {
+	t.Helper()
+	items, err := env.ListHTTPRoutesE(t, opts...)
+	require.NoError(t, err, "failed to list HTTPRoutes")
+	return items
+}
+
+// ListHTTPRoutesE lists the HTTPRoutes matching opts, returning the error.
+func (env *Env) ListHTTPRoutesE(t *testing.T, opts ...ListOption) ([]v118.HTTPRoute, error) {
+	t.Helper()
+	return listDynamic[v118.HTTPRoute](env.Ctx, env.DynamicClient.Resource(gvrHTTPRoutes).Namespace(env.Kube.Namespace), listOptions(opts))
+}
+
+// WaitForHTTPRoute watches the HTTPRoute called name until ready reports true
+// for it and returns it. A nil ready waits for it to exist. The test fails
+// after timeout.
+func (env *Env) WaitForHTTPRoute(t *testing.T, name string, ready func(*v118.HTTPRoute) bool, timeout time.Duration) *v118.HTTPRoute {
+	t.Helper()
+	return waitForDynamic(t, env, "HTTPRoute", name, env.DynamicClient.Resource(gvrHTTPRoutes).Namespace(env.Kube.Namespace), ready, timeout)
+}
+
+var gvrReferenceGrants = schema.GroupVersionResource{
+	Group:    "gateway.networking.k8s.io",
+	Resource: "referencegrants",
+	Version:  "v1",
+}
+
+// GetReferenceGrant fetches a ReferenceGrant by name, failing the test on error.
+func (env *Env) GetReferenceGrant(t *testing.T, name string) *v118.ReferenceGrant {
+	t.Helper()
+	obj, err := env.GetReferenceGrantE(t, name)
+	require.NoError(t, err, "failed to get ReferenceGrant %s", name)
+	return obj
+}
+
+// GetReferenceGrantE fetches a ReferenceGrant by name, returning the error for non-existence checks.
+func (env *Env) GetReferenceGrantE(t *testing.T, name string) (*v118.ReferenceGrant, error) {
+	t.Helper()
+	return getDynamic[v118.ReferenceGrant](env.Ctx, env.DynamicClient.Resource(gvrReferenceGrants).Namespace(env.Kube.Namespace), name)
+}
+
+// ListReferenceGrants lists the ReferenceGrants matching opts, failing the test on error.
+func (env *Env) ListReferenceGrants(t *testing.T, opts ...ListOption) []v118.ReferenceGrant {
+	t.Helper()
+	items, err := env.ListReferenceGrantsE(t, opts...)
+	require.NoError(t, err, "failed to list ReferenceGrants")
+	return items
+}
+
+// ListReferenceGrantsE lists the ReferenceGrants matching opts, returning the error.
+func (env *Env) ListReferenceGrantsE(t *testing.T, opts ...ListOption) ([]v118.ReferenceGrant, error) {
+	t.Helper()
+	return listDynamic[v118.ReferenceGrant](env.Ctx, env.DynamicClient.Resource(gvrReferenceGrants).Namespace(env.Kube.Namespace), listOptions(opts))
+}
+
+// WaitForReferenceGrant watches the ReferenceGrant called name until ready reports true
+// for it and returns it. A nil ready waits for it to exist. The test fails
+// after timeout.
+func (env *Env) WaitForReferenceGrant(t *testing.T, name string, ready func(*v118.ReferenceGrant) bool, timeout time.Duration) *v118.ReferenceGrant {
+	t.Helper()
+	return waitForDynamic(t, env, "ReferenceGrant", name, env.DynamicClient.Resource(gvrReferenceGrants).Namespace(env.Kube.Namespace), ready, timeout)
+}
+
+var gvrPodMonitors = schema.GroupVersionResource{
+	Group:    "monitoring.coreos.com",
+	Resource: "podmonitors",
+	Version:  "v1",
+}
+
+// GetPodMonitor fetches a PodMonitor by name, failing the test on error.
+func (env *Env) GetPodMonitor(t *testing.T, name string) *v119.PodMonitor {
+	t.Helper()
+	obj, err := env.GetPodMonitorE(t, name)
+	require.NoError(t, err, "failed to get PodMonitor %s", name)
+	return obj
+}
+
+// GetPodMonitorE fetches a PodMonitor by name, returning the error for non-existence checks.
+func (env *Env) GetPodMonitorE(t *testing.T, name string) (*v119.PodMonitor, error) {
+	t.Helper()
+	return getDynamic[v119.PodMonitor](env.Ctx, env.DynamicClient.Resource(gvrPodMonitors).Namespace(env.Kube.Namespace), name)
+}
+
+// ListPodMonitors lists the PodMonitors matching opts, failing the test on error.
+func (env *Env) ListPodMonitors(t *testing.T, opts ...ListOption) []v119.PodMonitor {
+	t.Helper()
+	items, err := env.ListPodMonitorsE(t, opts...)
+	require.NoError(t, err, "failed to list PodMonitors")
+	return items
+}
+
+// ListPodMonitorsE lists the PodMonitors matching opts, returning the error.
+func (env *Env) ListPodMonitorsE(t *testing.T, opts ...ListOption) ([]v119.PodMonitor, error) {
+	t.Helper()
+	return listDynamic[v119.PodMonitor](env.Ctx, env.DynamicClient.Resource(gvrPodMonitors).Namespace(env.Kube.Namespace), listOptions(opts))
+}
+
+// WaitForPodMonitor watches the PodMonitor called name until ready reports true
+// for it and returns it. A nil ready waits for it to exist. The test fails
+// after timeout.
+func (env *Env) WaitForPodMonitor(t *testing.T, name string, ready func(*v119.PodMonitor) bool, timeout time.Duration) *v119.PodMonitor {
+	t.Helper()
+	return waitForDynamic(t, env, "PodMonitor", name, env.DynamicClient.Resource(gvrPodMonitors).Namespace(env.Kube.Namespace), ready, timeout)
+}
+
+var gvrPrometheusRules = schema.GroupVersionResource{
+	Group:    "monitoring.coreos.com",
+	Resource: "prometheusrules",
+	Version:  "v1",
+}
+
+// GetPrometheusRule fetches a PrometheusRule by name, failing the test on error.
+func (env *Env) GetPrometheusRule(t *testing.T, name string) *v119.PrometheusRule {
+	t.Helper()
+	obj, err := env.GetPrometheusRuleE(t, name)
+	require.NoError(t, err, "failed to get PrometheusRule %s", name)
+	return obj
+}
+
+// GetPrometheusRuleE fetches a PrometheusRule by name, returning the error for non-existence checks.
+func (env *Env) GetPrometheusRuleE(t *testing.T, name string) (*v119.PrometheusRule, error) {
+	t.Helper()
+	return getDynamic[v119.PrometheusRule](env.Ctx, env.DynamicClient.Resource(gvrPrometheusRules).Namespace(env.Kube.Namespace), name)
+}
+
+// ListPrometheusRules lists the PrometheusRules matching opts, failing the test on error.
+func (env *Env) ListPrometheusRules(t *testing.T, opts ...ListOption) []v119.PrometheusRule {
+	t.Helper()
+	items, err := env.ListPrometheusRulesE(t, opts...)
+	require.NoError(t, err, "failed to list PrometheusRules")
+	return items
+}
+
+// ListPrometheusRulesE lists the PrometheusRules matching opts, returning the error.
+func (env *Env) ListPrometheusRulesE(t *testing.T, opts ...ListOption) ([]v119.PrometheusRule, error) {
+	t.Helper()
+	return listDynamic[v119.PrometheusRule](env.Ctx, env.DynamicClient.Resource(gvrPrometheusRules).Namespace(env.Kube.Namespace), listOptions(opts))
+}
+
+// WaitForPrometheusRule watches the PrometheusRule called name until ready reports true
+// for it and returns it. A nil ready waits for it to exist. The test fails
+// after timeout.
+func (env *Env) WaitForPrometheusRule(t *testing.T, name string, ready func(*v119.PrometheusRule) bool, timeout time.Duration) *v119.PrometheusRule {
+	t.Helper()
+	return waitForDynamic(t, env, "PrometheusRule", name, env.DynamicClient.Resource(gvrPrometheusRules).Namespace(env.Kube.Namespace), ready, timeout)
+}
+
+var gvrServiceMonitors = schema.GroupVersionResource{
+	Group:    "monitoring.coreos.com",
+	Resource: "servicemonitors",
+	Version:  "v1",
+}
+
+// GetServiceMonitor fetches a ServiceMonitor by name, failing the test on error.
+func (env *Env) GetServiceMonitor(t *testing.T, name string) *v119.ServiceMonitor {
+	t.Helper()
+	obj, err := env.GetServiceMonitorE(t, name)
+	require.NoError(t, err, "failed to get ServiceMonitor %s", name)
+	return obj
+}
+
+// GetServiceMonitorE fetches a ServiceMonitor by name, returning the error for non-existence checks.
+func (env *Env) GetServiceMonitorE(t *testing.T, name string) (*v119.ServiceMonitor, error) {
+	t.Helper()
+	return getDynamic[v119.ServiceMonitor](env.Ctx, env.DynamicClient.Resource(gvrServiceMonitors).Namespace(env.Kube.Namespace), name)
+}
+
+// ListServiceMonitors lists the ServiceMonitors matching opts, failing the test on error.
+func (env *Env) ListServiceMonitors(t *testing.T, opts ...ListOption) []v119.ServiceMonitor {
+	t.Helper()
+	items, err := env.ListServiceMonitorsE(t, opts...)
+	require.NoError(t, err, "failed to list ServiceMonitors")
+	return items
+}
+
+// ListServiceMonitorsE lists the ServiceMonitors matching opts, returning the error.
+func (env *Env) ListServiceMonitorsE(t *testing.T, opts ...ListOption) ([]v119.ServiceMonitor, error) {
+	t.Helper()
+	return listDynamic[v119.ServiceMonitor](env.Ctx, env.DynamicClient.Resource(gvrServiceMonitors).Namespace(env.Kube.Namespace), listOptions(opts))
+}
+
+// WaitForServiceMonitor watches the ServiceMonitor called name until ready reports true
+// for it and returns it. A nil ready waits for it to exist. The test fails
+// after timeout.
+func (env *Env) WaitForServiceMonitor(t *testing.T, name string, ready func(*v119.ServiceMonitor) bool, timeout time.Duration) *v119.ServiceMonitor {
+	t.Helper()
+	return waitForDynamic(t, env, "ServiceMonitor", name, env.DynamicClient.Resource(gvrServiceMonitors).Namespace(env.Kube.Namespace), ready, timeout)
+}
+
+// crdListKinds maps the CRD-backed resources to their list kinds, for the
+// fake dynamic client of the rendered backend.
+var crdListKinds = map[schema.GroupVersionResource]string{
+	gvrBackendTLSPolicies: "BackendTLSPolicyList",
+	gvrGRPCRoutes:         "GRPCRouteList",
+	gvrGateways:           "GatewayList",
+	gvrHTTPRoutes:         "HTTPRouteList",
+	gvrPodMonitors:        "PodMonitorList",
+	gvrPrometheusRules:    "PrometheusRuleList",
+	gvrReferenceGrants:    "ReferenceGrantList",
+	gvrServiceMonitors:    "ServiceMonitorList",
+}
+
+// fetch fetches the K8s resource implied by the assertion type. The
+// resource type is inferred from the concrete assertion struct via a type
+// switch.
+func (env *Env) fetch(t *testing.T, name string, assertion assert.Assertable) any {
+	t.Helper()
+	switch assertion.(type) {
+	case assert.ControllerRevisionAssertion, *assert.ControllerRevisionAssertion:
+		return env.GetControllerRevision(t, name)
+	case assert.DaemonSetAssertion, *assert.DaemonSetAssertion:
+		return env.GetDaemonSet(t, name)
+	case assert.DeploymentAssertion, *assert.DeploymentAssertion:
+		return env.GetDeployment(t, name)
+	case assert.ReplicaSetAssertion, *assert.ReplicaSetAssertion:
+		return env.GetReplicaSet(t, name)
+	case assert.StatefulSetAssertion, *assert.StatefulSetAssertion:
+		return env.GetStatefulSet(t, name)
+	case assert.HorizontalPodAutoscalerAssertion, *assert.HorizontalPodAutoscalerAssertion:
+		return env.GetHorizontalPodAutoscaler(t, name)
+	case assert.CronJobAssertion, *assert.CronJobAssertion:
+		return env.GetCronJob(t, name)
+	case assert.JobAssertion, *assert.JobAssertion:
+		return env.GetJob(t, name)
+	case assert.ConfigMapAssertion, *assert.ConfigMapAssertion:
+		return env.GetConfigMap(t, name)
+	case assert.EndpointsAssertion, *assert.EndpointsAssertion:
+		return env.GetEndpoints(t, name)
+	case assert.EventAssertion, *assert.EventAssertion:
+		return env.GetEvent(t, name)
+	case assert.LimitRangeAssertion, *assert.LimitRangeAssertion:
+		return env.GetLimitRange(t, name)
+	case assert.NamespaceAssertion, *assert.NamespaceAssertion:
+		return env.GetNamespace(t, name)
+	case assert.NodeAssertion, *assert.NodeAssertion:
+		return env.GetNode(t, name)
+	case assert.PersistentVolumeAssertion, *assert.PersistentVolumeAssertion:
+		return env.GetPersistentVolume(t, name)
+	case assert.PersistentVolumeClaimAssertion, *assert.PersistentVolumeClaimAssertion:
+		return env.GetPersistentVolumeClaim(t, name)
+	case assert.PodAssertion, *assert.PodAssertion:
+		return env.GetPod(t, name)
+	case assert.PodTemplateAssertion, *assert.PodTemplateAssertion:
+		return env.GetPodTemplate(t, name)
+	case assert.ReplicationControllerAssertion, *assert.ReplicationControllerAssertion:
+		return env.GetReplicationController(t, name)
+	case assert.ResourceQuotaAssertion, *assert.ResourceQuotaAssertion:
+		return env.GetResourceQuota(t, name)
+	case assert.SecretAssertion, *assert.SecretAssertion:
+		return env.GetSecret(t, name)
+	case assert.ServiceAssertion, *assert.ServiceAssertion:
+		return env.GetService(t, name)
+	case assert.ServiceAccountAssertion, *assert.ServiceAccountAssertion:
+		return env.GetServiceAccount(t, name)
+	case assert.IPAddressAssertion, *assert.IPAddressAssertion:
+		return env.GetIPAddress(t, name)
+	case assert.IngressAssertion, *assert.IngressAssertion:
+		return env.GetIngress(t, name)
+	case assert.IngressClassAssertion, *assert.IngressClassAssertion:
+		return env.GetIngressClass(t, name)
+	case assert.NetworkPolicyAssertion, *assert.NetworkPolicyAssertion:
+		return env.GetNetworkPolicy(t, name)
+	case assert.ServiceCIDRAssertion, *assert.ServiceCIDRAssertion:
+		return env.GetServiceCIDR(t, name)
+	case assert.PodDisruptionBudgetAssertion, *assert.PodDisruptionBudgetAssertion:
+		return env.GetPodDisruptionBudget(t, name)
+	case assert.ClusterRoleAssertion, *assert.ClusterRoleAssertion:
+		return env.GetClusterRole(t, name)
+	case assert.ClusterRoleBindingAssertion, *assert.ClusterRoleBindingAssertion:
+		return env.GetClusterRoleBinding(t, name)
+	case assert.RoleAssertion, *assert.RoleAssertion:
+		return env.GetRole(t, name)
+	case assert.RoleBindingAssertion, *assert.RoleBindingAssertion:
+		return env.GetRoleBinding(t, name)
+	case assert.CSIDriverAssertion, *assert.CSIDriverAssertion:
+		return env.GetCSIDriver(t, name)
+	case assert.CSINodeAssertion, *assert.CSINodeAssertion:
+		return env.GetCSINode(t, name)
+	case assert.CSIStorageCapacityAssertion, *assert.CSIStorageCapacityAssertion:
+		return env.GetCSIStorageCapacity(t, name)
+	case assert.StorageClassAssertion, *assert.StorageClassAssertion:
+		return env.GetStorageClass(t, name)
+	case assert.VolumeAttachmentAssertion, *assert.VolumeAttachmentAssertion:
+		return env.GetVolumeAttachment(t, name)
+	case assert.VolumeAttributesClassAssertion, *assert.VolumeAttributesClassAssertion:
+		return env.GetVolumeAttributesClass(t, name)
+	case assert.BackendTLSPolicyAssertion, *assert.BackendTLSPolicyAssertion:
+		return env.GetBackendTLSPolicy(t, name)
+	case assert.GatewayAssertion, *assert.GatewayAssertion:
+		return env.GetGateway(t, name)
+	case assert.GRPCRouteAssertion, *assert.GRPCRouteAssertion:
+		return env.GetGRPCRoute(t, name)
+	case assert.HTTPRouteAssertion, *assert.HTTPRouteAssertion:
+		return env.GetHTTPRoute(t, name)
+	case assert.ReferenceGrantAssertion, *assert.ReferenceGrantAssertion:
+		return env.GetReferenceGrant(t, name)
+	case assert.PodMonitorAssertion, *assert.PodMonitorAssertion:
+		return env.GetPodMonitor(t, name)
+	case assert.PrometheusRuleAssertion, *assert.PrometheusRuleAssertion:
+		return env.GetPrometheusRule(t, name)
+	case assert.ServiceMonitorAssertion, *assert.ServiceMonitorAssertion:
+		return env.GetServiceMonitor(t, name)
 	default:
-		env.assertPartialFallback(t, name, assertion)
+		t.Fatalf("env.AssertPartial: unsupported assertion type %T", assertion)
+		return nil
 	}
 }
 
+// fetchE is like fetch but returns the error instead of failing the test,
+// so that callers can retry.
+func (env *Env) fetchE(t *testing.T, name string, assertion assert.Assertable) (any, error) {
+	t.Helper()
+	switch assertion.(type) {
+	case assert.ControllerRevisionAssertion, *assert.ControllerRevisionAssertion:
+		return env.GetControllerRevisionE(t, name)
+	case assert.DaemonSetAssertion, *assert.DaemonSetAssertion:
+		return env.GetDaemonSetE(t, name)
+	case assert.DeploymentAssertion, *assert.DeploymentAssertion:
+		return env.GetDeploymentE(t, name)
+	case assert.ReplicaSetAssertion, *assert.ReplicaSetAssertion:
+		return env.GetReplicaSetE(t, name)
+	case assert.StatefulSetAssertion, *assert.StatefulSetAssertion:
+		return env.GetStatefulSetE(t, name)
+	case assert.HorizontalPodAutoscalerAssertion, *assert.HorizontalPodAutoscalerAssertion:
+		return env.GetHorizontalPodAutoscalerE(t, name)
+	case assert.CronJobAssertion, *assert.CronJobAssertion:
+		return env.GetCronJobE(t, name)
+	case assert.JobAssertion, *assert.JobAssertion:
+		return env.GetJobE(t, name)
+	case assert.ConfigMapAssertion, *assert.ConfigMapAssertion:
+		return env.GetConfigMapE(t, name)
+	case assert.EndpointsAssertion, *assert.EndpointsAssertion:
+		return env.GetEndpointsE(t, name)
+	case assert.EventAssertion, *assert.EventAssertion:
+		return env.GetEventE(t, name)
+	case assert.LimitRangeAssertion, *assert.LimitRangeAssertion:
+		return env.GetLimitRangeE(t, name)
+	case assert.NamespaceAssertion, *assert.NamespaceAssertion:
+		return env.GetNamespaceE(t, name)
+	case assert.NodeAssertion, *assert.NodeAssertion:
+		return env.GetNodeE(t, name)
+	case assert.PersistentVolumeAssertion, *assert.PersistentVolumeAssertion:
+		return env.GetPersistentVolumeE(t, name)
+	case assert.PersistentVolumeClaimAssertion, *assert.PersistentVolumeClaimAssertion:
+		return env.GetPersistentVolumeClaimE(t, name)
+	case assert.PodAssertion, *assert.PodAssertion:
+		return env.GetPodE(t, name)
+	case assert.PodTemplateAssertion, *assert.PodTemplateAssertion:
+		return env.GetPodTemplateE(t, name)
+	case assert.ReplicationControllerAssertion, *assert.ReplicationControllerAssertion:
+		return env.GetReplicationControllerE(t, name)
+	case assert.ResourceQuotaAssertion, *assert.ResourceQuotaAssertion:
+		return env.GetResourceQuotaE(t, name)
+	case assert.SecretAssertion, *assert.SecretAssertion:
+		return env.GetSecretE(t, name)
+	case assert.ServiceAssertion, *assert.ServiceAssertion:
+		return env.GetServiceE(t, name)
+	case assert.ServiceAccountAssertion, *assert.ServiceAccountAssertion:
+		return env.GetServiceAccountE(t, name)
+	case assert.IPAddressAssertion, *assert.IPAddressAssertion:
+		return env.GetIPAddressE(t, name)
+	case assert.IngressAssertion, *assert.IngressAssertion:
+		return env.GetIngressE(t, name)
+	case assert.IngressClassAssertion, *assert.IngressClassAssertion:
+		return env.GetIngressClassE(t, name)
+	case assert.NetworkPolicyAssertion, *assert.NetworkPolicyAssertion:
+		return env.GetNetworkPolicyE(t, name)
+	case assert.ServiceCIDRAssertion, *assert.ServiceCIDRAssertion:
+		return env.GetServiceCIDRE(t, name)
+	case assert.PodDisruptionBudgetAssertion, *assert.PodDisruptionBudgetAssertion:
+		return env.GetPodDisruptionBudgetE(t, name)
+	case assert.ClusterRoleAssertion, *assert.ClusterRoleAssertion:
+		return env.GetClusterRoleE(t, name)
+	case assert.ClusterRoleBindingAssertion, *assert.ClusterRoleBindingAssertion:
+		return env.GetClusterRoleBindingE(t, name)
+	case assert.RoleAssertion, *assert.RoleAssertion:
+		return env.GetRoleE(t, name)
+	case assert.RoleBindingAssertion, *assert.RoleBindingAssertion:
+		return env.GetRoleBindingE(t, name)
+	case assert.CSIDriverAssertion, *assert.CSIDriverAssertion:
+		return env.GetCSIDriverE(t, name)
+	case assert.CSINodeAssertion, *assert.CSINodeAssertion:
+		return env.GetCSINodeE(t, name)
+	case assert.CSIStorageCapacityAssertion, *assert.CSIStorageCapacityAssertion:
+		return env.GetCSIStorageCapacityE(t, name)
+	case assert.StorageClassAssertion, *assert.StorageClassAssertion:
+		return env.GetStorageClassE(t, name)
+	case assert.VolumeAttachmentAssertion, *assert.VolumeAttachmentAssertion:
+		return env.GetVolumeAttachmentE(t, name)
+	case assert.VolumeAttributesClassAssertion, *assert.VolumeAttributesClassAssertion:
+		return env.GetVolumeAttributesClassE(t, name)
+	case assert.BackendTLSPolicyAssertion, *assert.BackendTLSPolicyAssertion:
+		return env.GetBackendTLSPolicyE(t, name)
+	case assert.GatewayAssertion, *assert.GatewayAssertion:
+		return env.GetGatewayE(t, name)
+	case assert.GRPCRouteAssertion, *assert.GRPCRouteAssertion:
+		return env.GetGRPCRouteE(t, name)
+	case assert.HTTPRouteAssertion, *assert.HTTPRouteAssertion:
+		return env.GetHTTPRouteE(t, name)
+	case assert.ReferenceGrantAssertion, *assert.ReferenceGrantAssertion:
+		return env.GetReferenceGrantE(t, name)
+	case assert.PodMonitorAssertion, *assert.PodMonitorAssertion:
+		return env.GetPodMonitorE(t, name)
+	case assert.PrometheusRuleAssertion, *assert.PrometheusRuleAssertion:
+		return env.GetPrometheusRuleE(t, name)
+	case assert.ServiceMonitorAssertion, *assert.ServiceMonitorAssertion:
+		return env.GetServiceMonitorE(t, name)
+	default:
+		t.Fatalf("env.AssertPartialEventually: unsupported assertion type %T", assertion)
+		return nil, nil
+	}
+}
+
+// AssertPartial fetches the K8s resource implied by the assertion type and
+// performs a partial assertion, failing on the first mismatching field.
+func (env *Env) AssertPartial(t *testing.T, name string, assertion assert.Assertable) {
+	t.Helper()
+	assert.AssertPartial(t, env.fetch(t, name, assertion), assertion)
+}
+
+// AssertPartialAll is like AssertPartial but reports every mismatching
+// field, with its full path, in a single failure.
+func (env *Env) AssertPartialAll(t *testing.T, name string, assertion assert.Assertable) {
+	t.Helper()
+	assert.AssertPartialAll(t, env.fetch(t, name, assertion), assertion)
+}
+
 // AssertNone asserts that the K8s resource implied by the assertion type
 // does not exist. The resource type is inferred from the concrete assertion
 // struct via a type switch.
@@ -2254,7 +6201,31 @@ func (env *Env) AssertNone(t *testing.T, name string, assertion assert.Assertabl
 	case assert.VolumeAttributesClassAssertion, *assert.VolumeAttributesClassAssertion:
 		_, err := env.GetVolumeAttributesClassE(t, name)
 		require.True(t, errors.IsNotFound(err), "VolumeAttributesClass %q should not exist (err: %v)", name, err)
+	case assert.BackendTLSPolicyAssertion, *assert.BackendTLSPolicyAssertion:
+		_, err := env.GetBackendTLSPolicyE(t, name)
+		require.True(t, errors.IsNotFound(err), "BackendTLSPolicy %q should not exist (err: %v)", name, err)
+	case assert.GatewayAssertion, *assert.GatewayAssertion:
+		_, err := env.GetGatewayE(t, name)
+		require.True(t, errors.IsNotFound(err), "Gateway %q should not exist (err: %v)", name, err)
+	case assert.GRPCRouteAssertion, *assert.GRPCRouteAssertion:
+		_, err := env.GetGRPCRouteE(t, name)
+		require.True(t, errors.IsNotFound(err), "GRPCRoute %q should not exist (err: %v)", name, err)
+	case assert.HTTPRouteAssertion, *assert.HTTPRouteAssertion:
+		_, err := env.GetHTTPRouteE(t, name)
+		require.True(t, errors.IsNotFound(err), "HTTPRoute %q should not exist (err: %v)", name, err)
+	case assert.ReferenceGrantAssertion, *assert.ReferenceGrantAssertion:
+		_, err := env.GetReferenceGrantE(t, name)
+		require.True(t, errors.IsNotFound(err), "ReferenceGrant %q should not exist (err: %v)", name, err)
+	case assert.PodMonitorAssertion, *assert.PodMonitorAssertion:
+		_, err := env.GetPodMonitorE(t, name)
+		require.True(t, errors.IsNotFound(err), "PodMonitor %q should not exist (err: %v)", name, err)
+	case assert.PrometheusRuleAssertion, *assert.PrometheusRuleAssertion:
+		_, err := env.GetPrometheusRuleE(t, name)
+		require.True(t, errors.IsNotFound(err), "PrometheusRule %q should not exist (err: %v)", name, err)
+	case assert.ServiceMonitorAssertion, *assert.ServiceMonitorAssertion:
+		_, err := env.GetServiceMonitorE(t, name)
+		require.True(t, errors.IsNotFound(err), "ServiceMonitor %q should not exist (err: %v)", name, err)
 	default:
-		env.assertNoneFallback(t, name, assertion)
+		t.Fatalf("env.AssertNone: unsupported assertion type %T", assertion)
 	}
 }
@@ -0,0 +1,11 @@
+// Package values is a typed model of the ZITADEL chart's values, generated
+// from charts/zitadel/values.schema.json. Tests build a Values with only the
+// settings they override and pass it to the install helpers, which write it
+// out as a Helm values file. A misspelt key or a schema change fails
+// compilation instead of being silently ignored by Helm.
+//
+// zitadel.configmapConfig and zitadel.secretConfig are passed through to
+// ZITADEL as-is and are therefore plain maps.
+//
+//go:generate go run ../../internal/gen/valuesgen -schema ../../charts/zitadel/values.schema.json -out zz_generated.go -package values
+package values
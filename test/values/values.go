@@ -0,0 +1,29 @@
+package values
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"sigs.k8s.io/yaml"
+)
+
+// YAML marshals the values as a Helm values file. Unset fields are omitted.
+func (v *Values) YAML() ([]byte, error) {
+	return yaml.Marshal(v)
+}
+
+// WriteFile writes the values to a file in the test's temporary directory and
+// returns its path, for use with `helm -f`.
+func (v *Values) WriteFile(t *testing.T) string {
+	t.Helper()
+	data, err := v.YAML()
+	require.NoError(t, err, "failed to marshal values")
+	f, err := os.CreateTemp(t.TempDir(), "values-*.yaml")
+	require.NoError(t, err)
+	defer f.Close()
+	_, err = f.Write(data)
+	require.NoError(t, err)
+	return filepath.Clean(f.Name())
+}
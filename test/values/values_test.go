@@ -0,0 +1,68 @@
+package values_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/zitadel/zitadel-charts/test/render"
+	"github.com/zitadel/zitadel-charts/test/values"
+)
+
+// TestYAMLOmitsUnsetFields verifies that only the fields a test sets end up in
+// the values file, so everything else keeps the chart defaults.
+func TestYAMLOmitsUnsetFields(t *testing.T) {
+	t.Parallel()
+
+	data, err := (&values.Values{
+		Zitadel: &values.Zitadel{Masterkey: ptr.To("x123456789012345678901234567891y")},
+		Service: &values.Service{AppProtocol: ptr.To("")},
+	}).YAML()
+	require.NoError(t, err)
+	require.YAMLEq(t, `
+service:
+  appProtocol: ""
+zitadel:
+  masterkey: x123456789012345678901234567891y
+`, string(data))
+}
+
+// TestValuesRenderThroughChart verifies that a typed values file is accepted
+// by the chart's schema and reaches the rendered objects, including fields
+// typed with upstream Kubernetes and Gateway API types.
+func TestValuesRenderThroughChart(t *testing.T) {
+	t.Parallel()
+
+	v := &values.Values{
+		Zitadel: &values.Zitadel{
+			Masterkey:       ptr.To("x123456789012345678901234567891y"),
+			ConfigmapConfig: map[string]any{"ExternalDomain": "values.example.local"},
+		},
+		Env: []corev1.EnvVar{{Name: "EXTRA", Value: "1"}},
+		Gateway: &values.Gateway{
+			HTTPRoute: &values.GatewayHTTPRoute{
+				Enabled:    ptr.To(true),
+				ParentRefs: []values.GatewayHTTPRouteParentRef{{Name: ptr.To("my-gateway")}},
+				Filters: []gatewayv1.HTTPRouteFilter{{
+					Type: gatewayv1.HTTPRouteFilterRequestHeaderModifier,
+					RequestHeaderModifier: &gatewayv1.HTTPHeaderFilter{
+						Set: []gatewayv1.HTTPHeader{{Name: "X-Test", Value: "on"}},
+					},
+				}},
+			},
+		},
+	}
+
+	m := render.Chart(t,
+		render.WithRelease("zitadel-values"),
+		render.WithValuesFiles(v.WriteFile(t)),
+		render.WithSchemaValidation(),
+	)
+
+	route := render.Get[*gatewayv1.HTTPRoute](t, m, "zitadel-values")
+	require.Len(t, route.Spec.Rules, 1)
+	require.Equal(t, v.Gateway.HTTPRoute.Filters, route.Spec.Rules[0].Filters)
+}
@@ -0,0 +1,1149 @@
+// Code generated by valuesgen. DO NOT EDIT.
+
+package values
+
+import (
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	intstr "k8s.io/apimachinery/pkg/util/intstr"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+// Values holds chart value overrides. Unset fields keep the chart defaults.
+type Values struct {
+	// Affinity rules for pod scheduling. Use for advanced pod placement
+	// strategies like co-locating pods on the same node (pod affinity),
+	// spreading pods across zones (pod anti-affinity), or preferring certain
+	// nodes (node affinity). Ref:
+	// https://kubernetes.io/docs/concepts/scheduling-eviction/assign-pod-node/#affinity-and-anti-affinity
+	Affinity *corev1.Affinity `json:"affinity,omitempty"`
+	// Annotations to add to the ZITADEL Deployment resource. Use this for
+	// integration with tools like ArgoCD, Flux, or external monitoring systems.
+	Annotations map[string]string `json:"annotations,omitempty"`
+	CleanupJob  *CleanupJob       `json:"cleanupJob,omitempty"`
+	ConfigMap   *ConfigMap        `json:"configMap,omitempty"`
+	// Additional environment variables for the ZITADEL container. Use this to
+	// pass configuration that isn't available through configmapConfig or
+	// secretConfig, or to inject values from other Kubernetes resources like
+	// ConfigMaps or Secrets. ZITADEL environment variables follow the pattern
+	// ZITADEL_<SECTION>_<KEY>. Ref:
+	// https://zitadel.com/docs/self-hosting/manage/configure#configure-by-environment-variables
+	Env []corev1.EnvVar `json:"env,omitempty"`
+	// Name of a Kubernetes Secret containing environment variables to inject
+	// into the ZITADEL container. All key-value pairs in the secret will be
+	// available as environment variables. This is useful for managing multiple
+	// ZITADEL configuration values in a single secret, especially when using
+	// external secret management tools like External Secrets Operator or Sealed
+	// Secrets. Ref:
+	// https://zitadel.com/docs/self-hosting/manage/configure#configure-by-environment-variables
+	EnvVarsSecret *string `json:"envVarsSecret,omitempty"`
+	// Sidecar containers to run alongside the main ZITADEL container in the
+	// Deployment pod. Use this for logging agents, monitoring sidecars, service
+	// meshes, or database proxies (e.g., cloud-sql-proxy for Google Cloud SQL).
+	// These containers share the pod's network namespace and can access the same
+	// volumes as the main container.
+	ExtraContainers []corev1.Container `json:"extraContainers,omitempty"`
+	// Additional Kubernetes manifests to deploy alongside the chart. This allows
+	// you to include custom resources without creating a separate chart.
+	// Supports Helm templating syntax including .Release, .Values, and template
+	// functions. Use this for secrets, configmaps, network policies, or any
+	// other resources that ZITADEL depends on.
+	ExtraManifests []map[string]any `json:"extraManifests,omitempty"`
+	// Additional volume mounts for the main ZITADEL container. Use this to mount
+	// volumes defined in extraVolumes into the container filesystem. Common use
+	// cases include mounting custom CA certificates, configuration files, or
+	// shared data between containers.
+	ExtraVolumeMounts []corev1.VolumeMount `json:"extraVolumeMounts,omitempty"`
+	// Additional volumes to add to ZITADEL pods. These volumes can be referenced
+	// by extraVolumeMounts to make data available to the ZITADEL container or
+	// sidecar containers. Supports all Kubernetes volume types: secrets,
+	// configMaps, persistentVolumeClaims, emptyDir, hostPath, etc.
+	ExtraVolumes []corev1.Volume `json:"extraVolumes,omitempty"`
+	// Completely override the generated resource names (release-name +
+	// chart-name). Takes precedence over nameOverride. Use this when you need
+	// full control over resource naming, such as when migrating from another
+	// chart.
+	FullnameOverride *string  `json:"fullnameOverride,omitempty"`
+	Gateway          *Gateway `json:"gateway,omitempty"`
+	Image            *Image   `json:"image,omitempty"`
+	// References to secrets containing Docker registry credentials for pulling
+	// private ZITADEL images. Each entry should be the name of an existing
+	// secret of type kubernetes.io/dockerconfigjson. Example: imagePullSecrets:
+	// - name: my-registry-secret
+	ImagePullSecrets []corev1.LocalObjectReference `json:"imagePullSecrets,omitempty"`
+	// Global container registry override for tool images (e.g., wait4x,
+	// kubectl). When set, this registry is prepended to tool image repositories
+	// for compatibility with CRI-O v1.34+ which enforces fully qualified image
+	// names. If left empty, defaults to "docker.io".
+	ImageRegistry *string        `json:"imageRegistry,omitempty"`
+	Ingress       *Ingress       `json:"ingress,omitempty"`
+	InitJob       *InitJob       `json:"initJob,omitempty"`
+	LivenessProbe *LivenessProbe `json:"livenessProbe,omitempty"`
+	Login         *Login         `json:"login,omitempty"`
+	Metrics       *Metrics       `json:"metrics,omitempty"`
+	// Override the "zitadel" portion of resource names. Useful when the default
+	// naming would conflict with existing resources or when deploying multiple
+	// instances with different configurations.
+	NameOverride *string `json:"nameOverride,omitempty"`
+	// Node labels for pod assignment. Pods will only be scheduled on nodes that
+	// have all the specified labels. Use this to target specific node pools
+	// (e.g., high-memory nodes, nodes in specific zones). Ref:
+	// https://kubernetes.io/docs/concepts/scheduling-eviction/assign-pod-node/
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+	PDB          *PDB              `json:"pdb,omitempty"`
+	// Additional labels to add to ZITADEL pods beyond the standard Helm labels.
+	// Useful for organizing pods with custom label selectors, network policies,
+	// or pod security policies.
+	PodAdditionalLabels map[string]string `json:"podAdditionalLabels,omitempty"`
+	// Annotations to add to ZITADEL pods. Use this for integrations like
+	// Prometheus scraping, Istio sidecar injection, Vault agent injection, or
+	// any other annotation-based configuration. Example: podAnnotations:
+	// prometheus.io/scrape: "true" sidecar.istio.io/inject: "true"
+	PodAnnotations     map[string]string          `json:"podAnnotations,omitempty"`
+	PodSecurityContext *corev1.PodSecurityContext `json:"podSecurityContext,omitempty"`
+	Postgresql         *Postgresql                `json:"postgresql,omitempty"`
+	ReadinessProbe     *ReadinessProbe            `json:"readinessProbe,omitempty"`
+	// Number of ZITADEL pod replicas. While a single replica is fine for
+	// testing, production environments should use 3 or more to prevent downtime
+	// during rolling updates, node failures, and ensure high availability.
+	ReplicaCount *int `json:"replicaCount,omitempty"`
+	// CPU and memory resource requests and limits for the ZITADEL container.
+	// Setting appropriate resources ensures predictable performance and prevents
+	// resource starvation. Requests affect scheduling; limits enforce caps. Ref:
+	// https://kubernetes.io/docs/concepts/configuration/manage-resources-containers/
+	Resources       *corev1.ResourceRequirements `json:"resources,omitempty"`
+	SecurityContext *corev1.SecurityContext      `json:"securityContext,omitempty"`
+	Service         *Service                     `json:"service,omitempty"`
+	ServiceAccount  *ServiceAccount              `json:"serviceAccount,omitempty"`
+	SetupJob        *SetupJob                    `json:"setupJob,omitempty"`
+	StartupProbe    *StartupProbe                `json:"startupProbe,omitempty"`
+	// Tolerations allow pods to be scheduled on nodes with matching taints.
+	// Taints are used to repel pods from nodes; tolerations allow exceptions.
+	// Ref:
+	// https://kubernetes.io/docs/concepts/scheduling-eviction/taint-and-toleration/
+	Tolerations []corev1.Toleration `json:"tolerations,omitempty"`
+	Tools       *Tools              `json:"tools,omitempty"`
+	// Topology spread constraints control how pods are distributed across
+	// topology domains (e.g., zones, nodes, regions) for high availability.
+	// Unlike affinity, these constraints provide more granular control over pod
+	// distribution. Ref:
+	// https://kubernetes.io/docs/concepts/scheduling-eviction/topology-spread-constraints/
+	TopologySpreadConstraints []corev1.TopologySpreadConstraint `json:"topologySpreadConstraints,omitempty"`
+	Zitadel                   *Zitadel                          `json:"zitadel,omitempty"`
+}
+
+// CleanupJob holds the cleanupJob values.
+type CleanupJob struct {
+	// Maximum time in seconds for the cleanup job to complete. After this
+	// deadline, the job is terminated even if still running.
+	ActiveDeadlineSeconds *int `json:"activeDeadlineSeconds,omitempty"`
+	// Annotations for the cleanup job. The post-delete hook ensures this runs on
+	// helm uninstall, and the delete policy removes the job after completion.
+	Annotations map[string]string `json:"annotations,omitempty"`
+	// Number of retries before marking the cleanup job as failed.
+	BackoffLimit *int `json:"backoffLimit,omitempty"`
+	// Enable the cleanup job to remove secrets created by the setup job. Set to
+	// false if you want to preserve secrets across reinstalls.
+	Enabled *bool `json:"enabled,omitempty"`
+	// Additional labels to add to cleanup job pods.
+	PodAdditionalLabels map[string]string `json:"podAdditionalLabels,omitempty"`
+	// Additional annotations to add to cleanup job pods.
+	PodAnnotations map[string]string `json:"podAnnotations,omitempty"`
+	// Resource limits and requests for the cleanup job container. Keep minimal
+	// as this job only runs kubectl delete commands.
+	Resources *corev1.ResourceRequirements `json:"resources,omitempty"`
+}
+
+// ConfigMap holds the configMap values.
+type ConfigMap struct {
+	// Annotations for the ZITADEL ConfigMap. The default Helm hooks ensure the
+	// ConfigMap is created before the deployment and recreated on upgrades to
+	// pick up configuration changes.
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+// Gateway holds the gateway values.
+type Gateway struct {
+	GRPCRoute *GatewayGRPCRoute `json:"grpcRoute,omitempty"`
+	HTTPRoute *GatewayHTTPRoute `json:"httpRoute,omitempty"`
+}
+
+// GatewayGRPCRoute holds the gateway.grpcRoute values.
+type GatewayGRPCRoute struct {
+	// Annotations to apply to the GRPCRoute resource.
+	Annotations map[string]string `json:"annotations,omitempty"`
+	// If true, creates a GRPCRoute resource for the ZITADEL gRPC API.
+	Enabled *bool `json:"enabled,omitempty"`
+	// Filters to apply to all rules. These define processing steps for gRPC
+	// requests, such as header modification or mirroring. Ref:
+	// https://gateway-api.sigs.k8s.io/reference/spec/#gateway.networking.k8s.io/v1.GRPCRouteFilter
+	Filters []gatewayv1.GRPCRouteFilter `json:"filters,omitempty"`
+	// Hostnames for the GRPCRoute. If empty, defaults to ExternalDomain.
+	Hostnames []string `json:"hostnames,omitempty"`
+	// Additional labels to apply to the GRPCRoute resource.
+	Labels map[string]string `json:"labels,omitempty"`
+	// Matches to apply to the GRPCRoute rule. If empty, the route matches all
+	// gRPC requests per the Gateway API spec. Some implementations (e.g. Cilium)
+	// may require explicit matches to correctly prioritize GRPCRoute over
+	// HTTPRoute when both share the same hostname. Ref:
+	// https://gateway-api.sigs.k8s.io/reference/spec/#gateway.networking.k8s.io/v1.GRPCRouteMatch
+	Matches []GatewayGRPCRouteMatch `json:"matches,omitempty"`
+	// References to Gateway resources that this route should be attached to.
+	// Example: parentRefs: - name: my-gateway
+	ParentRefs []GatewayGRPCRouteParentRef `json:"parentRefs,omitempty"`
+}
+
+// GatewayGRPCRouteMatch holds the gateway.grpcRoute.matches[] values.
+type GatewayGRPCRouteMatch struct {
+	Headers []GatewayGRPCRouteMatchHeader `json:"headers,omitempty"`
+	Method  *GatewayGRPCRouteMatchMethod  `json:"method,omitempty"`
+}
+
+// GatewayGRPCRouteMatchHeader holds the gateway.grpcRoute.matches[].headers[]
+// values.
+type GatewayGRPCRouteMatchHeader struct {
+	Name  *string `json:"name,omitempty"`
+	Type  *string `json:"type,omitempty"`
+	Value *string `json:"value,omitempty"`
+}
+
+// GatewayGRPCRouteMatchMethod holds the gateway.grpcRoute.matches[].method
+// values.
+type GatewayGRPCRouteMatchMethod struct {
+	Method  *string `json:"method,omitempty"`
+	Service *string `json:"service,omitempty"`
+	Type    *string `json:"type,omitempty"`
+}
+
+// GatewayGRPCRouteParentRef holds the gateway.grpcRoute.parentRefs[] values.
+type GatewayGRPCRouteParentRef struct {
+	Name        *string `json:"name,omitempty"`
+	Namespace   *string `json:"namespace,omitempty"`
+	Port        *int    `json:"port,omitempty"`
+	SectionName *string `json:"sectionName,omitempty"`
+}
+
+// GatewayHTTPRoute holds the gateway.httpRoute values.
+type GatewayHTTPRoute struct {
+	// Annotations to apply to the HTTPRoute resource.
+	Annotations map[string]string `json:"annotations,omitempty"`
+	// If true, creates an HTTPRoute resource for the ZITADEL service.
+	Enabled *bool `json:"enabled,omitempty"`
+	// Filters to apply to all rules. These define processing steps for requests,
+	// such as header modification or URL rewrites. Ref:
+	// https://gateway-api.sigs.k8s.io/reference/spec/#gateway.networking.k8s.io/v1.HTTPRouteFilter
+	Filters []gatewayv1.HTTPRouteFilter `json:"filters,omitempty"`
+	// Hostnames for the HTTPRoute. If empty, defaults to ExternalDomain.
+	Hostnames []string `json:"hostnames,omitempty"`
+	// Additional labels to apply to the HTTPRoute resource.
+	Labels map[string]string `json:"labels,omitempty"`
+	// References to Gateway resources that this route should be attached to.
+	// Each entry must include at least a `name` field matching an existing
+	// Gateway. Example: parentRefs: - name: my-gateway sectionName: https
+	ParentRefs []GatewayHTTPRouteParentRef `json:"parentRefs,omitempty"`
+	// Path matching rules for the HTTPRoute. Each entry generates a separate
+	// rule.
+	Paths []GatewayHTTPRoutePath `json:"paths,omitempty"`
+	// Timeouts for HTTP requests routed via this HTTPRoute. Ref:
+	// https://gateway-api.sigs.k8s.io/reference/spec/#gateway.networking.k8s.io/v1.HTTPRouteTimeouts
+	Timeouts map[string]string `json:"timeouts,omitempty"`
+}
+
+// GatewayHTTPRouteParentRef holds the gateway.httpRoute.parentRefs[] values.
+type GatewayHTTPRouteParentRef struct {
+	Name        *string `json:"name,omitempty"`
+	Namespace   *string `json:"namespace,omitempty"`
+	Port        *int    `json:"port,omitempty"`
+	SectionName *string `json:"sectionName,omitempty"`
+}
+
+// GatewayHTTPRoutePath holds the gateway.httpRoute.paths[] values.
+type GatewayHTTPRoutePath struct {
+	Path     *string `json:"path,omitempty"`
+	PathType *string `json:"pathType,omitempty"`
+}
+
+// Image holds the image values.
+type Image struct {
+	// Image pull policy. Use "Always" for mutable tags like "latest", or
+	// "IfNotPresent" for immutable version tags to reduce network traffic.
+	PullPolicy *string `json:"pullPolicy,omitempty"`
+	// Docker image repository for ZITADEL. The default uses GitHub Container
+	// Registry. Change this if using a private registry or mirror.
+	Repository *string `json:"repository,omitempty"`
+	// Image tag. Defaults to the chart's appVersion if not specified. Use a
+	// specific version tag (e.g., "v2.45.0") for production deployments to
+	// ensure reproducibility and controlled upgrades.
+	Tag *string `json:"tag,omitempty"`
+}
+
+// Ingress holds the ingress values.
+type Ingress struct {
+	// Annotations to apply to the Ingress resource.
+	Annotations map[string]string `json:"annotations,omitempty"`
+	// The name of the IngressClass resource to use for this Ingress. Ref:
+	// https://kubernetes.io/docs/concepts/services-networking/ingress/#ingress-class
+	ClassName *string `json:"className,omitempty"`
+	// A chart-specific setting to enable logic for different controllers. Use
+	// "aws" to generate AWS ALB-specific annotations and resources. Use "nginx"
+	// to inject the nginx.ingress.kubernetes.io/backend-protocol annotation.
+	Controller *string `json:"controller,omitempty"`
+	// If true, creates an Ingress resource for the ZITADEL service.
+	Enabled *bool `json:"enabled,omitempty"`
+	// A list of host rules for the Ingress. Each host can have multiple paths.
+	Hosts []IngressHost `json:"hosts,omitempty"`
+	// TLS configuration for the Ingress. This allows you to secure the endpoint
+	// with HTTPS by referencing a secret that contains the TLS certificate and
+	// key.
+	TLS []networkingv1.IngressTLS `json:"tls,omitempty"`
+}
+
+// IngressHost holds the ingress.hosts[] values.
+type IngressHost struct {
+	Host  *string           `json:"host,omitempty"`
+	Paths []IngressHostPath `json:"paths,omitempty"`
+}
+
+// IngressHostPath holds the ingress.hosts[].paths[] values.
+type IngressHostPath struct {
+	Path     *string `json:"path,omitempty"`
+	PathType *string `json:"pathType,omitempty"`
+}
+
+// InitJob holds the initJob values.
+type InitJob struct {
+	// Maximum time in seconds for the init job to complete. The job is
+	// terminated if it exceeds this deadline, regardless of backoffLimit.
+	ActiveDeadlineSeconds *int `json:"activeDeadlineSeconds,omitempty"`
+	// Annotations for the init job. The Helm hooks ensure this job runs before
+	// the main deployment and is recreated on each upgrade.
+	Annotations map[string]string `json:"annotations,omitempty"`
+	// Number of retries before marking the init job as failed. Increase this if
+	// the database might take longer to become available.
+	BackoffLimit *int    `json:"backoffLimit,omitempty"`
+	Command      *string `json:"command,omitempty"`
+	// Enable or disable the init job. Set to false after the initial
+	// installation if you want to manage database initialization externally or
+	// if no database changes are expected during upgrades.
+	Enabled *bool `json:"enabled,omitempty"`
+	// Sidecar containers to run alongside the init container. Useful for
+	// logging, proxies (e.g., cloud-sql-proxy), or other supporting services.
+	ExtraContainers []corev1.Container `json:"extraContainers,omitempty"`
+	// Init containers to run before the main init container. Useful for waiting
+	// on additional dependencies or performing pre-initialization tasks.
+	InitContainers []corev1.Container `json:"initContainers,omitempty"`
+	// Additional labels to add to init job pods.
+	PodAdditionalLabels map[string]string `json:"podAdditionalLabels,omitempty"`
+	// Additional annotations to add to init job pods.
+	PodAnnotations map[string]string `json:"podAnnotations,omitempty"`
+	// CPU and memory resource requests and limits for the init job container.
+	// The init job typically requires minimal resources as it only runs SQL
+	// commands against the database.
+	Resources *corev1.ResourceRequirements `json:"resources,omitempty"`
+}
+
+// LivenessProbe holds the livenessProbe values.
+type LivenessProbe struct {
+	// Enable or disable the liveness probe.
+	Enabled *bool `json:"enabled,omitempty"`
+	// Number of consecutive failures before restarting the container.
+	FailureThreshold *int `json:"failureThreshold,omitempty"`
+	// Seconds to wait before starting liveness checks after container start.
+	InitialDelaySeconds *int `json:"initialDelaySeconds,omitempty"`
+	// How often (in seconds) to perform the liveness check.
+	PeriodSeconds *int `json:"periodSeconds,omitempty"`
+}
+
+// Login holds the login values.
+type Login struct {
+	// Affinity rules for pod scheduling. Use for advanced pod placement
+	// strategies like co-locating pods or spreading across zones. Ref:
+	// https://kubernetes.io/docs/concepts/scheduling-eviction/assign-pod-node/#affinity-and-anti-affinity
+	Affinity *corev1.Affinity `json:"affinity,omitempty"`
+	// Annotations to add to the Login UI Deployment resource.
+	Annotations map[string]string `json:"annotations,omitempty"`
+	Autoscaling *LoginAutoscaling `json:"autoscaling,omitempty"`
+	ConfigMap   *LoginConfigMap   `json:"configMap,omitempty"`
+	// Custom environment variables for the Login UI ConfigMap. These override
+	// the default values which configure the service user token path, API URL,
+	// and custom request headers. Only set this if you need to customize the
+	// Login UI behavior beyond the defaults. The defaults are:
+	// ZITADEL_SERVICE_USER_TOKEN_FILE="/login-client/pat"
+	// ZITADEL_API_URL="http://<release>-zitadel:<port>"
+	// CUSTOM_REQUEST_HEADERS="Host:<ExternalDomain>"
+	CustomConfigmapConfig *string `json:"customConfigmapConfig,omitempty"`
+	// Enable or disable the Login UI deployment. When disabled, ZITADEL uses its
+	// built-in login interface instead of the separate Login UI application.
+	Enabled *bool `json:"enabled,omitempty"`
+	// Additional environment variables for the Login UI container. Use this to
+	// pass configuration that isn't available through customConfigmapConfig.
+	Env []corev1.EnvVar `json:"env,omitempty"`
+	// Sidecar containers to run alongside the Login UI container. Useful for
+	// logging agents, proxies, or other supporting services.
+	ExtraContainers []corev1.Container `json:"extraContainers,omitempty"`
+	// Additional volume mounts for the Login UI container. Use this to mount
+	// custom certificates, configuration files, or other data into the
+	// container.
+	ExtraVolumeMounts []corev1.VolumeMount `json:"extraVolumeMounts,omitempty"`
+	// Additional volumes for the Login UI pod. Define volumes here that are
+	// referenced by extraVolumeMounts.
+	ExtraVolumes []corev1.Volume `json:"extraVolumes,omitempty"`
+	// Completely override the generated resource names. Takes precedence over
+	// nameOverride when set.
+	FullnameOverride *string       `json:"fullnameOverride,omitempty"`
+	Gateway          *LoginGateway `json:"gateway,omitempty"`
+	Image            *LoginImage   `json:"image,omitempty"`
+	// References to secrets containing Docker registry credentials for pulling
+	// private images. Each entry should be the name of an existing secret.
+	ImagePullSecrets []corev1.LocalObjectReference `json:"imagePullSecrets,omitempty"`
+	Ingress          *LoginIngress                 `json:"ingress,omitempty"`
+	// Init containers to run before the Login UI container starts. Useful for
+	// waiting on dependencies or performing setup tasks.
+	InitContainers []corev1.Container  `json:"initContainers,omitempty"`
+	LivenessProbe  *LoginLivenessProbe `json:"livenessProbe,omitempty"`
+	// Prefix for the login client secret name. Use this when deploying multiple
+	// ZITADEL instances in the same namespace to avoid secret name collisions.
+	// When set, the login client secret will be named "{prefix}login-client".
+	LoginClientSecretPrefix *string       `json:"loginClientSecretPrefix,omitempty"`
+	Metrics                 *LoginMetrics `json:"metrics,omitempty"`
+	// Override the "login" portion of resource names. Useful when the default
+	// naming conflicts with existing resources.
+	NameOverride *string `json:"nameOverride,omitempty"`
+	// Node labels for pod assignment. Pods will only be scheduled on nodes with
+	// matching labels. Ref:
+	// https://kubernetes.io/docs/concepts/scheduling-eviction/assign-pod-node/
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+	PDB          *LoginPDB         `json:"pdb,omitempty"`
+	// Additional labels to add to Login UI pods beyond the standard Helm labels.
+	// Useful for organizing pods with custom label selectors.
+	PodAdditionalLabels map[string]string `json:"podAdditionalLabels,omitempty"`
+	// Annotations to add to Login UI pods. Useful for integrations like
+	// Prometheus scraping, Istio sidecar injection, or Vault agent injection.
+	PodAnnotations map[string]string `json:"podAnnotations,omitempty"`
+	// Optional pod-level security context overrides for Login UI pods. If left
+	// empty, the chart-wide podSecurityContext defined below is used instead.
+	// Use this to customize security settings specifically for the Login UI.
+	// Ref:
+	// https://kubernetes.io/docs/tasks/configure-pod-container/security-context/
+	PodSecurityContext *corev1.PodSecurityContext `json:"podSecurityContext,omitempty"`
+	ReadinessProbe     *LoginReadinessProbe       `json:"readinessProbe,omitempty"`
+	// Number of Login UI pod replicas. A single replica is fine for testing, but
+	// production environments should use 3 or more for high availability during
+	// rolling updates and node failures.
+	ReplicaCount *int `json:"replicaCount,omitempty"`
+	// CPU and memory resource requests and limits for the Login UI container.
+	// Ref:
+	// https://kubernetes.io/docs/concepts/configuration/manage-resources-containers/
+	Resources *corev1.ResourceRequirements `json:"resources,omitempty"`
+	// Number of old ReplicaSets to retain for rollback purposes. Set to 0 to
+	// disable rollback capability and save cluster resources.
+	RevisionHistoryLimit *int `json:"revisionHistoryLimit,omitempty"`
+	// Optional container-level security context overrides for the Login UI
+	// container. If left empty, the chart-wide securityContext defined below is
+	// used instead. Use this to customize security settings specifically for the
+	// Login UI container. Ref:
+	// https://kubernetes.io/docs/tasks/configure-pod-container/security-context/
+	SecurityContext *corev1.SecurityContext `json:"securityContext,omitempty"`
+	Service         *LoginService           `json:"service,omitempty"`
+	ServiceAccount  *LoginServiceAccount    `json:"serviceAccount,omitempty"`
+	StartupProbe    *LoginStartupProbe      `json:"startupProbe,omitempty"`
+	// Tolerations allow pods to be scheduled on nodes with matching taints. Ref:
+	// https://kubernetes.io/docs/concepts/scheduling-eviction/taint-and-toleration/
+	Tolerations []corev1.Toleration `json:"tolerations,omitempty"`
+	// Topology spread constraints control how pods are distributed across
+	// topology domains (e.g., zones, nodes) for high availability. Ref:
+	// https://kubernetes.io/docs/concepts/scheduling-eviction/topology-spread-constraints/
+	TopologySpreadConstraints []corev1.TopologySpreadConstraint `json:"topologySpreadConstraints,omitempty"`
+}
+
+// LoginAutoscaling holds the login.autoscaling values.
+type LoginAutoscaling struct {
+	// Annotations applied to the HPA object.
+	Annotations map[string]string `json:"annotations,omitempty"`
+	// Configures the scaling behavior for scaling up and down. Use this to
+	// control how quickly the HPA scales pods in response to metric changes.
+	// Ref:
+	// https://kubernetes.io/docs/tasks/run-application/horizontal-pod-autoscale/#configurable-scaling-behavior
+	Behavior *autoscalingv2.HorizontalPodAutoscalerBehavior `json:"behavior,omitempty"`
+	// If true, enables the Horizontal Pod Autoscaler for the login deployment.
+	// This will automatically override the `replicaCount` value.
+	Enabled *bool `json:"enabled,omitempty"`
+	// The maximum number of pod replicas.
+	MaxReplicas *int `json:"maxReplicas,omitempty"`
+	// Advanced scaling based on custom metrics exposed by Zitadel. To use these
+	// for scaling, you MUST have a metrics server (e.g., Prometheus) and a
+	// metrics adapter (e.g., prometheus-adapter) running in your cluster. Ref:
+	// https://github.com/kubernetes-sigs/prometheus-adapter
+	Metrics []autoscalingv2.MetricSpec `json:"metrics,omitempty"`
+	// The minimum number of pod replicas.
+	MinReplicas *int `json:"minReplicas,omitempty"`
+	// The target average CPU utilization percentage.
+	TargetCPU *int `json:"targetCPU,omitempty"`
+	// The target average memory utilization percentage.
+	TargetMemory *int `json:"targetMemory,omitempty"`
+}
+
+// LoginConfigMap holds the login.configMap values.
+type LoginConfigMap struct {
+	// Annotations for the Login UI ConfigMap. The default hooks ensure the
+	// ConfigMap is created before the deployment and recreated on upgrades.
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+// LoginGateway holds the login.gateway values.
+type LoginGateway struct {
+	HTTPRoute *LoginGatewayHTTPRoute `json:"httpRoute,omitempty"`
+}
+
+// LoginGatewayHTTPRoute holds the login.gateway.httpRoute values.
+type LoginGatewayHTTPRoute struct {
+	// Annotations to apply to the HTTPRoute resource.
+	Annotations map[string]string `json:"annotations,omitempty"`
+	// If true, creates an HTTPRoute resource for the Login UI service.
+	Enabled *bool `json:"enabled,omitempty"`
+	// Filters to apply to all rules. These define processing steps for requests,
+	// such as header modification or URL rewrites. Ref:
+	// https://gateway-api.sigs.k8s.io/reference/spec/#gateway.networking.k8s.io/v1.HTTPRouteFilter
+	Filters []gatewayv1.HTTPRouteFilter `json:"filters,omitempty"`
+	// Hostnames for the HTTPRoute. If empty, defaults to ExternalDomain.
+	Hostnames []string `json:"hostnames,omitempty"`
+	// Additional labels to apply to the HTTPRoute resource.
+	Labels map[string]string `json:"labels,omitempty"`
+	// References to Gateway resources that this route should be attached to.
+	// Example: parentRefs: - name: my-gateway
+	ParentRefs []LoginGatewayHTTPRouteParentRef `json:"parentRefs,omitempty"`
+	// Path matching rules for the HTTPRoute. Each entry generates a separate
+	// rule.
+	Paths []LoginGatewayHTTPRoutePath `json:"paths,omitempty"`
+	// Timeouts for HTTP requests routed via this HTTPRoute. Ref:
+	// https://gateway-api.sigs.k8s.io/reference/spec/#gateway.networking.k8s.io/v1.HTTPRouteTimeouts
+	Timeouts map[string]string `json:"timeouts,omitempty"`
+}
+
+// LoginGatewayHTTPRouteParentRef holds the login.gateway.httpRoute.parentRefs[]
+// values.
+type LoginGatewayHTTPRouteParentRef struct {
+	Name        *string `json:"name,omitempty"`
+	Namespace   *string `json:"namespace,omitempty"`
+	Port        *int    `json:"port,omitempty"`
+	SectionName *string `json:"sectionName,omitempty"`
+}
+
+// LoginGatewayHTTPRoutePath holds the login.gateway.httpRoute.paths[] values.
+type LoginGatewayHTTPRoutePath struct {
+	Path     *string `json:"path,omitempty"`
+	PathType *string `json:"pathType,omitempty"`
+}
+
+// LoginImage holds the login.image values.
+type LoginImage struct {
+	// Image pull policy. Use "Always" for mutable tags like "latest", or
+	// "IfNotPresent" for immutable tags.
+	PullPolicy *string `json:"pullPolicy,omitempty"`
+	// Docker image repository for the Login UI.
+	Repository *string `json:"repository,omitempty"`
+	// Image tag. Defaults to the chart's appVersion if not specified. Use a
+	// specific version tag for production deployments to ensure reproducibility.
+	Tag *string `json:"tag,omitempty"`
+}
+
+// LoginIngress holds the login.ingress values.
+type LoginIngress struct {
+	// Annotations to apply to the Login UI Ingress resource.
+	Annotations map[string]string `json:"annotations,omitempty"`
+	// The name of the IngressClass resource to use for this Ingress. Ref:
+	// https://kubernetes.io/docs/concepts/services-networking/ingress/#ingress-class
+	ClassName *string `json:"className,omitempty"`
+	// A chart-specific setting to enable logic for different controllers. Use
+	// "aws" to generate AWS ALB-specific annotations.
+	Controller *string `json:"controller,omitempty"`
+	// If true, creates an Ingress resource for the Login UI service.
+	Enabled *bool `json:"enabled,omitempty"`
+	// A list of host rules for the Ingress. The default path targets the login
+	// UI.
+	Hosts []LoginIngressHost `json:"hosts,omitempty"`
+	// TLS configuration for the Ingress. Secure the login UI with HTTPS by
+	// referencing a secret containing the TLS certificate and key.
+	TLS []networkingv1.IngressTLS `json:"tls,omitempty"`
+}
+
+// LoginIngressHost holds the login.ingress.hosts[] values.
+type LoginIngressHost struct {
+	Host  *string                `json:"host,omitempty"`
+	Paths []LoginIngressHostPath `json:"paths,omitempty"`
+}
+
+// LoginIngressHostPath holds the login.ingress.hosts[].paths[] values.
+type LoginIngressHostPath struct {
+	Path     *string `json:"path,omitempty"`
+	PathType *string `json:"pathType,omitempty"`
+}
+
+// LoginLivenessProbe holds the login.livenessProbe values.
+type LoginLivenessProbe struct {
+	// Enable or disable the liveness probe.
+	Enabled *bool `json:"enabled,omitempty"`
+	// Number of consecutive failures before restarting the container.
+	FailureThreshold *int `json:"failureThreshold,omitempty"`
+	// Seconds to wait before starting liveness checks after container start.
+	InitialDelaySeconds *int `json:"initialDelaySeconds,omitempty"`
+	// How often (in seconds) to perform the liveness check.
+	PeriodSeconds *int `json:"periodSeconds,omitempty"`
+}
+
+// LoginMetrics holds the login.metrics values.
+type LoginMetrics struct {
+	// Enable metrics scraping annotations on Login UI pods. When true, adds
+	// prometheus.io/* annotations that enable automatic discovery by Prometheus.
+	Enabled        *bool                       `json:"enabled,omitempty"`
+	ServiceMonitor *LoginMetricsServiceMonitor `json:"serviceMonitor,omitempty"`
+}
+
+// LoginMetricsServiceMonitor holds the login.metrics.serviceMonitor values.
+type LoginMetricsServiceMonitor struct {
+	// Additional labels to add to the ServiceMonitor. Use this to match
+	// Prometheus Operator's serviceMonitorSelector if configured.
+	AdditionalLabels map[string]string `json:"additionalLabels,omitempty"`
+	// Create a ServiceMonitor resource for Prometheus Operator integration. The
+	// Prometheus community Helm chart (kube-prometheus-stack) installs this
+	// operator. To allow discovery across all namespaces, set:
+	// prometheus.prometheusSpec.serviceMonitorSelectorNilUsesHelmValues=false
+	Enabled *bool `json:"enabled,omitempty"`
+	// If true, use metric labels from the Login UI instead of relabeling them to
+	// match Prometheus conventions.
+	HonorLabels *bool `json:"honorLabels,omitempty"`
+	// If true, preserve original scrape timestamps from the Login UI instead of
+	// using Prometheus server time.
+	HonorTimestamps *bool `json:"honorTimestamps,omitempty"`
+	// Relabeling rules applied to individual metrics. Use to rename metrics,
+	// drop expensive metrics, or modify metric labels.
+	MetricRelabellings []monitoringv1.RelabelConfig `json:"metricRelabellings,omitempty"`
+	// Namespace where the ServiceMonitor should be created. If null, uses the
+	// release namespace. Set this if Prometheus watches a specific namespace.
+	Namespace *string `json:"namespace,omitempty"`
+	// HTTP proxy URL for scraping. Use if Prometheus needs to access the Login
+	// UI through a proxy.
+	ProxyURL *string `json:"proxyUrl,omitempty"`
+	// Relabeling rules applied before ingestion. Use to modify, filter, or drop
+	// labels before metrics are stored.
+	Relabellings []monitoringv1.RelabelConfig `json:"relabellings,omitempty"`
+	// HTTP scheme to use for scraping. Set to "https" if the Login UI has
+	// internal TLS enabled. If null, defaults to "http".
+	Scheme *string `json:"scheme,omitempty"`
+	// How often Prometheus should scrape metrics from the Login UI. If null,
+	// uses Prometheus's default scrape interval (typically 30s).
+	ScrapeInterval *string `json:"scrapeInterval,omitempty"`
+	// Timeout for scrape requests. If null, uses Prometheus's default timeout.
+	// Should be less than scrapeInterval.
+	ScrapeTimeout *string `json:"scrapeTimeout,omitempty"`
+	// TLS configuration for scraping HTTPS endpoints. Configure this if the
+	// Login UI has internal TLS enabled and you need to verify certificates.
+	TLSConfig *monitoringv1.TLSConfig `json:"tlsConfig,omitempty"`
+}
+
+// LoginPDB holds the login.pdb values.
+type LoginPDB struct {
+	// Additional annotations to apply to the Pod Disruption Budget resource.
+	Annotations map[string]string `json:"annotations,omitempty"`
+	// Enable or disable the Pod Disruption Budget for Login UI pods.
+	Enabled *bool `json:"enabled,omitempty"`
+	// Maximum number of pods that can be unavailable during disruptions. Cannot
+	// be used together with minAvailable.
+	MaxUnavailable *intstr.IntOrString `json:"maxUnavailable,omitempty"`
+	// Minimum number of pods that must remain available during disruptions.
+	// Cannot be used together with maxUnavailable.
+	MinAvailable *intstr.IntOrString `json:"minAvailable,omitempty"`
+}
+
+// LoginReadinessProbe holds the login.readinessProbe values.
+type LoginReadinessProbe struct {
+	// Enable or disable the readiness probe.
+	Enabled *bool `json:"enabled,omitempty"`
+	// Number of consecutive failures before marking the pod as not ready.
+	FailureThreshold *int `json:"failureThreshold,omitempty"`
+	// Seconds to wait before starting readiness checks after container start.
+	InitialDelaySeconds *int `json:"initialDelaySeconds,omitempty"`
+	// How often (in seconds) to perform the readiness check.
+	PeriodSeconds *int `json:"periodSeconds,omitempty"`
+}
+
+// LoginService holds the login.service values.
+type LoginService struct {
+	// Annotations to add to the Service resource.
+	Annotations map[string]string `json:"annotations,omitempty"`
+	// Application protocol hint for ingress controllers and service meshes.
+	// Helps with protocol detection and routing decisions.
+	AppProtocol *string `json:"appProtocol,omitempty"`
+	// Fixed cluster IP address for ClusterIP services. Leave empty for automatic
+	// assignment. Only applicable when type is "ClusterIP".
+	ClusterIP *string `json:"clusterIP,omitempty"`
+	// Traffic policy for LoadBalancer services. "Cluster" distributes traffic to
+	// all nodes, "Local" only routes to nodes with pods. Only applicable when
+	// type is "LoadBalancer".
+	ExternalTrafficPolicy *string `json:"externalTrafficPolicy,omitempty"`
+	// Labels to add to the Service resource.
+	Labels map[string]string `json:"labels,omitempty"`
+	// Port number the service exposes. Clients connect to this port.
+	Port *int `json:"port,omitempty"`
+	// Protocol identifier used in port naming (e.g., "http", "https", "grpc").
+	Protocol *string `json:"protocol,omitempty"`
+	// HTTP scheme for health checks and internal communication.
+	Scheme *string `json:"scheme,omitempty"`
+	// Service type. Use "ClusterIP" for internal access, "NodePort" for
+	// node-level access, or "LoadBalancer" for cloud provider load balancers.
+	Type *string `json:"type,omitempty"`
+}
+
+// LoginServiceAccount holds the login.serviceAccount values.
+type LoginServiceAccount struct {
+	// Annotations for the Login UI service account. The default Helm hooks
+	// ensure it exists before pods are created. Add annotations here for cloud
+	// provider integrations (e.g., AWS IAM roles, GCP Workload Identity).
+	Annotations map[string]string `json:"annotations,omitempty"`
+	// Whether to create a dedicated service account for the Login UI. Set to
+	// false to use an existing service account or the default account.
+	Create *bool `json:"create,omitempty"`
+	// The name of the service account to use. If not set and create is true, a
+	// name is generated using the fullname template.
+	Name *string `json:"name,omitempty"`
+}
+
+// LoginStartupProbe holds the login.startupProbe values.
+type LoginStartupProbe struct {
+	// Enable or disable the startup probe. When enabled, liveness and readiness
+	// probes are disabled until the startup probe succeeds.
+	Enabled *bool `json:"enabled,omitempty"`
+	// Number of consecutive failures before marking startup as failed and
+	// restarting the container.
+	FailureThreshold *int `json:"failureThreshold,omitempty"`
+	// How often (in seconds) to perform the startup check.
+	PeriodSeconds *int `json:"periodSeconds,omitempty"`
+}
+
+// Metrics holds the metrics values.
+type Metrics struct {
+	// Enable metrics scraping annotations on ZITADEL pods. When true, adds
+	// prometheus.io/* annotations that enable automatic discovery by Prometheus.
+	Enabled        *bool                  `json:"enabled,omitempty"`
+	ServiceMonitor *MetricsServiceMonitor `json:"serviceMonitor,omitempty"`
+}
+
+// MetricsServiceMonitor holds the metrics.serviceMonitor values.
+type MetricsServiceMonitor struct {
+	// Additional labels to add to the ServiceMonitor. Use this to match
+	// Prometheus Operator's serviceMonitorSelector if configured.
+	AdditionalLabels map[string]string `json:"additionalLabels,omitempty"`
+	Enabled          *bool             `json:"enabled,omitempty"`
+	// If true, use metric labels from ZITADEL instead of relabeling them to
+	// match Prometheus conventions.
+	HonorLabels *bool `json:"honorLabels,omitempty"`
+	// If true, preserve original scrape timestamps from ZITADEL instead of using
+	// Prometheus server time.
+	HonorTimestamps *bool `json:"honorTimestamps,omitempty"`
+	// Relabeling rules applied to individual metrics. Use to rename metrics,
+	// drop expensive metrics, or modify metric labels.
+	MetricRelabellings []monitoringv1.RelabelConfig `json:"metricRelabellings,omitempty"`
+	// Namespace where the ServiceMonitor should be created. If null, uses the
+	// release namespace. Set this if Prometheus watches a specific namespace.
+	Namespace *string `json:"namespace,omitempty"`
+	// HTTP proxy URL for scraping. Use if Prometheus needs to access ZITADEL
+	// through a proxy.
+	ProxyURL *string `json:"proxyUrl,omitempty"`
+	// Relabeling rules applied before ingestion. Use to modify, filter, or drop
+	// labels before metrics are stored.
+	Relabellings []monitoringv1.RelabelConfig `json:"relabellings,omitempty"`
+	// HTTP scheme to use for scraping. Set to "https" if ZITADEL has internal
+	// TLS enabled. If null, defaults to "http".
+	Scheme *string `json:"scheme,omitempty"`
+	// How often Prometheus should scrape metrics from ZITADEL. If null, uses
+	// Prometheus's default scrape interval (typically 30s).
+	ScrapeInterval *string `json:"scrapeInterval,omitempty"`
+	// Timeout for scrape requests. If null, uses Prometheus's default timeout.
+	// Should be less than scrapeInterval.
+	ScrapeTimeout *string `json:"scrapeTimeout,omitempty"`
+	// TLS configuration for scraping HTTPS endpoints. Configure this if ZITADEL
+	// has internal TLS enabled and you need to verify certificates.
+	TLSConfig *monitoringv1.TLSConfig `json:"tlsConfig,omitempty"`
+}
+
+// PDB holds the pdb values.
+type PDB struct {
+	// Additional annotations to apply to the Pod Disruption Budget resource.
+	Annotations map[string]string `json:"annotations,omitempty"`
+	// Enable or disable the Pod Disruption Budget for ZITADEL pods
+	Enabled *bool `json:"enabled,omitempty"`
+	// Maximum number of pods that can be unavailable during disruptions. Cannot
+	// be used together with minAvailable.
+	MaxUnavailable *intstr.IntOrString `json:"maxUnavailable,omitempty"`
+	// Minimum number of pods that must remain available during disruptions.
+	// Cannot be used together with maxUnavailable.
+	MinAvailable *intstr.IntOrString `json:"minAvailable,omitempty"`
+}
+
+// Postgresql holds the postgresql values.
+type Postgresql struct {
+	Auth *PostgresqlAuth `json:"auth,omitempty"`
+	// Deploy a PostgreSQL instance as a subchart. Enables one-command full-stack
+	// install.
+	Enabled *bool              `json:"enabled,omitempty"`
+	Primary *PostgresqlPrimary `json:"primary,omitempty"`
+}
+
+// PostgresqlAuth holds the postgresql.auth values.
+type PostgresqlAuth struct {
+	// Database name to create for ZITADEL.
+	Database *string `json:"database,omitempty"`
+	// Password for the ZITADEL application user. Change this for anything beyond
+	// a local quickstart.
+	Password *string `json:"password,omitempty"`
+	// Password for the PostgreSQL superuser (postgres). Change this for anything
+	// beyond a local quickstart.
+	PostgresPassword *string `json:"postgresPassword,omitempty"`
+	// Username for the ZITADEL application user.
+	Username *string `json:"username,omitempty"`
+}
+
+// PostgresqlPrimary holds the postgresql.primary values.
+type PostgresqlPrimary struct {
+	Persistence *PostgresqlPrimaryPersistence `json:"persistence,omitempty"`
+}
+
+// PostgresqlPrimaryPersistence holds the postgresql.primary.persistence values.
+type PostgresqlPrimaryPersistence struct {
+	// Enable persistent storage for PostgreSQL data. Set to true if you want
+	// data to survive pod restarts.
+	Enabled *bool `json:"enabled,omitempty"`
+}
+
+// ReadinessProbe holds the readinessProbe values.
+type ReadinessProbe struct {
+	// Enable or disable the readiness probe.
+	Enabled *bool `json:"enabled,omitempty"`
+	// Number of consecutive failures before marking the pod as not ready.
+	FailureThreshold *int `json:"failureThreshold,omitempty"`
+	// Seconds to wait before starting readiness checks after container start.
+	// Set higher if ZITADEL needs time to initialize before accepting traffic.
+	InitialDelaySeconds *int `json:"initialDelaySeconds,omitempty"`
+	// How often (in seconds) to perform the readiness check.
+	PeriodSeconds *int `json:"periodSeconds,omitempty"`
+}
+
+// Service holds the service values.
+type Service struct {
+	// Annotations to add to the Service resource. The default annotation tells
+	// Traefik to use HTTP/2 when communicating with ZITADEL backends.
+	Annotations map[string]string `json:"annotations,omitempty"`
+	// Application protocol hint for ingress controllers and service meshes.
+	// "kubernetes.io/h2c" indicates HTTP/2 over cleartext (without TLS).
+	AppProtocol *string `json:"appProtocol,omitempty"`
+	// Fixed cluster IP address for ClusterIP services. Leave empty for automatic
+	// assignment by Kubernetes. Only applicable when type is "ClusterIP".
+	// Setting a fixed IP is useful when other services need a stable endpoint.
+	ClusterIP *string `json:"clusterIP,omitempty"`
+	// Traffic policy for LoadBalancer and NodePort services. "Cluster"
+	// distributes traffic across all nodes (default), "Local" only routes to
+	// nodes with pods, preserving client source IP but potentially causing
+	// uneven load distribution.
+	ExternalTrafficPolicy *string `json:"externalTrafficPolicy,omitempty"`
+	// Labels to add to the Service resource. Use for organizing services or
+	// matching service selectors in network policies.
+	Labels map[string]string `json:"labels,omitempty"`
+	// Port number the service exposes. Clients and ingress controllers connect
+	// to this port. ZITADEL uses 8080 by default for its HTTP/2 server.
+	Port *int `json:"port,omitempty"`
+	// Protocol identifier used in port naming. ZITADEL uses HTTP/2 (h2c) for
+	// both REST API and gRPC traffic on the same port.
+	Protocol *string `json:"protocol,omitempty"`
+	// HTTP scheme for health checks and internal communication. Use "HTTP" when
+	// TLS termination happens at the ingress/load balancer, or "HTTPS" when
+	// ZITADEL is configured with internal TLS.
+	Scheme *string `json:"scheme,omitempty"`
+	// Service type. Use "ClusterIP" for internal-only access (typical when using
+	// an ingress controller), "NodePort" for direct node-level access, or
+	// "LoadBalancer" for cloud provider load balancers.
+	Type *string `json:"type,omitempty"`
+}
+
+// ServiceAccount holds the serviceAccount values.
+type ServiceAccount struct {
+	// Annotations to add to the service account. The default Helm hooks ensure
+	// the service account exists before pods are created. Add annotations here
+	// for cloud provider integrations (e.g., AWS IAM roles, GCP Workload
+	// Identity).
+	Annotations map[string]string `json:"annotations,omitempty"`
+	// Whether to create a dedicated service account for ZITADEL. Set to false if
+	// you want to use an existing service account or the default account.
+	Create *bool `json:"create,omitempty"`
+	// The name of the service account to use. If not set and create is true, a
+	// name is generated using the fullname template. Set this to use a specific
+	// existing service account when create is false.
+	Name *string `json:"name,omitempty"`
+}
+
+// SetupJob holds the setupJob values.
+type SetupJob struct {
+	// Maximum time in seconds for the setup job to complete. The job is
+	// terminated if it exceeds this deadline. Increase this for slow
+	// environments.
+	ActiveDeadlineSeconds *int `json:"activeDeadlineSeconds,omitempty"`
+	// Additional command-line arguments to pass to the ZITADEL setup command.
+	// The default enables projection initialization for better startup
+	// performance.
+	AdditionalArgs []string `json:"additionalArgs,omitempty"`
+	// Annotations for the setup job. The Helm hooks ensure this job runs after
+	// the init job (weight "2" > "1") and before the main deployment.
+	Annotations map[string]string `json:"annotations,omitempty"`
+	// Number of retries before marking the setup job as failed.
+	BackoffLimit *int `json:"backoffLimit,omitempty"`
+	// Sidecar containers to run alongside the setup container. Useful for
+	// logging, proxies (e.g., cloud-sql-proxy), or other supporting services.
+	ExtraContainers []corev1.Container `json:"extraContainers,omitempty"`
+	// Init containers to run before the main setup container. Useful for waiting
+	// on additional dependencies or performing pre-setup tasks.
+	InitContainers   []corev1.Container        `json:"initContainers,omitempty"`
+	MachinekeyWriter *SetupJobMachinekeyWriter `json:"machinekeyWriter,omitempty"`
+	// Additional labels to add to setup job pods.
+	PodAdditionalLabels map[string]string `json:"podAdditionalLabels,omitempty"`
+	// Additional annotations to add to setup job pods.
+	PodAnnotations map[string]string `json:"podAnnotations,omitempty"`
+	// CPU and memory resource requests and limits for the setup job container.
+	// The setup job performs more work than init, including generating keys and
+	// creating initial data.
+	Resources *corev1.ResourceRequirements `json:"resources,omitempty"`
+}
+
+// SetupJobMachinekeyWriter holds the setupJob.machinekeyWriter values.
+type SetupJobMachinekeyWriter struct {
+	Image *SetupJobMachinekeyWriterImage `json:"image,omitempty"`
+	// CPU and memory resource requests and limits for the machinekey writer
+	// container. This container only runs kubectl commands and needs minimal
+	// resources.
+	Resources *corev1.ResourceRequirements `json:"resources,omitempty"`
+}
+
+// SetupJobMachinekeyWriterImage holds the setupJob.machinekeyWriter.image
+// values.
+type SetupJobMachinekeyWriterImage struct {
+	// Override the default kubectl image repository. Leave empty to use the
+	// value from tools.kubectl.image.repository.
+	Repository *string `json:"repository,omitempty"`
+	// Override the default kubectl image tag. Leave empty to use the value from
+	// tools.kubectl.image.tag (which defaults to cluster version).
+	Tag *string `json:"tag,omitempty"`
+}
+
+// StartupProbe holds the startupProbe values.
+type StartupProbe struct {
+	// Enable or disable the startup probe. When enabled, liveness and readiness
+	// probes are disabled until the startup probe succeeds.
+	Enabled *bool `json:"enabled,omitempty"`
+	// Number of consecutive failures before marking startup as failed and
+	// restarting the container. With periodSeconds=1 and failureThreshold=30,
+	// the container has 30 seconds to start.
+	FailureThreshold *int `json:"failureThreshold,omitempty"`
+	// How often (in seconds) to perform the startup check.
+	PeriodSeconds *int `json:"periodSeconds,omitempty"`
+}
+
+// Tools holds the tools values.
+type Tools struct {
+	Kubectl *ToolsKubectl `json:"kubectl,omitempty"`
+	Wait4x  *ToolsWait4x  `json:"wait4x,omitempty"`
+}
+
+// ToolsKubectl holds the tools.kubectl values.
+type ToolsKubectl struct {
+	Image *ToolsKubectlImage `json:"image,omitempty"`
+}
+
+// ToolsKubectlImage holds the tools.kubectl.image values.
+type ToolsKubectlImage struct {
+	// The pull policy for the kubectl image. If left empty, Kubernetes applies
+	// its default policy depending on whether the tag is mutable or fixed.
+	PullPolicy *string `json:"pullPolicy,omitempty"`
+	// The name of the image repository that contains the kubectl image. The
+	// chart automatically prepends the registry (docker.io by default) for
+	// compatibility with CRI-O v1.34+ which enforces fully qualified names.
+	Repository *string `json:"repository,omitempty"`
+	// The image tag to use for the kubectl image. It should be left empty to
+	// automatically default to the Kubernetes cluster version
+	Tag *string `json:"tag,omitempty"`
+}
+
+// ToolsWait4x holds the tools.wait4x values.
+type ToolsWait4x struct {
+	Image *ToolsWait4xImage `json:"image,omitempty"`
+	// CPU and memory resource requests and limits for wait4x init containers.
+	// These resources apply to all init containers using the wait4x tool, such
+	// as wait-for-zitadel. Setting equal requests and limits enables the
+	// "Guaranteed" QoS class when combined with resource settings on the main
+	// container. Ref:
+	// https://kubernetes.io/docs/concepts/configuration/manage-resources-containers/
+	Resources *corev1.ResourceRequirements `json:"resources,omitempty"`
+}
+
+// ToolsWait4xImage holds the tools.wait4x.image values.
+type ToolsWait4xImage struct {
+	// The pull policy for the wait4x image. If left empty, the chart defaults to
+	// the Kubernetes default pull policy for the given tag.
+	PullPolicy *string `json:"pullPolicy,omitempty"`
+	// The name of the image repository that contains the wait4x image. The chart
+	// automatically prepends the registry (docker.io by default) for
+	// compatibility with CRI-O v1.34+ which enforces fully qualified names.
+	Repository *string `json:"repository,omitempty"`
+	// The image tag to use for the wait4x image. Leave empty to require the user
+	// to set a specific version explicitly.
+	Tag *string `json:"tag,omitempty"`
+}
+
+// Zitadel holds the zitadel values.
+type Zitadel struct {
+	Autoscaling *ZitadelAutoscaling `json:"autoscaling,omitempty"`
+	// The key within the configSecretName secret that contains the ZITADEL
+	// configuration YAML. The default "config-yaml" matches the expected format.
+	ConfigSecretKey *string `json:"configSecretKey,omitempty"`
+	// Name of an existing Kubernetes Secret containing ZITADEL configuration.
+	// Use this when you want to manage ZITADEL configuration externally (e.g.,
+	// via External Secrets Operator, Sealed Secrets, or GitOps). The secret
+	// should contain YAML configuration in the same format as configmapConfig.
+	ConfigSecretName *string `json:"configSecretName,omitempty"`
+	// ZITADEL runtime configuration written to a Kubernetes ConfigMap. These
+	// values are passed directly to the ZITADEL binary and control its behavior.
+	// For the complete list of available configuration options, see:
+	// https://github.com/zitadel/zitadel/blob/main/cmd/defaults.yaml
+	ConfigmapConfig map[string]any `json:"configmapConfig,omitempty"`
+	// Name of a Kubernetes Secret containing the admin user's client certificate
+	// for mutual TLS (mTLS) authentication to the database. The secret must
+	// contain keys "tls.crt" (certificate) and "tls.key" (private key). Used by
+	// the init job for database setup operations that require elevated
+	// privileges.
+	DBSSLAdminCrtSecret *string `json:"dbSslAdminCrtSecret,omitempty"`
+	// PEM-encoded CA certificate for verifying the database server's TLS
+	// certificate. Use this when your PostgreSQL server uses a self-signed
+	// certificate or a certificate signed by a private CA. The certificate is
+	// stored in a Kubernetes Secret and mounted into ZITADEL pods at
+	// /db-ssl-ca-crt/ca.crt. Either provide the certificate inline here, or
+	// reference an existing secret using dbSslCaCrtSecret instead.
+	DBSSLCaCrt *string `json:"dbSslCaCrt,omitempty"`
+	// Annotations for the dbSslCaCrt Secret when created from the inline
+	// certificate. The default Helm hooks ensure the secret exists before pods
+	// start.
+	DBSSLCaCrtAnnotations map[string]string `json:"dbSslCaCrtAnnotations,omitempty"`
+	// Name of an existing Kubernetes Secret containing the database CA
+	// certificate at key "ca.crt". Use this instead of dbSslCaCrt when the
+	// certificate is managed externally (e.g., by cert-manager or an operator).
+	// The secret must exist in the same namespace as the ZITADEL release.
+	DBSSLCaCrtSecret *string `json:"dbSslCaCrtSecret,omitempty"`
+	// Name of a Kubernetes Secret containing the application user's client
+	// certificate for mutual TLS (mTLS) authentication to the database. The
+	// secret must contain keys "tls.crt" (certificate) and "tls.key" (private
+	// key). Used by the main ZITADEL deployment and setup job for normal
+	// database operations.
+	DBSSLUserCrtSecret *string       `json:"dbSslUserCrtSecret,omitempty"`
+	Debug              *ZitadelDebug `json:"debug,omitempty"`
+	// Global sidecar containers added to all ZITADEL workloads (Deployment, init
+	// job, setup job, and debug pod when enabled). Use this for shared services
+	// like database proxies (e.g., cloud-sql-proxy) that all workloads need to
+	// connect to the database.
+	ExtraContainers []corev1.Container `json:"extraContainers,omitempty"`
+	// Global init containers added to all ZITADEL workloads (Deployment, init
+	// job, setup job, and debug pod when enabled). Use this for shared
+	// dependencies like database readiness checks or certificate initialization
+	// that all workloads need.
+	InitContainers []corev1.Container `json:"initContainers,omitempty"`
+	// ZITADEL's masterkey for symmetric encryption of sensitive data like
+	// private keys and tokens. Must be exactly 32 bytes. Using printable ASCII
+	// characters is recommended (alphanumeric). Do NOT use multi-byte Unicode
+	// characters, as the key length is measured in bytes, not characters.
+	// Generate with: tr -dc A-Za-z0-9 </dev/urandom | head -c 32 IMPORTANT:
+	// Store this value securely. Loss of the masterkey means loss of all
+	// encrypted data. Either set this value or use masterkeySecretName.
+	Masterkey *string `json:"masterkey,omitempty"`
+	// Annotations for the masterkey Secret when created from zitadel.masterkey.
+	// The secret is created once on install and is immutable.
+	MasterkeyAnnotations map[string]string `json:"masterkeyAnnotations,omitempty"`
+	// Name of an existing Kubernetes Secret containing the masterkey at key
+	// "masterkey". Use this for production deployments to avoid storing the
+	// masterkey in values files. The secret must exist before chart
+	// installation. Note: Either zitadel.masterkey or
+	// zitadel.masterkeySecretName must be set.
+	MasterkeySecretName *string `json:"masterkeySecretName,omitempty"`
+	// Optional overrides for the pod security context used by Zitadel pods. If
+	// left empty, the chart-wide podSecurityContext defined below is used.
+	PodSecurityContext *corev1.PodSecurityContext `json:"podSecurityContext,omitempty"`
+	// Number of old ReplicaSets to retain for rollback purposes Set to 0 to not
+	// keep any old ReplicaSets
+	RevisionHistoryLimit *int `json:"revisionHistoryLimit,omitempty"`
+	// Sensitive ZITADEL configuration values written to a Kubernetes Secret
+	// instead of a ConfigMap. Use this for database passwords, API keys, SMTP
+	// credentials, and other values that should not be stored in plain text. The
+	// secret is mounted alongside the ConfigMap and both are merged by ZITADEL
+	// at startup. Structure follows the same format as configmapConfig. See all
+	// options: https://github.com/zitadel/zitadel/blob/main/cmd/defaults.yaml
+	// Example: secretConfig: Database: Postgres: User: Password:
+	// "my-secure-password"
+	SecretConfig map[string]any `json:"secretConfig,omitempty"`
+	// Annotations for the secretConfig Secret. The default Helm hooks ensure the
+	// secret is created before the deployment and recreated on upgrades.
+	SecretConfigAnnotations map[string]string `json:"secretConfigAnnotations,omitempty"`
+	// Optional overrides for the container security context used by Zitadel
+	// pods. If left empty, the chart-wide securityContext defined below is used.
+	SecurityContext *corev1.SecurityContext `json:"securityContext,omitempty"`
+	SelfSignedCert  *ZitadelSelfSignedCert  `json:"selfSignedCert,omitempty"`
+	// Name of a Kubernetes Secret containing the TLS certificate for ZITADEL's
+	// internal HTTPS server. The secret must contain keys "tls.crt"
+	// (certificate) and "tls.key" (private key). Use this when ZITADEL should
+	// serve HTTPS directly instead of relying on TLS termination at an ingress
+	// controller or load balancer. Requires configmapConfig.TLS.Enabled to be
+	// true.
+	ServerSSLCrtSecret *string `json:"serverSslCrtSecret,omitempty"`
+}
+
+// ZitadelAutoscaling holds the zitadel.autoscaling values.
+type ZitadelAutoscaling struct {
+	// Annotations applied to the HPA object.
+	Annotations map[string]string `json:"annotations,omitempty"`
+	// Configures the scaling behavior for scaling up and down. See:
+	// https://kubernetes.io/docs/tasks/run-application/horizontal-pod-autoscale/#configurable-scaling-behavior
+	Behavior *autoscalingv2.HorizontalPodAutoscalerBehavior `json:"behavior,omitempty"`
+	// If true, enables the Horizontal Pod Autoscaler for the Zitadel deployment.
+	// This will automatically override the `replicaCount` value.
+	Enabled *bool `json:"enabled,omitempty"`
+	// The maximum number of pod replicas.
+	MaxReplicas *int `json:"maxReplicas,omitempty"`
+	// Advanced scaling based on custom metrics exposed by Zitadel. Zitadel
+	// exposes standard Go runtime metrics. To use these for scaling, you MUST
+	// have a metrics server (e.g., Prometheus) and a metrics adapter (e.g.,
+	// prometheus-adapter) running in your cluster. Ref:
+	// https://github.com/kubernetes-sigs/prometheus-adapter
+	Metrics []autoscalingv2.MetricSpec `json:"metrics,omitempty"`
+	// The minimum number of pod replicas.
+	MinReplicas *int `json:"minReplicas,omitempty"`
+	// The target average CPU utilization percentage.
+	TargetCPU *int `json:"targetCPU,omitempty"`
+	// The target average memory utilization percentage.
+	TargetMemory *int `json:"targetMemory,omitempty"`
+}
+
+// ZitadelDebug holds the zitadel.debug values.
+type ZitadelDebug struct {
+	// Annotations for the debug pod. The Helm hooks ensure it's created during
+	// install/upgrade and cleaned up appropriately.
+	Annotations map[string]string `json:"annotations,omitempty"`
+	// Enable or disable the debug pod. Only enable for troubleshooting; disable
+	// in production environments.
+	Enabled *bool `json:"enabled,omitempty"`
+	// Sidecar containers to run alongside the debug container.
+	ExtraContainers []corev1.Container `json:"extraContainers,omitempty"`
+	// Init containers to run before the debug container starts.
+	InitContainers []corev1.Container `json:"initContainers,omitempty"`
+}
+
+// ZitadelSelfSignedCert holds the zitadel.selfSignedCert values.
+type ZitadelSelfSignedCert struct {
+	AdditionalDNSName *string `json:"additionalDnsName,omitempty"`
+	// Enable generation of a self-signed TLS certificate.
+	Enabled *bool `json:"enabled,omitempty"`
+}